@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"os"
 
+	"github.com/kingfs/llm-tracelab/internal/analyzer"
 	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/observeworker"
 	"github.com/kingfs/llm-tracelab/internal/reanalysis"
 	"github.com/kingfs/llm-tracelab/internal/sessionanalysis"
 	"github.com/kingfs/llm-tracelab/internal/store"
@@ -54,6 +57,14 @@ type analyzeSessionOptions struct {
 	stdout     io.Writer
 }
 
+type analyzeRulesTestOptions struct {
+	configPath string
+	rulePath   string
+	traceID    string
+	format     string
+	stdout     io.Writer
+}
+
 func newAnalyzeCommand(runtime *cliRuntime) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:           "analyze",
@@ -69,6 +80,7 @@ func newAnalyzeCommand(runtime *cliRuntime) *cobra.Command {
 	cmd.AddCommand(newAnalyzeRepairUsageCommand(runtime))
 	cmd.AddCommand(newAnalyzeReanalyzeCommand(runtime))
 	cmd.AddCommand(newAnalyzeSessionCommand(runtime))
+	cmd.AddCommand(newAnalyzeRulesCommand(runtime))
 	return cmd
 }
 
@@ -220,6 +232,48 @@ func newAnalyzeSessionCommand(runtime *cliRuntime) *cobra.Command {
 	return cmd
 }
 
+func newAnalyzeRulesCommand(runtime *cliRuntime) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "rules",
+		Short:         "Work with declarative detector rule packs",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return requireSubcommand(cmd)
+		},
+	}
+	var rulePath string
+	var traceID string
+	test := &cobra.Command{
+		Use:           "test",
+		Short:         "Run a rule pack against one recorded trace without saving findings",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if rulePath == "" {
+				return cliUsageError("--rule is required", "rule")
+			}
+			if traceID == "" {
+				return cliUsageError("--trace is required", "trace")
+			}
+			return runCode(func() int {
+				return runAnalyzeRulesTest(analyzeRulesTestOptions{
+					configPath: runtime.configPath(),
+					rulePath:   rulePath,
+					traceID:    traceID,
+					format:     runtime.outputFormat(),
+					stdout:     cmd.OutOrStdout(),
+				})
+			})
+		},
+	}
+	test.Flags().StringVar(&rulePath, "rule", "", "Rule pack file or directory of rule packs")
+	test.Flags().StringVar(&traceID, "trace", "", "Trace ID to test against")
+	cmd.AddCommand(test)
+	return cmd
+}
+
 func runAnalyzeReparse(opts analyzeReparseOptions) int {
 	cfg, err := config.Load(opts.configPath)
 	if err != nil {
@@ -331,7 +385,12 @@ func runAnalyzeReanalyze(opts analyzeReanalyzeOptions) int {
 	}
 	defer traceStore.Close()
 
-	svc := reanalysis.New(traceStore, reanalysis.Options{})
+	runner, err := newAnalysisRunner(cfg)
+	if err != nil {
+		slog.Error("Failed to load detector rules", "error", err)
+		return 1
+	}
+	svc := reanalysis.New(traceStore, reanalysis.Options{Runner: runner})
 	if opts.traceID != "" {
 		var result reanalysis.Result
 		if opts.repairUsage {
@@ -425,6 +484,12 @@ func runAnalyzeSession(opts analyzeSessionOptions) int {
 		slog.Error("Failed to load session traces", "session_id", opts.sessionID, "error", err)
 		return 1
 	}
+	trajectory := sessionanalysis.BuildTrajectory(opts.sessionID, traces, sessionanalysis.ParseObservations(context.Background(), traces))
+	sessionFindings := sessionanalysis.Detect(trajectory, sessionanalysis.DefaultDetectorOptions())
+	if err := traceStore.SaveSessionFindings(opts.sessionID, sessionFindings); err != nil {
+		slog.Error("Failed to save session findings", "session_id", opts.sessionID, "error", err)
+		return 1
+	}
 	findingsByTrace := map[string][]observe.Finding{}
 	for _, trace := range traces {
 		findings, err := traceStore.ListFindings(trace.ID, store.FindingFilter{})
@@ -435,6 +500,7 @@ func runAnalyzeSession(opts analyzeSessionOptions) int {
 		findingsByTrace[trace.ID] = findings
 	}
 	output := sessionanalysis.Build(summary, traces, findingsByTrace)
+	output.Trajectory = &trajectory
 	outputJSON, err := sessionanalysis.Marshal(output)
 	if err != nil {
 		slog.Error("Failed to marshal session analysis", "session_id", opts.sessionID, "error", err)
@@ -461,9 +527,10 @@ func runAnalyzeSession(opts analyzeSessionOptions) int {
 		"analyzer_version": sessionanalysis.AnalyzerVersion,
 		"trace_count":      len(output.TraceRefs),
 		"finding_refs":     len(output.FindingRefs),
+		"session_findings": len(sessionFindings),
 	}
 	if err := writeCLIResult(stdoutOrDefault(opts.stdout), opts.format, "analyze session", result, func(w io.Writer) error {
-		_, err := fmt.Fprintf(w, "analyzed session %s (%d traces, %d finding refs)\n", opts.sessionID, len(output.TraceRefs), len(output.FindingRefs))
+		_, err := fmt.Fprintf(w, "analyzed session %s (%d traces, %d finding refs, %d session findings)\n", opts.sessionID, len(output.TraceRefs), len(output.FindingRefs), len(sessionFindings))
 		return err
 	}); err != nil {
 		slog.Error("Write command result failed", "error", err)
@@ -491,7 +558,12 @@ func runAnalyzeScan(opts analyzeScanOptions) int {
 	}
 	defer traceStore.Close()
 
-	result, err := reanalysis.New(traceStore, reanalysis.Options{}).RescanTrace(context.Background(), opts.traceID)
+	runner, err := newAnalysisRunner(cfg)
+	if err != nil {
+		slog.Error("Failed to load detector rules", "error", err)
+		return 1
+	}
+	result, err := reanalysis.New(traceStore, reanalysis.Options{Runner: runner}).RescanTrace(context.Background(), opts.traceID)
 	if err != nil {
 		slog.Error("Failed to scan trace", "trace_id", opts.traceID, "error", err)
 		return 1
@@ -510,3 +582,91 @@ func runAnalyzeScan(opts analyzeScanOptions) int {
 	}
 	return 0
 }
+
+// newAnalysisRunner 在配置了 analysis.rules_dir 时返回内置检测器加规则检测器的 runner，否则返回 nil 使用默认检测器。
+func newAnalysisRunner(cfg *config.Config) (*analyzer.Runner, error) {
+	if cfg.Analysis.RulesDir == "" && !cfg.Analysis.PII.Enabled {
+		return nil, nil
+	}
+	detectors := analyzer.DefaultDetectors()
+	if cfg.Analysis.PII.Enabled {
+		pii, err := analyzer.NewPIIDetector(analyzer.PIIDetectorOptions{
+			Entities: cfg.Analysis.PII.Entities,
+			Locales:  cfg.Analysis.PII.Locales,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("analysis.pii: %w", err)
+		}
+		detectors = append(detectors, pii)
+	}
+	if cfg.Analysis.RulesDir != "" {
+		rules, err := analyzer.NewRuleDetector(cfg.Analysis.RulesDir, cfg.Analysis.RulesReloadInterval)
+		if err != nil {
+			return nil, fmt.Errorf("load %s: %w", cfg.Analysis.RulesDir, err)
+		}
+		detectors = append(detectors, rules)
+	}
+	return analyzer.NewRunner(detectors...), nil
+}
+
+// runAnalyzeRulesTest 从 cassette 重新解析 trace 后只运行指定规则，结果不写入数据库。
+func runAnalyzeRulesTest(opts analyzeRulesTestOptions) int {
+	var packs []analyzer.RulePack
+	info, err := os.Stat(opts.rulePath)
+	if err == nil && info.IsDir() {
+		packs, err = analyzer.LoadRulePacks(opts.rulePath)
+	} else if err == nil {
+		var pack analyzer.RulePack
+		pack, err = analyzer.LoadRulePackFile(opts.rulePath)
+		packs = []analyzer.RulePack{pack}
+	}
+	if err != nil {
+		slog.Error("Failed to load rule pack", "path", opts.rulePath, "error", err)
+		return 1
+	}
+	traceStore, code := openTraceStore(opts.configPath)
+	if code != 0 {
+		return code
+	}
+	defer traceStore.Close()
+	entry, err := traceStore.GetByID(opts.traceID)
+	if err != nil {
+		slog.Error("Failed to load trace", "trace_id", opts.traceID, "error", err)
+		return 1
+	}
+	obs, err := observeworker.ParseCassette(context.Background(), nil, entry.ID, entry.LogPath)
+	if err != nil {
+		slog.Error("Failed to parse trace", "trace_id", opts.traceID, "error", err)
+		return 1
+	}
+	findings, err := analyzer.NewRunner(analyzer.NewStaticRuleDetector(packs...)).Analyze(context.Background(), obs)
+	if err != nil {
+		slog.Error("Failed to run rules", "trace_id", opts.traceID, "error", err)
+		return 1
+	}
+	rules := 0
+	for _, pack := range packs {
+		rules += len(pack.Rules)
+	}
+	output := map[string]any{
+		"trace_id": opts.traceID,
+		"packs":    packs,
+		"rules":    rules,
+		"findings": findings,
+	}
+	if err := writeCLIResult(stdoutOrDefault(opts.stdout), opts.format, "analyze rules test", output, func(w io.Writer) error {
+		if _, err := fmt.Fprintf(w, "tested %d rule(s) from %d pack(s) against trace %s: %d finding(s)\n", rules, len(packs), opts.traceID, len(findings)); err != nil {
+			return err
+		}
+		for _, finding := range findings {
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", finding.Severity, finding.Category, finding.Detector, finding.EvidencePath, finding.Title); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		slog.Error("Write command result failed", "error", err)
+		return 1
+	}
+	return 0
+}
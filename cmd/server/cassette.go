@@ -0,0 +1,348 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/observeworker"
+	"github.com/kingfs/llm-tracelab/internal/recorder"
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+	"github.com/kingfs/llm-tracelab/pkg/recordfile"
+	"github.com/kingfs/llm-tracelab/pkg/redact"
+	"github.com/spf13/cobra"
+)
+
+type cassetteScrubOptions struct {
+	configPath string
+	paths      []string
+	headers    []string
+	jsonPaths  []string
+	patterns   []string
+	dryRun     bool
+	format     string
+	stdout     io.Writer
+}
+
+type cassetteDiffOptions struct {
+	pathA  string
+	pathB  string
+	format string
+	stdout io.Writer
+}
+
+type cassetteScrubItem struct {
+	Path    string `json:"path"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
+
+func newCassetteCommand(runtime *cliRuntime) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "cassette",
+		Short:         "Maintain recorded cassette files",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return requireSubcommand(cmd)
+		},
+	}
+	cmd.AddCommand(newCassetteScrubCommand(runtime), newCassetteDiffCommand(runtime))
+	return cmd
+}
+
+func newCassetteScrubCommand(runtime *cliRuntime) *cobra.Command {
+	var (
+		headers   []string
+		jsonPaths []string
+		patterns  []string
+		dryRun    bool
+	)
+	cmd := &cobra.Command{
+		Use:           "scrub [path...]",
+		Short:         "Apply the redaction policy to recorded cassettes in place",
+		Long:          "Apply the configured redaction policy, plus any --header/--json-path/--pattern flags, to cassette files. Directories are walked for *.http files; without arguments the trace output directory is used.",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCode(func() int {
+				return runCassetteScrub(cassetteScrubOptions{
+					configPath: runtime.configPath(),
+					paths:      args,
+					headers:    headers,
+					jsonPaths:  jsonPaths,
+					patterns:   patterns,
+					dryRun:     dryRun,
+					format:     runtime.outputFormat(),
+					stdout:     cmd.OutOrStdout(),
+				})
+			})
+		},
+	}
+	cmd.Flags().StringArrayVar(&headers, "header", nil, "Additional header name to redact (repeatable)")
+	cmd.Flags().StringArrayVar(&jsonPaths, "json-path", nil, "Additional JSON path to redact, e.g. $.metadata.user_id (repeatable)")
+	cmd.Flags().StringArrayVar(&patterns, "pattern", nil, "Additional pattern as name=regexp, or a built-in name such as email or credentials (repeatable)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Report which cassettes would change without rewriting them")
+	return cmd
+}
+
+func newCassetteDiffCommand(runtime *cliRuntime) *cobra.Command {
+	return &cobra.Command{
+		Use:           "diff <a.http> <b.http>",
+		Short:         "Semantically compare two recorded cassettes",
+		Long:          "Parse both cassettes into observations and compare instructions, messages, tool declarations, tool calls (arguments diffed as JSON), outputs, finish reasons, usage and timings.",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return cliUsageError("diff requires exactly two cassette paths", "path")
+			}
+			return runCode(func() int {
+				return runCassetteDiff(cassetteDiffOptions{
+					pathA:  args[0],
+					pathB:  args[1],
+					format: runtime.outputFormat(),
+					stdout: cmd.OutOrStdout(),
+				})
+			})
+		},
+	}
+}
+
+func runCassetteDiff(opts cassetteDiffOptions) int {
+	registry := observe.NewDefaultRegistry()
+	var sides [2]observe.TraceObservation
+	for i, path := range []string{opts.pathA, opts.pathB} {
+		obs, err := observeworker.ParseCassette(context.Background(), registry, path, path)
+		if err != nil {
+			slog.Error("Failed to parse cassette", "path", path, "error", err)
+			return 1
+		}
+		sides[i] = obs
+	}
+	diff := observe.DiffObservations(sides[0], sides[1])
+	if err := writeCLIResult(stdoutOrDefault(opts.stdout), opts.format, "cassette diff", diff, func(w io.Writer) error {
+		return writeTraceDiffText(w, diff)
+	}); err != nil {
+		slog.Error("Write command result failed", "error", err)
+		return 1
+	}
+	return 0
+}
+
+// writeTraceDiffText 只输出有变化的条目，完整对齐结果见 JSON 输出。
+func writeTraceDiffText(w io.Writer, diff observe.TraceDiff) error {
+	if _, err := fmt.Fprintf(w, "--- %s (%s)\n+++ %s (%s)\n", diff.A.TraceID, diff.A.Model, diff.B.TraceID, diff.B.Model); err != nil {
+		return err
+	}
+	for _, section := range diff.Sections {
+		if !section.Changed {
+			if _, err := fmt.Fprintf(w, "== %s: unchanged\n", section.Name); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "== %s\n", section.Name); err != nil {
+			return err
+		}
+		for _, item := range section.Items {
+			if err := writeTraceDiffItem(w, item); err != nil {
+				return err
+			}
+		}
+	}
+	verdict := "traces differ"
+	if diff.Identical {
+		verdict = "traces are semantically identical"
+		if diff.PerformanceChanged {
+			verdict += " (usage or timings differ)"
+		}
+	}
+	_, err := fmt.Fprintln(w, verdict)
+	return err
+}
+
+func writeTraceDiffItem(w io.Writer, item observe.DiffItem) error {
+	var err error
+	switch item.Status {
+	case observe.DiffAdded:
+		_, err = fmt.Fprintf(w, "  + %s: %s\n", item.Key, diffPreview(item.B))
+	case observe.DiffRemoved:
+		_, err = fmt.Fprintf(w, "  - %s: %s\n", item.Key, diffPreview(item.A))
+	case observe.DiffChanged:
+		switch {
+		case item.Delta != nil:
+			_, err = fmt.Fprintf(w, "  ~ %s: %s -> %s (%+g)\n", item.Key, item.A, item.B, *item.Delta)
+		case len(item.Changes) > 0:
+			if _, err = fmt.Fprintf(w, "  ~ %s\n", item.Key); err != nil {
+				return err
+			}
+			for _, change := range item.Changes {
+				if _, err = fmt.Fprintf(w, "      %s %s: %s -> %s\n", change.Status, change.Path, diffJSONPreview(change.A), diffJSONPreview(change.B)); err != nil {
+					return err
+				}
+			}
+		default:
+			_, err = fmt.Fprintf(w, "  ~ %s\n      a: %s\n      b: %s\n", item.Key, diffPreview(item.A), diffPreview(item.B))
+		}
+	}
+	return err
+}
+
+func diffPreview(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if runes := []rune(text); len(runes) > 160 {
+		return string(runes[:160]) + "…"
+	}
+	return text
+}
+
+func diffJSONPreview(value any) string {
+	if value == nil {
+		return "∅"
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return diffPreview(string(raw))
+}
+
+func runCassetteScrub(opts cassetteScrubOptions) int {
+	cfg, err := config.Load(opts.configPath)
+	if err != nil {
+		slog.Error("Failed to load config", "path", opts.configPath, "error", err)
+		return 1
+	}
+	cfg.Redaction.Headers = append(cfg.Redaction.Headers, opts.headers...)
+	cfg.Redaction.JSONPaths = append(cfg.Redaction.JSONPaths, opts.jsonPaths...)
+	for _, raw := range opts.patterns {
+		name, pattern, _ := strings.Cut(raw, "=")
+		cfg.Redaction.Patterns = append(cfg.Redaction.Patterns, config.RedactionPattern{Name: name, Pattern: pattern})
+	}
+	policy, err := recorder.NewRedactionPolicy(cfg)
+	if err != nil {
+		slog.Error("Invalid redaction policy", "error", err)
+		return 1
+	}
+	if !policy.Enabled() {
+		slog.Error("No redaction rules configured; set redaction in config or pass --header/--json-path/--pattern")
+		return 1
+	}
+
+	roots := opts.paths
+	if len(roots) == 0 {
+		roots = []string{cfg.TraceOutputDir()}
+	}
+	files, err := collectCassettes(roots)
+	if err != nil {
+		slog.Error("Failed to list cassettes", "error", err)
+		return 1
+	}
+
+	var (
+		items   []cassetteScrubItem
+		changed int
+		failed  int
+	)
+	for _, path := range files {
+		item := cassetteScrubItem{Path: path}
+		item.Changed, err = scrubCassetteFile(path, policy, opts.dryRun)
+		if err != nil {
+			item.Error = err.Error()
+			failed++
+		} else if item.Changed {
+			changed++
+		}
+		items = append(items, item)
+	}
+
+	output := map[string]any{
+		"dry_run": opts.dryRun,
+		"files":   len(files),
+		"changed": changed,
+		"failed":  failed,
+		"items":   items,
+	}
+	if err := writeCLIResult(stdoutOrDefault(opts.stdout), opts.format, "cassette scrub", output, func(w io.Writer) error {
+		for _, item := range items {
+			switch {
+			case item.Error != "":
+				if _, err := fmt.Fprintf(w, "failed  %s: %s\n", item.Path, item.Error); err != nil {
+					return err
+				}
+			case item.Changed:
+				if _, err := fmt.Fprintf(w, "scrubbed %s\n", item.Path); err != nil {
+					return err
+				}
+			}
+		}
+		verb := "scrubbed"
+		if opts.dryRun {
+			verb = "would scrub"
+		}
+		_, err := fmt.Fprintf(w, "%s %d of %d cassette(s), %d failed\n", verb, changed, len(files), failed)
+		return err
+	}); err != nil {
+		slog.Error("Write command result failed", "error", err)
+		return 1
+	}
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
+
+func collectCassettes(roots []string) ([]string, error) {
+	var files []string
+	for _, root := range roots {
+		info, err := os.Stat(root)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, root)
+			continue
+		}
+		err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && recordfile.IsRecordPath(d.Name()) {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// scrubCassetteFile 按原压缩格式写回；recordfile.WriteFile 先写临时文件再 rename，避免留下半截 cassette。
+func scrubCassetteFile(path string, policy *redact.Policy, dryRun bool) (bool, error) {
+	content, err := recordfile.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+	out, changed, err := policy.Cassette(content)
+	if err != nil || !changed || dryRun {
+		return changed, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+	if err := recordfile.WriteFile(path, out, info.Mode().Perm()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
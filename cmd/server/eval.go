@@ -0,0 +1,503 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/evals"
+	"github.com/kingfs/llm-tracelab/internal/proxy"
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/spf13/cobra"
+)
+
+type evalProfilesOptions struct {
+	configPath string
+	name       string
+	files      []string
+	format     string
+	stdout     io.Writer
+}
+
+func newEvalCommand(runtime *cliRuntime) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "eval",
+		Short:         "Run evaluations and manage evaluator sets",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return requireSubcommand(cmd)
+		},
+	}
+	cmd.AddCommand(newEvalRunCommand(runtime), newEvalProfilesCommand(runtime))
+	return cmd
+}
+
+type evalRunOptions struct {
+	configPath  string
+	datasetID   string
+	profile     string
+	minPassRate float64
+	// minPassRateSet 表示显式指定了 --min-pass-rate；未指定时找到基线后不检查整体通过率。
+	minPassRateSet bool
+	thresholds     []string
+	baseline       string
+	monitorURL     string
+	worst          int
+	junitFile      string
+	markdownFile   string
+	format         string
+	stdout         io.Writer
+}
+
+func newEvalRunCommand(runtime *cliRuntime) *cobra.Command {
+	opts := evalRunOptions{}
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Score a dataset with an evaluator set and fail on threshold violations or regressions",
+		Long: "Score every example of a dataset with an evaluator set, store the eval run and report per-evaluator pass rates " +
+			"and the worst traces. The command exits non-zero when the overall or a per-evaluator pass rate is below its threshold. " +
+			"With --baseline, only checks that did not already fail in the baseline run count as failures, and --min-pass-rate " +
+			"applies only when set explicitly; when `latest` finds no earlier run the default --min-pass-rate of 1 applies. --format junit|markdown writes a JUnit XML or Markdown report to stdout; " +
+			"--junit-file and --markdown-file write them in addition to the selected format.",
+		Args:          cobra.NoArgs,
+		Annotations:   map[string]string{outputFormatsAnnotation: "junit,markdown"},
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if strings.TrimSpace(opts.datasetID) == "" {
+				return cliUsageError("--dataset is required", "dataset")
+			}
+			if opts.minPassRate < 0 || opts.minPassRate > 1 {
+				return cliUsageError("--min-pass-rate must be within [0, 1]", "min-pass-rate")
+			}
+			if _, err := parseEvalThresholds(opts.thresholds); err != nil {
+				return cliUsageError(err.Error(), "threshold")
+			}
+			opts.minPassRateSet = cmd.Flags().Changed("min-pass-rate")
+			opts.configPath = runtime.configPath()
+			opts.format = runtime.outputFormat()
+			opts.stdout = cmd.OutOrStdout()
+			return runCode(func() int {
+				return runEvalRun(opts)
+			})
+		},
+	}
+	cmd.Flags().StringVar(&opts.datasetID, "dataset", "", "Dataset id to score")
+	cmd.Flags().StringVar(&opts.profile, "profile", evals.BaselineEvaluatorSet, "Evaluator set (built-in or custom profile)")
+	cmd.Flags().Float64Var(&opts.minPassRate, "min-pass-rate", 1, "Minimum overall pass rate in [0, 1]")
+	cmd.Flags().StringArrayVar(&opts.thresholds, "threshold", nil, "Minimum pass rate of one evaluator as key=rate, e.g. llm_judge=0.9; repeatable")
+	cmd.Flags().StringVar(&opts.baseline, "baseline", "", "Eval run id to compare with, or `latest` for the previous run of the same dataset and profile; fails only on regressions")
+	cmd.Flags().StringVar(&opts.monitorURL, "monitor-url", "", "Monitor base URL for trace links, default http://localhost:<monitor.port>")
+	cmd.Flags().IntVar(&opts.worst, "worst", 10, "Number of worst traces listed in the report")
+	cmd.Flags().StringVar(&opts.junitFile, "junit-file", "", "Also write a JUnit XML report to this file")
+	cmd.Flags().StringVar(&opts.markdownFile, "markdown-file", "", "Also write a Markdown summary to this file, e.g. $GITHUB_STEP_SUMMARY")
+	return cmd
+}
+
+func newEvalProfilesCommand(runtime *cliRuntime) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "profiles",
+		Short:         "List, import and delete evaluator sets",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return requireSubcommand(cmd)
+		},
+	}
+	options := func(cmd *cobra.Command) evalProfilesOptions {
+		return evalProfilesOptions{
+			configPath: runtime.configPath(),
+			format:     runtime.outputFormat(),
+			stdout:     cmd.OutOrStdout(),
+		}
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:           "list",
+		Short:         "List built-in and custom evaluator sets",
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCode(func() int {
+				return runEvalProfilesList(options(cmd))
+			})
+		},
+	}, &cobra.Command{
+		Use:           "show <name>",
+		Short:         "Show one evaluator set with its assertions",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return cliUsageError("show requires exactly one profile name", "name")
+			}
+			opts := options(cmd)
+			opts.name = args[0]
+			return runCode(func() int {
+				return runEvalProfilesShow(opts)
+			})
+		},
+	}, &cobra.Command{
+		Use:           "import <file>...",
+		Short:         "Import evaluator sets from YAML or JSON files, replacing sets with the same name",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return cliUsageError("import requires at least one file", "file")
+			}
+			opts := options(cmd)
+			opts.files = args
+			return runCode(func() int {
+				return runEvalProfilesImport(opts)
+			})
+		},
+	}, &cobra.Command{
+		Use:           "delete <name>",
+		Short:         "Delete a custom evaluator set",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return cliUsageError("delete requires exactly one profile name", "name")
+			}
+			opts := options(cmd)
+			opts.name = args[0]
+			return runCode(func() int {
+				return runEvalProfilesDelete(opts)
+			})
+		},
+	})
+	return cmd
+}
+
+func runEvalRun(opts evalRunOptions) int {
+	cfg, err := config.Load(opts.configPath)
+	if err != nil {
+		slog.Error("Failed to load config", "path", opts.configPath, "error", err)
+		return 1
+	}
+	traceStore, code := openTraceStore(opts.configPath)
+	if code != 0 {
+		return code
+	}
+	defer traceStore.Close()
+	if code := importConfiguredEvalProfiles(cfg, traceStore); code != 0 {
+		return code
+	}
+	profile, err := evals.ResolveProfile(traceStore, opts.profile)
+	if err != nil {
+		slog.Error("Failed to load eval profile", "profile", opts.profile, "error", err)
+		return 1
+	}
+	dataset, err := traceStore.GetDataset(opts.datasetID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			slog.Error("Dataset not found", "dataset_id", opts.datasetID)
+		} else {
+			slog.Error("Failed to load dataset", "dataset_id", opts.datasetID, "error", err)
+		}
+		return 1
+	}
+	examples, err := traceStore.GetDatasetExamples(dataset.ID)
+	if err != nil {
+		slog.Error("Failed to load dataset examples", "dataset_id", dataset.ID, "error", err)
+		return 1
+	}
+	entries := make([]store.LogEntry, 0, len(examples))
+	expected := map[string]string{}
+	for _, example := range examples {
+		if example.Trace.ID == "" || example.Trace.LogPath == "" {
+			continue
+		}
+		entries = append(entries, example.Trace)
+		if example.ExpectedOutput != "" {
+			expected[example.TraceID] = example.ExpectedOutput
+		}
+	}
+	if len(entries) == 0 {
+		slog.Error("Dataset has no traces to score", "dataset_id", dataset.ID)
+		return 1
+	}
+
+	var judge *evals.Judge
+	if !profile.Deterministic {
+		// 只有 llm_judge 需要经代理访问上游，确定性评估集在 CI 中可完全离线运行。
+		rtr, code := newUpstreamRouter(cfg, traceStore)
+		if code != 0 {
+			return code
+		}
+		defer rtr.Close()
+		handler, err := proxy.NewHandler(cfg, traceStore, rtr)
+		if err != nil {
+			slog.Error("Failed to create proxy handler", "error", err)
+			return 1
+		}
+		defer handler.Close()
+		judge = &evals.Judge{
+			Handler:  handler,
+			Channel:  cfg.Evals.Judge.Channel,
+			Model:    cfg.Evals.Judge.Model,
+			Protocol: evals.JudgeProtocol(rtr.Targets(), cfg.Evals.Judge.Channel, cfg.Evals.Judge.Model),
+		}
+	}
+	run, err := evals.ScoreTraces(traceStore, entries, evals.RunOptions{
+		DatasetID:       dataset.ID,
+		SourceType:      "eval_cli",
+		SourceID:        dataset.ID,
+		EvaluatorSet:    profile.Name,
+		ExpectedOutputs: expected,
+		Judge:           judge,
+	})
+	if err != nil {
+		slog.Error("Eval run failed", "dataset_id", dataset.ID, "error", err)
+		return 1
+	}
+
+	thresholds, _ := parseEvalThresholds(opts.thresholds)
+	reportOpts := evals.ReportOptions{
+		MinPassRate: opts.minPassRate,
+		Thresholds:  thresholds,
+		MonitorURL:  opts.monitorURL,
+		WorstTraces: opts.worst,
+	}
+	if reportOpts.MonitorURL == "" && cfg.Monitor.Port != "" {
+		reportOpts.MonitorURL = "http://localhost:" + cfg.Monitor.Port
+	}
+	switch opts.baseline {
+	case "":
+	case "latest":
+		baseline, err := evals.LatestEvalRun(traceStore, dataset.ID, profile.Name, run.ID)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			// 首次运行没有可对比的历史，按无基线处理：不让 CI 因缺少基线失败，但仍按 --min-pass-rate 检查整体通过率。
+			slog.Warn("No earlier eval run to use as baseline; reporting without one", "dataset_id", dataset.ID, "evaluator_set", profile.Name, "min_pass_rate", opts.minPassRate)
+		case err != nil:
+			slog.Error("Failed to find baseline eval run", "error", err)
+			return 1
+		default:
+			reportOpts.BaselineRunID = baseline.ID
+		}
+	default:
+		reportOpts.BaselineRunID = opts.baseline
+	}
+	if reportOpts.BaselineRunID != "" && !opts.minPassRateSet {
+		reportOpts.MinPassRate = 0
+	}
+	report, err := evals.BuildRunReport(traceStore, run.ID, reportOpts)
+	if err != nil {
+		slog.Error("Failed to build eval report", "eval_run_id", run.ID, "error", err)
+		return 1
+	}
+
+	for _, output := range []struct {
+		path  string
+		write func(io.Writer, evals.Report) error
+	}{{opts.junitFile, evals.WriteJUnit}, {opts.markdownFile, evals.WriteMarkdown}} {
+		if output.path == "" {
+			continue
+		}
+		if err := writeEvalReportFile(output.path, report, output.write); err != nil {
+			slog.Error("Failed to write eval report", "path", output.path, "error", err)
+			return 1
+		}
+	}
+	stdout := stdoutOrDefault(opts.stdout)
+	switch opts.format {
+	case "junit":
+		err = evals.WriteJUnit(stdout, report)
+	case "markdown":
+		err = evals.WriteMarkdown(stdout, report)
+	default:
+		err = writeCLIResult(stdout, opts.format, "eval run", report, func(w io.Writer) error {
+			return writeEvalReportText(w, report)
+		})
+	}
+	if err != nil {
+		slog.Error("Write command result failed", "error", err)
+		return 1
+	}
+	if !report.Passed {
+		slog.Error("Eval run did not pass", "eval_run_id", report.RunID, "violations", strings.Join(report.Violations, "; "))
+		return 1
+	}
+	return 0
+}
+
+// parseEvalThresholds 解析 key=rate 形式的 evaluator 阈值。
+func parseEvalThresholds(values []string) (map[string]float64, error) {
+	out := map[string]float64{}
+	for _, value := range values {
+		key, rate, ok := strings.Cut(value, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("--threshold %q must be key=rate", value)
+		}
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(rate), 64)
+		if err != nil || parsed < 0 || parsed > 1 {
+			return nil, fmt.Errorf("--threshold %q: rate must be a number within [0, 1]", value)
+		}
+		out[key] = parsed
+	}
+	return out, nil
+}
+
+func writeEvalReportFile(path string, report evals.Report, write func(io.Writer, evals.Report) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(file, report); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func writeEvalReportText(w io.Writer, report evals.Report) error {
+	status := "PASS"
+	if !report.Passed {
+		status = "FAIL"
+	}
+	if _, err := fmt.Fprintf(w, "%s eval run %s dataset=%s profile=%s traces=%d checks=%d/%d (%.1f%%)\n", status, report.RunID, report.DatasetID, report.EvaluatorSet, report.TraceCount, report.PassCount, report.ScoreCount, report.PassRate*100); err != nil {
+		return err
+	}
+	if report.Baseline != nil {
+		if _, err := fmt.Fprintf(w, "baseline %s %.1f%% (%+.1f) regressions=%d fixed=%d\n", report.Baseline.RunID, report.Baseline.PassRate*100, report.Baseline.PassRateDelta*100, len(report.Baseline.Regressions), report.Baseline.FixedCount); err != nil {
+			return err
+		}
+	}
+	for _, stat := range report.Evaluators {
+		if _, err := fmt.Fprintf(w, "  %-28s %4d pass %4d fail %6.1f%%\n", stat.EvaluatorKey, stat.PassCount, stat.FailCount, stat.PassRate*100); err != nil {
+			return err
+		}
+	}
+	for _, trace := range report.WorstTraces {
+		if _, err := fmt.Fprintf(w, "  worst %s %s failed=%d %s\n", trace.TraceID, trace.Model, trace.FailCount, trace.URL); err != nil {
+			return err
+		}
+	}
+	for _, violation := range report.Violations {
+		if _, err := fmt.Fprintf(w, "  violation: %s\n", violation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importConfiguredEvalProfiles 把 evals.profile_files 中的评估集导入数据库。
+func importConfiguredEvalProfiles(cfg *config.Config, traceStore *store.Store) int {
+	for _, path := range cfg.Evals.ProfileFiles {
+		imported, err := evals.ImportProfileFile(traceStore, path)
+		if err != nil {
+			slog.Error("Failed to import eval profiles", "path", path, "error", err)
+			return 1
+		}
+		slog.Info("Imported eval profiles", "path", path, "profiles", imported)
+	}
+	return 0
+}
+
+func runEvalProfilesList(opts evalProfilesOptions) int {
+	traceStore, code := openTraceStore(opts.configPath)
+	if code != 0 {
+		return code
+	}
+	defer traceStore.Close()
+	profiles, err := evals.ListAllProfiles(traceStore)
+	if err != nil {
+		slog.Error("Failed to list eval profiles", "error", err)
+		return 1
+	}
+	if err := writeCLIResult(stdoutOrDefault(opts.stdout), opts.format, "eval profiles list", map[string]any{"items": profiles}, func(w io.Writer) error {
+		for _, profile := range profiles {
+			if _, err := fmt.Fprintf(w, "%-24s %-10s %2d check(s)  %s\n", profile.Name, profile.Source, len(profile.EvaluatorKeys), profile.Description); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		slog.Error("Write command result failed", "error", err)
+		return 1
+	}
+	return 0
+}
+
+func runEvalProfilesShow(opts evalProfilesOptions) int {
+	traceStore, code := openTraceStore(opts.configPath)
+	if code != 0 {
+		return code
+	}
+	defer traceStore.Close()
+	profile, err := evals.ResolveProfile(traceStore, opts.name)
+	if err != nil {
+		slog.Error("Failed to load eval profile", "name", opts.name, "error", err)
+		return 1
+	}
+	if err := writeCLIResult(stdoutOrDefault(opts.stdout), opts.format, "eval profiles show", profile, func(w io.Writer) error {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(profile)
+	}); err != nil {
+		slog.Error("Write command result failed", "error", err)
+		return 1
+	}
+	return 0
+}
+
+func runEvalProfilesImport(opts evalProfilesOptions) int {
+	traceStore, code := openTraceStore(opts.configPath)
+	if code != 0 {
+		return code
+	}
+	defer traceStore.Close()
+	total := 0
+	for _, path := range opts.files {
+		imported, err := evals.ImportProfileFile(traceStore, path)
+		if err != nil {
+			slog.Error("Failed to import eval profiles", "path", path, "error", err)
+			return 1
+		}
+		total += imported
+	}
+	if err := writeCLIResult(stdoutOrDefault(opts.stdout), opts.format, "eval profiles import", map[string]any{"files": opts.files, "imported": total}, func(w io.Writer) error {
+		_, err := fmt.Fprintf(w, "imported %d eval profile(s) from %d file(s)\n", total, len(opts.files))
+		return err
+	}); err != nil {
+		slog.Error("Write command result failed", "error", err)
+		return 1
+	}
+	return 0
+}
+
+func runEvalProfilesDelete(opts evalProfilesOptions) int {
+	traceStore, code := openTraceStore(opts.configPath)
+	if code != 0 {
+		return code
+	}
+	defer traceStore.Close()
+	if err := traceStore.DeleteEvalProfile(opts.name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			slog.Error("Eval profile not found", "name", opts.name)
+		} else {
+			slog.Error("Failed to delete eval profile", "name", opts.name, "error", err)
+		}
+		return 1
+	}
+	if err := writeCLIResult(stdoutOrDefault(opts.stdout), opts.format, "eval profiles delete", map[string]any{"name": opts.name, "deleted": true}, func(w io.Writer) error {
+		_, err := fmt.Fprintf(w, "deleted eval profile %s\n", opts.name)
+		return err
+	}); err != nil {
+		slog.Error("Write command result failed", "error", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,304 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/experiments"
+	"github.com/kingfs/llm-tracelab/internal/proxy"
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/spf13/cobra"
+)
+
+type experimentOptions struct {
+	configPath   string
+	id           string
+	datasetID    string
+	channels     []string
+	models       []string
+	evaluatorSet string
+	name         string
+	description  string
+	limit        int
+	format       string
+	stdout       io.Writer
+}
+
+func newExperimentCommand(runtime *cliRuntime) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "experiment",
+		Short:         "Replay datasets against candidate channels and compare evaluation results",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return requireSubcommand(cmd)
+		},
+	}
+	cmd.AddCommand(newExperimentRunCommand(runtime), newExperimentListCommand(runtime), newExperimentReportCommand(runtime))
+	return cmd
+}
+
+func newExperimentRunCommand(runtime *cliRuntime) *cobra.Command {
+	opts := experimentOptions{}
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Replay every dataset example against candidate channels/models and compare with the recorded baseline",
+		Long: "Replay every dataset example through the configured upstreams, record the new traces, score baseline and candidate " +
+			"with the same evaluator set and store a comparison report. Each combination of --channel and --model is one candidate; " +
+			"omit --channel to use normal routing, omit --model to keep the recorded model.",
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if strings.TrimSpace(opts.datasetID) == "" {
+				return cliUsageError("--dataset is required", "dataset")
+			}
+			if len(opts.channels) == 0 && len(opts.models) == 0 {
+				return cliUsageError("at least one --channel or --model is required", "channel")
+			}
+			opts.configPath = runtime.configPath()
+			opts.format = runtime.outputFormat()
+			opts.stdout = cmd.OutOrStdout()
+			return runCode(func() int {
+				return runExperimentRun(opts)
+			})
+		},
+	}
+	cmd.Flags().StringVar(&opts.datasetID, "dataset", "", "Dataset id to replay")
+	cmd.Flags().StringSliceVar(&opts.channels, "channel", nil, "Candidate channel (upstream id); repeatable")
+	cmd.Flags().StringSliceVar(&opts.models, "model", nil, "Candidate model; repeatable")
+	cmd.Flags().StringVar(&opts.evaluatorSet, "evaluator-set", "", "Evaluator set, default baseline_v4")
+	cmd.Flags().StringVar(&opts.name, "name", "", "Experiment name, default `<dataset> vs <candidate>`")
+	cmd.Flags().StringVar(&opts.description, "description", "", "Experiment description")
+	return cmd
+}
+
+func newExperimentListCommand(runtime *cliRuntime) *cobra.Command {
+	opts := experimentOptions{}
+	cmd := &cobra.Command{
+		Use:           "list",
+		Short:         "List experiments with pass-rate deltas",
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.configPath = runtime.configPath()
+			opts.format = runtime.outputFormat()
+			opts.stdout = cmd.OutOrStdout()
+			return runCode(func() int {
+				return runExperimentList(opts)
+			})
+		},
+	}
+	cmd.Flags().IntVar(&opts.limit, "limit", 20, "Maximum experiments to list")
+	return cmd
+}
+
+func newExperimentReportCommand(runtime *cliRuntime) *cobra.Command {
+	return &cobra.Command{
+		Use:           "report <experiment-id>",
+		Short:         "Show the comparison report of one experiment",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return cliUsageError("report requires exactly one experiment id", "experiment-id")
+			}
+			return runCode(func() int {
+				return runExperimentReport(experimentOptions{
+					configPath: runtime.configPath(),
+					id:         args[0],
+					format:     runtime.outputFormat(),
+					stdout:     cmd.OutOrStdout(),
+				})
+			})
+		},
+	}
+}
+
+func runExperimentRun(opts experimentOptions) int {
+	cfg, err := config.Load(opts.configPath)
+	if err != nil {
+		slog.Error("Failed to load config", "path", opts.configPath, "error", err)
+		return 1
+	}
+	traceStore, code := openTraceStore(opts.configPath)
+	if code != 0 {
+		return code
+	}
+	defer traceStore.Close()
+	if code := importConfiguredEvalProfiles(cfg, traceStore); code != 0 {
+		return code
+	}
+	rtr, code := newUpstreamRouter(cfg, traceStore)
+	if code != 0 {
+		return code
+	}
+	defer rtr.Close()
+	handler, err := proxy.NewHandler(cfg, traceStore, rtr)
+	if err != nil {
+		slog.Error("Failed to create proxy handler", "error", err)
+		return 1
+	}
+	defer handler.Close()
+
+	spec := experiments.Spec{
+		DatasetID:    opts.datasetID,
+		Name:         opts.name,
+		Description:  opts.description,
+		EvaluatorSet: opts.evaluatorSet,
+		Candidates:   experimentCandidates(opts.channels, opts.models),
+		User:         os.Getenv("USER"),
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	analysisRunner, err := newAnalysisRunner(cfg)
+	if err != nil {
+		slog.Error("Failed to load detector rules", "error", err)
+		return 1
+	}
+	runner := &experiments.Runner{Store: traceStore, Handler: handler, Pricing: cfg.Experiments.Pricing, Judge: cfg.Evals.Judge, Analyzer: analysisRunner, Router: rtr}
+	reports, err := runner.Run(ctx, spec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			slog.Error("Dataset not found", "dataset_id", opts.datasetID)
+		} else {
+			slog.Error("Experiment failed", "dataset_id", opts.datasetID, "error", err)
+		}
+		return 1
+	}
+	if err := writeCLIResult(stdoutOrDefault(opts.stdout), opts.format, "experiment run", map[string]any{"items": reports}, func(w io.Writer) error {
+		for _, report := range reports {
+			if err := writeExperimentReportText(w, report); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		slog.Error("Write command result failed", "error", err)
+		return 1
+	}
+	return 0
+}
+
+func runExperimentList(opts experimentOptions) int {
+	traceStore, code := openTraceStore(opts.configPath)
+	if code != 0 {
+		return code
+	}
+	defer traceStore.Close()
+	items, err := experiments.ListSummaries(traceStore, opts.limit)
+	if err != nil {
+		slog.Error("Failed to list experiments", "error", err)
+		return 1
+	}
+	if err := writeCLIResult(stdoutOrDefault(opts.stdout), opts.format, "experiment list", map[string]any{"items": items}, func(w io.Writer) error {
+		for _, item := range items {
+			if item.Status != store.ExperimentCompleted {
+				if _, err := fmt.Fprintf(w, "%s %s %s %s\n", item.CreatedAt.Format("2006-01-02 15:04"), item.ID, item.Status, item.Name); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "%s %s pass %.1f%% -> %.1f%% (%+.1f) +%d/-%d %s\n", item.CreatedAt.Format("2006-01-02 15:04"), item.ID, item.BaselinePassRate*100, item.CandidatePassRate*100, item.PassRateDelta*100, item.ImprovementCount, item.RegressionCount, item.Name); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		slog.Error("Write command result failed", "error", err)
+		return 1
+	}
+	return 0
+}
+
+func runExperimentReport(opts experimentOptions) int {
+	cfg, err := config.Load(opts.configPath)
+	if err != nil {
+		slog.Error("Failed to load config", "path", opts.configPath, "error", err)
+		return 1
+	}
+	traceStore, code := openTraceStore(opts.configPath)
+	if code != 0 {
+		return code
+	}
+	defer traceStore.Close()
+	report, err := experiments.BuildReport(traceStore, opts.id, cfg.Experiments.Pricing)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			slog.Error("Experiment not found", "experiment_id", opts.id)
+		} else {
+			slog.Error("Failed to build experiment report", "experiment_id", opts.id, "error", err)
+		}
+		return 1
+	}
+	if err := writeCLIResult(stdoutOrDefault(opts.stdout), opts.format, "experiment report", report, func(w io.Writer) error {
+		return writeExperimentReportText(w, report)
+	}); err != nil {
+		slog.Error("Write command result failed", "error", err)
+		return 1
+	}
+	return 0
+}
+
+// experimentCandidates 展开 channel 与 model 的组合；只给出一侧时另一侧留空。
+func experimentCandidates(channels []string, models []string) []experiments.Candidate {
+	if len(channels) == 0 {
+		channels = []string{""}
+	}
+	if len(models) == 0 {
+		models = []string{""}
+	}
+	out := make([]experiments.Candidate, 0, len(channels)*len(models))
+	for _, channel := range channels {
+		for _, model := range models {
+			out = append(out, experiments.Candidate{Channel: channel, Model: model})
+		}
+	}
+	return out
+}
+
+func writeExperimentReportText(w io.Writer, report experiments.Report) error {
+	cost := func(side experiments.Side) string {
+		if side.Cost == nil {
+			return "-"
+		}
+		return fmt.Sprintf("%.4f", *side.Cost)
+	}
+	if report.Status != store.ExperimentCompleted {
+		line := fmt.Sprintf("experiment %s  %s  %s", report.ID, report.Name, report.Status)
+		if report.Error != "" {
+			line += ": " + report.Error
+		}
+		_, err := fmt.Fprintln(w, line)
+		return err
+	}
+	lines := []string{
+		fmt.Sprintf("experiment %s  %s", report.ID, report.Name),
+		fmt.Sprintf("dataset %s  evaluator_set %s  target %s", report.DatasetID, report.EvaluatorSet, report.Target.Label()),
+		fmt.Sprintf("%-10s %8s %8s %12s %10s %10s %10s", "", "pass", "errors", "avg_latency", "avg_ttft", "tokens", "cost"),
+	}
+	for _, row := range []struct {
+		name string
+		side experiments.Side
+	}{{"baseline", report.Baseline}, {"candidate", report.Candidate}} {
+		lines = append(lines, fmt.Sprintf("%-10s %7.1f%% %8d %10.0fms %8.0fms %10d %10s", row.name, row.side.PassRate*100, row.side.ErrorCount, row.side.AvgDurationMS, row.side.AvgTTFTMS, row.side.TotalTokens, cost(row.side)))
+	}
+	lines = append(lines, fmt.Sprintf("delta: pass %+.1f%%, latency %+.0fms, tokens %+d; %d improved, %d regressed score(s)", report.Delta.PassRate*100, report.Delta.AvgDurationMS, report.Delta.TotalTokens, report.ImprovementCount, report.RegressionCount))
+	for _, example := range report.Examples {
+		if example.Outcome == experiments.OutcomeUnchanged {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("  %-9s %s -> %s", example.Outcome, example.TraceID, example.CandidateTraceID))
+	}
+	_, err := fmt.Fprintln(w, strings.Join(lines, "\n"))
+	return err
+}
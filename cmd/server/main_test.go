@@ -10,6 +10,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -17,8 +18,10 @@ import (
 	"github.com/kingfs/llm-tracelab/internal/auth"
 	"github.com/kingfs/llm-tracelab/internal/channel"
 	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/proxy"
 	"github.com/kingfs/llm-tracelab/internal/store"
 	"github.com/kingfs/llm-tracelab/internal/upstream"
+	"github.com/kingfs/llm-tracelab/pkg/observe"
 	"github.com/kingfs/llm-tracelab/pkg/recordfile"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	_ "modernc.org/sqlite"
@@ -164,7 +167,7 @@ func TestRootCommandRegistersBaseCommands(t *testing.T) {
 	t.Parallel()
 
 	cmd := newRootCommand()
-	for _, want := range []string{"serve", "migrate", "db", "db secret", "db secret status", "db secret export", "db secret rotate", "auth", "analyze", "analyze repair-usage", "analyze reanalyze", "version", "schema", "completion"} {
+	for _, want := range []string{"serve", "migrate", "db", "db secret", "db secret status", "db secret export", "db secret rotate", "auth", "analyze", "analyze repair-usage", "analyze reanalyze", "analyze rules", "analyze rules test", "cassette", "cassette scrub", "cassette diff", "prune", "traces", "traces query", "traces saved", "traces saved list", "traces saved delete", "experiment", "experiment run", "experiment list", "experiment report", "eval", "eval run", "eval profiles", "eval profiles list", "eval profiles show", "eval profiles import", "eval profiles delete", "version", "schema", "completion"} {
 		parts := strings.Fields(want)
 		found, _, err := cmd.Find(parts)
 		if err != nil || found.CommandPath() != cliName+" "+want {
@@ -747,6 +750,66 @@ debug:
 	if code != 0 {
 		t.Fatalf("runAnalyzeReanalyze(session) = %d, want 0", code)
 	}
+
+	rulesDir := filepath.Join(dir, "rules")
+	if err := os.MkdirAll(rulesDir, 0o755); err != nil {
+		t.Fatalf("MkdirAll(rules) error = %v", err)
+	}
+	rulePath := filepath.Join(rulesDir, "greetings.yaml")
+	if err := os.WriteFile(rulePath, []byte(strings.TrimSpace(`
+rules:
+  - id: greeting
+    category: custom_greeting
+    severity: low
+    title: "Greeting from {role}"
+    match:
+      roles: [user]
+      keywords: [hello]
+`)), 0o644); err != nil {
+		t.Fatalf("WriteFile(rule) error = %v", err)
+	}
+	out.Reset()
+	code = runAnalyzeRulesTest(analyzeRulesTestOptions{
+		configPath: configPath,
+		rulePath:   rulePath,
+		traceID:    traceID,
+		format:     "json",
+		stdout:     &out,
+	})
+	if code != 0 {
+		t.Fatalf("runAnalyzeRulesTest() = %d, want 0", code)
+	}
+	var rulesEnvelope struct {
+		OK     bool `json:"ok"`
+		Result struct {
+			Rules    int               `json:"rules"`
+			Findings []observe.Finding `json:"findings"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &rulesEnvelope); err != nil {
+		t.Fatalf("json.Unmarshal(rules test) error = %v, output=%q", err, out.String())
+	}
+	if !rulesEnvelope.OK || rulesEnvelope.Result.Rules != 1 || len(rulesEnvelope.Result.Findings) == 0 {
+		t.Fatalf("rules test envelope = %+v", rulesEnvelope)
+	}
+	if got := rulesEnvelope.Result.Findings[0]; got.Detector != "rules.greetings" || got.Title != "Greeting from user" || !strings.HasPrefix(got.DetectorVersion, "sha256:") {
+		t.Fatalf("rule finding = %+v", got)
+	}
+	if saved, err := st.ListFindings(traceID, store.FindingFilter{Category: "custom_greeting"}); err != nil || len(saved) != 0 {
+		t.Fatalf("rules test saved findings = %+v, %v", saved, err)
+	}
+
+	// 配置 analysis.rules_dir 后，scan 会把规则 finding 与内置检测器的结果一起写入。
+	if err := os.WriteFile(configPath, append(configBody, []byte("\nanalysis:\n  rules_dir: \""+rulesDir+"\"\n")...), 0o644); err != nil {
+		t.Fatalf("WriteFile(config with rules) error = %v", err)
+	}
+	out.Reset()
+	if code := runAnalyzeScan(analyzeScanOptions{configPath: configPath, traceID: traceID, format: "json", stdout: &out}); code != 0 {
+		t.Fatalf("runAnalyzeScan(rules) = %d, want 0", code)
+	}
+	if saved, err := st.ListFindings(traceID, store.FindingFilter{Category: "custom_greeting"}); err != nil || len(saved) == 0 {
+		t.Fatalf("scan with rules findings = %+v, %v", saved, err)
+	}
 }
 
 func TestAnalyzeCommandsEndToEnd(t *testing.T) {
@@ -1140,7 +1203,7 @@ func TestNewManagementMuxServesStreamableMCP(t *testing.T) {
 	cfg.MCP.Enabled = true
 	cfg.MCP.Path = "/mcp"
 
-	httpServer := httptest.NewServer(newManagementMux(st, nil, cfg))
+	httpServer := httptest.NewServer(newManagementMux(st, nil, cfg, nil, nil))
 	defer httpServer.Close()
 
 	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "v0.0.1"}, nil)
@@ -1156,8 +1219,56 @@ func TestNewManagementMuxServesStreamableMCP(t *testing.T) {
 	if err != nil {
 		t.Fatalf("session.ListTools() error = %v", err)
 	}
-	if len(tools.Tools) != 17 {
-		t.Fatalf("len(tools.Tools) = %d, want 17", len(tools.Tools))
+	if len(tools.Tools) != 25 {
+		t.Fatalf("len(tools.Tools) = %d, want 25", len(tools.Tools))
+	}
+}
+
+func TestNewManagementMuxServesProxyMetrics(t *testing.T) {
+	t.Parallel()
+
+	outputDir := t.TempDir()
+	st, err := store.New(outputDir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	upstreamServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"chatcmpl-1","object":"chat.completion","choices":[{"index":0,"message":{"role":"assistant","content":"ok"},"finish_reason":"stop"}],"usage":{"prompt_tokens":4,"completion_tokens":1,"total_tokens":5}}`))
+	}))
+	defer upstreamServer.Close()
+
+	cfg := &config.Config{}
+	cfg.Monitor.Port = "8081"
+	cfg.Upstream.BaseURL = upstreamServer.URL + "/v1"
+	cfg.Debug.OutputDir = outputDir
+	handler, err := proxy.NewHandler(cfg, st)
+	if err != nil {
+		t.Fatalf("proxy.NewHandler() error = %v", err)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/chat/completions", strings.NewReader(`{"model":"gpt-4o-mini","messages":[{"role":"user","content":"hi"}]}`)))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("proxy status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+
+	httpServer := httptest.NewServer(newManagementMux(st, nil, cfg, handler, nil))
+	defer httpServer.Close()
+	resp, err := http.Get(httpServer.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("GET /metrics error = %v", err)
+	}
+	defer resp.Body.Close()
+	var body bytes.Buffer
+	_, _ = body.ReadFrom(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("/metrics status = %d", resp.StatusCode)
+	}
+	if !strings.Contains(body.String(), `llm_tracelab_requests_total{model="gpt-4o-mini",provider="openai_compatible",`) ||
+		!strings.Contains(body.String(), `type="prompt"} 4`) {
+		t.Fatalf("metrics output missing proxied request:\n%s", body.String())
 	}
 }
 
@@ -1178,7 +1289,7 @@ func TestNewManagementMuxRejectsUnauthorizedMCP(t *testing.T) {
 	authStore := newTestAuthStore(t)
 	defer authStore.Close()
 
-	httpServer := httptest.NewServer(newManagementMux(st, nil, cfg, authStore))
+	httpServer := httptest.NewServer(newManagementMux(st, nil, cfg, nil, nil, authStore))
 	defer httpServer.Close()
 
 	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "v0.0.1"}, nil)
@@ -1214,7 +1325,7 @@ func TestNewManagementMuxServesAuthorizedStreamableMCP(t *testing.T) {
 		t.Fatalf("CreateToken() error = %v", err)
 	}
 
-	httpServer := httptest.NewServer(newManagementMux(st, nil, cfg, authStore))
+	httpServer := httptest.NewServer(newManagementMux(st, nil, cfg, nil, nil, authStore))
 	defer httpServer.Close()
 
 	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "v0.0.1"}, nil)
@@ -1231,8 +1342,8 @@ func TestNewManagementMuxServesAuthorizedStreamableMCP(t *testing.T) {
 	if err != nil {
 		t.Fatalf("session.ListTools() error = %v", err)
 	}
-	if len(tools.Tools) != 17 {
-		t.Fatalf("len(tools.Tools) = %d, want 17", len(tools.Tools))
+	if len(tools.Tools) != 25 {
+		t.Fatalf("len(tools.Tools) = %d, want 25", len(tools.Tools))
 	}
 }
 
@@ -1263,3 +1374,468 @@ func (t authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	clone.Header.Set("Authorization", "Bearer "+t.Token)
 	return http.DefaultTransport.RoundTrip(clone)
 }
+
+func TestRunCassetteScrubRewritesCassettes(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yaml")
+	configBody := `
+trace:
+  output_dir: "` + dir + `"
+redaction:
+  replacement: mask
+  patterns:
+    - name: email
+`
+	if err := os.WriteFile(configPath, []byte(configBody), 0o644); err != nil {
+		t.Fatalf("WriteFile(config) error = %v", err)
+	}
+
+	reqHead := "POST /v1/responses HTTP/1.1\r\nHost: example.com\r\nX-Api-Key: secret-key\r\n\r\n"
+	reqBody := `{"model":"gpt-5.1","input":"contact carol@example.com"}`
+	resHead := "HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n"
+	resBody := `{"output":"ok"}`
+	header := recordfile.RecordHeader{
+		Version: "LLM_PROXY_V3",
+		Meta:    recordfile.MetaData{RequestID: "req-scrub", Time: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), URL: "/v1/responses", Method: "POST", StatusCode: 200},
+		Layout: recordfile.LayoutInfo{
+			ReqHeaderLen: int64(len(reqHead)),
+			ReqBodyLen:   int64(len(reqBody)),
+			ResHeaderLen: int64(len(resHead)),
+			ResBodyLen:   int64(len(resBody)),
+		},
+	}
+	prelude, err := recordfile.MarshalPrelude(header, recordfile.BuildEvents(header))
+	if err != nil {
+		t.Fatalf("MarshalPrelude() error = %v", err)
+	}
+	cassettePath := filepath.Join(dir, "nested", "trace.http")
+	if err := os.MkdirAll(filepath.Dir(cassettePath), 0o755); err != nil {
+		t.Fatalf("MkdirAll() error = %v", err)
+	}
+	original := append(prelude, []byte(reqHead+reqBody+"\n"+resHead+resBody)...)
+	if err := os.WriteFile(cassettePath, original, 0o644); err != nil {
+		t.Fatalf("WriteFile(cassette) error = %v", err)
+	}
+
+	var out bytes.Buffer
+	if code := runCassetteScrub(cassetteScrubOptions{configPath: configPath, dryRun: true, format: "json", stdout: &out}); code != 0 {
+		t.Fatalf("runCassetteScrub(dry-run) = %d, output=%s", code, out.String())
+	}
+	if content, _ := os.ReadFile(cassettePath); !bytes.Equal(content, original) {
+		t.Fatalf("dry-run rewrote cassette")
+	}
+	var envelope struct {
+		Result struct {
+			Files   int `json:"files"`
+			Changed int `json:"changed"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &envelope); err != nil || envelope.Result.Files != 1 || envelope.Result.Changed != 1 {
+		t.Fatalf("dry-run output = %s, err = %v", out.String(), err)
+	}
+
+	out.Reset()
+	if code := runCassetteScrub(cassetteScrubOptions{configPath: configPath, paths: []string{cassettePath}, headers: []string{"x-api-key"}, stdout: &out}); code != 0 {
+		t.Fatalf("runCassetteScrub() = %d, output=%s", code, out.String())
+	}
+	content, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if strings.Contains(string(content), "carol@example.com") || strings.Contains(string(content), "secret-key") {
+		t.Fatalf("scrubbed cassette still contains secrets:\n%s", content)
+	}
+	parsed, err := recordfile.ParsePrelude(content)
+	if err != nil {
+		t.Fatalf("ParsePrelude() error = %v", err)
+	}
+	_, gotReqBody, _, gotResBody := recordfile.ExtractSections(content, parsed)
+	if string(gotResBody) != resBody || int64(len(gotReqBody)) != parsed.Header.Layout.ReqBodyLen {
+		t.Fatalf("layout = %+v, req body = %q, res body = %q", parsed.Header.Layout, gotReqBody, gotResBody)
+	}
+}
+
+func TestRunCassetteDiffReportsSemanticChanges(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeCassette := func(name string, input string, outputText string, outputTokens int) string {
+		t.Helper()
+		reqHead := "POST /v1/responses HTTP/1.1\r\nHost: example.com\r\n\r\n"
+		reqBody := `{"model":"gpt-5.1","instructions":"Answer in one word.","input":"` + input + `"}`
+		resHead := "HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n"
+		resBody := `{"id":"resp_1","object":"response","status":"completed","model":"gpt-5.1","output":[{"type":"message","role":"assistant","content":[{"type":"output_text","text":"` + outputText + `"}]}],"usage":{"input_tokens":5,"output_tokens":` + strconv.Itoa(outputTokens) + `,"total_tokens":` + strconv.Itoa(5+outputTokens) + `}}`
+		header := recordfile.RecordHeader{
+			Version: "LLM_PROXY_V3",
+			Meta: recordfile.MetaData{
+				RequestID:  name,
+				Time:       time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+				URL:        "/v1/responses",
+				Method:     "POST",
+				StatusCode: 200,
+				Provider:   "openai_compatible",
+				Operation:  "responses",
+				Endpoint:   "/v1/responses",
+				Model:      "gpt-5.1",
+			},
+			Layout: recordfile.LayoutInfo{
+				ReqHeaderLen: int64(len(reqHead)),
+				ReqBodyLen:   int64(len(reqBody)),
+				ResHeaderLen: int64(len(resHead)),
+				ResBodyLen:   int64(len(resBody)),
+			},
+		}
+		prelude, err := recordfile.MarshalPrelude(header, recordfile.BuildEvents(header))
+		if err != nil {
+			t.Fatalf("MarshalPrelude() error = %v", err)
+		}
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, append(prelude, []byte(reqHead+reqBody+"\n"+resHead+resBody)...), 0o644); err != nil {
+			t.Fatalf("WriteFile(cassette) error = %v", err)
+		}
+		return path
+	}
+	pathA := writeCassette("a.http", "capital of France?", "Paris", 1)
+	pathB := writeCassette("b.http", "capital of Italy?", "Rome", 2)
+
+	var out bytes.Buffer
+	if code := runCassetteDiff(cassetteDiffOptions{pathA: pathA, pathB: pathB, format: "json", stdout: &out}); code != 0 {
+		t.Fatalf("runCassetteDiff(json) = %d, output=%s", code, out.String())
+	}
+	var envelope struct {
+		Result observe.TraceDiff `json:"result"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &envelope); err != nil || envelope.Result.Identical {
+		t.Fatalf("diff output = %s, err = %v", out.String(), err)
+	}
+	changed := map[string]bool{}
+	for _, section := range envelope.Result.Sections {
+		changed[section.Name] = section.Changed
+	}
+	if changed[observe.DiffSectionInstructions] || !changed[observe.DiffSectionMessages] || !changed[observe.DiffSectionOutputs] || !changed[observe.DiffSectionUsage] {
+		t.Fatalf("changed sections = %+v", changed)
+	}
+
+	out.Reset()
+	if code := runCassetteDiff(cassetteDiffOptions{pathA: pathA, pathB: pathB, stdout: &out}); code != 0 {
+		t.Fatalf("runCassetteDiff(text) = %d, output=%s", code, out.String())
+	}
+	for _, want := range []string{"== instructions: unchanged", "== outputs", "Paris", "Rome", "~ output_tokens: 1 -> 2 (+1)", "traces differ"} {
+		if !strings.Contains(out.String(), want) {
+			t.Fatalf("text output missing %q:\n%s", want, out.String())
+		}
+	}
+
+	out.Reset()
+	if code := runCassetteDiff(cassetteDiffOptions{pathA: pathA, pathB: pathA, stdout: &out}); code != 0 || !strings.Contains(out.String(), "semantically identical") {
+		t.Fatalf("runCassetteDiff(same) = %d, output=%s", code, out.String())
+	}
+}
+
+func TestRunPruneDeletesExpiredTraces(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yaml")
+	configBody := `
+trace:
+  output_dir: "` + dir + `"
+database:
+  dsn: "` + filepath.Join(dir, "trace_index.sqlite3") + `"
+retention:
+  max_age_days: 30
+`
+	if err := os.WriteFile(configPath, []byte(configBody), 0o644); err != nil {
+		t.Fatalf("WriteFile(config) error = %v", err)
+	}
+
+	writeCassette := func(name string, recordedAt time.Time) string {
+		t.Helper()
+		reqHead := "POST /v1/responses HTTP/1.1\r\nHost: example.com\r\n\r\n"
+		reqBody := `{"model":"gpt-5.1","input":"hi"}`
+		resHead := "HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n"
+		resBody := `{"output":"ok"}`
+		header := recordfile.RecordHeader{
+			Version: "LLM_PROXY_V3",
+			Meta:    recordfile.MetaData{RequestID: name, Time: recordedAt, Model: "gpt-5.1", URL: "/v1/responses", Method: "POST", StatusCode: 200},
+			Layout: recordfile.LayoutInfo{
+				ReqHeaderLen: int64(len(reqHead)),
+				ReqBodyLen:   int64(len(reqBody)),
+				ResHeaderLen: int64(len(resHead)),
+				ResBodyLen:   int64(len(resBody)),
+			},
+		}
+		prelude, err := recordfile.MarshalPrelude(header, recordfile.BuildEvents(header))
+		if err != nil {
+			t.Fatalf("MarshalPrelude() error = %v", err)
+		}
+		path := filepath.Join(dir, name+".http")
+		if err := os.WriteFile(path, append(prelude, []byte(reqHead+reqBody+"\n"+resHead+resBody)...), 0o644); err != nil {
+			t.Fatalf("WriteFile(cassette) error = %v", err)
+		}
+		return path
+	}
+	oldPath := writeCassette("req-old", time.Now().Add(-60*24*time.Hour))
+	newPath := writeCassette("req-new", time.Now().Add(-time.Hour))
+
+	type pruneEnvelope struct {
+		Result struct {
+			Traces         int   `json:"traces"`
+			Deleted        int   `json:"deleted"`
+			ReclaimedBytes int64 `json:"reclaimed_bytes"`
+			Candidates     []struct {
+				Path string `json:"path"`
+			} `json:"candidates"`
+		} `json:"result"`
+	}
+
+	var out bytes.Buffer
+	if code := runPrune(pruneOptions{configPath: configPath, dryRun: true, format: "json", stdout: &out}); code != 0 {
+		t.Fatalf("runPrune(dry-run) = %d, output=%s", code, out.String())
+	}
+	var envelope pruneEnvelope
+	if err := json.Unmarshal(out.Bytes(), &envelope); err != nil {
+		t.Fatalf("Unmarshal() error = %v, output=%s", err, out.String())
+	}
+	if envelope.Result.Traces != 2 || len(envelope.Result.Candidates) != 1 || envelope.Result.Candidates[0].Path != oldPath || envelope.Result.ReclaimedBytes <= 0 {
+		t.Fatalf("dry-run output = %s", out.String())
+	}
+	if _, err := os.Stat(oldPath); err != nil {
+		t.Fatalf("dry-run removed cassette: %v", err)
+	}
+
+	out.Reset()
+	if code := runPrune(pruneOptions{configPath: configPath, format: "json", stdout: &out}); code != 0 {
+		t.Fatalf("runPrune() = %d, output=%s", code, out.String())
+	}
+	envelope = pruneEnvelope{}
+	if err := json.Unmarshal(out.Bytes(), &envelope); err != nil || envelope.Result.Deleted != 1 {
+		t.Fatalf("prune output = %s, err = %v", out.String(), err)
+	}
+	if _, err := os.Stat(oldPath); !os.IsNotExist(err) {
+		t.Fatalf("expired cassette still exists: %v", err)
+	}
+	if _, err := os.Stat(newPath); err != nil {
+		t.Fatalf("recent cassette removed: %v", err)
+	}
+
+	out.Reset()
+	disabled := 0
+	if code := runPrune(pruneOptions{configPath: configPath, maxAgeDays: &disabled, dryRun: true, format: "json", stdout: &out}); code != 0 {
+		t.Fatalf("runPrune(override) = %d, output=%s", code, out.String())
+	}
+	envelope = pruneEnvelope{}
+	if err := json.Unmarshal(out.Bytes(), &envelope); err != nil || envelope.Result.Traces != 1 || len(envelope.Result.Candidates) != 0 {
+		t.Fatalf("override output = %s, err = %v", out.String(), err)
+	}
+}
+
+func TestRunTracesQueryFiltersAndSavesQueries(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yaml")
+	configBody := `
+trace:
+  output_dir: "` + dir + `"
+database:
+  dsn: "` + filepath.Join(dir, "trace_index.sqlite3") + `"
+`
+	if err := os.WriteFile(configPath, []byte(configBody), 0o644); err != nil {
+		t.Fatalf("WriteFile(config) error = %v", err)
+	}
+	writeCassette := func(name string, model string, status int) {
+		t.Helper()
+		reqHead := "POST /v1/responses HTTP/1.1\r\nHost: example.com\r\n\r\n"
+		reqBody := `{"model":"` + model + `","input":"hi"}`
+		resHead := "HTTP/1.1 " + strconv.Itoa(status) + " OK\r\nContent-Type: application/json\r\n\r\n"
+		resBody := `{"output":"ok"}`
+		header := recordfile.RecordHeader{
+			Version: "LLM_PROXY_V3",
+			Meta:    recordfile.MetaData{RequestID: name, Time: time.Now().Add(-time.Hour), Model: model, URL: "/v1/responses", Method: "POST", StatusCode: status},
+			Layout: recordfile.LayoutInfo{
+				ReqHeaderLen: int64(len(reqHead)),
+				ReqBodyLen:   int64(len(reqBody)),
+				ResHeaderLen: int64(len(resHead)),
+				ResBodyLen:   int64(len(resBody)),
+			},
+		}
+		prelude, err := recordfile.MarshalPrelude(header, recordfile.BuildEvents(header))
+		if err != nil {
+			t.Fatalf("MarshalPrelude() error = %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name+".http"), append(prelude, []byte(reqHead+reqBody+"\n"+resHead+resBody)...), 0o644); err != nil {
+			t.Fatalf("WriteFile(cassette) error = %v", err)
+		}
+	}
+	writeCassette("req-ok", "gpt-5.1", 200)
+	writeCassette("req-failed", "gpt-5.1-mini", 500)
+	writeCassette("req-other", "claude-sonnet", 500)
+
+	type queryEnvelope struct {
+		Result struct {
+			Total int `json:"total"`
+			Items []struct {
+				Model      string `json:"model"`
+				StatusCode int    `json:"status_code"`
+			} `json:"items"`
+		} `json:"result"`
+	}
+
+	var out bytes.Buffer
+	if code := runTracesQuery(tracesQueryOptions{configPath: configPath, expression: "model:gpt-5* status:error", save: "gpt-failures", page: 1, pageSize: 50, format: "json", stdout: &out}); code != 0 {
+		t.Fatalf("runTracesQuery() = %d, output=%s", code, out.String())
+	}
+	var envelope queryEnvelope
+	if err := json.Unmarshal(out.Bytes(), &envelope); err != nil {
+		t.Fatalf("Unmarshal() error = %v, output=%s", err, out.String())
+	}
+	if envelope.Result.Total != 1 || envelope.Result.Items[0].Model != "gpt-5.1-mini" || envelope.Result.Items[0].StatusCode != 500 {
+		t.Fatalf("query output = %s", out.String())
+	}
+
+	out.Reset()
+	if code := runTracesQuery(tracesQueryOptions{configPath: configPath, saved: "gpt-failures", page: 1, pageSize: 50, format: "json", stdout: &out}); code != 0 {
+		t.Fatalf("runTracesQuery(saved) = %d, output=%s", code, out.String())
+	}
+	envelope = queryEnvelope{}
+	if err := json.Unmarshal(out.Bytes(), &envelope); err != nil || envelope.Result.Total != 1 {
+		t.Fatalf("saved query output = %s, err = %v", out.String(), err)
+	}
+
+	out.Reset()
+	if code := runTracesQuery(tracesQueryOptions{configPath: configPath, expression: "latency>soon", page: 1, pageSize: 50, format: "json", stdout: &out}); code == 0 {
+		t.Fatalf("runTracesQuery(invalid) = 0, want failure")
+	}
+
+	out.Reset()
+	if code := runTracesSavedList(tracesSavedOptions{configPath: configPath, stdout: &out}); code != 0 || !strings.Contains(out.String(), "gpt-failures") {
+		t.Fatalf("runTracesSavedList() = %d, output=%s", code, out.String())
+	}
+	if code := runTracesSavedDelete(tracesSavedOptions{configPath: configPath, name: "gpt-failures", stdout: &out}); code != 0 {
+		t.Fatalf("runTracesSavedDelete() = %d", code)
+	}
+	if code := runTracesSavedDelete(tracesSavedOptions{configPath: configPath, name: "gpt-failures", stdout: &out}); code == 0 {
+		t.Fatalf("runTracesSavedDelete(missing) = 0, want failure")
+	}
+}
+
+func TestRunEvalRunFailsOnThresholdsAndPassesWithoutRegressions(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yaml")
+	configBody := `
+monitor:
+  port: "8099"
+trace:
+  output_dir: "` + dir + `"
+database:
+  dsn: "` + filepath.Join(dir, "trace_index.sqlite3") + `"
+`
+	if err := os.WriteFile(configPath, []byte(configBody), 0o644); err != nil {
+		t.Fatalf("WriteFile(config) error = %v", err)
+	}
+	for _, item := range []struct {
+		name   string
+		status int
+	}{{"req-ok", 200}, {"req-failed", 500}} {
+		reqHead := "POST /v1/responses HTTP/1.1\r\nHost: example.com\r\n\r\n"
+		reqBody := `{"model":"gpt-5.1","input":"hi"}`
+		resHead := "HTTP/1.1 " + strconv.Itoa(item.status) + " OK\r\nContent-Type: application/json\r\n\r\n"
+		resBody := `{"output":"ok"}`
+		header := recordfile.RecordHeader{
+			Version: "LLM_PROXY_V3",
+			Meta:    recordfile.MetaData{RequestID: item.name, Time: time.Now().Add(-time.Hour), Model: "gpt-5.1", URL: "/v1/responses", Method: "POST", StatusCode: item.status},
+			Layout: recordfile.LayoutInfo{
+				ReqHeaderLen: int64(len(reqHead)),
+				ReqBodyLen:   int64(len(reqBody)),
+				ResHeaderLen: int64(len(resHead)),
+				ResBodyLen:   int64(len(resBody)),
+			},
+		}
+		prelude, err := recordfile.MarshalPrelude(header, recordfile.BuildEvents(header))
+		if err != nil {
+			t.Fatalf("MarshalPrelude() error = %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, item.name+".http"), append(prelude, []byte(reqHead+reqBody+"\n"+resHead+resBody)...), 0o644); err != nil {
+			t.Fatalf("WriteFile(cassette) error = %v", err)
+		}
+	}
+	traceStore, code := openTraceStore(configPath)
+	if code != 0 {
+		t.Fatalf("openTraceStore() = %d", code)
+	}
+	if err := traceStore.Sync(); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	dataset, err := traceStore.CreateDataset("ci", "")
+	if err != nil {
+		t.Fatalf("CreateDataset() error = %v", err)
+	}
+	var traceIDs []string
+	for _, requestID := range []string{"req-ok", "req-failed"} {
+		entry, err := traceStore.GetByRequestID(requestID)
+		if err != nil {
+			t.Fatalf("GetByRequestID(%s) error = %v", requestID, err)
+		}
+		traceIDs = append(traceIDs, entry.ID)
+	}
+	if _, _, err := traceStore.AppendDatasetExamples(dataset.ID, traceIDs, "manual", "", ""); err != nil {
+		t.Fatalf("AppendDatasetExamples() error = %v", err)
+	}
+	traceStore.Close()
+
+	junitPath := filepath.Join(dir, "eval.xml")
+	var out bytes.Buffer
+	if code := runEvalRun(evalRunOptions{configPath: configPath, datasetID: dataset.ID, profile: "baseline_v1", minPassRate: 1, junitFile: junitPath, format: "markdown", stdout: &out}); code == 0 {
+		t.Fatalf("runEvalRun() = 0 with a failing trace, want failure")
+	}
+	if !strings.Contains(out.String(), "❌ failed") || !strings.Contains(out.String(), "http://localhost:8099/traces/"+traceIDs[1]) {
+		t.Fatalf("markdown output = %s", out.String())
+	}
+	junit, err := os.ReadFile(junitPath)
+	if err != nil || !strings.Contains(string(junit), `<testsuites name="llm-tracelab eval baseline_v1" tests="6" failures="1">`) {
+		t.Fatalf("junit file = %s, err = %v", junit, err)
+	}
+
+	out.Reset()
+	if code := runEvalRun(evalRunOptions{configPath: configPath, datasetID: dataset.ID, profile: "baseline_v1", minPassRate: 1, baseline: "latest", format: "json", stdout: &out}); code != 0 {
+		t.Fatalf("runEvalRun(baseline latest) = %d, output=%s", code, out.String())
+	}
+	var envelope struct {
+		Result struct {
+			Passed   bool `json:"passed"`
+			Baseline struct {
+				Regressions []any `json:"regressions"`
+			} `json:"baseline"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &envelope); err != nil || !envelope.Result.Passed || len(envelope.Result.Baseline.Regressions) != 0 {
+		t.Fatalf("baseline output = %s, err = %v", out.String(), err)
+	}
+
+	// 该评估集首次运行没有更早的 eval run，--baseline latest 视为无基线，仍按默认的 --min-pass-rate 1 检查，失败的 trace 使其退出非零。
+	out.Reset()
+	if code := runEvalRun(evalRunOptions{configPath: configPath, datasetID: dataset.ID, profile: "baseline_v2", minPassRate: 1, baseline: "latest", format: "json", stdout: &out}); code == 0 {
+		t.Fatalf("runEvalRun(first run, baseline latest) = 0 with a failing trace, want the default min pass rate to apply; output=%s", out.String())
+	}
+	var firstRun struct {
+		Result struct {
+			Baseline *json.RawMessage `json:"baseline"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &firstRun); err != nil || firstRun.Result.Baseline != nil {
+		t.Fatalf("first run output = %s, err = %v", out.String(), err)
+	}
+
+	cmd := newRootCommand()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"traces", "query", "--format", "junit", "-c", configPath})
+	if err := cmd.Execute(); err == nil {
+		t.Fatalf("traces query --format junit error = nil, want invalid format")
+	}
+}
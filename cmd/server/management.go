@@ -6,10 +6,13 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/kingfs/llm-tracelab/internal/analyzer"
 	"github.com/kingfs/llm-tracelab/internal/auth"
 	"github.com/kingfs/llm-tracelab/internal/channel"
 	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/experiments"
 	"github.com/kingfs/llm-tracelab/internal/mcpserver"
+	"github.com/kingfs/llm-tracelab/internal/metrics"
 	"github.com/kingfs/llm-tracelab/internal/monitor"
 	"github.com/kingfs/llm-tracelab/internal/router"
 	"github.com/kingfs/llm-tracelab/internal/store"
@@ -17,7 +20,7 @@ import (
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
-func newManagementMux(traceStore *store.Store, rtr *router.Router, cfg *config.Config, authStore ...*auth.Store) *http.ServeMux {
+func newManagementMux(traceStore *store.Store, rtr *router.Router, cfg *config.Config, proxyHandler http.Handler, analysisRunner *analyzer.Runner, authStore ...*auth.Store) *http.ServeMux {
 	mux := http.NewServeMux()
 	var authStorePtr *auth.Store
 	var verifier auth.TokenVerifier
@@ -27,19 +30,35 @@ func newManagementMux(traceStore *store.Store, rtr *router.Router, cfg *config.C
 	if authStorePtr != nil {
 		verifier = authStorePtr
 	}
+	experimentsHandler := experiments.NewHandler(&experiments.Runner{
+		Store:    traceStore,
+		Handler:  proxyHandler,
+		Pricing:  cfg.Experiments.Pricing,
+		Judge:    cfg.Evals.Judge,
+		Analyzer: analysisRunner,
+		Router:   rtr,
+	})
 	if cfg.MCP.Enabled {
-		server := mcpserver.New(traceStore, mcpserver.Options{Router: rtr})
+		server := mcpserver.New(traceStore, mcpserver.Options{Router: rtr, Experiments: experimentsHandler, Analyzer: analysisRunner})
 		mcpHandler := mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server {
 			return server
 		}, nil)
 		mux.Handle(normalizeMCPPathMust(cfg.MCP.Path), auth.Middleware(mcpHandler, "llm-tracelab-mcp", verifier))
 	}
+	metricsOptions := metrics.Options{Store: traceStore, Router: rtr}
+	if source, ok := proxyHandler.(interface{ Metrics() *metrics.Requests }); ok {
+		metricsOptions.Requests = source.Metrics()
+	}
 	monitor.RegisterRoutes(mux, traceStore, monitor.RouteOptions{
 		Router:         rtr,
 		ChannelService: channel.NewService(traceStore),
 		AuthVerifier:   verifier,
 		AuthStore:      authStorePtr,
 		SessionTTL:     cfg.AuthSessionTTL(),
+		Proxy:          proxyHandler,
+		Experiments:    experimentsHandler,
+		Metrics:        metrics.Handler(metricsOptions),
+		Analyzer:       analysisRunner,
 	})
 	return mux
 }
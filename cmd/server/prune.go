@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/retention"
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/spf13/cobra"
+)
+
+type pruneOptions struct {
+	configPath string
+	maxAgeDays *int
+	maxTotalMB *int64
+	dryRun     bool
+	format     string
+	stdout     io.Writer
+}
+
+func newPruneCommand(runtime *cliRuntime) *cobra.Command {
+	var (
+		maxAgeDays int
+		maxTotalMB int64
+		dryRun     bool
+	)
+	cmd := &cobra.Command{
+		Use:           "prune",
+		Short:         "Delete traces outside the retention policy",
+		Long:          "Apply the retention policy from config, optionally overridden by --max-age-days/--max-total-mb. Cassettes are removed together with their index rows, observations, semantic nodes, findings and system events.",
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := pruneOptions{
+				configPath: runtime.configPath(),
+				dryRun:     dryRun,
+				format:     runtime.outputFormat(),
+				stdout:     cmd.OutOrStdout(),
+			}
+			if cmd.Flags().Changed("max-age-days") {
+				opts.maxAgeDays = &maxAgeDays
+			}
+			if cmd.Flags().Changed("max-total-mb") {
+				opts.maxTotalMB = &maxTotalMB
+			}
+			return runCode(func() int {
+				return runPrune(opts)
+			})
+		},
+	}
+	cmd.Flags().IntVar(&maxAgeDays, "max-age-days", 0, "Override retention.max_age_days; 0 disables the age limit")
+	cmd.Flags().Int64Var(&maxTotalMB, "max-total-mb", 0, "Override retention.max_total_mb; 0 disables the size limit")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Report which traces would be deleted without deleting them")
+	return cmd
+}
+
+func runPrune(opts pruneOptions) int {
+	cfg, err := config.Load(opts.configPath)
+	if err != nil {
+		slog.Error("Failed to load config", "path", opts.configPath, "error", err)
+		return 1
+	}
+	if opts.maxAgeDays != nil {
+		cfg.Retention.MaxAgeDays = *opts.maxAgeDays
+	}
+	if opts.maxTotalMB != nil {
+		cfg.Retention.MaxTotalMB = *opts.maxTotalMB
+	}
+	traceStore, err := store.NewWithDatabase(
+		cfg.TraceOutputDir(),
+		cfg.DatabaseDriver(),
+		cfg.DatabaseDSN(),
+		cfg.DatabaseMaxOpenConns(),
+		cfg.DatabaseMaxIdleConns(),
+	)
+	if err != nil {
+		slog.Error("Failed to initialize trace store", "error", err)
+		return 1
+	}
+	defer traceStore.Close()
+	if err := traceStore.Sync(); err != nil {
+		slog.Error("Failed to sync trace index", "error", err)
+		return 1
+	}
+
+	worker, err := retention.New(traceStore, retention.OptionsFromConfig(cfg.Retention))
+	if err != nil {
+		slog.Error("Invalid retention config", "error", err)
+		return 1
+	}
+	plan, err := worker.Plan()
+	if err != nil {
+		slog.Error("Failed to plan retention", "error", err)
+		return 1
+	}
+	result := retention.Result{ReclaimedBytes: plan.ReclaimBytes()}
+	if !opts.dryRun {
+		result, err = worker.Apply(context.Background(), plan)
+		if err != nil {
+			slog.Error("Failed to prune traces", "error", err)
+			return 1
+		}
+	}
+
+	output := map[string]any{
+		"dry_run":         opts.dryRun,
+		"traces":          plan.Traces,
+		"total_bytes":     plan.TotalBytes,
+		"protected":       plan.Protected,
+		"candidates":      plan.Candidates,
+		"deleted":         result.Deleted,
+		"failed":          result.Failed,
+		"reclaimed_bytes": result.ReclaimedBytes,
+	}
+	if err := writeCLIResult(stdoutOrDefault(opts.stdout), opts.format, "prune", output, func(w io.Writer) error {
+		for _, candidate := range plan.Candidates {
+			if _, err := fmt.Fprintf(w, "%-9s %s %s %s\n", candidate.Reason, candidate.RecordedAt.Format("2006-01-02"), candidate.TraceID, candidate.Path); err != nil {
+				return err
+			}
+		}
+		if opts.dryRun {
+			_, err := fmt.Fprintf(w, "would delete %d of %d trace(s), reclaiming %d bytes (%d protected)\n", len(plan.Candidates), plan.Traces, result.ReclaimedBytes, plan.Protected)
+			return err
+		}
+		_, err := fmt.Fprintf(w, "deleted %d of %d trace(s), reclaimed %d bytes, %d failed\n", result.Deleted, plan.Traces, result.ReclaimedBytes, result.Failed)
+		return err
+	}); err != nil {
+		slog.Error("Write command result failed", "error", err)
+		return 1
+	}
+	if result.Failed > 0 {
+		return 1
+	}
+	return 0
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"log/slog"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -54,7 +55,7 @@ func newRootCommandWithRuntime(runtime *cliRuntime) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-			return runtime.validateOutputFormat()
+			return runtime.validateOutputFormat(cmd)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runCode(func() int {
@@ -77,6 +78,11 @@ func newRootCommandWithRuntime(runtime *cliRuntime) *cobra.Command {
 		newDBCommand(runtime),
 		newAuthCommand(runtime),
 		newAnalyzeCommand(runtime),
+		newCassetteCommand(runtime),
+		newPruneCommand(runtime),
+		newTracesCommand(runtime),
+		newExperimentCommand(runtime),
+		newEvalCommand(runtime),
 		newVersionCommand(runtime),
 		newSchemaCommand(runtime, cmd),
 		newCompletionCommand(cmd),
@@ -116,18 +122,28 @@ func (rt *cliRuntime) outputFormat() string {
 	return "text"
 }
 
-func (rt *cliRuntime) validateOutputFormat() error {
-	switch rt.outputFormat() {
-	case "text", "json":
+// outputFormatsAnnotation 列出命令在 text、json 之外额外支持的 --format 取值（逗号分隔）。
+const outputFormatsAnnotation = "output_formats"
+
+func commandOutputFormats(cmd *cobra.Command) []string {
+	formats := []string{"text", "json"}
+	if cmd != nil && cmd.Annotations[outputFormatsAnnotation] != "" {
+		formats = append(formats, strings.Split(cmd.Annotations[outputFormatsAnnotation], ",")...)
+	}
+	return formats
+}
+
+func (rt *cliRuntime) validateOutputFormat(cmd *cobra.Command) error {
+	formats := commandOutputFormats(cmd)
+	if slices.Contains(formats, rt.outputFormat()) {
 		return nil
-	default:
-		return cliExitError{
-			code:     exitCodeUsage,
-			category: errorCategoryUsage,
-			errCode:  "INVALID_OUTPUT_FORMAT",
-			message:  "--format must be one of: text, json",
-			field:    "format",
-		}
+	}
+	return cliExitError{
+		code:     exitCodeUsage,
+		category: errorCategoryUsage,
+		errCode:  "INVALID_OUTPUT_FORMAT",
+		message:  "--format must be one of: " + strings.Join(formats, ", "),
+		field:    "format",
 	}
 }
 
@@ -181,7 +181,7 @@ func collectFlagSchemas(cmd *cobra.Command) []cliFlagSchema {
 				Default:   flag.DefValue,
 				Type:      flag.Value.Type(),
 				Required:  isRequiredFlag(flag),
-				Values:    knownFlagValues(flag),
+				Values:    knownFlagValues(cmd, flag),
 			})
 		})
 	}
@@ -199,9 +199,9 @@ func isRequiredFlag(flag *pflag.Flag) bool {
 	return len(values) > 0 && values[0] == "true"
 }
 
-func knownFlagValues(flag *pflag.Flag) []string {
+func knownFlagValues(cmd *cobra.Command, flag *pflag.Flag) []string {
 	if flag == nil || flag.Name != "format" {
 		return nil
 	}
-	return []string{"text", "json"}
+	return commandOutputFormats(cmd)
 }
@@ -10,14 +10,18 @@ import (
 	"sync"
 	"time"
 
+	"github.com/kingfs/llm-tracelab/internal/alerts"
 	"github.com/kingfs/llm-tracelab/internal/auth"
 	"github.com/kingfs/llm-tracelab/internal/channel"
 	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/notify"
 	"github.com/kingfs/llm-tracelab/internal/observeworker"
 	"github.com/kingfs/llm-tracelab/internal/proxy"
 	"github.com/kingfs/llm-tracelab/internal/reanalysis"
+	"github.com/kingfs/llm-tracelab/internal/retention"
 	"github.com/kingfs/llm-tracelab/internal/router"
 	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/internal/tiering"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -85,6 +89,9 @@ func runServeWithConfig(configPath string) int {
 		return 1
 	}
 	defer traceStore.Close()
+	if code := importConfiguredEvalProfiles(cfg, traceStore); code != 0 {
+		return code
+	}
 	syncCtx, cancelSync := context.WithCancel(context.Background())
 	var background sync.WaitGroup
 	defer func() {
@@ -98,43 +105,89 @@ func runServeWithConfig(configPath string) int {
 		defer background.Done()
 		parseWorker.Run(syncCtx)
 	}()
-	analysisWorker := reanalysis.NewWorker(traceStore, reanalysis.WorkerOptions{Interval: 5 * time.Second, BatchSize: 5})
+	analysisRunner, err := newAnalysisRunner(cfg)
+	if err != nil {
+		slog.Error("Failed to load detector rules", "error", err)
+		return 1
+	}
+	analysisWorker := reanalysis.NewWorker(traceStore, reanalysis.WorkerOptions{
+		Interval:  5 * time.Second,
+		BatchSize: 5,
+		Service:   reanalysis.New(traceStore, reanalysis.Options{Runner: analysisRunner}),
+	})
 	background.Add(1)
 	go func() {
 		defer background.Done()
 		analysisWorker.Run(syncCtx)
 	}()
-
-	channelService := channel.NewService(traceStore)
-	if imported, err := channelService.BootstrapFromConfig(cfg); err != nil {
-		slog.Error("Failed to bootstrap channel config", "error", err)
-		return 1
-	} else if imported > 0 {
-		slog.Info("Imported upstream config into channel store", "channels", imported)
+	if cfg.Trace.Compression.Enabled {
+		compressionWorker, err := tiering.New(traceStore, tiering.OptionsFromConfig(cfg.Trace.Compression))
+		if err != nil {
+			slog.Error("Invalid trace compression config", "error", err)
+			return 1
+		}
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			compressionWorker.Run(syncCtx)
+		}()
 	}
-	routerCfg, source, err := routerConfigFromChannels(cfg, channelService)
-	if err != nil {
-		slog.Error("Failed to build router config from channels", "error", err)
-		return 1
+	if cfg.Retention.Enabled {
+		retentionWorker, err := retention.New(traceStore, retention.OptionsFromConfig(cfg.Retention))
+		if err != nil {
+			slog.Error("Invalid retention config", "error", err)
+			return 1
+		}
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			retentionWorker.Run(syncCtx)
+		}()
 	}
-	slog.Info("Resolved router config source", "source", source)
 
-	rtr, err := router.New(routerCfg, traceStore)
-	if err != nil {
-		slog.Error("Invalid upstream config", "error", err)
-		return 1
+	if cfg.Notifications.Enabled {
+		notifier, err := notify.New(traceStore, cfg.Notifications)
+		if err != nil {
+			slog.Error("Invalid notifications config", "error", err)
+			return 1
+		}
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			notifier.Run(syncCtx)
+		}()
 	}
-	if err := rtr.Initialize(); err != nil {
-		slog.Error("Failed to initialize upstream router", "error", err)
-		return 1
+	if cfg.Alerts.Enabled {
+		evaluator, err := alerts.New(traceStore, cfg.Alerts)
+		if err != nil {
+			slog.Error("Invalid alerts config", "error", err)
+			return 1
+		}
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			evaluator.Run(syncCtx)
+		}()
+	}
+
+	rtr, code := newUpstreamRouter(cfg, traceStore)
+	if code != 0 {
+		return code
 	}
 	defer rtr.Close()
 	rtr.StartBackgroundRefresh()
 	logResolvedTargets(rtr)
 
+	handler, err := proxy.NewHandlerWithAuth(cfg, traceStore, rtr, authStore)
+	if err != nil {
+		slog.Error("Failed to create proxy handler", "error", err)
+		return 1
+	}
+	defer handler.Close()
+
 	if cfg.Monitor.Port != "" {
 		go func() {
-			mux := newManagementMux(traceStore, rtr, cfg, authStore)
+			mux := newManagementMux(traceStore, rtr, cfg, handler, analysisRunner, authStore)
 
 			addr := ":" + cfg.Monitor.Port
 			srv := &http.Server{
@@ -152,12 +205,6 @@ func runServeWithConfig(configPath string) int {
 		}()
 	}
 
-	handler, err := proxy.NewHandlerWithAuth(cfg, traceStore, rtr, authStore)
-	if err != nil {
-		slog.Error("Failed to create proxy handler", "error", err)
-		return 1
-	}
-
 	addr := ":" + cfg.Server.Port
 	srv := &http.Server{
 		Addr:              addr,
@@ -243,6 +290,35 @@ func validateServeConfig(cfg *config.Config) error {
 	return nil
 }
 
+// newUpstreamRouter 从渠道存储（首次启动时由 YAML 导入）构建并初始化上游路由，失败原因已记录日志。
+func newUpstreamRouter(cfg *config.Config, traceStore *store.Store) (*router.Router, int) {
+	channelService := channel.NewService(traceStore)
+	if imported, err := channelService.BootstrapFromConfig(cfg); err != nil {
+		slog.Error("Failed to bootstrap channel config", "error", err)
+		return nil, 1
+	} else if imported > 0 {
+		slog.Info("Imported upstream config into channel store", "channels", imported)
+	}
+	routerCfg, source, err := routerConfigFromChannels(cfg, channelService)
+	if err != nil {
+		slog.Error("Failed to build router config from channels", "error", err)
+		return nil, 1
+	}
+	slog.Info("Resolved router config source", "source", source)
+
+	rtr, err := router.New(routerCfg, traceStore)
+	if err != nil {
+		slog.Error("Invalid upstream config", "error", err)
+		return nil, 1
+	}
+	if err := rtr.Initialize(); err != nil {
+		rtr.Close()
+		slog.Error("Failed to initialize upstream router", "error", err)
+		return nil, 1
+	}
+	return rtr, 0
+}
+
 func routerConfigFromChannels(cfg *config.Config, channelService *channel.Service) (*config.Config, string, error) {
 	targets, err := channelService.RuntimeTargets()
 	if err != nil {
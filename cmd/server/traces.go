@@ -0,0 +1,323 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/spf13/cobra"
+)
+
+type tracesQueryOptions struct {
+	configPath  string
+	expression  string
+	saved       string
+	save        string
+	description string
+	page        int
+	pageSize    int
+	format      string
+	stdout      io.Writer
+}
+
+type tracesSavedOptions struct {
+	configPath string
+	name       string
+	format     string
+	stdout     io.Writer
+}
+
+type traceQueryItem struct {
+	ID           string    `json:"id"`
+	Time         time.Time `json:"time"`
+	Model        string    `json:"model"`
+	Provider     string    `json:"provider"`
+	Endpoint     string    `json:"endpoint"`
+	StatusCode   int       `json:"status_code"`
+	DurationMs   int64     `json:"duration_ms"`
+	TTFTMs       int64     `json:"ttft_ms"`
+	TotalTokens  int       `json:"total_tokens"`
+	SessionID    string    `json:"session_id,omitempty"`
+	LogPath      string    `json:"log_path"`
+	ErrorMessage string    `json:"error,omitempty"`
+}
+
+func newTracesCommand(runtime *cliRuntime) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "traces",
+		Short:         "Query recorded traces",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return requireSubcommand(cmd)
+		},
+	}
+	cmd.AddCommand(newTracesQueryCommand(runtime), newTracesSavedCommand(runtime))
+	return cmd
+}
+
+func newTracesQueryCommand(runtime *cliRuntime) *cobra.Command {
+	var (
+		saved       string
+		save        string
+		description string
+		page        int
+		pageSize    int
+	)
+	cmd := &cobra.Command{
+		Use:   "query [expression...]",
+		Short: "List traces matching a query expression",
+		Long: "List traces matching a query expression such as `model:gpt-4* status:error latency>5s finding:loop* since:24h`. " +
+			"Terms are ANDed, a leading - negates a term and bare words are matched with full-text search. " +
+			"Fields: " + strings.Join(store.QueryFieldNames(), ", ") + ".",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			expression := strings.TrimSpace(strings.Join(args, " "))
+			if expression == "" && saved == "" {
+				return cliUsageError("an expression or --saved is required", "expression")
+			}
+			if save != "" && expression == "" {
+				return cliUsageError("--save requires an expression", "save")
+			}
+			return runCode(func() int {
+				return runTracesQuery(tracesQueryOptions{
+					configPath:  runtime.configPath(),
+					expression:  expression,
+					saved:       saved,
+					save:        save,
+					description: description,
+					page:        page,
+					pageSize:    pageSize,
+					format:      runtime.outputFormat(),
+					stdout:      cmd.OutOrStdout(),
+				})
+			})
+		},
+	}
+	cmd.Flags().StringVar(&saved, "saved", "", "Run a saved query by name, combined with the expression if both are given")
+	cmd.Flags().StringVar(&save, "save", "", "Save the expression under this name before running it")
+	cmd.Flags().StringVar(&description, "description", "", "Description stored with --save")
+	cmd.Flags().IntVar(&page, "page", 1, "1-based page number")
+	cmd.Flags().IntVar(&pageSize, "page-size", 50, "Number of traces per page")
+	return cmd
+}
+
+func newTracesSavedCommand(runtime *cliRuntime) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "saved",
+		Short:         "Manage saved trace queries",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return requireSubcommand(cmd)
+		},
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:           "list",
+		Short:         "List saved queries",
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCode(func() int {
+				return runTracesSavedList(tracesSavedOptions{
+					configPath: runtime.configPath(),
+					format:     runtime.outputFormat(),
+					stdout:     cmd.OutOrStdout(),
+				})
+			})
+		},
+	}, &cobra.Command{
+		Use:           "delete <name>",
+		Short:         "Delete a saved query",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return cliUsageError("delete requires exactly one saved query name", "name")
+			}
+			return runCode(func() int {
+				return runTracesSavedDelete(tracesSavedOptions{
+					configPath: runtime.configPath(),
+					name:       args[0],
+					format:     runtime.outputFormat(),
+					stdout:     cmd.OutOrStdout(),
+				})
+			})
+		},
+	})
+	return cmd
+}
+
+func openTraceStore(configPath string) (*store.Store, int) {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		slog.Error("Failed to load config", "path", configPath, "error", err)
+		return nil, 1
+	}
+	traceStore, err := store.NewWithDatabase(
+		cfg.TraceOutputDir(),
+		cfg.DatabaseDriver(),
+		cfg.DatabaseDSN(),
+		cfg.DatabaseMaxOpenConns(),
+		cfg.DatabaseMaxIdleConns(),
+	)
+	if err != nil {
+		slog.Error("Failed to initialize trace store", "error", err)
+		return nil, 1
+	}
+	return traceStore, 0
+}
+
+func runTracesQuery(opts tracesQueryOptions) int {
+	traceStore, code := openTraceStore(opts.configPath)
+	if code != 0 {
+		return code
+	}
+	defer traceStore.Close()
+	if err := traceStore.Sync(); err != nil {
+		slog.Error("Failed to sync trace index", "error", err)
+		return 1
+	}
+
+	if opts.save != "" {
+		if _, err := traceStore.SaveQuery(opts.save, opts.expression, opts.description); err != nil {
+			slog.Error("Failed to save query", "name", opts.save, "error", err)
+			return 1
+		}
+	}
+	var expressions []string
+	if opts.saved != "" {
+		saved, err := traceStore.GetSavedQuery(opts.saved)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				slog.Error("Saved query not found", "name", opts.saved)
+			} else {
+				slog.Error("Failed to load saved query", "name", opts.saved, "error", err)
+			}
+			return 1
+		}
+		expressions = append(expressions, saved.Query)
+	}
+	if opts.expression != "" {
+		expressions = append(expressions, opts.expression)
+	}
+	var filter store.ListFilter
+	for _, expr := range expressions {
+		conditions, err := store.ParseTraceQuery(expr)
+		if err != nil {
+			slog.Error("Invalid query", "query", expr, "error", err)
+			return 1
+		}
+		filter.Conditions = append(filter.Conditions, conditions...)
+	}
+
+	result, err := traceStore.ListPage(opts.page, opts.pageSize, filter)
+	if err != nil {
+		slog.Error("Failed to query traces", "error", err)
+		return 1
+	}
+	items := make([]traceQueryItem, 0, len(result.Items))
+	for _, entry := range result.Items {
+		meta := entry.Header.Meta
+		endpoint := meta.Endpoint
+		if endpoint == "" {
+			endpoint = meta.URL
+		}
+		items = append(items, traceQueryItem{
+			ID:           entry.ID,
+			Time:         meta.Time,
+			Model:        meta.Model,
+			Provider:     meta.Provider,
+			Endpoint:     endpoint,
+			StatusCode:   meta.StatusCode,
+			DurationMs:   meta.DurationMs,
+			TTFTMs:       meta.TTFTMs,
+			TotalTokens:  entry.Header.Usage.TotalTokens,
+			SessionID:    entry.SessionID,
+			LogPath:      entry.LogPath,
+			ErrorMessage: meta.Error,
+		})
+	}
+
+	output := map[string]any{
+		"query":       strings.Join(expressions, " "),
+		"items":       items,
+		"total":       result.Total,
+		"page":        result.Page,
+		"page_size":   result.PageSize,
+		"total_pages": result.TotalPages,
+	}
+	if err := writeCLIResult(stdoutOrDefault(opts.stdout), opts.format, "traces query", output, func(w io.Writer) error {
+		for _, item := range items {
+			if _, err := fmt.Fprintf(w, "%s %s %3d %6dms %6d tok %-24s %s\n", item.Time.Format(time.RFC3339), item.ID, item.StatusCode, item.DurationMs, item.TotalTokens, item.Model, item.Endpoint); err != nil {
+				return err
+			}
+		}
+		_, err := fmt.Fprintf(w, "%d trace(s) matched, page %d/%d\n", result.Total, result.Page, max(result.TotalPages, 1))
+		return err
+	}); err != nil {
+		slog.Error("Write command result failed", "error", err)
+		return 1
+	}
+	return 0
+}
+
+func runTracesSavedList(opts tracesSavedOptions) int {
+	traceStore, code := openTraceStore(opts.configPath)
+	if code != 0 {
+		return code
+	}
+	defer traceStore.Close()
+	queries, err := traceStore.ListSavedQueries()
+	if err != nil {
+		slog.Error("Failed to list saved queries", "error", err)
+		return 1
+	}
+	if queries == nil {
+		queries = []store.SavedQuery{}
+	}
+	if err := writeCLIResult(stdoutOrDefault(opts.stdout), opts.format, "traces saved list", map[string]any{"items": queries}, func(w io.Writer) error {
+		for _, query := range queries {
+			if _, err := fmt.Fprintf(w, "%-24s %s\n", query.Name, query.Query); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		slog.Error("Write command result failed", "error", err)
+		return 1
+	}
+	return 0
+}
+
+func runTracesSavedDelete(opts tracesSavedOptions) int {
+	traceStore, code := openTraceStore(opts.configPath)
+	if code != 0 {
+		return code
+	}
+	defer traceStore.Close()
+	if err := traceStore.DeleteSavedQuery(opts.name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			slog.Error("Saved query not found", "name", opts.name)
+		} else {
+			slog.Error("Failed to delete saved query", "name", opts.name, "error", err)
+		}
+		return 1
+	}
+	if err := writeCLIResult(stdoutOrDefault(opts.stdout), opts.format, "traces saved delete", map[string]any{"name": opts.name, "deleted": true}, func(w io.Writer) error {
+		_, err := fmt.Fprintf(w, "deleted saved query %s\n", opts.name)
+		return err
+	}); err != nil {
+		slog.Error("Write command result failed", "error", err)
+		return 1
+	}
+	return 0
+}
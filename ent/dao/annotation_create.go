@@ -0,0 +1,849 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kingfs/llm-tracelab/ent/dao/annotation"
+)
+
+// AnnotationCreate is the builder for creating a Annotation entity.
+type AnnotationCreate struct {
+	config
+	mutation *AnnotationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTargetType sets the "target_type" field.
+func (_c *AnnotationCreate) SetTargetType(v string) *AnnotationCreate {
+	_c.mutation.SetTargetType(v)
+	return _c
+}
+
+// SetTargetID sets the "target_id" field.
+func (_c *AnnotationCreate) SetTargetID(v string) *AnnotationCreate {
+	_c.mutation.SetTargetID(v)
+	return _c
+}
+
+// SetParentID sets the "parent_id" field.
+func (_c *AnnotationCreate) SetParentID(v string) *AnnotationCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *AnnotationCreate) SetNillableParentID(v *string) *AnnotationCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
+// SetAuthor sets the "author" field.
+func (_c *AnnotationCreate) SetAuthor(v string) *AnnotationCreate {
+	_c.mutation.SetAuthor(v)
+	return _c
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_c *AnnotationCreate) SetNillableAuthor(v *string) *AnnotationCreate {
+	if v != nil {
+		_c.SetAuthor(*v)
+	}
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *AnnotationCreate) SetBody(v string) *AnnotationCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AnnotationCreate) SetCreatedAt(v time.Time) *AnnotationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AnnotationCreate) SetNillableCreatedAt(v *time.Time) *AnnotationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AnnotationCreate) SetUpdatedAt(v time.Time) *AnnotationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AnnotationCreate) SetNillableUpdatedAt(v *time.Time) *AnnotationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AnnotationCreate) SetID(v string) *AnnotationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AnnotationMutation object of the builder.
+func (_c *AnnotationCreate) Mutation() *AnnotationMutation {
+	return _c.mutation
+}
+
+// Save creates the Annotation in the database.
+func (_c *AnnotationCreate) Save(ctx context.Context) (*Annotation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AnnotationCreate) SaveX(ctx context.Context) *Annotation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnnotationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnnotationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AnnotationCreate) defaults() {
+	if _, ok := _c.mutation.ParentID(); !ok {
+		v := annotation.DefaultParentID
+		_c.mutation.SetParentID(v)
+	}
+	if _, ok := _c.mutation.Author(); !ok {
+		v := annotation.DefaultAuthor
+		_c.mutation.SetAuthor(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := annotation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := annotation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AnnotationCreate) check() error {
+	if _, ok := _c.mutation.TargetType(); !ok {
+		return &ValidationError{Name: "target_type", err: errors.New(`dao: missing required field "Annotation.target_type"`)}
+	}
+	if v, ok := _c.mutation.TargetType(); ok {
+		if err := annotation.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`dao: validator failed for field "Annotation.target_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`dao: missing required field "Annotation.target_id"`)}
+	}
+	if v, ok := _c.mutation.TargetID(); ok {
+		if err := annotation.TargetIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_id", err: fmt.Errorf(`dao: validator failed for field "Annotation.target_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ParentID(); !ok {
+		return &ValidationError{Name: "parent_id", err: errors.New(`dao: missing required field "Annotation.parent_id"`)}
+	}
+	if _, ok := _c.mutation.Author(); !ok {
+		return &ValidationError{Name: "author", err: errors.New(`dao: missing required field "Annotation.author"`)}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`dao: missing required field "Annotation.body"`)}
+	}
+	if v, ok := _c.mutation.Body(); ok {
+		if err := annotation.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`dao: validator failed for field "Annotation.body": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`dao: missing required field "Annotation.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`dao: missing required field "Annotation.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := annotation.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`dao: validator failed for field "Annotation.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AnnotationCreate) sqlSave(ctx context.Context) (*Annotation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Annotation.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AnnotationCreate) createSpec() (*Annotation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Annotation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(annotation.Table, sqlgraph.NewFieldSpec(annotation.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.Annotation
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.TargetType(); ok {
+		_spec.SetField(annotation.FieldTargetType, field.TypeString, value)
+		_node.TargetType = value
+	}
+	if value, ok := _c.mutation.TargetID(); ok {
+		_spec.SetField(annotation.FieldTargetID, field.TypeString, value)
+		_node.TargetID = value
+	}
+	if value, ok := _c.mutation.ParentID(); ok {
+		_spec.SetField(annotation.FieldParentID, field.TypeString, value)
+		_node.ParentID = value
+	}
+	if value, ok := _c.mutation.Author(); ok {
+		_spec.SetField(annotation.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(annotation.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(annotation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(annotation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Annotation.Create().
+//		SetTargetType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnnotationUpsert) {
+//			SetTargetType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnnotationCreate) OnConflict(opts ...sql.ConflictOption) *AnnotationUpsertOne {
+	_c.conflict = opts
+	return &AnnotationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Annotation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnnotationCreate) OnConflictColumns(columns ...string) *AnnotationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnnotationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AnnotationUpsertOne is the builder for "upsert"-ing
+	//  one Annotation node.
+	AnnotationUpsertOne struct {
+		create *AnnotationCreate
+	}
+
+	// AnnotationUpsert is the "OnConflict" setter.
+	AnnotationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTargetType sets the "target_type" field.
+func (u *AnnotationUpsert) SetTargetType(v string) *AnnotationUpsert {
+	u.Set(annotation.FieldTargetType, v)
+	return u
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *AnnotationUpsert) UpdateTargetType() *AnnotationUpsert {
+	u.SetExcluded(annotation.FieldTargetType)
+	return u
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *AnnotationUpsert) SetTargetID(v string) *AnnotationUpsert {
+	u.Set(annotation.FieldTargetID, v)
+	return u
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *AnnotationUpsert) UpdateTargetID() *AnnotationUpsert {
+	u.SetExcluded(annotation.FieldTargetID)
+	return u
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *AnnotationUpsert) SetParentID(v string) *AnnotationUpsert {
+	u.Set(annotation.FieldParentID, v)
+	return u
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *AnnotationUpsert) UpdateParentID() *AnnotationUpsert {
+	u.SetExcluded(annotation.FieldParentID)
+	return u
+}
+
+// SetAuthor sets the "author" field.
+func (u *AnnotationUpsert) SetAuthor(v string) *AnnotationUpsert {
+	u.Set(annotation.FieldAuthor, v)
+	return u
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *AnnotationUpsert) UpdateAuthor() *AnnotationUpsert {
+	u.SetExcluded(annotation.FieldAuthor)
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *AnnotationUpsert) SetBody(v string) *AnnotationUpsert {
+	u.Set(annotation.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *AnnotationUpsert) UpdateBody() *AnnotationUpsert {
+	u.SetExcluded(annotation.FieldBody)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnnotationUpsert) SetUpdatedAt(v time.Time) *AnnotationUpsert {
+	u.Set(annotation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnnotationUpsert) UpdateUpdatedAt() *AnnotationUpsert {
+	u.SetExcluded(annotation.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Annotation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(annotation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnnotationUpsertOne) UpdateNewValues() *AnnotationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(annotation.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(annotation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Annotation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AnnotationUpsertOne) Ignore() *AnnotationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnnotationUpsertOne) DoNothing() *AnnotationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnnotationCreate.OnConflict
+// documentation for more info.
+func (u *AnnotationUpsertOne) Update(set func(*AnnotationUpsert)) *AnnotationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnnotationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *AnnotationUpsertOne) SetTargetType(v string) *AnnotationUpsertOne {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *AnnotationUpsertOne) UpdateTargetType() *AnnotationUpsertOne {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *AnnotationUpsertOne) SetTargetID(v string) *AnnotationUpsertOne {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *AnnotationUpsertOne) UpdateTargetID() *AnnotationUpsertOne {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *AnnotationUpsertOne) SetParentID(v string) *AnnotationUpsertOne {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *AnnotationUpsertOne) UpdateParentID() *AnnotationUpsertOne {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *AnnotationUpsertOne) SetAuthor(v string) *AnnotationUpsertOne {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *AnnotationUpsertOne) UpdateAuthor() *AnnotationUpsertOne {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *AnnotationUpsertOne) SetBody(v string) *AnnotationUpsertOne {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *AnnotationUpsertOne) UpdateBody() *AnnotationUpsertOne {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnnotationUpsertOne) SetUpdatedAt(v time.Time) *AnnotationUpsertOne {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnnotationUpsertOne) UpdateUpdatedAt() *AnnotationUpsertOne {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AnnotationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("dao: missing options for AnnotationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnnotationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AnnotationUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("dao: AnnotationUpsertOne.ID is not supported by MySQL driver. Use AnnotationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AnnotationUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AnnotationCreateBulk is the builder for creating many Annotation entities in bulk.
+type AnnotationCreateBulk struct {
+	config
+	err      error
+	builders []*AnnotationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Annotation entities in the database.
+func (_c *AnnotationCreateBulk) Save(ctx context.Context) ([]*Annotation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Annotation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AnnotationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AnnotationCreateBulk) SaveX(ctx context.Context) []*Annotation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnnotationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnnotationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Annotation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnnotationUpsert) {
+//			SetTargetType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnnotationCreateBulk) OnConflict(opts ...sql.ConflictOption) *AnnotationUpsertBulk {
+	_c.conflict = opts
+	return &AnnotationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Annotation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnnotationCreateBulk) OnConflictColumns(columns ...string) *AnnotationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnnotationUpsertBulk{
+		create: _c,
+	}
+}
+
+// AnnotationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Annotation nodes.
+type AnnotationUpsertBulk struct {
+	create *AnnotationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Annotation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(annotation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnnotationUpsertBulk) UpdateNewValues() *AnnotationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(annotation.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(annotation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Annotation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AnnotationUpsertBulk) Ignore() *AnnotationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnnotationUpsertBulk) DoNothing() *AnnotationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnnotationCreateBulk.OnConflict
+// documentation for more info.
+func (u *AnnotationUpsertBulk) Update(set func(*AnnotationUpsert)) *AnnotationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnnotationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *AnnotationUpsertBulk) SetTargetType(v string) *AnnotationUpsertBulk {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *AnnotationUpsertBulk) UpdateTargetType() *AnnotationUpsertBulk {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *AnnotationUpsertBulk) SetTargetID(v string) *AnnotationUpsertBulk {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *AnnotationUpsertBulk) UpdateTargetID() *AnnotationUpsertBulk {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *AnnotationUpsertBulk) SetParentID(v string) *AnnotationUpsertBulk {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *AnnotationUpsertBulk) UpdateParentID() *AnnotationUpsertBulk {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *AnnotationUpsertBulk) SetAuthor(v string) *AnnotationUpsertBulk {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *AnnotationUpsertBulk) UpdateAuthor() *AnnotationUpsertBulk {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *AnnotationUpsertBulk) SetBody(v string) *AnnotationUpsertBulk {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *AnnotationUpsertBulk) UpdateBody() *AnnotationUpsertBulk {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnnotationUpsertBulk) SetUpdatedAt(v time.Time) *AnnotationUpsertBulk {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnnotationUpsertBulk) UpdateUpdatedAt() *AnnotationUpsertBulk {
+	return u.Update(func(s *AnnotationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AnnotationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("dao: OnConflict was set for builder %d. Set it on the AnnotationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("dao: missing options for AnnotationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnnotationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,447 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kingfs/llm-tracelab/ent/dao/annotation"
+	"github.com/kingfs/llm-tracelab/ent/dao/internal"
+	"github.com/kingfs/llm-tracelab/ent/dao/predicate"
+)
+
+// AnnotationUpdate is the builder for updating Annotation entities.
+type AnnotationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AnnotationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AnnotationUpdate builder.
+func (_u *AnnotationUpdate) Where(ps ...predicate.Annotation) *AnnotationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTargetType sets the "target_type" field.
+func (_u *AnnotationUpdate) SetTargetType(v string) *AnnotationUpdate {
+	_u.mutation.SetTargetType(v)
+	return _u
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (_u *AnnotationUpdate) SetNillableTargetType(v *string) *AnnotationUpdate {
+	if v != nil {
+		_u.SetTargetType(*v)
+	}
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *AnnotationUpdate) SetTargetID(v string) *AnnotationUpdate {
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *AnnotationUpdate) SetNillableTargetID(v *string) *AnnotationUpdate {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *AnnotationUpdate) SetParentID(v string) *AnnotationUpdate {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *AnnotationUpdate) SetNillableParentID(v *string) *AnnotationUpdate {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// SetAuthor sets the "author" field.
+func (_u *AnnotationUpdate) SetAuthor(v string) *AnnotationUpdate {
+	_u.mutation.SetAuthor(v)
+	return _u
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_u *AnnotationUpdate) SetNillableAuthor(v *string) *AnnotationUpdate {
+	if v != nil {
+		_u.SetAuthor(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *AnnotationUpdate) SetBody(v string) *AnnotationUpdate {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *AnnotationUpdate) SetNillableBody(v *string) *AnnotationUpdate {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AnnotationUpdate) SetUpdatedAt(v time.Time) *AnnotationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *AnnotationUpdate) SetNillableUpdatedAt(v *time.Time) *AnnotationUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AnnotationMutation object of the builder.
+func (_u *AnnotationUpdate) Mutation() *AnnotationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AnnotationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnnotationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AnnotationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnnotationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AnnotationUpdate) check() error {
+	if v, ok := _u.mutation.TargetType(); ok {
+		if err := annotation.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`dao: validator failed for field "Annotation.target_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetID(); ok {
+		if err := annotation.TargetIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_id", err: fmt.Errorf(`dao: validator failed for field "Annotation.target_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Body(); ok {
+		if err := annotation.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`dao: validator failed for field "Annotation.body": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AnnotationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnnotationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AnnotationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(annotation.Table, annotation.Columns, sqlgraph.NewFieldSpec(annotation.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TargetType(); ok {
+		_spec.SetField(annotation.FieldTargetType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetID(); ok {
+		_spec.SetField(annotation.FieldTargetID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ParentID(); ok {
+		_spec.SetField(annotation.FieldParentID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Author(); ok {
+		_spec.SetField(annotation.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(annotation.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(annotation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.Annotation
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{annotation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AnnotationUpdateOne is the builder for updating a single Annotation entity.
+type AnnotationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AnnotationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTargetType sets the "target_type" field.
+func (_u *AnnotationUpdateOne) SetTargetType(v string) *AnnotationUpdateOne {
+	_u.mutation.SetTargetType(v)
+	return _u
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (_u *AnnotationUpdateOne) SetNillableTargetType(v *string) *AnnotationUpdateOne {
+	if v != nil {
+		_u.SetTargetType(*v)
+	}
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *AnnotationUpdateOne) SetTargetID(v string) *AnnotationUpdateOne {
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *AnnotationUpdateOne) SetNillableTargetID(v *string) *AnnotationUpdateOne {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *AnnotationUpdateOne) SetParentID(v string) *AnnotationUpdateOne {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *AnnotationUpdateOne) SetNillableParentID(v *string) *AnnotationUpdateOne {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// SetAuthor sets the "author" field.
+func (_u *AnnotationUpdateOne) SetAuthor(v string) *AnnotationUpdateOne {
+	_u.mutation.SetAuthor(v)
+	return _u
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_u *AnnotationUpdateOne) SetNillableAuthor(v *string) *AnnotationUpdateOne {
+	if v != nil {
+		_u.SetAuthor(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *AnnotationUpdateOne) SetBody(v string) *AnnotationUpdateOne {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *AnnotationUpdateOne) SetNillableBody(v *string) *AnnotationUpdateOne {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AnnotationUpdateOne) SetUpdatedAt(v time.Time) *AnnotationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *AnnotationUpdateOne) SetNillableUpdatedAt(v *time.Time) *AnnotationUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AnnotationMutation object of the builder.
+func (_u *AnnotationUpdateOne) Mutation() *AnnotationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AnnotationUpdate builder.
+func (_u *AnnotationUpdateOne) Where(ps ...predicate.Annotation) *AnnotationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AnnotationUpdateOne) Select(field string, fields ...string) *AnnotationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Annotation entity.
+func (_u *AnnotationUpdateOne) Save(ctx context.Context) (*Annotation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnnotationUpdateOne) SaveX(ctx context.Context) *Annotation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AnnotationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnnotationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AnnotationUpdateOne) check() error {
+	if v, ok := _u.mutation.TargetType(); ok {
+		if err := annotation.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`dao: validator failed for field "Annotation.target_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetID(); ok {
+		if err := annotation.TargetIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_id", err: fmt.Errorf(`dao: validator failed for field "Annotation.target_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Body(); ok {
+		if err := annotation.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`dao: validator failed for field "Annotation.body": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AnnotationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnnotationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AnnotationUpdateOne) sqlSave(ctx context.Context) (_node *Annotation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(annotation.Table, annotation.Columns, sqlgraph.NewFieldSpec(annotation.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`dao: missing "Annotation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, annotation.FieldID)
+		for _, f := range fields {
+			if !annotation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("dao: invalid field %q for query", f)}
+			}
+			if f != annotation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TargetType(); ok {
+		_spec.SetField(annotation.FieldTargetType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetID(); ok {
+		_spec.SetField(annotation.FieldTargetID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ParentID(); ok {
+		_spec.SetField(annotation.FieldParentID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Author(); ok {
+		_spec.SetField(annotation.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(annotation.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(annotation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.Annotation
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Annotation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{annotation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
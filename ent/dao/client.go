@@ -15,17 +15,21 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/kingfs/llm-tracelab/ent/dao/annotation"
 	"github.com/kingfs/llm-tracelab/ent/dao/apitoken"
 	"github.com/kingfs/llm-tracelab/ent/dao/channelconfig"
 	"github.com/kingfs/llm-tracelab/ent/dao/channelmodel"
 	"github.com/kingfs/llm-tracelab/ent/dao/channelproberun"
 	"github.com/kingfs/llm-tracelab/ent/dao/dataset"
 	"github.com/kingfs/llm-tracelab/ent/dao/datasetexample"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetexampleoverride"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetsnapshot"
 	"github.com/kingfs/llm-tracelab/ent/dao/evalrun"
 	"github.com/kingfs/llm-tracelab/ent/dao/experimentrun"
 	"github.com/kingfs/llm-tracelab/ent/dao/modelcatalog"
 	"github.com/kingfs/llm-tracelab/ent/dao/score"
 	"github.com/kingfs/llm-tracelab/ent/dao/tracelog"
+	"github.com/kingfs/llm-tracelab/ent/dao/tracetag"
 	"github.com/kingfs/llm-tracelab/ent/dao/upstreammodel"
 	"github.com/kingfs/llm-tracelab/ent/dao/upstreamtarget"
 	"github.com/kingfs/llm-tracelab/ent/dao/user"
@@ -42,6 +46,8 @@ type Client struct {
 	Schema *migrate.Schema
 	// APIToken is the client for interacting with the APIToken builders.
 	APIToken *APITokenClient
+	// Annotation is the client for interacting with the Annotation builders.
+	Annotation *AnnotationClient
 	// ChannelConfig is the client for interacting with the ChannelConfig builders.
 	ChannelConfig *ChannelConfigClient
 	// ChannelModel is the client for interacting with the ChannelModel builders.
@@ -52,6 +58,10 @@ type Client struct {
 	Dataset *DatasetClient
 	// DatasetExample is the client for interacting with the DatasetExample builders.
 	DatasetExample *DatasetExampleClient
+	// DatasetExampleOverride is the client for interacting with the DatasetExampleOverride builders.
+	DatasetExampleOverride *DatasetExampleOverrideClient
+	// DatasetSnapshot is the client for interacting with the DatasetSnapshot builders.
+	DatasetSnapshot *DatasetSnapshotClient
 	// EvalRun is the client for interacting with the EvalRun builders.
 	EvalRun *EvalRunClient
 	// ExperimentRun is the client for interacting with the ExperimentRun builders.
@@ -62,6 +72,8 @@ type Client struct {
 	Score *ScoreClient
 	// TraceLog is the client for interacting with the TraceLog builders.
 	TraceLog *TraceLogClient
+	// TraceTag is the client for interacting with the TraceTag builders.
+	TraceTag *TraceTagClient
 	// UpstreamModel is the client for interacting with the UpstreamModel builders.
 	UpstreamModel *UpstreamModelClient
 	// UpstreamTarget is the client for interacting with the UpstreamTarget builders.
@@ -80,16 +92,20 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.APIToken = NewAPITokenClient(c.config)
+	c.Annotation = NewAnnotationClient(c.config)
 	c.ChannelConfig = NewChannelConfigClient(c.config)
 	c.ChannelModel = NewChannelModelClient(c.config)
 	c.ChannelProbeRun = NewChannelProbeRunClient(c.config)
 	c.Dataset = NewDatasetClient(c.config)
 	c.DatasetExample = NewDatasetExampleClient(c.config)
+	c.DatasetExampleOverride = NewDatasetExampleOverrideClient(c.config)
+	c.DatasetSnapshot = NewDatasetSnapshotClient(c.config)
 	c.EvalRun = NewEvalRunClient(c.config)
 	c.ExperimentRun = NewExperimentRunClient(c.config)
 	c.ModelCatalog = NewModelCatalogClient(c.config)
 	c.Score = NewScoreClient(c.config)
 	c.TraceLog = NewTraceLogClient(c.config)
+	c.TraceTag = NewTraceTagClient(c.config)
 	c.UpstreamModel = NewUpstreamModelClient(c.config)
 	c.UpstreamTarget = NewUpstreamTargetClient(c.config)
 	c.User = NewUserClient(c.config)
@@ -185,22 +201,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		APIToken:        NewAPITokenClient(cfg),
-		ChannelConfig:   NewChannelConfigClient(cfg),
-		ChannelModel:    NewChannelModelClient(cfg),
-		ChannelProbeRun: NewChannelProbeRunClient(cfg),
-		Dataset:         NewDatasetClient(cfg),
-		DatasetExample:  NewDatasetExampleClient(cfg),
-		EvalRun:         NewEvalRunClient(cfg),
-		ExperimentRun:   NewExperimentRunClient(cfg),
-		ModelCatalog:    NewModelCatalogClient(cfg),
-		Score:           NewScoreClient(cfg),
-		TraceLog:        NewTraceLogClient(cfg),
-		UpstreamModel:   NewUpstreamModelClient(cfg),
-		UpstreamTarget:  NewUpstreamTargetClient(cfg),
-		User:            NewUserClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		APIToken:               NewAPITokenClient(cfg),
+		Annotation:             NewAnnotationClient(cfg),
+		ChannelConfig:          NewChannelConfigClient(cfg),
+		ChannelModel:           NewChannelModelClient(cfg),
+		ChannelProbeRun:        NewChannelProbeRunClient(cfg),
+		Dataset:                NewDatasetClient(cfg),
+		DatasetExample:         NewDatasetExampleClient(cfg),
+		DatasetExampleOverride: NewDatasetExampleOverrideClient(cfg),
+		DatasetSnapshot:        NewDatasetSnapshotClient(cfg),
+		EvalRun:                NewEvalRunClient(cfg),
+		ExperimentRun:          NewExperimentRunClient(cfg),
+		ModelCatalog:           NewModelCatalogClient(cfg),
+		Score:                  NewScoreClient(cfg),
+		TraceLog:               NewTraceLogClient(cfg),
+		TraceTag:               NewTraceTagClient(cfg),
+		UpstreamModel:          NewUpstreamModelClient(cfg),
+		UpstreamTarget:         NewUpstreamTargetClient(cfg),
+		User:                   NewUserClient(cfg),
 	}, nil
 }
 
@@ -218,22 +238,26 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		APIToken:        NewAPITokenClient(cfg),
-		ChannelConfig:   NewChannelConfigClient(cfg),
-		ChannelModel:    NewChannelModelClient(cfg),
-		ChannelProbeRun: NewChannelProbeRunClient(cfg),
-		Dataset:         NewDatasetClient(cfg),
-		DatasetExample:  NewDatasetExampleClient(cfg),
-		EvalRun:         NewEvalRunClient(cfg),
-		ExperimentRun:   NewExperimentRunClient(cfg),
-		ModelCatalog:    NewModelCatalogClient(cfg),
-		Score:           NewScoreClient(cfg),
-		TraceLog:        NewTraceLogClient(cfg),
-		UpstreamModel:   NewUpstreamModelClient(cfg),
-		UpstreamTarget:  NewUpstreamTargetClient(cfg),
-		User:            NewUserClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		APIToken:               NewAPITokenClient(cfg),
+		Annotation:             NewAnnotationClient(cfg),
+		ChannelConfig:          NewChannelConfigClient(cfg),
+		ChannelModel:           NewChannelModelClient(cfg),
+		ChannelProbeRun:        NewChannelProbeRunClient(cfg),
+		Dataset:                NewDatasetClient(cfg),
+		DatasetExample:         NewDatasetExampleClient(cfg),
+		DatasetExampleOverride: NewDatasetExampleOverrideClient(cfg),
+		DatasetSnapshot:        NewDatasetSnapshotClient(cfg),
+		EvalRun:                NewEvalRunClient(cfg),
+		ExperimentRun:          NewExperimentRunClient(cfg),
+		ModelCatalog:           NewModelCatalogClient(cfg),
+		Score:                  NewScoreClient(cfg),
+		TraceLog:               NewTraceLogClient(cfg),
+		TraceTag:               NewTraceTagClient(cfg),
+		UpstreamModel:          NewUpstreamModelClient(cfg),
+		UpstreamTarget:         NewUpstreamTargetClient(cfg),
+		User:                   NewUserClient(cfg),
 	}, nil
 }
 
@@ -263,9 +287,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.APIToken, c.ChannelConfig, c.ChannelModel, c.ChannelProbeRun, c.Dataset,
-		c.DatasetExample, c.EvalRun, c.ExperimentRun, c.ModelCatalog, c.Score,
-		c.TraceLog, c.UpstreamModel, c.UpstreamTarget, c.User,
+		c.APIToken, c.Annotation, c.ChannelConfig, c.ChannelModel, c.ChannelProbeRun,
+		c.Dataset, c.DatasetExample, c.DatasetExampleOverride, c.DatasetSnapshot,
+		c.EvalRun, c.ExperimentRun, c.ModelCatalog, c.Score, c.TraceLog, c.TraceTag,
+		c.UpstreamModel, c.UpstreamTarget, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -275,9 +300,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.APIToken, c.ChannelConfig, c.ChannelModel, c.ChannelProbeRun, c.Dataset,
-		c.DatasetExample, c.EvalRun, c.ExperimentRun, c.ModelCatalog, c.Score,
-		c.TraceLog, c.UpstreamModel, c.UpstreamTarget, c.User,
+		c.APIToken, c.Annotation, c.ChannelConfig, c.ChannelModel, c.ChannelProbeRun,
+		c.Dataset, c.DatasetExample, c.DatasetExampleOverride, c.DatasetSnapshot,
+		c.EvalRun, c.ExperimentRun, c.ModelCatalog, c.Score, c.TraceLog, c.TraceTag,
+		c.UpstreamModel, c.UpstreamTarget, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -288,6 +314,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *APITokenMutation:
 		return c.APIToken.mutate(ctx, m)
+	case *AnnotationMutation:
+		return c.Annotation.mutate(ctx, m)
 	case *ChannelConfigMutation:
 		return c.ChannelConfig.mutate(ctx, m)
 	case *ChannelModelMutation:
@@ -298,6 +326,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Dataset.mutate(ctx, m)
 	case *DatasetExampleMutation:
 		return c.DatasetExample.mutate(ctx, m)
+	case *DatasetExampleOverrideMutation:
+		return c.DatasetExampleOverride.mutate(ctx, m)
+	case *DatasetSnapshotMutation:
+		return c.DatasetSnapshot.mutate(ctx, m)
 	case *EvalRunMutation:
 		return c.EvalRun.mutate(ctx, m)
 	case *ExperimentRunMutation:
@@ -308,6 +340,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Score.mutate(ctx, m)
 	case *TraceLogMutation:
 		return c.TraceLog.mutate(ctx, m)
+	case *TraceTagMutation:
+		return c.TraceTag.mutate(ctx, m)
 	case *UpstreamModelMutation:
 		return c.UpstreamModel.mutate(ctx, m)
 	case *UpstreamTargetMutation:
@@ -471,6 +505,139 @@ func (c *APITokenClient) mutate(ctx context.Context, m *APITokenMutation) (Value
 	}
 }
 
+// AnnotationClient is a client for the Annotation schema.
+type AnnotationClient struct {
+	config
+}
+
+// NewAnnotationClient returns a client for the Annotation from the given config.
+func NewAnnotationClient(c config) *AnnotationClient {
+	return &AnnotationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `annotation.Hooks(f(g(h())))`.
+func (c *AnnotationClient) Use(hooks ...Hook) {
+	c.hooks.Annotation = append(c.hooks.Annotation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `annotation.Intercept(f(g(h())))`.
+func (c *AnnotationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Annotation = append(c.inters.Annotation, interceptors...)
+}
+
+// Create returns a builder for creating a Annotation entity.
+func (c *AnnotationClient) Create() *AnnotationCreate {
+	mutation := newAnnotationMutation(c.config, OpCreate)
+	return &AnnotationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Annotation entities.
+func (c *AnnotationClient) CreateBulk(builders ...*AnnotationCreate) *AnnotationCreateBulk {
+	return &AnnotationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AnnotationClient) MapCreateBulk(slice any, setFunc func(*AnnotationCreate, int)) *AnnotationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AnnotationCreateBulk{err: fmt.Errorf("calling to AnnotationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AnnotationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AnnotationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Annotation.
+func (c *AnnotationClient) Update() *AnnotationUpdate {
+	mutation := newAnnotationMutation(c.config, OpUpdate)
+	return &AnnotationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AnnotationClient) UpdateOne(_m *Annotation) *AnnotationUpdateOne {
+	mutation := newAnnotationMutation(c.config, OpUpdateOne, withAnnotation(_m))
+	return &AnnotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AnnotationClient) UpdateOneID(id string) *AnnotationUpdateOne {
+	mutation := newAnnotationMutation(c.config, OpUpdateOne, withAnnotationID(id))
+	return &AnnotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Annotation.
+func (c *AnnotationClient) Delete() *AnnotationDelete {
+	mutation := newAnnotationMutation(c.config, OpDelete)
+	return &AnnotationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AnnotationClient) DeleteOne(_m *Annotation) *AnnotationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AnnotationClient) DeleteOneID(id string) *AnnotationDeleteOne {
+	builder := c.Delete().Where(annotation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AnnotationDeleteOne{builder}
+}
+
+// Query returns a query builder for Annotation.
+func (c *AnnotationClient) Query() *AnnotationQuery {
+	return &AnnotationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAnnotation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Annotation entity by its id.
+func (c *AnnotationClient) Get(ctx context.Context, id string) (*Annotation, error) {
+	return c.Query().Where(annotation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AnnotationClient) GetX(ctx context.Context, id string) *Annotation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AnnotationClient) Hooks() []Hook {
+	return c.hooks.Annotation
+}
+
+// Interceptors returns the client interceptors.
+func (c *AnnotationClient) Interceptors() []Interceptor {
+	return c.inters.Annotation
+}
+
+func (c *AnnotationClient) mutate(ctx context.Context, m *AnnotationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AnnotationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AnnotationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AnnotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AnnotationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("dao: unknown Annotation mutation op: %q", m.Op())
+	}
+}
+
 // ChannelConfigClient is a client for the ChannelConfig schema.
 type ChannelConfigClient struct {
 	config
@@ -1136,6 +1303,272 @@ func (c *DatasetExampleClient) mutate(ctx context.Context, m *DatasetExampleMuta
 	}
 }
 
+// DatasetExampleOverrideClient is a client for the DatasetExampleOverride schema.
+type DatasetExampleOverrideClient struct {
+	config
+}
+
+// NewDatasetExampleOverrideClient returns a client for the DatasetExampleOverride from the given config.
+func NewDatasetExampleOverrideClient(c config) *DatasetExampleOverrideClient {
+	return &DatasetExampleOverrideClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `datasetexampleoverride.Hooks(f(g(h())))`.
+func (c *DatasetExampleOverrideClient) Use(hooks ...Hook) {
+	c.hooks.DatasetExampleOverride = append(c.hooks.DatasetExampleOverride, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `datasetexampleoverride.Intercept(f(g(h())))`.
+func (c *DatasetExampleOverrideClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DatasetExampleOverride = append(c.inters.DatasetExampleOverride, interceptors...)
+}
+
+// Create returns a builder for creating a DatasetExampleOverride entity.
+func (c *DatasetExampleOverrideClient) Create() *DatasetExampleOverrideCreate {
+	mutation := newDatasetExampleOverrideMutation(c.config, OpCreate)
+	return &DatasetExampleOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DatasetExampleOverride entities.
+func (c *DatasetExampleOverrideClient) CreateBulk(builders ...*DatasetExampleOverrideCreate) *DatasetExampleOverrideCreateBulk {
+	return &DatasetExampleOverrideCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DatasetExampleOverrideClient) MapCreateBulk(slice any, setFunc func(*DatasetExampleOverrideCreate, int)) *DatasetExampleOverrideCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DatasetExampleOverrideCreateBulk{err: fmt.Errorf("calling to DatasetExampleOverrideClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DatasetExampleOverrideCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DatasetExampleOverrideCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DatasetExampleOverride.
+func (c *DatasetExampleOverrideClient) Update() *DatasetExampleOverrideUpdate {
+	mutation := newDatasetExampleOverrideMutation(c.config, OpUpdate)
+	return &DatasetExampleOverrideUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DatasetExampleOverrideClient) UpdateOne(_m *DatasetExampleOverride) *DatasetExampleOverrideUpdateOne {
+	mutation := newDatasetExampleOverrideMutation(c.config, OpUpdateOne, withDatasetExampleOverride(_m))
+	return &DatasetExampleOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DatasetExampleOverrideClient) UpdateOneID(id int) *DatasetExampleOverrideUpdateOne {
+	mutation := newDatasetExampleOverrideMutation(c.config, OpUpdateOne, withDatasetExampleOverrideID(id))
+	return &DatasetExampleOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DatasetExampleOverride.
+func (c *DatasetExampleOverrideClient) Delete() *DatasetExampleOverrideDelete {
+	mutation := newDatasetExampleOverrideMutation(c.config, OpDelete)
+	return &DatasetExampleOverrideDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DatasetExampleOverrideClient) DeleteOne(_m *DatasetExampleOverride) *DatasetExampleOverrideDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DatasetExampleOverrideClient) DeleteOneID(id int) *DatasetExampleOverrideDeleteOne {
+	builder := c.Delete().Where(datasetexampleoverride.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DatasetExampleOverrideDeleteOne{builder}
+}
+
+// Query returns a query builder for DatasetExampleOverride.
+func (c *DatasetExampleOverrideClient) Query() *DatasetExampleOverrideQuery {
+	return &DatasetExampleOverrideQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDatasetExampleOverride},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DatasetExampleOverride entity by its id.
+func (c *DatasetExampleOverrideClient) Get(ctx context.Context, id int) (*DatasetExampleOverride, error) {
+	return c.Query().Where(datasetexampleoverride.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DatasetExampleOverrideClient) GetX(ctx context.Context, id int) *DatasetExampleOverride {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DatasetExampleOverrideClient) Hooks() []Hook {
+	return c.hooks.DatasetExampleOverride
+}
+
+// Interceptors returns the client interceptors.
+func (c *DatasetExampleOverrideClient) Interceptors() []Interceptor {
+	return c.inters.DatasetExampleOverride
+}
+
+func (c *DatasetExampleOverrideClient) mutate(ctx context.Context, m *DatasetExampleOverrideMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DatasetExampleOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DatasetExampleOverrideUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DatasetExampleOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DatasetExampleOverrideDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("dao: unknown DatasetExampleOverride mutation op: %q", m.Op())
+	}
+}
+
+// DatasetSnapshotClient is a client for the DatasetSnapshot schema.
+type DatasetSnapshotClient struct {
+	config
+}
+
+// NewDatasetSnapshotClient returns a client for the DatasetSnapshot from the given config.
+func NewDatasetSnapshotClient(c config) *DatasetSnapshotClient {
+	return &DatasetSnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `datasetsnapshot.Hooks(f(g(h())))`.
+func (c *DatasetSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.DatasetSnapshot = append(c.hooks.DatasetSnapshot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `datasetsnapshot.Intercept(f(g(h())))`.
+func (c *DatasetSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DatasetSnapshot = append(c.inters.DatasetSnapshot, interceptors...)
+}
+
+// Create returns a builder for creating a DatasetSnapshot entity.
+func (c *DatasetSnapshotClient) Create() *DatasetSnapshotCreate {
+	mutation := newDatasetSnapshotMutation(c.config, OpCreate)
+	return &DatasetSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DatasetSnapshot entities.
+func (c *DatasetSnapshotClient) CreateBulk(builders ...*DatasetSnapshotCreate) *DatasetSnapshotCreateBulk {
+	return &DatasetSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DatasetSnapshotClient) MapCreateBulk(slice any, setFunc func(*DatasetSnapshotCreate, int)) *DatasetSnapshotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DatasetSnapshotCreateBulk{err: fmt.Errorf("calling to DatasetSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DatasetSnapshotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DatasetSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DatasetSnapshot.
+func (c *DatasetSnapshotClient) Update() *DatasetSnapshotUpdate {
+	mutation := newDatasetSnapshotMutation(c.config, OpUpdate)
+	return &DatasetSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DatasetSnapshotClient) UpdateOne(_m *DatasetSnapshot) *DatasetSnapshotUpdateOne {
+	mutation := newDatasetSnapshotMutation(c.config, OpUpdateOne, withDatasetSnapshot(_m))
+	return &DatasetSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DatasetSnapshotClient) UpdateOneID(id string) *DatasetSnapshotUpdateOne {
+	mutation := newDatasetSnapshotMutation(c.config, OpUpdateOne, withDatasetSnapshotID(id))
+	return &DatasetSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DatasetSnapshot.
+func (c *DatasetSnapshotClient) Delete() *DatasetSnapshotDelete {
+	mutation := newDatasetSnapshotMutation(c.config, OpDelete)
+	return &DatasetSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DatasetSnapshotClient) DeleteOne(_m *DatasetSnapshot) *DatasetSnapshotDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DatasetSnapshotClient) DeleteOneID(id string) *DatasetSnapshotDeleteOne {
+	builder := c.Delete().Where(datasetsnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DatasetSnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for DatasetSnapshot.
+func (c *DatasetSnapshotClient) Query() *DatasetSnapshotQuery {
+	return &DatasetSnapshotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDatasetSnapshot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DatasetSnapshot entity by its id.
+func (c *DatasetSnapshotClient) Get(ctx context.Context, id string) (*DatasetSnapshot, error) {
+	return c.Query().Where(datasetsnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DatasetSnapshotClient) GetX(ctx context.Context, id string) *DatasetSnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DatasetSnapshotClient) Hooks() []Hook {
+	return c.hooks.DatasetSnapshot
+}
+
+// Interceptors returns the client interceptors.
+func (c *DatasetSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.DatasetSnapshot
+}
+
+func (c *DatasetSnapshotClient) mutate(ctx context.Context, m *DatasetSnapshotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DatasetSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DatasetSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DatasetSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DatasetSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("dao: unknown DatasetSnapshot mutation op: %q", m.Op())
+	}
+}
+
 // EvalRunClient is a client for the EvalRun schema.
 type EvalRunClient struct {
 	config
@@ -1801,6 +2234,139 @@ func (c *TraceLogClient) mutate(ctx context.Context, m *TraceLogMutation) (Value
 	}
 }
 
+// TraceTagClient is a client for the TraceTag schema.
+type TraceTagClient struct {
+	config
+}
+
+// NewTraceTagClient returns a client for the TraceTag from the given config.
+func NewTraceTagClient(c config) *TraceTagClient {
+	return &TraceTagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tracetag.Hooks(f(g(h())))`.
+func (c *TraceTagClient) Use(hooks ...Hook) {
+	c.hooks.TraceTag = append(c.hooks.TraceTag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tracetag.Intercept(f(g(h())))`.
+func (c *TraceTagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TraceTag = append(c.inters.TraceTag, interceptors...)
+}
+
+// Create returns a builder for creating a TraceTag entity.
+func (c *TraceTagClient) Create() *TraceTagCreate {
+	mutation := newTraceTagMutation(c.config, OpCreate)
+	return &TraceTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TraceTag entities.
+func (c *TraceTagClient) CreateBulk(builders ...*TraceTagCreate) *TraceTagCreateBulk {
+	return &TraceTagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TraceTagClient) MapCreateBulk(slice any, setFunc func(*TraceTagCreate, int)) *TraceTagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TraceTagCreateBulk{err: fmt.Errorf("calling to TraceTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TraceTagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TraceTagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TraceTag.
+func (c *TraceTagClient) Update() *TraceTagUpdate {
+	mutation := newTraceTagMutation(c.config, OpUpdate)
+	return &TraceTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TraceTagClient) UpdateOne(_m *TraceTag) *TraceTagUpdateOne {
+	mutation := newTraceTagMutation(c.config, OpUpdateOne, withTraceTag(_m))
+	return &TraceTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TraceTagClient) UpdateOneID(id int) *TraceTagUpdateOne {
+	mutation := newTraceTagMutation(c.config, OpUpdateOne, withTraceTagID(id))
+	return &TraceTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TraceTag.
+func (c *TraceTagClient) Delete() *TraceTagDelete {
+	mutation := newTraceTagMutation(c.config, OpDelete)
+	return &TraceTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TraceTagClient) DeleteOne(_m *TraceTag) *TraceTagDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TraceTagClient) DeleteOneID(id int) *TraceTagDeleteOne {
+	builder := c.Delete().Where(tracetag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TraceTagDeleteOne{builder}
+}
+
+// Query returns a query builder for TraceTag.
+func (c *TraceTagClient) Query() *TraceTagQuery {
+	return &TraceTagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTraceTag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TraceTag entity by its id.
+func (c *TraceTagClient) Get(ctx context.Context, id int) (*TraceTag, error) {
+	return c.Query().Where(tracetag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TraceTagClient) GetX(ctx context.Context, id int) *TraceTag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TraceTagClient) Hooks() []Hook {
+	return c.hooks.TraceTag
+}
+
+// Interceptors returns the client interceptors.
+func (c *TraceTagClient) Interceptors() []Interceptor {
+	return c.inters.TraceTag
+}
+
+func (c *TraceTagClient) mutate(ctx context.Context, m *TraceTagMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TraceTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TraceTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TraceTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TraceTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("dao: unknown TraceTag mutation op: %q", m.Op())
+	}
+}
+
 // UpstreamModelClient is a client for the UpstreamModel schema.
 type UpstreamModelClient struct {
 	config
@@ -2222,13 +2788,15 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		APIToken, ChannelConfig, ChannelModel, ChannelProbeRun, Dataset, DatasetExample,
-		EvalRun, ExperimentRun, ModelCatalog, Score, TraceLog, UpstreamModel,
+		APIToken, Annotation, ChannelConfig, ChannelModel, ChannelProbeRun, Dataset,
+		DatasetExample, DatasetExampleOverride, DatasetSnapshot, EvalRun,
+		ExperimentRun, ModelCatalog, Score, TraceLog, TraceTag, UpstreamModel,
 		UpstreamTarget, User []ent.Hook
 	}
 	inters struct {
-		APIToken, ChannelConfig, ChannelModel, ChannelProbeRun, Dataset, DatasetExample,
-		EvalRun, ExperimentRun, ModelCatalog, Score, TraceLog, UpstreamModel,
+		APIToken, Annotation, ChannelConfig, ChannelModel, ChannelProbeRun, Dataset,
+		DatasetExample, DatasetExampleOverride, DatasetSnapshot, EvalRun,
+		ExperimentRun, ModelCatalog, Score, TraceLog, TraceTag, UpstreamModel,
 		UpstreamTarget, User []ent.Interceptor
 	}
 )
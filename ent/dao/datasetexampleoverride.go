@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dao
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetexampleoverride"
+)
+
+// DatasetExampleOverride is the model entity for the DatasetExampleOverride schema.
+type DatasetExampleOverride struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// DatasetID holds the value of the "dataset_id" field.
+	DatasetID string `json:"dataset_id,omitempty"`
+	// TraceID holds the value of the "trace_id" field.
+	TraceID string `json:"trace_id,omitempty"`
+	// ExpectedOutput holds the value of the "expected_output" field.
+	ExpectedOutput string `json:"expected_output,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON string `json:"tags_json,omitempty"`
+	// InputOverrideJSON holds the value of the "input_override_json" field.
+	InputOverrideJSON string `json:"input_override_json,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DatasetExampleOverride) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case datasetexampleoverride.FieldID:
+			values[i] = new(sql.NullInt64)
+		case datasetexampleoverride.FieldDatasetID, datasetexampleoverride.FieldTraceID, datasetexampleoverride.FieldExpectedOutput, datasetexampleoverride.FieldTagsJSON, datasetexampleoverride.FieldInputOverrideJSON, datasetexampleoverride.FieldUpdatedBy:
+			values[i] = new(sql.NullString)
+		case datasetexampleoverride.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DatasetExampleOverride fields.
+func (_m *DatasetExampleOverride) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case datasetexampleoverride.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case datasetexampleoverride.FieldDatasetID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dataset_id", values[i])
+			} else if value.Valid {
+				_m.DatasetID = value.String
+			}
+		case datasetexampleoverride.FieldTraceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trace_id", values[i])
+			} else if value.Valid {
+				_m.TraceID = value.String
+			}
+		case datasetexampleoverride.FieldExpectedOutput:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_output", values[i])
+			} else if value.Valid {
+				_m.ExpectedOutput = value.String
+			}
+		case datasetexampleoverride.FieldTagsJSON:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value.Valid {
+				_m.TagsJSON = value.String
+			}
+		case datasetexampleoverride.FieldInputOverrideJSON:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field input_override_json", values[i])
+			} else if value.Valid {
+				_m.InputOverrideJSON = value.String
+			}
+		case datasetexampleoverride.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = value.String
+			}
+		case datasetexampleoverride.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DatasetExampleOverride.
+// This includes values selected through modifiers, order, etc.
+func (_m *DatasetExampleOverride) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DatasetExampleOverride.
+// Note that you need to call DatasetExampleOverride.Unwrap() before calling this method if this DatasetExampleOverride
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DatasetExampleOverride) Update() *DatasetExampleOverrideUpdateOne {
+	return NewDatasetExampleOverrideClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DatasetExampleOverride entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DatasetExampleOverride) Unwrap() *DatasetExampleOverride {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("dao: DatasetExampleOverride is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DatasetExampleOverride) String() string {
+	var builder strings.Builder
+	builder.WriteString("DatasetExampleOverride(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("dataset_id=")
+	builder.WriteString(_m.DatasetID)
+	builder.WriteString(", ")
+	builder.WriteString("trace_id=")
+	builder.WriteString(_m.TraceID)
+	builder.WriteString(", ")
+	builder.WriteString("expected_output=")
+	builder.WriteString(_m.ExpectedOutput)
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(_m.TagsJSON)
+	builder.WriteString(", ")
+	builder.WriteString("input_override_json=")
+	builder.WriteString(_m.InputOverrideJSON)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(_m.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DatasetExampleOverrides is a parsable slice of DatasetExampleOverride.
+type DatasetExampleOverrides []*DatasetExampleOverride
@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package datasetexampleoverride
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the datasetexampleoverride type in the database.
+	Label = "dataset_example_override"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDatasetID holds the string denoting the dataset_id field in the database.
+	FieldDatasetID = "dataset_id"
+	// FieldTraceID holds the string denoting the trace_id field in the database.
+	FieldTraceID = "trace_id"
+	// FieldExpectedOutput holds the string denoting the expected_output field in the database.
+	FieldExpectedOutput = "expected_output"
+	// FieldTagsJSON holds the string denoting the tags_json field in the database.
+	FieldTagsJSON = "tags_json"
+	// FieldInputOverrideJSON holds the string denoting the input_override_json field in the database.
+	FieldInputOverrideJSON = "input_override_json"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the datasetexampleoverride in the database.
+	Table = "dataset_example_overrides"
+)
+
+// Columns holds all SQL columns for datasetexampleoverride fields.
+var Columns = []string{
+	FieldID,
+	FieldDatasetID,
+	FieldTraceID,
+	FieldExpectedOutput,
+	FieldTagsJSON,
+	FieldInputOverrideJSON,
+	FieldUpdatedBy,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DatasetIDValidator is a validator for the "dataset_id" field. It is called by the builders before save.
+	DatasetIDValidator func(string) error
+	// TraceIDValidator is a validator for the "trace_id" field. It is called by the builders before save.
+	TraceIDValidator func(string) error
+	// DefaultExpectedOutput holds the default value on creation for the "expected_output" field.
+	DefaultExpectedOutput string
+	// DefaultTagsJSON holds the default value on creation for the "tags_json" field.
+	DefaultTagsJSON string
+	// DefaultInputOverrideJSON holds the default value on creation for the "input_override_json" field.
+	DefaultInputOverrideJSON string
+	// DefaultUpdatedBy holds the default value on creation for the "updated_by" field.
+	DefaultUpdatedBy string
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the DatasetExampleOverride queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDatasetID orders the results by the dataset_id field.
+func ByDatasetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDatasetID, opts...).ToFunc()
+}
+
+// ByTraceID orders the results by the trace_id field.
+func ByTraceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTraceID, opts...).ToFunc()
+}
+
+// ByExpectedOutput orders the results by the expected_output field.
+func ByExpectedOutput(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpectedOutput, opts...).ToFunc()
+}
+
+// ByTagsJSON orders the results by the tags_json field.
+func ByTagsJSON(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTagsJSON, opts...).ToFunc()
+}
+
+// ByInputOverrideJSON orders the results by the input_override_json field.
+func ByInputOverrideJSON(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInputOverrideJSON, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
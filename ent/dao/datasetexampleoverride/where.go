@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package datasetexampleoverride
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kingfs/llm-tracelab/ent/dao/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldLTE(FieldID, id))
+}
+
+// DatasetID applies equality check predicate on the "dataset_id" field. It's identical to DatasetIDEQ.
+func DatasetID(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEQ(FieldDatasetID, v))
+}
+
+// TraceID applies equality check predicate on the "trace_id" field. It's identical to TraceIDEQ.
+func TraceID(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEQ(FieldTraceID, v))
+}
+
+// ExpectedOutput applies equality check predicate on the "expected_output" field. It's identical to ExpectedOutputEQ.
+func ExpectedOutput(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEQ(FieldExpectedOutput, v))
+}
+
+// TagsJSON applies equality check predicate on the "tags_json" field. It's identical to TagsJSONEQ.
+func TagsJSON(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEQ(FieldTagsJSON, v))
+}
+
+// InputOverrideJSON applies equality check predicate on the "input_override_json" field. It's identical to InputOverrideJSONEQ.
+func InputOverrideJSON(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEQ(FieldInputOverrideJSON, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DatasetIDEQ applies the EQ predicate on the "dataset_id" field.
+func DatasetIDEQ(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEQ(FieldDatasetID, v))
+}
+
+// DatasetIDNEQ applies the NEQ predicate on the "dataset_id" field.
+func DatasetIDNEQ(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldNEQ(FieldDatasetID, v))
+}
+
+// DatasetIDIn applies the In predicate on the "dataset_id" field.
+func DatasetIDIn(vs ...string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldIn(FieldDatasetID, vs...))
+}
+
+// DatasetIDNotIn applies the NotIn predicate on the "dataset_id" field.
+func DatasetIDNotIn(vs ...string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldNotIn(FieldDatasetID, vs...))
+}
+
+// DatasetIDGT applies the GT predicate on the "dataset_id" field.
+func DatasetIDGT(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldGT(FieldDatasetID, v))
+}
+
+// DatasetIDGTE applies the GTE predicate on the "dataset_id" field.
+func DatasetIDGTE(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldGTE(FieldDatasetID, v))
+}
+
+// DatasetIDLT applies the LT predicate on the "dataset_id" field.
+func DatasetIDLT(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldLT(FieldDatasetID, v))
+}
+
+// DatasetIDLTE applies the LTE predicate on the "dataset_id" field.
+func DatasetIDLTE(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldLTE(FieldDatasetID, v))
+}
+
+// DatasetIDContains applies the Contains predicate on the "dataset_id" field.
+func DatasetIDContains(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldContains(FieldDatasetID, v))
+}
+
+// DatasetIDHasPrefix applies the HasPrefix predicate on the "dataset_id" field.
+func DatasetIDHasPrefix(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldHasPrefix(FieldDatasetID, v))
+}
+
+// DatasetIDHasSuffix applies the HasSuffix predicate on the "dataset_id" field.
+func DatasetIDHasSuffix(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldHasSuffix(FieldDatasetID, v))
+}
+
+// DatasetIDEqualFold applies the EqualFold predicate on the "dataset_id" field.
+func DatasetIDEqualFold(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEqualFold(FieldDatasetID, v))
+}
+
+// DatasetIDContainsFold applies the ContainsFold predicate on the "dataset_id" field.
+func DatasetIDContainsFold(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldContainsFold(FieldDatasetID, v))
+}
+
+// TraceIDEQ applies the EQ predicate on the "trace_id" field.
+func TraceIDEQ(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEQ(FieldTraceID, v))
+}
+
+// TraceIDNEQ applies the NEQ predicate on the "trace_id" field.
+func TraceIDNEQ(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldNEQ(FieldTraceID, v))
+}
+
+// TraceIDIn applies the In predicate on the "trace_id" field.
+func TraceIDIn(vs ...string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldIn(FieldTraceID, vs...))
+}
+
+// TraceIDNotIn applies the NotIn predicate on the "trace_id" field.
+func TraceIDNotIn(vs ...string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldNotIn(FieldTraceID, vs...))
+}
+
+// TraceIDGT applies the GT predicate on the "trace_id" field.
+func TraceIDGT(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldGT(FieldTraceID, v))
+}
+
+// TraceIDGTE applies the GTE predicate on the "trace_id" field.
+func TraceIDGTE(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldGTE(FieldTraceID, v))
+}
+
+// TraceIDLT applies the LT predicate on the "trace_id" field.
+func TraceIDLT(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldLT(FieldTraceID, v))
+}
+
+// TraceIDLTE applies the LTE predicate on the "trace_id" field.
+func TraceIDLTE(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldLTE(FieldTraceID, v))
+}
+
+// TraceIDContains applies the Contains predicate on the "trace_id" field.
+func TraceIDContains(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldContains(FieldTraceID, v))
+}
+
+// TraceIDHasPrefix applies the HasPrefix predicate on the "trace_id" field.
+func TraceIDHasPrefix(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldHasPrefix(FieldTraceID, v))
+}
+
+// TraceIDHasSuffix applies the HasSuffix predicate on the "trace_id" field.
+func TraceIDHasSuffix(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldHasSuffix(FieldTraceID, v))
+}
+
+// TraceIDEqualFold applies the EqualFold predicate on the "trace_id" field.
+func TraceIDEqualFold(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEqualFold(FieldTraceID, v))
+}
+
+// TraceIDContainsFold applies the ContainsFold predicate on the "trace_id" field.
+func TraceIDContainsFold(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldContainsFold(FieldTraceID, v))
+}
+
+// ExpectedOutputEQ applies the EQ predicate on the "expected_output" field.
+func ExpectedOutputEQ(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEQ(FieldExpectedOutput, v))
+}
+
+// ExpectedOutputNEQ applies the NEQ predicate on the "expected_output" field.
+func ExpectedOutputNEQ(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldNEQ(FieldExpectedOutput, v))
+}
+
+// ExpectedOutputIn applies the In predicate on the "expected_output" field.
+func ExpectedOutputIn(vs ...string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldIn(FieldExpectedOutput, vs...))
+}
+
+// ExpectedOutputNotIn applies the NotIn predicate on the "expected_output" field.
+func ExpectedOutputNotIn(vs ...string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldNotIn(FieldExpectedOutput, vs...))
+}
+
+// ExpectedOutputGT applies the GT predicate on the "expected_output" field.
+func ExpectedOutputGT(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldGT(FieldExpectedOutput, v))
+}
+
+// ExpectedOutputGTE applies the GTE predicate on the "expected_output" field.
+func ExpectedOutputGTE(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldGTE(FieldExpectedOutput, v))
+}
+
+// ExpectedOutputLT applies the LT predicate on the "expected_output" field.
+func ExpectedOutputLT(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldLT(FieldExpectedOutput, v))
+}
+
+// ExpectedOutputLTE applies the LTE predicate on the "expected_output" field.
+func ExpectedOutputLTE(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldLTE(FieldExpectedOutput, v))
+}
+
+// ExpectedOutputContains applies the Contains predicate on the "expected_output" field.
+func ExpectedOutputContains(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldContains(FieldExpectedOutput, v))
+}
+
+// ExpectedOutputHasPrefix applies the HasPrefix predicate on the "expected_output" field.
+func ExpectedOutputHasPrefix(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldHasPrefix(FieldExpectedOutput, v))
+}
+
+// ExpectedOutputHasSuffix applies the HasSuffix predicate on the "expected_output" field.
+func ExpectedOutputHasSuffix(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldHasSuffix(FieldExpectedOutput, v))
+}
+
+// ExpectedOutputEqualFold applies the EqualFold predicate on the "expected_output" field.
+func ExpectedOutputEqualFold(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEqualFold(FieldExpectedOutput, v))
+}
+
+// ExpectedOutputContainsFold applies the ContainsFold predicate on the "expected_output" field.
+func ExpectedOutputContainsFold(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldContainsFold(FieldExpectedOutput, v))
+}
+
+// TagsJSONEQ applies the EQ predicate on the "tags_json" field.
+func TagsJSONEQ(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEQ(FieldTagsJSON, v))
+}
+
+// TagsJSONNEQ applies the NEQ predicate on the "tags_json" field.
+func TagsJSONNEQ(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldNEQ(FieldTagsJSON, v))
+}
+
+// TagsJSONIn applies the In predicate on the "tags_json" field.
+func TagsJSONIn(vs ...string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldIn(FieldTagsJSON, vs...))
+}
+
+// TagsJSONNotIn applies the NotIn predicate on the "tags_json" field.
+func TagsJSONNotIn(vs ...string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldNotIn(FieldTagsJSON, vs...))
+}
+
+// TagsJSONGT applies the GT predicate on the "tags_json" field.
+func TagsJSONGT(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldGT(FieldTagsJSON, v))
+}
+
+// TagsJSONGTE applies the GTE predicate on the "tags_json" field.
+func TagsJSONGTE(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldGTE(FieldTagsJSON, v))
+}
+
+// TagsJSONLT applies the LT predicate on the "tags_json" field.
+func TagsJSONLT(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldLT(FieldTagsJSON, v))
+}
+
+// TagsJSONLTE applies the LTE predicate on the "tags_json" field.
+func TagsJSONLTE(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldLTE(FieldTagsJSON, v))
+}
+
+// TagsJSONContains applies the Contains predicate on the "tags_json" field.
+func TagsJSONContains(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldContains(FieldTagsJSON, v))
+}
+
+// TagsJSONHasPrefix applies the HasPrefix predicate on the "tags_json" field.
+func TagsJSONHasPrefix(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldHasPrefix(FieldTagsJSON, v))
+}
+
+// TagsJSONHasSuffix applies the HasSuffix predicate on the "tags_json" field.
+func TagsJSONHasSuffix(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldHasSuffix(FieldTagsJSON, v))
+}
+
+// TagsJSONEqualFold applies the EqualFold predicate on the "tags_json" field.
+func TagsJSONEqualFold(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEqualFold(FieldTagsJSON, v))
+}
+
+// TagsJSONContainsFold applies the ContainsFold predicate on the "tags_json" field.
+func TagsJSONContainsFold(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldContainsFold(FieldTagsJSON, v))
+}
+
+// InputOverrideJSONEQ applies the EQ predicate on the "input_override_json" field.
+func InputOverrideJSONEQ(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEQ(FieldInputOverrideJSON, v))
+}
+
+// InputOverrideJSONNEQ applies the NEQ predicate on the "input_override_json" field.
+func InputOverrideJSONNEQ(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldNEQ(FieldInputOverrideJSON, v))
+}
+
+// InputOverrideJSONIn applies the In predicate on the "input_override_json" field.
+func InputOverrideJSONIn(vs ...string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldIn(FieldInputOverrideJSON, vs...))
+}
+
+// InputOverrideJSONNotIn applies the NotIn predicate on the "input_override_json" field.
+func InputOverrideJSONNotIn(vs ...string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldNotIn(FieldInputOverrideJSON, vs...))
+}
+
+// InputOverrideJSONGT applies the GT predicate on the "input_override_json" field.
+func InputOverrideJSONGT(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldGT(FieldInputOverrideJSON, v))
+}
+
+// InputOverrideJSONGTE applies the GTE predicate on the "input_override_json" field.
+func InputOverrideJSONGTE(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldGTE(FieldInputOverrideJSON, v))
+}
+
+// InputOverrideJSONLT applies the LT predicate on the "input_override_json" field.
+func InputOverrideJSONLT(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldLT(FieldInputOverrideJSON, v))
+}
+
+// InputOverrideJSONLTE applies the LTE predicate on the "input_override_json" field.
+func InputOverrideJSONLTE(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldLTE(FieldInputOverrideJSON, v))
+}
+
+// InputOverrideJSONContains applies the Contains predicate on the "input_override_json" field.
+func InputOverrideJSONContains(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldContains(FieldInputOverrideJSON, v))
+}
+
+// InputOverrideJSONHasPrefix applies the HasPrefix predicate on the "input_override_json" field.
+func InputOverrideJSONHasPrefix(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldHasPrefix(FieldInputOverrideJSON, v))
+}
+
+// InputOverrideJSONHasSuffix applies the HasSuffix predicate on the "input_override_json" field.
+func InputOverrideJSONHasSuffix(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldHasSuffix(FieldInputOverrideJSON, v))
+}
+
+// InputOverrideJSONEqualFold applies the EqualFold predicate on the "input_override_json" field.
+func InputOverrideJSONEqualFold(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEqualFold(FieldInputOverrideJSON, v))
+}
+
+// InputOverrideJSONContainsFold applies the ContainsFold predicate on the "input_override_json" field.
+func InputOverrideJSONContainsFold(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldContainsFold(FieldInputOverrideJSON, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DatasetExampleOverride) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DatasetExampleOverride) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DatasetExampleOverride) predicate.DatasetExampleOverride {
+	return predicate.DatasetExampleOverride(sql.NotPredicates(p))
+}
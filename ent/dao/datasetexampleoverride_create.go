@@ -0,0 +1,850 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetexampleoverride"
+)
+
+// DatasetExampleOverrideCreate is the builder for creating a DatasetExampleOverride entity.
+type DatasetExampleOverrideCreate struct {
+	config
+	mutation *DatasetExampleOverrideMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetDatasetID sets the "dataset_id" field.
+func (_c *DatasetExampleOverrideCreate) SetDatasetID(v string) *DatasetExampleOverrideCreate {
+	_c.mutation.SetDatasetID(v)
+	return _c
+}
+
+// SetTraceID sets the "trace_id" field.
+func (_c *DatasetExampleOverrideCreate) SetTraceID(v string) *DatasetExampleOverrideCreate {
+	_c.mutation.SetTraceID(v)
+	return _c
+}
+
+// SetExpectedOutput sets the "expected_output" field.
+func (_c *DatasetExampleOverrideCreate) SetExpectedOutput(v string) *DatasetExampleOverrideCreate {
+	_c.mutation.SetExpectedOutput(v)
+	return _c
+}
+
+// SetNillableExpectedOutput sets the "expected_output" field if the given value is not nil.
+func (_c *DatasetExampleOverrideCreate) SetNillableExpectedOutput(v *string) *DatasetExampleOverrideCreate {
+	if v != nil {
+		_c.SetExpectedOutput(*v)
+	}
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *DatasetExampleOverrideCreate) SetTagsJSON(v string) *DatasetExampleOverrideCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetNillableTagsJSON sets the "tags_json" field if the given value is not nil.
+func (_c *DatasetExampleOverrideCreate) SetNillableTagsJSON(v *string) *DatasetExampleOverrideCreate {
+	if v != nil {
+		_c.SetTagsJSON(*v)
+	}
+	return _c
+}
+
+// SetInputOverrideJSON sets the "input_override_json" field.
+func (_c *DatasetExampleOverrideCreate) SetInputOverrideJSON(v string) *DatasetExampleOverrideCreate {
+	_c.mutation.SetInputOverrideJSON(v)
+	return _c
+}
+
+// SetNillableInputOverrideJSON sets the "input_override_json" field if the given value is not nil.
+func (_c *DatasetExampleOverrideCreate) SetNillableInputOverrideJSON(v *string) *DatasetExampleOverrideCreate {
+	if v != nil {
+		_c.SetInputOverrideJSON(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *DatasetExampleOverrideCreate) SetUpdatedBy(v string) *DatasetExampleOverrideCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *DatasetExampleOverrideCreate) SetNillableUpdatedBy(v *string) *DatasetExampleOverrideCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DatasetExampleOverrideCreate) SetUpdatedAt(v time.Time) *DatasetExampleOverrideCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DatasetExampleOverrideCreate) SetNillableUpdatedAt(v *time.Time) *DatasetExampleOverrideCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DatasetExampleOverrideMutation object of the builder.
+func (_c *DatasetExampleOverrideCreate) Mutation() *DatasetExampleOverrideMutation {
+	return _c.mutation
+}
+
+// Save creates the DatasetExampleOverride in the database.
+func (_c *DatasetExampleOverrideCreate) Save(ctx context.Context) (*DatasetExampleOverride, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DatasetExampleOverrideCreate) SaveX(ctx context.Context) *DatasetExampleOverride {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DatasetExampleOverrideCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DatasetExampleOverrideCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DatasetExampleOverrideCreate) defaults() {
+	if _, ok := _c.mutation.ExpectedOutput(); !ok {
+		v := datasetexampleoverride.DefaultExpectedOutput
+		_c.mutation.SetExpectedOutput(v)
+	}
+	if _, ok := _c.mutation.TagsJSON(); !ok {
+		v := datasetexampleoverride.DefaultTagsJSON
+		_c.mutation.SetTagsJSON(v)
+	}
+	if _, ok := _c.mutation.InputOverrideJSON(); !ok {
+		v := datasetexampleoverride.DefaultInputOverrideJSON
+		_c.mutation.SetInputOverrideJSON(v)
+	}
+	if _, ok := _c.mutation.UpdatedBy(); !ok {
+		v := datasetexampleoverride.DefaultUpdatedBy
+		_c.mutation.SetUpdatedBy(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := datasetexampleoverride.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DatasetExampleOverrideCreate) check() error {
+	if _, ok := _c.mutation.DatasetID(); !ok {
+		return &ValidationError{Name: "dataset_id", err: errors.New(`dao: missing required field "DatasetExampleOverride.dataset_id"`)}
+	}
+	if v, ok := _c.mutation.DatasetID(); ok {
+		if err := datasetexampleoverride.DatasetIDValidator(v); err != nil {
+			return &ValidationError{Name: "dataset_id", err: fmt.Errorf(`dao: validator failed for field "DatasetExampleOverride.dataset_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TraceID(); !ok {
+		return &ValidationError{Name: "trace_id", err: errors.New(`dao: missing required field "DatasetExampleOverride.trace_id"`)}
+	}
+	if v, ok := _c.mutation.TraceID(); ok {
+		if err := datasetexampleoverride.TraceIDValidator(v); err != nil {
+			return &ValidationError{Name: "trace_id", err: fmt.Errorf(`dao: validator failed for field "DatasetExampleOverride.trace_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpectedOutput(); !ok {
+		return &ValidationError{Name: "expected_output", err: errors.New(`dao: missing required field "DatasetExampleOverride.expected_output"`)}
+	}
+	if _, ok := _c.mutation.TagsJSON(); !ok {
+		return &ValidationError{Name: "tags_json", err: errors.New(`dao: missing required field "DatasetExampleOverride.tags_json"`)}
+	}
+	if _, ok := _c.mutation.InputOverrideJSON(); !ok {
+		return &ValidationError{Name: "input_override_json", err: errors.New(`dao: missing required field "DatasetExampleOverride.input_override_json"`)}
+	}
+	if _, ok := _c.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`dao: missing required field "DatasetExampleOverride.updated_by"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`dao: missing required field "DatasetExampleOverride.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *DatasetExampleOverrideCreate) sqlSave(ctx context.Context) (*DatasetExampleOverride, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DatasetExampleOverrideCreate) createSpec() (*DatasetExampleOverride, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DatasetExampleOverride{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(datasetexampleoverride.Table, sqlgraph.NewFieldSpec(datasetexampleoverride.FieldID, field.TypeInt))
+	)
+	_spec.Schema = _c.schemaConfig.DatasetExampleOverride
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.DatasetID(); ok {
+		_spec.SetField(datasetexampleoverride.FieldDatasetID, field.TypeString, value)
+		_node.DatasetID = value
+	}
+	if value, ok := _c.mutation.TraceID(); ok {
+		_spec.SetField(datasetexampleoverride.FieldTraceID, field.TypeString, value)
+		_node.TraceID = value
+	}
+	if value, ok := _c.mutation.ExpectedOutput(); ok {
+		_spec.SetField(datasetexampleoverride.FieldExpectedOutput, field.TypeString, value)
+		_node.ExpectedOutput = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(datasetexampleoverride.FieldTagsJSON, field.TypeString, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.InputOverrideJSON(); ok {
+		_spec.SetField(datasetexampleoverride.FieldInputOverrideJSON, field.TypeString, value)
+		_node.InputOverrideJSON = value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(datasetexampleoverride.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(datasetexampleoverride.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DatasetExampleOverride.Create().
+//		SetDatasetID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DatasetExampleOverrideUpsert) {
+//			SetDatasetID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DatasetExampleOverrideCreate) OnConflict(opts ...sql.ConflictOption) *DatasetExampleOverrideUpsertOne {
+	_c.conflict = opts
+	return &DatasetExampleOverrideUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DatasetExampleOverride.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DatasetExampleOverrideCreate) OnConflictColumns(columns ...string) *DatasetExampleOverrideUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DatasetExampleOverrideUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DatasetExampleOverrideUpsertOne is the builder for "upsert"-ing
+	//  one DatasetExampleOverride node.
+	DatasetExampleOverrideUpsertOne struct {
+		create *DatasetExampleOverrideCreate
+	}
+
+	// DatasetExampleOverrideUpsert is the "OnConflict" setter.
+	DatasetExampleOverrideUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDatasetID sets the "dataset_id" field.
+func (u *DatasetExampleOverrideUpsert) SetDatasetID(v string) *DatasetExampleOverrideUpsert {
+	u.Set(datasetexampleoverride.FieldDatasetID, v)
+	return u
+}
+
+// UpdateDatasetID sets the "dataset_id" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsert) UpdateDatasetID() *DatasetExampleOverrideUpsert {
+	u.SetExcluded(datasetexampleoverride.FieldDatasetID)
+	return u
+}
+
+// SetTraceID sets the "trace_id" field.
+func (u *DatasetExampleOverrideUpsert) SetTraceID(v string) *DatasetExampleOverrideUpsert {
+	u.Set(datasetexampleoverride.FieldTraceID, v)
+	return u
+}
+
+// UpdateTraceID sets the "trace_id" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsert) UpdateTraceID() *DatasetExampleOverrideUpsert {
+	u.SetExcluded(datasetexampleoverride.FieldTraceID)
+	return u
+}
+
+// SetExpectedOutput sets the "expected_output" field.
+func (u *DatasetExampleOverrideUpsert) SetExpectedOutput(v string) *DatasetExampleOverrideUpsert {
+	u.Set(datasetexampleoverride.FieldExpectedOutput, v)
+	return u
+}
+
+// UpdateExpectedOutput sets the "expected_output" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsert) UpdateExpectedOutput() *DatasetExampleOverrideUpsert {
+	u.SetExcluded(datasetexampleoverride.FieldExpectedOutput)
+	return u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (u *DatasetExampleOverrideUpsert) SetTagsJSON(v string) *DatasetExampleOverrideUpsert {
+	u.Set(datasetexampleoverride.FieldTagsJSON, v)
+	return u
+}
+
+// UpdateTagsJSON sets the "tags_json" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsert) UpdateTagsJSON() *DatasetExampleOverrideUpsert {
+	u.SetExcluded(datasetexampleoverride.FieldTagsJSON)
+	return u
+}
+
+// SetInputOverrideJSON sets the "input_override_json" field.
+func (u *DatasetExampleOverrideUpsert) SetInputOverrideJSON(v string) *DatasetExampleOverrideUpsert {
+	u.Set(datasetexampleoverride.FieldInputOverrideJSON, v)
+	return u
+}
+
+// UpdateInputOverrideJSON sets the "input_override_json" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsert) UpdateInputOverrideJSON() *DatasetExampleOverrideUpsert {
+	u.SetExcluded(datasetexampleoverride.FieldInputOverrideJSON)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *DatasetExampleOverrideUpsert) SetUpdatedBy(v string) *DatasetExampleOverrideUpsert {
+	u.Set(datasetexampleoverride.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsert) UpdateUpdatedBy() *DatasetExampleOverrideUpsert {
+	u.SetExcluded(datasetexampleoverride.FieldUpdatedBy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DatasetExampleOverrideUpsert) SetUpdatedAt(v time.Time) *DatasetExampleOverrideUpsert {
+	u.Set(datasetexampleoverride.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsert) UpdateUpdatedAt() *DatasetExampleOverrideUpsert {
+	u.SetExcluded(datasetexampleoverride.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.DatasetExampleOverride.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DatasetExampleOverrideUpsertOne) UpdateNewValues() *DatasetExampleOverrideUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DatasetExampleOverride.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DatasetExampleOverrideUpsertOne) Ignore() *DatasetExampleOverrideUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DatasetExampleOverrideUpsertOne) DoNothing() *DatasetExampleOverrideUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DatasetExampleOverrideCreate.OnConflict
+// documentation for more info.
+func (u *DatasetExampleOverrideUpsertOne) Update(set func(*DatasetExampleOverrideUpsert)) *DatasetExampleOverrideUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DatasetExampleOverrideUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDatasetID sets the "dataset_id" field.
+func (u *DatasetExampleOverrideUpsertOne) SetDatasetID(v string) *DatasetExampleOverrideUpsertOne {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.SetDatasetID(v)
+	})
+}
+
+// UpdateDatasetID sets the "dataset_id" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsertOne) UpdateDatasetID() *DatasetExampleOverrideUpsertOne {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.UpdateDatasetID()
+	})
+}
+
+// SetTraceID sets the "trace_id" field.
+func (u *DatasetExampleOverrideUpsertOne) SetTraceID(v string) *DatasetExampleOverrideUpsertOne {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.SetTraceID(v)
+	})
+}
+
+// UpdateTraceID sets the "trace_id" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsertOne) UpdateTraceID() *DatasetExampleOverrideUpsertOne {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.UpdateTraceID()
+	})
+}
+
+// SetExpectedOutput sets the "expected_output" field.
+func (u *DatasetExampleOverrideUpsertOne) SetExpectedOutput(v string) *DatasetExampleOverrideUpsertOne {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.SetExpectedOutput(v)
+	})
+}
+
+// UpdateExpectedOutput sets the "expected_output" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsertOne) UpdateExpectedOutput() *DatasetExampleOverrideUpsertOne {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.UpdateExpectedOutput()
+	})
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (u *DatasetExampleOverrideUpsertOne) SetTagsJSON(v string) *DatasetExampleOverrideUpsertOne {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.SetTagsJSON(v)
+	})
+}
+
+// UpdateTagsJSON sets the "tags_json" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsertOne) UpdateTagsJSON() *DatasetExampleOverrideUpsertOne {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.UpdateTagsJSON()
+	})
+}
+
+// SetInputOverrideJSON sets the "input_override_json" field.
+func (u *DatasetExampleOverrideUpsertOne) SetInputOverrideJSON(v string) *DatasetExampleOverrideUpsertOne {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.SetInputOverrideJSON(v)
+	})
+}
+
+// UpdateInputOverrideJSON sets the "input_override_json" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsertOne) UpdateInputOverrideJSON() *DatasetExampleOverrideUpsertOne {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.UpdateInputOverrideJSON()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *DatasetExampleOverrideUpsertOne) SetUpdatedBy(v string) *DatasetExampleOverrideUpsertOne {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsertOne) UpdateUpdatedBy() *DatasetExampleOverrideUpsertOne {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DatasetExampleOverrideUpsertOne) SetUpdatedAt(v time.Time) *DatasetExampleOverrideUpsertOne {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsertOne) UpdateUpdatedAt() *DatasetExampleOverrideUpsertOne {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DatasetExampleOverrideUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("dao: missing options for DatasetExampleOverrideCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DatasetExampleOverrideUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DatasetExampleOverrideUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DatasetExampleOverrideUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DatasetExampleOverrideCreateBulk is the builder for creating many DatasetExampleOverride entities in bulk.
+type DatasetExampleOverrideCreateBulk struct {
+	config
+	err      error
+	builders []*DatasetExampleOverrideCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DatasetExampleOverride entities in the database.
+func (_c *DatasetExampleOverrideCreateBulk) Save(ctx context.Context) ([]*DatasetExampleOverride, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DatasetExampleOverride, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DatasetExampleOverrideMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DatasetExampleOverrideCreateBulk) SaveX(ctx context.Context) []*DatasetExampleOverride {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DatasetExampleOverrideCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DatasetExampleOverrideCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DatasetExampleOverride.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DatasetExampleOverrideUpsert) {
+//			SetDatasetID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DatasetExampleOverrideCreateBulk) OnConflict(opts ...sql.ConflictOption) *DatasetExampleOverrideUpsertBulk {
+	_c.conflict = opts
+	return &DatasetExampleOverrideUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DatasetExampleOverride.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DatasetExampleOverrideCreateBulk) OnConflictColumns(columns ...string) *DatasetExampleOverrideUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DatasetExampleOverrideUpsertBulk{
+		create: _c,
+	}
+}
+
+// DatasetExampleOverrideUpsertBulk is the builder for "upsert"-ing
+// a bulk of DatasetExampleOverride nodes.
+type DatasetExampleOverrideUpsertBulk struct {
+	create *DatasetExampleOverrideCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DatasetExampleOverride.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DatasetExampleOverrideUpsertBulk) UpdateNewValues() *DatasetExampleOverrideUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DatasetExampleOverride.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DatasetExampleOverrideUpsertBulk) Ignore() *DatasetExampleOverrideUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DatasetExampleOverrideUpsertBulk) DoNothing() *DatasetExampleOverrideUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DatasetExampleOverrideCreateBulk.OnConflict
+// documentation for more info.
+func (u *DatasetExampleOverrideUpsertBulk) Update(set func(*DatasetExampleOverrideUpsert)) *DatasetExampleOverrideUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DatasetExampleOverrideUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDatasetID sets the "dataset_id" field.
+func (u *DatasetExampleOverrideUpsertBulk) SetDatasetID(v string) *DatasetExampleOverrideUpsertBulk {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.SetDatasetID(v)
+	})
+}
+
+// UpdateDatasetID sets the "dataset_id" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsertBulk) UpdateDatasetID() *DatasetExampleOverrideUpsertBulk {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.UpdateDatasetID()
+	})
+}
+
+// SetTraceID sets the "trace_id" field.
+func (u *DatasetExampleOverrideUpsertBulk) SetTraceID(v string) *DatasetExampleOverrideUpsertBulk {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.SetTraceID(v)
+	})
+}
+
+// UpdateTraceID sets the "trace_id" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsertBulk) UpdateTraceID() *DatasetExampleOverrideUpsertBulk {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.UpdateTraceID()
+	})
+}
+
+// SetExpectedOutput sets the "expected_output" field.
+func (u *DatasetExampleOverrideUpsertBulk) SetExpectedOutput(v string) *DatasetExampleOverrideUpsertBulk {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.SetExpectedOutput(v)
+	})
+}
+
+// UpdateExpectedOutput sets the "expected_output" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsertBulk) UpdateExpectedOutput() *DatasetExampleOverrideUpsertBulk {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.UpdateExpectedOutput()
+	})
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (u *DatasetExampleOverrideUpsertBulk) SetTagsJSON(v string) *DatasetExampleOverrideUpsertBulk {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.SetTagsJSON(v)
+	})
+}
+
+// UpdateTagsJSON sets the "tags_json" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsertBulk) UpdateTagsJSON() *DatasetExampleOverrideUpsertBulk {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.UpdateTagsJSON()
+	})
+}
+
+// SetInputOverrideJSON sets the "input_override_json" field.
+func (u *DatasetExampleOverrideUpsertBulk) SetInputOverrideJSON(v string) *DatasetExampleOverrideUpsertBulk {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.SetInputOverrideJSON(v)
+	})
+}
+
+// UpdateInputOverrideJSON sets the "input_override_json" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsertBulk) UpdateInputOverrideJSON() *DatasetExampleOverrideUpsertBulk {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.UpdateInputOverrideJSON()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *DatasetExampleOverrideUpsertBulk) SetUpdatedBy(v string) *DatasetExampleOverrideUpsertBulk {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsertBulk) UpdateUpdatedBy() *DatasetExampleOverrideUpsertBulk {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DatasetExampleOverrideUpsertBulk) SetUpdatedAt(v time.Time) *DatasetExampleOverrideUpsertBulk {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DatasetExampleOverrideUpsertBulk) UpdateUpdatedAt() *DatasetExampleOverrideUpsertBulk {
+	return u.Update(func(s *DatasetExampleOverrideUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DatasetExampleOverrideUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("dao: OnConflict was set for builder %d. Set it on the DatasetExampleOverrideCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("dao: missing options for DatasetExampleOverrideCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DatasetExampleOverrideUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dao
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetexampleoverride"
+	"github.com/kingfs/llm-tracelab/ent/dao/internal"
+	"github.com/kingfs/llm-tracelab/ent/dao/predicate"
+)
+
+// DatasetExampleOverrideDelete is the builder for deleting a DatasetExampleOverride entity.
+type DatasetExampleOverrideDelete struct {
+	config
+	hooks    []Hook
+	mutation *DatasetExampleOverrideMutation
+}
+
+// Where appends a list predicates to the DatasetExampleOverrideDelete builder.
+func (_d *DatasetExampleOverrideDelete) Where(ps ...predicate.DatasetExampleOverride) *DatasetExampleOverrideDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *DatasetExampleOverrideDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DatasetExampleOverrideDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *DatasetExampleOverrideDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(datasetexampleoverride.Table, sqlgraph.NewFieldSpec(datasetexampleoverride.FieldID, field.TypeInt))
+	_spec.Node.Schema = _d.schemaConfig.DatasetExampleOverride
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// DatasetExampleOverrideDeleteOne is the builder for deleting a single DatasetExampleOverride entity.
+type DatasetExampleOverrideDeleteOne struct {
+	_d *DatasetExampleOverrideDelete
+}
+
+// Where appends a list predicates to the DatasetExampleOverrideDelete builder.
+func (_d *DatasetExampleOverrideDeleteOne) Where(ps ...predicate.DatasetExampleOverride) *DatasetExampleOverrideDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *DatasetExampleOverrideDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{datasetexampleoverride.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DatasetExampleOverrideDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
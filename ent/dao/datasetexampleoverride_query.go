@@ -0,0 +1,585 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetexampleoverride"
+	"github.com/kingfs/llm-tracelab/ent/dao/internal"
+	"github.com/kingfs/llm-tracelab/ent/dao/predicate"
+)
+
+// DatasetExampleOverrideQuery is the builder for querying DatasetExampleOverride entities.
+type DatasetExampleOverrideQuery struct {
+	config
+	ctx        *QueryContext
+	order      []datasetexampleoverride.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DatasetExampleOverride
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DatasetExampleOverrideQuery builder.
+func (_q *DatasetExampleOverrideQuery) Where(ps ...predicate.DatasetExampleOverride) *DatasetExampleOverrideQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *DatasetExampleOverrideQuery) Limit(limit int) *DatasetExampleOverrideQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *DatasetExampleOverrideQuery) Offset(offset int) *DatasetExampleOverrideQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *DatasetExampleOverrideQuery) Unique(unique bool) *DatasetExampleOverrideQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *DatasetExampleOverrideQuery) Order(o ...datasetexampleoverride.OrderOption) *DatasetExampleOverrideQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// First returns the first DatasetExampleOverride entity from the query.
+// Returns a *NotFoundError when no DatasetExampleOverride was found.
+func (_q *DatasetExampleOverrideQuery) First(ctx context.Context) (*DatasetExampleOverride, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{datasetexampleoverride.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *DatasetExampleOverrideQuery) FirstX(ctx context.Context) *DatasetExampleOverride {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DatasetExampleOverride ID from the query.
+// Returns a *NotFoundError when no DatasetExampleOverride ID was found.
+func (_q *DatasetExampleOverrideQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{datasetexampleoverride.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *DatasetExampleOverrideQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DatasetExampleOverride entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DatasetExampleOverride entity is found.
+// Returns a *NotFoundError when no DatasetExampleOverride entities are found.
+func (_q *DatasetExampleOverrideQuery) Only(ctx context.Context) (*DatasetExampleOverride, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{datasetexampleoverride.Label}
+	default:
+		return nil, &NotSingularError{datasetexampleoverride.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *DatasetExampleOverrideQuery) OnlyX(ctx context.Context) *DatasetExampleOverride {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DatasetExampleOverride ID in the query.
+// Returns a *NotSingularError when more than one DatasetExampleOverride ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *DatasetExampleOverrideQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{datasetexampleoverride.Label}
+	default:
+		err = &NotSingularError{datasetexampleoverride.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *DatasetExampleOverrideQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DatasetExampleOverrides.
+func (_q *DatasetExampleOverrideQuery) All(ctx context.Context) ([]*DatasetExampleOverride, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DatasetExampleOverride, *DatasetExampleOverrideQuery]()
+	return withInterceptors[[]*DatasetExampleOverride](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *DatasetExampleOverrideQuery) AllX(ctx context.Context) []*DatasetExampleOverride {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DatasetExampleOverride IDs.
+func (_q *DatasetExampleOverrideQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(datasetexampleoverride.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *DatasetExampleOverrideQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *DatasetExampleOverrideQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*DatasetExampleOverrideQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *DatasetExampleOverrideQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *DatasetExampleOverrideQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("dao: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *DatasetExampleOverrideQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DatasetExampleOverrideQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *DatasetExampleOverrideQuery) Clone() *DatasetExampleOverrideQuery {
+	if _q == nil {
+		return nil
+	}
+	return &DatasetExampleOverrideQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]datasetexampleoverride.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.DatasetExampleOverride{}, _q.predicates...),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		DatasetID string `json:"dataset_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DatasetExampleOverride.Query().
+//		GroupBy(datasetexampleoverride.FieldDatasetID).
+//		Aggregate(dao.Count()).
+//		Scan(ctx, &v)
+func (_q *DatasetExampleOverrideQuery) GroupBy(field string, fields ...string) *DatasetExampleOverrideGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DatasetExampleOverrideGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = datasetexampleoverride.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		DatasetID string `json:"dataset_id,omitempty"`
+//	}
+//
+//	client.DatasetExampleOverride.Query().
+//		Select(datasetexampleoverride.FieldDatasetID).
+//		Scan(ctx, &v)
+func (_q *DatasetExampleOverrideQuery) Select(fields ...string) *DatasetExampleOverrideSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &DatasetExampleOverrideSelect{DatasetExampleOverrideQuery: _q}
+	sbuild.label = datasetexampleoverride.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DatasetExampleOverrideSelect configured with the given aggregations.
+func (_q *DatasetExampleOverrideQuery) Aggregate(fns ...AggregateFunc) *DatasetExampleOverrideSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *DatasetExampleOverrideQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("dao: uninitialized interceptor (forgotten import dao/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !datasetexampleoverride.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("dao: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *DatasetExampleOverrideQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DatasetExampleOverride, error) {
+	var (
+		nodes = []*DatasetExampleOverride{}
+		_spec = _q.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DatasetExampleOverride).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DatasetExampleOverride{config: _q.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = _q.schemaConfig.DatasetExampleOverride
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (_q *DatasetExampleOverrideQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Schema = _q.schemaConfig.DatasetExampleOverride
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *DatasetExampleOverrideQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(datasetexampleoverride.Table, datasetexampleoverride.Columns, sqlgraph.NewFieldSpec(datasetexampleoverride.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, datasetexampleoverride.FieldID)
+		for i := range fields {
+			if fields[i] != datasetexampleoverride.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *DatasetExampleOverrideQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(datasetexampleoverride.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = datasetexampleoverride.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(_q.schemaConfig.DatasetExampleOverride)
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *DatasetExampleOverrideQuery) ForUpdate(opts ...sql.LockOption) *DatasetExampleOverrideQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *DatasetExampleOverrideQuery) ForShare(opts ...sql.LockOption) *DatasetExampleOverrideQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *DatasetExampleOverrideQuery) Modify(modifiers ...func(s *sql.Selector)) *DatasetExampleOverrideSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// DatasetExampleOverrideGroupBy is the group-by builder for DatasetExampleOverride entities.
+type DatasetExampleOverrideGroupBy struct {
+	selector
+	build *DatasetExampleOverrideQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *DatasetExampleOverrideGroupBy) Aggregate(fns ...AggregateFunc) *DatasetExampleOverrideGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *DatasetExampleOverrideGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DatasetExampleOverrideQuery, *DatasetExampleOverrideGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *DatasetExampleOverrideGroupBy) sqlScan(ctx context.Context, root *DatasetExampleOverrideQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DatasetExampleOverrideSelect is the builder for selecting fields of DatasetExampleOverride entities.
+type DatasetExampleOverrideSelect struct {
+	*DatasetExampleOverrideQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *DatasetExampleOverrideSelect) Aggregate(fns ...AggregateFunc) *DatasetExampleOverrideSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *DatasetExampleOverrideSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DatasetExampleOverrideQuery, *DatasetExampleOverrideSelect](ctx, _s.DatasetExampleOverrideQuery, _s, _s.inters, v)
+}
+
+func (_s *DatasetExampleOverrideSelect) sqlScan(ctx context.Context, root *DatasetExampleOverrideQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *DatasetExampleOverrideSelect) Modify(modifiers ...func(s *sql.Selector)) *DatasetExampleOverrideSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
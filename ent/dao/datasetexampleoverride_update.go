@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetexampleoverride"
+	"github.com/kingfs/llm-tracelab/ent/dao/internal"
+	"github.com/kingfs/llm-tracelab/ent/dao/predicate"
+)
+
+// DatasetExampleOverrideUpdate is the builder for updating DatasetExampleOverride entities.
+type DatasetExampleOverrideUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DatasetExampleOverrideMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DatasetExampleOverrideUpdate builder.
+func (_u *DatasetExampleOverrideUpdate) Where(ps ...predicate.DatasetExampleOverride) *DatasetExampleOverrideUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDatasetID sets the "dataset_id" field.
+func (_u *DatasetExampleOverrideUpdate) SetDatasetID(v string) *DatasetExampleOverrideUpdate {
+	_u.mutation.SetDatasetID(v)
+	return _u
+}
+
+// SetNillableDatasetID sets the "dataset_id" field if the given value is not nil.
+func (_u *DatasetExampleOverrideUpdate) SetNillableDatasetID(v *string) *DatasetExampleOverrideUpdate {
+	if v != nil {
+		_u.SetDatasetID(*v)
+	}
+	return _u
+}
+
+// SetTraceID sets the "trace_id" field.
+func (_u *DatasetExampleOverrideUpdate) SetTraceID(v string) *DatasetExampleOverrideUpdate {
+	_u.mutation.SetTraceID(v)
+	return _u
+}
+
+// SetNillableTraceID sets the "trace_id" field if the given value is not nil.
+func (_u *DatasetExampleOverrideUpdate) SetNillableTraceID(v *string) *DatasetExampleOverrideUpdate {
+	if v != nil {
+		_u.SetTraceID(*v)
+	}
+	return _u
+}
+
+// SetExpectedOutput sets the "expected_output" field.
+func (_u *DatasetExampleOverrideUpdate) SetExpectedOutput(v string) *DatasetExampleOverrideUpdate {
+	_u.mutation.SetExpectedOutput(v)
+	return _u
+}
+
+// SetNillableExpectedOutput sets the "expected_output" field if the given value is not nil.
+func (_u *DatasetExampleOverrideUpdate) SetNillableExpectedOutput(v *string) *DatasetExampleOverrideUpdate {
+	if v != nil {
+		_u.SetExpectedOutput(*v)
+	}
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *DatasetExampleOverrideUpdate) SetTagsJSON(v string) *DatasetExampleOverrideUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// SetNillableTagsJSON sets the "tags_json" field if the given value is not nil.
+func (_u *DatasetExampleOverrideUpdate) SetNillableTagsJSON(v *string) *DatasetExampleOverrideUpdate {
+	if v != nil {
+		_u.SetTagsJSON(*v)
+	}
+	return _u
+}
+
+// SetInputOverrideJSON sets the "input_override_json" field.
+func (_u *DatasetExampleOverrideUpdate) SetInputOverrideJSON(v string) *DatasetExampleOverrideUpdate {
+	_u.mutation.SetInputOverrideJSON(v)
+	return _u
+}
+
+// SetNillableInputOverrideJSON sets the "input_override_json" field if the given value is not nil.
+func (_u *DatasetExampleOverrideUpdate) SetNillableInputOverrideJSON(v *string) *DatasetExampleOverrideUpdate {
+	if v != nil {
+		_u.SetInputOverrideJSON(*v)
+	}
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *DatasetExampleOverrideUpdate) SetUpdatedBy(v string) *DatasetExampleOverrideUpdate {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *DatasetExampleOverrideUpdate) SetNillableUpdatedBy(v *string) *DatasetExampleOverrideUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DatasetExampleOverrideUpdate) SetUpdatedAt(v time.Time) *DatasetExampleOverrideUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *DatasetExampleOverrideUpdate) SetNillableUpdatedAt(v *time.Time) *DatasetExampleOverrideUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DatasetExampleOverrideMutation object of the builder.
+func (_u *DatasetExampleOverrideUpdate) Mutation() *DatasetExampleOverrideMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DatasetExampleOverrideUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DatasetExampleOverrideUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DatasetExampleOverrideUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DatasetExampleOverrideUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DatasetExampleOverrideUpdate) check() error {
+	if v, ok := _u.mutation.DatasetID(); ok {
+		if err := datasetexampleoverride.DatasetIDValidator(v); err != nil {
+			return &ValidationError{Name: "dataset_id", err: fmt.Errorf(`dao: validator failed for field "DatasetExampleOverride.dataset_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TraceID(); ok {
+		if err := datasetexampleoverride.TraceIDValidator(v); err != nil {
+			return &ValidationError{Name: "trace_id", err: fmt.Errorf(`dao: validator failed for field "DatasetExampleOverride.trace_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DatasetExampleOverrideUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DatasetExampleOverrideUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DatasetExampleOverrideUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(datasetexampleoverride.Table, datasetexampleoverride.Columns, sqlgraph.NewFieldSpec(datasetexampleoverride.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DatasetID(); ok {
+		_spec.SetField(datasetexampleoverride.FieldDatasetID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TraceID(); ok {
+		_spec.SetField(datasetexampleoverride.FieldTraceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpectedOutput(); ok {
+		_spec.SetField(datasetexampleoverride.FieldExpectedOutput, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(datasetexampleoverride.FieldTagsJSON, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InputOverrideJSON(); ok {
+		_spec.SetField(datasetexampleoverride.FieldInputOverrideJSON, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(datasetexampleoverride.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(datasetexampleoverride.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.DatasetExampleOverride
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{datasetexampleoverride.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DatasetExampleOverrideUpdateOne is the builder for updating a single DatasetExampleOverride entity.
+type DatasetExampleOverrideUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DatasetExampleOverrideMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDatasetID sets the "dataset_id" field.
+func (_u *DatasetExampleOverrideUpdateOne) SetDatasetID(v string) *DatasetExampleOverrideUpdateOne {
+	_u.mutation.SetDatasetID(v)
+	return _u
+}
+
+// SetNillableDatasetID sets the "dataset_id" field if the given value is not nil.
+func (_u *DatasetExampleOverrideUpdateOne) SetNillableDatasetID(v *string) *DatasetExampleOverrideUpdateOne {
+	if v != nil {
+		_u.SetDatasetID(*v)
+	}
+	return _u
+}
+
+// SetTraceID sets the "trace_id" field.
+func (_u *DatasetExampleOverrideUpdateOne) SetTraceID(v string) *DatasetExampleOverrideUpdateOne {
+	_u.mutation.SetTraceID(v)
+	return _u
+}
+
+// SetNillableTraceID sets the "trace_id" field if the given value is not nil.
+func (_u *DatasetExampleOverrideUpdateOne) SetNillableTraceID(v *string) *DatasetExampleOverrideUpdateOne {
+	if v != nil {
+		_u.SetTraceID(*v)
+	}
+	return _u
+}
+
+// SetExpectedOutput sets the "expected_output" field.
+func (_u *DatasetExampleOverrideUpdateOne) SetExpectedOutput(v string) *DatasetExampleOverrideUpdateOne {
+	_u.mutation.SetExpectedOutput(v)
+	return _u
+}
+
+// SetNillableExpectedOutput sets the "expected_output" field if the given value is not nil.
+func (_u *DatasetExampleOverrideUpdateOne) SetNillableExpectedOutput(v *string) *DatasetExampleOverrideUpdateOne {
+	if v != nil {
+		_u.SetExpectedOutput(*v)
+	}
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *DatasetExampleOverrideUpdateOne) SetTagsJSON(v string) *DatasetExampleOverrideUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// SetNillableTagsJSON sets the "tags_json" field if the given value is not nil.
+func (_u *DatasetExampleOverrideUpdateOne) SetNillableTagsJSON(v *string) *DatasetExampleOverrideUpdateOne {
+	if v != nil {
+		_u.SetTagsJSON(*v)
+	}
+	return _u
+}
+
+// SetInputOverrideJSON sets the "input_override_json" field.
+func (_u *DatasetExampleOverrideUpdateOne) SetInputOverrideJSON(v string) *DatasetExampleOverrideUpdateOne {
+	_u.mutation.SetInputOverrideJSON(v)
+	return _u
+}
+
+// SetNillableInputOverrideJSON sets the "input_override_json" field if the given value is not nil.
+func (_u *DatasetExampleOverrideUpdateOne) SetNillableInputOverrideJSON(v *string) *DatasetExampleOverrideUpdateOne {
+	if v != nil {
+		_u.SetInputOverrideJSON(*v)
+	}
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *DatasetExampleOverrideUpdateOne) SetUpdatedBy(v string) *DatasetExampleOverrideUpdateOne {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *DatasetExampleOverrideUpdateOne) SetNillableUpdatedBy(v *string) *DatasetExampleOverrideUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DatasetExampleOverrideUpdateOne) SetUpdatedAt(v time.Time) *DatasetExampleOverrideUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *DatasetExampleOverrideUpdateOne) SetNillableUpdatedAt(v *time.Time) *DatasetExampleOverrideUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DatasetExampleOverrideMutation object of the builder.
+func (_u *DatasetExampleOverrideUpdateOne) Mutation() *DatasetExampleOverrideMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DatasetExampleOverrideUpdate builder.
+func (_u *DatasetExampleOverrideUpdateOne) Where(ps ...predicate.DatasetExampleOverride) *DatasetExampleOverrideUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DatasetExampleOverrideUpdateOne) Select(field string, fields ...string) *DatasetExampleOverrideUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DatasetExampleOverride entity.
+func (_u *DatasetExampleOverrideUpdateOne) Save(ctx context.Context) (*DatasetExampleOverride, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DatasetExampleOverrideUpdateOne) SaveX(ctx context.Context) *DatasetExampleOverride {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DatasetExampleOverrideUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DatasetExampleOverrideUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DatasetExampleOverrideUpdateOne) check() error {
+	if v, ok := _u.mutation.DatasetID(); ok {
+		if err := datasetexampleoverride.DatasetIDValidator(v); err != nil {
+			return &ValidationError{Name: "dataset_id", err: fmt.Errorf(`dao: validator failed for field "DatasetExampleOverride.dataset_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TraceID(); ok {
+		if err := datasetexampleoverride.TraceIDValidator(v); err != nil {
+			return &ValidationError{Name: "trace_id", err: fmt.Errorf(`dao: validator failed for field "DatasetExampleOverride.trace_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DatasetExampleOverrideUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DatasetExampleOverrideUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DatasetExampleOverrideUpdateOne) sqlSave(ctx context.Context) (_node *DatasetExampleOverride, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(datasetexampleoverride.Table, datasetexampleoverride.Columns, sqlgraph.NewFieldSpec(datasetexampleoverride.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`dao: missing "DatasetExampleOverride.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, datasetexampleoverride.FieldID)
+		for _, f := range fields {
+			if !datasetexampleoverride.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("dao: invalid field %q for query", f)}
+			}
+			if f != datasetexampleoverride.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DatasetID(); ok {
+		_spec.SetField(datasetexampleoverride.FieldDatasetID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TraceID(); ok {
+		_spec.SetField(datasetexampleoverride.FieldTraceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpectedOutput(); ok {
+		_spec.SetField(datasetexampleoverride.FieldExpectedOutput, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(datasetexampleoverride.FieldTagsJSON, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InputOverrideJSON(); ok {
+		_spec.SetField(datasetexampleoverride.FieldInputOverrideJSON, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(datasetexampleoverride.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(datasetexampleoverride.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.DatasetExampleOverride
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &DatasetExampleOverride{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{datasetexampleoverride.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dao
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetsnapshot"
+)
+
+// DatasetSnapshot is the model entity for the DatasetSnapshot schema.
+type DatasetSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// DatasetID holds the value of the "dataset_id" field.
+	DatasetID string `json:"dataset_id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// ExampleCount holds the value of the "example_count" field.
+	ExampleCount int `json:"example_count,omitempty"`
+	// ExamplesJSON holds the value of the "examples_json" field.
+	ExamplesJSON string `json:"examples_json,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DatasetSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case datasetsnapshot.FieldVersion, datasetsnapshot.FieldExampleCount:
+			values[i] = new(sql.NullInt64)
+		case datasetsnapshot.FieldID, datasetsnapshot.FieldDatasetID, datasetsnapshot.FieldNote, datasetsnapshot.FieldCreatedBy, datasetsnapshot.FieldExamplesJSON:
+			values[i] = new(sql.NullString)
+		case datasetsnapshot.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DatasetSnapshot fields.
+func (_m *DatasetSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case datasetsnapshot.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case datasetsnapshot.FieldDatasetID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dataset_id", values[i])
+			} else if value.Valid {
+				_m.DatasetID = value.String
+			}
+		case datasetsnapshot.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case datasetsnapshot.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case datasetsnapshot.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case datasetsnapshot.FieldExampleCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field example_count", values[i])
+			} else if value.Valid {
+				_m.ExampleCount = int(value.Int64)
+			}
+		case datasetsnapshot.FieldExamplesJSON:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field examples_json", values[i])
+			} else if value.Valid {
+				_m.ExamplesJSON = value.String
+			}
+		case datasetsnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DatasetSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (_m *DatasetSnapshot) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DatasetSnapshot.
+// Note that you need to call DatasetSnapshot.Unwrap() before calling this method if this DatasetSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DatasetSnapshot) Update() *DatasetSnapshotUpdateOne {
+	return NewDatasetSnapshotClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DatasetSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DatasetSnapshot) Unwrap() *DatasetSnapshot {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("dao: DatasetSnapshot is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DatasetSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("DatasetSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("dataset_id=")
+	builder.WriteString(_m.DatasetID)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("example_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExampleCount))
+	builder.WriteString(", ")
+	builder.WriteString("examples_json=")
+	builder.WriteString(_m.ExamplesJSON)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DatasetSnapshots is a parsable slice of DatasetSnapshot.
+type DatasetSnapshots []*DatasetSnapshot
@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package datasetsnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the datasetsnapshot type in the database.
+	Label = "dataset_snapshot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDatasetID holds the string denoting the dataset_id field in the database.
+	FieldDatasetID = "dataset_id"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldExampleCount holds the string denoting the example_count field in the database.
+	FieldExampleCount = "example_count"
+	// FieldExamplesJSON holds the string denoting the examples_json field in the database.
+	FieldExamplesJSON = "examples_json"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the datasetsnapshot in the database.
+	Table = "dataset_snapshots"
+)
+
+// Columns holds all SQL columns for datasetsnapshot fields.
+var Columns = []string{
+	FieldID,
+	FieldDatasetID,
+	FieldVersion,
+	FieldNote,
+	FieldCreatedBy,
+	FieldExampleCount,
+	FieldExamplesJSON,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DatasetIDValidator is a validator for the "dataset_id" field. It is called by the builders before save.
+	DatasetIDValidator func(string) error
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+	// DefaultNote holds the default value on creation for the "note" field.
+	DefaultNote string
+	// DefaultCreatedBy holds the default value on creation for the "created_by" field.
+	DefaultCreatedBy string
+	// DefaultExampleCount holds the default value on creation for the "example_count" field.
+	DefaultExampleCount int
+	// DefaultExamplesJSON holds the default value on creation for the "examples_json" field.
+	DefaultExamplesJSON string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the DatasetSnapshot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDatasetID orders the results by the dataset_id field.
+func ByDatasetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDatasetID, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByExampleCount orders the results by the example_count field.
+func ByExampleCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExampleCount, opts...).ToFunc()
+}
+
+// ByExamplesJSON orders the results by the examples_json field.
+func ByExamplesJSON(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExamplesJSON, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
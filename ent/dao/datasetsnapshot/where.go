@@ -0,0 +1,495 @@
+// Code generated by ent, DO NOT EDIT.
+
+package datasetsnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kingfs/llm-tracelab/ent/dao/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldContainsFold(FieldID, id))
+}
+
+// DatasetID applies equality check predicate on the "dataset_id" field. It's identical to DatasetIDEQ.
+func DatasetID(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEQ(FieldDatasetID, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEQ(FieldVersion, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// ExampleCount applies equality check predicate on the "example_count" field. It's identical to ExampleCountEQ.
+func ExampleCount(v int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEQ(FieldExampleCount, v))
+}
+
+// ExamplesJSON applies equality check predicate on the "examples_json" field. It's identical to ExamplesJSONEQ.
+func ExamplesJSON(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEQ(FieldExamplesJSON, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DatasetIDEQ applies the EQ predicate on the "dataset_id" field.
+func DatasetIDEQ(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEQ(FieldDatasetID, v))
+}
+
+// DatasetIDNEQ applies the NEQ predicate on the "dataset_id" field.
+func DatasetIDNEQ(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldNEQ(FieldDatasetID, v))
+}
+
+// DatasetIDIn applies the In predicate on the "dataset_id" field.
+func DatasetIDIn(vs ...string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldIn(FieldDatasetID, vs...))
+}
+
+// DatasetIDNotIn applies the NotIn predicate on the "dataset_id" field.
+func DatasetIDNotIn(vs ...string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldNotIn(FieldDatasetID, vs...))
+}
+
+// DatasetIDGT applies the GT predicate on the "dataset_id" field.
+func DatasetIDGT(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldGT(FieldDatasetID, v))
+}
+
+// DatasetIDGTE applies the GTE predicate on the "dataset_id" field.
+func DatasetIDGTE(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldGTE(FieldDatasetID, v))
+}
+
+// DatasetIDLT applies the LT predicate on the "dataset_id" field.
+func DatasetIDLT(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldLT(FieldDatasetID, v))
+}
+
+// DatasetIDLTE applies the LTE predicate on the "dataset_id" field.
+func DatasetIDLTE(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldLTE(FieldDatasetID, v))
+}
+
+// DatasetIDContains applies the Contains predicate on the "dataset_id" field.
+func DatasetIDContains(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldContains(FieldDatasetID, v))
+}
+
+// DatasetIDHasPrefix applies the HasPrefix predicate on the "dataset_id" field.
+func DatasetIDHasPrefix(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldHasPrefix(FieldDatasetID, v))
+}
+
+// DatasetIDHasSuffix applies the HasSuffix predicate on the "dataset_id" field.
+func DatasetIDHasSuffix(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldHasSuffix(FieldDatasetID, v))
+}
+
+// DatasetIDEqualFold applies the EqualFold predicate on the "dataset_id" field.
+func DatasetIDEqualFold(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEqualFold(FieldDatasetID, v))
+}
+
+// DatasetIDContainsFold applies the ContainsFold predicate on the "dataset_id" field.
+func DatasetIDContainsFold(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldContainsFold(FieldDatasetID, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldLTE(FieldVersion, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldContainsFold(FieldNote, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// ExampleCountEQ applies the EQ predicate on the "example_count" field.
+func ExampleCountEQ(v int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEQ(FieldExampleCount, v))
+}
+
+// ExampleCountNEQ applies the NEQ predicate on the "example_count" field.
+func ExampleCountNEQ(v int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldNEQ(FieldExampleCount, v))
+}
+
+// ExampleCountIn applies the In predicate on the "example_count" field.
+func ExampleCountIn(vs ...int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldIn(FieldExampleCount, vs...))
+}
+
+// ExampleCountNotIn applies the NotIn predicate on the "example_count" field.
+func ExampleCountNotIn(vs ...int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldNotIn(FieldExampleCount, vs...))
+}
+
+// ExampleCountGT applies the GT predicate on the "example_count" field.
+func ExampleCountGT(v int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldGT(FieldExampleCount, v))
+}
+
+// ExampleCountGTE applies the GTE predicate on the "example_count" field.
+func ExampleCountGTE(v int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldGTE(FieldExampleCount, v))
+}
+
+// ExampleCountLT applies the LT predicate on the "example_count" field.
+func ExampleCountLT(v int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldLT(FieldExampleCount, v))
+}
+
+// ExampleCountLTE applies the LTE predicate on the "example_count" field.
+func ExampleCountLTE(v int) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldLTE(FieldExampleCount, v))
+}
+
+// ExamplesJSONEQ applies the EQ predicate on the "examples_json" field.
+func ExamplesJSONEQ(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEQ(FieldExamplesJSON, v))
+}
+
+// ExamplesJSONNEQ applies the NEQ predicate on the "examples_json" field.
+func ExamplesJSONNEQ(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldNEQ(FieldExamplesJSON, v))
+}
+
+// ExamplesJSONIn applies the In predicate on the "examples_json" field.
+func ExamplesJSONIn(vs ...string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldIn(FieldExamplesJSON, vs...))
+}
+
+// ExamplesJSONNotIn applies the NotIn predicate on the "examples_json" field.
+func ExamplesJSONNotIn(vs ...string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldNotIn(FieldExamplesJSON, vs...))
+}
+
+// ExamplesJSONGT applies the GT predicate on the "examples_json" field.
+func ExamplesJSONGT(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldGT(FieldExamplesJSON, v))
+}
+
+// ExamplesJSONGTE applies the GTE predicate on the "examples_json" field.
+func ExamplesJSONGTE(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldGTE(FieldExamplesJSON, v))
+}
+
+// ExamplesJSONLT applies the LT predicate on the "examples_json" field.
+func ExamplesJSONLT(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldLT(FieldExamplesJSON, v))
+}
+
+// ExamplesJSONLTE applies the LTE predicate on the "examples_json" field.
+func ExamplesJSONLTE(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldLTE(FieldExamplesJSON, v))
+}
+
+// ExamplesJSONContains applies the Contains predicate on the "examples_json" field.
+func ExamplesJSONContains(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldContains(FieldExamplesJSON, v))
+}
+
+// ExamplesJSONHasPrefix applies the HasPrefix predicate on the "examples_json" field.
+func ExamplesJSONHasPrefix(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldHasPrefix(FieldExamplesJSON, v))
+}
+
+// ExamplesJSONHasSuffix applies the HasSuffix predicate on the "examples_json" field.
+func ExamplesJSONHasSuffix(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldHasSuffix(FieldExamplesJSON, v))
+}
+
+// ExamplesJSONEqualFold applies the EqualFold predicate on the "examples_json" field.
+func ExamplesJSONEqualFold(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEqualFold(FieldExamplesJSON, v))
+}
+
+// ExamplesJSONContainsFold applies the ContainsFold predicate on the "examples_json" field.
+func ExamplesJSONContainsFold(v string) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldContainsFold(FieldExamplesJSON, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DatasetSnapshot) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DatasetSnapshot) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DatasetSnapshot) predicate.DatasetSnapshot {
+	return predicate.DatasetSnapshot(sql.NotPredicates(p))
+}
@@ -0,0 +1,903 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetsnapshot"
+)
+
+// DatasetSnapshotCreate is the builder for creating a DatasetSnapshot entity.
+type DatasetSnapshotCreate struct {
+	config
+	mutation *DatasetSnapshotMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetDatasetID sets the "dataset_id" field.
+func (_c *DatasetSnapshotCreate) SetDatasetID(v string) *DatasetSnapshotCreate {
+	_c.mutation.SetDatasetID(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *DatasetSnapshotCreate) SetVersion(v int) *DatasetSnapshotCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *DatasetSnapshotCreate) SetNillableVersion(v *int) *DatasetSnapshotCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *DatasetSnapshotCreate) SetNote(v string) *DatasetSnapshotCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *DatasetSnapshotCreate) SetNillableNote(v *string) *DatasetSnapshotCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *DatasetSnapshotCreate) SetCreatedBy(v string) *DatasetSnapshotCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *DatasetSnapshotCreate) SetNillableCreatedBy(v *string) *DatasetSnapshotCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetExampleCount sets the "example_count" field.
+func (_c *DatasetSnapshotCreate) SetExampleCount(v int) *DatasetSnapshotCreate {
+	_c.mutation.SetExampleCount(v)
+	return _c
+}
+
+// SetNillableExampleCount sets the "example_count" field if the given value is not nil.
+func (_c *DatasetSnapshotCreate) SetNillableExampleCount(v *int) *DatasetSnapshotCreate {
+	if v != nil {
+		_c.SetExampleCount(*v)
+	}
+	return _c
+}
+
+// SetExamplesJSON sets the "examples_json" field.
+func (_c *DatasetSnapshotCreate) SetExamplesJSON(v string) *DatasetSnapshotCreate {
+	_c.mutation.SetExamplesJSON(v)
+	return _c
+}
+
+// SetNillableExamplesJSON sets the "examples_json" field if the given value is not nil.
+func (_c *DatasetSnapshotCreate) SetNillableExamplesJSON(v *string) *DatasetSnapshotCreate {
+	if v != nil {
+		_c.SetExamplesJSON(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DatasetSnapshotCreate) SetCreatedAt(v time.Time) *DatasetSnapshotCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DatasetSnapshotCreate) SetNillableCreatedAt(v *time.Time) *DatasetSnapshotCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DatasetSnapshotCreate) SetID(v string) *DatasetSnapshotCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the DatasetSnapshotMutation object of the builder.
+func (_c *DatasetSnapshotCreate) Mutation() *DatasetSnapshotMutation {
+	return _c.mutation
+}
+
+// Save creates the DatasetSnapshot in the database.
+func (_c *DatasetSnapshotCreate) Save(ctx context.Context) (*DatasetSnapshot, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DatasetSnapshotCreate) SaveX(ctx context.Context) *DatasetSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DatasetSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DatasetSnapshotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DatasetSnapshotCreate) defaults() {
+	if _, ok := _c.mutation.Version(); !ok {
+		v := datasetsnapshot.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		v := datasetsnapshot.DefaultNote
+		_c.mutation.SetNote(v)
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		v := datasetsnapshot.DefaultCreatedBy
+		_c.mutation.SetCreatedBy(v)
+	}
+	if _, ok := _c.mutation.ExampleCount(); !ok {
+		v := datasetsnapshot.DefaultExampleCount
+		_c.mutation.SetExampleCount(v)
+	}
+	if _, ok := _c.mutation.ExamplesJSON(); !ok {
+		v := datasetsnapshot.DefaultExamplesJSON
+		_c.mutation.SetExamplesJSON(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := datasetsnapshot.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DatasetSnapshotCreate) check() error {
+	if _, ok := _c.mutation.DatasetID(); !ok {
+		return &ValidationError{Name: "dataset_id", err: errors.New(`dao: missing required field "DatasetSnapshot.dataset_id"`)}
+	}
+	if v, ok := _c.mutation.DatasetID(); ok {
+		if err := datasetsnapshot.DatasetIDValidator(v); err != nil {
+			return &ValidationError{Name: "dataset_id", err: fmt.Errorf(`dao: validator failed for field "DatasetSnapshot.dataset_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`dao: missing required field "DatasetSnapshot.version"`)}
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		return &ValidationError{Name: "note", err: errors.New(`dao: missing required field "DatasetSnapshot.note"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`dao: missing required field "DatasetSnapshot.created_by"`)}
+	}
+	if _, ok := _c.mutation.ExampleCount(); !ok {
+		return &ValidationError{Name: "example_count", err: errors.New(`dao: missing required field "DatasetSnapshot.example_count"`)}
+	}
+	if _, ok := _c.mutation.ExamplesJSON(); !ok {
+		return &ValidationError{Name: "examples_json", err: errors.New(`dao: missing required field "DatasetSnapshot.examples_json"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`dao: missing required field "DatasetSnapshot.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := datasetsnapshot.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`dao: validator failed for field "DatasetSnapshot.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *DatasetSnapshotCreate) sqlSave(ctx context.Context) (*DatasetSnapshot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DatasetSnapshot.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DatasetSnapshotCreate) createSpec() (*DatasetSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DatasetSnapshot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(datasetsnapshot.Table, sqlgraph.NewFieldSpec(datasetsnapshot.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.DatasetSnapshot
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.DatasetID(); ok {
+		_spec.SetField(datasetsnapshot.FieldDatasetID, field.TypeString, value)
+		_node.DatasetID = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(datasetsnapshot.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(datasetsnapshot.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(datasetsnapshot.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.ExampleCount(); ok {
+		_spec.SetField(datasetsnapshot.FieldExampleCount, field.TypeInt, value)
+		_node.ExampleCount = value
+	}
+	if value, ok := _c.mutation.ExamplesJSON(); ok {
+		_spec.SetField(datasetsnapshot.FieldExamplesJSON, field.TypeString, value)
+		_node.ExamplesJSON = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(datasetsnapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DatasetSnapshot.Create().
+//		SetDatasetID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DatasetSnapshotUpsert) {
+//			SetDatasetID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DatasetSnapshotCreate) OnConflict(opts ...sql.ConflictOption) *DatasetSnapshotUpsertOne {
+	_c.conflict = opts
+	return &DatasetSnapshotUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DatasetSnapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DatasetSnapshotCreate) OnConflictColumns(columns ...string) *DatasetSnapshotUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DatasetSnapshotUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DatasetSnapshotUpsertOne is the builder for "upsert"-ing
+	//  one DatasetSnapshot node.
+	DatasetSnapshotUpsertOne struct {
+		create *DatasetSnapshotCreate
+	}
+
+	// DatasetSnapshotUpsert is the "OnConflict" setter.
+	DatasetSnapshotUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDatasetID sets the "dataset_id" field.
+func (u *DatasetSnapshotUpsert) SetDatasetID(v string) *DatasetSnapshotUpsert {
+	u.Set(datasetsnapshot.FieldDatasetID, v)
+	return u
+}
+
+// UpdateDatasetID sets the "dataset_id" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsert) UpdateDatasetID() *DatasetSnapshotUpsert {
+	u.SetExcluded(datasetsnapshot.FieldDatasetID)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *DatasetSnapshotUpsert) SetVersion(v int) *DatasetSnapshotUpsert {
+	u.Set(datasetsnapshot.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsert) UpdateVersion() *DatasetSnapshotUpsert {
+	u.SetExcluded(datasetsnapshot.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *DatasetSnapshotUpsert) AddVersion(v int) *DatasetSnapshotUpsert {
+	u.Add(datasetsnapshot.FieldVersion, v)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *DatasetSnapshotUpsert) SetNote(v string) *DatasetSnapshotUpsert {
+	u.Set(datasetsnapshot.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsert) UpdateNote() *DatasetSnapshotUpsert {
+	u.SetExcluded(datasetsnapshot.FieldNote)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *DatasetSnapshotUpsert) SetCreatedBy(v string) *DatasetSnapshotUpsert {
+	u.Set(datasetsnapshot.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsert) UpdateCreatedBy() *DatasetSnapshotUpsert {
+	u.SetExcluded(datasetsnapshot.FieldCreatedBy)
+	return u
+}
+
+// SetExampleCount sets the "example_count" field.
+func (u *DatasetSnapshotUpsert) SetExampleCount(v int) *DatasetSnapshotUpsert {
+	u.Set(datasetsnapshot.FieldExampleCount, v)
+	return u
+}
+
+// UpdateExampleCount sets the "example_count" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsert) UpdateExampleCount() *DatasetSnapshotUpsert {
+	u.SetExcluded(datasetsnapshot.FieldExampleCount)
+	return u
+}
+
+// AddExampleCount adds v to the "example_count" field.
+func (u *DatasetSnapshotUpsert) AddExampleCount(v int) *DatasetSnapshotUpsert {
+	u.Add(datasetsnapshot.FieldExampleCount, v)
+	return u
+}
+
+// SetExamplesJSON sets the "examples_json" field.
+func (u *DatasetSnapshotUpsert) SetExamplesJSON(v string) *DatasetSnapshotUpsert {
+	u.Set(datasetsnapshot.FieldExamplesJSON, v)
+	return u
+}
+
+// UpdateExamplesJSON sets the "examples_json" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsert) UpdateExamplesJSON() *DatasetSnapshotUpsert {
+	u.SetExcluded(datasetsnapshot.FieldExamplesJSON)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DatasetSnapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(datasetsnapshot.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DatasetSnapshotUpsertOne) UpdateNewValues() *DatasetSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(datasetsnapshot.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(datasetsnapshot.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DatasetSnapshot.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DatasetSnapshotUpsertOne) Ignore() *DatasetSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DatasetSnapshotUpsertOne) DoNothing() *DatasetSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DatasetSnapshotCreate.OnConflict
+// documentation for more info.
+func (u *DatasetSnapshotUpsertOne) Update(set func(*DatasetSnapshotUpsert)) *DatasetSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DatasetSnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDatasetID sets the "dataset_id" field.
+func (u *DatasetSnapshotUpsertOne) SetDatasetID(v string) *DatasetSnapshotUpsertOne {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.SetDatasetID(v)
+	})
+}
+
+// UpdateDatasetID sets the "dataset_id" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsertOne) UpdateDatasetID() *DatasetSnapshotUpsertOne {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.UpdateDatasetID()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *DatasetSnapshotUpsertOne) SetVersion(v int) *DatasetSnapshotUpsertOne {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *DatasetSnapshotUpsertOne) AddVersion(v int) *DatasetSnapshotUpsertOne {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsertOne) UpdateVersion() *DatasetSnapshotUpsertOne {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *DatasetSnapshotUpsertOne) SetNote(v string) *DatasetSnapshotUpsertOne {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsertOne) UpdateNote() *DatasetSnapshotUpsertOne {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *DatasetSnapshotUpsertOne) SetCreatedBy(v string) *DatasetSnapshotUpsertOne {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsertOne) UpdateCreatedBy() *DatasetSnapshotUpsertOne {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetExampleCount sets the "example_count" field.
+func (u *DatasetSnapshotUpsertOne) SetExampleCount(v int) *DatasetSnapshotUpsertOne {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.SetExampleCount(v)
+	})
+}
+
+// AddExampleCount adds v to the "example_count" field.
+func (u *DatasetSnapshotUpsertOne) AddExampleCount(v int) *DatasetSnapshotUpsertOne {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.AddExampleCount(v)
+	})
+}
+
+// UpdateExampleCount sets the "example_count" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsertOne) UpdateExampleCount() *DatasetSnapshotUpsertOne {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.UpdateExampleCount()
+	})
+}
+
+// SetExamplesJSON sets the "examples_json" field.
+func (u *DatasetSnapshotUpsertOne) SetExamplesJSON(v string) *DatasetSnapshotUpsertOne {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.SetExamplesJSON(v)
+	})
+}
+
+// UpdateExamplesJSON sets the "examples_json" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsertOne) UpdateExamplesJSON() *DatasetSnapshotUpsertOne {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.UpdateExamplesJSON()
+	})
+}
+
+// Exec executes the query.
+func (u *DatasetSnapshotUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("dao: missing options for DatasetSnapshotCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DatasetSnapshotUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DatasetSnapshotUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("dao: DatasetSnapshotUpsertOne.ID is not supported by MySQL driver. Use DatasetSnapshotUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DatasetSnapshotUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DatasetSnapshotCreateBulk is the builder for creating many DatasetSnapshot entities in bulk.
+type DatasetSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*DatasetSnapshotCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DatasetSnapshot entities in the database.
+func (_c *DatasetSnapshotCreateBulk) Save(ctx context.Context) ([]*DatasetSnapshot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DatasetSnapshot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DatasetSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DatasetSnapshotCreateBulk) SaveX(ctx context.Context) []*DatasetSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DatasetSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DatasetSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DatasetSnapshot.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DatasetSnapshotUpsert) {
+//			SetDatasetID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DatasetSnapshotCreateBulk) OnConflict(opts ...sql.ConflictOption) *DatasetSnapshotUpsertBulk {
+	_c.conflict = opts
+	return &DatasetSnapshotUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DatasetSnapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DatasetSnapshotCreateBulk) OnConflictColumns(columns ...string) *DatasetSnapshotUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DatasetSnapshotUpsertBulk{
+		create: _c,
+	}
+}
+
+// DatasetSnapshotUpsertBulk is the builder for "upsert"-ing
+// a bulk of DatasetSnapshot nodes.
+type DatasetSnapshotUpsertBulk struct {
+	create *DatasetSnapshotCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DatasetSnapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(datasetsnapshot.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DatasetSnapshotUpsertBulk) UpdateNewValues() *DatasetSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(datasetsnapshot.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(datasetsnapshot.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DatasetSnapshot.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DatasetSnapshotUpsertBulk) Ignore() *DatasetSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DatasetSnapshotUpsertBulk) DoNothing() *DatasetSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DatasetSnapshotCreateBulk.OnConflict
+// documentation for more info.
+func (u *DatasetSnapshotUpsertBulk) Update(set func(*DatasetSnapshotUpsert)) *DatasetSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DatasetSnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDatasetID sets the "dataset_id" field.
+func (u *DatasetSnapshotUpsertBulk) SetDatasetID(v string) *DatasetSnapshotUpsertBulk {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.SetDatasetID(v)
+	})
+}
+
+// UpdateDatasetID sets the "dataset_id" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsertBulk) UpdateDatasetID() *DatasetSnapshotUpsertBulk {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.UpdateDatasetID()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *DatasetSnapshotUpsertBulk) SetVersion(v int) *DatasetSnapshotUpsertBulk {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *DatasetSnapshotUpsertBulk) AddVersion(v int) *DatasetSnapshotUpsertBulk {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsertBulk) UpdateVersion() *DatasetSnapshotUpsertBulk {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *DatasetSnapshotUpsertBulk) SetNote(v string) *DatasetSnapshotUpsertBulk {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsertBulk) UpdateNote() *DatasetSnapshotUpsertBulk {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *DatasetSnapshotUpsertBulk) SetCreatedBy(v string) *DatasetSnapshotUpsertBulk {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsertBulk) UpdateCreatedBy() *DatasetSnapshotUpsertBulk {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetExampleCount sets the "example_count" field.
+func (u *DatasetSnapshotUpsertBulk) SetExampleCount(v int) *DatasetSnapshotUpsertBulk {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.SetExampleCount(v)
+	})
+}
+
+// AddExampleCount adds v to the "example_count" field.
+func (u *DatasetSnapshotUpsertBulk) AddExampleCount(v int) *DatasetSnapshotUpsertBulk {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.AddExampleCount(v)
+	})
+}
+
+// UpdateExampleCount sets the "example_count" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsertBulk) UpdateExampleCount() *DatasetSnapshotUpsertBulk {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.UpdateExampleCount()
+	})
+}
+
+// SetExamplesJSON sets the "examples_json" field.
+func (u *DatasetSnapshotUpsertBulk) SetExamplesJSON(v string) *DatasetSnapshotUpsertBulk {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.SetExamplesJSON(v)
+	})
+}
+
+// UpdateExamplesJSON sets the "examples_json" field to the value that was provided on create.
+func (u *DatasetSnapshotUpsertBulk) UpdateExamplesJSON() *DatasetSnapshotUpsertBulk {
+	return u.Update(func(s *DatasetSnapshotUpsert) {
+		s.UpdateExamplesJSON()
+	})
+}
+
+// Exec executes the query.
+func (u *DatasetSnapshotUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("dao: OnConflict was set for builder %d. Set it on the DatasetSnapshotCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("dao: missing options for DatasetSnapshotCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DatasetSnapshotUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
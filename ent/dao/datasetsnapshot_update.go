@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetsnapshot"
+	"github.com/kingfs/llm-tracelab/ent/dao/internal"
+	"github.com/kingfs/llm-tracelab/ent/dao/predicate"
+)
+
+// DatasetSnapshotUpdate is the builder for updating DatasetSnapshot entities.
+type DatasetSnapshotUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DatasetSnapshotMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DatasetSnapshotUpdate builder.
+func (_u *DatasetSnapshotUpdate) Where(ps ...predicate.DatasetSnapshot) *DatasetSnapshotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDatasetID sets the "dataset_id" field.
+func (_u *DatasetSnapshotUpdate) SetDatasetID(v string) *DatasetSnapshotUpdate {
+	_u.mutation.SetDatasetID(v)
+	return _u
+}
+
+// SetNillableDatasetID sets the "dataset_id" field if the given value is not nil.
+func (_u *DatasetSnapshotUpdate) SetNillableDatasetID(v *string) *DatasetSnapshotUpdate {
+	if v != nil {
+		_u.SetDatasetID(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *DatasetSnapshotUpdate) SetVersion(v int) *DatasetSnapshotUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *DatasetSnapshotUpdate) SetNillableVersion(v *int) *DatasetSnapshotUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *DatasetSnapshotUpdate) AddVersion(v int) *DatasetSnapshotUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *DatasetSnapshotUpdate) SetNote(v string) *DatasetSnapshotUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *DatasetSnapshotUpdate) SetNillableNote(v *string) *DatasetSnapshotUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *DatasetSnapshotUpdate) SetCreatedBy(v string) *DatasetSnapshotUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *DatasetSnapshotUpdate) SetNillableCreatedBy(v *string) *DatasetSnapshotUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetExampleCount sets the "example_count" field.
+func (_u *DatasetSnapshotUpdate) SetExampleCount(v int) *DatasetSnapshotUpdate {
+	_u.mutation.ResetExampleCount()
+	_u.mutation.SetExampleCount(v)
+	return _u
+}
+
+// SetNillableExampleCount sets the "example_count" field if the given value is not nil.
+func (_u *DatasetSnapshotUpdate) SetNillableExampleCount(v *int) *DatasetSnapshotUpdate {
+	if v != nil {
+		_u.SetExampleCount(*v)
+	}
+	return _u
+}
+
+// AddExampleCount adds value to the "example_count" field.
+func (_u *DatasetSnapshotUpdate) AddExampleCount(v int) *DatasetSnapshotUpdate {
+	_u.mutation.AddExampleCount(v)
+	return _u
+}
+
+// SetExamplesJSON sets the "examples_json" field.
+func (_u *DatasetSnapshotUpdate) SetExamplesJSON(v string) *DatasetSnapshotUpdate {
+	_u.mutation.SetExamplesJSON(v)
+	return _u
+}
+
+// SetNillableExamplesJSON sets the "examples_json" field if the given value is not nil.
+func (_u *DatasetSnapshotUpdate) SetNillableExamplesJSON(v *string) *DatasetSnapshotUpdate {
+	if v != nil {
+		_u.SetExamplesJSON(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DatasetSnapshotMutation object of the builder.
+func (_u *DatasetSnapshotUpdate) Mutation() *DatasetSnapshotMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DatasetSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DatasetSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DatasetSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DatasetSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DatasetSnapshotUpdate) check() error {
+	if v, ok := _u.mutation.DatasetID(); ok {
+		if err := datasetsnapshot.DatasetIDValidator(v); err != nil {
+			return &ValidationError{Name: "dataset_id", err: fmt.Errorf(`dao: validator failed for field "DatasetSnapshot.dataset_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DatasetSnapshotUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DatasetSnapshotUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DatasetSnapshotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(datasetsnapshot.Table, datasetsnapshot.Columns, sqlgraph.NewFieldSpec(datasetsnapshot.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DatasetID(); ok {
+		_spec.SetField(datasetsnapshot.FieldDatasetID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(datasetsnapshot.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(datasetsnapshot.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(datasetsnapshot.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(datasetsnapshot.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExampleCount(); ok {
+		_spec.SetField(datasetsnapshot.FieldExampleCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExampleCount(); ok {
+		_spec.AddField(datasetsnapshot.FieldExampleCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExamplesJSON(); ok {
+		_spec.SetField(datasetsnapshot.FieldExamplesJSON, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.DatasetSnapshot
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{datasetsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DatasetSnapshotUpdateOne is the builder for updating a single DatasetSnapshot entity.
+type DatasetSnapshotUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DatasetSnapshotMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDatasetID sets the "dataset_id" field.
+func (_u *DatasetSnapshotUpdateOne) SetDatasetID(v string) *DatasetSnapshotUpdateOne {
+	_u.mutation.SetDatasetID(v)
+	return _u
+}
+
+// SetNillableDatasetID sets the "dataset_id" field if the given value is not nil.
+func (_u *DatasetSnapshotUpdateOne) SetNillableDatasetID(v *string) *DatasetSnapshotUpdateOne {
+	if v != nil {
+		_u.SetDatasetID(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *DatasetSnapshotUpdateOne) SetVersion(v int) *DatasetSnapshotUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *DatasetSnapshotUpdateOne) SetNillableVersion(v *int) *DatasetSnapshotUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *DatasetSnapshotUpdateOne) AddVersion(v int) *DatasetSnapshotUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *DatasetSnapshotUpdateOne) SetNote(v string) *DatasetSnapshotUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *DatasetSnapshotUpdateOne) SetNillableNote(v *string) *DatasetSnapshotUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *DatasetSnapshotUpdateOne) SetCreatedBy(v string) *DatasetSnapshotUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *DatasetSnapshotUpdateOne) SetNillableCreatedBy(v *string) *DatasetSnapshotUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetExampleCount sets the "example_count" field.
+func (_u *DatasetSnapshotUpdateOne) SetExampleCount(v int) *DatasetSnapshotUpdateOne {
+	_u.mutation.ResetExampleCount()
+	_u.mutation.SetExampleCount(v)
+	return _u
+}
+
+// SetNillableExampleCount sets the "example_count" field if the given value is not nil.
+func (_u *DatasetSnapshotUpdateOne) SetNillableExampleCount(v *int) *DatasetSnapshotUpdateOne {
+	if v != nil {
+		_u.SetExampleCount(*v)
+	}
+	return _u
+}
+
+// AddExampleCount adds value to the "example_count" field.
+func (_u *DatasetSnapshotUpdateOne) AddExampleCount(v int) *DatasetSnapshotUpdateOne {
+	_u.mutation.AddExampleCount(v)
+	return _u
+}
+
+// SetExamplesJSON sets the "examples_json" field.
+func (_u *DatasetSnapshotUpdateOne) SetExamplesJSON(v string) *DatasetSnapshotUpdateOne {
+	_u.mutation.SetExamplesJSON(v)
+	return _u
+}
+
+// SetNillableExamplesJSON sets the "examples_json" field if the given value is not nil.
+func (_u *DatasetSnapshotUpdateOne) SetNillableExamplesJSON(v *string) *DatasetSnapshotUpdateOne {
+	if v != nil {
+		_u.SetExamplesJSON(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DatasetSnapshotMutation object of the builder.
+func (_u *DatasetSnapshotUpdateOne) Mutation() *DatasetSnapshotMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DatasetSnapshotUpdate builder.
+func (_u *DatasetSnapshotUpdateOne) Where(ps ...predicate.DatasetSnapshot) *DatasetSnapshotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DatasetSnapshotUpdateOne) Select(field string, fields ...string) *DatasetSnapshotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DatasetSnapshot entity.
+func (_u *DatasetSnapshotUpdateOne) Save(ctx context.Context) (*DatasetSnapshot, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DatasetSnapshotUpdateOne) SaveX(ctx context.Context) *DatasetSnapshot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DatasetSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DatasetSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DatasetSnapshotUpdateOne) check() error {
+	if v, ok := _u.mutation.DatasetID(); ok {
+		if err := datasetsnapshot.DatasetIDValidator(v); err != nil {
+			return &ValidationError{Name: "dataset_id", err: fmt.Errorf(`dao: validator failed for field "DatasetSnapshot.dataset_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DatasetSnapshotUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DatasetSnapshotUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DatasetSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *DatasetSnapshot, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(datasetsnapshot.Table, datasetsnapshot.Columns, sqlgraph.NewFieldSpec(datasetsnapshot.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`dao: missing "DatasetSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, datasetsnapshot.FieldID)
+		for _, f := range fields {
+			if !datasetsnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("dao: invalid field %q for query", f)}
+			}
+			if f != datasetsnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DatasetID(); ok {
+		_spec.SetField(datasetsnapshot.FieldDatasetID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(datasetsnapshot.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(datasetsnapshot.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(datasetsnapshot.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(datasetsnapshot.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExampleCount(); ok {
+		_spec.SetField(datasetsnapshot.FieldExampleCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExampleCount(); ok {
+		_spec.AddField(datasetsnapshot.FieldExampleCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExamplesJSON(); ok {
+		_spec.SetField(datasetsnapshot.FieldExamplesJSON, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.DatasetSnapshot
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &DatasetSnapshot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{datasetsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
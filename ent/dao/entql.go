@@ -3,18 +3,22 @@
 package dao
 
 import (
+	"github.com/kingfs/llm-tracelab/ent/dao/annotation"
 	"github.com/kingfs/llm-tracelab/ent/dao/apitoken"
 	"github.com/kingfs/llm-tracelab/ent/dao/channelconfig"
 	"github.com/kingfs/llm-tracelab/ent/dao/channelmodel"
 	"github.com/kingfs/llm-tracelab/ent/dao/channelproberun"
 	"github.com/kingfs/llm-tracelab/ent/dao/dataset"
 	"github.com/kingfs/llm-tracelab/ent/dao/datasetexample"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetexampleoverride"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetsnapshot"
 	"github.com/kingfs/llm-tracelab/ent/dao/evalrun"
 	"github.com/kingfs/llm-tracelab/ent/dao/experimentrun"
 	"github.com/kingfs/llm-tracelab/ent/dao/modelcatalog"
 	"github.com/kingfs/llm-tracelab/ent/dao/predicate"
 	"github.com/kingfs/llm-tracelab/ent/dao/score"
 	"github.com/kingfs/llm-tracelab/ent/dao/tracelog"
+	"github.com/kingfs/llm-tracelab/ent/dao/tracetag"
 	"github.com/kingfs/llm-tracelab/ent/dao/upstreammodel"
 	"github.com/kingfs/llm-tracelab/ent/dao/upstreamtarget"
 	"github.com/kingfs/llm-tracelab/ent/dao/user"
@@ -27,7 +31,7 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 14)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 18)}
 	graph.Nodes[0] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   apitoken.Table,
@@ -50,6 +54,26 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 	}
 	graph.Nodes[1] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   annotation.Table,
+			Columns: annotation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: annotation.FieldID,
+			},
+		},
+		Type: "Annotation",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			annotation.FieldTargetType: {Type: field.TypeString, Column: annotation.FieldTargetType},
+			annotation.FieldTargetID:   {Type: field.TypeString, Column: annotation.FieldTargetID},
+			annotation.FieldParentID:   {Type: field.TypeString, Column: annotation.FieldParentID},
+			annotation.FieldAuthor:     {Type: field.TypeString, Column: annotation.FieldAuthor},
+			annotation.FieldBody:       {Type: field.TypeString, Column: annotation.FieldBody},
+			annotation.FieldCreatedAt:  {Type: field.TypeTime, Column: annotation.FieldCreatedAt},
+			annotation.FieldUpdatedAt:  {Type: field.TypeTime, Column: annotation.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[2] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   channelconfig.Table,
 			Columns: channelconfig.Columns,
@@ -88,7 +112,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			channelconfig.FieldLastProbeError:     {Type: field.TypeString, Column: channelconfig.FieldLastProbeError},
 		},
 	}
-	graph.Nodes[2] = &sqlgraph.Node{
+	graph.Nodes[3] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   channelmodel.Table,
 			Columns: channelmodel.Columns,
@@ -116,7 +140,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			channelmodel.FieldLastProbeAt:             {Type: field.TypeTime, Column: channelmodel.FieldLastProbeAt},
 		},
 	}
-	graph.Nodes[3] = &sqlgraph.Node{
+	graph.Nodes[4] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   channelproberun.Table,
 			Columns: channelproberun.Columns,
@@ -141,7 +165,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			channelproberun.FieldResponseSampleJSON: {Type: field.TypeString, Column: channelproberun.FieldResponseSampleJSON},
 		},
 	}
-	graph.Nodes[4] = &sqlgraph.Node{
+	graph.Nodes[5] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   dataset.Table,
 			Columns: dataset.Columns,
@@ -158,7 +182,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			dataset.FieldUpdatedAt:   {Type: field.TypeTime, Column: dataset.FieldUpdatedAt},
 		},
 	}
-	graph.Nodes[5] = &sqlgraph.Node{
+	graph.Nodes[6] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   datasetexample.Table,
 			Columns: datasetexample.Columns,
@@ -178,7 +202,47 @@ var schemaGraph = func() *sqlgraph.Schema {
 			datasetexample.FieldNote:       {Type: field.TypeString, Column: datasetexample.FieldNote},
 		},
 	}
-	graph.Nodes[6] = &sqlgraph.Node{
+	graph.Nodes[7] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   datasetexampleoverride.Table,
+			Columns: datasetexampleoverride.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: datasetexampleoverride.FieldID,
+			},
+		},
+		Type: "DatasetExampleOverride",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			datasetexampleoverride.FieldDatasetID:         {Type: field.TypeString, Column: datasetexampleoverride.FieldDatasetID},
+			datasetexampleoverride.FieldTraceID:           {Type: field.TypeString, Column: datasetexampleoverride.FieldTraceID},
+			datasetexampleoverride.FieldExpectedOutput:    {Type: field.TypeString, Column: datasetexampleoverride.FieldExpectedOutput},
+			datasetexampleoverride.FieldTagsJSON:          {Type: field.TypeString, Column: datasetexampleoverride.FieldTagsJSON},
+			datasetexampleoverride.FieldInputOverrideJSON: {Type: field.TypeString, Column: datasetexampleoverride.FieldInputOverrideJSON},
+			datasetexampleoverride.FieldUpdatedBy:         {Type: field.TypeString, Column: datasetexampleoverride.FieldUpdatedBy},
+			datasetexampleoverride.FieldUpdatedAt:         {Type: field.TypeTime, Column: datasetexampleoverride.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[8] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   datasetsnapshot.Table,
+			Columns: datasetsnapshot.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: datasetsnapshot.FieldID,
+			},
+		},
+		Type: "DatasetSnapshot",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			datasetsnapshot.FieldDatasetID:    {Type: field.TypeString, Column: datasetsnapshot.FieldDatasetID},
+			datasetsnapshot.FieldVersion:      {Type: field.TypeInt, Column: datasetsnapshot.FieldVersion},
+			datasetsnapshot.FieldNote:         {Type: field.TypeString, Column: datasetsnapshot.FieldNote},
+			datasetsnapshot.FieldCreatedBy:    {Type: field.TypeString, Column: datasetsnapshot.FieldCreatedBy},
+			datasetsnapshot.FieldExampleCount: {Type: field.TypeInt, Column: datasetsnapshot.FieldExampleCount},
+			datasetsnapshot.FieldExamplesJSON: {Type: field.TypeString, Column: datasetsnapshot.FieldExamplesJSON},
+			datasetsnapshot.FieldCreatedAt:    {Type: field.TypeTime, Column: datasetsnapshot.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[9] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   evalrun.Table,
 			Columns: evalrun.Columns,
@@ -201,7 +265,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			evalrun.FieldFailCount:    {Type: field.TypeInt, Column: evalrun.FieldFailCount},
 		},
 	}
-	graph.Nodes[7] = &sqlgraph.Node{
+	graph.Nodes[10] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   experimentrun.Table,
 			Columns: experimentrun.Columns,
@@ -225,9 +289,11 @@ var schemaGraph = func() *sqlgraph.Schema {
 			experimentrun.FieldMatchedScoreCount:   {Type: field.TypeInt, Column: experimentrun.FieldMatchedScoreCount},
 			experimentrun.FieldImprovementCount:    {Type: field.TypeInt, Column: experimentrun.FieldImprovementCount},
 			experimentrun.FieldRegressionCount:     {Type: field.TypeInt, Column: experimentrun.FieldRegressionCount},
+			experimentrun.FieldStatus:              {Type: field.TypeString, Column: experimentrun.FieldStatus},
+			experimentrun.FieldError:               {Type: field.TypeString, Column: experimentrun.FieldError},
 		},
 	}
-	graph.Nodes[8] = &sqlgraph.Node{
+	graph.Nodes[11] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   modelcatalog.Table,
 			Columns: modelcatalog.Columns,
@@ -248,7 +314,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			modelcatalog.FieldLastUsedAt:  {Type: field.TypeTime, Column: modelcatalog.FieldLastUsedAt},
 		},
 	}
-	graph.Nodes[9] = &sqlgraph.Node{
+	graph.Nodes[12] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   score.Table,
 			Columns: score.Columns,
@@ -271,7 +337,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			score.FieldCreatedAt:    {Type: field.TypeTime, Column: score.FieldCreatedAt},
 		},
 	}
-	graph.Nodes[10] = &sqlgraph.Node{
+	graph.Nodes[13] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   tracelog.Table,
 			Columns: tracelog.Columns,
@@ -320,9 +386,29 @@ var schemaGraph = func() *sqlgraph.Schema {
 			tracelog.FieldRoutingScore:                   {Type: field.TypeFloat64, Column: tracelog.FieldRoutingScore},
 			tracelog.FieldRoutingCandidateCount:          {Type: field.TypeInt, Column: tracelog.FieldRoutingCandidateCount},
 			tracelog.FieldRoutingFailureReason:           {Type: field.TypeString, Column: tracelog.FieldRoutingFailureReason},
+			tracelog.FieldTokenName:                      {Type: field.TypeString, Column: tracelog.FieldTokenName},
+			tracelog.FieldClientCanceled:                 {Type: field.TypeBool, Column: tracelog.FieldClientCanceled},
 		},
 	}
-	graph.Nodes[11] = &sqlgraph.Node{
+	graph.Nodes[14] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   tracetag.Table,
+			Columns: tracetag.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: tracetag.FieldID,
+			},
+		},
+		Type: "TraceTag",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			tracetag.FieldTargetType: {Type: field.TypeString, Column: tracetag.FieldTargetType},
+			tracetag.FieldTargetID:   {Type: field.TypeString, Column: tracetag.FieldTargetID},
+			tracetag.FieldTag:        {Type: field.TypeString, Column: tracetag.FieldTag},
+			tracetag.FieldCreatedBy:  {Type: field.TypeString, Column: tracetag.FieldCreatedBy},
+			tracetag.FieldCreatedAt:  {Type: field.TypeTime, Column: tracetag.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[15] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   upstreammodel.Table,
 			Columns: upstreammodel.Columns,
@@ -339,7 +425,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			upstreammodel.FieldSeenAt:     {Type: field.TypeTime, Column: upstreammodel.FieldSeenAt},
 		},
 	}
-	graph.Nodes[12] = &sqlgraph.Node{
+	graph.Nodes[16] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   upstreamtarget.Table,
 			Columns: upstreamtarget.Columns,
@@ -363,7 +449,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			upstreamtarget.FieldLastRefreshError:  {Type: field.TypeString, Column: upstreamtarget.FieldLastRefreshError},
 		},
 	}
-	graph.Nodes[13] = &sqlgraph.Node{
+	graph.Nodes[17] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   user.Table,
 			Columns: user.Columns,
@@ -510,6 +596,81 @@ func (f *APITokenFilter) WhereHasUserWith(preds ...predicate.User) {
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *AnnotationQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the AnnotationQuery builder.
+func (_q *AnnotationQuery) Filter() *AnnotationFilter {
+	return &AnnotationFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *AnnotationMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the AnnotationMutation builder.
+func (m *AnnotationMutation) Filter() *AnnotationFilter {
+	return &AnnotationFilter{config: m.config, predicateAdder: m}
+}
+
+// AnnotationFilter provides a generic filtering capability at runtime for AnnotationQuery.
+type AnnotationFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *AnnotationFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *AnnotationFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(annotation.FieldID))
+}
+
+// WhereTargetType applies the entql string predicate on the target_type field.
+func (f *AnnotationFilter) WhereTargetType(p entql.StringP) {
+	f.Where(p.Field(annotation.FieldTargetType))
+}
+
+// WhereTargetID applies the entql string predicate on the target_id field.
+func (f *AnnotationFilter) WhereTargetID(p entql.StringP) {
+	f.Where(p.Field(annotation.FieldTargetID))
+}
+
+// WhereParentID applies the entql string predicate on the parent_id field.
+func (f *AnnotationFilter) WhereParentID(p entql.StringP) {
+	f.Where(p.Field(annotation.FieldParentID))
+}
+
+// WhereAuthor applies the entql string predicate on the author field.
+func (f *AnnotationFilter) WhereAuthor(p entql.StringP) {
+	f.Where(p.Field(annotation.FieldAuthor))
+}
+
+// WhereBody applies the entql string predicate on the body field.
+func (f *AnnotationFilter) WhereBody(p entql.StringP) {
+	f.Where(p.Field(annotation.FieldBody))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *AnnotationFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(annotation.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *AnnotationFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(annotation.FieldUpdatedAt))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *ChannelConfigQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -539,7 +700,7 @@ type ChannelConfigFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ChannelConfigFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -709,7 +870,7 @@ type ChannelModelFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ChannelModelFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -824,7 +985,7 @@ type ChannelProbeRunFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ChannelProbeRunFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -924,7 +1085,7 @@ type DatasetFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *DatasetFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -984,7 +1145,7 @@ type DatasetExampleFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *DatasetExampleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1030,6 +1191,156 @@ func (f *DatasetExampleFilter) WhereNote(p entql.StringP) {
 	f.Where(p.Field(datasetexample.FieldNote))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *DatasetExampleOverrideQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the DatasetExampleOverrideQuery builder.
+func (_q *DatasetExampleOverrideQuery) Filter() *DatasetExampleOverrideFilter {
+	return &DatasetExampleOverrideFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *DatasetExampleOverrideMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the DatasetExampleOverrideMutation builder.
+func (m *DatasetExampleOverrideMutation) Filter() *DatasetExampleOverrideFilter {
+	return &DatasetExampleOverrideFilter{config: m.config, predicateAdder: m}
+}
+
+// DatasetExampleOverrideFilter provides a generic filtering capability at runtime for DatasetExampleOverrideQuery.
+type DatasetExampleOverrideFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *DatasetExampleOverrideFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *DatasetExampleOverrideFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(datasetexampleoverride.FieldID))
+}
+
+// WhereDatasetID applies the entql string predicate on the dataset_id field.
+func (f *DatasetExampleOverrideFilter) WhereDatasetID(p entql.StringP) {
+	f.Where(p.Field(datasetexampleoverride.FieldDatasetID))
+}
+
+// WhereTraceID applies the entql string predicate on the trace_id field.
+func (f *DatasetExampleOverrideFilter) WhereTraceID(p entql.StringP) {
+	f.Where(p.Field(datasetexampleoverride.FieldTraceID))
+}
+
+// WhereExpectedOutput applies the entql string predicate on the expected_output field.
+func (f *DatasetExampleOverrideFilter) WhereExpectedOutput(p entql.StringP) {
+	f.Where(p.Field(datasetexampleoverride.FieldExpectedOutput))
+}
+
+// WhereTagsJSON applies the entql string predicate on the tags_json field.
+func (f *DatasetExampleOverrideFilter) WhereTagsJSON(p entql.StringP) {
+	f.Where(p.Field(datasetexampleoverride.FieldTagsJSON))
+}
+
+// WhereInputOverrideJSON applies the entql string predicate on the input_override_json field.
+func (f *DatasetExampleOverrideFilter) WhereInputOverrideJSON(p entql.StringP) {
+	f.Where(p.Field(datasetexampleoverride.FieldInputOverrideJSON))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *DatasetExampleOverrideFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(datasetexampleoverride.FieldUpdatedBy))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *DatasetExampleOverrideFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(datasetexampleoverride.FieldUpdatedAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *DatasetSnapshotQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the DatasetSnapshotQuery builder.
+func (_q *DatasetSnapshotQuery) Filter() *DatasetSnapshotFilter {
+	return &DatasetSnapshotFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *DatasetSnapshotMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the DatasetSnapshotMutation builder.
+func (m *DatasetSnapshotMutation) Filter() *DatasetSnapshotFilter {
+	return &DatasetSnapshotFilter{config: m.config, predicateAdder: m}
+}
+
+// DatasetSnapshotFilter provides a generic filtering capability at runtime for DatasetSnapshotQuery.
+type DatasetSnapshotFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *DatasetSnapshotFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *DatasetSnapshotFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(datasetsnapshot.FieldID))
+}
+
+// WhereDatasetID applies the entql string predicate on the dataset_id field.
+func (f *DatasetSnapshotFilter) WhereDatasetID(p entql.StringP) {
+	f.Where(p.Field(datasetsnapshot.FieldDatasetID))
+}
+
+// WhereVersion applies the entql int predicate on the version field.
+func (f *DatasetSnapshotFilter) WhereVersion(p entql.IntP) {
+	f.Where(p.Field(datasetsnapshot.FieldVersion))
+}
+
+// WhereNote applies the entql string predicate on the note field.
+func (f *DatasetSnapshotFilter) WhereNote(p entql.StringP) {
+	f.Where(p.Field(datasetsnapshot.FieldNote))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *DatasetSnapshotFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(datasetsnapshot.FieldCreatedBy))
+}
+
+// WhereExampleCount applies the entql int predicate on the example_count field.
+func (f *DatasetSnapshotFilter) WhereExampleCount(p entql.IntP) {
+	f.Where(p.Field(datasetsnapshot.FieldExampleCount))
+}
+
+// WhereExamplesJSON applies the entql string predicate on the examples_json field.
+func (f *DatasetSnapshotFilter) WhereExamplesJSON(p entql.StringP) {
+	f.Where(p.Field(datasetsnapshot.FieldExamplesJSON))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *DatasetSnapshotFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(datasetsnapshot.FieldCreatedAt))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *EvalRunQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -1059,7 +1370,7 @@ type EvalRunFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *EvalRunFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1149,7 +1460,7 @@ type ExperimentRunFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ExperimentRunFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1225,6 +1536,16 @@ func (f *ExperimentRunFilter) WhereRegressionCount(p entql.IntP) {
 	f.Where(p.Field(experimentrun.FieldRegressionCount))
 }
 
+// WhereStatus applies the entql string predicate on the status field.
+func (f *ExperimentRunFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(experimentrun.FieldStatus))
+}
+
+// WhereError applies the entql string predicate on the error field.
+func (f *ExperimentRunFilter) WhereError(p entql.StringP) {
+	f.Where(p.Field(experimentrun.FieldError))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *ModelCatalogQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -1254,7 +1575,7 @@ type ModelCatalogFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ModelCatalogFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1334,7 +1655,7 @@ type ScoreFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ScoreFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1424,7 +1745,7 @@ type TraceLogFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TraceLogFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1625,6 +1946,81 @@ func (f *TraceLogFilter) WhereRoutingFailureReason(p entql.StringP) {
 	f.Where(p.Field(tracelog.FieldRoutingFailureReason))
 }
 
+// WhereTokenName applies the entql string predicate on the token_name field.
+func (f *TraceLogFilter) WhereTokenName(p entql.StringP) {
+	f.Where(p.Field(tracelog.FieldTokenName))
+}
+
+// WhereClientCanceled applies the entql bool predicate on the client_canceled field.
+func (f *TraceLogFilter) WhereClientCanceled(p entql.BoolP) {
+	f.Where(p.Field(tracelog.FieldClientCanceled))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *TraceTagQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TraceTagQuery builder.
+func (_q *TraceTagQuery) Filter() *TraceTagFilter {
+	return &TraceTagFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TraceTagMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TraceTagMutation builder.
+func (m *TraceTagMutation) Filter() *TraceTagFilter {
+	return &TraceTagFilter{config: m.config, predicateAdder: m}
+}
+
+// TraceTagFilter provides a generic filtering capability at runtime for TraceTagQuery.
+type TraceTagFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TraceTagFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *TraceTagFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(tracetag.FieldID))
+}
+
+// WhereTargetType applies the entql string predicate on the target_type field.
+func (f *TraceTagFilter) WhereTargetType(p entql.StringP) {
+	f.Where(p.Field(tracetag.FieldTargetType))
+}
+
+// WhereTargetID applies the entql string predicate on the target_id field.
+func (f *TraceTagFilter) WhereTargetID(p entql.StringP) {
+	f.Where(p.Field(tracetag.FieldTargetID))
+}
+
+// WhereTag applies the entql string predicate on the tag field.
+func (f *TraceTagFilter) WhereTag(p entql.StringP) {
+	f.Where(p.Field(tracetag.FieldTag))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *TraceTagFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(tracetag.FieldCreatedBy))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TraceTagFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(tracetag.FieldCreatedAt))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *UpstreamModelQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -1654,7 +2050,7 @@ type UpstreamModelFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UpstreamModelFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1714,7 +2110,7 @@ type UpstreamTargetFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UpstreamTargetFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1809,7 +2205,7 @@ type UserFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -43,7 +43,11 @@ type ExperimentRun struct {
 	ImprovementCount int `json:"improvement_count,omitempty"`
 	// RegressionCount holds the value of the "regression_count" field.
 	RegressionCount int `json:"regression_count,omitempty"`
-	selectValues    sql.SelectValues
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Error holds the value of the "error" field.
+	Error        string `json:"error,omitempty"`
+	selectValues sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -55,7 +59,7 @@ func (*ExperimentRun) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullFloat64)
 		case experimentrun.FieldBaselineScoreCount, experimentrun.FieldCandidateScoreCount, experimentrun.FieldMatchedScoreCount, experimentrun.FieldImprovementCount, experimentrun.FieldRegressionCount:
 			values[i] = new(sql.NullInt64)
-		case experimentrun.FieldID, experimentrun.FieldName, experimentrun.FieldDescription, experimentrun.FieldBaselineEvalRunID, experimentrun.FieldCandidateEvalRunID:
+		case experimentrun.FieldID, experimentrun.FieldName, experimentrun.FieldDescription, experimentrun.FieldBaselineEvalRunID, experimentrun.FieldCandidateEvalRunID, experimentrun.FieldStatus, experimentrun.FieldError:
 			values[i] = new(sql.NullString)
 		case experimentrun.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -158,6 +162,18 @@ func (_m *ExperimentRun) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.RegressionCount = int(value.Int64)
 			}
+		case experimentrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case experimentrun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -232,6 +248,12 @@ func (_m *ExperimentRun) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("regression_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.RegressionCount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
 	builder.WriteByte(')')
 	return builder.String()
 }
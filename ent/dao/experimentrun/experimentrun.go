@@ -39,6 +39,10 @@ const (
 	FieldImprovementCount = "improvement_count"
 	// FieldRegressionCount holds the string denoting the regression_count field in the database.
 	FieldRegressionCount = "regression_count"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
 	// Table holds the table name of the experimentrun in the database.
 	Table = "experiment_runs"
 )
@@ -59,6 +63,8 @@ var Columns = []string{
 	FieldMatchedScoreCount,
 	FieldImprovementCount,
 	FieldRegressionCount,
+	FieldStatus,
+	FieldError,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -76,10 +82,6 @@ var (
 	DefaultName string
 	// DefaultDescription holds the default value on creation for the "description" field.
 	DefaultDescription string
-	// BaselineEvalRunIDValidator is a validator for the "baseline_eval_run_id" field. It is called by the builders before save.
-	BaselineEvalRunIDValidator func(string) error
-	// CandidateEvalRunIDValidator is a validator for the "candidate_eval_run_id" field. It is called by the builders before save.
-	CandidateEvalRunIDValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultBaselineScoreCount holds the default value on creation for the "baseline_score_count" field.
@@ -98,6 +100,10 @@ var (
 	DefaultImprovementCount int
 	// DefaultRegressionCount holds the default value on creation for the "regression_count" field.
 	DefaultRegressionCount int
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultError holds the default value on creation for the "error" field.
+	DefaultError string
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -174,3 +180,13 @@ func ByImprovementCount(opts ...sql.OrderTermOption) OrderOption {
 func ByRegressionCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRegressionCount, opts...).ToFunc()
 }
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
@@ -129,6 +129,16 @@ func RegressionCount(v int) predicate.ExperimentRun {
 	return predicate.ExperimentRun(sql.FieldEQ(FieldRegressionCount, v))
 }
 
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldEQ(FieldError, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.ExperimentRun {
 	return predicate.ExperimentRun(sql.FieldEQ(FieldName, v))
@@ -749,6 +759,136 @@ func RegressionCountLTE(v int) predicate.ExperimentRun {
 	return predicate.ExperimentRun(sql.FieldLTE(FieldRegressionCount, v))
 }
 
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.ExperimentRun {
+	return predicate.ExperimentRun(sql.FieldContainsFold(FieldError, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ExperimentRun) predicate.ExperimentRun {
 	return predicate.ExperimentRun(sql.AndPredicates(predicates...))
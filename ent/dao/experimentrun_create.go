@@ -189,6 +189,34 @@ func (_c *ExperimentRunCreate) SetNillableRegressionCount(v *int) *ExperimentRun
 	return _c
 }
 
+// SetStatus sets the "status" field.
+func (_c *ExperimentRunCreate) SetStatus(v string) *ExperimentRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ExperimentRunCreate) SetNillableStatus(v *string) *ExperimentRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *ExperimentRunCreate) SetError(v string) *ExperimentRunCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *ExperimentRunCreate) SetNillableError(v *string) *ExperimentRunCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ExperimentRunCreate) SetID(v string) *ExperimentRunCreate {
 	_c.mutation.SetID(v)
@@ -274,6 +302,14 @@ func (_c *ExperimentRunCreate) defaults() {
 		v := experimentrun.DefaultRegressionCount
 		_c.mutation.SetRegressionCount(v)
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := experimentrun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Error(); !ok {
+		v := experimentrun.DefaultError
+		_c.mutation.SetError(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -287,19 +323,9 @@ func (_c *ExperimentRunCreate) check() error {
 	if _, ok := _c.mutation.BaselineEvalRunID(); !ok {
 		return &ValidationError{Name: "baseline_eval_run_id", err: errors.New(`dao: missing required field "ExperimentRun.baseline_eval_run_id"`)}
 	}
-	if v, ok := _c.mutation.BaselineEvalRunID(); ok {
-		if err := experimentrun.BaselineEvalRunIDValidator(v); err != nil {
-			return &ValidationError{Name: "baseline_eval_run_id", err: fmt.Errorf(`dao: validator failed for field "ExperimentRun.baseline_eval_run_id": %w`, err)}
-		}
-	}
 	if _, ok := _c.mutation.CandidateEvalRunID(); !ok {
 		return &ValidationError{Name: "candidate_eval_run_id", err: errors.New(`dao: missing required field "ExperimentRun.candidate_eval_run_id"`)}
 	}
-	if v, ok := _c.mutation.CandidateEvalRunID(); ok {
-		if err := experimentrun.CandidateEvalRunIDValidator(v); err != nil {
-			return &ValidationError{Name: "candidate_eval_run_id", err: fmt.Errorf(`dao: validator failed for field "ExperimentRun.candidate_eval_run_id": %w`, err)}
-		}
-	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`dao: missing required field "ExperimentRun.created_at"`)}
 	}
@@ -327,6 +353,12 @@ func (_c *ExperimentRunCreate) check() error {
 	if _, ok := _c.mutation.RegressionCount(); !ok {
 		return &ValidationError{Name: "regression_count", err: errors.New(`dao: missing required field "ExperimentRun.regression_count"`)}
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`dao: missing required field "ExperimentRun.status"`)}
+	}
+	if _, ok := _c.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`dao: missing required field "ExperimentRun.error"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := experimentrun.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`dao: validator failed for field "ExperimentRun.id": %w`, err)}
@@ -421,6 +453,14 @@ func (_c *ExperimentRunCreate) createSpec() (*ExperimentRun, *sqlgraph.CreateSpe
 		_spec.SetField(experimentrun.FieldRegressionCount, field.TypeInt, value)
 		_node.RegressionCount = value
 	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(experimentrun.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(experimentrun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
 	return _node, _spec
 }
 
@@ -665,6 +705,30 @@ func (u *ExperimentRunUpsert) AddRegressionCount(v int) *ExperimentRunUpsert {
 	return u
 }
 
+// SetStatus sets the "status" field.
+func (u *ExperimentRunUpsert) SetStatus(v string) *ExperimentRunUpsert {
+	u.Set(experimentrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ExperimentRunUpsert) UpdateStatus() *ExperimentRunUpsert {
+	u.SetExcluded(experimentrun.FieldStatus)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *ExperimentRunUpsert) SetError(v string) *ExperimentRunUpsert {
+	u.Set(experimentrun.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ExperimentRunUpsert) UpdateError() *ExperimentRunUpsert {
+	u.SetExcluded(experimentrun.FieldError)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -940,6 +1004,34 @@ func (u *ExperimentRunUpsertOne) UpdateRegressionCount() *ExperimentRunUpsertOne
 	})
 }
 
+// SetStatus sets the "status" field.
+func (u *ExperimentRunUpsertOne) SetStatus(v string) *ExperimentRunUpsertOne {
+	return u.Update(func(s *ExperimentRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ExperimentRunUpsertOne) UpdateStatus() *ExperimentRunUpsertOne {
+	return u.Update(func(s *ExperimentRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ExperimentRunUpsertOne) SetError(v string) *ExperimentRunUpsertOne {
+	return u.Update(func(s *ExperimentRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ExperimentRunUpsertOne) UpdateError() *ExperimentRunUpsertOne {
+	return u.Update(func(s *ExperimentRunUpsert) {
+		s.UpdateError()
+	})
+}
+
 // Exec executes the query.
 func (u *ExperimentRunUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1382,6 +1474,34 @@ func (u *ExperimentRunUpsertBulk) UpdateRegressionCount() *ExperimentRunUpsertBu
 	})
 }
 
+// SetStatus sets the "status" field.
+func (u *ExperimentRunUpsertBulk) SetStatus(v string) *ExperimentRunUpsertBulk {
+	return u.Update(func(s *ExperimentRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ExperimentRunUpsertBulk) UpdateStatus() *ExperimentRunUpsertBulk {
+	return u.Update(func(s *ExperimentRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ExperimentRunUpsertBulk) SetError(v string) *ExperimentRunUpsertBulk {
+	return u.Update(func(s *ExperimentRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ExperimentRunUpsertBulk) UpdateError() *ExperimentRunUpsertBulk {
+	return u.Update(func(s *ExperimentRunUpsert) {
+		s.UpdateError()
+	})
+}
+
 // Exec executes the query.
 func (u *ExperimentRunUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -253,6 +253,34 @@ func (_u *ExperimentRunUpdate) AddRegressionCount(v int) *ExperimentRunUpdate {
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *ExperimentRunUpdate) SetStatus(v string) *ExperimentRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ExperimentRunUpdate) SetNillableStatus(v *string) *ExperimentRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ExperimentRunUpdate) SetError(v string) *ExperimentRunUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ExperimentRunUpdate) SetNillableError(v *string) *ExperimentRunUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
 // Mutation returns the ExperimentRunMutation object of the builder.
 func (_u *ExperimentRunUpdate) Mutation() *ExperimentRunMutation {
 	return _u.mutation
@@ -285,21 +313,6 @@ func (_u *ExperimentRunUpdate) ExecX(ctx context.Context) {
 	}
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *ExperimentRunUpdate) check() error {
-	if v, ok := _u.mutation.BaselineEvalRunID(); ok {
-		if err := experimentrun.BaselineEvalRunIDValidator(v); err != nil {
-			return &ValidationError{Name: "baseline_eval_run_id", err: fmt.Errorf(`dao: validator failed for field "ExperimentRun.baseline_eval_run_id": %w`, err)}
-		}
-	}
-	if v, ok := _u.mutation.CandidateEvalRunID(); ok {
-		if err := experimentrun.CandidateEvalRunIDValidator(v); err != nil {
-			return &ValidationError{Name: "candidate_eval_run_id", err: fmt.Errorf(`dao: validator failed for field "ExperimentRun.candidate_eval_run_id": %w`, err)}
-		}
-	}
-	return nil
-}
-
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
 func (_u *ExperimentRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExperimentRunUpdate {
 	_u.modifiers = append(_u.modifiers, modifiers...)
@@ -307,9 +320,6 @@ func (_u *ExperimentRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *
 }
 
 func (_u *ExperimentRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
-	}
 	_spec := sqlgraph.NewUpdateSpec(experimentrun.Table, experimentrun.Columns, sqlgraph.NewFieldSpec(experimentrun.FieldID, field.TypeString))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -378,6 +388,12 @@ func (_u *ExperimentRunUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.AddedRegressionCount(); ok {
 		_spec.AddField(experimentrun.FieldRegressionCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(experimentrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(experimentrun.FieldError, field.TypeString, value)
+	}
 	_spec.Node.Schema = _u.schemaConfig.ExperimentRun
 	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
 	_spec.AddModifiers(_u.modifiers...)
@@ -626,6 +642,34 @@ func (_u *ExperimentRunUpdateOne) AddRegressionCount(v int) *ExperimentRunUpdate
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *ExperimentRunUpdateOne) SetStatus(v string) *ExperimentRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ExperimentRunUpdateOne) SetNillableStatus(v *string) *ExperimentRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ExperimentRunUpdateOne) SetError(v string) *ExperimentRunUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ExperimentRunUpdateOne) SetNillableError(v *string) *ExperimentRunUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
 // Mutation returns the ExperimentRunMutation object of the builder.
 func (_u *ExperimentRunUpdateOne) Mutation() *ExperimentRunMutation {
 	return _u.mutation
@@ -671,21 +715,6 @@ func (_u *ExperimentRunUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *ExperimentRunUpdateOne) check() error {
-	if v, ok := _u.mutation.BaselineEvalRunID(); ok {
-		if err := experimentrun.BaselineEvalRunIDValidator(v); err != nil {
-			return &ValidationError{Name: "baseline_eval_run_id", err: fmt.Errorf(`dao: validator failed for field "ExperimentRun.baseline_eval_run_id": %w`, err)}
-		}
-	}
-	if v, ok := _u.mutation.CandidateEvalRunID(); ok {
-		if err := experimentrun.CandidateEvalRunIDValidator(v); err != nil {
-			return &ValidationError{Name: "candidate_eval_run_id", err: fmt.Errorf(`dao: validator failed for field "ExperimentRun.candidate_eval_run_id": %w`, err)}
-		}
-	}
-	return nil
-}
-
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
 func (_u *ExperimentRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExperimentRunUpdateOne {
 	_u.modifiers = append(_u.modifiers, modifiers...)
@@ -693,9 +722,6 @@ func (_u *ExperimentRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)
 }
 
 func (_u *ExperimentRunUpdateOne) sqlSave(ctx context.Context) (_node *ExperimentRun, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
-	}
 	_spec := sqlgraph.NewUpdateSpec(experimentrun.Table, experimentrun.Columns, sqlgraph.NewFieldSpec(experimentrun.FieldID, field.TypeString))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -781,6 +807,12 @@ func (_u *ExperimentRunUpdateOne) sqlSave(ctx context.Context) (_node *Experimen
 	if value, ok := _u.mutation.AddedRegressionCount(); ok {
 		_spec.AddField(experimentrun.FieldRegressionCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(experimentrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(experimentrun.FieldError, field.TypeString, value)
+	}
 	_spec.Node.Schema = _u.schemaConfig.ExperimentRun
 	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
 	_spec.AddModifiers(_u.modifiers...)
@@ -21,6 +21,18 @@ func (f APITokenFunc) Mutate(ctx context.Context, m dao.Mutation) (dao.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *dao.APITokenMutation", m)
 }
 
+// The AnnotationFunc type is an adapter to allow the use of ordinary
+// function as Annotation mutator.
+type AnnotationFunc func(context.Context, *dao.AnnotationMutation) (dao.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AnnotationFunc) Mutate(ctx context.Context, m dao.Mutation) (dao.Value, error) {
+	if mv, ok := m.(*dao.AnnotationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *dao.AnnotationMutation", m)
+}
+
 // The ChannelConfigFunc type is an adapter to allow the use of ordinary
 // function as ChannelConfig mutator.
 type ChannelConfigFunc func(context.Context, *dao.ChannelConfigMutation) (dao.Value, error)
@@ -81,6 +93,30 @@ func (f DatasetExampleFunc) Mutate(ctx context.Context, m dao.Mutation) (dao.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *dao.DatasetExampleMutation", m)
 }
 
+// The DatasetExampleOverrideFunc type is an adapter to allow the use of ordinary
+// function as DatasetExampleOverride mutator.
+type DatasetExampleOverrideFunc func(context.Context, *dao.DatasetExampleOverrideMutation) (dao.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DatasetExampleOverrideFunc) Mutate(ctx context.Context, m dao.Mutation) (dao.Value, error) {
+	if mv, ok := m.(*dao.DatasetExampleOverrideMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *dao.DatasetExampleOverrideMutation", m)
+}
+
+// The DatasetSnapshotFunc type is an adapter to allow the use of ordinary
+// function as DatasetSnapshot mutator.
+type DatasetSnapshotFunc func(context.Context, *dao.DatasetSnapshotMutation) (dao.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DatasetSnapshotFunc) Mutate(ctx context.Context, m dao.Mutation) (dao.Value, error) {
+	if mv, ok := m.(*dao.DatasetSnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *dao.DatasetSnapshotMutation", m)
+}
+
 // The EvalRunFunc type is an adapter to allow the use of ordinary
 // function as EvalRun mutator.
 type EvalRunFunc func(context.Context, *dao.EvalRunMutation) (dao.Value, error)
@@ -141,6 +177,18 @@ func (f TraceLogFunc) Mutate(ctx context.Context, m dao.Mutation) (dao.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *dao.TraceLogMutation", m)
 }
 
+// The TraceTagFunc type is an adapter to allow the use of ordinary
+// function as TraceTag mutator.
+type TraceTagFunc func(context.Context, *dao.TraceTagMutation) (dao.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TraceTagFunc) Mutate(ctx context.Context, m dao.Mutation) (dao.Value, error) {
+	if mv, ok := m.(*dao.TraceTagMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *dao.TraceTagMutation", m)
+}
+
 // The UpstreamModelFunc type is an adapter to allow the use of ordinary
 // function as UpstreamModel mutator.
 type UpstreamModelFunc func(context.Context, *dao.UpstreamModelMutation) (dao.Value, error)
@@ -8,18 +8,22 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/kingfs/llm-tracelab/ent/dao"
+	"github.com/kingfs/llm-tracelab/ent/dao/annotation"
 	"github.com/kingfs/llm-tracelab/ent/dao/apitoken"
 	"github.com/kingfs/llm-tracelab/ent/dao/channelconfig"
 	"github.com/kingfs/llm-tracelab/ent/dao/channelmodel"
 	"github.com/kingfs/llm-tracelab/ent/dao/channelproberun"
 	"github.com/kingfs/llm-tracelab/ent/dao/dataset"
 	"github.com/kingfs/llm-tracelab/ent/dao/datasetexample"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetexampleoverride"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetsnapshot"
 	"github.com/kingfs/llm-tracelab/ent/dao/evalrun"
 	"github.com/kingfs/llm-tracelab/ent/dao/experimentrun"
 	"github.com/kingfs/llm-tracelab/ent/dao/modelcatalog"
 	"github.com/kingfs/llm-tracelab/ent/dao/predicate"
 	"github.com/kingfs/llm-tracelab/ent/dao/score"
 	"github.com/kingfs/llm-tracelab/ent/dao/tracelog"
+	"github.com/kingfs/llm-tracelab/ent/dao/tracetag"
 	"github.com/kingfs/llm-tracelab/ent/dao/upstreammodel"
 	"github.com/kingfs/llm-tracelab/ent/dao/upstreamtarget"
 	"github.com/kingfs/llm-tracelab/ent/dao/user"
@@ -108,6 +112,33 @@ func (f TraverseAPIToken) Traverse(ctx context.Context, q dao.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *dao.APITokenQuery", q)
 }
 
+// The AnnotationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AnnotationFunc func(context.Context, *dao.AnnotationQuery) (dao.Value, error)
+
+// Query calls f(ctx, q).
+func (f AnnotationFunc) Query(ctx context.Context, q dao.Query) (dao.Value, error) {
+	if q, ok := q.(*dao.AnnotationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *dao.AnnotationQuery", q)
+}
+
+// The TraverseAnnotation type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAnnotation func(context.Context, *dao.AnnotationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAnnotation) Intercept(next dao.Querier) dao.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAnnotation) Traverse(ctx context.Context, q dao.Query) error {
+	if q, ok := q.(*dao.AnnotationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *dao.AnnotationQuery", q)
+}
+
 // The ChannelConfigFunc type is an adapter to allow the use of ordinary function as a Querier.
 type ChannelConfigFunc func(context.Context, *dao.ChannelConfigQuery) (dao.Value, error)
 
@@ -243,6 +274,60 @@ func (f TraverseDatasetExample) Traverse(ctx context.Context, q dao.Query) error
 	return fmt.Errorf("unexpected query type %T. expect *dao.DatasetExampleQuery", q)
 }
 
+// The DatasetExampleOverrideFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DatasetExampleOverrideFunc func(context.Context, *dao.DatasetExampleOverrideQuery) (dao.Value, error)
+
+// Query calls f(ctx, q).
+func (f DatasetExampleOverrideFunc) Query(ctx context.Context, q dao.Query) (dao.Value, error) {
+	if q, ok := q.(*dao.DatasetExampleOverrideQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *dao.DatasetExampleOverrideQuery", q)
+}
+
+// The TraverseDatasetExampleOverride type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDatasetExampleOverride func(context.Context, *dao.DatasetExampleOverrideQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDatasetExampleOverride) Intercept(next dao.Querier) dao.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDatasetExampleOverride) Traverse(ctx context.Context, q dao.Query) error {
+	if q, ok := q.(*dao.DatasetExampleOverrideQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *dao.DatasetExampleOverrideQuery", q)
+}
+
+// The DatasetSnapshotFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DatasetSnapshotFunc func(context.Context, *dao.DatasetSnapshotQuery) (dao.Value, error)
+
+// Query calls f(ctx, q).
+func (f DatasetSnapshotFunc) Query(ctx context.Context, q dao.Query) (dao.Value, error) {
+	if q, ok := q.(*dao.DatasetSnapshotQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *dao.DatasetSnapshotQuery", q)
+}
+
+// The TraverseDatasetSnapshot type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDatasetSnapshot func(context.Context, *dao.DatasetSnapshotQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDatasetSnapshot) Intercept(next dao.Querier) dao.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDatasetSnapshot) Traverse(ctx context.Context, q dao.Query) error {
+	if q, ok := q.(*dao.DatasetSnapshotQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *dao.DatasetSnapshotQuery", q)
+}
+
 // The EvalRunFunc type is an adapter to allow the use of ordinary function as a Querier.
 type EvalRunFunc func(context.Context, *dao.EvalRunQuery) (dao.Value, error)
 
@@ -378,6 +463,33 @@ func (f TraverseTraceLog) Traverse(ctx context.Context, q dao.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *dao.TraceLogQuery", q)
 }
 
+// The TraceTagFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TraceTagFunc func(context.Context, *dao.TraceTagQuery) (dao.Value, error)
+
+// Query calls f(ctx, q).
+func (f TraceTagFunc) Query(ctx context.Context, q dao.Query) (dao.Value, error) {
+	if q, ok := q.(*dao.TraceTagQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *dao.TraceTagQuery", q)
+}
+
+// The TraverseTraceTag type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTraceTag func(context.Context, *dao.TraceTagQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTraceTag) Intercept(next dao.Querier) dao.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTraceTag) Traverse(ctx context.Context, q dao.Query) error {
+	if q, ok := q.(*dao.TraceTagQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *dao.TraceTagQuery", q)
+}
+
 // The UpstreamModelFunc type is an adapter to allow the use of ordinary function as a Querier.
 type UpstreamModelFunc func(context.Context, *dao.UpstreamModelQuery) (dao.Value, error)
 
@@ -464,6 +576,8 @@ func NewQuery(q dao.Query) (Query, error) {
 	switch q := q.(type) {
 	case *dao.APITokenQuery:
 		return &query[*dao.APITokenQuery, predicate.APIToken, apitoken.OrderOption]{typ: dao.TypeAPIToken, tq: q}, nil
+	case *dao.AnnotationQuery:
+		return &query[*dao.AnnotationQuery, predicate.Annotation, annotation.OrderOption]{typ: dao.TypeAnnotation, tq: q}, nil
 	case *dao.ChannelConfigQuery:
 		return &query[*dao.ChannelConfigQuery, predicate.ChannelConfig, channelconfig.OrderOption]{typ: dao.TypeChannelConfig, tq: q}, nil
 	case *dao.ChannelModelQuery:
@@ -474,6 +588,10 @@ func NewQuery(q dao.Query) (Query, error) {
 		return &query[*dao.DatasetQuery, predicate.Dataset, dataset.OrderOption]{typ: dao.TypeDataset, tq: q}, nil
 	case *dao.DatasetExampleQuery:
 		return &query[*dao.DatasetExampleQuery, predicate.DatasetExample, datasetexample.OrderOption]{typ: dao.TypeDatasetExample, tq: q}, nil
+	case *dao.DatasetExampleOverrideQuery:
+		return &query[*dao.DatasetExampleOverrideQuery, predicate.DatasetExampleOverride, datasetexampleoverride.OrderOption]{typ: dao.TypeDatasetExampleOverride, tq: q}, nil
+	case *dao.DatasetSnapshotQuery:
+		return &query[*dao.DatasetSnapshotQuery, predicate.DatasetSnapshot, datasetsnapshot.OrderOption]{typ: dao.TypeDatasetSnapshot, tq: q}, nil
 	case *dao.EvalRunQuery:
 		return &query[*dao.EvalRunQuery, predicate.EvalRun, evalrun.OrderOption]{typ: dao.TypeEvalRun, tq: q}, nil
 	case *dao.ExperimentRunQuery:
@@ -484,6 +602,8 @@ func NewQuery(q dao.Query) (Query, error) {
 		return &query[*dao.ScoreQuery, predicate.Score, score.OrderOption]{typ: dao.TypeScore, tq: q}, nil
 	case *dao.TraceLogQuery:
 		return &query[*dao.TraceLogQuery, predicate.TraceLog, tracelog.OrderOption]{typ: dao.TypeTraceLog, tq: q}, nil
+	case *dao.TraceTagQuery:
+		return &query[*dao.TraceTagQuery, predicate.TraceTag, tracetag.OrderOption]{typ: dao.TypeTraceTag, tq: q}, nil
 	case *dao.UpstreamModelQuery:
 		return &query[*dao.UpstreamModelQuery, predicate.UpstreamModel, upstreammodel.OrderOption]{typ: dao.TypeUpstreamModel, tq: q}, nil
 	case *dao.UpstreamTargetQuery:
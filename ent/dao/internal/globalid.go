@@ -2,4 +2,4 @@
 
 package internal
 
-const IncrementStarts = "{\"api_tokens\":0,\"channel_configs\":42949672960,\"channel_models\":47244640256,\"channel_probe_runs\":51539607552,\"dataset_examples\":12884901888,\"datasets\":8589934592,\"eval_runs\":17179869184,\"experiment_runs\":21474836480,\"logs\":30064771072,\"model_catalog\":55834574848,\"scores\":25769803776,\"upstream_models\":34359738368,\"upstream_targets\":38654705664,\"users\":4294967296}"
+const IncrementStarts = "{\"annotations\":60129542144,\"api_tokens\":0,\"channel_configs\":42949672960,\"channel_models\":47244640256,\"channel_probe_runs\":51539607552,\"dataset_example_overrides\":73014444032,\"dataset_examples\":12884901888,\"dataset_snapshots\":68719476736,\"datasets\":8589934592,\"eval_runs\":17179869184,\"experiment_runs\":21474836480,\"logs\":30064771072,\"model_catalog\":55834574848,\"scores\":25769803776,\"trace_tags\":64424509440,\"upstream_models\":34359738368,\"upstream_targets\":38654705664,\"users\":4294967296}"
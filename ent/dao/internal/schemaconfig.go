@@ -7,20 +7,24 @@ import "context"
 // SchemaConfig represents alternative schema names for all tables
 // that can be passed at runtime.
 type SchemaConfig struct {
-	APIToken        string // APIToken table.
-	ChannelConfig   string // ChannelConfig table.
-	ChannelModel    string // ChannelModel table.
-	ChannelProbeRun string // ChannelProbeRun table.
-	Dataset         string // Dataset table.
-	DatasetExample  string // DatasetExample table.
-	EvalRun         string // EvalRun table.
-	ExperimentRun   string // ExperimentRun table.
-	ModelCatalog    string // ModelCatalog table.
-	Score           string // Score table.
-	TraceLog        string // TraceLog table.
-	UpstreamModel   string // UpstreamModel table.
-	UpstreamTarget  string // UpstreamTarget table.
-	User            string // User table.
+	APIToken               string // APIToken table.
+	Annotation             string // Annotation table.
+	ChannelConfig          string // ChannelConfig table.
+	ChannelModel           string // ChannelModel table.
+	ChannelProbeRun        string // ChannelProbeRun table.
+	Dataset                string // Dataset table.
+	DatasetExample         string // DatasetExample table.
+	DatasetExampleOverride string // DatasetExampleOverride table.
+	DatasetSnapshot        string // DatasetSnapshot table.
+	EvalRun                string // EvalRun table.
+	ExperimentRun          string // ExperimentRun table.
+	ModelCatalog           string // ModelCatalog table.
+	Score                  string // Score table.
+	TraceLog               string // TraceLog table.
+	TraceTag               string // TraceTag table.
+	UpstreamModel          string // UpstreamModel table.
+	UpstreamTarget         string // UpstreamTarget table.
+	User                   string // User table.
 }
 
 type schemaCtxKey struct{}
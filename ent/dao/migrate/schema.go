@@ -48,6 +48,35 @@ var (
 			},
 		},
 	}
+	// AnnotationsColumns holds the columns for the "annotations" table.
+	AnnotationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "target_type", Type: field.TypeString},
+		{Name: "target_id", Type: field.TypeString},
+		{Name: "parent_id", Type: field.TypeString, Default: ""},
+		{Name: "author", Type: field.TypeString, Default: ""},
+		{Name: "body", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// AnnotationsTable holds the schema information for the "annotations" table.
+	AnnotationsTable = &schema.Table{
+		Name:       "annotations",
+		Columns:    AnnotationsColumns,
+		PrimaryKey: []*schema.Column{AnnotationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "annotation_target_type_target_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AnnotationsColumns[1], AnnotationsColumns[2], AnnotationsColumns[6]},
+			},
+			{
+				Name:    "annotation_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{AnnotationsColumns[3]},
+			},
+		},
+	}
 	// ChannelConfigsColumns holds the columns for the "channel_configs" table.
 	ChannelConfigsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -222,6 +251,54 @@ var (
 			},
 		},
 	}
+	// DatasetExampleOverridesColumns holds the columns for the "dataset_example_overrides" table.
+	DatasetExampleOverridesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "dataset_id", Type: field.TypeString},
+		{Name: "trace_id", Type: field.TypeString},
+		{Name: "expected_output", Type: field.TypeString, Default: ""},
+		{Name: "tags_json", Type: field.TypeString, Default: "[]"},
+		{Name: "input_override_json", Type: field.TypeString, Default: ""},
+		{Name: "updated_by", Type: field.TypeString, Default: ""},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DatasetExampleOverridesTable holds the schema information for the "dataset_example_overrides" table.
+	DatasetExampleOverridesTable = &schema.Table{
+		Name:       "dataset_example_overrides",
+		Columns:    DatasetExampleOverridesColumns,
+		PrimaryKey: []*schema.Column{DatasetExampleOverridesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "datasetexampleoverride_dataset_id_trace_id",
+				Unique:  true,
+				Columns: []*schema.Column{DatasetExampleOverridesColumns[1], DatasetExampleOverridesColumns[2]},
+			},
+		},
+	}
+	// DatasetSnapshotsColumns holds the columns for the "dataset_snapshots" table.
+	DatasetSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "dataset_id", Type: field.TypeString},
+		{Name: "version", Type: field.TypeInt, Default: 0},
+		{Name: "note", Type: field.TypeString, Default: ""},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "example_count", Type: field.TypeInt, Default: 0},
+		{Name: "examples_json", Type: field.TypeString, Default: "[]"},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// DatasetSnapshotsTable holds the schema information for the "dataset_snapshots" table.
+	DatasetSnapshotsTable = &schema.Table{
+		Name:       "dataset_snapshots",
+		Columns:    DatasetSnapshotsColumns,
+		PrimaryKey: []*schema.Column{DatasetSnapshotsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "datasetsnapshot_dataset_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{DatasetSnapshotsColumns[1], DatasetSnapshotsColumns[2]},
+			},
+		},
+	}
 	// EvalRunsColumns holds the columns for the "eval_runs" table.
 	EvalRunsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -265,6 +342,8 @@ var (
 		{Name: "matched_score_count", Type: field.TypeInt, Default: 0},
 		{Name: "improvement_count", Type: field.TypeInt, Default: 0},
 		{Name: "regression_count", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeString, Default: "completed"},
+		{Name: "error", Type: field.TypeString, Default: ""},
 	}
 	// ExperimentRunsTable holds the schema information for the "experiment_runs" table.
 	ExperimentRunsTable = &schema.Table{
@@ -380,6 +459,8 @@ var (
 		{Name: "routing_score", Type: field.TypeFloat64, Default: 0},
 		{Name: "routing_candidate_count", Type: field.TypeInt, Default: 0},
 		{Name: "routing_failure_reason", Type: field.TypeString, Default: ""},
+		{Name: "token_name", Type: field.TypeString, Default: ""},
+		{Name: "client_canceled", Type: field.TypeBool, Default: false},
 	}
 	// LogsTable holds the schema information for the "logs" table.
 	LogsTable = &schema.Table{
@@ -407,6 +488,38 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{LogsColumns[5]},
 			},
+			{
+				Name:    "tracelog_token_name_recorded_at",
+				Unique:  false,
+				Columns: []*schema.Column{LogsColumns[39], LogsColumns[6]},
+			},
+		},
+	}
+	// TraceTagsColumns holds the columns for the "trace_tags" table.
+	TraceTagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "target_type", Type: field.TypeString},
+		{Name: "target_id", Type: field.TypeString},
+		{Name: "tag", Type: field.TypeString},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TraceTagsTable holds the schema information for the "trace_tags" table.
+	TraceTagsTable = &schema.Table{
+		Name:       "trace_tags",
+		Columns:    TraceTagsColumns,
+		PrimaryKey: []*schema.Column{TraceTagsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tracetag_target_type_target_id_tag",
+				Unique:  true,
+				Columns: []*schema.Column{TraceTagsColumns[1], TraceTagsColumns[2], TraceTagsColumns[3]},
+			},
+			{
+				Name:    "tracetag_tag",
+				Unique:  false,
+				Columns: []*schema.Column{TraceTagsColumns[3]},
+			},
 		},
 	}
 	// UpstreamModelsColumns holds the columns for the "upstream_models" table.
@@ -476,16 +589,20 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		APITokensTable,
+		AnnotationsTable,
 		ChannelConfigsTable,
 		ChannelModelsTable,
 		ChannelProbeRunsTable,
 		DatasetsTable,
 		DatasetExamplesTable,
+		DatasetExampleOverridesTable,
+		DatasetSnapshotsTable,
 		EvalRunsTable,
 		ExperimentRunsTable,
 		ModelCatalogTable,
 		ScoresTable,
 		LogsTable,
+		TraceTagsTable,
 		UpstreamModelsTable,
 		UpstreamTargetsTable,
 		UsersTable,
@@ -497,6 +614,10 @@ func init() {
 	APITokensTable.Annotation = &entsql.Annotation{
 		IncrementStart: func(i int) *int { return &i }(0),
 	}
+	AnnotationsTable.Annotation = &entsql.Annotation{
+		Table:          "annotations",
+		IncrementStart: func(i int) *int { return &i }(60129542144),
+	}
 	ChannelConfigsTable.Annotation = &entsql.Annotation{
 		Table:          "channel_configs",
 		IncrementStart: func(i int) *int { return &i }(42949672960),
@@ -517,6 +638,14 @@ func init() {
 		Table:          "dataset_examples",
 		IncrementStart: func(i int) *int { return &i }(12884901888),
 	}
+	DatasetExampleOverridesTable.Annotation = &entsql.Annotation{
+		Table:          "dataset_example_overrides",
+		IncrementStart: func(i int) *int { return &i }(73014444032),
+	}
+	DatasetSnapshotsTable.Annotation = &entsql.Annotation{
+		Table:          "dataset_snapshots",
+		IncrementStart: func(i int) *int { return &i }(68719476736),
+	}
 	EvalRunsTable.Annotation = &entsql.Annotation{
 		Table:          "eval_runs",
 		IncrementStart: func(i int) *int { return &i }(17179869184),
@@ -537,6 +666,10 @@ func init() {
 		Table:          "logs",
 		IncrementStart: func(i int) *int { return &i }(30064771072),
 	}
+	TraceTagsTable.Annotation = &entsql.Annotation{
+		Table:          "trace_tags",
+		IncrementStart: func(i int) *int { return &i }(64424509440),
+	}
 	UpstreamModelsTable.Annotation = &entsql.Annotation{
 		Table:          "upstream_models",
 		IncrementStart: func(i int) *int { return &i }(34359738368),
@@ -11,18 +11,22 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/kingfs/llm-tracelab/ent/dao/annotation"
 	"github.com/kingfs/llm-tracelab/ent/dao/apitoken"
 	"github.com/kingfs/llm-tracelab/ent/dao/channelconfig"
 	"github.com/kingfs/llm-tracelab/ent/dao/channelmodel"
 	"github.com/kingfs/llm-tracelab/ent/dao/channelproberun"
 	"github.com/kingfs/llm-tracelab/ent/dao/dataset"
 	"github.com/kingfs/llm-tracelab/ent/dao/datasetexample"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetexampleoverride"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetsnapshot"
 	"github.com/kingfs/llm-tracelab/ent/dao/evalrun"
 	"github.com/kingfs/llm-tracelab/ent/dao/experimentrun"
 	"github.com/kingfs/llm-tracelab/ent/dao/modelcatalog"
 	"github.com/kingfs/llm-tracelab/ent/dao/predicate"
 	"github.com/kingfs/llm-tracelab/ent/dao/score"
 	"github.com/kingfs/llm-tracelab/ent/dao/tracelog"
+	"github.com/kingfs/llm-tracelab/ent/dao/tracetag"
 	"github.com/kingfs/llm-tracelab/ent/dao/upstreammodel"
 	"github.com/kingfs/llm-tracelab/ent/dao/upstreamtarget"
 	"github.com/kingfs/llm-tracelab/ent/dao/user"
@@ -37,20 +41,24 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAPIToken        = "APIToken"
-	TypeChannelConfig   = "ChannelConfig"
-	TypeChannelModel    = "ChannelModel"
-	TypeChannelProbeRun = "ChannelProbeRun"
-	TypeDataset         = "Dataset"
-	TypeDatasetExample  = "DatasetExample"
-	TypeEvalRun         = "EvalRun"
-	TypeExperimentRun   = "ExperimentRun"
-	TypeModelCatalog    = "ModelCatalog"
-	TypeScore           = "Score"
-	TypeTraceLog        = "TraceLog"
-	TypeUpstreamModel   = "UpstreamModel"
-	TypeUpstreamTarget  = "UpstreamTarget"
-	TypeUser            = "User"
+	TypeAPIToken               = "APIToken"
+	TypeAnnotation             = "Annotation"
+	TypeChannelConfig          = "ChannelConfig"
+	TypeChannelModel           = "ChannelModel"
+	TypeChannelProbeRun        = "ChannelProbeRun"
+	TypeDataset                = "Dataset"
+	TypeDatasetExample         = "DatasetExample"
+	TypeDatasetExampleOverride = "DatasetExampleOverride"
+	TypeDatasetSnapshot        = "DatasetSnapshot"
+	TypeEvalRun                = "EvalRun"
+	TypeExperimentRun          = "ExperimentRun"
+	TypeModelCatalog           = "ModelCatalog"
+	TypeScore                  = "Score"
+	TypeTraceLog               = "TraceLog"
+	TypeTraceTag               = "TraceTag"
+	TypeUpstreamModel          = "UpstreamModel"
+	TypeUpstreamTarget         = "UpstreamTarget"
+	TypeUser                   = "User"
 )
 
 // APITokenMutation represents an operation that mutates the APIToken nodes in the graph.
@@ -865,58 +873,36 @@ func (m *APITokenMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown APIToken edge %s", name)
 }
 
-// ChannelConfigMutation represents an operation that mutates the ChannelConfig nodes in the graph.
-type ChannelConfigMutation struct {
+// AnnotationMutation represents an operation that mutates the Annotation nodes in the graph.
+type AnnotationMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *string
-	name                 *string
-	description          *string
-	source               *string
-	base_url             *string
-	provider_preset      *string
-	protocol_family      *string
-	routing_profile      *string
-	api_version          *string
-	deployment           *string
-	project              *string
-	location             *string
-	model_resource       *string
-	api_key_ciphertext   *[]byte
-	api_key_hint         *string
-	headers_json         *string
-	enabled              *bool
-	priority             *int
-	addpriority          *int
-	weight               *float64
-	addweight            *float64
-	capacity_hint        *float64
-	addcapacity_hint     *float64
-	model_discovery      *string
-	allow_unknown_models *bool
-	created_at           *time.Time
-	updated_at           *time.Time
-	last_probe_at        *time.Time
-	last_probe_status    *string
-	last_probe_error     *string
-	clearedFields        map[string]struct{}
-	done                 bool
-	oldValue             func(context.Context) (*ChannelConfig, error)
-	predicates           []predicate.ChannelConfig
+	op            Op
+	typ           string
+	id            *string
+	target_type   *string
+	target_id     *string
+	parent_id     *string
+	author        *string
+	body          *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Annotation, error)
+	predicates    []predicate.Annotation
 }
 
-var _ ent.Mutation = (*ChannelConfigMutation)(nil)
+var _ ent.Mutation = (*AnnotationMutation)(nil)
 
-// channelconfigOption allows management of the mutation configuration using functional options.
-type channelconfigOption func(*ChannelConfigMutation)
+// annotationOption allows management of the mutation configuration using functional options.
+type annotationOption func(*AnnotationMutation)
 
-// newChannelConfigMutation creates new mutation for the ChannelConfig entity.
-func newChannelConfigMutation(c config, op Op, opts ...channelconfigOption) *ChannelConfigMutation {
-	m := &ChannelConfigMutation{
+// newAnnotationMutation creates new mutation for the Annotation entity.
+func newAnnotationMutation(c config, op Op, opts ...annotationOption) *AnnotationMutation {
+	m := &AnnotationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeChannelConfig,
+		typ:           TypeAnnotation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -925,20 +911,20 @@ func newChannelConfigMutation(c config, op Op, opts ...channelconfigOption) *Cha
 	return m
 }
 
-// withChannelConfigID sets the ID field of the mutation.
-func withChannelConfigID(id string) channelconfigOption {
-	return func(m *ChannelConfigMutation) {
+// withAnnotationID sets the ID field of the mutation.
+func withAnnotationID(id string) annotationOption {
+	return func(m *AnnotationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ChannelConfig
+			value *Annotation
 		)
-		m.oldValue = func(ctx context.Context) (*ChannelConfig, error) {
+		m.oldValue = func(ctx context.Context) (*Annotation, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ChannelConfig.Get(ctx, id)
+					value, err = m.Client().Annotation.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -947,10 +933,10 @@ func withChannelConfigID(id string) channelconfigOption {
 	}
 }
 
-// withChannelConfig sets the old ChannelConfig of the mutation.
-func withChannelConfig(node *ChannelConfig) channelconfigOption {
-	return func(m *ChannelConfigMutation) {
-		m.oldValue = func(context.Context) (*ChannelConfig, error) {
+// withAnnotation sets the old Annotation of the mutation.
+func withAnnotation(node *Annotation) annotationOption {
+	return func(m *AnnotationMutation) {
+		m.oldValue = func(context.Context) (*Annotation, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -959,7 +945,7 @@ func withChannelConfig(node *ChannelConfig) channelconfigOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChannelConfigMutation) Client() *Client {
+func (m AnnotationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -967,7 +953,7 @@ func (m ChannelConfigMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ChannelConfigMutation) Tx() (*Tx, error) {
+func (m AnnotationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("dao: mutation is not running in a transaction")
 	}
@@ -977,14 +963,14 @@ func (m ChannelConfigMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ChannelConfig entities.
-func (m *ChannelConfigMutation) SetID(id string) {
+// operation is only accepted on creation of Annotation entities.
+func (m *AnnotationMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ChannelConfigMutation) ID() (id string, exists bool) {
+func (m *AnnotationMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -995,7 +981,7 @@ func (m *ChannelConfigMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ChannelConfigMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *AnnotationMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1004,1146 +990,3267 @@ func (m *ChannelConfigMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ChannelConfig.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Annotation.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *ChannelConfigMutation) SetName(s string) {
-	m.name = &s
+// SetTargetType sets the "target_type" field.
+func (m *AnnotationMutation) SetTargetType(s string) {
+	m.target_type = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ChannelConfigMutation) Name() (r string, exists bool) {
-	v := m.name
+// TargetType returns the value of the "target_type" field in the mutation.
+func (m *AnnotationMutation) TargetType() (r string, exists bool) {
+	v := m.target_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ChannelConfig entity.
-// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetType returns the old "target_type" field's value of the Annotation entity.
+// If the Annotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AnnotationMutation) OldTargetType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTargetType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetType: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.TargetType, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ChannelConfigMutation) ResetName() {
-	m.name = nil
+// ResetTargetType resets all changes to the "target_type" field.
+func (m *AnnotationMutation) ResetTargetType() {
+	m.target_type = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ChannelConfigMutation) SetDescription(s string) {
-	m.description = &s
+// SetTargetID sets the "target_id" field.
+func (m *AnnotationMutation) SetTargetID(s string) {
+	m.target_id = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ChannelConfigMutation) Description() (r string, exists bool) {
-	v := m.description
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *AnnotationMutation) TargetID() (r string, exists bool) {
+	v := m.target_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the ChannelConfig entity.
-// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetID returns the old "target_id" field's value of the Annotation entity.
+// If the Annotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *AnnotationMutation) OldTargetID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.TargetID, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ChannelConfigMutation) ResetDescription() {
-	m.description = nil
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *AnnotationMutation) ResetTargetID() {
+	m.target_id = nil
 }
 
-// SetSource sets the "source" field.
-func (m *ChannelConfigMutation) SetSource(s string) {
-	m.source = &s
+// SetParentID sets the "parent_id" field.
+func (m *AnnotationMutation) SetParentID(s string) {
+	m.parent_id = &s
 }
 
-// Source returns the value of the "source" field in the mutation.
-func (m *ChannelConfigMutation) Source() (r string, exists bool) {
-	v := m.source
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *AnnotationMutation) ParentID() (r string, exists bool) {
+	v := m.parent_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSource returns the old "source" field's value of the ChannelConfig entity.
-// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// OldParentID returns the old "parent_id" field's value of the Annotation entity.
+// If the Annotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldSource(ctx context.Context) (v string, err error) {
+func (m *AnnotationMutation) OldParentID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
+		return v, errors.New("OldParentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
 	}
-	return oldValue.Source, nil
+	return oldValue.ParentID, nil
 }
 
-// ResetSource resets all changes to the "source" field.
-func (m *ChannelConfigMutation) ResetSource() {
-	m.source = nil
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *AnnotationMutation) ResetParentID() {
+	m.parent_id = nil
 }
 
-// SetBaseURL sets the "base_url" field.
-func (m *ChannelConfigMutation) SetBaseURL(s string) {
-	m.base_url = &s
+// SetAuthor sets the "author" field.
+func (m *AnnotationMutation) SetAuthor(s string) {
+	m.author = &s
 }
 
-// BaseURL returns the value of the "base_url" field in the mutation.
-func (m *ChannelConfigMutation) BaseURL() (r string, exists bool) {
-	v := m.base_url
+// Author returns the value of the "author" field in the mutation.
+func (m *AnnotationMutation) Author() (r string, exists bool) {
+	v := m.author
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBaseURL returns the old "base_url" field's value of the ChannelConfig entity.
-// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthor returns the old "author" field's value of the Annotation entity.
+// If the Annotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldBaseURL(ctx context.Context) (v string, err error) {
+func (m *AnnotationMutation) OldAuthor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBaseURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBaseURL requires an ID field in the mutation")
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBaseURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
 	}
-	return oldValue.BaseURL, nil
+	return oldValue.Author, nil
 }
 
-// ResetBaseURL resets all changes to the "base_url" field.
-func (m *ChannelConfigMutation) ResetBaseURL() {
-	m.base_url = nil
+// ResetAuthor resets all changes to the "author" field.
+func (m *AnnotationMutation) ResetAuthor() {
+	m.author = nil
 }
 
-// SetProviderPreset sets the "provider_preset" field.
-func (m *ChannelConfigMutation) SetProviderPreset(s string) {
-	m.provider_preset = &s
+// SetBody sets the "body" field.
+func (m *AnnotationMutation) SetBody(s string) {
+	m.body = &s
 }
 
-// ProviderPreset returns the value of the "provider_preset" field in the mutation.
-func (m *ChannelConfigMutation) ProviderPreset() (r string, exists bool) {
-	v := m.provider_preset
+// Body returns the value of the "body" field in the mutation.
+func (m *AnnotationMutation) Body() (r string, exists bool) {
+	v := m.body
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProviderPreset returns the old "provider_preset" field's value of the ChannelConfig entity.
-// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// OldBody returns the old "body" field's value of the Annotation entity.
+// If the Annotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldProviderPreset(ctx context.Context) (v string, err error) {
+func (m *AnnotationMutation) OldBody(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProviderPreset is only allowed on UpdateOne operations")
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProviderPreset requires an ID field in the mutation")
+		return v, errors.New("OldBody requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProviderPreset: %w", err)
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
 	}
-	return oldValue.ProviderPreset, nil
+	return oldValue.Body, nil
 }
 
-// ResetProviderPreset resets all changes to the "provider_preset" field.
-func (m *ChannelConfigMutation) ResetProviderPreset() {
-	m.provider_preset = nil
+// ResetBody resets all changes to the "body" field.
+func (m *AnnotationMutation) ResetBody() {
+	m.body = nil
 }
 
-// SetProtocolFamily sets the "protocol_family" field.
-func (m *ChannelConfigMutation) SetProtocolFamily(s string) {
-	m.protocol_family = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *AnnotationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ProtocolFamily returns the value of the "protocol_family" field in the mutation.
-func (m *ChannelConfigMutation) ProtocolFamily() (r string, exists bool) {
-	v := m.protocol_family
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AnnotationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProtocolFamily returns the old "protocol_family" field's value of the ChannelConfig entity.
-// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Annotation entity.
+// If the Annotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldProtocolFamily(ctx context.Context) (v string, err error) {
+func (m *AnnotationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProtocolFamily is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProtocolFamily requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProtocolFamily: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.ProtocolFamily, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetProtocolFamily resets all changes to the "protocol_family" field.
-func (m *ChannelConfigMutation) ResetProtocolFamily() {
-	m.protocol_family = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AnnotationMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetRoutingProfile sets the "routing_profile" field.
-func (m *ChannelConfigMutation) SetRoutingProfile(s string) {
-	m.routing_profile = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *AnnotationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RoutingProfile returns the value of the "routing_profile" field in the mutation.
-func (m *ChannelConfigMutation) RoutingProfile() (r string, exists bool) {
-	v := m.routing_profile
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *AnnotationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRoutingProfile returns the old "routing_profile" field's value of the ChannelConfig entity.
-// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Annotation entity.
+// If the Annotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldRoutingProfile(ctx context.Context) (v string, err error) {
+func (m *AnnotationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoutingProfile is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoutingProfile requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoutingProfile: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.RoutingProfile, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetRoutingProfile resets all changes to the "routing_profile" field.
-func (m *ChannelConfigMutation) ResetRoutingProfile() {
-	m.routing_profile = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *AnnotationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetAPIVersion sets the "api_version" field.
-func (m *ChannelConfigMutation) SetAPIVersion(s string) {
-	m.api_version = &s
+// Where appends a list predicates to the AnnotationMutation builder.
+func (m *AnnotationMutation) Where(ps ...predicate.Annotation) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// APIVersion returns the value of the "api_version" field in the mutation.
-func (m *ChannelConfigMutation) APIVersion() (r string, exists bool) {
-	v := m.api_version
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the AnnotationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AnnotationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Annotation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldAPIVersion returns the old "api_version" field's value of the ChannelConfig entity.
-// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldAPIVersion(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIVersion is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIVersion requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIVersion: %w", err)
-	}
-	return oldValue.APIVersion, nil
+// Op returns the operation name.
+func (m *AnnotationMutation) Op() Op {
+	return m.op
 }
 
-// ResetAPIVersion resets all changes to the "api_version" field.
-func (m *ChannelConfigMutation) ResetAPIVersion() {
-	m.api_version = nil
+// SetOp allows setting the mutation operation.
+func (m *AnnotationMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetDeployment sets the "deployment" field.
-func (m *ChannelConfigMutation) SetDeployment(s string) {
-	m.deployment = &s
+// Type returns the node type of this mutation (Annotation).
+func (m *AnnotationMutation) Type() string {
+	return m.typ
 }
 
-// Deployment returns the value of the "deployment" field in the mutation.
-func (m *ChannelConfigMutation) Deployment() (r string, exists bool) {
-	v := m.deployment
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AnnotationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.target_type != nil {
+		fields = append(fields, annotation.FieldTargetType)
 	}
-	return *v, true
-}
-
-// OldDeployment returns the old "deployment" field's value of the ChannelConfig entity.
-// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldDeployment(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeployment is only allowed on UpdateOne operations")
+	if m.target_id != nil {
+		fields = append(fields, annotation.FieldTargetID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeployment requires an ID field in the mutation")
+	if m.parent_id != nil {
+		fields = append(fields, annotation.FieldParentID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeployment: %w", err)
+	if m.author != nil {
+		fields = append(fields, annotation.FieldAuthor)
 	}
-	return oldValue.Deployment, nil
-}
-
-// ResetDeployment resets all changes to the "deployment" field.
-func (m *ChannelConfigMutation) ResetDeployment() {
-	m.deployment = nil
-}
-
-// SetProject sets the "project" field.
-func (m *ChannelConfigMutation) SetProject(s string) {
-	m.project = &s
+	if m.body != nil {
+		fields = append(fields, annotation.FieldBody)
+	}
+	if m.created_at != nil {
+		fields = append(fields, annotation.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, annotation.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// Project returns the value of the "project" field in the mutation.
-func (m *ChannelConfigMutation) Project() (r string, exists bool) {
-	v := m.project
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AnnotationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case annotation.FieldTargetType:
+		return m.TargetType()
+	case annotation.FieldTargetID:
+		return m.TargetID()
+	case annotation.FieldParentID:
+		return m.ParentID()
+	case annotation.FieldAuthor:
+		return m.Author()
+	case annotation.FieldBody:
+		return m.Body()
+	case annotation.FieldCreatedAt:
+		return m.CreatedAt()
+	case annotation.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldProject returns the old "project" field's value of the ChannelConfig entity.
-// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldProject(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProject is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProject requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProject: %w", err)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AnnotationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case annotation.FieldTargetType:
+		return m.OldTargetType(ctx)
+	case annotation.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case annotation.FieldParentID:
+		return m.OldParentID(ctx)
+	case annotation.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case annotation.FieldBody:
+		return m.OldBody(ctx)
+	case annotation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case annotation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return oldValue.Project, nil
+	return nil, fmt.Errorf("unknown Annotation field %s", name)
 }
 
-// ResetProject resets all changes to the "project" field.
-func (m *ChannelConfigMutation) ResetProject() {
-	m.project = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AnnotationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case annotation.FieldTargetType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetType(v)
+		return nil
+	case annotation.FieldTargetID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetID(v)
+		return nil
+	case annotation.FieldParentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case annotation.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case annotation.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case annotation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case annotation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Annotation field %s", name)
 }
 
-// SetLocation sets the "location" field.
-func (m *ChannelConfigMutation) SetLocation(s string) {
-	m.location = &s
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AnnotationMutation) AddedFields() []string {
+	return nil
 }
 
-// Location returns the value of the "location" field in the mutation.
-func (m *ChannelConfigMutation) Location() (r string, exists bool) {
-	v := m.location
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AnnotationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// OldLocation returns the old "location" field's value of the ChannelConfig entity.
-// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldLocation(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLocation requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AnnotationMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return oldValue.Location, nil
+	return fmt.Errorf("unknown Annotation numeric field %s", name)
 }
 
-// ResetLocation resets all changes to the "location" field.
-func (m *ChannelConfigMutation) ResetLocation() {
-	m.location = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AnnotationMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetModelResource sets the "model_resource" field.
-func (m *ChannelConfigMutation) SetModelResource(s string) {
-	m.model_resource = &s
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AnnotationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ModelResource returns the value of the "model_resource" field in the mutation.
-func (m *ChannelConfigMutation) ModelResource() (r string, exists bool) {
-	v := m.model_resource
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AnnotationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Annotation nullable field %s", name)
 }
 
-// OldModelResource returns the old "model_resource" field's value of the ChannelConfig entity.
-// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldModelResource(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelResource is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelResource requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelResource: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AnnotationMutation) ResetField(name string) error {
+	switch name {
+	case annotation.FieldTargetType:
+		m.ResetTargetType()
+		return nil
+	case annotation.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	case annotation.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case annotation.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case annotation.FieldBody:
+		m.ResetBody()
+		return nil
+	case annotation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case annotation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.ModelResource, nil
+	return fmt.Errorf("unknown Annotation field %s", name)
 }
 
-// ResetModelResource resets all changes to the "model_resource" field.
-func (m *ChannelConfigMutation) ResetModelResource() {
-	m.model_resource = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AnnotationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetAPIKeyCiphertext sets the "api_key_ciphertext" field.
-func (m *ChannelConfigMutation) SetAPIKeyCiphertext(b []byte) {
-	m.api_key_ciphertext = &b
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AnnotationMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// APIKeyCiphertext returns the value of the "api_key_ciphertext" field in the mutation.
-func (m *ChannelConfigMutation) APIKeyCiphertext() (r []byte, exists bool) {
-	v := m.api_key_ciphertext
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AnnotationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldAPIKeyCiphertext returns the old "api_key_ciphertext" field's value of the ChannelConfig entity.
-// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldAPIKeyCiphertext(ctx context.Context) (v *[]byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKeyCiphertext is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKeyCiphertext requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKeyCiphertext: %w", err)
-	}
-	return oldValue.APIKeyCiphertext, nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AnnotationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ClearAPIKeyCiphertext clears the value of the "api_key_ciphertext" field.
-func (m *ChannelConfigMutation) ClearAPIKeyCiphertext() {
-	m.api_key_ciphertext = nil
-	m.clearedFields[channelconfig.FieldAPIKeyCiphertext] = struct{}{}
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AnnotationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// APIKeyCiphertextCleared returns if the "api_key_ciphertext" field was cleared in this mutation.
-func (m *ChannelConfigMutation) APIKeyCiphertextCleared() bool {
-	_, ok := m.clearedFields[channelconfig.FieldAPIKeyCiphertext]
-	return ok
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AnnotationMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ResetAPIKeyCiphertext resets all changes to the "api_key_ciphertext" field.
-func (m *ChannelConfigMutation) ResetAPIKeyCiphertext() {
-	m.api_key_ciphertext = nil
-	delete(m.clearedFields, channelconfig.FieldAPIKeyCiphertext)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AnnotationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Annotation unique edge %s", name)
 }
 
-// SetAPIKeyHint sets the "api_key_hint" field.
-func (m *ChannelConfigMutation) SetAPIKeyHint(s string) {
-	m.api_key_hint = &s
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AnnotationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Annotation edge %s", name)
 }
 
-// APIKeyHint returns the value of the "api_key_hint" field in the mutation.
-func (m *ChannelConfigMutation) APIKeyHint() (r string, exists bool) {
-	v := m.api_key_hint
-	if v == nil {
-		return
+// ChannelConfigMutation represents an operation that mutates the ChannelConfig nodes in the graph.
+type ChannelConfigMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *string
+	name                 *string
+	description          *string
+	source               *string
+	base_url             *string
+	provider_preset      *string
+	protocol_family      *string
+	routing_profile      *string
+	api_version          *string
+	deployment           *string
+	project              *string
+	location             *string
+	model_resource       *string
+	api_key_ciphertext   *[]byte
+	api_key_hint         *string
+	headers_json         *string
+	enabled              *bool
+	priority             *int
+	addpriority          *int
+	weight               *float64
+	addweight            *float64
+	capacity_hint        *float64
+	addcapacity_hint     *float64
+	model_discovery      *string
+	allow_unknown_models *bool
+	created_at           *time.Time
+	updated_at           *time.Time
+	last_probe_at        *time.Time
+	last_probe_status    *string
+	last_probe_error     *string
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*ChannelConfig, error)
+	predicates           []predicate.ChannelConfig
+}
+
+var _ ent.Mutation = (*ChannelConfigMutation)(nil)
+
+// channelconfigOption allows management of the mutation configuration using functional options.
+type channelconfigOption func(*ChannelConfigMutation)
+
+// newChannelConfigMutation creates new mutation for the ChannelConfig entity.
+func newChannelConfigMutation(c config, op Op, opts ...channelconfigOption) *ChannelConfigMutation {
+	m := &ChannelConfigMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChannelConfig,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChannelConfigID sets the ID field of the mutation.
+func withChannelConfigID(id string) channelconfigOption {
+	return func(m *ChannelConfigMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChannelConfig
+		)
+		m.oldValue = func(ctx context.Context) (*ChannelConfig, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChannelConfig.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChannelConfig sets the old ChannelConfig of the mutation.
+func withChannelConfig(node *ChannelConfig) channelconfigOption {
+	return func(m *ChannelConfigMutation) {
+		m.oldValue = func(context.Context) (*ChannelConfig, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChannelConfigMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChannelConfigMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("dao: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ChannelConfig entities.
+func (m *ChannelConfigMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChannelConfigMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChannelConfigMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChannelConfig.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ChannelConfigMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ChannelConfigMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
 	return *v, true
 }
 
-// OldAPIKeyHint returns the old "api_key_hint" field's value of the ChannelConfig entity.
+// OldName returns the old "name" field's value of the ChannelConfig entity.
 // If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldAPIKeyHint(ctx context.Context) (v string, err error) {
+func (m *ChannelConfigMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKeyHint is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKeyHint requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKeyHint: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.APIKeyHint, nil
+	return oldValue.Name, nil
 }
 
-// ResetAPIKeyHint resets all changes to the "api_key_hint" field.
-func (m *ChannelConfigMutation) ResetAPIKeyHint() {
-	m.api_key_hint = nil
+// ResetName resets all changes to the "name" field.
+func (m *ChannelConfigMutation) ResetName() {
+	m.name = nil
 }
 
-// SetHeadersJSON sets the "headers_json" field.
-func (m *ChannelConfigMutation) SetHeadersJSON(s string) {
-	m.headers_json = &s
+// SetDescription sets the "description" field.
+func (m *ChannelConfigMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// HeadersJSON returns the value of the "headers_json" field in the mutation.
-func (m *ChannelConfigMutation) HeadersJSON() (r string, exists bool) {
-	v := m.headers_json
+// Description returns the value of the "description" field in the mutation.
+func (m *ChannelConfigMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHeadersJSON returns the old "headers_json" field's value of the ChannelConfig entity.
+// OldDescription returns the old "description" field's value of the ChannelConfig entity.
 // If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldHeadersJSON(ctx context.Context) (v string, err error) {
+func (m *ChannelConfigMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHeadersJSON is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHeadersJSON requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHeadersJSON: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.HeadersJSON, nil
+	return oldValue.Description, nil
 }
 
-// ResetHeadersJSON resets all changes to the "headers_json" field.
-func (m *ChannelConfigMutation) ResetHeadersJSON() {
-	m.headers_json = nil
+// ResetDescription resets all changes to the "description" field.
+func (m *ChannelConfigMutation) ResetDescription() {
+	m.description = nil
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *ChannelConfigMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// SetSource sets the "source" field.
+func (m *ChannelConfigMutation) SetSource(s string) {
+	m.source = &s
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *ChannelConfigMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
+// Source returns the value of the "source" field in the mutation.
+func (m *ChannelConfigMutation) Source() (r string, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the ChannelConfig entity.
+// OldSource returns the old "source" field's value of the ChannelConfig entity.
 // If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+func (m *ChannelConfigMutation) OldSource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return oldValue.Enabled, nil
+	return oldValue.Source, nil
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *ChannelConfigMutation) ResetEnabled() {
-	m.enabled = nil
+// ResetSource resets all changes to the "source" field.
+func (m *ChannelConfigMutation) ResetSource() {
+	m.source = nil
 }
 
-// SetPriority sets the "priority" field.
-func (m *ChannelConfigMutation) SetPriority(i int) {
-	m.priority = &i
-	m.addpriority = nil
+// SetBaseURL sets the "base_url" field.
+func (m *ChannelConfigMutation) SetBaseURL(s string) {
+	m.base_url = &s
 }
 
-// Priority returns the value of the "priority" field in the mutation.
-func (m *ChannelConfigMutation) Priority() (r int, exists bool) {
-	v := m.priority
+// BaseURL returns the value of the "base_url" field in the mutation.
+func (m *ChannelConfigMutation) BaseURL() (r string, exists bool) {
+	v := m.base_url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPriority returns the old "priority" field's value of the ChannelConfig entity.
+// OldBaseURL returns the old "base_url" field's value of the ChannelConfig entity.
 // If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldPriority(ctx context.Context) (v int, err error) {
+func (m *ChannelConfigMutation) OldBaseURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+		return v, errors.New("OldBaseURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriority requires an ID field in the mutation")
+		return v, errors.New("OldBaseURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+		return v, fmt.Errorf("querying old value for OldBaseURL: %w", err)
 	}
-	return oldValue.Priority, nil
-}
-
-// AddPriority adds i to the "priority" field.
-func (m *ChannelConfigMutation) AddPriority(i int) {
-	if m.addpriority != nil {
-		*m.addpriority += i
-	} else {
-		m.addpriority = &i
-	}
-}
-
-// AddedPriority returns the value that was added to the "priority" field in this mutation.
-func (m *ChannelConfigMutation) AddedPriority() (r int, exists bool) {
-	v := m.addpriority
-	if v == nil {
-		return
-	}
-	return *v, true
+	return oldValue.BaseURL, nil
 }
 
-// ResetPriority resets all changes to the "priority" field.
-func (m *ChannelConfigMutation) ResetPriority() {
-	m.priority = nil
-	m.addpriority = nil
+// ResetBaseURL resets all changes to the "base_url" field.
+func (m *ChannelConfigMutation) ResetBaseURL() {
+	m.base_url = nil
 }
 
-// SetWeight sets the "weight" field.
-func (m *ChannelConfigMutation) SetWeight(f float64) {
-	m.weight = &f
-	m.addweight = nil
+// SetProviderPreset sets the "provider_preset" field.
+func (m *ChannelConfigMutation) SetProviderPreset(s string) {
+	m.provider_preset = &s
 }
 
-// Weight returns the value of the "weight" field in the mutation.
-func (m *ChannelConfigMutation) Weight() (r float64, exists bool) {
-	v := m.weight
+// ProviderPreset returns the value of the "provider_preset" field in the mutation.
+func (m *ChannelConfigMutation) ProviderPreset() (r string, exists bool) {
+	v := m.provider_preset
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWeight returns the old "weight" field's value of the ChannelConfig entity.
+// OldProviderPreset returns the old "provider_preset" field's value of the ChannelConfig entity.
 // If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldWeight(ctx context.Context) (v float64, err error) {
+func (m *ChannelConfigMutation) OldProviderPreset(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
+		return v, errors.New("OldProviderPreset is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWeight requires an ID field in the mutation")
+		return v, errors.New("OldProviderPreset requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
-	}
-	return oldValue.Weight, nil
-}
-
-// AddWeight adds f to the "weight" field.
-func (m *ChannelConfigMutation) AddWeight(f float64) {
-	if m.addweight != nil {
-		*m.addweight += f
-	} else {
-		m.addweight = &f
-	}
-}
-
-// AddedWeight returns the value that was added to the "weight" field in this mutation.
-func (m *ChannelConfigMutation) AddedWeight() (r float64, exists bool) {
-	v := m.addweight
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldProviderPreset: %w", err)
 	}
-	return *v, true
+	return oldValue.ProviderPreset, nil
 }
 
-// ResetWeight resets all changes to the "weight" field.
-func (m *ChannelConfigMutation) ResetWeight() {
-	m.weight = nil
-	m.addweight = nil
+// ResetProviderPreset resets all changes to the "provider_preset" field.
+func (m *ChannelConfigMutation) ResetProviderPreset() {
+	m.provider_preset = nil
 }
 
-// SetCapacityHint sets the "capacity_hint" field.
-func (m *ChannelConfigMutation) SetCapacityHint(f float64) {
-	m.capacity_hint = &f
-	m.addcapacity_hint = nil
+// SetProtocolFamily sets the "protocol_family" field.
+func (m *ChannelConfigMutation) SetProtocolFamily(s string) {
+	m.protocol_family = &s
 }
 
-// CapacityHint returns the value of the "capacity_hint" field in the mutation.
-func (m *ChannelConfigMutation) CapacityHint() (r float64, exists bool) {
-	v := m.capacity_hint
+// ProtocolFamily returns the value of the "protocol_family" field in the mutation.
+func (m *ChannelConfigMutation) ProtocolFamily() (r string, exists bool) {
+	v := m.protocol_family
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCapacityHint returns the old "capacity_hint" field's value of the ChannelConfig entity.
+// OldProtocolFamily returns the old "protocol_family" field's value of the ChannelConfig entity.
 // If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldCapacityHint(ctx context.Context) (v float64, err error) {
+func (m *ChannelConfigMutation) OldProtocolFamily(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCapacityHint is only allowed on UpdateOne operations")
+		return v, errors.New("OldProtocolFamily is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCapacityHint requires an ID field in the mutation")
+		return v, errors.New("OldProtocolFamily requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCapacityHint: %w", err)
-	}
-	return oldValue.CapacityHint, nil
-}
-
-// AddCapacityHint adds f to the "capacity_hint" field.
-func (m *ChannelConfigMutation) AddCapacityHint(f float64) {
-	if m.addcapacity_hint != nil {
-		*m.addcapacity_hint += f
-	} else {
-		m.addcapacity_hint = &f
-	}
-}
-
-// AddedCapacityHint returns the value that was added to the "capacity_hint" field in this mutation.
-func (m *ChannelConfigMutation) AddedCapacityHint() (r float64, exists bool) {
-	v := m.addcapacity_hint
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldProtocolFamily: %w", err)
 	}
-	return *v, true
+	return oldValue.ProtocolFamily, nil
 }
 
-// ResetCapacityHint resets all changes to the "capacity_hint" field.
-func (m *ChannelConfigMutation) ResetCapacityHint() {
-	m.capacity_hint = nil
-	m.addcapacity_hint = nil
+// ResetProtocolFamily resets all changes to the "protocol_family" field.
+func (m *ChannelConfigMutation) ResetProtocolFamily() {
+	m.protocol_family = nil
 }
 
-// SetModelDiscovery sets the "model_discovery" field.
-func (m *ChannelConfigMutation) SetModelDiscovery(s string) {
-	m.model_discovery = &s
+// SetRoutingProfile sets the "routing_profile" field.
+func (m *ChannelConfigMutation) SetRoutingProfile(s string) {
+	m.routing_profile = &s
 }
 
-// ModelDiscovery returns the value of the "model_discovery" field in the mutation.
-func (m *ChannelConfigMutation) ModelDiscovery() (r string, exists bool) {
-	v := m.model_discovery
+// RoutingProfile returns the value of the "routing_profile" field in the mutation.
+func (m *ChannelConfigMutation) RoutingProfile() (r string, exists bool) {
+	v := m.routing_profile
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModelDiscovery returns the old "model_discovery" field's value of the ChannelConfig entity.
+// OldRoutingProfile returns the old "routing_profile" field's value of the ChannelConfig entity.
 // If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldModelDiscovery(ctx context.Context) (v string, err error) {
+func (m *ChannelConfigMutation) OldRoutingProfile(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelDiscovery is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoutingProfile is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelDiscovery requires an ID field in the mutation")
+		return v, errors.New("OldRoutingProfile requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelDiscovery: %w", err)
+		return v, fmt.Errorf("querying old value for OldRoutingProfile: %w", err)
 	}
-	return oldValue.ModelDiscovery, nil
+	return oldValue.RoutingProfile, nil
 }
 
-// ResetModelDiscovery resets all changes to the "model_discovery" field.
-func (m *ChannelConfigMutation) ResetModelDiscovery() {
-	m.model_discovery = nil
+// ResetRoutingProfile resets all changes to the "routing_profile" field.
+func (m *ChannelConfigMutation) ResetRoutingProfile() {
+	m.routing_profile = nil
 }
 
-// SetAllowUnknownModels sets the "allow_unknown_models" field.
-func (m *ChannelConfigMutation) SetAllowUnknownModels(b bool) {
-	m.allow_unknown_models = &b
+// SetAPIVersion sets the "api_version" field.
+func (m *ChannelConfigMutation) SetAPIVersion(s string) {
+	m.api_version = &s
 }
 
-// AllowUnknownModels returns the value of the "allow_unknown_models" field in the mutation.
-func (m *ChannelConfigMutation) AllowUnknownModels() (r bool, exists bool) {
-	v := m.allow_unknown_models
+// APIVersion returns the value of the "api_version" field in the mutation.
+func (m *ChannelConfigMutation) APIVersion() (r string, exists bool) {
+	v := m.api_version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAllowUnknownModels returns the old "allow_unknown_models" field's value of the ChannelConfig entity.
+// OldAPIVersion returns the old "api_version" field's value of the ChannelConfig entity.
 // If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldAllowUnknownModels(ctx context.Context) (v bool, err error) {
+func (m *ChannelConfigMutation) OldAPIVersion(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAllowUnknownModels is only allowed on UpdateOne operations")
+		return v, errors.New("OldAPIVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAllowUnknownModels requires an ID field in the mutation")
+		return v, errors.New("OldAPIVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAllowUnknownModels: %w", err)
+		return v, fmt.Errorf("querying old value for OldAPIVersion: %w", err)
 	}
-	return oldValue.AllowUnknownModels, nil
+	return oldValue.APIVersion, nil
 }
 
-// ResetAllowUnknownModels resets all changes to the "allow_unknown_models" field.
-func (m *ChannelConfigMutation) ResetAllowUnknownModels() {
-	m.allow_unknown_models = nil
+// ResetAPIVersion resets all changes to the "api_version" field.
+func (m *ChannelConfigMutation) ResetAPIVersion() {
+	m.api_version = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ChannelConfigMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetDeployment sets the "deployment" field.
+func (m *ChannelConfigMutation) SetDeployment(s string) {
+	m.deployment = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ChannelConfigMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Deployment returns the value of the "deployment" field in the mutation.
+func (m *ChannelConfigMutation) Deployment() (r string, exists bool) {
+	v := m.deployment
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ChannelConfig entity.
+// OldDeployment returns the old "deployment" field's value of the ChannelConfig entity.
 // If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ChannelConfigMutation) OldDeployment(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeployment is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDeployment requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeployment: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Deployment, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ChannelConfigMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetDeployment resets all changes to the "deployment" field.
+func (m *ChannelConfigMutation) ResetDeployment() {
+	m.deployment = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ChannelConfigMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetProject sets the "project" field.
+func (m *ChannelConfigMutation) SetProject(s string) {
+	m.project = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ChannelConfigMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Project returns the value of the "project" field in the mutation.
+func (m *ChannelConfigMutation) Project() (r string, exists bool) {
+	v := m.project
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ChannelConfig entity.
+// OldProject returns the old "project" field's value of the ChannelConfig entity.
 // If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ChannelConfigMutation) OldProject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldProject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldProject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldProject: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Project, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ChannelConfigMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetProject resets all changes to the "project" field.
+func (m *ChannelConfigMutation) ResetProject() {
+	m.project = nil
 }
 
-// SetLastProbeAt sets the "last_probe_at" field.
-func (m *ChannelConfigMutation) SetLastProbeAt(t time.Time) {
-	m.last_probe_at = &t
+// SetLocation sets the "location" field.
+func (m *ChannelConfigMutation) SetLocation(s string) {
+	m.location = &s
 }
 
-// LastProbeAt returns the value of the "last_probe_at" field in the mutation.
-func (m *ChannelConfigMutation) LastProbeAt() (r time.Time, exists bool) {
-	v := m.last_probe_at
+// Location returns the value of the "location" field in the mutation.
+func (m *ChannelConfigMutation) Location() (r string, exists bool) {
+	v := m.location
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastProbeAt returns the old "last_probe_at" field's value of the ChannelConfig entity.
+// OldLocation returns the old "location" field's value of the ChannelConfig entity.
 // If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldLastProbeAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ChannelConfigMutation) OldLocation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastProbeAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastProbeAt requires an ID field in the mutation")
+		return v, errors.New("OldLocation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastProbeAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
 	}
-	return oldValue.LastProbeAt, nil
+	return oldValue.Location, nil
 }
 
-// ClearLastProbeAt clears the value of the "last_probe_at" field.
-func (m *ChannelConfigMutation) ClearLastProbeAt() {
-	m.last_probe_at = nil
-	m.clearedFields[channelconfig.FieldLastProbeAt] = struct{}{}
+// ResetLocation resets all changes to the "location" field.
+func (m *ChannelConfigMutation) ResetLocation() {
+	m.location = nil
 }
 
-// LastProbeAtCleared returns if the "last_probe_at" field was cleared in this mutation.
-func (m *ChannelConfigMutation) LastProbeAtCleared() bool {
-	_, ok := m.clearedFields[channelconfig.FieldLastProbeAt]
-	return ok
+// SetModelResource sets the "model_resource" field.
+func (m *ChannelConfigMutation) SetModelResource(s string) {
+	m.model_resource = &s
 }
 
-// ResetLastProbeAt resets all changes to the "last_probe_at" field.
-func (m *ChannelConfigMutation) ResetLastProbeAt() {
-	m.last_probe_at = nil
-	delete(m.clearedFields, channelconfig.FieldLastProbeAt)
+// ModelResource returns the value of the "model_resource" field in the mutation.
+func (m *ChannelConfigMutation) ModelResource() (r string, exists bool) {
+	v := m.model_resource
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetLastProbeStatus sets the "last_probe_status" field.
-func (m *ChannelConfigMutation) SetLastProbeStatus(s string) {
-	m.last_probe_status = &s
+// OldModelResource returns the old "model_resource" field's value of the ChannelConfig entity.
+// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelConfigMutation) OldModelResource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelResource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelResource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelResource: %w", err)
+	}
+	return oldValue.ModelResource, nil
 }
 
-// LastProbeStatus returns the value of the "last_probe_status" field in the mutation.
-func (m *ChannelConfigMutation) LastProbeStatus() (r string, exists bool) {
-	v := m.last_probe_status
+// ResetModelResource resets all changes to the "model_resource" field.
+func (m *ChannelConfigMutation) ResetModelResource() {
+	m.model_resource = nil
+}
+
+// SetAPIKeyCiphertext sets the "api_key_ciphertext" field.
+func (m *ChannelConfigMutation) SetAPIKeyCiphertext(b []byte) {
+	m.api_key_ciphertext = &b
+}
+
+// APIKeyCiphertext returns the value of the "api_key_ciphertext" field in the mutation.
+func (m *ChannelConfigMutation) APIKeyCiphertext() (r []byte, exists bool) {
+	v := m.api_key_ciphertext
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastProbeStatus returns the old "last_probe_status" field's value of the ChannelConfig entity.
+// OldAPIKeyCiphertext returns the old "api_key_ciphertext" field's value of the ChannelConfig entity.
 // If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldLastProbeStatus(ctx context.Context) (v string, err error) {
+func (m *ChannelConfigMutation) OldAPIKeyCiphertext(ctx context.Context) (v *[]byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastProbeStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldAPIKeyCiphertext is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastProbeStatus requires an ID field in the mutation")
+		return v, errors.New("OldAPIKeyCiphertext requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastProbeStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldAPIKeyCiphertext: %w", err)
 	}
-	return oldValue.LastProbeStatus, nil
+	return oldValue.APIKeyCiphertext, nil
 }
 
-// ResetLastProbeStatus resets all changes to the "last_probe_status" field.
-func (m *ChannelConfigMutation) ResetLastProbeStatus() {
-	m.last_probe_status = nil
+// ClearAPIKeyCiphertext clears the value of the "api_key_ciphertext" field.
+func (m *ChannelConfigMutation) ClearAPIKeyCiphertext() {
+	m.api_key_ciphertext = nil
+	m.clearedFields[channelconfig.FieldAPIKeyCiphertext] = struct{}{}
 }
 
-// SetLastProbeError sets the "last_probe_error" field.
-func (m *ChannelConfigMutation) SetLastProbeError(s string) {
-	m.last_probe_error = &s
+// APIKeyCiphertextCleared returns if the "api_key_ciphertext" field was cleared in this mutation.
+func (m *ChannelConfigMutation) APIKeyCiphertextCleared() bool {
+	_, ok := m.clearedFields[channelconfig.FieldAPIKeyCiphertext]
+	return ok
 }
 
-// LastProbeError returns the value of the "last_probe_error" field in the mutation.
-func (m *ChannelConfigMutation) LastProbeError() (r string, exists bool) {
-	v := m.last_probe_error
+// ResetAPIKeyCiphertext resets all changes to the "api_key_ciphertext" field.
+func (m *ChannelConfigMutation) ResetAPIKeyCiphertext() {
+	m.api_key_ciphertext = nil
+	delete(m.clearedFields, channelconfig.FieldAPIKeyCiphertext)
+}
+
+// SetAPIKeyHint sets the "api_key_hint" field.
+func (m *ChannelConfigMutation) SetAPIKeyHint(s string) {
+	m.api_key_hint = &s
+}
+
+// APIKeyHint returns the value of the "api_key_hint" field in the mutation.
+func (m *ChannelConfigMutation) APIKeyHint() (r string, exists bool) {
+	v := m.api_key_hint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastProbeError returns the old "last_probe_error" field's value of the ChannelConfig entity.
+// OldAPIKeyHint returns the old "api_key_hint" field's value of the ChannelConfig entity.
 // If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelConfigMutation) OldLastProbeError(ctx context.Context) (v string, err error) {
+func (m *ChannelConfigMutation) OldAPIKeyHint(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastProbeError is only allowed on UpdateOne operations")
+		return v, errors.New("OldAPIKeyHint is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastProbeError requires an ID field in the mutation")
+		return v, errors.New("OldAPIKeyHint requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastProbeError: %w", err)
+		return v, fmt.Errorf("querying old value for OldAPIKeyHint: %w", err)
 	}
-	return oldValue.LastProbeError, nil
+	return oldValue.APIKeyHint, nil
 }
 
-// ResetLastProbeError resets all changes to the "last_probe_error" field.
-func (m *ChannelConfigMutation) ResetLastProbeError() {
-	m.last_probe_error = nil
+// ResetAPIKeyHint resets all changes to the "api_key_hint" field.
+func (m *ChannelConfigMutation) ResetAPIKeyHint() {
+	m.api_key_hint = nil
 }
 
-// Where appends a list predicates to the ChannelConfigMutation builder.
-func (m *ChannelConfigMutation) Where(ps ...predicate.ChannelConfig) {
-	m.predicates = append(m.predicates, ps...)
+// SetHeadersJSON sets the "headers_json" field.
+func (m *ChannelConfigMutation) SetHeadersJSON(s string) {
+	m.headers_json = &s
 }
 
-// WhereP appends storage-level predicates to the ChannelConfigMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChannelConfigMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ChannelConfig, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// HeadersJSON returns the value of the "headers_json" field in the mutation.
+func (m *ChannelConfigMutation) HeadersJSON() (r string, exists bool) {
+	v := m.headers_json
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *ChannelConfigMutation) Op() Op {
-	return m.op
+// OldHeadersJSON returns the old "headers_json" field's value of the ChannelConfig entity.
+// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelConfigMutation) OldHeadersJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeadersJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeadersJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeadersJSON: %w", err)
+	}
+	return oldValue.HeadersJSON, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ChannelConfigMutation) SetOp(op Op) {
-	m.op = op
+// ResetHeadersJSON resets all changes to the "headers_json" field.
+func (m *ChannelConfigMutation) ResetHeadersJSON() {
+	m.headers_json = nil
 }
 
-// Type returns the node type of this mutation (ChannelConfig).
-func (m *ChannelConfigMutation) Type() string {
-	return m.typ
+// SetEnabled sets the "enabled" field.
+func (m *ChannelConfigMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ChannelConfigMutation) Fields() []string {
-	fields := make([]string, 0, 26)
-	if m.name != nil {
-		fields = append(fields, channelconfig.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, channelconfig.FieldDescription)
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ChannelConfigMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
 	}
-	if m.source != nil {
-		fields = append(fields, channelconfig.FieldSource)
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the ChannelConfig entity.
+// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelConfigMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
-	if m.base_url != nil {
-		fields = append(fields, channelconfig.FieldBaseURL)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
 	}
-	if m.provider_preset != nil {
-		fields = append(fields, channelconfig.FieldProviderPreset)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
 	}
-	if m.protocol_family != nil {
-		fields = append(fields, channelconfig.FieldProtocolFamily)
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ChannelConfigMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *ChannelConfigMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *ChannelConfigMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
 	}
-	if m.routing_profile != nil {
-		fields = append(fields, channelconfig.FieldRoutingProfile)
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the ChannelConfig entity.
+// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelConfigMutation) OldPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
 	}
-	if m.api_version != nil {
-		fields = append(fields, channelconfig.FieldAPIVersion)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
 	}
-	if m.deployment != nil {
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *ChannelConfigMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *ChannelConfigMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *ChannelConfigMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetWeight sets the "weight" field.
+func (m *ChannelConfigMutation) SetWeight(f float64) {
+	m.weight = &f
+	m.addweight = nil
+}
+
+// Weight returns the value of the "weight" field in the mutation.
+func (m *ChannelConfigMutation) Weight() (r float64, exists bool) {
+	v := m.weight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWeight returns the old "weight" field's value of the ChannelConfig entity.
+// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelConfigMutation) OldWeight(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
+	}
+	return oldValue.Weight, nil
+}
+
+// AddWeight adds f to the "weight" field.
+func (m *ChannelConfigMutation) AddWeight(f float64) {
+	if m.addweight != nil {
+		*m.addweight += f
+	} else {
+		m.addweight = &f
+	}
+}
+
+// AddedWeight returns the value that was added to the "weight" field in this mutation.
+func (m *ChannelConfigMutation) AddedWeight() (r float64, exists bool) {
+	v := m.addweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWeight resets all changes to the "weight" field.
+func (m *ChannelConfigMutation) ResetWeight() {
+	m.weight = nil
+	m.addweight = nil
+}
+
+// SetCapacityHint sets the "capacity_hint" field.
+func (m *ChannelConfigMutation) SetCapacityHint(f float64) {
+	m.capacity_hint = &f
+	m.addcapacity_hint = nil
+}
+
+// CapacityHint returns the value of the "capacity_hint" field in the mutation.
+func (m *ChannelConfigMutation) CapacityHint() (r float64, exists bool) {
+	v := m.capacity_hint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCapacityHint returns the old "capacity_hint" field's value of the ChannelConfig entity.
+// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelConfigMutation) OldCapacityHint(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCapacityHint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCapacityHint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCapacityHint: %w", err)
+	}
+	return oldValue.CapacityHint, nil
+}
+
+// AddCapacityHint adds f to the "capacity_hint" field.
+func (m *ChannelConfigMutation) AddCapacityHint(f float64) {
+	if m.addcapacity_hint != nil {
+		*m.addcapacity_hint += f
+	} else {
+		m.addcapacity_hint = &f
+	}
+}
+
+// AddedCapacityHint returns the value that was added to the "capacity_hint" field in this mutation.
+func (m *ChannelConfigMutation) AddedCapacityHint() (r float64, exists bool) {
+	v := m.addcapacity_hint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCapacityHint resets all changes to the "capacity_hint" field.
+func (m *ChannelConfigMutation) ResetCapacityHint() {
+	m.capacity_hint = nil
+	m.addcapacity_hint = nil
+}
+
+// SetModelDiscovery sets the "model_discovery" field.
+func (m *ChannelConfigMutation) SetModelDiscovery(s string) {
+	m.model_discovery = &s
+}
+
+// ModelDiscovery returns the value of the "model_discovery" field in the mutation.
+func (m *ChannelConfigMutation) ModelDiscovery() (r string, exists bool) {
+	v := m.model_discovery
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelDiscovery returns the old "model_discovery" field's value of the ChannelConfig entity.
+// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelConfigMutation) OldModelDiscovery(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelDiscovery is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelDiscovery requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelDiscovery: %w", err)
+	}
+	return oldValue.ModelDiscovery, nil
+}
+
+// ResetModelDiscovery resets all changes to the "model_discovery" field.
+func (m *ChannelConfigMutation) ResetModelDiscovery() {
+	m.model_discovery = nil
+}
+
+// SetAllowUnknownModels sets the "allow_unknown_models" field.
+func (m *ChannelConfigMutation) SetAllowUnknownModels(b bool) {
+	m.allow_unknown_models = &b
+}
+
+// AllowUnknownModels returns the value of the "allow_unknown_models" field in the mutation.
+func (m *ChannelConfigMutation) AllowUnknownModels() (r bool, exists bool) {
+	v := m.allow_unknown_models
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowUnknownModels returns the old "allow_unknown_models" field's value of the ChannelConfig entity.
+// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelConfigMutation) OldAllowUnknownModels(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowUnknownModels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowUnknownModels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowUnknownModels: %w", err)
+	}
+	return oldValue.AllowUnknownModels, nil
+}
+
+// ResetAllowUnknownModels resets all changes to the "allow_unknown_models" field.
+func (m *ChannelConfigMutation) ResetAllowUnknownModels() {
+	m.allow_unknown_models = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ChannelConfigMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ChannelConfigMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ChannelConfig entity.
+// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelConfigMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ChannelConfigMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ChannelConfigMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ChannelConfigMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ChannelConfig entity.
+// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelConfigMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ChannelConfigMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetLastProbeAt sets the "last_probe_at" field.
+func (m *ChannelConfigMutation) SetLastProbeAt(t time.Time) {
+	m.last_probe_at = &t
+}
+
+// LastProbeAt returns the value of the "last_probe_at" field in the mutation.
+func (m *ChannelConfigMutation) LastProbeAt() (r time.Time, exists bool) {
+	v := m.last_probe_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastProbeAt returns the old "last_probe_at" field's value of the ChannelConfig entity.
+// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelConfigMutation) OldLastProbeAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastProbeAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastProbeAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastProbeAt: %w", err)
+	}
+	return oldValue.LastProbeAt, nil
+}
+
+// ClearLastProbeAt clears the value of the "last_probe_at" field.
+func (m *ChannelConfigMutation) ClearLastProbeAt() {
+	m.last_probe_at = nil
+	m.clearedFields[channelconfig.FieldLastProbeAt] = struct{}{}
+}
+
+// LastProbeAtCleared returns if the "last_probe_at" field was cleared in this mutation.
+func (m *ChannelConfigMutation) LastProbeAtCleared() bool {
+	_, ok := m.clearedFields[channelconfig.FieldLastProbeAt]
+	return ok
+}
+
+// ResetLastProbeAt resets all changes to the "last_probe_at" field.
+func (m *ChannelConfigMutation) ResetLastProbeAt() {
+	m.last_probe_at = nil
+	delete(m.clearedFields, channelconfig.FieldLastProbeAt)
+}
+
+// SetLastProbeStatus sets the "last_probe_status" field.
+func (m *ChannelConfigMutation) SetLastProbeStatus(s string) {
+	m.last_probe_status = &s
+}
+
+// LastProbeStatus returns the value of the "last_probe_status" field in the mutation.
+func (m *ChannelConfigMutation) LastProbeStatus() (r string, exists bool) {
+	v := m.last_probe_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastProbeStatus returns the old "last_probe_status" field's value of the ChannelConfig entity.
+// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelConfigMutation) OldLastProbeStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastProbeStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastProbeStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastProbeStatus: %w", err)
+	}
+	return oldValue.LastProbeStatus, nil
+}
+
+// ResetLastProbeStatus resets all changes to the "last_probe_status" field.
+func (m *ChannelConfigMutation) ResetLastProbeStatus() {
+	m.last_probe_status = nil
+}
+
+// SetLastProbeError sets the "last_probe_error" field.
+func (m *ChannelConfigMutation) SetLastProbeError(s string) {
+	m.last_probe_error = &s
+}
+
+// LastProbeError returns the value of the "last_probe_error" field in the mutation.
+func (m *ChannelConfigMutation) LastProbeError() (r string, exists bool) {
+	v := m.last_probe_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastProbeError returns the old "last_probe_error" field's value of the ChannelConfig entity.
+// If the ChannelConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelConfigMutation) OldLastProbeError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastProbeError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastProbeError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastProbeError: %w", err)
+	}
+	return oldValue.LastProbeError, nil
+}
+
+// ResetLastProbeError resets all changes to the "last_probe_error" field.
+func (m *ChannelConfigMutation) ResetLastProbeError() {
+	m.last_probe_error = nil
+}
+
+// Where appends a list predicates to the ChannelConfigMutation builder.
+func (m *ChannelConfigMutation) Where(ps ...predicate.ChannelConfig) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChannelConfigMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChannelConfigMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChannelConfig, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChannelConfigMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChannelConfigMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ChannelConfig).
+func (m *ChannelConfigMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChannelConfigMutation) Fields() []string {
+	fields := make([]string, 0, 26)
+	if m.name != nil {
+		fields = append(fields, channelconfig.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, channelconfig.FieldDescription)
+	}
+	if m.source != nil {
+		fields = append(fields, channelconfig.FieldSource)
+	}
+	if m.base_url != nil {
+		fields = append(fields, channelconfig.FieldBaseURL)
+	}
+	if m.provider_preset != nil {
+		fields = append(fields, channelconfig.FieldProviderPreset)
+	}
+	if m.protocol_family != nil {
+		fields = append(fields, channelconfig.FieldProtocolFamily)
+	}
+	if m.routing_profile != nil {
+		fields = append(fields, channelconfig.FieldRoutingProfile)
+	}
+	if m.api_version != nil {
+		fields = append(fields, channelconfig.FieldAPIVersion)
+	}
+	if m.deployment != nil {
 		fields = append(fields, channelconfig.FieldDeployment)
 	}
-	if m.project != nil {
-		fields = append(fields, channelconfig.FieldProject)
+	if m.project != nil {
+		fields = append(fields, channelconfig.FieldProject)
+	}
+	if m.location != nil {
+		fields = append(fields, channelconfig.FieldLocation)
+	}
+	if m.model_resource != nil {
+		fields = append(fields, channelconfig.FieldModelResource)
+	}
+	if m.api_key_ciphertext != nil {
+		fields = append(fields, channelconfig.FieldAPIKeyCiphertext)
+	}
+	if m.api_key_hint != nil {
+		fields = append(fields, channelconfig.FieldAPIKeyHint)
+	}
+	if m.headers_json != nil {
+		fields = append(fields, channelconfig.FieldHeadersJSON)
+	}
+	if m.enabled != nil {
+		fields = append(fields, channelconfig.FieldEnabled)
+	}
+	if m.priority != nil {
+		fields = append(fields, channelconfig.FieldPriority)
+	}
+	if m.weight != nil {
+		fields = append(fields, channelconfig.FieldWeight)
+	}
+	if m.capacity_hint != nil {
+		fields = append(fields, channelconfig.FieldCapacityHint)
+	}
+	if m.model_discovery != nil {
+		fields = append(fields, channelconfig.FieldModelDiscovery)
+	}
+	if m.allow_unknown_models != nil {
+		fields = append(fields, channelconfig.FieldAllowUnknownModels)
+	}
+	if m.created_at != nil {
+		fields = append(fields, channelconfig.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, channelconfig.FieldUpdatedAt)
+	}
+	if m.last_probe_at != nil {
+		fields = append(fields, channelconfig.FieldLastProbeAt)
+	}
+	if m.last_probe_status != nil {
+		fields = append(fields, channelconfig.FieldLastProbeStatus)
+	}
+	if m.last_probe_error != nil {
+		fields = append(fields, channelconfig.FieldLastProbeError)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChannelConfigMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case channelconfig.FieldName:
+		return m.Name()
+	case channelconfig.FieldDescription:
+		return m.Description()
+	case channelconfig.FieldSource:
+		return m.Source()
+	case channelconfig.FieldBaseURL:
+		return m.BaseURL()
+	case channelconfig.FieldProviderPreset:
+		return m.ProviderPreset()
+	case channelconfig.FieldProtocolFamily:
+		return m.ProtocolFamily()
+	case channelconfig.FieldRoutingProfile:
+		return m.RoutingProfile()
+	case channelconfig.FieldAPIVersion:
+		return m.APIVersion()
+	case channelconfig.FieldDeployment:
+		return m.Deployment()
+	case channelconfig.FieldProject:
+		return m.Project()
+	case channelconfig.FieldLocation:
+		return m.Location()
+	case channelconfig.FieldModelResource:
+		return m.ModelResource()
+	case channelconfig.FieldAPIKeyCiphertext:
+		return m.APIKeyCiphertext()
+	case channelconfig.FieldAPIKeyHint:
+		return m.APIKeyHint()
+	case channelconfig.FieldHeadersJSON:
+		return m.HeadersJSON()
+	case channelconfig.FieldEnabled:
+		return m.Enabled()
+	case channelconfig.FieldPriority:
+		return m.Priority()
+	case channelconfig.FieldWeight:
+		return m.Weight()
+	case channelconfig.FieldCapacityHint:
+		return m.CapacityHint()
+	case channelconfig.FieldModelDiscovery:
+		return m.ModelDiscovery()
+	case channelconfig.FieldAllowUnknownModels:
+		return m.AllowUnknownModels()
+	case channelconfig.FieldCreatedAt:
+		return m.CreatedAt()
+	case channelconfig.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case channelconfig.FieldLastProbeAt:
+		return m.LastProbeAt()
+	case channelconfig.FieldLastProbeStatus:
+		return m.LastProbeStatus()
+	case channelconfig.FieldLastProbeError:
+		return m.LastProbeError()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChannelConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case channelconfig.FieldName:
+		return m.OldName(ctx)
+	case channelconfig.FieldDescription:
+		return m.OldDescription(ctx)
+	case channelconfig.FieldSource:
+		return m.OldSource(ctx)
+	case channelconfig.FieldBaseURL:
+		return m.OldBaseURL(ctx)
+	case channelconfig.FieldProviderPreset:
+		return m.OldProviderPreset(ctx)
+	case channelconfig.FieldProtocolFamily:
+		return m.OldProtocolFamily(ctx)
+	case channelconfig.FieldRoutingProfile:
+		return m.OldRoutingProfile(ctx)
+	case channelconfig.FieldAPIVersion:
+		return m.OldAPIVersion(ctx)
+	case channelconfig.FieldDeployment:
+		return m.OldDeployment(ctx)
+	case channelconfig.FieldProject:
+		return m.OldProject(ctx)
+	case channelconfig.FieldLocation:
+		return m.OldLocation(ctx)
+	case channelconfig.FieldModelResource:
+		return m.OldModelResource(ctx)
+	case channelconfig.FieldAPIKeyCiphertext:
+		return m.OldAPIKeyCiphertext(ctx)
+	case channelconfig.FieldAPIKeyHint:
+		return m.OldAPIKeyHint(ctx)
+	case channelconfig.FieldHeadersJSON:
+		return m.OldHeadersJSON(ctx)
+	case channelconfig.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case channelconfig.FieldPriority:
+		return m.OldPriority(ctx)
+	case channelconfig.FieldWeight:
+		return m.OldWeight(ctx)
+	case channelconfig.FieldCapacityHint:
+		return m.OldCapacityHint(ctx)
+	case channelconfig.FieldModelDiscovery:
+		return m.OldModelDiscovery(ctx)
+	case channelconfig.FieldAllowUnknownModels:
+		return m.OldAllowUnknownModels(ctx)
+	case channelconfig.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case channelconfig.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case channelconfig.FieldLastProbeAt:
+		return m.OldLastProbeAt(ctx)
+	case channelconfig.FieldLastProbeStatus:
+		return m.OldLastProbeStatus(ctx)
+	case channelconfig.FieldLastProbeError:
+		return m.OldLastProbeError(ctx)
+	}
+	return nil, fmt.Errorf("unknown ChannelConfig field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChannelConfigMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case channelconfig.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case channelconfig.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case channelconfig.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case channelconfig.FieldBaseURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseURL(v)
+		return nil
+	case channelconfig.FieldProviderPreset:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderPreset(v)
+		return nil
+	case channelconfig.FieldProtocolFamily:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProtocolFamily(v)
+		return nil
+	case channelconfig.FieldRoutingProfile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoutingProfile(v)
+		return nil
+	case channelconfig.FieldAPIVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIVersion(v)
+		return nil
+	case channelconfig.FieldDeployment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeployment(v)
+		return nil
+	case channelconfig.FieldProject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProject(v)
+		return nil
+	case channelconfig.FieldLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case channelconfig.FieldModelResource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelResource(v)
+		return nil
+	case channelconfig.FieldAPIKeyCiphertext:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIKeyCiphertext(v)
+		return nil
+	case channelconfig.FieldAPIKeyHint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIKeyHint(v)
+		return nil
+	case channelconfig.FieldHeadersJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeadersJSON(v)
+		return nil
+	case channelconfig.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case channelconfig.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case channelconfig.FieldWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeight(v)
+		return nil
+	case channelconfig.FieldCapacityHint:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCapacityHint(v)
+		return nil
+	case channelconfig.FieldModelDiscovery:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelDiscovery(v)
+		return nil
+	case channelconfig.FieldAllowUnknownModels:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowUnknownModels(v)
+		return nil
+	case channelconfig.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case channelconfig.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case channelconfig.FieldLastProbeAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastProbeAt(v)
+		return nil
+	case channelconfig.FieldLastProbeStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastProbeStatus(v)
+		return nil
+	case channelconfig.FieldLastProbeError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastProbeError(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChannelConfig field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChannelConfigMutation) AddedFields() []string {
+	var fields []string
+	if m.addpriority != nil {
+		fields = append(fields, channelconfig.FieldPriority)
+	}
+	if m.addweight != nil {
+		fields = append(fields, channelconfig.FieldWeight)
+	}
+	if m.addcapacity_hint != nil {
+		fields = append(fields, channelconfig.FieldCapacityHint)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChannelConfigMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case channelconfig.FieldPriority:
+		return m.AddedPriority()
+	case channelconfig.FieldWeight:
+		return m.AddedWeight()
+	case channelconfig.FieldCapacityHint:
+		return m.AddedCapacityHint()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChannelConfigMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case channelconfig.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	case channelconfig.FieldWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeight(v)
+		return nil
+	case channelconfig.FieldCapacityHint:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCapacityHint(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChannelConfig numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChannelConfigMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(channelconfig.FieldAPIKeyCiphertext) {
+		fields = append(fields, channelconfig.FieldAPIKeyCiphertext)
+	}
+	if m.FieldCleared(channelconfig.FieldLastProbeAt) {
+		fields = append(fields, channelconfig.FieldLastProbeAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChannelConfigMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChannelConfigMutation) ClearField(name string) error {
+	switch name {
+	case channelconfig.FieldAPIKeyCiphertext:
+		m.ClearAPIKeyCiphertext()
+		return nil
+	case channelconfig.FieldLastProbeAt:
+		m.ClearLastProbeAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ChannelConfig nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChannelConfigMutation) ResetField(name string) error {
+	switch name {
+	case channelconfig.FieldName:
+		m.ResetName()
+		return nil
+	case channelconfig.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case channelconfig.FieldSource:
+		m.ResetSource()
+		return nil
+	case channelconfig.FieldBaseURL:
+		m.ResetBaseURL()
+		return nil
+	case channelconfig.FieldProviderPreset:
+		m.ResetProviderPreset()
+		return nil
+	case channelconfig.FieldProtocolFamily:
+		m.ResetProtocolFamily()
+		return nil
+	case channelconfig.FieldRoutingProfile:
+		m.ResetRoutingProfile()
+		return nil
+	case channelconfig.FieldAPIVersion:
+		m.ResetAPIVersion()
+		return nil
+	case channelconfig.FieldDeployment:
+		m.ResetDeployment()
+		return nil
+	case channelconfig.FieldProject:
+		m.ResetProject()
+		return nil
+	case channelconfig.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case channelconfig.FieldModelResource:
+		m.ResetModelResource()
+		return nil
+	case channelconfig.FieldAPIKeyCiphertext:
+		m.ResetAPIKeyCiphertext()
+		return nil
+	case channelconfig.FieldAPIKeyHint:
+		m.ResetAPIKeyHint()
+		return nil
+	case channelconfig.FieldHeadersJSON:
+		m.ResetHeadersJSON()
+		return nil
+	case channelconfig.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case channelconfig.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case channelconfig.FieldWeight:
+		m.ResetWeight()
+		return nil
+	case channelconfig.FieldCapacityHint:
+		m.ResetCapacityHint()
+		return nil
+	case channelconfig.FieldModelDiscovery:
+		m.ResetModelDiscovery()
+		return nil
+	case channelconfig.FieldAllowUnknownModels:
+		m.ResetAllowUnknownModels()
+		return nil
+	case channelconfig.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case channelconfig.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case channelconfig.FieldLastProbeAt:
+		m.ResetLastProbeAt()
+		return nil
+	case channelconfig.FieldLastProbeStatus:
+		m.ResetLastProbeStatus()
+		return nil
+	case channelconfig.FieldLastProbeError:
+		m.ResetLastProbeError()
+		return nil
+	}
+	return fmt.Errorf("unknown ChannelConfig field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChannelConfigMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChannelConfigMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChannelConfigMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChannelConfigMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChannelConfigMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChannelConfigMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChannelConfigMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChannelConfig unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChannelConfigMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChannelConfig edge %s", name)
+}
+
+// ChannelModelMutation represents an operation that mutates the ChannelModel nodes in the graph.
+type ChannelModelMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *int
+	channel_id                   *string
+	model                        *string
+	display_name                 *string
+	source                       *string
+	enabled                      *bool
+	supports_responses           *int
+	addsupports_responses        *int
+	supports_chat_completions    *int
+	addsupports_chat_completions *int
+	supports_embeddings          *int
+	addsupports_embeddings       *int
+	context_window               *int
+	addcontext_window            *int
+	input_modalities_json        *string
+	output_modalities_json       *string
+	raw_model_json               *string
+	first_seen_at                *time.Time
+	last_seen_at                 *time.Time
+	last_probe_at                *time.Time
+	clearedFields                map[string]struct{}
+	done                         bool
+	oldValue                     func(context.Context) (*ChannelModel, error)
+	predicates                   []predicate.ChannelModel
+}
+
+var _ ent.Mutation = (*ChannelModelMutation)(nil)
+
+// channelmodelOption allows management of the mutation configuration using functional options.
+type channelmodelOption func(*ChannelModelMutation)
+
+// newChannelModelMutation creates new mutation for the ChannelModel entity.
+func newChannelModelMutation(c config, op Op, opts ...channelmodelOption) *ChannelModelMutation {
+	m := &ChannelModelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChannelModel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChannelModelID sets the ID field of the mutation.
+func withChannelModelID(id int) channelmodelOption {
+	return func(m *ChannelModelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChannelModel
+		)
+		m.oldValue = func(ctx context.Context) (*ChannelModel, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChannelModel.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChannelModel sets the old ChannelModel of the mutation.
+func withChannelModel(node *ChannelModel) channelmodelOption {
+	return func(m *ChannelModelMutation) {
+		m.oldValue = func(context.Context) (*ChannelModel, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChannelModelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChannelModelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("dao: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChannelModelMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChannelModelMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChannelModel.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetChannelID sets the "channel_id" field.
+func (m *ChannelModelMutation) SetChannelID(s string) {
+	m.channel_id = &s
+}
+
+// ChannelID returns the value of the "channel_id" field in the mutation.
+func (m *ChannelModelMutation) ChannelID() (r string, exists bool) {
+	v := m.channel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChannelID returns the old "channel_id" field's value of the ChannelModel entity.
+// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelModelMutation) OldChannelID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannelID: %w", err)
+	}
+	return oldValue.ChannelID, nil
+}
+
+// ResetChannelID resets all changes to the "channel_id" field.
+func (m *ChannelModelMutation) ResetChannelID() {
+	m.channel_id = nil
+}
+
+// SetModel sets the "model" field.
+func (m *ChannelModelMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *ChannelModelMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the ChannelModel entity.
+// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelModelMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *ChannelModelMutation) ResetModel() {
+	m.model = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *ChannelModelMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *ChannelModelMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the ChannelModel entity.
+// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelModelMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *ChannelModelMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetSource sets the "source" field.
+func (m *ChannelModelMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *ChannelModelMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the ChannelModel entity.
+// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelModelMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *ChannelModelMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *ChannelModelMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ChannelModelMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the ChannelModel entity.
+// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelModelMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ChannelModelMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetSupportsResponses sets the "supports_responses" field.
+func (m *ChannelModelMutation) SetSupportsResponses(i int) {
+	m.supports_responses = &i
+	m.addsupports_responses = nil
+}
+
+// SupportsResponses returns the value of the "supports_responses" field in the mutation.
+func (m *ChannelModelMutation) SupportsResponses() (r int, exists bool) {
+	v := m.supports_responses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSupportsResponses returns the old "supports_responses" field's value of the ChannelModel entity.
+// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelModelMutation) OldSupportsResponses(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSupportsResponses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSupportsResponses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSupportsResponses: %w", err)
+	}
+	return oldValue.SupportsResponses, nil
+}
+
+// AddSupportsResponses adds i to the "supports_responses" field.
+func (m *ChannelModelMutation) AddSupportsResponses(i int) {
+	if m.addsupports_responses != nil {
+		*m.addsupports_responses += i
+	} else {
+		m.addsupports_responses = &i
+	}
+}
+
+// AddedSupportsResponses returns the value that was added to the "supports_responses" field in this mutation.
+func (m *ChannelModelMutation) AddedSupportsResponses() (r int, exists bool) {
+	v := m.addsupports_responses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSupportsResponses clears the value of the "supports_responses" field.
+func (m *ChannelModelMutation) ClearSupportsResponses() {
+	m.supports_responses = nil
+	m.addsupports_responses = nil
+	m.clearedFields[channelmodel.FieldSupportsResponses] = struct{}{}
+}
+
+// SupportsResponsesCleared returns if the "supports_responses" field was cleared in this mutation.
+func (m *ChannelModelMutation) SupportsResponsesCleared() bool {
+	_, ok := m.clearedFields[channelmodel.FieldSupportsResponses]
+	return ok
+}
+
+// ResetSupportsResponses resets all changes to the "supports_responses" field.
+func (m *ChannelModelMutation) ResetSupportsResponses() {
+	m.supports_responses = nil
+	m.addsupports_responses = nil
+	delete(m.clearedFields, channelmodel.FieldSupportsResponses)
+}
+
+// SetSupportsChatCompletions sets the "supports_chat_completions" field.
+func (m *ChannelModelMutation) SetSupportsChatCompletions(i int) {
+	m.supports_chat_completions = &i
+	m.addsupports_chat_completions = nil
+}
+
+// SupportsChatCompletions returns the value of the "supports_chat_completions" field in the mutation.
+func (m *ChannelModelMutation) SupportsChatCompletions() (r int, exists bool) {
+	v := m.supports_chat_completions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSupportsChatCompletions returns the old "supports_chat_completions" field's value of the ChannelModel entity.
+// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelModelMutation) OldSupportsChatCompletions(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSupportsChatCompletions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSupportsChatCompletions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSupportsChatCompletions: %w", err)
+	}
+	return oldValue.SupportsChatCompletions, nil
+}
+
+// AddSupportsChatCompletions adds i to the "supports_chat_completions" field.
+func (m *ChannelModelMutation) AddSupportsChatCompletions(i int) {
+	if m.addsupports_chat_completions != nil {
+		*m.addsupports_chat_completions += i
+	} else {
+		m.addsupports_chat_completions = &i
+	}
+}
+
+// AddedSupportsChatCompletions returns the value that was added to the "supports_chat_completions" field in this mutation.
+func (m *ChannelModelMutation) AddedSupportsChatCompletions() (r int, exists bool) {
+	v := m.addsupports_chat_completions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSupportsChatCompletions clears the value of the "supports_chat_completions" field.
+func (m *ChannelModelMutation) ClearSupportsChatCompletions() {
+	m.supports_chat_completions = nil
+	m.addsupports_chat_completions = nil
+	m.clearedFields[channelmodel.FieldSupportsChatCompletions] = struct{}{}
+}
+
+// SupportsChatCompletionsCleared returns if the "supports_chat_completions" field was cleared in this mutation.
+func (m *ChannelModelMutation) SupportsChatCompletionsCleared() bool {
+	_, ok := m.clearedFields[channelmodel.FieldSupportsChatCompletions]
+	return ok
+}
+
+// ResetSupportsChatCompletions resets all changes to the "supports_chat_completions" field.
+func (m *ChannelModelMutation) ResetSupportsChatCompletions() {
+	m.supports_chat_completions = nil
+	m.addsupports_chat_completions = nil
+	delete(m.clearedFields, channelmodel.FieldSupportsChatCompletions)
+}
+
+// SetSupportsEmbeddings sets the "supports_embeddings" field.
+func (m *ChannelModelMutation) SetSupportsEmbeddings(i int) {
+	m.supports_embeddings = &i
+	m.addsupports_embeddings = nil
+}
+
+// SupportsEmbeddings returns the value of the "supports_embeddings" field in the mutation.
+func (m *ChannelModelMutation) SupportsEmbeddings() (r int, exists bool) {
+	v := m.supports_embeddings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSupportsEmbeddings returns the old "supports_embeddings" field's value of the ChannelModel entity.
+// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelModelMutation) OldSupportsEmbeddings(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSupportsEmbeddings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSupportsEmbeddings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSupportsEmbeddings: %w", err)
+	}
+	return oldValue.SupportsEmbeddings, nil
+}
+
+// AddSupportsEmbeddings adds i to the "supports_embeddings" field.
+func (m *ChannelModelMutation) AddSupportsEmbeddings(i int) {
+	if m.addsupports_embeddings != nil {
+		*m.addsupports_embeddings += i
+	} else {
+		m.addsupports_embeddings = &i
+	}
+}
+
+// AddedSupportsEmbeddings returns the value that was added to the "supports_embeddings" field in this mutation.
+func (m *ChannelModelMutation) AddedSupportsEmbeddings() (r int, exists bool) {
+	v := m.addsupports_embeddings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSupportsEmbeddings clears the value of the "supports_embeddings" field.
+func (m *ChannelModelMutation) ClearSupportsEmbeddings() {
+	m.supports_embeddings = nil
+	m.addsupports_embeddings = nil
+	m.clearedFields[channelmodel.FieldSupportsEmbeddings] = struct{}{}
+}
+
+// SupportsEmbeddingsCleared returns if the "supports_embeddings" field was cleared in this mutation.
+func (m *ChannelModelMutation) SupportsEmbeddingsCleared() bool {
+	_, ok := m.clearedFields[channelmodel.FieldSupportsEmbeddings]
+	return ok
+}
+
+// ResetSupportsEmbeddings resets all changes to the "supports_embeddings" field.
+func (m *ChannelModelMutation) ResetSupportsEmbeddings() {
+	m.supports_embeddings = nil
+	m.addsupports_embeddings = nil
+	delete(m.clearedFields, channelmodel.FieldSupportsEmbeddings)
+}
+
+// SetContextWindow sets the "context_window" field.
+func (m *ChannelModelMutation) SetContextWindow(i int) {
+	m.context_window = &i
+	m.addcontext_window = nil
+}
+
+// ContextWindow returns the value of the "context_window" field in the mutation.
+func (m *ChannelModelMutation) ContextWindow() (r int, exists bool) {
+	v := m.context_window
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContextWindow returns the old "context_window" field's value of the ChannelModel entity.
+// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelModelMutation) OldContextWindow(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContextWindow is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContextWindow requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContextWindow: %w", err)
+	}
+	return oldValue.ContextWindow, nil
+}
+
+// AddContextWindow adds i to the "context_window" field.
+func (m *ChannelModelMutation) AddContextWindow(i int) {
+	if m.addcontext_window != nil {
+		*m.addcontext_window += i
+	} else {
+		m.addcontext_window = &i
+	}
+}
+
+// AddedContextWindow returns the value that was added to the "context_window" field in this mutation.
+func (m *ChannelModelMutation) AddedContextWindow() (r int, exists bool) {
+	v := m.addcontext_window
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearContextWindow clears the value of the "context_window" field.
+func (m *ChannelModelMutation) ClearContextWindow() {
+	m.context_window = nil
+	m.addcontext_window = nil
+	m.clearedFields[channelmodel.FieldContextWindow] = struct{}{}
+}
+
+// ContextWindowCleared returns if the "context_window" field was cleared in this mutation.
+func (m *ChannelModelMutation) ContextWindowCleared() bool {
+	_, ok := m.clearedFields[channelmodel.FieldContextWindow]
+	return ok
+}
+
+// ResetContextWindow resets all changes to the "context_window" field.
+func (m *ChannelModelMutation) ResetContextWindow() {
+	m.context_window = nil
+	m.addcontext_window = nil
+	delete(m.clearedFields, channelmodel.FieldContextWindow)
+}
+
+// SetInputModalitiesJSON sets the "input_modalities_json" field.
+func (m *ChannelModelMutation) SetInputModalitiesJSON(s string) {
+	m.input_modalities_json = &s
+}
+
+// InputModalitiesJSON returns the value of the "input_modalities_json" field in the mutation.
+func (m *ChannelModelMutation) InputModalitiesJSON() (r string, exists bool) {
+	v := m.input_modalities_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInputModalitiesJSON returns the old "input_modalities_json" field's value of the ChannelModel entity.
+// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelModelMutation) OldInputModalitiesJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputModalitiesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputModalitiesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputModalitiesJSON: %w", err)
+	}
+	return oldValue.InputModalitiesJSON, nil
+}
+
+// ResetInputModalitiesJSON resets all changes to the "input_modalities_json" field.
+func (m *ChannelModelMutation) ResetInputModalitiesJSON() {
+	m.input_modalities_json = nil
+}
+
+// SetOutputModalitiesJSON sets the "output_modalities_json" field.
+func (m *ChannelModelMutation) SetOutputModalitiesJSON(s string) {
+	m.output_modalities_json = &s
+}
+
+// OutputModalitiesJSON returns the value of the "output_modalities_json" field in the mutation.
+func (m *ChannelModelMutation) OutputModalitiesJSON() (r string, exists bool) {
+	v := m.output_modalities_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutputModalitiesJSON returns the old "output_modalities_json" field's value of the ChannelModel entity.
+// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelModelMutation) OldOutputModalitiesJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutputModalitiesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutputModalitiesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutputModalitiesJSON: %w", err)
+	}
+	return oldValue.OutputModalitiesJSON, nil
+}
+
+// ResetOutputModalitiesJSON resets all changes to the "output_modalities_json" field.
+func (m *ChannelModelMutation) ResetOutputModalitiesJSON() {
+	m.output_modalities_json = nil
+}
+
+// SetRawModelJSON sets the "raw_model_json" field.
+func (m *ChannelModelMutation) SetRawModelJSON(s string) {
+	m.raw_model_json = &s
+}
+
+// RawModelJSON returns the value of the "raw_model_json" field in the mutation.
+func (m *ChannelModelMutation) RawModelJSON() (r string, exists bool) {
+	v := m.raw_model_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRawModelJSON returns the old "raw_model_json" field's value of the ChannelModel entity.
+// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelModelMutation) OldRawModelJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRawModelJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRawModelJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawModelJSON: %w", err)
+	}
+	return oldValue.RawModelJSON, nil
+}
+
+// ResetRawModelJSON resets all changes to the "raw_model_json" field.
+func (m *ChannelModelMutation) ResetRawModelJSON() {
+	m.raw_model_json = nil
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (m *ChannelModelMutation) SetFirstSeenAt(t time.Time) {
+	m.first_seen_at = &t
+}
+
+// FirstSeenAt returns the value of the "first_seen_at" field in the mutation.
+func (m *ChannelModelMutation) FirstSeenAt() (r time.Time, exists bool) {
+	v := m.first_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstSeenAt returns the old "first_seen_at" field's value of the ChannelModel entity.
+// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelModelMutation) OldFirstSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstSeenAt requires an ID field in the mutation")
 	}
-	if m.location != nil {
-		fields = append(fields, channelconfig.FieldLocation)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstSeenAt: %w", err)
 	}
-	if m.model_resource != nil {
-		fields = append(fields, channelconfig.FieldModelResource)
+	return oldValue.FirstSeenAt, nil
+}
+
+// ResetFirstSeenAt resets all changes to the "first_seen_at" field.
+func (m *ChannelModelMutation) ResetFirstSeenAt() {
+	m.first_seen_at = nil
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *ChannelModelMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *ChannelModelMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
 	}
-	if m.api_key_ciphertext != nil {
-		fields = append(fields, channelconfig.FieldAPIKeyCiphertext)
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the ChannelModel entity.
+// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelModelMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
 	}
-	if m.api_key_hint != nil {
-		fields = append(fields, channelconfig.FieldAPIKeyHint)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
 	}
-	if m.headers_json != nil {
-		fields = append(fields, channelconfig.FieldHeadersJSON)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *ChannelModelMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+}
+
+// SetLastProbeAt sets the "last_probe_at" field.
+func (m *ChannelModelMutation) SetLastProbeAt(t time.Time) {
+	m.last_probe_at = &t
+}
+
+// LastProbeAt returns the value of the "last_probe_at" field in the mutation.
+func (m *ChannelModelMutation) LastProbeAt() (r time.Time, exists bool) {
+	v := m.last_probe_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastProbeAt returns the old "last_probe_at" field's value of the ChannelModel entity.
+// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelModelMutation) OldLastProbeAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastProbeAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastProbeAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastProbeAt: %w", err)
+	}
+	return oldValue.LastProbeAt, nil
+}
+
+// ClearLastProbeAt clears the value of the "last_probe_at" field.
+func (m *ChannelModelMutation) ClearLastProbeAt() {
+	m.last_probe_at = nil
+	m.clearedFields[channelmodel.FieldLastProbeAt] = struct{}{}
+}
+
+// LastProbeAtCleared returns if the "last_probe_at" field was cleared in this mutation.
+func (m *ChannelModelMutation) LastProbeAtCleared() bool {
+	_, ok := m.clearedFields[channelmodel.FieldLastProbeAt]
+	return ok
+}
+
+// ResetLastProbeAt resets all changes to the "last_probe_at" field.
+func (m *ChannelModelMutation) ResetLastProbeAt() {
+	m.last_probe_at = nil
+	delete(m.clearedFields, channelmodel.FieldLastProbeAt)
+}
+
+// Where appends a list predicates to the ChannelModelMutation builder.
+func (m *ChannelModelMutation) Where(ps ...predicate.ChannelModel) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChannelModelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChannelModelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChannelModel, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChannelModelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChannelModelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ChannelModel).
+func (m *ChannelModelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChannelModelMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.channel_id != nil {
+		fields = append(fields, channelmodel.FieldChannelID)
+	}
+	if m.model != nil {
+		fields = append(fields, channelmodel.FieldModel)
+	}
+	if m.display_name != nil {
+		fields = append(fields, channelmodel.FieldDisplayName)
+	}
+	if m.source != nil {
+		fields = append(fields, channelmodel.FieldSource)
 	}
 	if m.enabled != nil {
-		fields = append(fields, channelconfig.FieldEnabled)
+		fields = append(fields, channelmodel.FieldEnabled)
 	}
-	if m.priority != nil {
-		fields = append(fields, channelconfig.FieldPriority)
+	if m.supports_responses != nil {
+		fields = append(fields, channelmodel.FieldSupportsResponses)
 	}
-	if m.weight != nil {
-		fields = append(fields, channelconfig.FieldWeight)
+	if m.supports_chat_completions != nil {
+		fields = append(fields, channelmodel.FieldSupportsChatCompletions)
 	}
-	if m.capacity_hint != nil {
-		fields = append(fields, channelconfig.FieldCapacityHint)
+	if m.supports_embeddings != nil {
+		fields = append(fields, channelmodel.FieldSupportsEmbeddings)
 	}
-	if m.model_discovery != nil {
-		fields = append(fields, channelconfig.FieldModelDiscovery)
+	if m.context_window != nil {
+		fields = append(fields, channelmodel.FieldContextWindow)
 	}
-	if m.allow_unknown_models != nil {
-		fields = append(fields, channelconfig.FieldAllowUnknownModels)
+	if m.input_modalities_json != nil {
+		fields = append(fields, channelmodel.FieldInputModalitiesJSON)
 	}
-	if m.created_at != nil {
-		fields = append(fields, channelconfig.FieldCreatedAt)
+	if m.output_modalities_json != nil {
+		fields = append(fields, channelmodel.FieldOutputModalitiesJSON)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, channelconfig.FieldUpdatedAt)
+	if m.raw_model_json != nil {
+		fields = append(fields, channelmodel.FieldRawModelJSON)
 	}
-	if m.last_probe_at != nil {
-		fields = append(fields, channelconfig.FieldLastProbeAt)
+	if m.first_seen_at != nil {
+		fields = append(fields, channelmodel.FieldFirstSeenAt)
 	}
-	if m.last_probe_status != nil {
-		fields = append(fields, channelconfig.FieldLastProbeStatus)
+	if m.last_seen_at != nil {
+		fields = append(fields, channelmodel.FieldLastSeenAt)
 	}
-	if m.last_probe_error != nil {
-		fields = append(fields, channelconfig.FieldLastProbeError)
+	if m.last_probe_at != nil {
+		fields = append(fields, channelmodel.FieldLastProbeAt)
 	}
 	return fields
 }
@@ -2151,60 +4258,38 @@ func (m *ChannelConfigMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ChannelConfigMutation) Field(name string) (ent.Value, bool) {
+func (m *ChannelModelMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case channelconfig.FieldName:
-		return m.Name()
-	case channelconfig.FieldDescription:
-		return m.Description()
-	case channelconfig.FieldSource:
-		return m.Source()
-	case channelconfig.FieldBaseURL:
-		return m.BaseURL()
-	case channelconfig.FieldProviderPreset:
-		return m.ProviderPreset()
-	case channelconfig.FieldProtocolFamily:
-		return m.ProtocolFamily()
-	case channelconfig.FieldRoutingProfile:
-		return m.RoutingProfile()
-	case channelconfig.FieldAPIVersion:
-		return m.APIVersion()
-	case channelconfig.FieldDeployment:
-		return m.Deployment()
-	case channelconfig.FieldProject:
-		return m.Project()
-	case channelconfig.FieldLocation:
-		return m.Location()
-	case channelconfig.FieldModelResource:
-		return m.ModelResource()
-	case channelconfig.FieldAPIKeyCiphertext:
-		return m.APIKeyCiphertext()
-	case channelconfig.FieldAPIKeyHint:
-		return m.APIKeyHint()
-	case channelconfig.FieldHeadersJSON:
-		return m.HeadersJSON()
-	case channelconfig.FieldEnabled:
-		return m.Enabled()
-	case channelconfig.FieldPriority:
-		return m.Priority()
-	case channelconfig.FieldWeight:
-		return m.Weight()
-	case channelconfig.FieldCapacityHint:
-		return m.CapacityHint()
-	case channelconfig.FieldModelDiscovery:
-		return m.ModelDiscovery()
-	case channelconfig.FieldAllowUnknownModels:
-		return m.AllowUnknownModels()
-	case channelconfig.FieldCreatedAt:
-		return m.CreatedAt()
-	case channelconfig.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case channelconfig.FieldLastProbeAt:
+	case channelmodel.FieldChannelID:
+		return m.ChannelID()
+	case channelmodel.FieldModel:
+		return m.Model()
+	case channelmodel.FieldDisplayName:
+		return m.DisplayName()
+	case channelmodel.FieldSource:
+		return m.Source()
+	case channelmodel.FieldEnabled:
+		return m.Enabled()
+	case channelmodel.FieldSupportsResponses:
+		return m.SupportsResponses()
+	case channelmodel.FieldSupportsChatCompletions:
+		return m.SupportsChatCompletions()
+	case channelmodel.FieldSupportsEmbeddings:
+		return m.SupportsEmbeddings()
+	case channelmodel.FieldContextWindow:
+		return m.ContextWindow()
+	case channelmodel.FieldInputModalitiesJSON:
+		return m.InputModalitiesJSON()
+	case channelmodel.FieldOutputModalitiesJSON:
+		return m.OutputModalitiesJSON()
+	case channelmodel.FieldRawModelJSON:
+		return m.RawModelJSON()
+	case channelmodel.FieldFirstSeenAt:
+		return m.FirstSeenAt()
+	case channelmodel.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case channelmodel.FieldLastProbeAt:
 		return m.LastProbeAt()
-	case channelconfig.FieldLastProbeStatus:
-		return m.LastProbeStatus()
-	case channelconfig.FieldLastProbeError:
-		return m.LastProbeError()
 	}
 	return nil, false
 }
@@ -2212,267 +4297,171 @@ func (m *ChannelConfigMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ChannelConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ChannelModelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case channelconfig.FieldName:
-		return m.OldName(ctx)
-	case channelconfig.FieldDescription:
-		return m.OldDescription(ctx)
-	case channelconfig.FieldSource:
+	case channelmodel.FieldChannelID:
+		return m.OldChannelID(ctx)
+	case channelmodel.FieldModel:
+		return m.OldModel(ctx)
+	case channelmodel.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case channelmodel.FieldSource:
 		return m.OldSource(ctx)
-	case channelconfig.FieldBaseURL:
-		return m.OldBaseURL(ctx)
-	case channelconfig.FieldProviderPreset:
-		return m.OldProviderPreset(ctx)
-	case channelconfig.FieldProtocolFamily:
-		return m.OldProtocolFamily(ctx)
-	case channelconfig.FieldRoutingProfile:
-		return m.OldRoutingProfile(ctx)
-	case channelconfig.FieldAPIVersion:
-		return m.OldAPIVersion(ctx)
-	case channelconfig.FieldDeployment:
-		return m.OldDeployment(ctx)
-	case channelconfig.FieldProject:
-		return m.OldProject(ctx)
-	case channelconfig.FieldLocation:
-		return m.OldLocation(ctx)
-	case channelconfig.FieldModelResource:
-		return m.OldModelResource(ctx)
-	case channelconfig.FieldAPIKeyCiphertext:
-		return m.OldAPIKeyCiphertext(ctx)
-	case channelconfig.FieldAPIKeyHint:
-		return m.OldAPIKeyHint(ctx)
-	case channelconfig.FieldHeadersJSON:
-		return m.OldHeadersJSON(ctx)
-	case channelconfig.FieldEnabled:
+	case channelmodel.FieldEnabled:
 		return m.OldEnabled(ctx)
-	case channelconfig.FieldPriority:
-		return m.OldPriority(ctx)
-	case channelconfig.FieldWeight:
-		return m.OldWeight(ctx)
-	case channelconfig.FieldCapacityHint:
-		return m.OldCapacityHint(ctx)
-	case channelconfig.FieldModelDiscovery:
-		return m.OldModelDiscovery(ctx)
-	case channelconfig.FieldAllowUnknownModels:
-		return m.OldAllowUnknownModels(ctx)
-	case channelconfig.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case channelconfig.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case channelconfig.FieldLastProbeAt:
+	case channelmodel.FieldSupportsResponses:
+		return m.OldSupportsResponses(ctx)
+	case channelmodel.FieldSupportsChatCompletions:
+		return m.OldSupportsChatCompletions(ctx)
+	case channelmodel.FieldSupportsEmbeddings:
+		return m.OldSupportsEmbeddings(ctx)
+	case channelmodel.FieldContextWindow:
+		return m.OldContextWindow(ctx)
+	case channelmodel.FieldInputModalitiesJSON:
+		return m.OldInputModalitiesJSON(ctx)
+	case channelmodel.FieldOutputModalitiesJSON:
+		return m.OldOutputModalitiesJSON(ctx)
+	case channelmodel.FieldRawModelJSON:
+		return m.OldRawModelJSON(ctx)
+	case channelmodel.FieldFirstSeenAt:
+		return m.OldFirstSeenAt(ctx)
+	case channelmodel.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case channelmodel.FieldLastProbeAt:
 		return m.OldLastProbeAt(ctx)
-	case channelconfig.FieldLastProbeStatus:
-		return m.OldLastProbeStatus(ctx)
-	case channelconfig.FieldLastProbeError:
-		return m.OldLastProbeError(ctx)
 	}
-	return nil, fmt.Errorf("unknown ChannelConfig field %s", name)
+	return nil, fmt.Errorf("unknown ChannelModel field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChannelConfigMutation) SetField(name string, value ent.Value) error {
+func (m *ChannelModelMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case channelconfig.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case channelconfig.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case channelconfig.FieldSource:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSource(v)
-		return nil
-	case channelconfig.FieldBaseURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBaseURL(v)
-		return nil
-	case channelconfig.FieldProviderPreset:
+	case channelmodel.FieldChannelID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProviderPreset(v)
+		m.SetChannelID(v)
 		return nil
-	case channelconfig.FieldProtocolFamily:
+	case channelmodel.FieldModel:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProtocolFamily(v)
+		m.SetModel(v)
 		return nil
-	case channelconfig.FieldRoutingProfile:
+	case channelmodel.FieldDisplayName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRoutingProfile(v)
+		m.SetDisplayName(v)
 		return nil
-	case channelconfig.FieldAPIVersion:
+	case channelmodel.FieldSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAPIVersion(v)
+		m.SetSource(v)
 		return nil
-	case channelconfig.FieldDeployment:
-		v, ok := value.(string)
+	case channelmodel.FieldEnabled:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeployment(v)
+		m.SetEnabled(v)
 		return nil
-	case channelconfig.FieldProject:
-		v, ok := value.(string)
+	case channelmodel.FieldSupportsResponses:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProject(v)
+		m.SetSupportsResponses(v)
 		return nil
-	case channelconfig.FieldLocation:
-		v, ok := value.(string)
+	case channelmodel.FieldSupportsChatCompletions:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLocation(v)
+		m.SetSupportsChatCompletions(v)
 		return nil
-	case channelconfig.FieldModelResource:
-		v, ok := value.(string)
+	case channelmodel.FieldSupportsEmbeddings:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelResource(v)
+		m.SetSupportsEmbeddings(v)
 		return nil
-	case channelconfig.FieldAPIKeyCiphertext:
-		v, ok := value.([]byte)
+	case channelmodel.FieldContextWindow:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAPIKeyCiphertext(v)
+		m.SetContextWindow(v)
 		return nil
-	case channelconfig.FieldAPIKeyHint:
+	case channelmodel.FieldInputModalitiesJSON:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAPIKeyHint(v)
+		m.SetInputModalitiesJSON(v)
 		return nil
-	case channelconfig.FieldHeadersJSON:
+	case channelmodel.FieldOutputModalitiesJSON:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHeadersJSON(v)
-		return nil
-	case channelconfig.FieldEnabled:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnabled(v)
-		return nil
-	case channelconfig.FieldPriority:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPriority(v)
-		return nil
-	case channelconfig.FieldWeight:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWeight(v)
-		return nil
-	case channelconfig.FieldCapacityHint:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCapacityHint(v)
+		m.SetOutputModalitiesJSON(v)
 		return nil
-	case channelconfig.FieldModelDiscovery:
+	case channelmodel.FieldRawModelJSON:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelDiscovery(v)
-		return nil
-	case channelconfig.FieldAllowUnknownModels:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAllowUnknownModels(v)
+		m.SetRawModelJSON(v)
 		return nil
-	case channelconfig.FieldCreatedAt:
+	case channelmodel.FieldFirstSeenAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetFirstSeenAt(v)
 		return nil
-	case channelconfig.FieldUpdatedAt:
+	case channelmodel.FieldLastSeenAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetLastSeenAt(v)
 		return nil
-	case channelconfig.FieldLastProbeAt:
+	case channelmodel.FieldLastProbeAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastProbeAt(v)
-		return nil
-	case channelconfig.FieldLastProbeStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastProbeStatus(v)
-		return nil
-	case channelconfig.FieldLastProbeError:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastProbeError(v)
+		m.SetLastProbeAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ChannelConfig field %s", name)
+	return fmt.Errorf("unknown ChannelModel field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ChannelConfigMutation) AddedFields() []string {
+func (m *ChannelModelMutation) AddedFields() []string {
 	var fields []string
-	if m.addpriority != nil {
-		fields = append(fields, channelconfig.FieldPriority)
+	if m.addsupports_responses != nil {
+		fields = append(fields, channelmodel.FieldSupportsResponses)
 	}
-	if m.addweight != nil {
-		fields = append(fields, channelconfig.FieldWeight)
+	if m.addsupports_chat_completions != nil {
+		fields = append(fields, channelmodel.FieldSupportsChatCompletions)
 	}
-	if m.addcapacity_hint != nil {
-		fields = append(fields, channelconfig.FieldCapacityHint)
+	if m.addsupports_embeddings != nil {
+		fields = append(fields, channelmodel.FieldSupportsEmbeddings)
+	}
+	if m.addcontext_window != nil {
+		fields = append(fields, channelmodel.FieldContextWindow)
 	}
 	return fields
 }
@@ -2480,14 +4469,16 @@ func (m *ChannelConfigMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ChannelConfigMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ChannelModelMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case channelconfig.FieldPriority:
-		return m.AddedPriority()
-	case channelconfig.FieldWeight:
-		return m.AddedWeight()
-	case channelconfig.FieldCapacityHint:
-		return m.AddedCapacityHint()
+	case channelmodel.FieldSupportsResponses:
+		return m.AddedSupportsResponses()
+	case channelmodel.FieldSupportsChatCompletions:
+		return m.AddedSupportsChatCompletions()
+	case channelmodel.FieldSupportsEmbeddings:
+		return m.AddedSupportsEmbeddings()
+	case channelmodel.FieldContextWindow:
+		return m.AddedContextWindow()
 	}
 	return nil, false
 }
@@ -2495,243 +4486,232 @@ func (m *ChannelConfigMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChannelConfigMutation) AddField(name string, value ent.Value) error {
+func (m *ChannelModelMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case channelconfig.FieldPriority:
+	case channelmodel.FieldSupportsResponses:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPriority(v)
+		m.AddSupportsResponses(v)
 		return nil
-	case channelconfig.FieldWeight:
-		v, ok := value.(float64)
+	case channelmodel.FieldSupportsChatCompletions:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddWeight(v)
+		m.AddSupportsChatCompletions(v)
 		return nil
-	case channelconfig.FieldCapacityHint:
-		v, ok := value.(float64)
+	case channelmodel.FieldSupportsEmbeddings:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCapacityHint(v)
+		m.AddSupportsEmbeddings(v)
+		return nil
+	case channelmodel.FieldContextWindow:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddContextWindow(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ChannelConfig numeric field %s", name)
+	return fmt.Errorf("unknown ChannelModel numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ChannelConfigMutation) ClearedFields() []string {
+func (m *ChannelModelMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(channelconfig.FieldAPIKeyCiphertext) {
-		fields = append(fields, channelconfig.FieldAPIKeyCiphertext)
+	if m.FieldCleared(channelmodel.FieldSupportsResponses) {
+		fields = append(fields, channelmodel.FieldSupportsResponses)
 	}
-	if m.FieldCleared(channelconfig.FieldLastProbeAt) {
-		fields = append(fields, channelconfig.FieldLastProbeAt)
+	if m.FieldCleared(channelmodel.FieldSupportsChatCompletions) {
+		fields = append(fields, channelmodel.FieldSupportsChatCompletions)
+	}
+	if m.FieldCleared(channelmodel.FieldSupportsEmbeddings) {
+		fields = append(fields, channelmodel.FieldSupportsEmbeddings)
+	}
+	if m.FieldCleared(channelmodel.FieldContextWindow) {
+		fields = append(fields, channelmodel.FieldContextWindow)
+	}
+	if m.FieldCleared(channelmodel.FieldLastProbeAt) {
+		fields = append(fields, channelmodel.FieldLastProbeAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ChannelConfigMutation) FieldCleared(name string) bool {
+func (m *ChannelModelMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ChannelConfigMutation) ClearField(name string) error {
+func (m *ChannelModelMutation) ClearField(name string) error {
 	switch name {
-	case channelconfig.FieldAPIKeyCiphertext:
-		m.ClearAPIKeyCiphertext()
+	case channelmodel.FieldSupportsResponses:
+		m.ClearSupportsResponses()
 		return nil
-	case channelconfig.FieldLastProbeAt:
+	case channelmodel.FieldSupportsChatCompletions:
+		m.ClearSupportsChatCompletions()
+		return nil
+	case channelmodel.FieldSupportsEmbeddings:
+		m.ClearSupportsEmbeddings()
+		return nil
+	case channelmodel.FieldContextWindow:
+		m.ClearContextWindow()
+		return nil
+	case channelmodel.FieldLastProbeAt:
 		m.ClearLastProbeAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ChannelConfig nullable field %s", name)
+	return fmt.Errorf("unknown ChannelModel nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ChannelConfigMutation) ResetField(name string) error {
+func (m *ChannelModelMutation) ResetField(name string) error {
 	switch name {
-	case channelconfig.FieldName:
-		m.ResetName()
-		return nil
-	case channelconfig.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case channelconfig.FieldSource:
-		m.ResetSource()
-		return nil
-	case channelconfig.FieldBaseURL:
-		m.ResetBaseURL()
-		return nil
-	case channelconfig.FieldProviderPreset:
-		m.ResetProviderPreset()
-		return nil
-	case channelconfig.FieldProtocolFamily:
-		m.ResetProtocolFamily()
-		return nil
-	case channelconfig.FieldRoutingProfile:
-		m.ResetRoutingProfile()
-		return nil
-	case channelconfig.FieldAPIVersion:
-		m.ResetAPIVersion()
-		return nil
-	case channelconfig.FieldDeployment:
-		m.ResetDeployment()
-		return nil
-	case channelconfig.FieldProject:
-		m.ResetProject()
-		return nil
-	case channelconfig.FieldLocation:
-		m.ResetLocation()
-		return nil
-	case channelconfig.FieldModelResource:
-		m.ResetModelResource()
+	case channelmodel.FieldChannelID:
+		m.ResetChannelID()
 		return nil
-	case channelconfig.FieldAPIKeyCiphertext:
-		m.ResetAPIKeyCiphertext()
+	case channelmodel.FieldModel:
+		m.ResetModel()
 		return nil
-	case channelconfig.FieldAPIKeyHint:
-		m.ResetAPIKeyHint()
+	case channelmodel.FieldDisplayName:
+		m.ResetDisplayName()
 		return nil
-	case channelconfig.FieldHeadersJSON:
-		m.ResetHeadersJSON()
+	case channelmodel.FieldSource:
+		m.ResetSource()
 		return nil
-	case channelconfig.FieldEnabled:
+	case channelmodel.FieldEnabled:
 		m.ResetEnabled()
 		return nil
-	case channelconfig.FieldPriority:
-		m.ResetPriority()
+	case channelmodel.FieldSupportsResponses:
+		m.ResetSupportsResponses()
 		return nil
-	case channelconfig.FieldWeight:
-		m.ResetWeight()
+	case channelmodel.FieldSupportsChatCompletions:
+		m.ResetSupportsChatCompletions()
 		return nil
-	case channelconfig.FieldCapacityHint:
-		m.ResetCapacityHint()
+	case channelmodel.FieldSupportsEmbeddings:
+		m.ResetSupportsEmbeddings()
 		return nil
-	case channelconfig.FieldModelDiscovery:
-		m.ResetModelDiscovery()
+	case channelmodel.FieldContextWindow:
+		m.ResetContextWindow()
 		return nil
-	case channelconfig.FieldAllowUnknownModels:
-		m.ResetAllowUnknownModels()
+	case channelmodel.FieldInputModalitiesJSON:
+		m.ResetInputModalitiesJSON()
 		return nil
-	case channelconfig.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case channelmodel.FieldOutputModalitiesJSON:
+		m.ResetOutputModalitiesJSON()
 		return nil
-	case channelconfig.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case channelmodel.FieldRawModelJSON:
+		m.ResetRawModelJSON()
 		return nil
-	case channelconfig.FieldLastProbeAt:
-		m.ResetLastProbeAt()
+	case channelmodel.FieldFirstSeenAt:
+		m.ResetFirstSeenAt()
 		return nil
-	case channelconfig.FieldLastProbeStatus:
-		m.ResetLastProbeStatus()
+	case channelmodel.FieldLastSeenAt:
+		m.ResetLastSeenAt()
 		return nil
-	case channelconfig.FieldLastProbeError:
-		m.ResetLastProbeError()
+	case channelmodel.FieldLastProbeAt:
+		m.ResetLastProbeAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ChannelConfig field %s", name)
+	return fmt.Errorf("unknown ChannelModel field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChannelConfigMutation) AddedEdges() []string {
+func (m *ChannelModelMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ChannelConfigMutation) AddedIDs(name string) []ent.Value {
+func (m *ChannelModelMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChannelConfigMutation) RemovedEdges() []string {
+func (m *ChannelModelMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ChannelConfigMutation) RemovedIDs(name string) []ent.Value {
+func (m *ChannelModelMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChannelConfigMutation) ClearedEdges() []string {
+func (m *ChannelModelMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ChannelConfigMutation) EdgeCleared(name string) bool {
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ChannelConfigMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ChannelConfig unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ChannelConfigMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ChannelConfig edge %s", name)
-}
-
-// ChannelModelMutation represents an operation that mutates the ChannelModel nodes in the graph.
-type ChannelModelMutation struct {
-	config
-	op                           Op
-	typ                          string
-	id                           *int
-	channel_id                   *string
-	model                        *string
-	display_name                 *string
-	source                       *string
-	enabled                      *bool
-	supports_responses           *int
-	addsupports_responses        *int
-	supports_chat_completions    *int
-	addsupports_chat_completions *int
-	supports_embeddings          *int
-	addsupports_embeddings       *int
-	context_window               *int
-	addcontext_window            *int
-	input_modalities_json        *string
-	output_modalities_json       *string
-	raw_model_json               *string
-	first_seen_at                *time.Time
-	last_seen_at                 *time.Time
-	last_probe_at                *time.Time
-	clearedFields                map[string]struct{}
-	done                         bool
-	oldValue                     func(context.Context) (*ChannelModel, error)
-	predicates                   []predicate.ChannelModel
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChannelModelMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-var _ ent.Mutation = (*ChannelModelMutation)(nil)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChannelModelMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChannelModel unique edge %s", name)
+}
 
-// channelmodelOption allows management of the mutation configuration using functional options.
-type channelmodelOption func(*ChannelModelMutation)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChannelModelMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChannelModel edge %s", name)
+}
 
-// newChannelModelMutation creates new mutation for the ChannelModel entity.
-func newChannelModelMutation(c config, op Op, opts ...channelmodelOption) *ChannelModelMutation {
-	m := &ChannelModelMutation{
+// ChannelProbeRunMutation represents an operation that mutates the ChannelProbeRun nodes in the graph.
+type ChannelProbeRunMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *string
+	channel_id           *string
+	status               *string
+	started_at           *time.Time
+	completed_at         *time.Time
+	duration_ms          *int64
+	addduration_ms       *int64
+	discovered_count     *int
+	adddiscovered_count  *int
+	enabled_count        *int
+	addenabled_count     *int
+	endpoint             *string
+	status_code          *int
+	addstatus_code       *int
+	error_text           *string
+	request_meta_json    *string
+	response_sample_json *string
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*ChannelProbeRun, error)
+	predicates           []predicate.ChannelProbeRun
+}
+
+var _ ent.Mutation = (*ChannelProbeRunMutation)(nil)
+
+// channelproberunOption allows management of the mutation configuration using functional options.
+type channelproberunOption func(*ChannelProbeRunMutation)
+
+// newChannelProbeRunMutation creates new mutation for the ChannelProbeRun entity.
+func newChannelProbeRunMutation(c config, op Op, opts ...channelproberunOption) *ChannelProbeRunMutation {
+	m := &ChannelProbeRunMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeChannelModel,
+		typ:           TypeChannelProbeRun,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2740,20 +4720,20 @@ func newChannelModelMutation(c config, op Op, opts ...channelmodelOption) *Chann
 	return m
 }
 
-// withChannelModelID sets the ID field of the mutation.
-func withChannelModelID(id int) channelmodelOption {
-	return func(m *ChannelModelMutation) {
+// withChannelProbeRunID sets the ID field of the mutation.
+func withChannelProbeRunID(id string) channelproberunOption {
+	return func(m *ChannelProbeRunMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ChannelModel
+			value *ChannelProbeRun
 		)
-		m.oldValue = func(ctx context.Context) (*ChannelModel, error) {
+		m.oldValue = func(ctx context.Context) (*ChannelProbeRun, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ChannelModel.Get(ctx, id)
+					value, err = m.Client().ChannelProbeRun.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2762,10 +4742,10 @@ func withChannelModelID(id int) channelmodelOption {
 	}
 }
 
-// withChannelModel sets the old ChannelModel of the mutation.
-func withChannelModel(node *ChannelModel) channelmodelOption {
-	return func(m *ChannelModelMutation) {
-		m.oldValue = func(context.Context) (*ChannelModel, error) {
+// withChannelProbeRun sets the old ChannelProbeRun of the mutation.
+func withChannelProbeRun(node *ChannelProbeRun) channelproberunOption {
+	return func(m *ChannelProbeRunMutation) {
+		m.oldValue = func(context.Context) (*ChannelProbeRun, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2774,7 +4754,7 @@ func withChannelModel(node *ChannelModel) channelmodelOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChannelModelMutation) Client() *Client {
+func (m ChannelProbeRunMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2782,7 +4762,7 @@ func (m ChannelModelMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ChannelModelMutation) Tx() (*Tx, error) {
+func (m ChannelProbeRunMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("dao: mutation is not running in a transaction")
 	}
@@ -2791,9 +4771,15 @@ func (m ChannelModelMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ChannelProbeRun entities.
+func (m *ChannelProbeRunMutation) SetID(id string) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ChannelModelMutation) ID() (id int, exists bool) {
+func (m *ChannelProbeRunMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2804,28 +4790,28 @@ func (m *ChannelModelMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ChannelModelMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ChannelProbeRunMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ChannelModel.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ChannelProbeRun.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetChannelID sets the "channel_id" field.
-func (m *ChannelModelMutation) SetChannelID(s string) {
+func (m *ChannelProbeRunMutation) SetChannelID(s string) {
 	m.channel_id = &s
 }
 
 // ChannelID returns the value of the "channel_id" field in the mutation.
-func (m *ChannelModelMutation) ChannelID() (r string, exists bool) {
+func (m *ChannelProbeRunMutation) ChannelID() (r string, exists bool) {
 	v := m.channel_id
 	if v == nil {
 		return
@@ -2833,10 +4819,10 @@ func (m *ChannelModelMutation) ChannelID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldChannelID returns the old "channel_id" field's value of the ChannelModel entity.
-// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// OldChannelID returns the old "channel_id" field's value of the ChannelProbeRun entity.
+// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelModelMutation) OldChannelID(ctx context.Context) (v string, err error) {
+func (m *ChannelProbeRunMutation) OldChannelID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldChannelID is only allowed on UpdateOne operations")
 	}
@@ -2851,672 +4837,508 @@ func (m *ChannelModelMutation) OldChannelID(ctx context.Context) (v string, err
 }
 
 // ResetChannelID resets all changes to the "channel_id" field.
-func (m *ChannelModelMutation) ResetChannelID() {
+func (m *ChannelProbeRunMutation) ResetChannelID() {
 	m.channel_id = nil
 }
 
-// SetModel sets the "model" field.
-func (m *ChannelModelMutation) SetModel(s string) {
-	m.model = &s
-}
-
-// Model returns the value of the "model" field in the mutation.
-func (m *ChannelModelMutation) Model() (r string, exists bool) {
-	v := m.model
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldModel returns the old "model" field's value of the ChannelModel entity.
-// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelModelMutation) OldModel(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModel is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModel requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModel: %w", err)
-	}
-	return oldValue.Model, nil
-}
-
-// ResetModel resets all changes to the "model" field.
-func (m *ChannelModelMutation) ResetModel() {
-	m.model = nil
-}
-
-// SetDisplayName sets the "display_name" field.
-func (m *ChannelModelMutation) SetDisplayName(s string) {
-	m.display_name = &s
-}
-
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *ChannelModelMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDisplayName returns the old "display_name" field's value of the ChannelModel entity.
-// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelModelMutation) OldDisplayName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
-	}
-	return oldValue.DisplayName, nil
-}
-
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *ChannelModelMutation) ResetDisplayName() {
-	m.display_name = nil
-}
-
-// SetSource sets the "source" field.
-func (m *ChannelModelMutation) SetSource(s string) {
-	m.source = &s
+// SetStatus sets the "status" field.
+func (m *ChannelProbeRunMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// Source returns the value of the "source" field in the mutation.
-func (m *ChannelModelMutation) Source() (r string, exists bool) {
-	v := m.source
+// Status returns the value of the "status" field in the mutation.
+func (m *ChannelProbeRunMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSource returns the old "source" field's value of the ChannelModel entity.
-// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the ChannelProbeRun entity.
+// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelModelMutation) OldSource(ctx context.Context) (v string, err error) {
+func (m *ChannelProbeRunMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Source, nil
+	return oldValue.Status, nil
 }
 
-// ResetSource resets all changes to the "source" field.
-func (m *ChannelModelMutation) ResetSource() {
-	m.source = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *ChannelProbeRunMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *ChannelModelMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// SetStartedAt sets the "started_at" field.
+func (m *ChannelProbeRunMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *ChannelModelMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *ChannelProbeRunMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the ChannelModel entity.
-// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the ChannelProbeRun entity.
+// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelModelMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+func (m *ChannelProbeRunMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.Enabled, nil
+	return oldValue.StartedAt, nil
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *ChannelModelMutation) ResetEnabled() {
-	m.enabled = nil
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *ChannelProbeRunMutation) ResetStartedAt() {
+	m.started_at = nil
 }
 
-// SetSupportsResponses sets the "supports_responses" field.
-func (m *ChannelModelMutation) SetSupportsResponses(i int) {
-	m.supports_responses = &i
-	m.addsupports_responses = nil
+// SetCompletedAt sets the "completed_at" field.
+func (m *ChannelProbeRunMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
 }
 
-// SupportsResponses returns the value of the "supports_responses" field in the mutation.
-func (m *ChannelModelMutation) SupportsResponses() (r int, exists bool) {
-	v := m.supports_responses
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *ChannelProbeRunMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSupportsResponses returns the old "supports_responses" field's value of the ChannelModel entity.
-// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// OldCompletedAt returns the old "completed_at" field's value of the ChannelProbeRun entity.
+// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelModelMutation) OldSupportsResponses(ctx context.Context) (v *int, err error) {
+func (m *ChannelProbeRunMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSupportsResponses is only allowed on UpdateOne operations")
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSupportsResponses requires an ID field in the mutation")
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSupportsResponses: %w", err)
-	}
-	return oldValue.SupportsResponses, nil
-}
-
-// AddSupportsResponses adds i to the "supports_responses" field.
-func (m *ChannelModelMutation) AddSupportsResponses(i int) {
-	if m.addsupports_responses != nil {
-		*m.addsupports_responses += i
-	} else {
-		m.addsupports_responses = &i
-	}
-}
-
-// AddedSupportsResponses returns the value that was added to the "supports_responses" field in this mutation.
-func (m *ChannelModelMutation) AddedSupportsResponses() (r int, exists bool) {
-	v := m.addsupports_responses
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.CompletedAt, nil
 }
 
-// ClearSupportsResponses clears the value of the "supports_responses" field.
-func (m *ChannelModelMutation) ClearSupportsResponses() {
-	m.supports_responses = nil
-	m.addsupports_responses = nil
-	m.clearedFields[channelmodel.FieldSupportsResponses] = struct{}{}
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *ChannelProbeRunMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[channelproberun.FieldCompletedAt] = struct{}{}
 }
 
-// SupportsResponsesCleared returns if the "supports_responses" field was cleared in this mutation.
-func (m *ChannelModelMutation) SupportsResponsesCleared() bool {
-	_, ok := m.clearedFields[channelmodel.FieldSupportsResponses]
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *ChannelProbeRunMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[channelproberun.FieldCompletedAt]
 	return ok
 }
 
-// ResetSupportsResponses resets all changes to the "supports_responses" field.
-func (m *ChannelModelMutation) ResetSupportsResponses() {
-	m.supports_responses = nil
-	m.addsupports_responses = nil
-	delete(m.clearedFields, channelmodel.FieldSupportsResponses)
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *ChannelProbeRunMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, channelproberun.FieldCompletedAt)
 }
 
-// SetSupportsChatCompletions sets the "supports_chat_completions" field.
-func (m *ChannelModelMutation) SetSupportsChatCompletions(i int) {
-	m.supports_chat_completions = &i
-	m.addsupports_chat_completions = nil
+// SetDurationMs sets the "duration_ms" field.
+func (m *ChannelProbeRunMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
 }
 
-// SupportsChatCompletions returns the value of the "supports_chat_completions" field in the mutation.
-func (m *ChannelModelMutation) SupportsChatCompletions() (r int, exists bool) {
-	v := m.supports_chat_completions
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *ChannelProbeRunMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSupportsChatCompletions returns the old "supports_chat_completions" field's value of the ChannelModel entity.
-// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// OldDurationMs returns the old "duration_ms" field's value of the ChannelProbeRun entity.
+// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelModelMutation) OldSupportsChatCompletions(ctx context.Context) (v *int, err error) {
+func (m *ChannelProbeRunMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSupportsChatCompletions is only allowed on UpdateOne operations")
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSupportsChatCompletions requires an ID field in the mutation")
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSupportsChatCompletions: %w", err)
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
 	}
-	return oldValue.SupportsChatCompletions, nil
+	return oldValue.DurationMs, nil
 }
 
-// AddSupportsChatCompletions adds i to the "supports_chat_completions" field.
-func (m *ChannelModelMutation) AddSupportsChatCompletions(i int) {
-	if m.addsupports_chat_completions != nil {
-		*m.addsupports_chat_completions += i
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *ChannelProbeRunMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
 	} else {
-		m.addsupports_chat_completions = &i
+		m.addduration_ms = &i
 	}
 }
 
-// AddedSupportsChatCompletions returns the value that was added to the "supports_chat_completions" field in this mutation.
-func (m *ChannelModelMutation) AddedSupportsChatCompletions() (r int, exists bool) {
-	v := m.addsupports_chat_completions
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *ChannelProbeRunMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearSupportsChatCompletions clears the value of the "supports_chat_completions" field.
-func (m *ChannelModelMutation) ClearSupportsChatCompletions() {
-	m.supports_chat_completions = nil
-	m.addsupports_chat_completions = nil
-	m.clearedFields[channelmodel.FieldSupportsChatCompletions] = struct{}{}
-}
-
-// SupportsChatCompletionsCleared returns if the "supports_chat_completions" field was cleared in this mutation.
-func (m *ChannelModelMutation) SupportsChatCompletionsCleared() bool {
-	_, ok := m.clearedFields[channelmodel.FieldSupportsChatCompletions]
-	return ok
-}
-
-// ResetSupportsChatCompletions resets all changes to the "supports_chat_completions" field.
-func (m *ChannelModelMutation) ResetSupportsChatCompletions() {
-	m.supports_chat_completions = nil
-	m.addsupports_chat_completions = nil
-	delete(m.clearedFields, channelmodel.FieldSupportsChatCompletions)
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *ChannelProbeRunMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
 }
 
-// SetSupportsEmbeddings sets the "supports_embeddings" field.
-func (m *ChannelModelMutation) SetSupportsEmbeddings(i int) {
-	m.supports_embeddings = &i
-	m.addsupports_embeddings = nil
+// SetDiscoveredCount sets the "discovered_count" field.
+func (m *ChannelProbeRunMutation) SetDiscoveredCount(i int) {
+	m.discovered_count = &i
+	m.adddiscovered_count = nil
 }
 
-// SupportsEmbeddings returns the value of the "supports_embeddings" field in the mutation.
-func (m *ChannelModelMutation) SupportsEmbeddings() (r int, exists bool) {
-	v := m.supports_embeddings
+// DiscoveredCount returns the value of the "discovered_count" field in the mutation.
+func (m *ChannelProbeRunMutation) DiscoveredCount() (r int, exists bool) {
+	v := m.discovered_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSupportsEmbeddings returns the old "supports_embeddings" field's value of the ChannelModel entity.
-// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// OldDiscoveredCount returns the old "discovered_count" field's value of the ChannelProbeRun entity.
+// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelModelMutation) OldSupportsEmbeddings(ctx context.Context) (v *int, err error) {
+func (m *ChannelProbeRunMutation) OldDiscoveredCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSupportsEmbeddings is only allowed on UpdateOne operations")
+		return v, errors.New("OldDiscoveredCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSupportsEmbeddings requires an ID field in the mutation")
+		return v, errors.New("OldDiscoveredCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSupportsEmbeddings: %w", err)
+		return v, fmt.Errorf("querying old value for OldDiscoveredCount: %w", err)
 	}
-	return oldValue.SupportsEmbeddings, nil
+	return oldValue.DiscoveredCount, nil
 }
 
-// AddSupportsEmbeddings adds i to the "supports_embeddings" field.
-func (m *ChannelModelMutation) AddSupportsEmbeddings(i int) {
-	if m.addsupports_embeddings != nil {
-		*m.addsupports_embeddings += i
+// AddDiscoveredCount adds i to the "discovered_count" field.
+func (m *ChannelProbeRunMutation) AddDiscoveredCount(i int) {
+	if m.adddiscovered_count != nil {
+		*m.adddiscovered_count += i
 	} else {
-		m.addsupports_embeddings = &i
+		m.adddiscovered_count = &i
 	}
 }
 
-// AddedSupportsEmbeddings returns the value that was added to the "supports_embeddings" field in this mutation.
-func (m *ChannelModelMutation) AddedSupportsEmbeddings() (r int, exists bool) {
-	v := m.addsupports_embeddings
+// AddedDiscoveredCount returns the value that was added to the "discovered_count" field in this mutation.
+func (m *ChannelProbeRunMutation) AddedDiscoveredCount() (r int, exists bool) {
+	v := m.adddiscovered_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearSupportsEmbeddings clears the value of the "supports_embeddings" field.
-func (m *ChannelModelMutation) ClearSupportsEmbeddings() {
-	m.supports_embeddings = nil
-	m.addsupports_embeddings = nil
-	m.clearedFields[channelmodel.FieldSupportsEmbeddings] = struct{}{}
-}
-
-// SupportsEmbeddingsCleared returns if the "supports_embeddings" field was cleared in this mutation.
-func (m *ChannelModelMutation) SupportsEmbeddingsCleared() bool {
-	_, ok := m.clearedFields[channelmodel.FieldSupportsEmbeddings]
-	return ok
-}
-
-// ResetSupportsEmbeddings resets all changes to the "supports_embeddings" field.
-func (m *ChannelModelMutation) ResetSupportsEmbeddings() {
-	m.supports_embeddings = nil
-	m.addsupports_embeddings = nil
-	delete(m.clearedFields, channelmodel.FieldSupportsEmbeddings)
+// ResetDiscoveredCount resets all changes to the "discovered_count" field.
+func (m *ChannelProbeRunMutation) ResetDiscoveredCount() {
+	m.discovered_count = nil
+	m.adddiscovered_count = nil
 }
 
-// SetContextWindow sets the "context_window" field.
-func (m *ChannelModelMutation) SetContextWindow(i int) {
-	m.context_window = &i
-	m.addcontext_window = nil
+// SetEnabledCount sets the "enabled_count" field.
+func (m *ChannelProbeRunMutation) SetEnabledCount(i int) {
+	m.enabled_count = &i
+	m.addenabled_count = nil
 }
 
-// ContextWindow returns the value of the "context_window" field in the mutation.
-func (m *ChannelModelMutation) ContextWindow() (r int, exists bool) {
-	v := m.context_window
+// EnabledCount returns the value of the "enabled_count" field in the mutation.
+func (m *ChannelProbeRunMutation) EnabledCount() (r int, exists bool) {
+	v := m.enabled_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContextWindow returns the old "context_window" field's value of the ChannelModel entity.
-// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// OldEnabledCount returns the old "enabled_count" field's value of the ChannelProbeRun entity.
+// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelModelMutation) OldContextWindow(ctx context.Context) (v *int, err error) {
+func (m *ChannelProbeRunMutation) OldEnabledCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContextWindow is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnabledCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContextWindow requires an ID field in the mutation")
+		return v, errors.New("OldEnabledCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContextWindow: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnabledCount: %w", err)
 	}
-	return oldValue.ContextWindow, nil
+	return oldValue.EnabledCount, nil
 }
 
-// AddContextWindow adds i to the "context_window" field.
-func (m *ChannelModelMutation) AddContextWindow(i int) {
-	if m.addcontext_window != nil {
-		*m.addcontext_window += i
+// AddEnabledCount adds i to the "enabled_count" field.
+func (m *ChannelProbeRunMutation) AddEnabledCount(i int) {
+	if m.addenabled_count != nil {
+		*m.addenabled_count += i
 	} else {
-		m.addcontext_window = &i
+		m.addenabled_count = &i
 	}
 }
 
-// AddedContextWindow returns the value that was added to the "context_window" field in this mutation.
-func (m *ChannelModelMutation) AddedContextWindow() (r int, exists bool) {
-	v := m.addcontext_window
+// AddedEnabledCount returns the value that was added to the "enabled_count" field in this mutation.
+func (m *ChannelProbeRunMutation) AddedEnabledCount() (r int, exists bool) {
+	v := m.addenabled_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearContextWindow clears the value of the "context_window" field.
-func (m *ChannelModelMutation) ClearContextWindow() {
-	m.context_window = nil
-	m.addcontext_window = nil
-	m.clearedFields[channelmodel.FieldContextWindow] = struct{}{}
-}
-
-// ContextWindowCleared returns if the "context_window" field was cleared in this mutation.
-func (m *ChannelModelMutation) ContextWindowCleared() bool {
-	_, ok := m.clearedFields[channelmodel.FieldContextWindow]
-	return ok
-}
-
-// ResetContextWindow resets all changes to the "context_window" field.
-func (m *ChannelModelMutation) ResetContextWindow() {
-	m.context_window = nil
-	m.addcontext_window = nil
-	delete(m.clearedFields, channelmodel.FieldContextWindow)
+// ResetEnabledCount resets all changes to the "enabled_count" field.
+func (m *ChannelProbeRunMutation) ResetEnabledCount() {
+	m.enabled_count = nil
+	m.addenabled_count = nil
 }
 
-// SetInputModalitiesJSON sets the "input_modalities_json" field.
-func (m *ChannelModelMutation) SetInputModalitiesJSON(s string) {
-	m.input_modalities_json = &s
+// SetEndpoint sets the "endpoint" field.
+func (m *ChannelProbeRunMutation) SetEndpoint(s string) {
+	m.endpoint = &s
 }
 
-// InputModalitiesJSON returns the value of the "input_modalities_json" field in the mutation.
-func (m *ChannelModelMutation) InputModalitiesJSON() (r string, exists bool) {
-	v := m.input_modalities_json
+// Endpoint returns the value of the "endpoint" field in the mutation.
+func (m *ChannelProbeRunMutation) Endpoint() (r string, exists bool) {
+	v := m.endpoint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInputModalitiesJSON returns the old "input_modalities_json" field's value of the ChannelModel entity.
-// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// OldEndpoint returns the old "endpoint" field's value of the ChannelProbeRun entity.
+// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelModelMutation) OldInputModalitiesJSON(ctx context.Context) (v string, err error) {
+func (m *ChannelProbeRunMutation) OldEndpoint(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInputModalitiesJSON is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInputModalitiesJSON requires an ID field in the mutation")
+		return v, errors.New("OldEndpoint requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInputModalitiesJSON: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
 	}
-	return oldValue.InputModalitiesJSON, nil
+	return oldValue.Endpoint, nil
 }
 
-// ResetInputModalitiesJSON resets all changes to the "input_modalities_json" field.
-func (m *ChannelModelMutation) ResetInputModalitiesJSON() {
-	m.input_modalities_json = nil
+// ResetEndpoint resets all changes to the "endpoint" field.
+func (m *ChannelProbeRunMutation) ResetEndpoint() {
+	m.endpoint = nil
 }
 
-// SetOutputModalitiesJSON sets the "output_modalities_json" field.
-func (m *ChannelModelMutation) SetOutputModalitiesJSON(s string) {
-	m.output_modalities_json = &s
+// SetStatusCode sets the "status_code" field.
+func (m *ChannelProbeRunMutation) SetStatusCode(i int) {
+	m.status_code = &i
+	m.addstatus_code = nil
 }
 
-// OutputModalitiesJSON returns the value of the "output_modalities_json" field in the mutation.
-func (m *ChannelModelMutation) OutputModalitiesJSON() (r string, exists bool) {
-	v := m.output_modalities_json
+// StatusCode returns the value of the "status_code" field in the mutation.
+func (m *ChannelProbeRunMutation) StatusCode() (r int, exists bool) {
+	v := m.status_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOutputModalitiesJSON returns the old "output_modalities_json" field's value of the ChannelModel entity.
-// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// OldStatusCode returns the old "status_code" field's value of the ChannelProbeRun entity.
+// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelModelMutation) OldOutputModalitiesJSON(ctx context.Context) (v string, err error) {
+func (m *ChannelProbeRunMutation) OldStatusCode(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOutputModalitiesJSON is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatusCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOutputModalitiesJSON requires an ID field in the mutation")
+		return v, errors.New("OldStatusCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOutputModalitiesJSON: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatusCode: %w", err)
 	}
-	return oldValue.OutputModalitiesJSON, nil
-}
-
-// ResetOutputModalitiesJSON resets all changes to the "output_modalities_json" field.
-func (m *ChannelModelMutation) ResetOutputModalitiesJSON() {
-	m.output_modalities_json = nil
+	return oldValue.StatusCode, nil
 }
 
-// SetRawModelJSON sets the "raw_model_json" field.
-func (m *ChannelModelMutation) SetRawModelJSON(s string) {
-	m.raw_model_json = &s
+// AddStatusCode adds i to the "status_code" field.
+func (m *ChannelProbeRunMutation) AddStatusCode(i int) {
+	if m.addstatus_code != nil {
+		*m.addstatus_code += i
+	} else {
+		m.addstatus_code = &i
+	}
 }
 
-// RawModelJSON returns the value of the "raw_model_json" field in the mutation.
-func (m *ChannelModelMutation) RawModelJSON() (r string, exists bool) {
-	v := m.raw_model_json
+// AddedStatusCode returns the value that was added to the "status_code" field in this mutation.
+func (m *ChannelProbeRunMutation) AddedStatusCode() (r int, exists bool) {
+	v := m.addstatus_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRawModelJSON returns the old "raw_model_json" field's value of the ChannelModel entity.
-// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelModelMutation) OldRawModelJSON(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRawModelJSON is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRawModelJSON requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRawModelJSON: %w", err)
-	}
-	return oldValue.RawModelJSON, nil
-}
-
-// ResetRawModelJSON resets all changes to the "raw_model_json" field.
-func (m *ChannelModelMutation) ResetRawModelJSON() {
-	m.raw_model_json = nil
+// ResetStatusCode resets all changes to the "status_code" field.
+func (m *ChannelProbeRunMutation) ResetStatusCode() {
+	m.status_code = nil
+	m.addstatus_code = nil
 }
 
-// SetFirstSeenAt sets the "first_seen_at" field.
-func (m *ChannelModelMutation) SetFirstSeenAt(t time.Time) {
-	m.first_seen_at = &t
+// SetErrorText sets the "error_text" field.
+func (m *ChannelProbeRunMutation) SetErrorText(s string) {
+	m.error_text = &s
 }
 
-// FirstSeenAt returns the value of the "first_seen_at" field in the mutation.
-func (m *ChannelModelMutation) FirstSeenAt() (r time.Time, exists bool) {
-	v := m.first_seen_at
+// ErrorText returns the value of the "error_text" field in the mutation.
+func (m *ChannelProbeRunMutation) ErrorText() (r string, exists bool) {
+	v := m.error_text
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFirstSeenAt returns the old "first_seen_at" field's value of the ChannelModel entity.
-// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// OldErrorText returns the old "error_text" field's value of the ChannelProbeRun entity.
+// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelModelMutation) OldFirstSeenAt(ctx context.Context) (v time.Time, err error) {
+func (m *ChannelProbeRunMutation) OldErrorText(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirstSeenAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldErrorText is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirstSeenAt requires an ID field in the mutation")
+		return v, errors.New("OldErrorText requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirstSeenAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldErrorText: %w", err)
 	}
-	return oldValue.FirstSeenAt, nil
+	return oldValue.ErrorText, nil
 }
 
-// ResetFirstSeenAt resets all changes to the "first_seen_at" field.
-func (m *ChannelModelMutation) ResetFirstSeenAt() {
-	m.first_seen_at = nil
+// ResetErrorText resets all changes to the "error_text" field.
+func (m *ChannelProbeRunMutation) ResetErrorText() {
+	m.error_text = nil
 }
 
-// SetLastSeenAt sets the "last_seen_at" field.
-func (m *ChannelModelMutation) SetLastSeenAt(t time.Time) {
-	m.last_seen_at = &t
+// SetRequestMetaJSON sets the "request_meta_json" field.
+func (m *ChannelProbeRunMutation) SetRequestMetaJSON(s string) {
+	m.request_meta_json = &s
 }
 
-// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
-func (m *ChannelModelMutation) LastSeenAt() (r time.Time, exists bool) {
-	v := m.last_seen_at
+// RequestMetaJSON returns the value of the "request_meta_json" field in the mutation.
+func (m *ChannelProbeRunMutation) RequestMetaJSON() (r string, exists bool) {
+	v := m.request_meta_json
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastSeenAt returns the old "last_seen_at" field's value of the ChannelModel entity.
-// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// OldRequestMetaJSON returns the old "request_meta_json" field's value of the ChannelProbeRun entity.
+// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelModelMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+func (m *ChannelProbeRunMutation) OldRequestMetaJSON(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRequestMetaJSON is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+		return v, errors.New("OldRequestMetaJSON requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRequestMetaJSON: %w", err)
 	}
-	return oldValue.LastSeenAt, nil
+	return oldValue.RequestMetaJSON, nil
 }
 
-// ResetLastSeenAt resets all changes to the "last_seen_at" field.
-func (m *ChannelModelMutation) ResetLastSeenAt() {
-	m.last_seen_at = nil
+// ResetRequestMetaJSON resets all changes to the "request_meta_json" field.
+func (m *ChannelProbeRunMutation) ResetRequestMetaJSON() {
+	m.request_meta_json = nil
 }
 
-// SetLastProbeAt sets the "last_probe_at" field.
-func (m *ChannelModelMutation) SetLastProbeAt(t time.Time) {
-	m.last_probe_at = &t
+// SetResponseSampleJSON sets the "response_sample_json" field.
+func (m *ChannelProbeRunMutation) SetResponseSampleJSON(s string) {
+	m.response_sample_json = &s
 }
 
-// LastProbeAt returns the value of the "last_probe_at" field in the mutation.
-func (m *ChannelModelMutation) LastProbeAt() (r time.Time, exists bool) {
-	v := m.last_probe_at
+// ResponseSampleJSON returns the value of the "response_sample_json" field in the mutation.
+func (m *ChannelProbeRunMutation) ResponseSampleJSON() (r string, exists bool) {
+	v := m.response_sample_json
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastProbeAt returns the old "last_probe_at" field's value of the ChannelModel entity.
-// If the ChannelModel object wasn't provided to the builder, the object is fetched from the database.
+// OldResponseSampleJSON returns the old "response_sample_json" field's value of the ChannelProbeRun entity.
+// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelModelMutation) OldLastProbeAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ChannelProbeRunMutation) OldResponseSampleJSON(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastProbeAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldResponseSampleJSON is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastProbeAt requires an ID field in the mutation")
+		return v, errors.New("OldResponseSampleJSON requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastProbeAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponseSampleJSON: %w", err)
 	}
-	return oldValue.LastProbeAt, nil
-}
-
-// ClearLastProbeAt clears the value of the "last_probe_at" field.
-func (m *ChannelModelMutation) ClearLastProbeAt() {
-	m.last_probe_at = nil
-	m.clearedFields[channelmodel.FieldLastProbeAt] = struct{}{}
-}
-
-// LastProbeAtCleared returns if the "last_probe_at" field was cleared in this mutation.
-func (m *ChannelModelMutation) LastProbeAtCleared() bool {
-	_, ok := m.clearedFields[channelmodel.FieldLastProbeAt]
-	return ok
+	return oldValue.ResponseSampleJSON, nil
 }
 
-// ResetLastProbeAt resets all changes to the "last_probe_at" field.
-func (m *ChannelModelMutation) ResetLastProbeAt() {
-	m.last_probe_at = nil
-	delete(m.clearedFields, channelmodel.FieldLastProbeAt)
+// ResetResponseSampleJSON resets all changes to the "response_sample_json" field.
+func (m *ChannelProbeRunMutation) ResetResponseSampleJSON() {
+	m.response_sample_json = nil
 }
 
-// Where appends a list predicates to the ChannelModelMutation builder.
-func (m *ChannelModelMutation) Where(ps ...predicate.ChannelModel) {
+// Where appends a list predicates to the ChannelProbeRunMutation builder.
+func (m *ChannelProbeRunMutation) Where(ps ...predicate.ChannelProbeRun) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ChannelModelMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ChannelProbeRunMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChannelModelMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ChannelModel, len(ps))
+func (m *ChannelProbeRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChannelProbeRun, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3524,69 +5346,60 @@ func (m *ChannelModelMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ChannelModelMutation) Op() Op {
+func (m *ChannelProbeRunMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ChannelModelMutation) SetOp(op Op) {
+func (m *ChannelProbeRunMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ChannelModel).
-func (m *ChannelModelMutation) Type() string {
+// Type returns the node type of this mutation (ChannelProbeRun).
+func (m *ChannelProbeRunMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ChannelModelMutation) Fields() []string {
-	fields := make([]string, 0, 15)
+func (m *ChannelProbeRunMutation) Fields() []string {
+	fields := make([]string, 0, 12)
 	if m.channel_id != nil {
-		fields = append(fields, channelmodel.FieldChannelID)
-	}
-	if m.model != nil {
-		fields = append(fields, channelmodel.FieldModel)
-	}
-	if m.display_name != nil {
-		fields = append(fields, channelmodel.FieldDisplayName)
-	}
-	if m.source != nil {
-		fields = append(fields, channelmodel.FieldSource)
+		fields = append(fields, channelproberun.FieldChannelID)
 	}
-	if m.enabled != nil {
-		fields = append(fields, channelmodel.FieldEnabled)
+	if m.status != nil {
+		fields = append(fields, channelproberun.FieldStatus)
 	}
-	if m.supports_responses != nil {
-		fields = append(fields, channelmodel.FieldSupportsResponses)
+	if m.started_at != nil {
+		fields = append(fields, channelproberun.FieldStartedAt)
 	}
-	if m.supports_chat_completions != nil {
-		fields = append(fields, channelmodel.FieldSupportsChatCompletions)
+	if m.completed_at != nil {
+		fields = append(fields, channelproberun.FieldCompletedAt)
 	}
-	if m.supports_embeddings != nil {
-		fields = append(fields, channelmodel.FieldSupportsEmbeddings)
+	if m.duration_ms != nil {
+		fields = append(fields, channelproberun.FieldDurationMs)
 	}
-	if m.context_window != nil {
-		fields = append(fields, channelmodel.FieldContextWindow)
+	if m.discovered_count != nil {
+		fields = append(fields, channelproberun.FieldDiscoveredCount)
 	}
-	if m.input_modalities_json != nil {
-		fields = append(fields, channelmodel.FieldInputModalitiesJSON)
+	if m.enabled_count != nil {
+		fields = append(fields, channelproberun.FieldEnabledCount)
 	}
-	if m.output_modalities_json != nil {
-		fields = append(fields, channelmodel.FieldOutputModalitiesJSON)
+	if m.endpoint != nil {
+		fields = append(fields, channelproberun.FieldEndpoint)
 	}
-	if m.raw_model_json != nil {
-		fields = append(fields, channelmodel.FieldRawModelJSON)
+	if m.status_code != nil {
+		fields = append(fields, channelproberun.FieldStatusCode)
 	}
-	if m.first_seen_at != nil {
-		fields = append(fields, channelmodel.FieldFirstSeenAt)
+	if m.error_text != nil {
+		fields = append(fields, channelproberun.FieldErrorText)
 	}
-	if m.last_seen_at != nil {
-		fields = append(fields, channelmodel.FieldLastSeenAt)
+	if m.request_meta_json != nil {
+		fields = append(fields, channelproberun.FieldRequestMetaJSON)
 	}
-	if m.last_probe_at != nil {
-		fields = append(fields, channelmodel.FieldLastProbeAt)
+	if m.response_sample_json != nil {
+		fields = append(fields, channelproberun.FieldResponseSampleJSON)
 	}
 	return fields
 }
@@ -3594,38 +5407,32 @@ func (m *ChannelModelMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ChannelModelMutation) Field(name string) (ent.Value, bool) {
+func (m *ChannelProbeRunMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case channelmodel.FieldChannelID:
+	case channelproberun.FieldChannelID:
 		return m.ChannelID()
-	case channelmodel.FieldModel:
-		return m.Model()
-	case channelmodel.FieldDisplayName:
-		return m.DisplayName()
-	case channelmodel.FieldSource:
-		return m.Source()
-	case channelmodel.FieldEnabled:
-		return m.Enabled()
-	case channelmodel.FieldSupportsResponses:
-		return m.SupportsResponses()
-	case channelmodel.FieldSupportsChatCompletions:
-		return m.SupportsChatCompletions()
-	case channelmodel.FieldSupportsEmbeddings:
-		return m.SupportsEmbeddings()
-	case channelmodel.FieldContextWindow:
-		return m.ContextWindow()
-	case channelmodel.FieldInputModalitiesJSON:
-		return m.InputModalitiesJSON()
-	case channelmodel.FieldOutputModalitiesJSON:
-		return m.OutputModalitiesJSON()
-	case channelmodel.FieldRawModelJSON:
-		return m.RawModelJSON()
-	case channelmodel.FieldFirstSeenAt:
-		return m.FirstSeenAt()
-	case channelmodel.FieldLastSeenAt:
-		return m.LastSeenAt()
-	case channelmodel.FieldLastProbeAt:
-		return m.LastProbeAt()
+	case channelproberun.FieldStatus:
+		return m.Status()
+	case channelproberun.FieldStartedAt:
+		return m.StartedAt()
+	case channelproberun.FieldCompletedAt:
+		return m.CompletedAt()
+	case channelproberun.FieldDurationMs:
+		return m.DurationMs()
+	case channelproberun.FieldDiscoveredCount:
+		return m.DiscoveredCount()
+	case channelproberun.FieldEnabledCount:
+		return m.EnabledCount()
+	case channelproberun.FieldEndpoint:
+		return m.Endpoint()
+	case channelproberun.FieldStatusCode:
+		return m.StatusCode()
+	case channelproberun.FieldErrorText:
+		return m.ErrorText()
+	case channelproberun.FieldRequestMetaJSON:
+		return m.RequestMetaJSON()
+	case channelproberun.FieldResponseSampleJSON:
+		return m.ResponseSampleJSON()
 	}
 	return nil, false
 }
@@ -3633,1048 +5440,1215 @@ func (m *ChannelModelMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ChannelModelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ChannelProbeRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case channelmodel.FieldChannelID:
+	case channelproberun.FieldChannelID:
 		return m.OldChannelID(ctx)
-	case channelmodel.FieldModel:
-		return m.OldModel(ctx)
-	case channelmodel.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case channelmodel.FieldSource:
-		return m.OldSource(ctx)
-	case channelmodel.FieldEnabled:
-		return m.OldEnabled(ctx)
-	case channelmodel.FieldSupportsResponses:
-		return m.OldSupportsResponses(ctx)
-	case channelmodel.FieldSupportsChatCompletions:
-		return m.OldSupportsChatCompletions(ctx)
-	case channelmodel.FieldSupportsEmbeddings:
-		return m.OldSupportsEmbeddings(ctx)
-	case channelmodel.FieldContextWindow:
-		return m.OldContextWindow(ctx)
-	case channelmodel.FieldInputModalitiesJSON:
-		return m.OldInputModalitiesJSON(ctx)
-	case channelmodel.FieldOutputModalitiesJSON:
-		return m.OldOutputModalitiesJSON(ctx)
-	case channelmodel.FieldRawModelJSON:
-		return m.OldRawModelJSON(ctx)
-	case channelmodel.FieldFirstSeenAt:
-		return m.OldFirstSeenAt(ctx)
-	case channelmodel.FieldLastSeenAt:
-		return m.OldLastSeenAt(ctx)
-	case channelmodel.FieldLastProbeAt:
-		return m.OldLastProbeAt(ctx)
+	case channelproberun.FieldStatus:
+		return m.OldStatus(ctx)
+	case channelproberun.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case channelproberun.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case channelproberun.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case channelproberun.FieldDiscoveredCount:
+		return m.OldDiscoveredCount(ctx)
+	case channelproberun.FieldEnabledCount:
+		return m.OldEnabledCount(ctx)
+	case channelproberun.FieldEndpoint:
+		return m.OldEndpoint(ctx)
+	case channelproberun.FieldStatusCode:
+		return m.OldStatusCode(ctx)
+	case channelproberun.FieldErrorText:
+		return m.OldErrorText(ctx)
+	case channelproberun.FieldRequestMetaJSON:
+		return m.OldRequestMetaJSON(ctx)
+	case channelproberun.FieldResponseSampleJSON:
+		return m.OldResponseSampleJSON(ctx)
 	}
-	return nil, fmt.Errorf("unknown ChannelModel field %s", name)
+	return nil, fmt.Errorf("unknown ChannelProbeRun field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChannelModelMutation) SetField(name string, value ent.Value) error {
+func (m *ChannelProbeRunMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case channelmodel.FieldChannelID:
+	case channelproberun.FieldChannelID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetChannelID(v)
 		return nil
-	case channelmodel.FieldModel:
+	case channelproberun.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModel(v)
+		m.SetStatus(v)
 		return nil
-	case channelmodel.FieldDisplayName:
-		v, ok := value.(string)
+	case channelproberun.FieldStartedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayName(v)
+		m.SetStartedAt(v)
 		return nil
-	case channelmodel.FieldSource:
-		v, ok := value.(string)
+	case channelproberun.FieldCompletedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSource(v)
+		m.SetCompletedAt(v)
 		return nil
-	case channelmodel.FieldEnabled:
-		v, ok := value.(bool)
+	case channelproberun.FieldDurationMs:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnabled(v)
+		m.SetDurationMs(v)
 		return nil
-	case channelmodel.FieldSupportsResponses:
+	case channelproberun.FieldDiscoveredCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSupportsResponses(v)
+		m.SetDiscoveredCount(v)
 		return nil
-	case channelmodel.FieldSupportsChatCompletions:
+	case channelproberun.FieldEnabledCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSupportsChatCompletions(v)
+		m.SetEnabledCount(v)
 		return nil
-	case channelmodel.FieldSupportsEmbeddings:
-		v, ok := value.(int)
+	case channelproberun.FieldEndpoint:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSupportsEmbeddings(v)
+		m.SetEndpoint(v)
 		return nil
-	case channelmodel.FieldContextWindow:
+	case channelproberun.FieldStatusCode:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContextWindow(v)
+		m.SetStatusCode(v)
 		return nil
-	case channelmodel.FieldInputModalitiesJSON:
+	case channelproberun.FieldErrorText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorText(v)
+		return nil
+	case channelproberun.FieldRequestMetaJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestMetaJSON(v)
+		return nil
+	case channelproberun.FieldResponseSampleJSON:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInputModalitiesJSON(v)
+		m.SetResponseSampleJSON(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChannelProbeRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChannelProbeRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_ms != nil {
+		fields = append(fields, channelproberun.FieldDurationMs)
+	}
+	if m.adddiscovered_count != nil {
+		fields = append(fields, channelproberun.FieldDiscoveredCount)
+	}
+	if m.addenabled_count != nil {
+		fields = append(fields, channelproberun.FieldEnabledCount)
+	}
+	if m.addstatus_code != nil {
+		fields = append(fields, channelproberun.FieldStatusCode)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChannelProbeRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case channelproberun.FieldDurationMs:
+		return m.AddedDurationMs()
+	case channelproberun.FieldDiscoveredCount:
+		return m.AddedDiscoveredCount()
+	case channelproberun.FieldEnabledCount:
+		return m.AddedEnabledCount()
+	case channelproberun.FieldStatusCode:
+		return m.AddedStatusCode()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChannelProbeRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case channelproberun.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
 		return nil
-	case channelmodel.FieldOutputModalitiesJSON:
-		v, ok := value.(string)
+	case channelproberun.FieldDiscoveredCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOutputModalitiesJSON(v)
+		m.AddDiscoveredCount(v)
 		return nil
-	case channelmodel.FieldRawModelJSON:
-		v, ok := value.(string)
+	case channelproberun.FieldEnabledCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRawModelJSON(v)
+		m.AddEnabledCount(v)
 		return nil
-	case channelmodel.FieldFirstSeenAt:
-		v, ok := value.(time.Time)
+	case channelproberun.FieldStatusCode:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFirstSeenAt(v)
+		m.AddStatusCode(v)
 		return nil
-	case channelmodel.FieldLastSeenAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastSeenAt(v)
+	}
+	return fmt.Errorf("unknown ChannelProbeRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChannelProbeRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(channelproberun.FieldCompletedAt) {
+		fields = append(fields, channelproberun.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChannelProbeRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChannelProbeRunMutation) ClearField(name string) error {
+	switch name {
+	case channelproberun.FieldCompletedAt:
+		m.ClearCompletedAt()
 		return nil
-	case channelmodel.FieldLastProbeAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastProbeAt(v)
+	}
+	return fmt.Errorf("unknown ChannelProbeRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChannelProbeRunMutation) ResetField(name string) error {
+	switch name {
+	case channelproberun.FieldChannelID:
+		m.ResetChannelID()
+		return nil
+	case channelproberun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case channelproberun.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case channelproberun.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case channelproberun.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case channelproberun.FieldDiscoveredCount:
+		m.ResetDiscoveredCount()
+		return nil
+	case channelproberun.FieldEnabledCount:
+		m.ResetEnabledCount()
+		return nil
+	case channelproberun.FieldEndpoint:
+		m.ResetEndpoint()
+		return nil
+	case channelproberun.FieldStatusCode:
+		m.ResetStatusCode()
+		return nil
+	case channelproberun.FieldErrorText:
+		m.ResetErrorText()
+		return nil
+	case channelproberun.FieldRequestMetaJSON:
+		m.ResetRequestMetaJSON()
+		return nil
+	case channelproberun.FieldResponseSampleJSON:
+		m.ResetResponseSampleJSON()
 		return nil
 	}
-	return fmt.Errorf("unknown ChannelModel field %s", name)
+	return fmt.Errorf("unknown ChannelProbeRun field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ChannelModelMutation) AddedFields() []string {
-	var fields []string
-	if m.addsupports_responses != nil {
-		fields = append(fields, channelmodel.FieldSupportsResponses)
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChannelProbeRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChannelProbeRunMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChannelProbeRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChannelProbeRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChannelProbeRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChannelProbeRunMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChannelProbeRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChannelProbeRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChannelProbeRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChannelProbeRun edge %s", name)
+}
+
+// DatasetMutation represents an operation that mutates the Dataset nodes in the graph.
+type DatasetMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	name          *string
+	description   *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Dataset, error)
+	predicates    []predicate.Dataset
+}
+
+var _ ent.Mutation = (*DatasetMutation)(nil)
+
+// datasetOption allows management of the mutation configuration using functional options.
+type datasetOption func(*DatasetMutation)
+
+// newDatasetMutation creates new mutation for the Dataset entity.
+func newDatasetMutation(c config, op Op, opts ...datasetOption) *DatasetMutation {
+	m := &DatasetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDataset,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.addsupports_chat_completions != nil {
-		fields = append(fields, channelmodel.FieldSupportsChatCompletions)
+	for _, opt := range opts {
+		opt(m)
 	}
-	if m.addsupports_embeddings != nil {
-		fields = append(fields, channelmodel.FieldSupportsEmbeddings)
+	return m
+}
+
+// withDatasetID sets the ID field of the mutation.
+func withDatasetID(id string) datasetOption {
+	return func(m *DatasetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Dataset
+		)
+		m.oldValue = func(ctx context.Context) (*Dataset, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Dataset.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.addcontext_window != nil {
-		fields = append(fields, channelmodel.FieldContextWindow)
+}
+
+// withDataset sets the old Dataset of the mutation.
+func withDataset(node *Dataset) datasetOption {
+	return func(m *DatasetMutation) {
+		m.oldValue = func(context.Context) (*Dataset, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return fields
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ChannelModelMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case channelmodel.FieldSupportsResponses:
-		return m.AddedSupportsResponses()
-	case channelmodel.FieldSupportsChatCompletions:
-		return m.AddedSupportsChatCompletions()
-	case channelmodel.FieldSupportsEmbeddings:
-		return m.AddedSupportsEmbeddings()
-	case channelmodel.FieldContextWindow:
-		return m.AddedContextWindow()
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DatasetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DatasetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("dao: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Dataset entities.
+func (m *DatasetMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DatasetMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return nil, false
+	return *m.id, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ChannelModelMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case channelmodel.FieldSupportsResponses:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSupportsResponses(v)
-		return nil
-	case channelmodel.FieldSupportsChatCompletions:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSupportsChatCompletions(v)
-		return nil
-	case channelmodel.FieldSupportsEmbeddings:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSupportsEmbeddings(v)
-		return nil
-	case channelmodel.FieldContextWindow:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DatasetMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
 		}
-		m.AddContextWindow(v)
-		return nil
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Dataset.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return fmt.Errorf("unknown ChannelModel numeric field %s", name)
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ChannelModelMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(channelmodel.FieldSupportsResponses) {
-		fields = append(fields, channelmodel.FieldSupportsResponses)
-	}
-	if m.FieldCleared(channelmodel.FieldSupportsChatCompletions) {
-		fields = append(fields, channelmodel.FieldSupportsChatCompletions)
+// SetName sets the "name" field.
+func (m *DatasetMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DatasetMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(channelmodel.FieldSupportsEmbeddings) {
-		fields = append(fields, channelmodel.FieldSupportsEmbeddings)
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Dataset entity.
+// If the Dataset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DatasetMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(channelmodel.FieldContextWindow) {
-		fields = append(fields, channelmodel.FieldContextWindow)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
-	if m.FieldCleared(channelmodel.FieldLastProbeAt) {
-		fields = append(fields, channelmodel.FieldLastProbeAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return fields
+	return oldValue.Name, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ChannelModelMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetName resets all changes to the "name" field.
+func (m *DatasetMutation) ResetName() {
+	m.name = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ChannelModelMutation) ClearField(name string) error {
-	switch name {
-	case channelmodel.FieldSupportsResponses:
-		m.ClearSupportsResponses()
-		return nil
-	case channelmodel.FieldSupportsChatCompletions:
-		m.ClearSupportsChatCompletions()
-		return nil
-	case channelmodel.FieldSupportsEmbeddings:
-		m.ClearSupportsEmbeddings()
-		return nil
-	case channelmodel.FieldContextWindow:
-		m.ClearContextWindow()
-		return nil
-	case channelmodel.FieldLastProbeAt:
-		m.ClearLastProbeAt()
-		return nil
+// SetDescription sets the "description" field.
+func (m *DatasetMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *DatasetMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown ChannelModel nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ChannelModelMutation) ResetField(name string) error {
-	switch name {
-	case channelmodel.FieldChannelID:
-		m.ResetChannelID()
-		return nil
-	case channelmodel.FieldModel:
-		m.ResetModel()
-		return nil
-	case channelmodel.FieldDisplayName:
-		m.ResetDisplayName()
-		return nil
-	case channelmodel.FieldSource:
-		m.ResetSource()
-		return nil
-	case channelmodel.FieldEnabled:
-		m.ResetEnabled()
-		return nil
-	case channelmodel.FieldSupportsResponses:
-		m.ResetSupportsResponses()
-		return nil
-	case channelmodel.FieldSupportsChatCompletions:
-		m.ResetSupportsChatCompletions()
-		return nil
-	case channelmodel.FieldSupportsEmbeddings:
-		m.ResetSupportsEmbeddings()
-		return nil
-	case channelmodel.FieldContextWindow:
-		m.ResetContextWindow()
-		return nil
-	case channelmodel.FieldInputModalitiesJSON:
-		m.ResetInputModalitiesJSON()
-		return nil
-	case channelmodel.FieldOutputModalitiesJSON:
-		m.ResetOutputModalitiesJSON()
-		return nil
-	case channelmodel.FieldRawModelJSON:
-		m.ResetRawModelJSON()
-		return nil
-	case channelmodel.FieldFirstSeenAt:
-		m.ResetFirstSeenAt()
-		return nil
-	case channelmodel.FieldLastSeenAt:
-		m.ResetLastSeenAt()
-		return nil
-	case channelmodel.FieldLastProbeAt:
-		m.ResetLastProbeAt()
-		return nil
+// OldDescription returns the old "description" field's value of the Dataset entity.
+// If the Dataset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DatasetMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown ChannelModel field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChannelModelMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetDescription resets all changes to the "description" field.
+func (m *DatasetMutation) ResetDescription() {
+	m.description = nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ChannelModelMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// SetCreatedAt sets the "created_at" field.
+func (m *DatasetMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChannelModelMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DatasetMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ChannelModelMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// OldCreatedAt returns the old "created_at" field's value of the Dataset entity.
+// If the Dataset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DatasetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChannelModelMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DatasetMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ChannelModelMutation) EdgeCleared(name string) bool {
-	return false
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DatasetMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ChannelModelMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ChannelModel unique edge %s", name)
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DatasetMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ChannelModelMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ChannelModel edge %s", name)
+// OldUpdatedAt returns the old "updated_at" field's value of the Dataset entity.
+// If the Dataset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DatasetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ChannelProbeRunMutation represents an operation that mutates the ChannelProbeRun nodes in the graph.
-type ChannelProbeRunMutation struct {
-	config
-	op                   Op
-	typ                  string
-	id                   *string
-	channel_id           *string
-	status               *string
-	started_at           *time.Time
-	completed_at         *time.Time
-	duration_ms          *int64
-	addduration_ms       *int64
-	discovered_count     *int
-	adddiscovered_count  *int
-	enabled_count        *int
-	addenabled_count     *int
-	endpoint             *string
-	status_code          *int
-	addstatus_code       *int
-	error_text           *string
-	request_meta_json    *string
-	response_sample_json *string
-	clearedFields        map[string]struct{}
-	done                 bool
-	oldValue             func(context.Context) (*ChannelProbeRun, error)
-	predicates           []predicate.ChannelProbeRun
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DatasetMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-var _ ent.Mutation = (*ChannelProbeRunMutation)(nil)
-
-// channelproberunOption allows management of the mutation configuration using functional options.
-type channelproberunOption func(*ChannelProbeRunMutation)
+// Where appends a list predicates to the DatasetMutation builder.
+func (m *DatasetMutation) Where(ps ...predicate.Dataset) {
+	m.predicates = append(m.predicates, ps...)
+}
 
-// newChannelProbeRunMutation creates new mutation for the ChannelProbeRun entity.
-func newChannelProbeRunMutation(c config, op Op, opts ...channelproberunOption) *ChannelProbeRunMutation {
-	m := &ChannelProbeRunMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeChannelProbeRun,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// WhereP appends storage-level predicates to the DatasetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DatasetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Dataset, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return m
+	m.Where(p...)
 }
 
-// withChannelProbeRunID sets the ID field of the mutation.
-func withChannelProbeRunID(id string) channelproberunOption {
-	return func(m *ChannelProbeRunMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *ChannelProbeRun
-		)
-		m.oldValue = func(ctx context.Context) (*ChannelProbeRun, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().ChannelProbeRun.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// Op returns the operation name.
+func (m *DatasetMutation) Op() Op {
+	return m.op
 }
 
-// withChannelProbeRun sets the old ChannelProbeRun of the mutation.
-func withChannelProbeRun(node *ChannelProbeRun) channelproberunOption {
-	return func(m *ChannelProbeRunMutation) {
-		m.oldValue = func(context.Context) (*ChannelProbeRun, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetOp allows setting the mutation operation.
+func (m *DatasetMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChannelProbeRunMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// Type returns the node type of this mutation (Dataset).
+func (m *DatasetMutation) Type() string {
+	return m.typ
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ChannelProbeRunMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("dao: mutation is not running in a transaction")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DatasetMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, dataset.FieldName)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.description != nil {
+		fields = append(fields, dataset.FieldDescription)
+	}
+	if m.created_at != nil {
+		fields = append(fields, dataset.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, dataset.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ChannelProbeRun entities.
-func (m *ChannelProbeRunMutation) SetID(id string) {
-	m.id = &id
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DatasetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case dataset.FieldName:
+		return m.Name()
+	case dataset.FieldDescription:
+		return m.Description()
+	case dataset.FieldCreatedAt:
+		return m.CreatedAt()
+	case dataset.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ChannelProbeRunMutation) ID() (id string, exists bool) {
-	if m.id == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DatasetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case dataset.FieldName:
+		return m.OldName(ctx)
+	case dataset.FieldDescription:
+		return m.OldDescription(ctx)
+	case dataset.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case dataset.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return *m.id, true
+	return nil, fmt.Errorf("unknown Dataset field %s", name)
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ChannelProbeRunMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DatasetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case dataset.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ChannelProbeRun.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+		m.SetName(v)
+		return nil
+	case dataset.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case dataset.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case dataset.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
+	return fmt.Errorf("unknown Dataset field %s", name)
 }
 
-// SetChannelID sets the "channel_id" field.
-func (m *ChannelProbeRunMutation) SetChannelID(s string) {
-	m.channel_id = &s
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DatasetMutation) AddedFields() []string {
+	return nil
 }
 
-// ChannelID returns the value of the "channel_id" field in the mutation.
-func (m *ChannelProbeRunMutation) ChannelID() (r string, exists bool) {
-	v := m.channel_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DatasetMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// OldChannelID returns the old "channel_id" field's value of the ChannelProbeRun entity.
-// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelProbeRunMutation) OldChannelID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChannelID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChannelID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChannelID: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DatasetMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return oldValue.ChannelID, nil
+	return fmt.Errorf("unknown Dataset numeric field %s", name)
 }
 
-// ResetChannelID resets all changes to the "channel_id" field.
-func (m *ChannelProbeRunMutation) ResetChannelID() {
-	m.channel_id = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DatasetMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetStatus sets the "status" field.
-func (m *ChannelProbeRunMutation) SetStatus(s string) {
-	m.status = &s
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DatasetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ChannelProbeRunMutation) Status() (r string, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DatasetMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Dataset nullable field %s", name)
 }
 
-// OldStatus returns the old "status" field's value of the ChannelProbeRun entity.
-// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelProbeRunMutation) OldStatus(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DatasetMutation) ResetField(name string) error {
+	switch name {
+	case dataset.FieldName:
+		m.ResetName()
+		return nil
+	case dataset.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case dataset.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case dataset.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.Status, nil
+	return fmt.Errorf("unknown Dataset field %s", name)
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *ChannelProbeRunMutation) ResetStatus() {
-	m.status = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DatasetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetStartedAt sets the "started_at" field.
-func (m *ChannelProbeRunMutation) SetStartedAt(t time.Time) {
-	m.started_at = &t
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DatasetMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// StartedAt returns the value of the "started_at" field in the mutation.
-func (m *ChannelProbeRunMutation) StartedAt() (r time.Time, exists bool) {
-	v := m.started_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DatasetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldStartedAt returns the old "started_at" field's value of the ChannelProbeRun entity.
-// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelProbeRunMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
-	}
-	return oldValue.StartedAt, nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DatasetMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetStartedAt resets all changes to the "started_at" field.
-func (m *ChannelProbeRunMutation) ResetStartedAt() {
-	m.started_at = nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DatasetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (m *ChannelProbeRunMutation) SetCompletedAt(t time.Time) {
-	m.completed_at = &t
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DatasetMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *ChannelProbeRunMutation) CompletedAt() (r time.Time, exists bool) {
-	v := m.completed_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DatasetMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Dataset unique edge %s", name)
 }
 
-// OldCompletedAt returns the old "completed_at" field's value of the ChannelProbeRun entity.
-// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelProbeRunMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
-	}
-	return oldValue.CompletedAt, nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DatasetMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Dataset edge %s", name)
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (m *ChannelProbeRunMutation) ClearCompletedAt() {
-	m.completed_at = nil
-	m.clearedFields[channelproberun.FieldCompletedAt] = struct{}{}
+// DatasetExampleMutation represents an operation that mutates the DatasetExample nodes in the graph.
+type DatasetExampleMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	dataset_id    *string
+	trace_id      *string
+	position      *int
+	addposition   *int
+	added_at      *time.Time
+	source_type   *string
+	source_id     *string
+	note          *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DatasetExample, error)
+	predicates    []predicate.DatasetExample
 }
 
-// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *ChannelProbeRunMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[channelproberun.FieldCompletedAt]
-	return ok
-}
+var _ ent.Mutation = (*DatasetExampleMutation)(nil)
 
-// ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *ChannelProbeRunMutation) ResetCompletedAt() {
-	m.completed_at = nil
-	delete(m.clearedFields, channelproberun.FieldCompletedAt)
+// datasetexampleOption allows management of the mutation configuration using functional options.
+type datasetexampleOption func(*DatasetExampleMutation)
+
+// newDatasetExampleMutation creates new mutation for the DatasetExample entity.
+func newDatasetExampleMutation(c config, op Op, opts ...datasetexampleOption) *DatasetExampleMutation {
+	m := &DatasetExampleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDatasetExample,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// SetDurationMs sets the "duration_ms" field.
-func (m *ChannelProbeRunMutation) SetDurationMs(i int64) {
-	m.duration_ms = &i
-	m.addduration_ms = nil
+// withDatasetExampleID sets the ID field of the mutation.
+func withDatasetExampleID(id int) datasetexampleOption {
+	return func(m *DatasetExampleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DatasetExample
+		)
+		m.oldValue = func(ctx context.Context) (*DatasetExample, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DatasetExample.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// DurationMs returns the value of the "duration_ms" field in the mutation.
-func (m *ChannelProbeRunMutation) DurationMs() (r int64, exists bool) {
-	v := m.duration_ms
-	if v == nil {
-		return
+// withDatasetExample sets the old DatasetExample of the mutation.
+func withDatasetExample(node *DatasetExample) datasetexampleOption {
+	return func(m *DatasetExampleMutation) {
+		m.oldValue = func(context.Context) (*DatasetExample, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// OldDurationMs returns the old "duration_ms" field's value of the ChannelProbeRun entity.
-// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelProbeRunMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDurationMs requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
-	}
-	return oldValue.DurationMs, nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DatasetExampleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddDurationMs adds i to the "duration_ms" field.
-func (m *ChannelProbeRunMutation) AddDurationMs(i int64) {
-	if m.addduration_ms != nil {
-		*m.addduration_ms += i
-	} else {
-		m.addduration_ms = &i
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DatasetExampleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("dao: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
-func (m *ChannelProbeRunMutation) AddedDurationMs() (r int64, exists bool) {
-	v := m.addduration_ms
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DatasetExampleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetDurationMs resets all changes to the "duration_ms" field.
-func (m *ChannelProbeRunMutation) ResetDurationMs() {
-	m.duration_ms = nil
-	m.addduration_ms = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DatasetExampleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DatasetExample.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetDiscoveredCount sets the "discovered_count" field.
-func (m *ChannelProbeRunMutation) SetDiscoveredCount(i int) {
-	m.discovered_count = &i
-	m.adddiscovered_count = nil
+// SetDatasetID sets the "dataset_id" field.
+func (m *DatasetExampleMutation) SetDatasetID(s string) {
+	m.dataset_id = &s
 }
 
-// DiscoveredCount returns the value of the "discovered_count" field in the mutation.
-func (m *ChannelProbeRunMutation) DiscoveredCount() (r int, exists bool) {
-	v := m.discovered_count
+// DatasetID returns the value of the "dataset_id" field in the mutation.
+func (m *DatasetExampleMutation) DatasetID() (r string, exists bool) {
+	v := m.dataset_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDiscoveredCount returns the old "discovered_count" field's value of the ChannelProbeRun entity.
-// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
+// OldDatasetID returns the old "dataset_id" field's value of the DatasetExample entity.
+// If the DatasetExample object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelProbeRunMutation) OldDiscoveredCount(ctx context.Context) (v int, err error) {
+func (m *DatasetExampleMutation) OldDatasetID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDiscoveredCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldDatasetID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDiscoveredCount requires an ID field in the mutation")
+		return v, errors.New("OldDatasetID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDiscoveredCount: %w", err)
-	}
-	return oldValue.DiscoveredCount, nil
-}
-
-// AddDiscoveredCount adds i to the "discovered_count" field.
-func (m *ChannelProbeRunMutation) AddDiscoveredCount(i int) {
-	if m.adddiscovered_count != nil {
-		*m.adddiscovered_count += i
-	} else {
-		m.adddiscovered_count = &i
-	}
-}
-
-// AddedDiscoveredCount returns the value that was added to the "discovered_count" field in this mutation.
-func (m *ChannelProbeRunMutation) AddedDiscoveredCount() (r int, exists bool) {
-	v := m.adddiscovered_count
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldDatasetID: %w", err)
 	}
-	return *v, true
+	return oldValue.DatasetID, nil
 }
 
-// ResetDiscoveredCount resets all changes to the "discovered_count" field.
-func (m *ChannelProbeRunMutation) ResetDiscoveredCount() {
-	m.discovered_count = nil
-	m.adddiscovered_count = nil
+// ResetDatasetID resets all changes to the "dataset_id" field.
+func (m *DatasetExampleMutation) ResetDatasetID() {
+	m.dataset_id = nil
 }
 
-// SetEnabledCount sets the "enabled_count" field.
-func (m *ChannelProbeRunMutation) SetEnabledCount(i int) {
-	m.enabled_count = &i
-	m.addenabled_count = nil
+// SetTraceID sets the "trace_id" field.
+func (m *DatasetExampleMutation) SetTraceID(s string) {
+	m.trace_id = &s
 }
 
-// EnabledCount returns the value of the "enabled_count" field in the mutation.
-func (m *ChannelProbeRunMutation) EnabledCount() (r int, exists bool) {
-	v := m.enabled_count
+// TraceID returns the value of the "trace_id" field in the mutation.
+func (m *DatasetExampleMutation) TraceID() (r string, exists bool) {
+	v := m.trace_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnabledCount returns the old "enabled_count" field's value of the ChannelProbeRun entity.
-// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
+// OldTraceID returns the old "trace_id" field's value of the DatasetExample entity.
+// If the DatasetExample object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelProbeRunMutation) OldEnabledCount(ctx context.Context) (v int, err error) {
+func (m *DatasetExampleMutation) OldTraceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabledCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldTraceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabledCount requires an ID field in the mutation")
+		return v, errors.New("OldTraceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabledCount: %w", err)
-	}
-	return oldValue.EnabledCount, nil
-}
-
-// AddEnabledCount adds i to the "enabled_count" field.
-func (m *ChannelProbeRunMutation) AddEnabledCount(i int) {
-	if m.addenabled_count != nil {
-		*m.addenabled_count += i
-	} else {
-		m.addenabled_count = &i
-	}
-}
-
-// AddedEnabledCount returns the value that was added to the "enabled_count" field in this mutation.
-func (m *ChannelProbeRunMutation) AddedEnabledCount() (r int, exists bool) {
-	v := m.addenabled_count
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldTraceID: %w", err)
 	}
-	return *v, true
+	return oldValue.TraceID, nil
 }
 
-// ResetEnabledCount resets all changes to the "enabled_count" field.
-func (m *ChannelProbeRunMutation) ResetEnabledCount() {
-	m.enabled_count = nil
-	m.addenabled_count = nil
+// ResetTraceID resets all changes to the "trace_id" field.
+func (m *DatasetExampleMutation) ResetTraceID() {
+	m.trace_id = nil
 }
 
-// SetEndpoint sets the "endpoint" field.
-func (m *ChannelProbeRunMutation) SetEndpoint(s string) {
-	m.endpoint = &s
+// SetPosition sets the "position" field.
+func (m *DatasetExampleMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
 }
 
-// Endpoint returns the value of the "endpoint" field in the mutation.
-func (m *ChannelProbeRunMutation) Endpoint() (r string, exists bool) {
-	v := m.endpoint
+// Position returns the value of the "position" field in the mutation.
+func (m *DatasetExampleMutation) Position() (r int, exists bool) {
+	v := m.position
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndpoint returns the old "endpoint" field's value of the ChannelProbeRun entity.
-// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
+// OldPosition returns the old "position" field's value of the DatasetExample entity.
+// If the DatasetExample object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelProbeRunMutation) OldEndpoint(ctx context.Context) (v string, err error) {
+func (m *DatasetExampleMutation) OldPosition(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndpoint requires an ID field in the mutation")
+		return v, errors.New("OldPosition requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
 	}
-	return oldValue.Endpoint, nil
-}
-
-// ResetEndpoint resets all changes to the "endpoint" field.
-func (m *ChannelProbeRunMutation) ResetEndpoint() {
-	m.endpoint = nil
+	return oldValue.Position, nil
 }
 
-// SetStatusCode sets the "status_code" field.
-func (m *ChannelProbeRunMutation) SetStatusCode(i int) {
-	m.status_code = &i
-	m.addstatus_code = nil
+// AddPosition adds i to the "position" field.
+func (m *DatasetExampleMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
 }
 
-// StatusCode returns the value of the "status_code" field in the mutation.
-func (m *ChannelProbeRunMutation) StatusCode() (r int, exists bool) {
-	v := m.status_code
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *DatasetExampleMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatusCode returns the old "status_code" field's value of the ChannelProbeRun entity.
-// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelProbeRunMutation) OldStatusCode(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatusCode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatusCode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatusCode: %w", err)
-	}
-	return oldValue.StatusCode, nil
+// ResetPosition resets all changes to the "position" field.
+func (m *DatasetExampleMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
 }
 
-// AddStatusCode adds i to the "status_code" field.
-func (m *ChannelProbeRunMutation) AddStatusCode(i int) {
-	if m.addstatus_code != nil {
-		*m.addstatus_code += i
-	} else {
-		m.addstatus_code = &i
-	}
+// SetAddedAt sets the "added_at" field.
+func (m *DatasetExampleMutation) SetAddedAt(t time.Time) {
+	m.added_at = &t
 }
 
-// AddedStatusCode returns the value that was added to the "status_code" field in this mutation.
-func (m *ChannelProbeRunMutation) AddedStatusCode() (r int, exists bool) {
-	v := m.addstatus_code
+// AddedAt returns the value of the "added_at" field in the mutation.
+func (m *DatasetExampleMutation) AddedAt() (r time.Time, exists bool) {
+	v := m.added_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetStatusCode resets all changes to the "status_code" field.
-func (m *ChannelProbeRunMutation) ResetStatusCode() {
-	m.status_code = nil
-	m.addstatus_code = nil
+// OldAddedAt returns the old "added_at" field's value of the DatasetExample entity.
+// If the DatasetExample object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DatasetExampleMutation) OldAddedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddedAt: %w", err)
+	}
+	return oldValue.AddedAt, nil
 }
 
-// SetErrorText sets the "error_text" field.
-func (m *ChannelProbeRunMutation) SetErrorText(s string) {
-	m.error_text = &s
+// ResetAddedAt resets all changes to the "added_at" field.
+func (m *DatasetExampleMutation) ResetAddedAt() {
+	m.added_at = nil
 }
 
-// ErrorText returns the value of the "error_text" field in the mutation.
-func (m *ChannelProbeRunMutation) ErrorText() (r string, exists bool) {
-	v := m.error_text
+// SetSourceType sets the "source_type" field.
+func (m *DatasetExampleMutation) SetSourceType(s string) {
+	m.source_type = &s
+}
+
+// SourceType returns the value of the "source_type" field in the mutation.
+func (m *DatasetExampleMutation) SourceType() (r string, exists bool) {
+	v := m.source_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorText returns the old "error_text" field's value of the ChannelProbeRun entity.
-// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
+// OldSourceType returns the old "source_type" field's value of the DatasetExample entity.
+// If the DatasetExample object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelProbeRunMutation) OldErrorText(ctx context.Context) (v string, err error) {
+func (m *DatasetExampleMutation) OldSourceType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorText is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourceType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorText requires an ID field in the mutation")
+		return v, errors.New("OldSourceType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorText: %w", err)
+		return v, fmt.Errorf("querying old value for OldSourceType: %w", err)
 	}
-	return oldValue.ErrorText, nil
+	return oldValue.SourceType, nil
 }
 
-// ResetErrorText resets all changes to the "error_text" field.
-func (m *ChannelProbeRunMutation) ResetErrorText() {
-	m.error_text = nil
+// ResetSourceType resets all changes to the "source_type" field.
+func (m *DatasetExampleMutation) ResetSourceType() {
+	m.source_type = nil
 }
 
-// SetRequestMetaJSON sets the "request_meta_json" field.
-func (m *ChannelProbeRunMutation) SetRequestMetaJSON(s string) {
-	m.request_meta_json = &s
+// SetSourceID sets the "source_id" field.
+func (m *DatasetExampleMutation) SetSourceID(s string) {
+	m.source_id = &s
 }
 
-// RequestMetaJSON returns the value of the "request_meta_json" field in the mutation.
-func (m *ChannelProbeRunMutation) RequestMetaJSON() (r string, exists bool) {
-	v := m.request_meta_json
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *DatasetExampleMutation) SourceID() (r string, exists bool) {
+	v := m.source_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRequestMetaJSON returns the old "request_meta_json" field's value of the ChannelProbeRun entity.
-// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
+// OldSourceID returns the old "source_id" field's value of the DatasetExample entity.
+// If the DatasetExample object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelProbeRunMutation) OldRequestMetaJSON(ctx context.Context) (v string, err error) {
+func (m *DatasetExampleMutation) OldSourceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRequestMetaJSON is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRequestMetaJSON requires an ID field in the mutation")
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRequestMetaJSON: %w", err)
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
 	}
-	return oldValue.RequestMetaJSON, nil
+	return oldValue.SourceID, nil
 }
 
-// ResetRequestMetaJSON resets all changes to the "request_meta_json" field.
-func (m *ChannelProbeRunMutation) ResetRequestMetaJSON() {
-	m.request_meta_json = nil
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *DatasetExampleMutation) ResetSourceID() {
+	m.source_id = nil
 }
 
-// SetResponseSampleJSON sets the "response_sample_json" field.
-func (m *ChannelProbeRunMutation) SetResponseSampleJSON(s string) {
-	m.response_sample_json = &s
+// SetNote sets the "note" field.
+func (m *DatasetExampleMutation) SetNote(s string) {
+	m.note = &s
 }
 
-// ResponseSampleJSON returns the value of the "response_sample_json" field in the mutation.
-func (m *ChannelProbeRunMutation) ResponseSampleJSON() (r string, exists bool) {
-	v := m.response_sample_json
+// Note returns the value of the "note" field in the mutation.
+func (m *DatasetExampleMutation) Note() (r string, exists bool) {
+	v := m.note
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResponseSampleJSON returns the old "response_sample_json" field's value of the ChannelProbeRun entity.
-// If the ChannelProbeRun object wasn't provided to the builder, the object is fetched from the database.
+// OldNote returns the old "note" field's value of the DatasetExample entity.
+// If the DatasetExample object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChannelProbeRunMutation) OldResponseSampleJSON(ctx context.Context) (v string, err error) {
+func (m *DatasetExampleMutation) OldNote(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResponseSampleJSON is only allowed on UpdateOne operations")
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResponseSampleJSON requires an ID field in the mutation")
+		return v, errors.New("OldNote requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResponseSampleJSON: %w", err)
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
 	}
-	return oldValue.ResponseSampleJSON, nil
+	return oldValue.Note, nil
 }
 
-// ResetResponseSampleJSON resets all changes to the "response_sample_json" field.
-func (m *ChannelProbeRunMutation) ResetResponseSampleJSON() {
-	m.response_sample_json = nil
+// ResetNote resets all changes to the "note" field.
+func (m *DatasetExampleMutation) ResetNote() {
+	m.note = nil
 }
 
-// Where appends a list predicates to the ChannelProbeRunMutation builder.
-func (m *ChannelProbeRunMutation) Where(ps ...predicate.ChannelProbeRun) {
+// Where appends a list predicates to the DatasetExampleMutation builder.
+func (m *DatasetExampleMutation) Where(ps ...predicate.DatasetExample) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ChannelProbeRunMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DatasetExampleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChannelProbeRunMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ChannelProbeRun, len(ps))
+func (m *DatasetExampleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DatasetExample, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4682,60 +6656,45 @@ func (m *ChannelProbeRunMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ChannelProbeRunMutation) Op() Op {
+func (m *DatasetExampleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ChannelProbeRunMutation) SetOp(op Op) {
+func (m *DatasetExampleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ChannelProbeRun).
-func (m *ChannelProbeRunMutation) Type() string {
+// Type returns the node type of this mutation (DatasetExample).
+func (m *DatasetExampleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ChannelProbeRunMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.channel_id != nil {
-		fields = append(fields, channelproberun.FieldChannelID)
-	}
-	if m.status != nil {
-		fields = append(fields, channelproberun.FieldStatus)
-	}
-	if m.started_at != nil {
-		fields = append(fields, channelproberun.FieldStartedAt)
-	}
-	if m.completed_at != nil {
-		fields = append(fields, channelproberun.FieldCompletedAt)
-	}
-	if m.duration_ms != nil {
-		fields = append(fields, channelproberun.FieldDurationMs)
-	}
-	if m.discovered_count != nil {
-		fields = append(fields, channelproberun.FieldDiscoveredCount)
+func (m *DatasetExampleMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.dataset_id != nil {
+		fields = append(fields, datasetexample.FieldDatasetID)
 	}
-	if m.enabled_count != nil {
-		fields = append(fields, channelproberun.FieldEnabledCount)
+	if m.trace_id != nil {
+		fields = append(fields, datasetexample.FieldTraceID)
 	}
-	if m.endpoint != nil {
-		fields = append(fields, channelproberun.FieldEndpoint)
+	if m.position != nil {
+		fields = append(fields, datasetexample.FieldPosition)
 	}
-	if m.status_code != nil {
-		fields = append(fields, channelproberun.FieldStatusCode)
+	if m.added_at != nil {
+		fields = append(fields, datasetexample.FieldAddedAt)
 	}
-	if m.error_text != nil {
-		fields = append(fields, channelproberun.FieldErrorText)
+	if m.source_type != nil {
+		fields = append(fields, datasetexample.FieldSourceType)
 	}
-	if m.request_meta_json != nil {
-		fields = append(fields, channelproberun.FieldRequestMetaJSON)
+	if m.source_id != nil {
+		fields = append(fields, datasetexample.FieldSourceID)
 	}
-	if m.response_sample_json != nil {
-		fields = append(fields, channelproberun.FieldResponseSampleJSON)
+	if m.note != nil {
+		fields = append(fields, datasetexample.FieldNote)
 	}
 	return fields
 }
@@ -4743,177 +6702,113 @@ func (m *ChannelProbeRunMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ChannelProbeRunMutation) Field(name string) (ent.Value, bool) {
+func (m *DatasetExampleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case channelproberun.FieldChannelID:
-		return m.ChannelID()
-	case channelproberun.FieldStatus:
-		return m.Status()
-	case channelproberun.FieldStartedAt:
-		return m.StartedAt()
-	case channelproberun.FieldCompletedAt:
-		return m.CompletedAt()
-	case channelproberun.FieldDurationMs:
-		return m.DurationMs()
-	case channelproberun.FieldDiscoveredCount:
-		return m.DiscoveredCount()
-	case channelproberun.FieldEnabledCount:
-		return m.EnabledCount()
-	case channelproberun.FieldEndpoint:
-		return m.Endpoint()
-	case channelproberun.FieldStatusCode:
-		return m.StatusCode()
-	case channelproberun.FieldErrorText:
-		return m.ErrorText()
-	case channelproberun.FieldRequestMetaJSON:
-		return m.RequestMetaJSON()
-	case channelproberun.FieldResponseSampleJSON:
-		return m.ResponseSampleJSON()
+	case datasetexample.FieldDatasetID:
+		return m.DatasetID()
+	case datasetexample.FieldTraceID:
+		return m.TraceID()
+	case datasetexample.FieldPosition:
+		return m.Position()
+	case datasetexample.FieldAddedAt:
+		return m.AddedAt()
+	case datasetexample.FieldSourceType:
+		return m.SourceType()
+	case datasetexample.FieldSourceID:
+		return m.SourceID()
+	case datasetexample.FieldNote:
+		return m.Note()
 	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ChannelProbeRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case channelproberun.FieldChannelID:
-		return m.OldChannelID(ctx)
-	case channelproberun.FieldStatus:
-		return m.OldStatus(ctx)
-	case channelproberun.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case channelproberun.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
-	case channelproberun.FieldDurationMs:
-		return m.OldDurationMs(ctx)
-	case channelproberun.FieldDiscoveredCount:
-		return m.OldDiscoveredCount(ctx)
-	case channelproberun.FieldEnabledCount:
-		return m.OldEnabledCount(ctx)
-	case channelproberun.FieldEndpoint:
-		return m.OldEndpoint(ctx)
-	case channelproberun.FieldStatusCode:
-		return m.OldStatusCode(ctx)
-	case channelproberun.FieldErrorText:
-		return m.OldErrorText(ctx)
-	case channelproberun.FieldRequestMetaJSON:
-		return m.OldRequestMetaJSON(ctx)
-	case channelproberun.FieldResponseSampleJSON:
-		return m.OldResponseSampleJSON(ctx)
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DatasetExampleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case datasetexample.FieldDatasetID:
+		return m.OldDatasetID(ctx)
+	case datasetexample.FieldTraceID:
+		return m.OldTraceID(ctx)
+	case datasetexample.FieldPosition:
+		return m.OldPosition(ctx)
+	case datasetexample.FieldAddedAt:
+		return m.OldAddedAt(ctx)
+	case datasetexample.FieldSourceType:
+		return m.OldSourceType(ctx)
+	case datasetexample.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case datasetexample.FieldNote:
+		return m.OldNote(ctx)
 	}
-	return nil, fmt.Errorf("unknown ChannelProbeRun field %s", name)
+	return nil, fmt.Errorf("unknown DatasetExample field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChannelProbeRunMutation) SetField(name string, value ent.Value) error {
+func (m *DatasetExampleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case channelproberun.FieldChannelID:
+	case datasetexample.FieldDatasetID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChannelID(v)
+		m.SetDatasetID(v)
 		return nil
-	case channelproberun.FieldStatus:
+	case datasetexample.FieldTraceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
-		return nil
-	case channelproberun.FieldStartedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartedAt(v)
-		return nil
-	case channelproberun.FieldCompletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletedAt(v)
-		return nil
-	case channelproberun.FieldDurationMs:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDurationMs(v)
-		return nil
-	case channelproberun.FieldDiscoveredCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDiscoveredCount(v)
+		m.SetTraceID(v)
 		return nil
-	case channelproberun.FieldEnabledCount:
+	case datasetexample.FieldPosition:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnabledCount(v)
-		return nil
-	case channelproberun.FieldEndpoint:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEndpoint(v)
+		m.SetPosition(v)
 		return nil
-	case channelproberun.FieldStatusCode:
-		v, ok := value.(int)
+	case datasetexample.FieldAddedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatusCode(v)
+		m.SetAddedAt(v)
 		return nil
-	case channelproberun.FieldErrorText:
+	case datasetexample.FieldSourceType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorText(v)
+		m.SetSourceType(v)
 		return nil
-	case channelproberun.FieldRequestMetaJSON:
+	case datasetexample.FieldSourceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRequestMetaJSON(v)
+		m.SetSourceID(v)
 		return nil
-	case channelproberun.FieldResponseSampleJSON:
+	case datasetexample.FieldNote:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResponseSampleJSON(v)
+		m.SetNote(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ChannelProbeRun field %s", name)
+	return fmt.Errorf("unknown DatasetExample field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ChannelProbeRunMutation) AddedFields() []string {
+func (m *DatasetExampleMutation) AddedFields() []string {
 	var fields []string
-	if m.addduration_ms != nil {
-		fields = append(fields, channelproberun.FieldDurationMs)
-	}
-	if m.adddiscovered_count != nil {
-		fields = append(fields, channelproberun.FieldDiscoveredCount)
-	}
-	if m.addenabled_count != nil {
-		fields = append(fields, channelproberun.FieldEnabledCount)
-	}
-	if m.addstatus_code != nil {
-		fields = append(fields, channelproberun.FieldStatusCode)
+	if m.addposition != nil {
+		fields = append(fields, datasetexample.FieldPosition)
 	}
 	return fields
 }
@@ -4921,16 +6816,10 @@ func (m *ChannelProbeRunMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ChannelProbeRunMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DatasetExampleMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case channelproberun.FieldDurationMs:
-		return m.AddedDurationMs()
-	case channelproberun.FieldDiscoveredCount:
-		return m.AddedDiscoveredCount()
-	case channelproberun.FieldEnabledCount:
-		return m.AddedEnabledCount()
-	case channelproberun.FieldStatusCode:
-		return m.AddedStatusCode()
+	case datasetexample.FieldPosition:
+		return m.AddedPosition()
 	}
 	return nil, false
 }
@@ -4938,187 +6827,145 @@ func (m *ChannelProbeRunMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChannelProbeRunMutation) AddField(name string, value ent.Value) error {
+func (m *DatasetExampleMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case channelproberun.FieldDurationMs:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDurationMs(v)
-		return nil
-	case channelproberun.FieldDiscoveredCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDiscoveredCount(v)
-		return nil
-	case channelproberun.FieldEnabledCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddEnabledCount(v)
-		return nil
-	case channelproberun.FieldStatusCode:
+	case datasetexample.FieldPosition:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStatusCode(v)
+		m.AddPosition(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ChannelProbeRun numeric field %s", name)
+	return fmt.Errorf("unknown DatasetExample numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ChannelProbeRunMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(channelproberun.FieldCompletedAt) {
-		fields = append(fields, channelproberun.FieldCompletedAt)
-	}
-	return fields
+func (m *DatasetExampleMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ChannelProbeRunMutation) FieldCleared(name string) bool {
+func (m *DatasetExampleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ChannelProbeRunMutation) ClearField(name string) error {
-	switch name {
-	case channelproberun.FieldCompletedAt:
-		m.ClearCompletedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown ChannelProbeRun nullable field %s", name)
+func (m *DatasetExampleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DatasetExample nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ChannelProbeRunMutation) ResetField(name string) error {
+func (m *DatasetExampleMutation) ResetField(name string) error {
 	switch name {
-	case channelproberun.FieldChannelID:
-		m.ResetChannelID()
-		return nil
-	case channelproberun.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case channelproberun.FieldStartedAt:
-		m.ResetStartedAt()
-		return nil
-	case channelproberun.FieldCompletedAt:
-		m.ResetCompletedAt()
-		return nil
-	case channelproberun.FieldDurationMs:
-		m.ResetDurationMs()
-		return nil
-	case channelproberun.FieldDiscoveredCount:
-		m.ResetDiscoveredCount()
+	case datasetexample.FieldDatasetID:
+		m.ResetDatasetID()
 		return nil
-	case channelproberun.FieldEnabledCount:
-		m.ResetEnabledCount()
+	case datasetexample.FieldTraceID:
+		m.ResetTraceID()
 		return nil
-	case channelproberun.FieldEndpoint:
-		m.ResetEndpoint()
+	case datasetexample.FieldPosition:
+		m.ResetPosition()
 		return nil
-	case channelproberun.FieldStatusCode:
-		m.ResetStatusCode()
+	case datasetexample.FieldAddedAt:
+		m.ResetAddedAt()
 		return nil
-	case channelproberun.FieldErrorText:
-		m.ResetErrorText()
+	case datasetexample.FieldSourceType:
+		m.ResetSourceType()
 		return nil
-	case channelproberun.FieldRequestMetaJSON:
-		m.ResetRequestMetaJSON()
+	case datasetexample.FieldSourceID:
+		m.ResetSourceID()
 		return nil
-	case channelproberun.FieldResponseSampleJSON:
-		m.ResetResponseSampleJSON()
+	case datasetexample.FieldNote:
+		m.ResetNote()
 		return nil
 	}
-	return fmt.Errorf("unknown ChannelProbeRun field %s", name)
+	return fmt.Errorf("unknown DatasetExample field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChannelProbeRunMutation) AddedEdges() []string {
+func (m *DatasetExampleMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ChannelProbeRunMutation) AddedIDs(name string) []ent.Value {
+func (m *DatasetExampleMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChannelProbeRunMutation) RemovedEdges() []string {
+func (m *DatasetExampleMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ChannelProbeRunMutation) RemovedIDs(name string) []ent.Value {
+func (m *DatasetExampleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChannelProbeRunMutation) ClearedEdges() []string {
+func (m *DatasetExampleMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ChannelProbeRunMutation) EdgeCleared(name string) bool {
+func (m *DatasetExampleMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ChannelProbeRunMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ChannelProbeRun unique edge %s", name)
+func (m *DatasetExampleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DatasetExample unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ChannelProbeRunMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ChannelProbeRun edge %s", name)
+func (m *DatasetExampleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DatasetExample edge %s", name)
 }
 
-// DatasetMutation represents an operation that mutates the Dataset nodes in the graph.
-type DatasetMutation struct {
+// DatasetExampleOverrideMutation represents an operation that mutates the DatasetExampleOverride nodes in the graph.
+type DatasetExampleOverrideMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *string
-	name          *string
-	description   *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Dataset, error)
-	predicates    []predicate.Dataset
+	op                  Op
+	typ                 string
+	id                  *int
+	dataset_id          *string
+	trace_id            *string
+	expected_output     *string
+	tags_json           *string
+	input_override_json *string
+	updated_by          *string
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*DatasetExampleOverride, error)
+	predicates          []predicate.DatasetExampleOverride
 }
 
-var _ ent.Mutation = (*DatasetMutation)(nil)
-
-// datasetOption allows management of the mutation configuration using functional options.
-type datasetOption func(*DatasetMutation)
-
-// newDatasetMutation creates new mutation for the Dataset entity.
-func newDatasetMutation(c config, op Op, opts ...datasetOption) *DatasetMutation {
-	m := &DatasetMutation{
+var _ ent.Mutation = (*DatasetExampleOverrideMutation)(nil)
+
+// datasetexampleoverrideOption allows management of the mutation configuration using functional options.
+type datasetexampleoverrideOption func(*DatasetExampleOverrideMutation)
+
+// newDatasetExampleOverrideMutation creates new mutation for the DatasetExampleOverride entity.
+func newDatasetExampleOverrideMutation(c config, op Op, opts ...datasetexampleoverrideOption) *DatasetExampleOverrideMutation {
+	m := &DatasetExampleOverrideMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDataset,
+		typ:           TypeDatasetExampleOverride,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5127,20 +6974,20 @@ func newDatasetMutation(c config, op Op, opts ...datasetOption) *DatasetMutation
 	return m
 }
 
-// withDatasetID sets the ID field of the mutation.
-func withDatasetID(id string) datasetOption {
-	return func(m *DatasetMutation) {
+// withDatasetExampleOverrideID sets the ID field of the mutation.
+func withDatasetExampleOverrideID(id int) datasetexampleoverrideOption {
+	return func(m *DatasetExampleOverrideMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Dataset
+			value *DatasetExampleOverride
 		)
-		m.oldValue = func(ctx context.Context) (*Dataset, error) {
+		m.oldValue = func(ctx context.Context) (*DatasetExampleOverride, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Dataset.Get(ctx, id)
+					value, err = m.Client().DatasetExampleOverride.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5149,10 +6996,10 @@ func withDatasetID(id string) datasetOption {
 	}
 }
 
-// withDataset sets the old Dataset of the mutation.
-func withDataset(node *Dataset) datasetOption {
-	return func(m *DatasetMutation) {
-		m.oldValue = func(context.Context) (*Dataset, error) {
+// withDatasetExampleOverride sets the old DatasetExampleOverride of the mutation.
+func withDatasetExampleOverride(node *DatasetExampleOverride) datasetexampleoverrideOption {
+	return func(m *DatasetExampleOverrideMutation) {
+		m.oldValue = func(context.Context) (*DatasetExampleOverride, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5161,7 +7008,7 @@ func withDataset(node *Dataset) datasetOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DatasetMutation) Client() *Client {
+func (m DatasetExampleOverrideMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5169,7 +7016,7 @@ func (m DatasetMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DatasetMutation) Tx() (*Tx, error) {
+func (m DatasetExampleOverrideMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("dao: mutation is not running in a transaction")
 	}
@@ -5178,15 +7025,9 @@ func (m DatasetMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Dataset entities.
-func (m *DatasetMutation) SetID(id string) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DatasetMutation) ID() (id string, exists bool) {
+func (m *DatasetExampleOverrideMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5197,136 +7038,244 @@ func (m *DatasetMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DatasetMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *DatasetExampleOverrideMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Dataset.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DatasetExampleOverride.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *DatasetMutation) SetName(s string) {
-	m.name = &s
+// SetDatasetID sets the "dataset_id" field.
+func (m *DatasetExampleOverrideMutation) SetDatasetID(s string) {
+	m.dataset_id = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *DatasetMutation) Name() (r string, exists bool) {
-	v := m.name
+// DatasetID returns the value of the "dataset_id" field in the mutation.
+func (m *DatasetExampleOverrideMutation) DatasetID() (r string, exists bool) {
+	v := m.dataset_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Dataset entity.
-// If the Dataset object wasn't provided to the builder, the object is fetched from the database.
+// OldDatasetID returns the old "dataset_id" field's value of the DatasetExampleOverride entity.
+// If the DatasetExampleOverride object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DatasetMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *DatasetExampleOverrideMutation) OldDatasetID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldDatasetID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldDatasetID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldDatasetID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.DatasetID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *DatasetMutation) ResetName() {
-	m.name = nil
+// ResetDatasetID resets all changes to the "dataset_id" field.
+func (m *DatasetExampleOverrideMutation) ResetDatasetID() {
+	m.dataset_id = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *DatasetMutation) SetDescription(s string) {
-	m.description = &s
+// SetTraceID sets the "trace_id" field.
+func (m *DatasetExampleOverrideMutation) SetTraceID(s string) {
+	m.trace_id = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *DatasetMutation) Description() (r string, exists bool) {
-	v := m.description
+// TraceID returns the value of the "trace_id" field in the mutation.
+func (m *DatasetExampleOverrideMutation) TraceID() (r string, exists bool) {
+	v := m.trace_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Dataset entity.
-// If the Dataset object wasn't provided to the builder, the object is fetched from the database.
+// OldTraceID returns the old "trace_id" field's value of the DatasetExampleOverride entity.
+// If the DatasetExampleOverride object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DatasetMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *DatasetExampleOverrideMutation) OldTraceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldTraceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldTraceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldTraceID: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.TraceID, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *DatasetMutation) ResetDescription() {
-	m.description = nil
+// ResetTraceID resets all changes to the "trace_id" field.
+func (m *DatasetExampleOverrideMutation) ResetTraceID() {
+	m.trace_id = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DatasetMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetExpectedOutput sets the "expected_output" field.
+func (m *DatasetExampleOverrideMutation) SetExpectedOutput(s string) {
+	m.expected_output = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DatasetMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ExpectedOutput returns the value of the "expected_output" field in the mutation.
+func (m *DatasetExampleOverrideMutation) ExpectedOutput() (r string, exists bool) {
+	v := m.expected_output
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Dataset entity.
-// If the Dataset object wasn't provided to the builder, the object is fetched from the database.
+// OldExpectedOutput returns the old "expected_output" field's value of the DatasetExampleOverride entity.
+// If the DatasetExampleOverride object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DatasetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DatasetExampleOverrideMutation) OldExpectedOutput(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpectedOutput is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldExpectedOutput requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpectedOutput: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ExpectedOutput, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DatasetMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetExpectedOutput resets all changes to the "expected_output" field.
+func (m *DatasetExampleOverrideMutation) ResetExpectedOutput() {
+	m.expected_output = nil
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (m *DatasetExampleOverrideMutation) SetTagsJSON(s string) {
+	m.tags_json = &s
+}
+
+// TagsJSON returns the value of the "tags_json" field in the mutation.
+func (m *DatasetExampleOverrideMutation) TagsJSON() (r string, exists bool) {
+	v := m.tags_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsJSON returns the old "tags_json" field's value of the DatasetExampleOverride entity.
+// If the DatasetExampleOverride object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DatasetExampleOverrideMutation) OldTagsJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsJSON: %w", err)
+	}
+	return oldValue.TagsJSON, nil
+}
+
+// ResetTagsJSON resets all changes to the "tags_json" field.
+func (m *DatasetExampleOverrideMutation) ResetTagsJSON() {
+	m.tags_json = nil
+}
+
+// SetInputOverrideJSON sets the "input_override_json" field.
+func (m *DatasetExampleOverrideMutation) SetInputOverrideJSON(s string) {
+	m.input_override_json = &s
+}
+
+// InputOverrideJSON returns the value of the "input_override_json" field in the mutation.
+func (m *DatasetExampleOverrideMutation) InputOverrideJSON() (r string, exists bool) {
+	v := m.input_override_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInputOverrideJSON returns the old "input_override_json" field's value of the DatasetExampleOverride entity.
+// If the DatasetExampleOverride object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DatasetExampleOverrideMutation) OldInputOverrideJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputOverrideJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputOverrideJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputOverrideJSON: %w", err)
+	}
+	return oldValue.InputOverrideJSON, nil
+}
+
+// ResetInputOverrideJSON resets all changes to the "input_override_json" field.
+func (m *DatasetExampleOverrideMutation) ResetInputOverrideJSON() {
+	m.input_override_json = nil
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *DatasetExampleOverrideMutation) SetUpdatedBy(s string) {
+	m.updated_by = &s
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *DatasetExampleOverrideMutation) UpdatedBy() (r string, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedBy returns the old "updated_by" field's value of the DatasetExampleOverride entity.
+// If the DatasetExampleOverride object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DatasetExampleOverrideMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	}
+	return oldValue.UpdatedBy, nil
+}
+
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *DatasetExampleOverrideMutation) ResetUpdatedBy() {
+	m.updated_by = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *DatasetMutation) SetUpdatedAt(t time.Time) {
+func (m *DatasetExampleOverrideMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DatasetMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *DatasetExampleOverrideMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -5334,10 +7283,10 @@ func (m *DatasetMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Dataset entity.
-// If the Dataset object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the DatasetExampleOverride entity.
+// If the DatasetExampleOverride object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DatasetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DatasetExampleOverrideMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -5352,19 +7301,19 @@ func (m *DatasetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DatasetMutation) ResetUpdatedAt() {
+func (m *DatasetExampleOverrideMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// Where appends a list predicates to the DatasetMutation builder.
-func (m *DatasetMutation) Where(ps ...predicate.Dataset) {
+// Where appends a list predicates to the DatasetExampleOverrideMutation builder.
+func (m *DatasetExampleOverrideMutation) Where(ps ...predicate.DatasetExampleOverride) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DatasetMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DatasetExampleOverrideMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DatasetMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Dataset, len(ps))
+func (m *DatasetExampleOverrideMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DatasetExampleOverride, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5372,36 +7321,45 @@ func (m *DatasetMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DatasetMutation) Op() Op {
+func (m *DatasetExampleOverrideMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DatasetMutation) SetOp(op Op) {
+func (m *DatasetExampleOverrideMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Dataset).
-func (m *DatasetMutation) Type() string {
+// Type returns the node type of this mutation (DatasetExampleOverride).
+func (m *DatasetExampleOverrideMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DatasetMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, dataset.FieldName)
+func (m *DatasetExampleOverrideMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.dataset_id != nil {
+		fields = append(fields, datasetexampleoverride.FieldDatasetID)
 	}
-	if m.description != nil {
-		fields = append(fields, dataset.FieldDescription)
+	if m.trace_id != nil {
+		fields = append(fields, datasetexampleoverride.FieldTraceID)
 	}
-	if m.created_at != nil {
-		fields = append(fields, dataset.FieldCreatedAt)
+	if m.expected_output != nil {
+		fields = append(fields, datasetexampleoverride.FieldExpectedOutput)
+	}
+	if m.tags_json != nil {
+		fields = append(fields, datasetexampleoverride.FieldTagsJSON)
+	}
+	if m.input_override_json != nil {
+		fields = append(fields, datasetexampleoverride.FieldInputOverrideJSON)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, datasetexampleoverride.FieldUpdatedBy)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, dataset.FieldUpdatedAt)
+		fields = append(fields, datasetexampleoverride.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -5409,15 +7367,21 @@ func (m *DatasetMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DatasetMutation) Field(name string) (ent.Value, bool) {
+func (m *DatasetExampleOverrideMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case dataset.FieldName:
-		return m.Name()
-	case dataset.FieldDescription:
-		return m.Description()
-	case dataset.FieldCreatedAt:
-		return m.CreatedAt()
-	case dataset.FieldUpdatedAt:
+	case datasetexampleoverride.FieldDatasetID:
+		return m.DatasetID()
+	case datasetexampleoverride.FieldTraceID:
+		return m.TraceID()
+	case datasetexampleoverride.FieldExpectedOutput:
+		return m.ExpectedOutput()
+	case datasetexampleoverride.FieldTagsJSON:
+		return m.TagsJSON()
+	case datasetexampleoverride.FieldInputOverrideJSON:
+		return m.InputOverrideJSON()
+	case datasetexampleoverride.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case datasetexampleoverride.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -5426,47 +7390,74 @@ func (m *DatasetMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DatasetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DatasetExampleOverrideMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case dataset.FieldName:
-		return m.OldName(ctx)
-	case dataset.FieldDescription:
-		return m.OldDescription(ctx)
-	case dataset.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case dataset.FieldUpdatedAt:
+	case datasetexampleoverride.FieldDatasetID:
+		return m.OldDatasetID(ctx)
+	case datasetexampleoverride.FieldTraceID:
+		return m.OldTraceID(ctx)
+	case datasetexampleoverride.FieldExpectedOutput:
+		return m.OldExpectedOutput(ctx)
+	case datasetexampleoverride.FieldTagsJSON:
+		return m.OldTagsJSON(ctx)
+	case datasetexampleoverride.FieldInputOverrideJSON:
+		return m.OldInputOverrideJSON(ctx)
+	case datasetexampleoverride.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case datasetexampleoverride.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Dataset field %s", name)
+	return nil, fmt.Errorf("unknown DatasetExampleOverride field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DatasetMutation) SetField(name string, value ent.Value) error {
+func (m *DatasetExampleOverrideMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case dataset.FieldName:
+	case datasetexampleoverride.FieldDatasetID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetDatasetID(v)
 		return nil
-	case dataset.FieldDescription:
+	case datasetexampleoverride.FieldTraceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetTraceID(v)
 		return nil
-	case dataset.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case datasetexampleoverride.FieldExpectedOutput:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetExpectedOutput(v)
 		return nil
-	case dataset.FieldUpdatedAt:
+	case datasetexampleoverride.FieldTagsJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsJSON(v)
+		return nil
+	case datasetexampleoverride.FieldInputOverrideJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputOverrideJSON(v)
+		return nil
+	case datasetexampleoverride.FieldUpdatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case datasetexampleoverride.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -5474,149 +7465,159 @@ func (m *DatasetMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Dataset field %s", name)
+	return fmt.Errorf("unknown DatasetExampleOverride field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DatasetMutation) AddedFields() []string {
+func (m *DatasetExampleOverrideMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DatasetMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DatasetExampleOverrideMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DatasetMutation) AddField(name string, value ent.Value) error {
+func (m *DatasetExampleOverrideMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Dataset numeric field %s", name)
+	return fmt.Errorf("unknown DatasetExampleOverride numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DatasetMutation) ClearedFields() []string {
+func (m *DatasetExampleOverrideMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DatasetMutation) FieldCleared(name string) bool {
+func (m *DatasetExampleOverrideMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *DatasetMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Dataset nullable field %s", name)
+// error if the field is not defined in the schema.
+func (m *DatasetExampleOverrideMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DatasetExampleOverride nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DatasetMutation) ResetField(name string) error {
+func (m *DatasetExampleOverrideMutation) ResetField(name string) error {
 	switch name {
-	case dataset.FieldName:
-		m.ResetName()
+	case datasetexampleoverride.FieldDatasetID:
+		m.ResetDatasetID()
 		return nil
-	case dataset.FieldDescription:
-		m.ResetDescription()
+	case datasetexampleoverride.FieldTraceID:
+		m.ResetTraceID()
 		return nil
-	case dataset.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case datasetexampleoverride.FieldExpectedOutput:
+		m.ResetExpectedOutput()
 		return nil
-	case dataset.FieldUpdatedAt:
+	case datasetexampleoverride.FieldTagsJSON:
+		m.ResetTagsJSON()
+		return nil
+	case datasetexampleoverride.FieldInputOverrideJSON:
+		m.ResetInputOverrideJSON()
+		return nil
+	case datasetexampleoverride.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case datasetexampleoverride.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Dataset field %s", name)
+	return fmt.Errorf("unknown DatasetExampleOverride field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DatasetMutation) AddedEdges() []string {
+func (m *DatasetExampleOverrideMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DatasetMutation) AddedIDs(name string) []ent.Value {
+func (m *DatasetExampleOverrideMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DatasetMutation) RemovedEdges() []string {
+func (m *DatasetExampleOverrideMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DatasetMutation) RemovedIDs(name string) []ent.Value {
+func (m *DatasetExampleOverrideMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DatasetMutation) ClearedEdges() []string {
+func (m *DatasetExampleOverrideMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DatasetMutation) EdgeCleared(name string) bool {
+func (m *DatasetExampleOverrideMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DatasetMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Dataset unique edge %s", name)
+func (m *DatasetExampleOverrideMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DatasetExampleOverride unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DatasetMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Dataset edge %s", name)
+func (m *DatasetExampleOverrideMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DatasetExampleOverride edge %s", name)
 }
 
-// DatasetExampleMutation represents an operation that mutates the DatasetExample nodes in the graph.
-type DatasetExampleMutation struct {
+// DatasetSnapshotMutation represents an operation that mutates the DatasetSnapshot nodes in the graph.
+type DatasetSnapshotMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	dataset_id    *string
-	trace_id      *string
-	position      *int
-	addposition   *int
-	added_at      *time.Time
-	source_type   *string
-	source_id     *string
-	note          *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*DatasetExample, error)
-	predicates    []predicate.DatasetExample
-}
-
-var _ ent.Mutation = (*DatasetExampleMutation)(nil)
-
-// datasetexampleOption allows management of the mutation configuration using functional options.
-type datasetexampleOption func(*DatasetExampleMutation)
-
-// newDatasetExampleMutation creates new mutation for the DatasetExample entity.
-func newDatasetExampleMutation(c config, op Op, opts ...datasetexampleOption) *DatasetExampleMutation {
-	m := &DatasetExampleMutation{
+	op               Op
+	typ              string
+	id               *string
+	dataset_id       *string
+	version          *int
+	addversion       *int
+	note             *string
+	created_by       *string
+	example_count    *int
+	addexample_count *int
+	examples_json    *string
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*DatasetSnapshot, error)
+	predicates       []predicate.DatasetSnapshot
+}
+
+var _ ent.Mutation = (*DatasetSnapshotMutation)(nil)
+
+// datasetsnapshotOption allows management of the mutation configuration using functional options.
+type datasetsnapshotOption func(*DatasetSnapshotMutation)
+
+// newDatasetSnapshotMutation creates new mutation for the DatasetSnapshot entity.
+func newDatasetSnapshotMutation(c config, op Op, opts ...datasetsnapshotOption) *DatasetSnapshotMutation {
+	m := &DatasetSnapshotMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDatasetExample,
+		typ:           TypeDatasetSnapshot,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5625,20 +7626,20 @@ func newDatasetExampleMutation(c config, op Op, opts ...datasetexampleOption) *D
 	return m
 }
 
-// withDatasetExampleID sets the ID field of the mutation.
-func withDatasetExampleID(id int) datasetexampleOption {
-	return func(m *DatasetExampleMutation) {
+// withDatasetSnapshotID sets the ID field of the mutation.
+func withDatasetSnapshotID(id string) datasetsnapshotOption {
+	return func(m *DatasetSnapshotMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DatasetExample
+			value *DatasetSnapshot
 		)
-		m.oldValue = func(ctx context.Context) (*DatasetExample, error) {
+		m.oldValue = func(ctx context.Context) (*DatasetSnapshot, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DatasetExample.Get(ctx, id)
+					value, err = m.Client().DatasetSnapshot.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5647,10 +7648,10 @@ func withDatasetExampleID(id int) datasetexampleOption {
 	}
 }
 
-// withDatasetExample sets the old DatasetExample of the mutation.
-func withDatasetExample(node *DatasetExample) datasetexampleOption {
-	return func(m *DatasetExampleMutation) {
-		m.oldValue = func(context.Context) (*DatasetExample, error) {
+// withDatasetSnapshot sets the old DatasetSnapshot of the mutation.
+func withDatasetSnapshot(node *DatasetSnapshot) datasetsnapshotOption {
+	return func(m *DatasetSnapshotMutation) {
+		m.oldValue = func(context.Context) (*DatasetSnapshot, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5659,7 +7660,7 @@ func withDatasetExample(node *DatasetExample) datasetexampleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DatasetExampleMutation) Client() *Client {
+func (m DatasetSnapshotMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5667,7 +7668,7 @@ func (m DatasetExampleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DatasetExampleMutation) Tx() (*Tx, error) {
+func (m DatasetSnapshotMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("dao: mutation is not running in a transaction")
 	}
@@ -5676,9 +7677,15 @@ func (m DatasetExampleMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DatasetSnapshot entities.
+func (m *DatasetSnapshotMutation) SetID(id string) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DatasetExampleMutation) ID() (id int, exists bool) {
+func (m *DatasetSnapshotMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5689,28 +7696,28 @@ func (m *DatasetExampleMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DatasetExampleMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DatasetSnapshotMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DatasetExample.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DatasetSnapshot.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetDatasetID sets the "dataset_id" field.
-func (m *DatasetExampleMutation) SetDatasetID(s string) {
+func (m *DatasetSnapshotMutation) SetDatasetID(s string) {
 	m.dataset_id = &s
 }
 
 // DatasetID returns the value of the "dataset_id" field in the mutation.
-func (m *DatasetExampleMutation) DatasetID() (r string, exists bool) {
+func (m *DatasetSnapshotMutation) DatasetID() (r string, exists bool) {
 	v := m.dataset_id
 	if v == nil {
 		return
@@ -5718,10 +7725,10 @@ func (m *DatasetExampleMutation) DatasetID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDatasetID returns the old "dataset_id" field's value of the DatasetExample entity.
-// If the DatasetExample object wasn't provided to the builder, the object is fetched from the database.
+// OldDatasetID returns the old "dataset_id" field's value of the DatasetSnapshot entity.
+// If the DatasetSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DatasetExampleMutation) OldDatasetID(ctx context.Context) (v string, err error) {
+func (m *DatasetSnapshotMutation) OldDatasetID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDatasetID is only allowed on UpdateOne operations")
 	}
@@ -5736,255 +7743,275 @@ func (m *DatasetExampleMutation) OldDatasetID(ctx context.Context) (v string, er
 }
 
 // ResetDatasetID resets all changes to the "dataset_id" field.
-func (m *DatasetExampleMutation) ResetDatasetID() {
+func (m *DatasetSnapshotMutation) ResetDatasetID() {
 	m.dataset_id = nil
 }
 
-// SetTraceID sets the "trace_id" field.
-func (m *DatasetExampleMutation) SetTraceID(s string) {
-	m.trace_id = &s
+// SetVersion sets the "version" field.
+func (m *DatasetSnapshotMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// TraceID returns the value of the "trace_id" field in the mutation.
-func (m *DatasetExampleMutation) TraceID() (r string, exists bool) {
-	v := m.trace_id
+// Version returns the value of the "version" field in the mutation.
+func (m *DatasetSnapshotMutation) Version() (r int, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTraceID returns the old "trace_id" field's value of the DatasetExample entity.
-// If the DatasetExample object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the DatasetSnapshot entity.
+// If the DatasetSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DatasetExampleMutation) OldTraceID(ctx context.Context) (v string, err error) {
+func (m *DatasetSnapshotMutation) OldVersion(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTraceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTraceID requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTraceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.TraceID, nil
+	return oldValue.Version, nil
 }
 
-// ResetTraceID resets all changes to the "trace_id" field.
-func (m *DatasetExampleMutation) ResetTraceID() {
-	m.trace_id = nil
+// AddVersion adds i to the "version" field.
+func (m *DatasetSnapshotMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
 }
 
-// SetPosition sets the "position" field.
-func (m *DatasetExampleMutation) SetPosition(i int) {
-	m.position = &i
-	m.addposition = nil
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *DatasetSnapshotMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Position returns the value of the "position" field in the mutation.
-func (m *DatasetExampleMutation) Position() (r int, exists bool) {
-	v := m.position
+// ResetVersion resets all changes to the "version" field.
+func (m *DatasetSnapshotMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetNote sets the "note" field.
+func (m *DatasetSnapshotMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *DatasetSnapshotMutation) Note() (r string, exists bool) {
+	v := m.note
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPosition returns the old "position" field's value of the DatasetExample entity.
-// If the DatasetExample object wasn't provided to the builder, the object is fetched from the database.
+// OldNote returns the old "note" field's value of the DatasetSnapshot entity.
+// If the DatasetSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DatasetExampleMutation) OldPosition(ctx context.Context) (v int, err error) {
+func (m *DatasetSnapshotMutation) OldNote(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPosition requires an ID field in the mutation")
+		return v, errors.New("OldNote requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
-	}
-	return oldValue.Position, nil
-}
-
-// AddPosition adds i to the "position" field.
-func (m *DatasetExampleMutation) AddPosition(i int) {
-	if m.addposition != nil {
-		*m.addposition += i
-	} else {
-		m.addposition = &i
-	}
-}
-
-// AddedPosition returns the value that was added to the "position" field in this mutation.
-func (m *DatasetExampleMutation) AddedPosition() (r int, exists bool) {
-	v := m.addposition
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
 	}
-	return *v, true
+	return oldValue.Note, nil
 }
 
-// ResetPosition resets all changes to the "position" field.
-func (m *DatasetExampleMutation) ResetPosition() {
-	m.position = nil
-	m.addposition = nil
+// ResetNote resets all changes to the "note" field.
+func (m *DatasetSnapshotMutation) ResetNote() {
+	m.note = nil
 }
 
-// SetAddedAt sets the "added_at" field.
-func (m *DatasetExampleMutation) SetAddedAt(t time.Time) {
-	m.added_at = &t
+// SetCreatedBy sets the "created_by" field.
+func (m *DatasetSnapshotMutation) SetCreatedBy(s string) {
+	m.created_by = &s
 }
 
-// AddedAt returns the value of the "added_at" field in the mutation.
-func (m *DatasetExampleMutation) AddedAt() (r time.Time, exists bool) {
-	v := m.added_at
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *DatasetSnapshotMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAddedAt returns the old "added_at" field's value of the DatasetExample entity.
-// If the DatasetExample object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the DatasetSnapshot entity.
+// If the DatasetSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DatasetExampleMutation) OldAddedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DatasetSnapshotMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAddedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAddedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAddedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.AddedAt, nil
+	return oldValue.CreatedBy, nil
 }
 
-// ResetAddedAt resets all changes to the "added_at" field.
-func (m *DatasetExampleMutation) ResetAddedAt() {
-	m.added_at = nil
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *DatasetSnapshotMutation) ResetCreatedBy() {
+	m.created_by = nil
 }
 
-// SetSourceType sets the "source_type" field.
-func (m *DatasetExampleMutation) SetSourceType(s string) {
-	m.source_type = &s
+// SetExampleCount sets the "example_count" field.
+func (m *DatasetSnapshotMutation) SetExampleCount(i int) {
+	m.example_count = &i
+	m.addexample_count = nil
 }
 
-// SourceType returns the value of the "source_type" field in the mutation.
-func (m *DatasetExampleMutation) SourceType() (r string, exists bool) {
-	v := m.source_type
+// ExampleCount returns the value of the "example_count" field in the mutation.
+func (m *DatasetSnapshotMutation) ExampleCount() (r int, exists bool) {
+	v := m.example_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourceType returns the old "source_type" field's value of the DatasetExample entity.
-// If the DatasetExample object wasn't provided to the builder, the object is fetched from the database.
+// OldExampleCount returns the old "example_count" field's value of the DatasetSnapshot entity.
+// If the DatasetSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DatasetExampleMutation) OldSourceType(ctx context.Context) (v string, err error) {
+func (m *DatasetSnapshotMutation) OldExampleCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceType is only allowed on UpdateOne operations")
+		return v, errors.New("OldExampleCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceType requires an ID field in the mutation")
+		return v, errors.New("OldExampleCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceType: %w", err)
+		return v, fmt.Errorf("querying old value for OldExampleCount: %w", err)
 	}
-	return oldValue.SourceType, nil
+	return oldValue.ExampleCount, nil
 }
 
-// ResetSourceType resets all changes to the "source_type" field.
-func (m *DatasetExampleMutation) ResetSourceType() {
-	m.source_type = nil
+// AddExampleCount adds i to the "example_count" field.
+func (m *DatasetSnapshotMutation) AddExampleCount(i int) {
+	if m.addexample_count != nil {
+		*m.addexample_count += i
+	} else {
+		m.addexample_count = &i
+	}
 }
 
-// SetSourceID sets the "source_id" field.
-func (m *DatasetExampleMutation) SetSourceID(s string) {
-	m.source_id = &s
+// AddedExampleCount returns the value that was added to the "example_count" field in this mutation.
+func (m *DatasetSnapshotMutation) AddedExampleCount() (r int, exists bool) {
+	v := m.addexample_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SourceID returns the value of the "source_id" field in the mutation.
-func (m *DatasetExampleMutation) SourceID() (r string, exists bool) {
-	v := m.source_id
+// ResetExampleCount resets all changes to the "example_count" field.
+func (m *DatasetSnapshotMutation) ResetExampleCount() {
+	m.example_count = nil
+	m.addexample_count = nil
+}
+
+// SetExamplesJSON sets the "examples_json" field.
+func (m *DatasetSnapshotMutation) SetExamplesJSON(s string) {
+	m.examples_json = &s
+}
+
+// ExamplesJSON returns the value of the "examples_json" field in the mutation.
+func (m *DatasetSnapshotMutation) ExamplesJSON() (r string, exists bool) {
+	v := m.examples_json
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourceID returns the old "source_id" field's value of the DatasetExample entity.
-// If the DatasetExample object wasn't provided to the builder, the object is fetched from the database.
+// OldExamplesJSON returns the old "examples_json" field's value of the DatasetSnapshot entity.
+// If the DatasetSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DatasetExampleMutation) OldSourceID(ctx context.Context) (v string, err error) {
+func (m *DatasetSnapshotMutation) OldExamplesJSON(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldExamplesJSON is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceID requires an ID field in the mutation")
+		return v, errors.New("OldExamplesJSON requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldExamplesJSON: %w", err)
 	}
-	return oldValue.SourceID, nil
+	return oldValue.ExamplesJSON, nil
 }
 
-// ResetSourceID resets all changes to the "source_id" field.
-func (m *DatasetExampleMutation) ResetSourceID() {
-	m.source_id = nil
+// ResetExamplesJSON resets all changes to the "examples_json" field.
+func (m *DatasetSnapshotMutation) ResetExamplesJSON() {
+	m.examples_json = nil
 }
 
-// SetNote sets the "note" field.
-func (m *DatasetExampleMutation) SetNote(s string) {
-	m.note = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *DatasetSnapshotMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Note returns the value of the "note" field in the mutation.
-func (m *DatasetExampleMutation) Note() (r string, exists bool) {
-	v := m.note
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DatasetSnapshotMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNote returns the old "note" field's value of the DatasetExample entity.
-// If the DatasetExample object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the DatasetSnapshot entity.
+// If the DatasetSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DatasetExampleMutation) OldNote(ctx context.Context) (v string, err error) {
+func (m *DatasetSnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNote requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Note, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetNote resets all changes to the "note" field.
-func (m *DatasetExampleMutation) ResetNote() {
-	m.note = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DatasetSnapshotMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the DatasetExampleMutation builder.
-func (m *DatasetExampleMutation) Where(ps ...predicate.DatasetExample) {
+// Where appends a list predicates to the DatasetSnapshotMutation builder.
+func (m *DatasetSnapshotMutation) Where(ps ...predicate.DatasetSnapshot) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DatasetExampleMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DatasetSnapshotMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DatasetExampleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DatasetExample, len(ps))
+func (m *DatasetSnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DatasetSnapshot, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5992,45 +8019,45 @@ func (m *DatasetExampleMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DatasetExampleMutation) Op() Op {
+func (m *DatasetSnapshotMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DatasetExampleMutation) SetOp(op Op) {
+func (m *DatasetSnapshotMutation) SetOp(op Op) {
 	m.op = op
 }
-
-// Type returns the node type of this mutation (DatasetExample).
-func (m *DatasetExampleMutation) Type() string {
+
+// Type returns the node type of this mutation (DatasetSnapshot).
+func (m *DatasetSnapshotMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DatasetExampleMutation) Fields() []string {
+func (m *DatasetSnapshotMutation) Fields() []string {
 	fields := make([]string, 0, 7)
 	if m.dataset_id != nil {
-		fields = append(fields, datasetexample.FieldDatasetID)
+		fields = append(fields, datasetsnapshot.FieldDatasetID)
 	}
-	if m.trace_id != nil {
-		fields = append(fields, datasetexample.FieldTraceID)
+	if m.version != nil {
+		fields = append(fields, datasetsnapshot.FieldVersion)
 	}
-	if m.position != nil {
-		fields = append(fields, datasetexample.FieldPosition)
+	if m.note != nil {
+		fields = append(fields, datasetsnapshot.FieldNote)
 	}
-	if m.added_at != nil {
-		fields = append(fields, datasetexample.FieldAddedAt)
+	if m.created_by != nil {
+		fields = append(fields, datasetsnapshot.FieldCreatedBy)
 	}
-	if m.source_type != nil {
-		fields = append(fields, datasetexample.FieldSourceType)
+	if m.example_count != nil {
+		fields = append(fields, datasetsnapshot.FieldExampleCount)
 	}
-	if m.source_id != nil {
-		fields = append(fields, datasetexample.FieldSourceID)
+	if m.examples_json != nil {
+		fields = append(fields, datasetsnapshot.FieldExamplesJSON)
 	}
-	if m.note != nil {
-		fields = append(fields, datasetexample.FieldNote)
+	if m.created_at != nil {
+		fields = append(fields, datasetsnapshot.FieldCreatedAt)
 	}
 	return fields
 }
@@ -6038,22 +8065,22 @@ func (m *DatasetExampleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DatasetExampleMutation) Field(name string) (ent.Value, bool) {
+func (m *DatasetSnapshotMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case datasetexample.FieldDatasetID:
+	case datasetsnapshot.FieldDatasetID:
 		return m.DatasetID()
-	case datasetexample.FieldTraceID:
-		return m.TraceID()
-	case datasetexample.FieldPosition:
-		return m.Position()
-	case datasetexample.FieldAddedAt:
-		return m.AddedAt()
-	case datasetexample.FieldSourceType:
-		return m.SourceType()
-	case datasetexample.FieldSourceID:
-		return m.SourceID()
-	case datasetexample.FieldNote:
+	case datasetsnapshot.FieldVersion:
+		return m.Version()
+	case datasetsnapshot.FieldNote:
 		return m.Note()
+	case datasetsnapshot.FieldCreatedBy:
+		return m.CreatedBy()
+	case datasetsnapshot.FieldExampleCount:
+		return m.ExampleCount()
+	case datasetsnapshot.FieldExamplesJSON:
+		return m.ExamplesJSON()
+	case datasetsnapshot.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -6061,90 +8088,93 @@ func (m *DatasetExampleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DatasetExampleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DatasetSnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case datasetexample.FieldDatasetID:
+	case datasetsnapshot.FieldDatasetID:
 		return m.OldDatasetID(ctx)
-	case datasetexample.FieldTraceID:
-		return m.OldTraceID(ctx)
-	case datasetexample.FieldPosition:
-		return m.OldPosition(ctx)
-	case datasetexample.FieldAddedAt:
-		return m.OldAddedAt(ctx)
-	case datasetexample.FieldSourceType:
-		return m.OldSourceType(ctx)
-	case datasetexample.FieldSourceID:
-		return m.OldSourceID(ctx)
-	case datasetexample.FieldNote:
+	case datasetsnapshot.FieldVersion:
+		return m.OldVersion(ctx)
+	case datasetsnapshot.FieldNote:
 		return m.OldNote(ctx)
+	case datasetsnapshot.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case datasetsnapshot.FieldExampleCount:
+		return m.OldExampleCount(ctx)
+	case datasetsnapshot.FieldExamplesJSON:
+		return m.OldExamplesJSON(ctx)
+	case datasetsnapshot.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown DatasetExample field %s", name)
+	return nil, fmt.Errorf("unknown DatasetSnapshot field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DatasetExampleMutation) SetField(name string, value ent.Value) error {
+func (m *DatasetSnapshotMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case datasetexample.FieldDatasetID:
+	case datasetsnapshot.FieldDatasetID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDatasetID(v)
 		return nil
-	case datasetexample.FieldTraceID:
-		v, ok := value.(string)
+	case datasetsnapshot.FieldVersion:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTraceID(v)
+		m.SetVersion(v)
 		return nil
-	case datasetexample.FieldPosition:
-		v, ok := value.(int)
+	case datasetsnapshot.FieldNote:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPosition(v)
+		m.SetNote(v)
 		return nil
-	case datasetexample.FieldAddedAt:
-		v, ok := value.(time.Time)
+	case datasetsnapshot.FieldCreatedBy:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAddedAt(v)
+		m.SetCreatedBy(v)
 		return nil
-	case datasetexample.FieldSourceType:
-		v, ok := value.(string)
+	case datasetsnapshot.FieldExampleCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSourceType(v)
+		m.SetExampleCount(v)
 		return nil
-	case datasetexample.FieldSourceID:
+	case datasetsnapshot.FieldExamplesJSON:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSourceID(v)
+		m.SetExamplesJSON(v)
 		return nil
-	case datasetexample.FieldNote:
-		v, ok := value.(string)
+	case datasetsnapshot.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNote(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DatasetExample field %s", name)
+	return fmt.Errorf("unknown DatasetSnapshot field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DatasetExampleMutation) AddedFields() []string {
+func (m *DatasetSnapshotMutation) AddedFields() []string {
 	var fields []string
-	if m.addposition != nil {
-		fields = append(fields, datasetexample.FieldPosition)
+	if m.addversion != nil {
+		fields = append(fields, datasetsnapshot.FieldVersion)
+	}
+	if m.addexample_count != nil {
+		fields = append(fields, datasetsnapshot.FieldExampleCount)
 	}
 	return fields
 }
@@ -6152,10 +8182,12 @@ func (m *DatasetExampleMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DatasetExampleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DatasetSnapshotMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case datasetexample.FieldPosition:
-		return m.AddedPosition()
+	case datasetsnapshot.FieldVersion:
+		return m.AddedVersion()
+	case datasetsnapshot.FieldExampleCount:
+		return m.AddedExampleCount()
 	}
 	return nil, false
 }
@@ -6163,113 +8195,120 @@ func (m *DatasetExampleMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DatasetExampleMutation) AddField(name string, value ent.Value) error {
+func (m *DatasetSnapshotMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case datasetexample.FieldPosition:
+	case datasetsnapshot.FieldVersion:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPosition(v)
+		m.AddVersion(v)
+		return nil
+	case datasetsnapshot.FieldExampleCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExampleCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DatasetExample numeric field %s", name)
+	return fmt.Errorf("unknown DatasetSnapshot numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DatasetExampleMutation) ClearedFields() []string {
+func (m *DatasetSnapshotMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DatasetExampleMutation) FieldCleared(name string) bool {
+func (m *DatasetSnapshotMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DatasetExampleMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown DatasetExample nullable field %s", name)
+func (m *DatasetSnapshotMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DatasetSnapshot nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DatasetExampleMutation) ResetField(name string) error {
+func (m *DatasetSnapshotMutation) ResetField(name string) error {
 	switch name {
-	case datasetexample.FieldDatasetID:
+	case datasetsnapshot.FieldDatasetID:
 		m.ResetDatasetID()
 		return nil
-	case datasetexample.FieldTraceID:
-		m.ResetTraceID()
+	case datasetsnapshot.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case datasetexample.FieldPosition:
-		m.ResetPosition()
+	case datasetsnapshot.FieldNote:
+		m.ResetNote()
 		return nil
-	case datasetexample.FieldAddedAt:
-		m.ResetAddedAt()
+	case datasetsnapshot.FieldCreatedBy:
+		m.ResetCreatedBy()
 		return nil
-	case datasetexample.FieldSourceType:
-		m.ResetSourceType()
+	case datasetsnapshot.FieldExampleCount:
+		m.ResetExampleCount()
 		return nil
-	case datasetexample.FieldSourceID:
-		m.ResetSourceID()
+	case datasetsnapshot.FieldExamplesJSON:
+		m.ResetExamplesJSON()
 		return nil
-	case datasetexample.FieldNote:
-		m.ResetNote()
+	case datasetsnapshot.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DatasetExample field %s", name)
+	return fmt.Errorf("unknown DatasetSnapshot field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DatasetExampleMutation) AddedEdges() []string {
+func (m *DatasetSnapshotMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DatasetExampleMutation) AddedIDs(name string) []ent.Value {
+func (m *DatasetSnapshotMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DatasetExampleMutation) RemovedEdges() []string {
+func (m *DatasetSnapshotMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DatasetExampleMutation) RemovedIDs(name string) []ent.Value {
+func (m *DatasetSnapshotMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DatasetExampleMutation) ClearedEdges() []string {
+func (m *DatasetSnapshotMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DatasetExampleMutation) EdgeCleared(name string) bool {
+func (m *DatasetSnapshotMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DatasetExampleMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown DatasetExample unique edge %s", name)
+func (m *DatasetSnapshotMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DatasetSnapshot unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DatasetExampleMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown DatasetExample edge %s", name)
+func (m *DatasetSnapshotMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DatasetSnapshot edge %s", name)
 }
 
 // EvalRunMutation represents an operation that mutates the EvalRun nodes in the graph.
@@ -7252,6 +9291,8 @@ type ExperimentRunMutation struct {
 	addimprovement_count     *int
 	regression_count         *int
 	addregression_count      *int
+	status                   *string
+	error                    *string
 	clearedFields            map[string]struct{}
 	done                     bool
 	oldValue                 func(context.Context) (*ExperimentRun, error)
@@ -7990,6 +10031,78 @@ func (m *ExperimentRunMutation) ResetRegressionCount() {
 	m.addregression_count = nil
 }
 
+// SetStatus sets the "status" field.
+func (m *ExperimentRunMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ExperimentRunMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ExperimentRun entity.
+// If the ExperimentRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperimentRunMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ExperimentRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetError sets the "error" field.
+func (m *ExperimentRunMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *ExperimentRunMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the ExperimentRun entity.
+// If the ExperimentRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperimentRunMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *ExperimentRunMutation) ResetError() {
+	m.error = nil
+}
+
 // Where appends a list predicates to the ExperimentRunMutation builder.
 func (m *ExperimentRunMutation) Where(ps ...predicate.ExperimentRun) {
 	m.predicates = append(m.predicates, ps...)
@@ -8024,7 +10137,7 @@ func (m *ExperimentRunMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ExperimentRunMutation) Fields() []string {
-	fields := make([]string, 0, 13)
+	fields := make([]string, 0, 15)
 	if m.name != nil {
 		fields = append(fields, experimentrun.FieldName)
 	}
@@ -8064,6 +10177,12 @@ func (m *ExperimentRunMutation) Fields() []string {
 	if m.regression_count != nil {
 		fields = append(fields, experimentrun.FieldRegressionCount)
 	}
+	if m.status != nil {
+		fields = append(fields, experimentrun.FieldStatus)
+	}
+	if m.error != nil {
+		fields = append(fields, experimentrun.FieldError)
+	}
 	return fields
 }
 
@@ -8098,6 +10217,10 @@ func (m *ExperimentRunMutation) Field(name string) (ent.Value, bool) {
 		return m.ImprovementCount()
 	case experimentrun.FieldRegressionCount:
 		return m.RegressionCount()
+	case experimentrun.FieldStatus:
+		return m.Status()
+	case experimentrun.FieldError:
+		return m.Error()
 	}
 	return nil, false
 }
@@ -8133,6 +10256,10 @@ func (m *ExperimentRunMutation) OldField(ctx context.Context, name string) (ent.
 		return m.OldImprovementCount(ctx)
 	case experimentrun.FieldRegressionCount:
 		return m.OldRegressionCount(ctx)
+	case experimentrun.FieldStatus:
+		return m.OldStatus(ctx)
+	case experimentrun.FieldError:
+		return m.OldError(ctx)
 	}
 	return nil, fmt.Errorf("unknown ExperimentRun field %s", name)
 }
@@ -8233,6 +10360,20 @@ func (m *ExperimentRunMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetRegressionCount(v)
 		return nil
+	case experimentrun.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case experimentrun.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
 	}
 	return fmt.Errorf("unknown ExperimentRun field %s", name)
 }
@@ -8420,6 +10561,12 @@ func (m *ExperimentRunMutation) ResetField(name string) error {
 	case experimentrun.FieldRegressionCount:
 		m.ResetRegressionCount()
 		return nil
+	case experimentrun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case experimentrun.FieldError:
+		m.ResetError()
+		return nil
 	}
 	return fmt.Errorf("unknown ExperimentRun field %s", name)
 }
@@ -10118,6 +12265,8 @@ type TraceLogMutation struct {
 	routing_candidate_count           *int
 	addrouting_candidate_count        *int
 	routing_failure_reason            *string
+	token_name                        *string
+	client_canceled                   *bool
 	clearedFields                     map[string]struct{}
 	done                              bool
 	oldValue                          func(context.Context) (*TraceLog, error)
@@ -11856,64 +14005,136 @@ func (m *TraceLogMutation) OldRoutingCandidateCount(ctx context.Context) (v int,
 	return oldValue.RoutingCandidateCount, nil
 }
 
-// AddRoutingCandidateCount adds i to the "routing_candidate_count" field.
-func (m *TraceLogMutation) AddRoutingCandidateCount(i int) {
-	if m.addrouting_candidate_count != nil {
-		*m.addrouting_candidate_count += i
-	} else {
-		m.addrouting_candidate_count = &i
-	}
+// AddRoutingCandidateCount adds i to the "routing_candidate_count" field.
+func (m *TraceLogMutation) AddRoutingCandidateCount(i int) {
+	if m.addrouting_candidate_count != nil {
+		*m.addrouting_candidate_count += i
+	} else {
+		m.addrouting_candidate_count = &i
+	}
+}
+
+// AddedRoutingCandidateCount returns the value that was added to the "routing_candidate_count" field in this mutation.
+func (m *TraceLogMutation) AddedRoutingCandidateCount() (r int, exists bool) {
+	v := m.addrouting_candidate_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRoutingCandidateCount resets all changes to the "routing_candidate_count" field.
+func (m *TraceLogMutation) ResetRoutingCandidateCount() {
+	m.routing_candidate_count = nil
+	m.addrouting_candidate_count = nil
+}
+
+// SetRoutingFailureReason sets the "routing_failure_reason" field.
+func (m *TraceLogMutation) SetRoutingFailureReason(s string) {
+	m.routing_failure_reason = &s
+}
+
+// RoutingFailureReason returns the value of the "routing_failure_reason" field in the mutation.
+func (m *TraceLogMutation) RoutingFailureReason() (r string, exists bool) {
+	v := m.routing_failure_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoutingFailureReason returns the old "routing_failure_reason" field's value of the TraceLog entity.
+// If the TraceLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TraceLogMutation) OldRoutingFailureReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoutingFailureReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoutingFailureReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoutingFailureReason: %w", err)
+	}
+	return oldValue.RoutingFailureReason, nil
+}
+
+// ResetRoutingFailureReason resets all changes to the "routing_failure_reason" field.
+func (m *TraceLogMutation) ResetRoutingFailureReason() {
+	m.routing_failure_reason = nil
+}
+
+// SetTokenName sets the "token_name" field.
+func (m *TraceLogMutation) SetTokenName(s string) {
+	m.token_name = &s
 }
 
-// AddedRoutingCandidateCount returns the value that was added to the "routing_candidate_count" field in this mutation.
-func (m *TraceLogMutation) AddedRoutingCandidateCount() (r int, exists bool) {
-	v := m.addrouting_candidate_count
+// TokenName returns the value of the "token_name" field in the mutation.
+func (m *TraceLogMutation) TokenName() (r string, exists bool) {
+	v := m.token_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRoutingCandidateCount resets all changes to the "routing_candidate_count" field.
-func (m *TraceLogMutation) ResetRoutingCandidateCount() {
-	m.routing_candidate_count = nil
-	m.addrouting_candidate_count = nil
+// OldTokenName returns the old "token_name" field's value of the TraceLog entity.
+// If the TraceLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TraceLogMutation) OldTokenName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenName: %w", err)
+	}
+	return oldValue.TokenName, nil
 }
 
-// SetRoutingFailureReason sets the "routing_failure_reason" field.
-func (m *TraceLogMutation) SetRoutingFailureReason(s string) {
-	m.routing_failure_reason = &s
+// ResetTokenName resets all changes to the "token_name" field.
+func (m *TraceLogMutation) ResetTokenName() {
+	m.token_name = nil
 }
 
-// RoutingFailureReason returns the value of the "routing_failure_reason" field in the mutation.
-func (m *TraceLogMutation) RoutingFailureReason() (r string, exists bool) {
-	v := m.routing_failure_reason
+// SetClientCanceled sets the "client_canceled" field.
+func (m *TraceLogMutation) SetClientCanceled(b bool) {
+	m.client_canceled = &b
+}
+
+// ClientCanceled returns the value of the "client_canceled" field in the mutation.
+func (m *TraceLogMutation) ClientCanceled() (r bool, exists bool) {
+	v := m.client_canceled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRoutingFailureReason returns the old "routing_failure_reason" field's value of the TraceLog entity.
+// OldClientCanceled returns the old "client_canceled" field's value of the TraceLog entity.
 // If the TraceLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TraceLogMutation) OldRoutingFailureReason(ctx context.Context) (v string, err error) {
+func (m *TraceLogMutation) OldClientCanceled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoutingFailureReason is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientCanceled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoutingFailureReason requires an ID field in the mutation")
+		return v, errors.New("OldClientCanceled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoutingFailureReason: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientCanceled: %w", err)
 	}
-	return oldValue.RoutingFailureReason, nil
+	return oldValue.ClientCanceled, nil
 }
 
-// ResetRoutingFailureReason resets all changes to the "routing_failure_reason" field.
-func (m *TraceLogMutation) ResetRoutingFailureReason() {
-	m.routing_failure_reason = nil
+// ResetClientCanceled resets all changes to the "client_canceled" field.
+func (m *TraceLogMutation) ResetClientCanceled() {
+	m.client_canceled = nil
 }
 
 // Where appends a list predicates to the TraceLogMutation builder.
@@ -11950,7 +14171,7 @@ func (m *TraceLogMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *TraceLogMutation) Fields() []string {
-	fields := make([]string, 0, 38)
+	fields := make([]string, 0, 40)
 	if m.trace_id != nil {
 		fields = append(fields, tracelog.FieldTraceID)
 	}
@@ -12065,6 +14286,12 @@ func (m *TraceLogMutation) Fields() []string {
 	if m.routing_failure_reason != nil {
 		fields = append(fields, tracelog.FieldRoutingFailureReason)
 	}
+	if m.token_name != nil {
+		fields = append(fields, tracelog.FieldTokenName)
+	}
+	if m.client_canceled != nil {
+		fields = append(fields, tracelog.FieldClientCanceled)
+	}
 	return fields
 }
 
@@ -12092,831 +14319,1401 @@ func (m *TraceLogMutation) Field(name string) (ent.Value, bool) {
 	case tracelog.FieldOperation:
 		return m.Operation()
 	case tracelog.FieldEndpoint:
-		return m.Endpoint()
+		return m.Endpoint()
+	case tracelog.FieldURL:
+		return m.URL()
+	case tracelog.FieldMethod:
+		return m.Method()
+	case tracelog.FieldStatusCode:
+		return m.StatusCode()
+	case tracelog.FieldDurationMs:
+		return m.DurationMs()
+	case tracelog.FieldTtftMs:
+		return m.TtftMs()
+	case tracelog.FieldClientIP:
+		return m.ClientIP()
+	case tracelog.FieldContentLength:
+		return m.ContentLength()
+	case tracelog.FieldErrorText:
+		return m.ErrorText()
+	case tracelog.FieldPromptTokens:
+		return m.PromptTokens()
+	case tracelog.FieldCompletionTokens:
+		return m.CompletionTokens()
+	case tracelog.FieldTotalTokens:
+		return m.TotalTokens()
+	case tracelog.FieldCachedTokens:
+		return m.CachedTokens()
+	case tracelog.FieldReqHeaderLen:
+		return m.ReqHeaderLen()
+	case tracelog.FieldReqBodyLen:
+		return m.ReqBodyLen()
+	case tracelog.FieldResHeaderLen:
+		return m.ResHeaderLen()
+	case tracelog.FieldResBodyLen:
+		return m.ResBodyLen()
+	case tracelog.FieldIsStream:
+		return m.IsStream()
+	case tracelog.FieldSessionID:
+		return m.SessionID()
+	case tracelog.FieldSessionSource:
+		return m.SessionSource()
+	case tracelog.FieldWindowID:
+		return m.WindowID()
+	case tracelog.FieldClientRequestID:
+		return m.ClientRequestID()
+	case tracelog.FieldSelectedUpstreamID:
+		return m.SelectedUpstreamID()
+	case tracelog.FieldSelectedUpstreamBaseURL:
+		return m.SelectedUpstreamBaseURL()
+	case tracelog.FieldSelectedUpstreamProviderPreset:
+		return m.SelectedUpstreamProviderPreset()
+	case tracelog.FieldRoutingPolicy:
+		return m.RoutingPolicy()
+	case tracelog.FieldRoutingScore:
+		return m.RoutingScore()
+	case tracelog.FieldRoutingCandidateCount:
+		return m.RoutingCandidateCount()
+	case tracelog.FieldRoutingFailureReason:
+		return m.RoutingFailureReason()
+	case tracelog.FieldTokenName:
+		return m.TokenName()
+	case tracelog.FieldClientCanceled:
+		return m.ClientCanceled()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TraceLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tracelog.FieldTraceID:
+		return m.OldTraceID(ctx)
+	case tracelog.FieldModTimeNs:
+		return m.OldModTimeNs(ctx)
+	case tracelog.FieldFileSize:
+		return m.OldFileSize(ctx)
+	case tracelog.FieldVersion:
+		return m.OldVersion(ctx)
+	case tracelog.FieldRequestID:
+		return m.OldRequestID(ctx)
+	case tracelog.FieldRecordedAt:
+		return m.OldRecordedAt(ctx)
+	case tracelog.FieldModel:
+		return m.OldModel(ctx)
+	case tracelog.FieldProvider:
+		return m.OldProvider(ctx)
+	case tracelog.FieldOperation:
+		return m.OldOperation(ctx)
+	case tracelog.FieldEndpoint:
+		return m.OldEndpoint(ctx)
+	case tracelog.FieldURL:
+		return m.OldURL(ctx)
+	case tracelog.FieldMethod:
+		return m.OldMethod(ctx)
+	case tracelog.FieldStatusCode:
+		return m.OldStatusCode(ctx)
+	case tracelog.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case tracelog.FieldTtftMs:
+		return m.OldTtftMs(ctx)
+	case tracelog.FieldClientIP:
+		return m.OldClientIP(ctx)
+	case tracelog.FieldContentLength:
+		return m.OldContentLength(ctx)
+	case tracelog.FieldErrorText:
+		return m.OldErrorText(ctx)
+	case tracelog.FieldPromptTokens:
+		return m.OldPromptTokens(ctx)
+	case tracelog.FieldCompletionTokens:
+		return m.OldCompletionTokens(ctx)
+	case tracelog.FieldTotalTokens:
+		return m.OldTotalTokens(ctx)
+	case tracelog.FieldCachedTokens:
+		return m.OldCachedTokens(ctx)
+	case tracelog.FieldReqHeaderLen:
+		return m.OldReqHeaderLen(ctx)
+	case tracelog.FieldReqBodyLen:
+		return m.OldReqBodyLen(ctx)
+	case tracelog.FieldResHeaderLen:
+		return m.OldResHeaderLen(ctx)
+	case tracelog.FieldResBodyLen:
+		return m.OldResBodyLen(ctx)
+	case tracelog.FieldIsStream:
+		return m.OldIsStream(ctx)
+	case tracelog.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case tracelog.FieldSessionSource:
+		return m.OldSessionSource(ctx)
+	case tracelog.FieldWindowID:
+		return m.OldWindowID(ctx)
+	case tracelog.FieldClientRequestID:
+		return m.OldClientRequestID(ctx)
+	case tracelog.FieldSelectedUpstreamID:
+		return m.OldSelectedUpstreamID(ctx)
+	case tracelog.FieldSelectedUpstreamBaseURL:
+		return m.OldSelectedUpstreamBaseURL(ctx)
+	case tracelog.FieldSelectedUpstreamProviderPreset:
+		return m.OldSelectedUpstreamProviderPreset(ctx)
+	case tracelog.FieldRoutingPolicy:
+		return m.OldRoutingPolicy(ctx)
+	case tracelog.FieldRoutingScore:
+		return m.OldRoutingScore(ctx)
+	case tracelog.FieldRoutingCandidateCount:
+		return m.OldRoutingCandidateCount(ctx)
+	case tracelog.FieldRoutingFailureReason:
+		return m.OldRoutingFailureReason(ctx)
+	case tracelog.FieldTokenName:
+		return m.OldTokenName(ctx)
+	case tracelog.FieldClientCanceled:
+		return m.OldClientCanceled(ctx)
+	}
+	return nil, fmt.Errorf("unknown TraceLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TraceLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tracelog.FieldTraceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTraceID(v)
+		return nil
+	case tracelog.FieldModTimeNs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModTimeNs(v)
+		return nil
+	case tracelog.FieldFileSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileSize(v)
+		return nil
+	case tracelog.FieldVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case tracelog.FieldRequestID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestID(v)
+		return nil
+	case tracelog.FieldRecordedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecordedAt(v)
+		return nil
+	case tracelog.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case tracelog.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case tracelog.FieldOperation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperation(v)
+		return nil
+	case tracelog.FieldEndpoint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndpoint(v)
+		return nil
 	case tracelog.FieldURL:
-		return m.URL()
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
 	case tracelog.FieldMethod:
-		return m.Method()
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMethod(v)
+		return nil
 	case tracelog.FieldStatusCode:
-		return m.StatusCode()
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusCode(v)
+		return nil
 	case tracelog.FieldDurationMs:
-		return m.DurationMs()
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
 	case tracelog.FieldTtftMs:
-		return m.TtftMs()
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTtftMs(v)
+		return nil
 	case tracelog.FieldClientIP:
-		return m.ClientIP()
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientIP(v)
+		return nil
 	case tracelog.FieldContentLength:
-		return m.ContentLength()
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentLength(v)
+		return nil
 	case tracelog.FieldErrorText:
-		return m.ErrorText()
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorText(v)
+		return nil
 	case tracelog.FieldPromptTokens:
-		return m.PromptTokens()
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptTokens(v)
+		return nil
 	case tracelog.FieldCompletionTokens:
-		return m.CompletionTokens()
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletionTokens(v)
+		return nil
 	case tracelog.FieldTotalTokens:
-		return m.TotalTokens()
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalTokens(v)
+		return nil
 	case tracelog.FieldCachedTokens:
-		return m.CachedTokens()
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCachedTokens(v)
+		return nil
 	case tracelog.FieldReqHeaderLen:
-		return m.ReqHeaderLen()
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReqHeaderLen(v)
+		return nil
 	case tracelog.FieldReqBodyLen:
-		return m.ReqBodyLen()
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReqBodyLen(v)
+		return nil
 	case tracelog.FieldResHeaderLen:
-		return m.ResHeaderLen()
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResHeaderLen(v)
+		return nil
 	case tracelog.FieldResBodyLen:
-		return m.ResBodyLen()
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResBodyLen(v)
+		return nil
 	case tracelog.FieldIsStream:
-		return m.IsStream()
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsStream(v)
+		return nil
 	case tracelog.FieldSessionID:
-		return m.SessionID()
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
 	case tracelog.FieldSessionSource:
-		return m.SessionSource()
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionSource(v)
+		return nil
 	case tracelog.FieldWindowID:
-		return m.WindowID()
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWindowID(v)
+		return nil
 	case tracelog.FieldClientRequestID:
-		return m.ClientRequestID()
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientRequestID(v)
+		return nil
 	case tracelog.FieldSelectedUpstreamID:
-		return m.SelectedUpstreamID()
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSelectedUpstreamID(v)
+		return nil
 	case tracelog.FieldSelectedUpstreamBaseURL:
-		return m.SelectedUpstreamBaseURL()
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSelectedUpstreamBaseURL(v)
+		return nil
 	case tracelog.FieldSelectedUpstreamProviderPreset:
-		return m.SelectedUpstreamProviderPreset()
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSelectedUpstreamProviderPreset(v)
+		return nil
 	case tracelog.FieldRoutingPolicy:
-		return m.RoutingPolicy()
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoutingPolicy(v)
+		return nil
 	case tracelog.FieldRoutingScore:
-		return m.RoutingScore()
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoutingScore(v)
+		return nil
 	case tracelog.FieldRoutingCandidateCount:
-		return m.RoutingCandidateCount()
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoutingCandidateCount(v)
+		return nil
 	case tracelog.FieldRoutingFailureReason:
-		return m.RoutingFailureReason()
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoutingFailureReason(v)
+		return nil
+	case tracelog.FieldTokenName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenName(v)
+		return nil
+	case tracelog.FieldClientCanceled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientCanceled(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TraceLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TraceLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addmod_time_ns != nil {
+		fields = append(fields, tracelog.FieldModTimeNs)
+	}
+	if m.addfile_size != nil {
+		fields = append(fields, tracelog.FieldFileSize)
+	}
+	if m.addstatus_code != nil {
+		fields = append(fields, tracelog.FieldStatusCode)
+	}
+	if m.addduration_ms != nil {
+		fields = append(fields, tracelog.FieldDurationMs)
+	}
+	if m.addttft_ms != nil {
+		fields = append(fields, tracelog.FieldTtftMs)
+	}
+	if m.addcontent_length != nil {
+		fields = append(fields, tracelog.FieldContentLength)
+	}
+	if m.addprompt_tokens != nil {
+		fields = append(fields, tracelog.FieldPromptTokens)
+	}
+	if m.addcompletion_tokens != nil {
+		fields = append(fields, tracelog.FieldCompletionTokens)
+	}
+	if m.addtotal_tokens != nil {
+		fields = append(fields, tracelog.FieldTotalTokens)
+	}
+	if m.addcached_tokens != nil {
+		fields = append(fields, tracelog.FieldCachedTokens)
+	}
+	if m.addreq_header_len != nil {
+		fields = append(fields, tracelog.FieldReqHeaderLen)
+	}
+	if m.addreq_body_len != nil {
+		fields = append(fields, tracelog.FieldReqBodyLen)
 	}
-	return nil, false
+	if m.addres_header_len != nil {
+		fields = append(fields, tracelog.FieldResHeaderLen)
+	}
+	if m.addres_body_len != nil {
+		fields = append(fields, tracelog.FieldResBodyLen)
+	}
+	if m.addrouting_score != nil {
+		fields = append(fields, tracelog.FieldRoutingScore)
+	}
+	if m.addrouting_candidate_count != nil {
+		fields = append(fields, tracelog.FieldRoutingCandidateCount)
+	}
+	return fields
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *TraceLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TraceLogMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case tracelog.FieldTraceID:
-		return m.OldTraceID(ctx)
 	case tracelog.FieldModTimeNs:
-		return m.OldModTimeNs(ctx)
+		return m.AddedModTimeNs()
 	case tracelog.FieldFileSize:
-		return m.OldFileSize(ctx)
-	case tracelog.FieldVersion:
-		return m.OldVersion(ctx)
-	case tracelog.FieldRequestID:
-		return m.OldRequestID(ctx)
-	case tracelog.FieldRecordedAt:
-		return m.OldRecordedAt(ctx)
-	case tracelog.FieldModel:
-		return m.OldModel(ctx)
-	case tracelog.FieldProvider:
-		return m.OldProvider(ctx)
-	case tracelog.FieldOperation:
-		return m.OldOperation(ctx)
-	case tracelog.FieldEndpoint:
-		return m.OldEndpoint(ctx)
-	case tracelog.FieldURL:
-		return m.OldURL(ctx)
-	case tracelog.FieldMethod:
-		return m.OldMethod(ctx)
+		return m.AddedFileSize()
 	case tracelog.FieldStatusCode:
-		return m.OldStatusCode(ctx)
+		return m.AddedStatusCode()
 	case tracelog.FieldDurationMs:
-		return m.OldDurationMs(ctx)
+		return m.AddedDurationMs()
 	case tracelog.FieldTtftMs:
-		return m.OldTtftMs(ctx)
-	case tracelog.FieldClientIP:
-		return m.OldClientIP(ctx)
+		return m.AddedTtftMs()
 	case tracelog.FieldContentLength:
-		return m.OldContentLength(ctx)
-	case tracelog.FieldErrorText:
-		return m.OldErrorText(ctx)
+		return m.AddedContentLength()
 	case tracelog.FieldPromptTokens:
-		return m.OldPromptTokens(ctx)
+		return m.AddedPromptTokens()
 	case tracelog.FieldCompletionTokens:
-		return m.OldCompletionTokens(ctx)
+		return m.AddedCompletionTokens()
 	case tracelog.FieldTotalTokens:
-		return m.OldTotalTokens(ctx)
+		return m.AddedTotalTokens()
 	case tracelog.FieldCachedTokens:
-		return m.OldCachedTokens(ctx)
+		return m.AddedCachedTokens()
 	case tracelog.FieldReqHeaderLen:
-		return m.OldReqHeaderLen(ctx)
+		return m.AddedReqHeaderLen()
 	case tracelog.FieldReqBodyLen:
-		return m.OldReqBodyLen(ctx)
+		return m.AddedReqBodyLen()
 	case tracelog.FieldResHeaderLen:
-		return m.OldResHeaderLen(ctx)
+		return m.AddedResHeaderLen()
 	case tracelog.FieldResBodyLen:
-		return m.OldResBodyLen(ctx)
-	case tracelog.FieldIsStream:
-		return m.OldIsStream(ctx)
-	case tracelog.FieldSessionID:
-		return m.OldSessionID(ctx)
-	case tracelog.FieldSessionSource:
-		return m.OldSessionSource(ctx)
-	case tracelog.FieldWindowID:
-		return m.OldWindowID(ctx)
-	case tracelog.FieldClientRequestID:
-		return m.OldClientRequestID(ctx)
-	case tracelog.FieldSelectedUpstreamID:
-		return m.OldSelectedUpstreamID(ctx)
-	case tracelog.FieldSelectedUpstreamBaseURL:
-		return m.OldSelectedUpstreamBaseURL(ctx)
-	case tracelog.FieldSelectedUpstreamProviderPreset:
-		return m.OldSelectedUpstreamProviderPreset(ctx)
-	case tracelog.FieldRoutingPolicy:
-		return m.OldRoutingPolicy(ctx)
+		return m.AddedResBodyLen()
 	case tracelog.FieldRoutingScore:
-		return m.OldRoutingScore(ctx)
+		return m.AddedRoutingScore()
 	case tracelog.FieldRoutingCandidateCount:
-		return m.OldRoutingCandidateCount(ctx)
-	case tracelog.FieldRoutingFailureReason:
-		return m.OldRoutingFailureReason(ctx)
+		return m.AddedRoutingCandidateCount()
 	}
-	return nil, fmt.Errorf("unknown TraceLog field %s", name)
+	return nil, false
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
+// AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TraceLogMutation) SetField(name string, value ent.Value) error {
+func (m *TraceLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case tracelog.FieldTraceID:
-		v, ok := value.(string)
+	case tracelog.FieldModTimeNs:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTraceID(v)
+		m.AddModTimeNs(v)
 		return nil
-	case tracelog.FieldModTimeNs:
+	case tracelog.FieldFileSize:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModTimeNs(v)
+		m.AddFileSize(v)
 		return nil
-	case tracelog.FieldFileSize:
+	case tracelog.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatusCode(v)
+		return nil
+	case tracelog.FieldDurationMs:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileSize(v)
+		m.AddDurationMs(v)
 		return nil
-	case tracelog.FieldVersion:
-		v, ok := value.(string)
+	case tracelog.FieldTtftMs:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersion(v)
+		m.AddTtftMs(v)
 		return nil
-	case tracelog.FieldRequestID:
-		v, ok := value.(string)
+	case tracelog.FieldContentLength:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRequestID(v)
+		m.AddContentLength(v)
 		return nil
-	case tracelog.FieldRecordedAt:
-		v, ok := value.(time.Time)
+	case tracelog.FieldPromptTokens:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRecordedAt(v)
+		m.AddPromptTokens(v)
 		return nil
-	case tracelog.FieldModel:
-		v, ok := value.(string)
+	case tracelog.FieldCompletionTokens:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModel(v)
+		m.AddCompletionTokens(v)
 		return nil
-	case tracelog.FieldProvider:
-		v, ok := value.(string)
+	case tracelog.FieldTotalTokens:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProvider(v)
+		m.AddTotalTokens(v)
 		return nil
-	case tracelog.FieldOperation:
-		v, ok := value.(string)
+	case tracelog.FieldCachedTokens:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOperation(v)
+		m.AddCachedTokens(v)
 		return nil
-	case tracelog.FieldEndpoint:
-		v, ok := value.(string)
+	case tracelog.FieldReqHeaderLen:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndpoint(v)
+		m.AddReqHeaderLen(v)
+		return nil
+	case tracelog.FieldReqBodyLen:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReqBodyLen(v)
+		return nil
+	case tracelog.FieldResHeaderLen:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResHeaderLen(v)
+		return nil
+	case tracelog.FieldResBodyLen:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResBodyLen(v)
+		return nil
+	case tracelog.FieldRoutingScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRoutingScore(v)
+		return nil
+	case tracelog.FieldRoutingCandidateCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRoutingCandidateCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TraceLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TraceLogMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TraceLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TraceLogMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TraceLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TraceLogMutation) ResetField(name string) error {
+	switch name {
+	case tracelog.FieldTraceID:
+		m.ResetTraceID()
+		return nil
+	case tracelog.FieldModTimeNs:
+		m.ResetModTimeNs()
+		return nil
+	case tracelog.FieldFileSize:
+		m.ResetFileSize()
+		return nil
+	case tracelog.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case tracelog.FieldRequestID:
+		m.ResetRequestID()
+		return nil
+	case tracelog.FieldRecordedAt:
+		m.ResetRecordedAt()
+		return nil
+	case tracelog.FieldModel:
+		m.ResetModel()
+		return nil
+	case tracelog.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case tracelog.FieldOperation:
+		m.ResetOperation()
+		return nil
+	case tracelog.FieldEndpoint:
+		m.ResetEndpoint()
 		return nil
 	case tracelog.FieldURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetURL(v)
+		m.ResetURL()
 		return nil
 	case tracelog.FieldMethod:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMethod(v)
+		m.ResetMethod()
 		return nil
 	case tracelog.FieldStatusCode:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatusCode(v)
+		m.ResetStatusCode()
 		return nil
 	case tracelog.FieldDurationMs:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDurationMs(v)
+		m.ResetDurationMs()
 		return nil
 	case tracelog.FieldTtftMs:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTtftMs(v)
+		m.ResetTtftMs()
 		return nil
 	case tracelog.FieldClientIP:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetClientIP(v)
+		m.ResetClientIP()
 		return nil
 	case tracelog.FieldContentLength:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContentLength(v)
+		m.ResetContentLength()
 		return nil
 	case tracelog.FieldErrorText:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetErrorText(v)
+		m.ResetErrorText()
 		return nil
 	case tracelog.FieldPromptTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPromptTokens(v)
+		m.ResetPromptTokens()
 		return nil
 	case tracelog.FieldCompletionTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletionTokens(v)
+		m.ResetCompletionTokens()
 		return nil
 	case tracelog.FieldTotalTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalTokens(v)
+		m.ResetTotalTokens()
 		return nil
 	case tracelog.FieldCachedTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCachedTokens(v)
+		m.ResetCachedTokens()
 		return nil
 	case tracelog.FieldReqHeaderLen:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetReqHeaderLen(v)
+		m.ResetReqHeaderLen()
 		return nil
 	case tracelog.FieldReqBodyLen:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetReqBodyLen(v)
+		m.ResetReqBodyLen()
 		return nil
 	case tracelog.FieldResHeaderLen:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetResHeaderLen(v)
+		m.ResetResHeaderLen()
 		return nil
 	case tracelog.FieldResBodyLen:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetResBodyLen(v)
+		m.ResetResBodyLen()
 		return nil
 	case tracelog.FieldIsStream:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsStream(v)
+		m.ResetIsStream()
 		return nil
 	case tracelog.FieldSessionID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSessionID(v)
+		m.ResetSessionID()
 		return nil
 	case tracelog.FieldSessionSource:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSessionSource(v)
+		m.ResetSessionSource()
 		return nil
 	case tracelog.FieldWindowID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWindowID(v)
+		m.ResetWindowID()
 		return nil
 	case tracelog.FieldClientRequestID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetClientRequestID(v)
+		m.ResetClientRequestID()
 		return nil
 	case tracelog.FieldSelectedUpstreamID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSelectedUpstreamID(v)
+		m.ResetSelectedUpstreamID()
 		return nil
 	case tracelog.FieldSelectedUpstreamBaseURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSelectedUpstreamBaseURL(v)
+		m.ResetSelectedUpstreamBaseURL()
 		return nil
 	case tracelog.FieldSelectedUpstreamProviderPreset:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSelectedUpstreamProviderPreset(v)
+		m.ResetSelectedUpstreamProviderPreset()
 		return nil
 	case tracelog.FieldRoutingPolicy:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRoutingPolicy(v)
+		m.ResetRoutingPolicy()
 		return nil
 	case tracelog.FieldRoutingScore:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRoutingScore(v)
+		m.ResetRoutingScore()
 		return nil
 	case tracelog.FieldRoutingCandidateCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		m.ResetRoutingCandidateCount()
+		return nil
+	case tracelog.FieldRoutingFailureReason:
+		m.ResetRoutingFailureReason()
+		return nil
+	case tracelog.FieldTokenName:
+		m.ResetTokenName()
+		return nil
+	case tracelog.FieldClientCanceled:
+		m.ResetClientCanceled()
+		return nil
+	}
+	return fmt.Errorf("unknown TraceLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TraceLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TraceLogMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TraceLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TraceLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TraceLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TraceLogMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TraceLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TraceLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TraceLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TraceLog edge %s", name)
+}
+
+// TraceTagMutation represents an operation that mutates the TraceTag nodes in the graph.
+type TraceTagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	target_type   *string
+	target_id     *string
+	tag           *string
+	created_by    *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TraceTag, error)
+	predicates    []predicate.TraceTag
+}
+
+var _ ent.Mutation = (*TraceTagMutation)(nil)
+
+// tracetagOption allows management of the mutation configuration using functional options.
+type tracetagOption func(*TraceTagMutation)
+
+// newTraceTagMutation creates new mutation for the TraceTag entity.
+func newTraceTagMutation(c config, op Op, opts ...tracetagOption) *TraceTagMutation {
+	m := &TraceTagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTraceTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTraceTagID sets the ID field of the mutation.
+func withTraceTagID(id int) tracetagOption {
+	return func(m *TraceTagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TraceTag
+		)
+		m.oldValue = func(ctx context.Context) (*TraceTag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TraceTag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTraceTag sets the old TraceTag of the mutation.
+func withTraceTag(node *TraceTag) tracetagOption {
+	return func(m *TraceTagMutation) {
+		m.oldValue = func(context.Context) (*TraceTag, error) {
+			return node, nil
 		}
-		m.SetRoutingCandidateCount(v)
-		return nil
-	case tracelog.FieldRoutingFailureReason:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TraceTagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TraceTagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("dao: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TraceTagMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TraceTagMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
 		}
-		m.SetRoutingFailureReason(v)
-		return nil
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TraceTag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return fmt.Errorf("unknown TraceLog field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *TraceLogMutation) AddedFields() []string {
-	var fields []string
-	if m.addmod_time_ns != nil {
-		fields = append(fields, tracelog.FieldModTimeNs)
+// SetTargetType sets the "target_type" field.
+func (m *TraceTagMutation) SetTargetType(s string) {
+	m.target_type = &s
+}
+
+// TargetType returns the value of the "target_type" field in the mutation.
+func (m *TraceTagMutation) TargetType() (r string, exists bool) {
+	v := m.target_type
+	if v == nil {
+		return
 	}
-	if m.addfile_size != nil {
-		fields = append(fields, tracelog.FieldFileSize)
+	return *v, true
+}
+
+// OldTargetType returns the old "target_type" field's value of the TraceTag entity.
+// If the TraceTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TraceTagMutation) OldTargetType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetType is only allowed on UpdateOne operations")
 	}
-	if m.addstatus_code != nil {
-		fields = append(fields, tracelog.FieldStatusCode)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetType requires an ID field in the mutation")
 	}
-	if m.addduration_ms != nil {
-		fields = append(fields, tracelog.FieldDurationMs)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetType: %w", err)
 	}
-	if m.addttft_ms != nil {
-		fields = append(fields, tracelog.FieldTtftMs)
+	return oldValue.TargetType, nil
+}
+
+// ResetTargetType resets all changes to the "target_type" field.
+func (m *TraceTagMutation) ResetTargetType() {
+	m.target_type = nil
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *TraceTagMutation) SetTargetID(s string) {
+	m.target_id = &s
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *TraceTagMutation) TargetID() (r string, exists bool) {
+	v := m.target_id
+	if v == nil {
+		return
 	}
-	if m.addcontent_length != nil {
-		fields = append(fields, tracelog.FieldContentLength)
+	return *v, true
+}
+
+// OldTargetID returns the old "target_id" field's value of the TraceTag entity.
+// If the TraceTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TraceTagMutation) OldTargetID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
 	}
-	if m.addprompt_tokens != nil {
-		fields = append(fields, tracelog.FieldPromptTokens)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
 	}
-	if m.addcompletion_tokens != nil {
-		fields = append(fields, tracelog.FieldCompletionTokens)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
 	}
-	if m.addtotal_tokens != nil {
-		fields = append(fields, tracelog.FieldTotalTokens)
+	return oldValue.TargetID, nil
+}
+
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *TraceTagMutation) ResetTargetID() {
+	m.target_id = nil
+}
+
+// SetTag sets the "tag" field.
+func (m *TraceTagMutation) SetTag(s string) {
+	m.tag = &s
+}
+
+// Tag returns the value of the "tag" field in the mutation.
+func (m *TraceTagMutation) Tag() (r string, exists bool) {
+	v := m.tag
+	if v == nil {
+		return
 	}
-	if m.addcached_tokens != nil {
-		fields = append(fields, tracelog.FieldCachedTokens)
+	return *v, true
+}
+
+// OldTag returns the old "tag" field's value of the TraceTag entity.
+// If the TraceTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TraceTagMutation) OldTag(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTag is only allowed on UpdateOne operations")
 	}
-	if m.addreq_header_len != nil {
-		fields = append(fields, tracelog.FieldReqHeaderLen)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTag requires an ID field in the mutation")
 	}
-	if m.addreq_body_len != nil {
-		fields = append(fields, tracelog.FieldReqBodyLen)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTag: %w", err)
 	}
-	if m.addres_header_len != nil {
-		fields = append(fields, tracelog.FieldResHeaderLen)
+	return oldValue.Tag, nil
+}
+
+// ResetTag resets all changes to the "tag" field.
+func (m *TraceTagMutation) ResetTag() {
+	m.tag = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *TraceTagMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *TraceTagMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
 	}
-	if m.addres_body_len != nil {
-		fields = append(fields, tracelog.FieldResBodyLen)
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the TraceTag entity.
+// If the TraceTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TraceTagMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
-	if m.addrouting_score != nil {
-		fields = append(fields, tracelog.FieldRoutingScore)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
-	if m.addrouting_candidate_count != nil {
-		fields = append(fields, tracelog.FieldRoutingCandidateCount)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *TraceTagMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TraceTagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TraceTagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TraceTag entity.
+// If the TraceTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TraceTagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TraceTagMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the TraceTagMutation builder.
+func (m *TraceTagMutation) Where(ps ...predicate.TraceTag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TraceTagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TraceTagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TraceTag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TraceTagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TraceTagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TraceTag).
+func (m *TraceTagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TraceTagMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.target_type != nil {
+		fields = append(fields, tracetag.FieldTargetType)
+	}
+	if m.target_id != nil {
+		fields = append(fields, tracetag.FieldTargetID)
+	}
+	if m.tag != nil {
+		fields = append(fields, tracetag.FieldTag)
+	}
+	if m.created_by != nil {
+		fields = append(fields, tracetag.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tracetag.FieldCreatedAt)
 	}
 	return fields
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *TraceLogMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case tracelog.FieldModTimeNs:
-		return m.AddedModTimeNs()
-	case tracelog.FieldFileSize:
-		return m.AddedFileSize()
-	case tracelog.FieldStatusCode:
-		return m.AddedStatusCode()
-	case tracelog.FieldDurationMs:
-		return m.AddedDurationMs()
-	case tracelog.FieldTtftMs:
-		return m.AddedTtftMs()
-	case tracelog.FieldContentLength:
-		return m.AddedContentLength()
-	case tracelog.FieldPromptTokens:
-		return m.AddedPromptTokens()
-	case tracelog.FieldCompletionTokens:
-		return m.AddedCompletionTokens()
-	case tracelog.FieldTotalTokens:
-		return m.AddedTotalTokens()
-	case tracelog.FieldCachedTokens:
-		return m.AddedCachedTokens()
-	case tracelog.FieldReqHeaderLen:
-		return m.AddedReqHeaderLen()
-	case tracelog.FieldReqBodyLen:
-		return m.AddedReqBodyLen()
-	case tracelog.FieldResHeaderLen:
-		return m.AddedResHeaderLen()
-	case tracelog.FieldResBodyLen:
-		return m.AddedResBodyLen()
-	case tracelog.FieldRoutingScore:
-		return m.AddedRoutingScore()
-	case tracelog.FieldRoutingCandidateCount:
-		return m.AddedRoutingCandidateCount()
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TraceTagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tracetag.FieldTargetType:
+		return m.TargetType()
+	case tracetag.FieldTargetID:
+		return m.TargetID()
+	case tracetag.FieldTag:
+		return m.Tag()
+	case tracetag.FieldCreatedBy:
+		return m.CreatedBy()
+	case tracetag.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TraceTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tracetag.FieldTargetType:
+		return m.OldTargetType(ctx)
+	case tracetag.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case tracetag.FieldTag:
+		return m.OldTag(ctx)
+	case tracetag.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case tracetag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TraceTag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TraceLogMutation) AddField(name string, value ent.Value) error {
+func (m *TraceTagMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tracelog.FieldModTimeNs:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddModTimeNs(v)
-		return nil
-	case tracelog.FieldFileSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFileSize(v)
-		return nil
-	case tracelog.FieldStatusCode:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStatusCode(v)
-		return nil
-	case tracelog.FieldDurationMs:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDurationMs(v)
-		return nil
-	case tracelog.FieldTtftMs:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTtftMs(v)
-		return nil
-	case tracelog.FieldContentLength:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddContentLength(v)
-		return nil
-	case tracelog.FieldPromptTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPromptTokens(v)
-		return nil
-	case tracelog.FieldCompletionTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCompletionTokens(v)
-		return nil
-	case tracelog.FieldTotalTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTotalTokens(v)
-		return nil
-	case tracelog.FieldCachedTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCachedTokens(v)
-		return nil
-	case tracelog.FieldReqHeaderLen:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddReqHeaderLen(v)
-		return nil
-	case tracelog.FieldReqBodyLen:
-		v, ok := value.(int64)
+	case tracetag.FieldTargetType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddReqBodyLen(v)
+		m.SetTargetType(v)
 		return nil
-	case tracelog.FieldResHeaderLen:
-		v, ok := value.(int64)
+	case tracetag.FieldTargetID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddResHeaderLen(v)
+		m.SetTargetID(v)
 		return nil
-	case tracelog.FieldResBodyLen:
-		v, ok := value.(int64)
+	case tracetag.FieldTag:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddResBodyLen(v)
+		m.SetTag(v)
 		return nil
-	case tracelog.FieldRoutingScore:
-		v, ok := value.(float64)
+	case tracetag.FieldCreatedBy:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRoutingScore(v)
+		m.SetCreatedBy(v)
 		return nil
-	case tracelog.FieldRoutingCandidateCount:
-		v, ok := value.(int)
+	case tracetag.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRoutingCandidateCount(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TraceLog numeric field %s", name)
+	return fmt.Errorf("unknown TraceTag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TraceTagMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TraceTagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TraceTagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TraceTag numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TraceLogMutation) ClearedFields() []string {
+func (m *TraceTagMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TraceLogMutation) FieldCleared(name string) bool {
+func (m *TraceTagMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TraceLogMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown TraceLog nullable field %s", name)
+func (m *TraceTagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TraceTag nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TraceLogMutation) ResetField(name string) error {
+func (m *TraceTagMutation) ResetField(name string) error {
 	switch name {
-	case tracelog.FieldTraceID:
-		m.ResetTraceID()
-		return nil
-	case tracelog.FieldModTimeNs:
-		m.ResetModTimeNs()
-		return nil
-	case tracelog.FieldFileSize:
-		m.ResetFileSize()
-		return nil
-	case tracelog.FieldVersion:
-		m.ResetVersion()
-		return nil
-	case tracelog.FieldRequestID:
-		m.ResetRequestID()
-		return nil
-	case tracelog.FieldRecordedAt:
-		m.ResetRecordedAt()
-		return nil
-	case tracelog.FieldModel:
-		m.ResetModel()
-		return nil
-	case tracelog.FieldProvider:
-		m.ResetProvider()
-		return nil
-	case tracelog.FieldOperation:
-		m.ResetOperation()
-		return nil
-	case tracelog.FieldEndpoint:
-		m.ResetEndpoint()
-		return nil
-	case tracelog.FieldURL:
-		m.ResetURL()
-		return nil
-	case tracelog.FieldMethod:
-		m.ResetMethod()
-		return nil
-	case tracelog.FieldStatusCode:
-		m.ResetStatusCode()
-		return nil
-	case tracelog.FieldDurationMs:
-		m.ResetDurationMs()
-		return nil
-	case tracelog.FieldTtftMs:
-		m.ResetTtftMs()
-		return nil
-	case tracelog.FieldClientIP:
-		m.ResetClientIP()
-		return nil
-	case tracelog.FieldContentLength:
-		m.ResetContentLength()
-		return nil
-	case tracelog.FieldErrorText:
-		m.ResetErrorText()
-		return nil
-	case tracelog.FieldPromptTokens:
-		m.ResetPromptTokens()
-		return nil
-	case tracelog.FieldCompletionTokens:
-		m.ResetCompletionTokens()
-		return nil
-	case tracelog.FieldTotalTokens:
-		m.ResetTotalTokens()
-		return nil
-	case tracelog.FieldCachedTokens:
-		m.ResetCachedTokens()
-		return nil
-	case tracelog.FieldReqHeaderLen:
-		m.ResetReqHeaderLen()
-		return nil
-	case tracelog.FieldReqBodyLen:
-		m.ResetReqBodyLen()
-		return nil
-	case tracelog.FieldResHeaderLen:
-		m.ResetResHeaderLen()
-		return nil
-	case tracelog.FieldResBodyLen:
-		m.ResetResBodyLen()
-		return nil
-	case tracelog.FieldIsStream:
-		m.ResetIsStream()
-		return nil
-	case tracelog.FieldSessionID:
-		m.ResetSessionID()
-		return nil
-	case tracelog.FieldSessionSource:
-		m.ResetSessionSource()
-		return nil
-	case tracelog.FieldWindowID:
-		m.ResetWindowID()
+	case tracetag.FieldTargetType:
+		m.ResetTargetType()
 		return nil
-	case tracelog.FieldClientRequestID:
-		m.ResetClientRequestID()
-		return nil
-	case tracelog.FieldSelectedUpstreamID:
-		m.ResetSelectedUpstreamID()
-		return nil
-	case tracelog.FieldSelectedUpstreamBaseURL:
-		m.ResetSelectedUpstreamBaseURL()
+	case tracetag.FieldTargetID:
+		m.ResetTargetID()
 		return nil
-	case tracelog.FieldSelectedUpstreamProviderPreset:
-		m.ResetSelectedUpstreamProviderPreset()
-		return nil
-	case tracelog.FieldRoutingPolicy:
-		m.ResetRoutingPolicy()
-		return nil
-	case tracelog.FieldRoutingScore:
-		m.ResetRoutingScore()
+	case tracetag.FieldTag:
+		m.ResetTag()
 		return nil
-	case tracelog.FieldRoutingCandidateCount:
-		m.ResetRoutingCandidateCount()
+	case tracetag.FieldCreatedBy:
+		m.ResetCreatedBy()
 		return nil
-	case tracelog.FieldRoutingFailureReason:
-		m.ResetRoutingFailureReason()
+	case tracetag.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TraceLog field %s", name)
+	return fmt.Errorf("unknown TraceTag field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TraceLogMutation) AddedEdges() []string {
+func (m *TraceTagMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TraceLogMutation) AddedIDs(name string) []ent.Value {
+func (m *TraceTagMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TraceLogMutation) RemovedEdges() []string {
+func (m *TraceTagMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TraceLogMutation) RemovedIDs(name string) []ent.Value {
+func (m *TraceTagMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TraceLogMutation) ClearedEdges() []string {
+func (m *TraceTagMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TraceLogMutation) EdgeCleared(name string) bool {
+func (m *TraceTagMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TraceLogMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown TraceLog unique edge %s", name)
+func (m *TraceTagMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TraceTag unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TraceLogMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown TraceLog edge %s", name)
+func (m *TraceTagMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TraceTag edge %s", name)
 }
 
 // UpstreamModelMutation represents an operation that mutates the UpstreamModel nodes in the graph.
@@ -9,6 +9,9 @@ import (
 // APIToken is the predicate function for apitoken builders.
 type APIToken func(*sql.Selector)
 
+// Annotation is the predicate function for annotation builders.
+type Annotation func(*sql.Selector)
+
 // ChannelConfig is the predicate function for channelconfig builders.
 type ChannelConfig func(*sql.Selector)
 
@@ -24,6 +27,12 @@ type Dataset func(*sql.Selector)
 // DatasetExample is the predicate function for datasetexample builders.
 type DatasetExample func(*sql.Selector)
 
+// DatasetExampleOverride is the predicate function for datasetexampleoverride builders.
+type DatasetExampleOverride func(*sql.Selector)
+
+// DatasetSnapshot is the predicate function for datasetsnapshot builders.
+type DatasetSnapshot func(*sql.Selector)
+
 // EvalRun is the predicate function for evalrun builders.
 type EvalRun func(*sql.Selector)
 
@@ -39,6 +48,9 @@ type Score func(*sql.Selector)
 // TraceLog is the predicate function for tracelog builders.
 type TraceLog func(*sql.Selector)
 
+// TraceTag is the predicate function for tracetag builders.
+type TraceTag func(*sql.Selector)
+
 // UpstreamModel is the predicate function for upstreammodel builders.
 type UpstreamModel func(*sql.Selector)
 
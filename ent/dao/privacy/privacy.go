@@ -135,6 +135,30 @@ func (f APITokenMutationRuleFunc) EvalMutation(ctx context.Context, m dao.Mutati
 	return Denyf("dao/privacy: unexpected mutation type %T, expect *dao.APITokenMutation", m)
 }
 
+// The AnnotationQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AnnotationQueryRuleFunc func(context.Context, *dao.AnnotationQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AnnotationQueryRuleFunc) EvalQuery(ctx context.Context, q dao.Query) error {
+	if q, ok := q.(*dao.AnnotationQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("dao/privacy: unexpected query type %T, expect *dao.AnnotationQuery", q)
+}
+
+// The AnnotationMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AnnotationMutationRuleFunc func(context.Context, *dao.AnnotationMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AnnotationMutationRuleFunc) EvalMutation(ctx context.Context, m dao.Mutation) error {
+	if m, ok := m.(*dao.AnnotationMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("dao/privacy: unexpected mutation type %T, expect *dao.AnnotationMutation", m)
+}
+
 // The ChannelConfigQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type ChannelConfigQueryRuleFunc func(context.Context, *dao.ChannelConfigQuery) error
@@ -255,6 +279,54 @@ func (f DatasetExampleMutationRuleFunc) EvalMutation(ctx context.Context, m dao.
 	return Denyf("dao/privacy: unexpected mutation type %T, expect *dao.DatasetExampleMutation", m)
 }
 
+// The DatasetExampleOverrideQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DatasetExampleOverrideQueryRuleFunc func(context.Context, *dao.DatasetExampleOverrideQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DatasetExampleOverrideQueryRuleFunc) EvalQuery(ctx context.Context, q dao.Query) error {
+	if q, ok := q.(*dao.DatasetExampleOverrideQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("dao/privacy: unexpected query type %T, expect *dao.DatasetExampleOverrideQuery", q)
+}
+
+// The DatasetExampleOverrideMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DatasetExampleOverrideMutationRuleFunc func(context.Context, *dao.DatasetExampleOverrideMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DatasetExampleOverrideMutationRuleFunc) EvalMutation(ctx context.Context, m dao.Mutation) error {
+	if m, ok := m.(*dao.DatasetExampleOverrideMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("dao/privacy: unexpected mutation type %T, expect *dao.DatasetExampleOverrideMutation", m)
+}
+
+// The DatasetSnapshotQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DatasetSnapshotQueryRuleFunc func(context.Context, *dao.DatasetSnapshotQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DatasetSnapshotQueryRuleFunc) EvalQuery(ctx context.Context, q dao.Query) error {
+	if q, ok := q.(*dao.DatasetSnapshotQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("dao/privacy: unexpected query type %T, expect *dao.DatasetSnapshotQuery", q)
+}
+
+// The DatasetSnapshotMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DatasetSnapshotMutationRuleFunc func(context.Context, *dao.DatasetSnapshotMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DatasetSnapshotMutationRuleFunc) EvalMutation(ctx context.Context, m dao.Mutation) error {
+	if m, ok := m.(*dao.DatasetSnapshotMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("dao/privacy: unexpected mutation type %T, expect *dao.DatasetSnapshotMutation", m)
+}
+
 // The EvalRunQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type EvalRunQueryRuleFunc func(context.Context, *dao.EvalRunQuery) error
@@ -375,6 +447,30 @@ func (f TraceLogMutationRuleFunc) EvalMutation(ctx context.Context, m dao.Mutati
 	return Denyf("dao/privacy: unexpected mutation type %T, expect *dao.TraceLogMutation", m)
 }
 
+// The TraceTagQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TraceTagQueryRuleFunc func(context.Context, *dao.TraceTagQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TraceTagQueryRuleFunc) EvalQuery(ctx context.Context, q dao.Query) error {
+	if q, ok := q.(*dao.TraceTagQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("dao/privacy: unexpected query type %T, expect *dao.TraceTagQuery", q)
+}
+
+// The TraceTagMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TraceTagMutationRuleFunc func(context.Context, *dao.TraceTagMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TraceTagMutationRuleFunc) EvalMutation(ctx context.Context, m dao.Mutation) error {
+	if m, ok := m.(*dao.TraceTagMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("dao/privacy: unexpected mutation type %T, expect *dao.TraceTagMutation", m)
+}
+
 // The UpstreamModelQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type UpstreamModelQueryRuleFunc func(context.Context, *dao.UpstreamModelQuery) error
@@ -484,6 +580,8 @@ func queryFilter(q dao.Query) (Filter, error) {
 	switch q := q.(type) {
 	case *dao.APITokenQuery:
 		return q.Filter(), nil
+	case *dao.AnnotationQuery:
+		return q.Filter(), nil
 	case *dao.ChannelConfigQuery:
 		return q.Filter(), nil
 	case *dao.ChannelModelQuery:
@@ -494,6 +592,10 @@ func queryFilter(q dao.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *dao.DatasetExampleQuery:
 		return q.Filter(), nil
+	case *dao.DatasetExampleOverrideQuery:
+		return q.Filter(), nil
+	case *dao.DatasetSnapshotQuery:
+		return q.Filter(), nil
 	case *dao.EvalRunQuery:
 		return q.Filter(), nil
 	case *dao.ExperimentRunQuery:
@@ -504,6 +606,8 @@ func queryFilter(q dao.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *dao.TraceLogQuery:
 		return q.Filter(), nil
+	case *dao.TraceTagQuery:
+		return q.Filter(), nil
 	case *dao.UpstreamModelQuery:
 		return q.Filter(), nil
 	case *dao.UpstreamTargetQuery:
@@ -519,6 +623,8 @@ func mutationFilter(m dao.Mutation) (Filter, error) {
 	switch m := m.(type) {
 	case *dao.APITokenMutation:
 		return m.Filter(), nil
+	case *dao.AnnotationMutation:
+		return m.Filter(), nil
 	case *dao.ChannelConfigMutation:
 		return m.Filter(), nil
 	case *dao.ChannelModelMutation:
@@ -529,6 +635,10 @@ func mutationFilter(m dao.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *dao.DatasetExampleMutation:
 		return m.Filter(), nil
+	case *dao.DatasetExampleOverrideMutation:
+		return m.Filter(), nil
+	case *dao.DatasetSnapshotMutation:
+		return m.Filter(), nil
 	case *dao.EvalRunMutation:
 		return m.Filter(), nil
 	case *dao.ExperimentRunMutation:
@@ -539,6 +649,8 @@ func mutationFilter(m dao.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *dao.TraceLogMutation:
 		return m.Filter(), nil
+	case *dao.TraceTagMutation:
+		return m.Filter(), nil
 	case *dao.UpstreamModelMutation:
 		return m.Filter(), nil
 	case *dao.UpstreamTargetMutation:
@@ -5,17 +5,21 @@ package dao
 import (
 	"time"
 
+	"github.com/kingfs/llm-tracelab/ent/dao/annotation"
 	"github.com/kingfs/llm-tracelab/ent/dao/apitoken"
 	"github.com/kingfs/llm-tracelab/ent/dao/channelconfig"
 	"github.com/kingfs/llm-tracelab/ent/dao/channelmodel"
 	"github.com/kingfs/llm-tracelab/ent/dao/channelproberun"
 	"github.com/kingfs/llm-tracelab/ent/dao/dataset"
 	"github.com/kingfs/llm-tracelab/ent/dao/datasetexample"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetexampleoverride"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetsnapshot"
 	"github.com/kingfs/llm-tracelab/ent/dao/evalrun"
 	"github.com/kingfs/llm-tracelab/ent/dao/experimentrun"
 	"github.com/kingfs/llm-tracelab/ent/dao/modelcatalog"
 	"github.com/kingfs/llm-tracelab/ent/dao/score"
 	"github.com/kingfs/llm-tracelab/ent/dao/tracelog"
+	"github.com/kingfs/llm-tracelab/ent/dao/tracetag"
 	"github.com/kingfs/llm-tracelab/ent/dao/upstreammodel"
 	"github.com/kingfs/llm-tracelab/ent/dao/upstreamtarget"
 	"github.com/kingfs/llm-tracelab/ent/dao/user"
@@ -52,6 +56,40 @@ func init() {
 	apitokenDescCreatedAt := apitokenFields[5].Descriptor()
 	// apitoken.DefaultCreatedAt holds the default value on creation for the created_at field.
 	apitoken.DefaultCreatedAt = apitokenDescCreatedAt.Default.(func() time.Time)
+	annotationFields := schema.Annotation{}.Fields()
+	_ = annotationFields
+	// annotationDescTargetType is the schema descriptor for target_type field.
+	annotationDescTargetType := annotationFields[1].Descriptor()
+	// annotation.TargetTypeValidator is a validator for the "target_type" field. It is called by the builders before save.
+	annotation.TargetTypeValidator = annotationDescTargetType.Validators[0].(func(string) error)
+	// annotationDescTargetID is the schema descriptor for target_id field.
+	annotationDescTargetID := annotationFields[2].Descriptor()
+	// annotation.TargetIDValidator is a validator for the "target_id" field. It is called by the builders before save.
+	annotation.TargetIDValidator = annotationDescTargetID.Validators[0].(func(string) error)
+	// annotationDescParentID is the schema descriptor for parent_id field.
+	annotationDescParentID := annotationFields[3].Descriptor()
+	// annotation.DefaultParentID holds the default value on creation for the parent_id field.
+	annotation.DefaultParentID = annotationDescParentID.Default.(string)
+	// annotationDescAuthor is the schema descriptor for author field.
+	annotationDescAuthor := annotationFields[4].Descriptor()
+	// annotation.DefaultAuthor holds the default value on creation for the author field.
+	annotation.DefaultAuthor = annotationDescAuthor.Default.(string)
+	// annotationDescBody is the schema descriptor for body field.
+	annotationDescBody := annotationFields[5].Descriptor()
+	// annotation.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	annotation.BodyValidator = annotationDescBody.Validators[0].(func(string) error)
+	// annotationDescCreatedAt is the schema descriptor for created_at field.
+	annotationDescCreatedAt := annotationFields[6].Descriptor()
+	// annotation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	annotation.DefaultCreatedAt = annotationDescCreatedAt.Default.(func() time.Time)
+	// annotationDescUpdatedAt is the schema descriptor for updated_at field.
+	annotationDescUpdatedAt := annotationFields[7].Descriptor()
+	// annotation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	annotation.DefaultUpdatedAt = annotationDescUpdatedAt.Default.(func() time.Time)
+	// annotationDescID is the schema descriptor for id field.
+	annotationDescID := annotationFields[0].Descriptor()
+	// annotation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	annotation.IDValidator = annotationDescID.Validators[0].(func(string) error)
 	channelconfigFields := schema.ChannelConfig{}.Fields()
 	_ = channelconfigFields
 	// channelconfigDescName is the schema descriptor for name field.
@@ -294,6 +332,70 @@ func init() {
 	datasetexampleDescNote := datasetexampleFields[6].Descriptor()
 	// datasetexample.DefaultNote holds the default value on creation for the note field.
 	datasetexample.DefaultNote = datasetexampleDescNote.Default.(string)
+	datasetexampleoverrideFields := schema.DatasetExampleOverride{}.Fields()
+	_ = datasetexampleoverrideFields
+	// datasetexampleoverrideDescDatasetID is the schema descriptor for dataset_id field.
+	datasetexampleoverrideDescDatasetID := datasetexampleoverrideFields[0].Descriptor()
+	// datasetexampleoverride.DatasetIDValidator is a validator for the "dataset_id" field. It is called by the builders before save.
+	datasetexampleoverride.DatasetIDValidator = datasetexampleoverrideDescDatasetID.Validators[0].(func(string) error)
+	// datasetexampleoverrideDescTraceID is the schema descriptor for trace_id field.
+	datasetexampleoverrideDescTraceID := datasetexampleoverrideFields[1].Descriptor()
+	// datasetexampleoverride.TraceIDValidator is a validator for the "trace_id" field. It is called by the builders before save.
+	datasetexampleoverride.TraceIDValidator = datasetexampleoverrideDescTraceID.Validators[0].(func(string) error)
+	// datasetexampleoverrideDescExpectedOutput is the schema descriptor for expected_output field.
+	datasetexampleoverrideDescExpectedOutput := datasetexampleoverrideFields[2].Descriptor()
+	// datasetexampleoverride.DefaultExpectedOutput holds the default value on creation for the expected_output field.
+	datasetexampleoverride.DefaultExpectedOutput = datasetexampleoverrideDescExpectedOutput.Default.(string)
+	// datasetexampleoverrideDescTagsJSON is the schema descriptor for tags_json field.
+	datasetexampleoverrideDescTagsJSON := datasetexampleoverrideFields[3].Descriptor()
+	// datasetexampleoverride.DefaultTagsJSON holds the default value on creation for the tags_json field.
+	datasetexampleoverride.DefaultTagsJSON = datasetexampleoverrideDescTagsJSON.Default.(string)
+	// datasetexampleoverrideDescInputOverrideJSON is the schema descriptor for input_override_json field.
+	datasetexampleoverrideDescInputOverrideJSON := datasetexampleoverrideFields[4].Descriptor()
+	// datasetexampleoverride.DefaultInputOverrideJSON holds the default value on creation for the input_override_json field.
+	datasetexampleoverride.DefaultInputOverrideJSON = datasetexampleoverrideDescInputOverrideJSON.Default.(string)
+	// datasetexampleoverrideDescUpdatedBy is the schema descriptor for updated_by field.
+	datasetexampleoverrideDescUpdatedBy := datasetexampleoverrideFields[5].Descriptor()
+	// datasetexampleoverride.DefaultUpdatedBy holds the default value on creation for the updated_by field.
+	datasetexampleoverride.DefaultUpdatedBy = datasetexampleoverrideDescUpdatedBy.Default.(string)
+	// datasetexampleoverrideDescUpdatedAt is the schema descriptor for updated_at field.
+	datasetexampleoverrideDescUpdatedAt := datasetexampleoverrideFields[6].Descriptor()
+	// datasetexampleoverride.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	datasetexampleoverride.DefaultUpdatedAt = datasetexampleoverrideDescUpdatedAt.Default.(func() time.Time)
+	datasetsnapshotFields := schema.DatasetSnapshot{}.Fields()
+	_ = datasetsnapshotFields
+	// datasetsnapshotDescDatasetID is the schema descriptor for dataset_id field.
+	datasetsnapshotDescDatasetID := datasetsnapshotFields[1].Descriptor()
+	// datasetsnapshot.DatasetIDValidator is a validator for the "dataset_id" field. It is called by the builders before save.
+	datasetsnapshot.DatasetIDValidator = datasetsnapshotDescDatasetID.Validators[0].(func(string) error)
+	// datasetsnapshotDescVersion is the schema descriptor for version field.
+	datasetsnapshotDescVersion := datasetsnapshotFields[2].Descriptor()
+	// datasetsnapshot.DefaultVersion holds the default value on creation for the version field.
+	datasetsnapshot.DefaultVersion = datasetsnapshotDescVersion.Default.(int)
+	// datasetsnapshotDescNote is the schema descriptor for note field.
+	datasetsnapshotDescNote := datasetsnapshotFields[3].Descriptor()
+	// datasetsnapshot.DefaultNote holds the default value on creation for the note field.
+	datasetsnapshot.DefaultNote = datasetsnapshotDescNote.Default.(string)
+	// datasetsnapshotDescCreatedBy is the schema descriptor for created_by field.
+	datasetsnapshotDescCreatedBy := datasetsnapshotFields[4].Descriptor()
+	// datasetsnapshot.DefaultCreatedBy holds the default value on creation for the created_by field.
+	datasetsnapshot.DefaultCreatedBy = datasetsnapshotDescCreatedBy.Default.(string)
+	// datasetsnapshotDescExampleCount is the schema descriptor for example_count field.
+	datasetsnapshotDescExampleCount := datasetsnapshotFields[5].Descriptor()
+	// datasetsnapshot.DefaultExampleCount holds the default value on creation for the example_count field.
+	datasetsnapshot.DefaultExampleCount = datasetsnapshotDescExampleCount.Default.(int)
+	// datasetsnapshotDescExamplesJSON is the schema descriptor for examples_json field.
+	datasetsnapshotDescExamplesJSON := datasetsnapshotFields[6].Descriptor()
+	// datasetsnapshot.DefaultExamplesJSON holds the default value on creation for the examples_json field.
+	datasetsnapshot.DefaultExamplesJSON = datasetsnapshotDescExamplesJSON.Default.(string)
+	// datasetsnapshotDescCreatedAt is the schema descriptor for created_at field.
+	datasetsnapshotDescCreatedAt := datasetsnapshotFields[7].Descriptor()
+	// datasetsnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	datasetsnapshot.DefaultCreatedAt = datasetsnapshotDescCreatedAt.Default.(func() time.Time)
+	// datasetsnapshotDescID is the schema descriptor for id field.
+	datasetsnapshotDescID := datasetsnapshotFields[0].Descriptor()
+	// datasetsnapshot.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	datasetsnapshot.IDValidator = datasetsnapshotDescID.Validators[0].(func(string) error)
 	evalrunFields := schema.EvalRun{}.Fields()
 	_ = evalrunFields
 	// evalrunDescDatasetID is the schema descriptor for dataset_id field.
@@ -350,14 +452,6 @@ func init() {
 	experimentrunDescDescription := experimentrunFields[2].Descriptor()
 	// experimentrun.DefaultDescription holds the default value on creation for the description field.
 	experimentrun.DefaultDescription = experimentrunDescDescription.Default.(string)
-	// experimentrunDescBaselineEvalRunID is the schema descriptor for baseline_eval_run_id field.
-	experimentrunDescBaselineEvalRunID := experimentrunFields[3].Descriptor()
-	// experimentrun.BaselineEvalRunIDValidator is a validator for the "baseline_eval_run_id" field. It is called by the builders before save.
-	experimentrun.BaselineEvalRunIDValidator = experimentrunDescBaselineEvalRunID.Validators[0].(func(string) error)
-	// experimentrunDescCandidateEvalRunID is the schema descriptor for candidate_eval_run_id field.
-	experimentrunDescCandidateEvalRunID := experimentrunFields[4].Descriptor()
-	// experimentrun.CandidateEvalRunIDValidator is a validator for the "candidate_eval_run_id" field. It is called by the builders before save.
-	experimentrun.CandidateEvalRunIDValidator = experimentrunDescCandidateEvalRunID.Validators[0].(func(string) error)
 	// experimentrunDescCreatedAt is the schema descriptor for created_at field.
 	experimentrunDescCreatedAt := experimentrunFields[5].Descriptor()
 	// experimentrun.DefaultCreatedAt holds the default value on creation for the created_at field.
@@ -394,6 +488,14 @@ func init() {
 	experimentrunDescRegressionCount := experimentrunFields[13].Descriptor()
 	// experimentrun.DefaultRegressionCount holds the default value on creation for the regression_count field.
 	experimentrun.DefaultRegressionCount = experimentrunDescRegressionCount.Default.(int)
+	// experimentrunDescStatus is the schema descriptor for status field.
+	experimentrunDescStatus := experimentrunFields[14].Descriptor()
+	// experimentrun.DefaultStatus holds the default value on creation for the status field.
+	experimentrun.DefaultStatus = experimentrunDescStatus.Default.(string)
+	// experimentrunDescError is the schema descriptor for error field.
+	experimentrunDescError := experimentrunFields[15].Descriptor()
+	// experimentrun.DefaultError holds the default value on creation for the error field.
+	experimentrun.DefaultError = experimentrunDescError.Default.(string)
 	// experimentrunDescID is the schema descriptor for id field.
 	experimentrunDescID := experimentrunFields[0].Descriptor()
 	// experimentrun.IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -624,10 +726,40 @@ func init() {
 	tracelogDescRoutingFailureReason := tracelogFields[38].Descriptor()
 	// tracelog.DefaultRoutingFailureReason holds the default value on creation for the routing_failure_reason field.
 	tracelog.DefaultRoutingFailureReason = tracelogDescRoutingFailureReason.Default.(string)
+	// tracelogDescTokenName is the schema descriptor for token_name field.
+	tracelogDescTokenName := tracelogFields[39].Descriptor()
+	// tracelog.DefaultTokenName holds the default value on creation for the token_name field.
+	tracelog.DefaultTokenName = tracelogDescTokenName.Default.(string)
+	// tracelogDescClientCanceled is the schema descriptor for client_canceled field.
+	tracelogDescClientCanceled := tracelogFields[40].Descriptor()
+	// tracelog.DefaultClientCanceled holds the default value on creation for the client_canceled field.
+	tracelog.DefaultClientCanceled = tracelogDescClientCanceled.Default.(bool)
 	// tracelogDescID is the schema descriptor for id field.
 	tracelogDescID := tracelogFields[0].Descriptor()
 	// tracelog.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	tracelog.IDValidator = tracelogDescID.Validators[0].(func(string) error)
+	tracetagFields := schema.TraceTag{}.Fields()
+	_ = tracetagFields
+	// tracetagDescTargetType is the schema descriptor for target_type field.
+	tracetagDescTargetType := tracetagFields[0].Descriptor()
+	// tracetag.TargetTypeValidator is a validator for the "target_type" field. It is called by the builders before save.
+	tracetag.TargetTypeValidator = tracetagDescTargetType.Validators[0].(func(string) error)
+	// tracetagDescTargetID is the schema descriptor for target_id field.
+	tracetagDescTargetID := tracetagFields[1].Descriptor()
+	// tracetag.TargetIDValidator is a validator for the "target_id" field. It is called by the builders before save.
+	tracetag.TargetIDValidator = tracetagDescTargetID.Validators[0].(func(string) error)
+	// tracetagDescTag is the schema descriptor for tag field.
+	tracetagDescTag := tracetagFields[2].Descriptor()
+	// tracetag.TagValidator is a validator for the "tag" field. It is called by the builders before save.
+	tracetag.TagValidator = tracetagDescTag.Validators[0].(func(string) error)
+	// tracetagDescCreatedBy is the schema descriptor for created_by field.
+	tracetagDescCreatedBy := tracetagFields[3].Descriptor()
+	// tracetag.DefaultCreatedBy holds the default value on creation for the created_by field.
+	tracetag.DefaultCreatedBy = tracetagDescCreatedBy.Default.(string)
+	// tracetagDescCreatedAt is the schema descriptor for created_at field.
+	tracetagDescCreatedAt := tracetagFields[4].Descriptor()
+	// tracetag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tracetag.DefaultCreatedAt = tracetagDescCreatedAt.Default.(func() time.Time)
 	upstreammodelFields := schema.UpstreamModel{}.Fields()
 	_ = upstreammodelFields
 	// upstreammodelDescUpstreamID is the schema descriptor for upstream_id field.
@@ -93,7 +93,11 @@ type TraceLog struct {
 	RoutingCandidateCount int `json:"routing_candidate_count,omitempty"`
 	// RoutingFailureReason holds the value of the "routing_failure_reason" field.
 	RoutingFailureReason string `json:"routing_failure_reason,omitempty"`
-	selectValues         sql.SelectValues
+	// TokenName holds the value of the "token_name" field.
+	TokenName string `json:"token_name,omitempty"`
+	// ClientCanceled holds the value of the "client_canceled" field.
+	ClientCanceled bool `json:"client_canceled,omitempty"`
+	selectValues   sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -101,13 +105,13 @@ func (*TraceLog) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case tracelog.FieldIsStream:
+		case tracelog.FieldIsStream, tracelog.FieldClientCanceled:
 			values[i] = new(sql.NullBool)
 		case tracelog.FieldRoutingScore:
 			values[i] = new(sql.NullFloat64)
 		case tracelog.FieldModTimeNs, tracelog.FieldFileSize, tracelog.FieldStatusCode, tracelog.FieldDurationMs, tracelog.FieldTtftMs, tracelog.FieldContentLength, tracelog.FieldPromptTokens, tracelog.FieldCompletionTokens, tracelog.FieldTotalTokens, tracelog.FieldCachedTokens, tracelog.FieldReqHeaderLen, tracelog.FieldReqBodyLen, tracelog.FieldResHeaderLen, tracelog.FieldResBodyLen, tracelog.FieldRoutingCandidateCount:
 			values[i] = new(sql.NullInt64)
-		case tracelog.FieldID, tracelog.FieldTraceID, tracelog.FieldVersion, tracelog.FieldRequestID, tracelog.FieldModel, tracelog.FieldProvider, tracelog.FieldOperation, tracelog.FieldEndpoint, tracelog.FieldURL, tracelog.FieldMethod, tracelog.FieldClientIP, tracelog.FieldErrorText, tracelog.FieldSessionID, tracelog.FieldSessionSource, tracelog.FieldWindowID, tracelog.FieldClientRequestID, tracelog.FieldSelectedUpstreamID, tracelog.FieldSelectedUpstreamBaseURL, tracelog.FieldSelectedUpstreamProviderPreset, tracelog.FieldRoutingPolicy, tracelog.FieldRoutingFailureReason:
+		case tracelog.FieldID, tracelog.FieldTraceID, tracelog.FieldVersion, tracelog.FieldRequestID, tracelog.FieldModel, tracelog.FieldProvider, tracelog.FieldOperation, tracelog.FieldEndpoint, tracelog.FieldURL, tracelog.FieldMethod, tracelog.FieldClientIP, tracelog.FieldErrorText, tracelog.FieldSessionID, tracelog.FieldSessionSource, tracelog.FieldWindowID, tracelog.FieldClientRequestID, tracelog.FieldSelectedUpstreamID, tracelog.FieldSelectedUpstreamBaseURL, tracelog.FieldSelectedUpstreamProviderPreset, tracelog.FieldRoutingPolicy, tracelog.FieldRoutingFailureReason, tracelog.FieldTokenName:
 			values[i] = new(sql.NullString)
 		case tracelog.FieldRecordedAt:
 			values[i] = new(sql.NullTime)
@@ -360,6 +364,18 @@ func (_m *TraceLog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.RoutingFailureReason = value.String
 			}
+		case tracelog.FieldTokenName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_name", values[i])
+			} else if value.Valid {
+				_m.TokenName = value.String
+			}
+		case tracelog.FieldClientCanceled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field client_canceled", values[i])
+			} else if value.Valid {
+				_m.ClientCanceled = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -509,6 +525,12 @@ func (_m *TraceLog) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("routing_failure_reason=")
 	builder.WriteString(_m.RoutingFailureReason)
+	builder.WriteString(", ")
+	builder.WriteString("token_name=")
+	builder.WriteString(_m.TokenName)
+	builder.WriteString(", ")
+	builder.WriteString("client_canceled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClientCanceled))
 	builder.WriteByte(')')
 	return builder.String()
 }
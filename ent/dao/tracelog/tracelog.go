@@ -89,6 +89,10 @@ const (
 	FieldRoutingCandidateCount = "routing_candidate_count"
 	// FieldRoutingFailureReason holds the string denoting the routing_failure_reason field in the database.
 	FieldRoutingFailureReason = "routing_failure_reason"
+	// FieldTokenName holds the string denoting the token_name field in the database.
+	FieldTokenName = "token_name"
+	// FieldClientCanceled holds the string denoting the client_canceled field in the database.
+	FieldClientCanceled = "client_canceled"
 	// Table holds the table name of the tracelog in the database.
 	Table = "logs"
 )
@@ -134,6 +138,8 @@ var Columns = []string{
 	FieldRoutingScore,
 	FieldRoutingCandidateCount,
 	FieldRoutingFailureReason,
+	FieldTokenName,
+	FieldClientCanceled,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -219,6 +225,10 @@ var (
 	DefaultRoutingCandidateCount int
 	// DefaultRoutingFailureReason holds the default value on creation for the "routing_failure_reason" field.
 	DefaultRoutingFailureReason string
+	// DefaultTokenName holds the default value on creation for the "token_name" field.
+	DefaultTokenName string
+	// DefaultClientCanceled holds the default value on creation for the "client_canceled" field.
+	DefaultClientCanceled bool
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -420,3 +430,13 @@ func ByRoutingCandidateCount(opts ...sql.OrderTermOption) OrderOption {
 func ByRoutingFailureReason(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRoutingFailureReason, opts...).ToFunc()
 }
+
+// ByTokenName orders the results by the token_name field.
+func ByTokenName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokenName, opts...).ToFunc()
+}
+
+// ByClientCanceled orders the results by the client_canceled field.
+func ByClientCanceled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientCanceled, opts...).ToFunc()
+}
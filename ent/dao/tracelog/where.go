@@ -254,6 +254,16 @@ func RoutingFailureReason(v string) predicate.TraceLog {
 	return predicate.TraceLog(sql.FieldEQ(FieldRoutingFailureReason, v))
 }
 
+// TokenName applies equality check predicate on the "token_name" field. It's identical to TokenNameEQ.
+func TokenName(v string) predicate.TraceLog {
+	return predicate.TraceLog(sql.FieldEQ(FieldTokenName, v))
+}
+
+// ClientCanceled applies equality check predicate on the "client_canceled" field. It's identical to ClientCanceledEQ.
+func ClientCanceled(v bool) predicate.TraceLog {
+	return predicate.TraceLog(sql.FieldEQ(FieldClientCanceled, v))
+}
+
 // TraceIDEQ applies the EQ predicate on the "trace_id" field.
 func TraceIDEQ(v string) predicate.TraceLog {
 	return predicate.TraceLog(sql.FieldEQ(FieldTraceID, v))
@@ -2244,6 +2254,81 @@ func RoutingFailureReasonContainsFold(v string) predicate.TraceLog {
 	return predicate.TraceLog(sql.FieldContainsFold(FieldRoutingFailureReason, v))
 }
 
+// TokenNameEQ applies the EQ predicate on the "token_name" field.
+func TokenNameEQ(v string) predicate.TraceLog {
+	return predicate.TraceLog(sql.FieldEQ(FieldTokenName, v))
+}
+
+// TokenNameNEQ applies the NEQ predicate on the "token_name" field.
+func TokenNameNEQ(v string) predicate.TraceLog {
+	return predicate.TraceLog(sql.FieldNEQ(FieldTokenName, v))
+}
+
+// TokenNameIn applies the In predicate on the "token_name" field.
+func TokenNameIn(vs ...string) predicate.TraceLog {
+	return predicate.TraceLog(sql.FieldIn(FieldTokenName, vs...))
+}
+
+// TokenNameNotIn applies the NotIn predicate on the "token_name" field.
+func TokenNameNotIn(vs ...string) predicate.TraceLog {
+	return predicate.TraceLog(sql.FieldNotIn(FieldTokenName, vs...))
+}
+
+// TokenNameGT applies the GT predicate on the "token_name" field.
+func TokenNameGT(v string) predicate.TraceLog {
+	return predicate.TraceLog(sql.FieldGT(FieldTokenName, v))
+}
+
+// TokenNameGTE applies the GTE predicate on the "token_name" field.
+func TokenNameGTE(v string) predicate.TraceLog {
+	return predicate.TraceLog(sql.FieldGTE(FieldTokenName, v))
+}
+
+// TokenNameLT applies the LT predicate on the "token_name" field.
+func TokenNameLT(v string) predicate.TraceLog {
+	return predicate.TraceLog(sql.FieldLT(FieldTokenName, v))
+}
+
+// TokenNameLTE applies the LTE predicate on the "token_name" field.
+func TokenNameLTE(v string) predicate.TraceLog {
+	return predicate.TraceLog(sql.FieldLTE(FieldTokenName, v))
+}
+
+// TokenNameContains applies the Contains predicate on the "token_name" field.
+func TokenNameContains(v string) predicate.TraceLog {
+	return predicate.TraceLog(sql.FieldContains(FieldTokenName, v))
+}
+
+// TokenNameHasPrefix applies the HasPrefix predicate on the "token_name" field.
+func TokenNameHasPrefix(v string) predicate.TraceLog {
+	return predicate.TraceLog(sql.FieldHasPrefix(FieldTokenName, v))
+}
+
+// TokenNameHasSuffix applies the HasSuffix predicate on the "token_name" field.
+func TokenNameHasSuffix(v string) predicate.TraceLog {
+	return predicate.TraceLog(sql.FieldHasSuffix(FieldTokenName, v))
+}
+
+// TokenNameEqualFold applies the EqualFold predicate on the "token_name" field.
+func TokenNameEqualFold(v string) predicate.TraceLog {
+	return predicate.TraceLog(sql.FieldEqualFold(FieldTokenName, v))
+}
+
+// TokenNameContainsFold applies the ContainsFold predicate on the "token_name" field.
+func TokenNameContainsFold(v string) predicate.TraceLog {
+	return predicate.TraceLog(sql.FieldContainsFold(FieldTokenName, v))
+}
+
+// ClientCanceledEQ applies the EQ predicate on the "client_canceled" field.
+func ClientCanceledEQ(v bool) predicate.TraceLog {
+	return predicate.TraceLog(sql.FieldEQ(FieldClientCanceled, v))
+}
+
+// ClientCanceledNEQ applies the NEQ predicate on the "client_canceled" field.
+func ClientCanceledNEQ(v bool) predicate.TraceLog {
+	return predicate.TraceLog(sql.FieldNEQ(FieldClientCanceled, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.TraceLog) predicate.TraceLog {
 	return predicate.TraceLog(sql.AndPredicates(predicates...))
@@ -523,6 +523,34 @@ func (_c *TraceLogCreate) SetNillableRoutingFailureReason(v *string) *TraceLogCr
 	return _c
 }
 
+// SetTokenName sets the "token_name" field.
+func (_c *TraceLogCreate) SetTokenName(v string) *TraceLogCreate {
+	_c.mutation.SetTokenName(v)
+	return _c
+}
+
+// SetNillableTokenName sets the "token_name" field if the given value is not nil.
+func (_c *TraceLogCreate) SetNillableTokenName(v *string) *TraceLogCreate {
+	if v != nil {
+		_c.SetTokenName(*v)
+	}
+	return _c
+}
+
+// SetClientCanceled sets the "client_canceled" field.
+func (_c *TraceLogCreate) SetClientCanceled(v bool) *TraceLogCreate {
+	_c.mutation.SetClientCanceled(v)
+	return _c
+}
+
+// SetNillableClientCanceled sets the "client_canceled" field if the given value is not nil.
+func (_c *TraceLogCreate) SetNillableClientCanceled(v *bool) *TraceLogCreate {
+	if v != nil {
+		_c.SetClientCanceled(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TraceLogCreate) SetID(v string) *TraceLogCreate {
 	_c.mutation.SetID(v)
@@ -700,6 +728,14 @@ func (_c *TraceLogCreate) defaults() {
 		v := tracelog.DefaultRoutingFailureReason
 		_c.mutation.SetRoutingFailureReason(v)
 	}
+	if _, ok := _c.mutation.TokenName(); !ok {
+		v := tracelog.DefaultTokenName
+		_c.mutation.SetTokenName(v)
+	}
+	if _, ok := _c.mutation.ClientCanceled(); !ok {
+		v := tracelog.DefaultClientCanceled
+		_c.mutation.SetClientCanceled(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -828,6 +864,12 @@ func (_c *TraceLogCreate) check() error {
 	if _, ok := _c.mutation.RoutingFailureReason(); !ok {
 		return &ValidationError{Name: "routing_failure_reason", err: errors.New(`dao: missing required field "TraceLog.routing_failure_reason"`)}
 	}
+	if _, ok := _c.mutation.TokenName(); !ok {
+		return &ValidationError{Name: "token_name", err: errors.New(`dao: missing required field "TraceLog.token_name"`)}
+	}
+	if _, ok := _c.mutation.ClientCanceled(); !ok {
+		return &ValidationError{Name: "client_canceled", err: errors.New(`dao: missing required field "TraceLog.client_canceled"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := tracelog.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`dao: validator failed for field "TraceLog.id": %w`, err)}
@@ -1022,6 +1064,14 @@ func (_c *TraceLogCreate) createSpec() (*TraceLog, *sqlgraph.CreateSpec) {
 		_spec.SetField(tracelog.FieldRoutingFailureReason, field.TypeString, value)
 		_node.RoutingFailureReason = value
 	}
+	if value, ok := _c.mutation.TokenName(); ok {
+		_spec.SetField(tracelog.FieldTokenName, field.TypeString, value)
+		_node.TokenName = value
+	}
+	if value, ok := _c.mutation.ClientCanceled(); ok {
+		_spec.SetField(tracelog.FieldClientCanceled, field.TypeBool, value)
+		_node.ClientCanceled = value
+	}
 	return _node, _spec
 }
 
@@ -1626,6 +1676,30 @@ func (u *TraceLogUpsert) UpdateRoutingFailureReason() *TraceLogUpsert {
 	return u
 }
 
+// SetTokenName sets the "token_name" field.
+func (u *TraceLogUpsert) SetTokenName(v string) *TraceLogUpsert {
+	u.Set(tracelog.FieldTokenName, v)
+	return u
+}
+
+// UpdateTokenName sets the "token_name" field to the value that was provided on create.
+func (u *TraceLogUpsert) UpdateTokenName() *TraceLogUpsert {
+	u.SetExcluded(tracelog.FieldTokenName)
+	return u
+}
+
+// SetClientCanceled sets the "client_canceled" field.
+func (u *TraceLogUpsert) SetClientCanceled(v bool) *TraceLogUpsert {
+	u.Set(tracelog.FieldClientCanceled, v)
+	return u
+}
+
+// UpdateClientCanceled sets the "client_canceled" field to the value that was provided on create.
+func (u *TraceLogUpsert) UpdateClientCanceled() *TraceLogUpsert {
+	u.SetExcluded(tracelog.FieldClientCanceled)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -2318,6 +2392,34 @@ func (u *TraceLogUpsertOne) UpdateRoutingFailureReason() *TraceLogUpsertOne {
 	})
 }
 
+// SetTokenName sets the "token_name" field.
+func (u *TraceLogUpsertOne) SetTokenName(v string) *TraceLogUpsertOne {
+	return u.Update(func(s *TraceLogUpsert) {
+		s.SetTokenName(v)
+	})
+}
+
+// UpdateTokenName sets the "token_name" field to the value that was provided on create.
+func (u *TraceLogUpsertOne) UpdateTokenName() *TraceLogUpsertOne {
+	return u.Update(func(s *TraceLogUpsert) {
+		s.UpdateTokenName()
+	})
+}
+
+// SetClientCanceled sets the "client_canceled" field.
+func (u *TraceLogUpsertOne) SetClientCanceled(v bool) *TraceLogUpsertOne {
+	return u.Update(func(s *TraceLogUpsert) {
+		s.SetClientCanceled(v)
+	})
+}
+
+// UpdateClientCanceled sets the "client_canceled" field to the value that was provided on create.
+func (u *TraceLogUpsertOne) UpdateClientCanceled() *TraceLogUpsertOne {
+	return u.Update(func(s *TraceLogUpsert) {
+		s.UpdateClientCanceled()
+	})
+}
+
 // Exec executes the query.
 func (u *TraceLogUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -3177,6 +3279,34 @@ func (u *TraceLogUpsertBulk) UpdateRoutingFailureReason() *TraceLogUpsertBulk {
 	})
 }
 
+// SetTokenName sets the "token_name" field.
+func (u *TraceLogUpsertBulk) SetTokenName(v string) *TraceLogUpsertBulk {
+	return u.Update(func(s *TraceLogUpsert) {
+		s.SetTokenName(v)
+	})
+}
+
+// UpdateTokenName sets the "token_name" field to the value that was provided on create.
+func (u *TraceLogUpsertBulk) UpdateTokenName() *TraceLogUpsertBulk {
+	return u.Update(func(s *TraceLogUpsert) {
+		s.UpdateTokenName()
+	})
+}
+
+// SetClientCanceled sets the "client_canceled" field.
+func (u *TraceLogUpsertBulk) SetClientCanceled(v bool) *TraceLogUpsertBulk {
+	return u.Update(func(s *TraceLogUpsert) {
+		s.SetClientCanceled(v)
+	})
+}
+
+// UpdateClientCanceled sets the "client_canceled" field to the value that was provided on create.
+func (u *TraceLogUpsertBulk) UpdateClientCanceled() *TraceLogUpsertBulk {
+	return u.Update(func(s *TraceLogUpsert) {
+		s.UpdateClientCanceled()
+	})
+}
+
 // Exec executes the query.
 func (u *TraceLogUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -674,6 +674,34 @@ func (_u *TraceLogUpdate) SetNillableRoutingFailureReason(v *string) *TraceLogUp
 	return _u
 }
 
+// SetTokenName sets the "token_name" field.
+func (_u *TraceLogUpdate) SetTokenName(v string) *TraceLogUpdate {
+	_u.mutation.SetTokenName(v)
+	return _u
+}
+
+// SetNillableTokenName sets the "token_name" field if the given value is not nil.
+func (_u *TraceLogUpdate) SetNillableTokenName(v *string) *TraceLogUpdate {
+	if v != nil {
+		_u.SetTokenName(*v)
+	}
+	return _u
+}
+
+// SetClientCanceled sets the "client_canceled" field.
+func (_u *TraceLogUpdate) SetClientCanceled(v bool) *TraceLogUpdate {
+	_u.mutation.SetClientCanceled(v)
+	return _u
+}
+
+// SetNillableClientCanceled sets the "client_canceled" field if the given value is not nil.
+func (_u *TraceLogUpdate) SetNillableClientCanceled(v *bool) *TraceLogUpdate {
+	if v != nil {
+		_u.SetClientCanceled(*v)
+	}
+	return _u
+}
+
 // Mutation returns the TraceLogMutation object of the builder.
 func (_u *TraceLogUpdate) Mutation() *TraceLogMutation {
 	return _u.mutation
@@ -901,6 +929,12 @@ func (_u *TraceLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.RoutingFailureReason(); ok {
 		_spec.SetField(tracelog.FieldRoutingFailureReason, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TokenName(); ok {
+		_spec.SetField(tracelog.FieldTokenName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientCanceled(); ok {
+		_spec.SetField(tracelog.FieldClientCanceled, field.TypeBool, value)
+	}
 	_spec.Node.Schema = _u.schemaConfig.TraceLog
 	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
 	_spec.AddModifiers(_u.modifiers...)
@@ -1569,6 +1603,34 @@ func (_u *TraceLogUpdateOne) SetNillableRoutingFailureReason(v *string) *TraceLo
 	return _u
 }
 
+// SetTokenName sets the "token_name" field.
+func (_u *TraceLogUpdateOne) SetTokenName(v string) *TraceLogUpdateOne {
+	_u.mutation.SetTokenName(v)
+	return _u
+}
+
+// SetNillableTokenName sets the "token_name" field if the given value is not nil.
+func (_u *TraceLogUpdateOne) SetNillableTokenName(v *string) *TraceLogUpdateOne {
+	if v != nil {
+		_u.SetTokenName(*v)
+	}
+	return _u
+}
+
+// SetClientCanceled sets the "client_canceled" field.
+func (_u *TraceLogUpdateOne) SetClientCanceled(v bool) *TraceLogUpdateOne {
+	_u.mutation.SetClientCanceled(v)
+	return _u
+}
+
+// SetNillableClientCanceled sets the "client_canceled" field if the given value is not nil.
+func (_u *TraceLogUpdateOne) SetNillableClientCanceled(v *bool) *TraceLogUpdateOne {
+	if v != nil {
+		_u.SetClientCanceled(*v)
+	}
+	return _u
+}
+
 // Mutation returns the TraceLogMutation object of the builder.
 func (_u *TraceLogUpdateOne) Mutation() *TraceLogMutation {
 	return _u.mutation
@@ -1826,6 +1888,12 @@ func (_u *TraceLogUpdateOne) sqlSave(ctx context.Context) (_node *TraceLog, err
 	if value, ok := _u.mutation.RoutingFailureReason(); ok {
 		_spec.SetField(tracelog.FieldRoutingFailureReason, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TokenName(); ok {
+		_spec.SetField(tracelog.FieldTokenName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientCanceled(); ok {
+		_spec.SetField(tracelog.FieldClientCanceled, field.TypeBool, value)
+	}
 	_spec.Node.Schema = _u.schemaConfig.TraceLog
 	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
 	_spec.AddModifiers(_u.modifiers...)
@@ -0,0 +1,685 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kingfs/llm-tracelab/ent/dao/tracetag"
+)
+
+// TraceTagCreate is the builder for creating a TraceTag entity.
+type TraceTagCreate struct {
+	config
+	mutation *TraceTagMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTargetType sets the "target_type" field.
+func (_c *TraceTagCreate) SetTargetType(v string) *TraceTagCreate {
+	_c.mutation.SetTargetType(v)
+	return _c
+}
+
+// SetTargetID sets the "target_id" field.
+func (_c *TraceTagCreate) SetTargetID(v string) *TraceTagCreate {
+	_c.mutation.SetTargetID(v)
+	return _c
+}
+
+// SetTag sets the "tag" field.
+func (_c *TraceTagCreate) SetTag(v string) *TraceTagCreate {
+	_c.mutation.SetTag(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *TraceTagCreate) SetCreatedBy(v string) *TraceTagCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *TraceTagCreate) SetNillableCreatedBy(v *string) *TraceTagCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TraceTagCreate) SetCreatedAt(v time.Time) *TraceTagCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TraceTagCreate) SetNillableCreatedAt(v *time.Time) *TraceTagCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TraceTagMutation object of the builder.
+func (_c *TraceTagCreate) Mutation() *TraceTagMutation {
+	return _c.mutation
+}
+
+// Save creates the TraceTag in the database.
+func (_c *TraceTagCreate) Save(ctx context.Context) (*TraceTag, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TraceTagCreate) SaveX(ctx context.Context) *TraceTag {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TraceTagCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TraceTagCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TraceTagCreate) defaults() {
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		v := tracetag.DefaultCreatedBy
+		_c.mutation.SetCreatedBy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := tracetag.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TraceTagCreate) check() error {
+	if _, ok := _c.mutation.TargetType(); !ok {
+		return &ValidationError{Name: "target_type", err: errors.New(`dao: missing required field "TraceTag.target_type"`)}
+	}
+	if v, ok := _c.mutation.TargetType(); ok {
+		if err := tracetag.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`dao: validator failed for field "TraceTag.target_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`dao: missing required field "TraceTag.target_id"`)}
+	}
+	if v, ok := _c.mutation.TargetID(); ok {
+		if err := tracetag.TargetIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_id", err: fmt.Errorf(`dao: validator failed for field "TraceTag.target_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Tag(); !ok {
+		return &ValidationError{Name: "tag", err: errors.New(`dao: missing required field "TraceTag.tag"`)}
+	}
+	if v, ok := _c.mutation.Tag(); ok {
+		if err := tracetag.TagValidator(v); err != nil {
+			return &ValidationError{Name: "tag", err: fmt.Errorf(`dao: validator failed for field "TraceTag.tag": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`dao: missing required field "TraceTag.created_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`dao: missing required field "TraceTag.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *TraceTagCreate) sqlSave(ctx context.Context) (*TraceTag, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TraceTagCreate) createSpec() (*TraceTag, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TraceTag{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tracetag.Table, sqlgraph.NewFieldSpec(tracetag.FieldID, field.TypeInt))
+	)
+	_spec.Schema = _c.schemaConfig.TraceTag
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.TargetType(); ok {
+		_spec.SetField(tracetag.FieldTargetType, field.TypeString, value)
+		_node.TargetType = value
+	}
+	if value, ok := _c.mutation.TargetID(); ok {
+		_spec.SetField(tracetag.FieldTargetID, field.TypeString, value)
+		_node.TargetID = value
+	}
+	if value, ok := _c.mutation.Tag(); ok {
+		_spec.SetField(tracetag.FieldTag, field.TypeString, value)
+		_node.Tag = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(tracetag.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tracetag.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TraceTag.Create().
+//		SetTargetType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TraceTagUpsert) {
+//			SetTargetType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TraceTagCreate) OnConflict(opts ...sql.ConflictOption) *TraceTagUpsertOne {
+	_c.conflict = opts
+	return &TraceTagUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TraceTag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TraceTagCreate) OnConflictColumns(columns ...string) *TraceTagUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TraceTagUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TraceTagUpsertOne is the builder for "upsert"-ing
+	//  one TraceTag node.
+	TraceTagUpsertOne struct {
+		create *TraceTagCreate
+	}
+
+	// TraceTagUpsert is the "OnConflict" setter.
+	TraceTagUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTargetType sets the "target_type" field.
+func (u *TraceTagUpsert) SetTargetType(v string) *TraceTagUpsert {
+	u.Set(tracetag.FieldTargetType, v)
+	return u
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *TraceTagUpsert) UpdateTargetType() *TraceTagUpsert {
+	u.SetExcluded(tracetag.FieldTargetType)
+	return u
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *TraceTagUpsert) SetTargetID(v string) *TraceTagUpsert {
+	u.Set(tracetag.FieldTargetID, v)
+	return u
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *TraceTagUpsert) UpdateTargetID() *TraceTagUpsert {
+	u.SetExcluded(tracetag.FieldTargetID)
+	return u
+}
+
+// SetTag sets the "tag" field.
+func (u *TraceTagUpsert) SetTag(v string) *TraceTagUpsert {
+	u.Set(tracetag.FieldTag, v)
+	return u
+}
+
+// UpdateTag sets the "tag" field to the value that was provided on create.
+func (u *TraceTagUpsert) UpdateTag() *TraceTagUpsert {
+	u.SetExcluded(tracetag.FieldTag)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *TraceTagUpsert) SetCreatedBy(v string) *TraceTagUpsert {
+	u.Set(tracetag.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *TraceTagUpsert) UpdateCreatedBy() *TraceTagUpsert {
+	u.SetExcluded(tracetag.FieldCreatedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TraceTag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TraceTagUpsertOne) UpdateNewValues() *TraceTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tracetag.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TraceTag.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TraceTagUpsertOne) Ignore() *TraceTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TraceTagUpsertOne) DoNothing() *TraceTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TraceTagCreate.OnConflict
+// documentation for more info.
+func (u *TraceTagUpsertOne) Update(set func(*TraceTagUpsert)) *TraceTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TraceTagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *TraceTagUpsertOne) SetTargetType(v string) *TraceTagUpsertOne {
+	return u.Update(func(s *TraceTagUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *TraceTagUpsertOne) UpdateTargetType() *TraceTagUpsertOne {
+	return u.Update(func(s *TraceTagUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *TraceTagUpsertOne) SetTargetID(v string) *TraceTagUpsertOne {
+	return u.Update(func(s *TraceTagUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *TraceTagUpsertOne) UpdateTargetID() *TraceTagUpsertOne {
+	return u.Update(func(s *TraceTagUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetTag sets the "tag" field.
+func (u *TraceTagUpsertOne) SetTag(v string) *TraceTagUpsertOne {
+	return u.Update(func(s *TraceTagUpsert) {
+		s.SetTag(v)
+	})
+}
+
+// UpdateTag sets the "tag" field to the value that was provided on create.
+func (u *TraceTagUpsertOne) UpdateTag() *TraceTagUpsertOne {
+	return u.Update(func(s *TraceTagUpsert) {
+		s.UpdateTag()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *TraceTagUpsertOne) SetCreatedBy(v string) *TraceTagUpsertOne {
+	return u.Update(func(s *TraceTagUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *TraceTagUpsertOne) UpdateCreatedBy() *TraceTagUpsertOne {
+	return u.Update(func(s *TraceTagUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *TraceTagUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("dao: missing options for TraceTagCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TraceTagUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TraceTagUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TraceTagUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TraceTagCreateBulk is the builder for creating many TraceTag entities in bulk.
+type TraceTagCreateBulk struct {
+	config
+	err      error
+	builders []*TraceTagCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TraceTag entities in the database.
+func (_c *TraceTagCreateBulk) Save(ctx context.Context) ([]*TraceTag, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TraceTag, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TraceTagMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TraceTagCreateBulk) SaveX(ctx context.Context) []*TraceTag {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TraceTagCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TraceTagCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TraceTag.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TraceTagUpsert) {
+//			SetTargetType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TraceTagCreateBulk) OnConflict(opts ...sql.ConflictOption) *TraceTagUpsertBulk {
+	_c.conflict = opts
+	return &TraceTagUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TraceTag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TraceTagCreateBulk) OnConflictColumns(columns ...string) *TraceTagUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TraceTagUpsertBulk{
+		create: _c,
+	}
+}
+
+// TraceTagUpsertBulk is the builder for "upsert"-ing
+// a bulk of TraceTag nodes.
+type TraceTagUpsertBulk struct {
+	create *TraceTagCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TraceTag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TraceTagUpsertBulk) UpdateNewValues() *TraceTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tracetag.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TraceTag.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TraceTagUpsertBulk) Ignore() *TraceTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TraceTagUpsertBulk) DoNothing() *TraceTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TraceTagCreateBulk.OnConflict
+// documentation for more info.
+func (u *TraceTagUpsertBulk) Update(set func(*TraceTagUpsert)) *TraceTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TraceTagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *TraceTagUpsertBulk) SetTargetType(v string) *TraceTagUpsertBulk {
+	return u.Update(func(s *TraceTagUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *TraceTagUpsertBulk) UpdateTargetType() *TraceTagUpsertBulk {
+	return u.Update(func(s *TraceTagUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *TraceTagUpsertBulk) SetTargetID(v string) *TraceTagUpsertBulk {
+	return u.Update(func(s *TraceTagUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *TraceTagUpsertBulk) UpdateTargetID() *TraceTagUpsertBulk {
+	return u.Update(func(s *TraceTagUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetTag sets the "tag" field.
+func (u *TraceTagUpsertBulk) SetTag(v string) *TraceTagUpsertBulk {
+	return u.Update(func(s *TraceTagUpsert) {
+		s.SetTag(v)
+	})
+}
+
+// UpdateTag sets the "tag" field to the value that was provided on create.
+func (u *TraceTagUpsertBulk) UpdateTag() *TraceTagUpsertBulk {
+	return u.Update(func(s *TraceTagUpsert) {
+		s.UpdateTag()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *TraceTagUpsertBulk) SetCreatedBy(v string) *TraceTagUpsertBulk {
+	return u.Update(func(s *TraceTagUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *TraceTagUpsertBulk) UpdateCreatedBy() *TraceTagUpsertBulk {
+	return u.Update(func(s *TraceTagUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *TraceTagUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("dao: OnConflict was set for builder %d. Set it on the TraceTagCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("dao: missing options for TraceTagCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TraceTagUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
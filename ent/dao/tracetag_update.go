@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kingfs/llm-tracelab/ent/dao/internal"
+	"github.com/kingfs/llm-tracelab/ent/dao/predicate"
+	"github.com/kingfs/llm-tracelab/ent/dao/tracetag"
+)
+
+// TraceTagUpdate is the builder for updating TraceTag entities.
+type TraceTagUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TraceTagMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TraceTagUpdate builder.
+func (_u *TraceTagUpdate) Where(ps ...predicate.TraceTag) *TraceTagUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTargetType sets the "target_type" field.
+func (_u *TraceTagUpdate) SetTargetType(v string) *TraceTagUpdate {
+	_u.mutation.SetTargetType(v)
+	return _u
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (_u *TraceTagUpdate) SetNillableTargetType(v *string) *TraceTagUpdate {
+	if v != nil {
+		_u.SetTargetType(*v)
+	}
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *TraceTagUpdate) SetTargetID(v string) *TraceTagUpdate {
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *TraceTagUpdate) SetNillableTargetID(v *string) *TraceTagUpdate {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// SetTag sets the "tag" field.
+func (_u *TraceTagUpdate) SetTag(v string) *TraceTagUpdate {
+	_u.mutation.SetTag(v)
+	return _u
+}
+
+// SetNillableTag sets the "tag" field if the given value is not nil.
+func (_u *TraceTagUpdate) SetNillableTag(v *string) *TraceTagUpdate {
+	if v != nil {
+		_u.SetTag(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *TraceTagUpdate) SetCreatedBy(v string) *TraceTagUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *TraceTagUpdate) SetNillableCreatedBy(v *string) *TraceTagUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TraceTagMutation object of the builder.
+func (_u *TraceTagUpdate) Mutation() *TraceTagMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TraceTagUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TraceTagUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TraceTagUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TraceTagUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TraceTagUpdate) check() error {
+	if v, ok := _u.mutation.TargetType(); ok {
+		if err := tracetag.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`dao: validator failed for field "TraceTag.target_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetID(); ok {
+		if err := tracetag.TargetIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_id", err: fmt.Errorf(`dao: validator failed for field "TraceTag.target_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Tag(); ok {
+		if err := tracetag.TagValidator(v); err != nil {
+			return &ValidationError{Name: "tag", err: fmt.Errorf(`dao: validator failed for field "TraceTag.tag": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TraceTagUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TraceTagUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TraceTagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tracetag.Table, tracetag.Columns, sqlgraph.NewFieldSpec(tracetag.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TargetType(); ok {
+		_spec.SetField(tracetag.FieldTargetType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetID(); ok {
+		_spec.SetField(tracetag.FieldTargetID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Tag(); ok {
+		_spec.SetField(tracetag.FieldTag, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(tracetag.FieldCreatedBy, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.TraceTag
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tracetag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TraceTagUpdateOne is the builder for updating a single TraceTag entity.
+type TraceTagUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TraceTagMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTargetType sets the "target_type" field.
+func (_u *TraceTagUpdateOne) SetTargetType(v string) *TraceTagUpdateOne {
+	_u.mutation.SetTargetType(v)
+	return _u
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (_u *TraceTagUpdateOne) SetNillableTargetType(v *string) *TraceTagUpdateOne {
+	if v != nil {
+		_u.SetTargetType(*v)
+	}
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *TraceTagUpdateOne) SetTargetID(v string) *TraceTagUpdateOne {
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *TraceTagUpdateOne) SetNillableTargetID(v *string) *TraceTagUpdateOne {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// SetTag sets the "tag" field.
+func (_u *TraceTagUpdateOne) SetTag(v string) *TraceTagUpdateOne {
+	_u.mutation.SetTag(v)
+	return _u
+}
+
+// SetNillableTag sets the "tag" field if the given value is not nil.
+func (_u *TraceTagUpdateOne) SetNillableTag(v *string) *TraceTagUpdateOne {
+	if v != nil {
+		_u.SetTag(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *TraceTagUpdateOne) SetCreatedBy(v string) *TraceTagUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *TraceTagUpdateOne) SetNillableCreatedBy(v *string) *TraceTagUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TraceTagMutation object of the builder.
+func (_u *TraceTagUpdateOne) Mutation() *TraceTagMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TraceTagUpdate builder.
+func (_u *TraceTagUpdateOne) Where(ps ...predicate.TraceTag) *TraceTagUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TraceTagUpdateOne) Select(field string, fields ...string) *TraceTagUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TraceTag entity.
+func (_u *TraceTagUpdateOne) Save(ctx context.Context) (*TraceTag, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TraceTagUpdateOne) SaveX(ctx context.Context) *TraceTag {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TraceTagUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TraceTagUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TraceTagUpdateOne) check() error {
+	if v, ok := _u.mutation.TargetType(); ok {
+		if err := tracetag.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`dao: validator failed for field "TraceTag.target_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetID(); ok {
+		if err := tracetag.TargetIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_id", err: fmt.Errorf(`dao: validator failed for field "TraceTag.target_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Tag(); ok {
+		if err := tracetag.TagValidator(v); err != nil {
+			return &ValidationError{Name: "tag", err: fmt.Errorf(`dao: validator failed for field "TraceTag.tag": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TraceTagUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TraceTagUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TraceTagUpdateOne) sqlSave(ctx context.Context) (_node *TraceTag, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tracetag.Table, tracetag.Columns, sqlgraph.NewFieldSpec(tracetag.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`dao: missing "TraceTag.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tracetag.FieldID)
+		for _, f := range fields {
+			if !tracetag.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("dao: invalid field %q for query", f)}
+			}
+			if f != tracetag.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TargetType(); ok {
+		_spec.SetField(tracetag.FieldTargetType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetID(); ok {
+		_spec.SetField(tracetag.FieldTargetID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Tag(); ok {
+		_spec.SetField(tracetag.FieldTag, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(tracetag.FieldCreatedBy, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.TraceTag
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TraceTag{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tracetag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -16,6 +16,8 @@ type Tx struct {
 	config
 	// APIToken is the client for interacting with the APIToken builders.
 	APIToken *APITokenClient
+	// Annotation is the client for interacting with the Annotation builders.
+	Annotation *AnnotationClient
 	// ChannelConfig is the client for interacting with the ChannelConfig builders.
 	ChannelConfig *ChannelConfigClient
 	// ChannelModel is the client for interacting with the ChannelModel builders.
@@ -26,6 +28,10 @@ type Tx struct {
 	Dataset *DatasetClient
 	// DatasetExample is the client for interacting with the DatasetExample builders.
 	DatasetExample *DatasetExampleClient
+	// DatasetExampleOverride is the client for interacting with the DatasetExampleOverride builders.
+	DatasetExampleOverride *DatasetExampleOverrideClient
+	// DatasetSnapshot is the client for interacting with the DatasetSnapshot builders.
+	DatasetSnapshot *DatasetSnapshotClient
 	// EvalRun is the client for interacting with the EvalRun builders.
 	EvalRun *EvalRunClient
 	// ExperimentRun is the client for interacting with the ExperimentRun builders.
@@ -36,6 +42,8 @@ type Tx struct {
 	Score *ScoreClient
 	// TraceLog is the client for interacting with the TraceLog builders.
 	TraceLog *TraceLogClient
+	// TraceTag is the client for interacting with the TraceTag builders.
+	TraceTag *TraceTagClient
 	// UpstreamModel is the client for interacting with the UpstreamModel builders.
 	UpstreamModel *UpstreamModelClient
 	// UpstreamTarget is the client for interacting with the UpstreamTarget builders.
@@ -174,16 +182,20 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.APIToken = NewAPITokenClient(tx.config)
+	tx.Annotation = NewAnnotationClient(tx.config)
 	tx.ChannelConfig = NewChannelConfigClient(tx.config)
 	tx.ChannelModel = NewChannelModelClient(tx.config)
 	tx.ChannelProbeRun = NewChannelProbeRunClient(tx.config)
 	tx.Dataset = NewDatasetClient(tx.config)
 	tx.DatasetExample = NewDatasetExampleClient(tx.config)
+	tx.DatasetExampleOverride = NewDatasetExampleOverrideClient(tx.config)
+	tx.DatasetSnapshot = NewDatasetSnapshotClient(tx.config)
 	tx.EvalRun = NewEvalRunClient(tx.config)
 	tx.ExperimentRun = NewExperimentRunClient(tx.config)
 	tx.ModelCatalog = NewModelCatalogClient(tx.config)
 	tx.Score = NewScoreClient(tx.config)
 	tx.TraceLog = NewTraceLogClient(tx.config)
+	tx.TraceTag = NewTraceTagClient(tx.config)
 	tx.UpstreamModel = NewUpstreamModelClient(tx.config)
 	tx.UpstreamTarget = NewUpstreamTargetClient(tx.config)
 	tx.User = NewUserClient(tx.config)
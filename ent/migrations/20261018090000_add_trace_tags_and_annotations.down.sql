@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS `annotation_parent_id`;
+DROP INDEX IF EXISTS `annotation_target_type_target_id_created_at`;
+DROP TABLE IF EXISTS `annotations`;
+DROP INDEX IF EXISTS `tracetag_tag`;
+DROP INDEX IF EXISTS `tracetag_target_type_target_id_tag`;
+DROP TABLE IF EXISTS `trace_tags`;
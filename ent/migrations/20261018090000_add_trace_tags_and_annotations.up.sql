@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS `trace_tags` (
+  `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `target_type` text NOT NULL,
+  `target_id` text NOT NULL,
+  `tag` text NOT NULL,
+  `created_by` text NOT NULL DEFAULT (''),
+  `created_at` datetime NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS `tracetag_target_type_target_id_tag` ON `trace_tags` (`target_type`, `target_id`, `tag`);
+CREATE INDEX IF NOT EXISTS `tracetag_tag` ON `trace_tags` (`tag`);
+
+CREATE TABLE IF NOT EXISTS `annotations` (
+  `id` text NOT NULL,
+  `target_type` text NOT NULL,
+  `target_id` text NOT NULL,
+  `parent_id` text NOT NULL DEFAULT (''),
+  `author` text NOT NULL DEFAULT (''),
+  `body` text NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`)
+);
+
+CREATE INDEX IF NOT EXISTS `annotation_target_type_target_id_created_at` ON `annotations` (`target_type`, `target_id`, `created_at`);
+CREATE INDEX IF NOT EXISTS `annotation_parent_id` ON `annotations` (`parent_id`);
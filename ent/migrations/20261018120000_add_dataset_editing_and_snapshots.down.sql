@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS `datasetsnapshot_dataset_id_version`;
+DROP TABLE IF EXISTS `dataset_snapshots`;
+DROP INDEX IF EXISTS `datasetexampleoverride_dataset_id_trace_id`;
+DROP TABLE IF EXISTS `dataset_example_overrides`;
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS `dataset_example_overrides` (
+  `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `dataset_id` text NOT NULL,
+  `trace_id` text NOT NULL,
+  `expected_output` text NOT NULL DEFAULT (''),
+  `tags_json` text NOT NULL DEFAULT ('[]'),
+  `input_override_json` text NOT NULL DEFAULT (''),
+  `updated_by` text NOT NULL DEFAULT (''),
+  `updated_at` datetime NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS `datasetexampleoverride_dataset_id_trace_id` ON `dataset_example_overrides` (`dataset_id`, `trace_id`);
+
+CREATE TABLE IF NOT EXISTS `dataset_snapshots` (
+  `id` text NOT NULL,
+  `dataset_id` text NOT NULL,
+  `version` integer NOT NULL DEFAULT (0),
+  `note` text NOT NULL DEFAULT (''),
+  `created_by` text NOT NULL DEFAULT (''),
+  `example_count` integer NOT NULL DEFAULT (0),
+  `examples_json` text NOT NULL DEFAULT ('[]'),
+  `created_at` datetime NOT NULL,
+  PRIMARY KEY (`id`)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS `datasetsnapshot_dataset_id_version` ON `dataset_snapshots` (`dataset_id`, `version`);
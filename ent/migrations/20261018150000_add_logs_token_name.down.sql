@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS `tracelog_token_name_recorded_at`;
+ALTER TABLE `logs` DROP COLUMN `token_name`;
@@ -0,0 +1,3 @@
+ALTER TABLE `logs` ADD COLUMN `token_name` text NOT NULL DEFAULT ('');
+
+CREATE INDEX IF NOT EXISTS `tracelog_token_name_recorded_at` ON `logs` (`token_name`, `recorded_at`);
@@ -0,0 +1,2 @@
+ALTER TABLE `experiment_runs` DROP COLUMN `error`;
+ALTER TABLE `experiment_runs` DROP COLUMN `status`;
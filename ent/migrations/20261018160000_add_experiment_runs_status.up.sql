@@ -0,0 +1,2 @@
+ALTER TABLE `experiment_runs` ADD COLUMN `status` text NOT NULL DEFAULT ('completed');
+ALTER TABLE `experiment_runs` ADD COLUMN `error` text NOT NULL DEFAULT ('');
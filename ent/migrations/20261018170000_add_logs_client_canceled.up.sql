@@ -0,0 +1 @@
+ALTER TABLE `logs` ADD COLUMN `client_canceled` bool NOT NULL DEFAULT (false);
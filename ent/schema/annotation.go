@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type Annotation struct {
+	ent.Schema
+}
+
+func (Annotation) Annotations() []schema.Annotation {
+	return []schema.Annotation{entsql.Annotation{Table: "annotations"}}
+}
+
+func (Annotation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Immutable(),
+		field.String("target_type").NotEmpty(),
+		field.String("target_id").NotEmpty(),
+		field.String("parent_id").Default(""),
+		field.String("author").Default(""),
+		field.String("body").NotEmpty(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now),
+	}
+}
+
+func (Annotation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_type", "target_id", "created_at"),
+		index.Fields("parent_id"),
+	}
+}
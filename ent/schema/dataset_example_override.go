@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type DatasetExampleOverride struct {
+	ent.Schema
+}
+
+func (DatasetExampleOverride) Annotations() []schema.Annotation {
+	return []schema.Annotation{entsql.Annotation{Table: "dataset_example_overrides"}}
+}
+
+func (DatasetExampleOverride) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("dataset_id").NotEmpty(),
+		field.String("trace_id").NotEmpty(),
+		field.String("expected_output").Default(""),
+		field.String("tags_json").Default("[]"),
+		field.String("input_override_json").Default(""),
+		field.String("updated_by").Default(""),
+		field.Time("updated_at").Default(time.Now),
+	}
+}
+
+func (DatasetExampleOverride) Indexes() []ent.Index {
+	return []ent.Index{index.Fields("dataset_id", "trace_id").Unique()}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type DatasetSnapshot struct {
+	ent.Schema
+}
+
+func (DatasetSnapshot) Annotations() []schema.Annotation {
+	return []schema.Annotation{entsql.Annotation{Table: "dataset_snapshots"}}
+}
+
+func (DatasetSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Immutable(),
+		field.String("dataset_id").NotEmpty(),
+		field.Int("version").Default(0),
+		field.String("note").Default(""),
+		field.String("created_by").Default(""),
+		field.Int("example_count").Default(0),
+		field.String("examples_json").Default("[]"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (DatasetSnapshot) Indexes() []ent.Index {
+	return []ent.Index{index.Fields("dataset_id", "version").Unique()}
+}
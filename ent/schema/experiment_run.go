@@ -23,8 +23,8 @@ func (ExperimentRun) Fields() []ent.Field {
 		field.String("id").NotEmpty().Immutable(),
 		field.String("name").Default(""),
 		field.String("description").Default(""),
-		field.String("baseline_eval_run_id").NotEmpty(),
-		field.String("candidate_eval_run_id").NotEmpty(),
+		field.String("baseline_eval_run_id"),
+		field.String("candidate_eval_run_id"),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Int("baseline_score_count").Default(0),
 		field.Int("candidate_score_count").Default(0),
@@ -34,6 +34,8 @@ func (ExperimentRun) Fields() []ent.Field {
 		field.Int("matched_score_count").Default(0),
 		field.Int("improvement_count").Default(0),
 		field.Int("regression_count").Default(0),
+		field.String("status").Default("completed"),
+		field.String("error").Default(""),
 	}
 }
 
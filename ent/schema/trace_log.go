@@ -59,6 +59,8 @@ func (TraceLog) Fields() []ent.Field {
 		field.Float("routing_score").Default(0),
 		field.Int("routing_candidate_count").Default(0),
 		field.String("routing_failure_reason").Default(""),
+		field.String("token_name").Default(""),
+		field.Bool("client_canceled").Default(false),
 	}
 }
 
@@ -68,5 +70,6 @@ func (TraceLog) Indexes() []ent.Index {
 		index.Fields("model", "recorded_at"),
 		index.Fields("session_id", "recorded_at"),
 		index.Fields("request_id"),
+		index.Fields("token_name", "recorded_at"),
 	}
 }
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type TraceTag struct {
+	ent.Schema
+}
+
+func (TraceTag) Annotations() []schema.Annotation {
+	return []schema.Annotation{entsql.Annotation{Table: "trace_tags"}}
+}
+
+func (TraceTag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("target_type").NotEmpty(),
+		field.String("target_id").NotEmpty(),
+		field.String("tag").NotEmpty(),
+		field.String("created_by").Default(""),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (TraceTag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_type", "target_id", "tag").Unique(),
+		index.Fields("tag"),
+	}
+}
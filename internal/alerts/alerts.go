@@ -0,0 +1,382 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/store"
+)
+
+const (
+	MetricErrorRate    = "error_rate"
+	MetricTTFTP95      = "ttft_p95_ms"
+	MetricLatencyP95   = "latency_p95_ms"
+	MetricRequestCount = "request_count"
+	MetricTotalTokens  = "total_tokens"
+
+	// SourceAlert / CategoryAlert 标记由告警规则产生的系统事件。
+	SourceAlert   = "alert"
+	CategoryAlert = "alert"
+
+	SourceConfig = "config"
+	SourceAPI    = "api"
+
+	defaultInterval     = time.Minute
+	defaultWindow       = 5 * time.Minute
+	minWindow           = time.Minute
+	maxWindow           = 31 * 24 * time.Hour
+	defaultOperator     = ">"
+	defaultMinRequests  = 1
+	defaultSeverity     = "warning"
+	fingerprintPrefix   = "alert:"
+	ruleIDPatternSource = `^[A-Za-z0-9][A-Za-z0-9_.-]*$`
+)
+
+var (
+	metrics       = []string{MetricErrorRate, MetricTTFTP95, MetricLatencyP95, MetricRequestCount, MetricTotalTokens}
+	operators     = []string{">", ">=", "<", "<="}
+	severities    = []string{"info", "warning", "error", "critical"}
+	ruleIDPattern = regexp.MustCompile(ruleIDPatternSource)
+)
+
+// RuleInput 是 monitor API / MCP 创建或更新规则的请求体，Window 使用 Go duration 格式（如 5m、24h）。
+type RuleInput struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Metric      string  `json:"metric"`
+	Operator    string  `json:"operator,omitempty"`
+	Threshold   float64 `json:"threshold"`
+	Window      string  `json:"window,omitempty"`
+	Model       string  `json:"model,omitempty"`
+	Channel     string  `json:"channel,omitempty"`
+	Token       string  `json:"token,omitempty"`
+	MinRequests int     `json:"min_requests,omitempty"`
+	Severity    string  `json:"severity,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// Rule 把请求体转换为校验后的规则，未指定 enabled 时默认启用。
+func (in RuleInput) Rule() (store.AlertRule, error) {
+	var window time.Duration
+	if text := strings.TrimSpace(in.Window); text != "" {
+		parsed, err := time.ParseDuration(text)
+		if err != nil {
+			return store.AlertRule{}, fmt.Errorf("invalid window %q: %w", in.Window, err)
+		}
+		window = parsed
+	}
+	enabled := in.Enabled == nil || *in.Enabled
+	return Normalize(store.AlertRule{
+		ID:            in.ID,
+		Name:          in.Name,
+		Description:   in.Description,
+		Metric:        in.Metric,
+		Operator:      in.Operator,
+		Threshold:     in.Threshold,
+		WindowSeconds: int64(window / time.Second),
+		Model:         in.Model,
+		Channel:       in.Channel,
+		Token:         in.Token,
+		MinRequests:   in.MinRequests,
+		Severity:      in.Severity,
+		Enabled:       enabled,
+		Source:        SourceAPI,
+	})
+}
+
+// RuleFromConfig 把配置文件中的规则转换为校验后的规则。
+func RuleFromConfig(item config.AlertRule) (store.AlertRule, error) {
+	return Normalize(store.AlertRule{
+		ID:            item.ID,
+		Name:          item.Name,
+		Description:   item.Description,
+		Metric:        item.Metric,
+		Operator:      item.Operator,
+		Threshold:     item.Threshold,
+		WindowSeconds: int64(item.Window / time.Second),
+		Model:         item.Model,
+		Channel:       item.Channel,
+		Token:         item.Token,
+		MinRequests:   item.MinRequests,
+		Severity:      item.Severity,
+		Enabled:       !item.Disabled,
+		Source:        SourceConfig,
+	})
+}
+
+// Normalize 校验规则并补齐默认值：operator 默认 >，window 默认 5m，min_requests 默认 1，severity 默认 warning。
+func Normalize(rule store.AlertRule) (store.AlertRule, error) {
+	rule.ID = strings.TrimSpace(rule.ID)
+	if !ruleIDPattern.MatchString(rule.ID) {
+		return store.AlertRule{}, fmt.Errorf("alert rule id %q must match %s", rule.ID, ruleIDPatternSource)
+	}
+	rule.Metric = strings.ToLower(strings.TrimSpace(rule.Metric))
+	if !slices.Contains(metrics, rule.Metric) {
+		return store.AlertRule{}, fmt.Errorf("alert rule %s: unknown metric %q (want one of %s)", rule.ID, rule.Metric, strings.Join(metrics, ", "))
+	}
+	rule.Operator = strings.TrimSpace(rule.Operator)
+	if rule.Operator == "" {
+		rule.Operator = defaultOperator
+	}
+	if !slices.Contains(operators, rule.Operator) {
+		return store.AlertRule{}, fmt.Errorf("alert rule %s: unknown operator %q", rule.ID, rule.Operator)
+	}
+	if rule.Metric == MetricErrorRate && (rule.Threshold < 0 || rule.Threshold > 1) {
+		return store.AlertRule{}, fmt.Errorf("alert rule %s: error_rate threshold must be between 0 and 1", rule.ID)
+	}
+	window := time.Duration(rule.WindowSeconds) * time.Second
+	if window == 0 {
+		window = defaultWindow
+	}
+	if window < minWindow || window > maxWindow {
+		return store.AlertRule{}, fmt.Errorf("alert rule %s: window must be between %s and %s", rule.ID, minWindow, maxWindow)
+	}
+	rule.WindowSeconds = int64(window / time.Second)
+	if rule.MinRequests <= 0 {
+		rule.MinRequests = defaultMinRequests
+	}
+	rule.Severity = strings.ToLower(strings.TrimSpace(rule.Severity))
+	if rule.Severity == "" {
+		rule.Severity = defaultSeverity
+	}
+	if !slices.Contains(severities, rule.Severity) {
+		return store.AlertRule{}, fmt.Errorf("alert rule %s: unknown severity %q", rule.ID, rule.Severity)
+	}
+	if strings.TrimSpace(rule.Name) == "" {
+		rule.Name = rule.ID
+	}
+	return rule, nil
+}
+
+// Evaluation 是某条规则在一个窗口内的评估结果；样本不足时 Breached 为 false。
+type Evaluation struct {
+	RuleID   string                 `json:"rule_id"`
+	Value    float64                `json:"value"`
+	Samples  int                    `json:"samples"`
+	Breached bool                   `json:"breached"`
+	Since    time.Time              `json:"since"`
+	Until    time.Time              `json:"until"`
+	Stats    store.AlertWindowStats `json:"stats"`
+}
+
+// Evaluate 计算规则在 (now - window, now] 内的指标值，不修改规则状态。
+func Evaluate(st *store.Store, rule store.AlertRule, now time.Time) (Evaluation, error) {
+	window := time.Duration(rule.WindowSeconds) * time.Second
+	since := now.Add(-window)
+	percentiles := rule.Metric == MetricTTFTP95 || rule.Metric == MetricLatencyP95
+	stats, err := st.AlertWindowStats(store.AlertScope{Model: rule.Model, Channel: rule.Channel, Token: rule.Token}, since, now, percentiles)
+	if err != nil {
+		return Evaluation{}, err
+	}
+	out := Evaluation{RuleID: rule.ID, Since: since, Until: now, Stats: stats}
+	enough := true
+	switch rule.Metric {
+	case MetricErrorRate:
+		out.Samples = stats.Requests
+		if stats.Requests > 0 {
+			out.Value = float64(stats.Failed) / float64(stats.Requests)
+		}
+		enough = stats.Requests >= rule.MinRequests
+	case MetricTTFTP95:
+		out.Value, out.Samples = stats.TTFTP95Ms, stats.TTFTSamples
+		enough = stats.TTFTSamples >= rule.MinRequests
+	case MetricLatencyP95:
+		out.Value, out.Samples = stats.LatencyP95Ms, stats.LatencySamples
+		enough = stats.LatencySamples >= rule.MinRequests
+	case MetricRequestCount:
+		out.Value, out.Samples = float64(stats.Requests), stats.Requests
+	case MetricTotalTokens:
+		out.Value, out.Samples = float64(stats.TotalTokens), stats.Requests
+	default:
+		return Evaluation{}, fmt.Errorf("alert rule %s: unknown metric %q", rule.ID, rule.Metric)
+	}
+	out.Breached = enough && compare(out.Value, rule.Operator, rule.Threshold)
+	return out, nil
+}
+
+func compare(value float64, operator string, threshold float64) bool {
+	switch operator {
+	case ">":
+		return value > threshold
+	case ">=":
+		return value >= threshold
+	case "<":
+		return value < threshold
+	case "<=":
+		return value <= threshold
+	}
+	return false
+}
+
+// Evaluator 定期评估数据库中的启用规则，状态变为 firing 时记录系统事件，恢复时把事件标记为 resolved。
+type Evaluator struct {
+	store    *store.Store
+	interval time.Duration
+	now      func() time.Time
+}
+
+// New 校验并写入配置文件中的规则（source=config），已存在的同 ID 规则会被覆盖定义、保留状态。
+func New(st *store.Store, cfg config.AlertsConfig) (*Evaluator, error) {
+	e := &Evaluator{store: st, interval: cfg.Interval, now: time.Now}
+	if e.interval <= 0 {
+		e.interval = defaultInterval
+	}
+	seen := map[string]bool{}
+	for i, item := range cfg.Rules {
+		rule, err := RuleFromConfig(item)
+		if err != nil {
+			return nil, fmt.Errorf("alerts.rules[%d]: %w", i, err)
+		}
+		if seen[rule.ID] {
+			return nil, fmt.Errorf("alerts.rules[%d]: duplicate id %q", i, rule.ID)
+		}
+		seen[rule.ID] = true
+		if st != nil {
+			if _, err := st.SaveAlertRule(rule); err != nil {
+				return nil, fmt.Errorf("alerts.rules[%d]: %w", i, err)
+			}
+		}
+	}
+	return e, nil
+}
+
+func (e *Evaluator) Run(ctx context.Context) {
+	if e == nil || e.store == nil {
+		return
+	}
+	e.EvaluateAll(ctx)
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.EvaluateAll(ctx)
+		}
+	}
+}
+
+// EvaluateAll 评估全部规则并写回状态，返回本轮状态发生变化的规则数；停用的规则若仍在 firing 会被恢复。
+func (e *Evaluator) EvaluateAll(ctx context.Context) int {
+	rules, err := e.store.ListAlertRules()
+	if err != nil {
+		slog.Warn("List alert rules failed", "error", err)
+		return 0
+	}
+	now := e.now().UTC()
+	changed := 0
+	for _, rule := range rules {
+		if ctx.Err() != nil {
+			break
+		}
+		var evaluation Evaluation
+		if rule.Enabled {
+			if evaluation, err = Evaluate(e.store, rule, now); err != nil {
+				slog.Warn("Evaluate alert rule failed", "rule", rule.ID, "error", err)
+				continue
+			}
+		} else if rule.State != store.AlertStateFiring {
+			continue
+		}
+		ok, err := e.apply(rule, evaluation, now)
+		if err != nil {
+			slog.Warn("Record alert state failed", "rule", rule.ID, "error", err)
+			continue
+		}
+		if ok {
+			changed++
+		}
+	}
+	return changed
+}
+
+func (e *Evaluator) apply(rule store.AlertRule, evaluation Evaluation, now time.Time) (bool, error) {
+	state := store.AlertRuleState{
+		State:       rule.State,
+		Value:       evaluation.Value,
+		Samples:     evaluation.Samples,
+		EventID:     rule.EventID,
+		EvaluatedAt: now,
+	}
+	switch {
+	case evaluation.Breached && rule.State != store.AlertStateFiring:
+		event, err := e.store.UpsertSystemEvent(firingEvent(rule, evaluation, now))
+		if err != nil {
+			return false, err
+		}
+		state.State, state.EventID, state.Changed = store.AlertStateFiring, event.ID, true
+		slog.Info("Alert firing", "rule", rule.ID, "metric", rule.Metric, "value", evaluation.Value, "threshold", rule.Threshold)
+	case !evaluation.Breached && rule.State == store.AlertStateFiring:
+		if rule.EventID != "" {
+			if err := e.store.ResolveSystemEvent(rule.EventID); err != nil {
+				return false, err
+			}
+		}
+		state.State, state.Changed = store.AlertStateOK, true
+		slog.Info("Alert resolved", "rule", rule.ID, "metric", rule.Metric, "value", evaluation.Value)
+	}
+	if err := e.store.UpdateAlertRuleState(rule.ID, state); err != nil {
+		return false, err
+	}
+	return state.Changed, nil
+}
+
+// firingEvent 为一次触发构造系统事件。fingerprint 带上触发时间，每次 firing 都是独立事件，
+// 不会被通知的出现次数翻倍去重吞掉；恢复时只 resolve 这一次触发的事件。
+func firingEvent(rule store.AlertRule, evaluation Evaluation, firedAt time.Time) store.SystemEvent {
+	window := time.Duration(rule.WindowSeconds) * time.Second
+	details, _ := json.Marshal(map[string]any{
+		"rule_id":   rule.ID,
+		"metric":    rule.Metric,
+		"operator":  rule.Operator,
+		"threshold": rule.Threshold,
+		"window":    window.String(),
+		"value":     evaluation.Value,
+		"samples":   evaluation.Samples,
+		"token":     rule.Token,
+		"stats":     evaluation.Stats,
+	})
+	return store.SystemEvent{
+		Fingerprint: fingerprintPrefix + rule.ID + ":" + strconv.FormatInt(firedAt.Unix(), 10),
+		Source:      SourceAlert,
+		Category:    CategoryAlert,
+		Severity:    rule.Severity,
+		Title:       "Alert: " + rule.Name,
+		Message: fmt.Sprintf("%s = %s %s %s over %s (%d samples)", rule.Metric, formatValue(rule.Metric, evaluation.Value),
+			rule.Operator, formatValue(rule.Metric, rule.Threshold), window, evaluation.Samples),
+		DetailsJSON: details,
+		UpstreamID:  rule.Channel,
+		Model:       rule.Model,
+	}
+}
+
+func formatValue(metric string, value float64) string {
+	if metric == MetricErrorRate {
+		return strconv.FormatFloat(value*100, 'f', 1, 64) + "%"
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// DeleteRule 删除规则；规则仍在 firing 时先把对应系统事件标记为 resolved。
+func DeleteRule(st *store.Store, id string) error {
+	rule, err := st.GetAlertRule(id)
+	if err != nil {
+		return err
+	}
+	if rule.State == store.AlertStateFiring && rule.EventID != "" {
+		if err := st.ResolveSystemEvent(rule.EventID); err != nil {
+			return err
+		}
+	}
+	return st.DeleteAlertRule(rule.ID)
+}
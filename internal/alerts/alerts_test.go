@@ -0,0 +1,241 @@
+package alerts
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/pkg/recordfile"
+)
+
+func TestEvaluatorFiresAndResolvesErrorRateAlert(t *testing.T) {
+	dir := t.TempDir()
+	st := newTestStore(t, dir)
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	for i, status := range []int{500, 502, 200} {
+		writeIndexedTrace(t, st, dir, "req-"+string(rune('a'+i)), func(meta *recordfile.MetaData, _ *recordfile.UsageInfo) {
+			meta.Time = now.Add(-time.Duration(i+1) * time.Minute)
+			meta.StatusCode = status
+			meta.SelectedUpstreamID = "primary"
+		})
+	}
+	writeIndexedTrace(t, st, dir, "req-other", func(meta *recordfile.MetaData, _ *recordfile.UsageInfo) {
+		meta.Time = now.Add(-time.Minute)
+		meta.SelectedUpstreamID = "secondary"
+	})
+
+	evaluator, err := New(st, config.AlertsConfig{Rules: []config.AlertRule{{
+		ID:          "primary-errors",
+		Metric:      MetricErrorRate,
+		Threshold:   0.5,
+		Window:      5 * time.Minute,
+		Channel:     "primary",
+		MinRequests: 3,
+		Severity:    "error",
+	}}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	evaluator.now = func() time.Time { return now }
+
+	if changed := evaluator.EvaluateAll(context.Background()); changed != 1 {
+		t.Fatalf("EvaluateAll() changed = %d, want 1", changed)
+	}
+	rule, err := st.GetAlertRule("primary-errors")
+	if err != nil {
+		t.Fatalf("GetAlertRule() error = %v", err)
+	}
+	if rule.State != store.AlertStateFiring || rule.LastSamples != 3 || rule.Source != SourceConfig || rule.EventID == "" {
+		t.Fatalf("rule after firing = %+v", rule)
+	}
+	event, err := st.GetSystemEvent(rule.EventID)
+	if err != nil {
+		t.Fatalf("GetSystemEvent() error = %v", err)
+	}
+	if event.Category != CategoryAlert || event.Severity != "error" || event.Status != store.SystemEventStatusUnread ||
+		event.UpstreamID != "primary" || !strings.Contains(event.Message, "error_rate = 66.7% > 50.0% over 5m0s (3 samples)") {
+		t.Fatalf("firing event = %+v", event)
+	}
+
+	// 仍在 firing 时不重复记录事件。
+	if changed := evaluator.EvaluateAll(context.Background()); changed != 0 {
+		t.Fatalf("EvaluateAll() while firing changed = %d, want 0", changed)
+	}
+
+	now = now.Add(10 * time.Minute)
+	if changed := evaluator.EvaluateAll(context.Background()); changed != 1 {
+		t.Fatalf("EvaluateAll() after window passed changed = %d, want 1", changed)
+	}
+	rule, _ = st.GetAlertRule("primary-errors")
+	event, _ = st.GetSystemEvent(rule.EventID)
+	if rule.State != store.AlertStateOK || event.Status != store.SystemEventStatusResolved || event.OccurrenceCount != 1 {
+		t.Fatalf("after resolve rule = %+v, event = %+v", rule, event)
+	}
+
+	// 再次触发记录新的事件，而不是累加到上一次已恢复的事件上。
+	for i, status := range []int{500, 503, 504} {
+		writeIndexedTrace(t, st, dir, "req-again-"+string(rune('a'+i)), func(meta *recordfile.MetaData, _ *recordfile.UsageInfo) {
+			meta.Time = now.Add(-time.Duration(i+1) * time.Minute)
+			meta.StatusCode = status
+			meta.SelectedUpstreamID = "primary"
+		})
+	}
+	if changed := evaluator.EvaluateAll(context.Background()); changed != 1 {
+		t.Fatalf("EvaluateAll() refiring changed = %d, want 1", changed)
+	}
+	refired, _ := st.GetAlertRule("primary-errors")
+	second, err := st.GetSystemEvent(refired.EventID)
+	if err != nil || refired.State != store.AlertStateFiring || second.ID == event.ID || second.Fingerprint == event.Fingerprint ||
+		second.Status != store.SystemEventStatusUnread || second.OccurrenceCount != 1 {
+		t.Fatalf("refired rule = %+v, event = %+v, err = %v", refired, second, err)
+	}
+}
+
+func TestEvaluateTokenBudgetTTFTAndNoTraffic(t *testing.T) {
+	dir := t.TempDir()
+	st := newTestStore(t, dir)
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	for i := 1; i <= 20; i++ {
+		writeIndexedTrace(t, st, dir, "req-"+strings.Repeat("x", i), func(meta *recordfile.MetaData, usage *recordfile.UsageInfo) {
+			meta.Time = now.Add(-time.Duration(i) * time.Hour)
+			meta.Model = "gpt-4o"
+			meta.TokenName = "ci"
+			meta.TTFTMs = int64(i * 100)
+			usage.PromptTokens, usage.CompletionTokens, usage.TotalTokens = 40, 10, 50
+		})
+	}
+
+	for _, tc := range []struct {
+		name     string
+		rule     store.AlertRule
+		value    float64
+		samples  int
+		breached bool
+	}{
+		{"token budget", store.AlertRule{ID: "ci-budget", Metric: MetricTotalTokens, Threshold: 500, WindowSeconds: 86400, Token: "ci"}, 1000, 20, true},
+		{"token budget other token", store.AlertRule{ID: "eval-budget", Metric: MetricTotalTokens, Threshold: 500, WindowSeconds: 86400, Token: "eval"}, 0, 0, false},
+		{"ttft p95", store.AlertRule{ID: "ttft", Metric: MetricTTFTP95, Threshold: 1800, WindowSeconds: 86400, Model: "gpt-4o"}, 1900, 20, true},
+		{"ttft min samples", store.AlertRule{ID: "ttft-min", Metric: MetricTTFTP95, Threshold: 1, WindowSeconds: 86400, MinRequests: 50}, 1900, 20, false},
+		{"ttft glob model", store.AlertRule{ID: "ttft-glob", Metric: MetricTTFTP95, Threshold: 1800, WindowSeconds: 86400, Model: "GPT-4*"}, 1900, 20, true},
+		{"ttft glob other model", store.AlertRule{ID: "ttft-claude", Metric: MetricTTFTP95, Threshold: 1800, WindowSeconds: 86400, Model: "claude-*"}, 0, 0, false},
+		{"no traffic", store.AlertRule{ID: "quiet", Metric: MetricRequestCount, Operator: "<", Threshold: 1, WindowSeconds: 1800}, 0, 0, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, err := Normalize(tc.rule)
+			if err != nil {
+				t.Fatalf("Normalize() error = %v", err)
+			}
+			evaluation, err := Evaluate(st, rule, now)
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+			if evaluation.Value != tc.value || evaluation.Samples != tc.samples || evaluation.Breached != tc.breached {
+				t.Fatalf("Evaluate() = value %v samples %d breached %v, want %v %d %v", evaluation.Value, evaluation.Samples, evaluation.Breached, tc.value, tc.samples, tc.breached)
+			}
+			// 非分位数指标不计算 p95。
+			if rule.Metric != MetricTTFTP95 && evaluation.Stats.TTFTP95Ms != 0 {
+				t.Fatalf("Stats.TTFTP95Ms = %v for metric %s, want 0", evaluation.Stats.TTFTP95Ms, rule.Metric)
+			}
+		})
+	}
+}
+
+func TestRuleInputValidationAndDeleteResolvesFiringEvent(t *testing.T) {
+	for _, in := range []RuleInput{
+		{ID: "a/b", Metric: MetricRequestCount},
+		{ID: "x", Metric: "cost"},
+		{ID: "x", Metric: MetricErrorRate, Threshold: 10},
+		{ID: "x", Metric: MetricRequestCount, Operator: "=="},
+		{ID: "x", Metric: MetricRequestCount, Window: "10s"},
+		{ID: "x", Metric: MetricRequestCount, Window: "soon"},
+		{ID: "x", Metric: MetricRequestCount, Severity: "page"},
+	} {
+		if _, err := in.Rule(); err == nil {
+			t.Fatalf("Rule(%+v) error = nil, want validation error", in)
+		}
+	}
+	disabled := false
+	rule, err := RuleInput{ID: "quiet", Metric: "REQUEST_COUNT", Threshold: 1, Enabled: &disabled}.Rule()
+	if err != nil {
+		t.Fatalf("Rule() error = %v", err)
+	}
+	if rule.Name != "quiet" || rule.Operator != ">" || rule.WindowSeconds != 300 || rule.Severity != "warning" || rule.Enabled || rule.Source != SourceAPI {
+		t.Fatalf("Rule() defaults = %+v", rule)
+	}
+
+	st := newTestStore(t, t.TempDir())
+	rule.Operator, rule.Enabled = "<", true
+	if _, err := st.SaveAlertRule(rule); err != nil {
+		t.Fatalf("SaveAlertRule() error = %v", err)
+	}
+	evaluator, _ := New(st, config.AlertsConfig{})
+	if changed := evaluator.EvaluateAll(context.Background()); changed != 1 {
+		t.Fatalf("EvaluateAll() changed = %d, want 1", changed)
+	}
+	firing, _ := st.GetAlertRule("quiet")
+	if err := DeleteRule(st, "quiet"); err != nil {
+		t.Fatalf("DeleteRule() error = %v", err)
+	}
+	event, err := st.GetSystemEvent(firing.EventID)
+	if err != nil || event.Status != store.SystemEventStatusResolved {
+		t.Fatalf("event after delete = %+v, %v", event, err)
+	}
+	if err := DeleteRule(st, "quiet"); err == nil {
+		t.Fatalf("DeleteRule() on missing rule should fail")
+	}
+}
+
+func newTestStore(t *testing.T, dir string) *store.Store {
+	t.Helper()
+	st, err := store.New(dir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	t.Cleanup(func() { st.Close() })
+	return st
+}
+
+func writeIndexedTrace(t *testing.T, st *store.Store, dir string, requestID string, mutate func(*recordfile.MetaData, *recordfile.UsageInfo)) {
+	t.Helper()
+	reqHead := "POST /v1/chat/completions HTTP/1.1\r\nHost: example.com\r\n\r\n"
+	reqBody := `{"model":"gpt-4o-mini","messages":[]}`
+	resHead := "HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n"
+	resBody := `{"id":"chatcmpl-1","object":"chat.completion","choices":[]}`
+	header := recordfile.RecordHeader{
+		Version: "LLM_PROXY_V3",
+		Meta: recordfile.MetaData{
+			RequestID:  requestID,
+			Model:      "gpt-4o-mini",
+			Provider:   "openai_compatible",
+			Operation:  "chat.completions",
+			Endpoint:   "/v1/chat/completions",
+			URL:        "/v1/chat/completions",
+			Method:     "POST",
+			StatusCode: 200,
+			DurationMs: 800,
+		},
+		Layout: recordfile.LayoutInfo{
+			ReqHeaderLen: int64(len(reqHead)),
+			ReqBodyLen:   int64(len(reqBody)),
+			ResHeaderLen: int64(len(resHead)),
+			ResBodyLen:   int64(len(resBody)),
+		},
+	}
+	mutate(&header.Meta, &header.Usage)
+	prelude, err := recordfile.MarshalPrelude(header, recordfile.BuildEvents(header))
+	if err != nil {
+		t.Fatalf("MarshalPrelude() error = %v", err)
+	}
+	logPath := filepath.Join(dir, requestID+".http")
+	if err := os.WriteFile(logPath, []byte(string(prelude)+reqHead+reqBody+"\n"+resHead+resBody), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := st.UpsertLog(logPath, header); err != nil {
+		t.Fatalf("UpsertLog() error = %v", err)
+	}
+}
@@ -40,6 +40,7 @@ func DefaultDetectors() []Detector {
 		CredentialDetector{},
 		ProviderSafetyDetector{},
 		ToolErrorDetector{},
+		PromptInjectionDetector{},
 	}
 }
 
@@ -67,7 +68,7 @@ func (r *Runner) Analyze(ctx context.Context, obs observe.TraceObservation) ([]o
 				next[i].CreatedAt = time.Now().UTC()
 			}
 			if next[i].ID == "" {
-				next[i].ID = stableFindingID(next[i])
+				next[i].ID = StableFindingID(next[i])
 			}
 		}
 		findings = append(findings, next...)
@@ -232,25 +233,12 @@ func (d ToolErrorDetector) Detect(_ context.Context, obs observe.TraceObservatio
 	return dedupeFindings(findings), nil
 }
 
-var credentialPatterns = []struct {
-	name string
-	re   *regexp.Regexp
-}{
-	{"OpenAI API key", regexp.MustCompile(`sk-[A-Za-z0-9_-]{20,}`)},
-	{"AWS access key", regexp.MustCompile(`AKIA[0-9A-Z]{16}`)},
-	{"GitHub token", regexp.MustCompile(`gh[pousr]_[A-Za-z0-9_]{20,}`)},
-	{"Private key block", regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`)},
-	{"Bearer token", regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9._~+/=-]{20,}`)},
-	{"Database connection string", regexp.MustCompile(`(?i)(postgres|mysql|mongodb)://[^ \n\t]+`)},
-	{"Cookie header", regexp.MustCompile(`(?i)\bcookie:\s*[^;\n]+=[^;\n]+`)},
-}
-
 var destructiveRMPattern = regexp.MustCompile("(^|[\\s;&|:\"'])rm\\s+-[a-z]*r[a-z]*f[a-z]*\\s+(/|~)([\\s\"'`,}$]|$|[;&|])")
 
 func credentialMatch(text string) (bool, string) {
 	for _, pattern := range credentialPatterns {
-		if pattern.re.MatchString(text) {
-			return true, pattern.name + " exposure"
+		if pattern.Match(text) {
+			return true, pattern.Name + " exposure"
 		}
 	}
 	if parsed, err := url.Parse(text); err == nil && parsed.User != nil && parsed.Scheme != "" && parsed.Host != "" {
@@ -278,11 +266,12 @@ func finding(traceID, category string, severity observe.Severity, confidence flo
 	} else {
 		f.EvidencePath = "trace#" + traceID
 	}
-	f.ID = stableFindingID(f)
+	f.ID = StableFindingID(f)
 	return f
 }
 
-func stableFindingID(f observe.Finding) string {
+// StableFindingID 根据 finding 的定位信息生成稳定 ID，重复分析同一 trace 时 ID 不变。
+func StableFindingID(f observe.Finding) string {
 	key := strings.Join([]string{f.TraceID, f.Category, string(f.Severity), f.NodeID, f.EvidencePath, f.Detector, f.DetectorVersion}, "|")
 	sum := sha1.Sum([]byte(key))
 	return "finding_" + hex.EncodeToString(sum[:])[:16]
@@ -322,7 +311,7 @@ func dedupeFindings(findings []observe.Finding) []observe.Finding {
 	out := make([]observe.Finding, 0, len(findings))
 	for _, finding := range findings {
 		if finding.ID == "" {
-			finding.ID = stableFindingID(finding)
+			finding.ID = StableFindingID(finding)
 		}
 		if _, ok := seen[finding.ID]; ok {
 			continue
@@ -0,0 +1,247 @@
+package analyzer
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+)
+
+// minZeroWidthRunes 是判定隐藏文本所需的零宽字符数量；开头的 BOM 与 emoji 使用的 ZWJ 不计入。
+const minZeroWidthRunes = 3
+
+var instructionOverridePatterns = []*regexp.Regexp{
+	regexp.MustCompile(`\b(ignore|disregard|forget|override)\s+(all\s+|any\s+)?(of\s+)?(the\s+|your\s+)?(previous|prior|above|earlier|preceding|original|system)\s+(instructions|prompts?|directions|rules|guidelines)`),
+	regexp.MustCompile(`\b(new|updated|real)\s+(system\s+)?instructions\s*:`),
+	regexp.MustCompile(`\byou\s+are\s+now\s+(in\s+)?(dan\b|developer\s+mode|jailbroken|an?\s+unrestricted)`),
+	regexp.MustCompile(`\bdo\s+anything\s+now\b`),
+	regexp.MustCompile(`\b(bypass|disable)\s+(your\s+|the\s+|all\s+)?(safety|content)\s+(filters?|guidelines|policies|restrictions)`),
+	regexp.MustCompile(`<\|?\s*(im_start\|?>\s*system|/?system\s*\|?>)`),
+}
+
+var (
+	markdownImagePattern = regexp.MustCompile(`!\[[^\]]*\]\(\s*<?(https?://[^)\s>]+)`)
+	urlPattern           = regexp.MustCompile(`https?://[^\s"'<>()\[\]{}` + "`" + `]+`)
+	emailPattern         = regexp.MustCompile(`[a-z0-9._%+-]+@[a-z0-9.-]+\.[a-z]{2,}`)
+	codeSpanPattern      = regexp.MustCompile("`([^`\n]{6,200})`")
+)
+
+// PromptInjectionDetector 检查 tool result、server tool result 与文件节点等不可信内容中的注入信号，
+// 并把本轮响应里引用了这些内容的工具调用标记为后续动作。
+type PromptInjectionDetector struct{}
+
+func (PromptInjectionDetector) Name() string    { return "prompt_injection" }
+func (PromptInjectionDetector) Version() string { return "0.1.0" }
+
+type injectedContent struct {
+	node       observe.SemanticNode
+	indicators []string
+}
+
+func (d PromptInjectionDetector) Detect(_ context.Context, obs observe.TraceObservation) ([]observe.Finding, error) {
+	var findings []observe.Finding
+	var injected []injectedContent
+	for _, node := range untrustedNodes(obs) {
+		text := untrustedText(node)
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		lower := strings.ToLower(text)
+		flagged := false
+		if match := matchInstructionOverride(lower); match != "" {
+			findings = append(findings, finding(obs.TraceID, "prompt_injection", observe.SeverityHigh, 0.85, "Instruction override in untrusted content",
+				"Tool result or fetched content contains text that tries to override the agent's instructions.",
+				node, excerpt(match), d.Name(), d.Version()))
+			flagged = true
+		}
+		if hidden, count := hiddenUnicode(text); count > 0 {
+			findings = append(findings, finding(obs.TraceID, "hidden_unicode_text", observe.SeverityMedium, 0.8, "Hidden unicode in untrusted content",
+				fmt.Sprintf("Tool result or fetched content contains %d invisible or bidirectional-control characters that can hide instructions from reviewers.", count),
+				node, excerpt(hidden), d.Name(), d.Version()))
+			flagged = true
+		}
+		if link := exfiltrationImage(text); link != "" {
+			findings = append(findings, finding(obs.TraceID, "markdown_image_exfiltration", observe.SeverityHigh, 0.7, "Markdown image exfiltration link",
+				"Untrusted content embeds a markdown image whose URL carries templated or bulky query data, a common channel for leaking context when the image is rendered.",
+				node, excerpt(link), d.Name(), d.Version()))
+			flagged = true
+		}
+		if flagged {
+			injected = append(injected, injectedContent{node: node, indicators: injectionIndicators(lower)})
+		}
+	}
+	findings = append(findings, d.detectFollowUps(obs, injected)...)
+	return dedupeFindings(findings), nil
+}
+
+// detectFollowUps 找出本轮响应中参数引用了注入内容（URL、域名、邮箱或代码片段）的工具调用。
+func (d PromptInjectionDetector) detectFollowUps(obs observe.TraceObservation, injected []injectedContent) []observe.Finding {
+	if len(injected) == 0 {
+		return nil
+	}
+	var calls []observe.SemanticNode
+	calls = append(calls, obs.Response.ToolCalls...)
+	calls = append(calls, obs.Stream.AccumulatedToolCalls...)
+	var findings []observe.Finding
+	for _, call := range calls {
+		args := strings.ToLower(firstNonEmpty(metadataString(call.Metadata, "arguments"), metadataString(call.Metadata, "input"), call.Text, rawText(call.JSON)))
+		if args == "" {
+			continue
+		}
+		for _, source := range injected {
+			indicator := firstIndicatorIn(args, source.indicators)
+			if indicator == "" {
+				continue
+			}
+			name := firstNonEmpty(metadataString(call.Metadata, "name"), call.ProviderType)
+			f := finding(obs.TraceID, "prompt_injection_followup", observe.SeverityHigh, 0.8, "Tool call acting on injected content",
+				fmt.Sprintf("Tool call %s reuses %q from untrusted content that carried injection signals (%s).", name, indicator, observe.EvidencePath(obs.TraceID, source.node)),
+				call, excerpt(args), d.Name(), d.Version())
+			f.EvidencePath = observe.EvidencePath(obs.TraceID, source.node) + " -> " + observe.EvidencePath(obs.TraceID, call)
+			f.ID = StableFindingID(f)
+			findings = append(findings, f)
+			break
+		}
+	}
+	return findings
+}
+
+// untrustedNodes 返回外部来源的内容节点；命中的节点不再展开子节点，避免同一段内容重复报告。
+func untrustedNodes(obs observe.TraceObservation) []observe.SemanticNode {
+	var out []observe.SemanticNode
+	var walk func([]observe.SemanticNode)
+	walk = func(nodes []observe.SemanticNode) {
+		for _, node := range nodes {
+			switch node.NormalizedType {
+			case observe.NodeToolResult, observe.NodeServerToolResult, observe.NodeFile:
+				out = append(out, node)
+			default:
+				walk(node.Children)
+			}
+		}
+	}
+	walk(obs.Request.Nodes)
+	walk(obs.Response.Nodes)
+	return out
+}
+
+func untrustedText(node observe.SemanticNode) string {
+	var parts []string
+	var walk func(observe.SemanticNode)
+	walk = func(n observe.SemanticNode) {
+		if n.Text != "" {
+			parts = append(parts, n.Text)
+		}
+		for _, child := range n.Children {
+			walk(child)
+		}
+	}
+	walk(node)
+	if len(parts) == 0 {
+		return firstNonEmpty(rawText(node.JSON), rawText(node.Raw))
+	}
+	return strings.Join(parts, "\n")
+}
+
+func matchInstructionOverride(lower string) string {
+	for _, pattern := range instructionOverridePatterns {
+		if loc := pattern.FindStringIndex(lower); loc != nil {
+			return lower[loc[0]:]
+		}
+	}
+	return ""
+}
+
+// hiddenUnicode 统计 Unicode tag、双向控制与零宽字符，并把 tag 字符还原为可见 ASCII 作为证据。
+func hiddenUnicode(text string) (string, int) {
+	var tags strings.Builder
+	tagCount, bidiCount, zeroWidthCount := 0, 0, 0
+	for i, r := range text {
+		switch {
+		case r >= 0xE0000 && r <= 0xE007F:
+			tagCount++
+			if r >= 0xE0020 && r <= 0xE007E {
+				tags.WriteRune(r - 0xE0000)
+			}
+		case r >= 0x202A && r <= 0x202E, r >= 0x2066 && r <= 0x2069:
+			bidiCount++
+		case r == 0x200B, r == 0x200C, r == 0x2060, r == 0x180E, r == 0xFEFF && i > 0:
+			zeroWidthCount++
+		}
+	}
+	if zeroWidthCount < minZeroWidthRunes {
+		zeroWidthCount = 0
+	}
+	count := tagCount + bidiCount + zeroWidthCount
+	if count == 0 {
+		return "", 0
+	}
+	if tags.Len() > 0 {
+		return "hidden text: " + tags.String(), count
+	}
+	return fmt.Sprintf("tag=%d bidi=%d zero_width=%d", tagCount, bidiCount, zeroWidthCount), count
+}
+
+// exfiltrationImage 只在图片 URL 的 query 带占位符或较长取值时返回，避免把 README 徽章当成外传链接。
+func exfiltrationImage(text string) string {
+	for _, match := range markdownImagePattern.FindAllStringSubmatch(text, -1) {
+		parsed, err := url.Parse(match[1])
+		if err != nil || parsed.RawQuery == "" {
+			continue
+		}
+		query := strings.ToLower(parsed.RawQuery)
+		if strings.ContainsAny(query, "{}[]<>$") || strings.Contains(query, "%7b") || strings.Contains(query, "%3c") {
+			return match[1]
+		}
+		for _, values := range parsed.Query() {
+			for _, value := range values {
+				if len(value) >= 32 {
+					return match[1]
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func injectionIndicators(lower string) []string {
+	seen := map[string]struct{}{}
+	var out []string
+	add := func(value string) {
+		value = strings.TrimSpace(value)
+		if len(value) < 6 {
+			return
+		}
+		if _, ok := seen[value]; ok {
+			return
+		}
+		seen[value] = struct{}{}
+		out = append(out, value)
+	}
+	for _, raw := range urlPattern.FindAllString(lower, -1) {
+		raw = strings.TrimRight(raw, ".,;:!?")
+		add(raw)
+		if parsed, err := url.Parse(raw); err == nil {
+			add(parsed.Hostname())
+		}
+	}
+	for _, email := range emailPattern.FindAllString(lower, -1) {
+		add(email)
+	}
+	for _, match := range codeSpanPattern.FindAllStringSubmatch(lower, -1) {
+		add(match[1])
+	}
+	return out
+}
+
+func firstIndicatorIn(text string, indicators []string) string {
+	for _, indicator := range indicators {
+		if strings.Contains(text, indicator) {
+			return indicator
+		}
+	}
+	return ""
+}
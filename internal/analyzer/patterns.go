@@ -0,0 +1,175 @@
+package analyzer
+
+import (
+	"math/big"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	SensitiveCategoryCredential = "credential"
+	SensitiveCategoryPII        = "pii"
+)
+
+// 个人信息实体类型，PIIDetector 按实体开关启用规则。
+const (
+	PIIEntityEmail      = "email"
+	PIIEntityPhone      = "phone"
+	PIIEntityNationalID = "national_id"
+	PIIEntityCreditCard = "credit_card"
+	PIIEntityIBAN       = "iban"
+	PIIEntityIPAddress  = "ip_address"
+)
+
+// PIIEntities 返回全部内置实体类型。
+func PIIEntities() []string {
+	return []string{PIIEntityEmail, PIIEntityPhone, PIIEntityNationalID, PIIEntityCreditCard, PIIEntityIBAN, PIIEntityIPAddress}
+}
+
+// SensitivePattern 描述一类敏感信息的匹配规则，离线检测器与代理出站 guard 共用同一份定义。
+type SensitivePattern struct {
+	Name     string
+	Category string
+	Regexp   *regexp.Regexp
+	// Entity 与 Locale 只用于个人信息规则；Locale 为空表示不区分地区。
+	Entity string
+	Locale string
+	// Validate 对正则命中的片段做二次校验（如 Luhn 校验），为空表示直接采信。
+	Validate func(string) bool
+}
+
+// Match 返回 text 中是否存在通过校验的命中。
+func (p SensitivePattern) Match(text string) bool {
+	if p.Regexp == nil {
+		return false
+	}
+	if p.Validate == nil {
+		return p.Regexp.MatchString(text)
+	}
+	for _, candidate := range p.Regexp.FindAllString(text, -1) {
+		if p.Validate(candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+var credentialPatterns = []SensitivePattern{
+	{Name: "OpenAI API key", Category: SensitiveCategoryCredential, Regexp: regexp.MustCompile(`sk-[A-Za-z0-9_-]{20,}`)},
+	{Name: "AWS access key", Category: SensitiveCategoryCredential, Regexp: regexp.MustCompile(`AKIA[0-9A-Z]{16}`)},
+	{Name: "GitHub token", Category: SensitiveCategoryCredential, Regexp: regexp.MustCompile(`gh[pousr]_[A-Za-z0-9_]{20,}`)},
+	{Name: "Private key block", Category: SensitiveCategoryCredential, Regexp: regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`)},
+	{Name: "Bearer token", Category: SensitiveCategoryCredential, Regexp: regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9._~+/=-]{20,}`)},
+	{Name: "Database connection string", Category: SensitiveCategoryCredential, Regexp: regexp.MustCompile(`(?i)(postgres|mysql|mongodb)://[^ \n\t]+`)},
+	{Name: "Cookie header", Category: SensitiveCategoryCredential, Regexp: regexp.MustCompile(`(?i)\bcookie:\s*[^;\n]+=[^;\n]+`)},
+}
+
+var piiPatterns = []SensitivePattern{
+	{Name: "email", Category: SensitiveCategoryPII, Entity: PIIEntityEmail, Regexp: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`)},
+	{Name: "phone", Category: SensitiveCategoryPII, Entity: PIIEntityPhone, Regexp: regexp.MustCompile(`\+[1-9]\d{0,2}[ -]?(?:\d[ -]?){6,13}\d`)},
+	{Name: "cn_mobile", Category: SensitiveCategoryPII, Entity: PIIEntityPhone, Locale: "cn", Regexp: regexp.MustCompile(`\b1[3-9]\d{9}\b`)},
+	{Name: "us_phone", Category: SensitiveCategoryPII, Entity: PIIEntityPhone, Locale: "us", Regexp: regexp.MustCompile(`\(\d{3}\) ?\d{3}-\d{4}\b|\b\d{3}[-.]\d{3}[-.]\d{4}\b`)},
+	{Name: "us_ssn", Category: SensitiveCategoryPII, Entity: PIIEntityNationalID, Locale: "us", Regexp: regexp.MustCompile(`\b\d{3}-\d{2}-\d{4}\b`)},
+	{Name: "credit_card", Category: SensitiveCategoryPII, Entity: PIIEntityCreditCard, Regexp: regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`), Validate: luhnValid},
+	{Name: "cn_id_card", Category: SensitiveCategoryPII, Entity: PIIEntityNationalID, Locale: "cn", Regexp: regexp.MustCompile(`\b\d{17}[\dXx]\b`), Validate: cnIDCardValid},
+	{Name: "iban", Category: SensitiveCategoryPII, Entity: PIIEntityIBAN, Regexp: regexp.MustCompile(`\b[A-Z]{2}\d{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,3})?\b`), Validate: ibanValid},
+	{Name: "ipv4", Category: SensitiveCategoryPII, Entity: PIIEntityIPAddress, Regexp: regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`), Validate: publicIPValid},
+	{Name: "ipv6", Category: SensitiveCategoryPII, Entity: PIIEntityIPAddress, Regexp: regexp.MustCompile(`(?i)\b(?:[0-9a-f]{1,4}:){2,7}(?::|[0-9a-f]{1,4})(?::[0-9a-f]{1,4}){0,5}\b`), Validate: publicIPValid},
+}
+
+// CredentialPatterns 返回内置凭据匹配规则的副本。
+func CredentialPatterns() []SensitivePattern {
+	return append([]SensitivePattern(nil), credentialPatterns...)
+}
+
+// PIIPatterns 返回内置个人信息匹配规则的副本；名称可在配置中按名引用。
+func PIIPatterns() []SensitivePattern {
+	return append([]SensitivePattern(nil), piiPatterns...)
+}
+
+// PIIPattern 按名称查找内置个人信息规则。
+func PIIPattern(name string) (SensitivePattern, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, pattern := range piiPatterns {
+		if pattern.Name == name {
+			return pattern, true
+		}
+	}
+	return SensitivePattern{}, false
+}
+
+func luhnValid(candidate string) bool {
+	var digits []int
+	for _, r := range candidate {
+		if r >= '0' && r <= '9' {
+			digits = append(digits, int(r-'0'))
+		}
+	}
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := digits[i]
+		if (len(digits)-1-i)%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// ibanValid 按 ISO 13616 把国家码与校验位移到末尾，字母换成数字后对 97 取模应为 1。
+func ibanValid(candidate string) bool {
+	compact := strings.ReplaceAll(candidate, " ", "")
+	if len(compact) < 15 || len(compact) > 34 {
+		return false
+	}
+	var digits strings.Builder
+	for _, r := range compact[4:] + compact[:4] {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r >= 'A' && r <= 'Z':
+			digits.WriteString(strconv.Itoa(int(r-'A') + 10))
+		default:
+			return false
+		}
+	}
+	value, ok := new(big.Int).SetString(digits.String(), 10)
+	return ok && new(big.Int).Mod(value, big.NewInt(97)).Int64() == 1
+}
+
+// publicIPValid 只接受公网地址：排除非法地址，回环、未指定、私有、链路本地、组播与广播地址，
+// 以及每段都是一位数、更像版本号（如 1.2.3.4）的 IPv4。
+func publicIPValid(candidate string) bool {
+	ip := net.ParseIP(candidate)
+	if ip == nil || ip.IsLoopback() || ip.IsUnspecified() || ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() ||
+		ip.Equal(net.IPv4bcast) {
+		return false
+	}
+	if ip.To4() != nil && len(candidate) == 7 {
+		// 四段均为一位数时长度恰好为 7，例如 1.2.3.4。
+		return false
+	}
+	return true
+}
+
+func cnIDCardValid(candidate string) bool {
+	if len(candidate) != 18 {
+		return false
+	}
+	weights := []int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+	checks := "10X98765432"
+	sum := 0
+	for i := 0; i < 17; i++ {
+		sum += int(candidate[i]-'0') * weights[i]
+	}
+	return strings.EqualFold(string(checks[sum%11]), candidate[17:])
+}
@@ -0,0 +1,197 @@
+package analyzer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+)
+
+// PIICategoryPrefix 是 PII finding 的类别前缀，完整类别为 pii_<entity>。
+const PIICategoryPrefix = "pii_"
+
+// PII 出现位置：用户输入、system prompt、模型生成（含工具调用参数）与工具返回。
+const (
+	PIISourceUser   = "user"
+	PIISourceSystem = "system"
+	PIISourceModel  = "model"
+	PIISourceTool   = "tool"
+)
+
+var piiEntityLabels = map[string]string{
+	PIIEntityEmail:      "Email address",
+	PIIEntityPhone:      "Phone number",
+	PIIEntityNationalID: "National ID number",
+	PIIEntityCreditCard: "Credit card number",
+	PIIEntityIBAN:       "IBAN",
+	PIIEntityIPAddress:  "IP address",
+}
+
+var piiSourceLabels = map[string]string{
+	PIISourceUser:   "user-supplied input",
+	PIISourceSystem: "system prompt",
+	PIISourceModel:  "model-generated output",
+	PIISourceTool:   "tool-returned content",
+}
+
+var piiEntitySeverity = map[string]observe.Severity{
+	PIIEntityEmail:      observe.SeverityMedium,
+	PIIEntityPhone:      observe.SeverityMedium,
+	PIIEntityNationalID: observe.SeverityHigh,
+	PIIEntityCreditCard: observe.SeverityHigh,
+	PIIEntityIBAN:       observe.SeverityHigh,
+	PIIEntityIPAddress:  observe.SeverityLow,
+}
+
+// PIIDetectorOptions 选择启用的实体与地区规则。Entities 中未出现的实体默认启用，
+// Locales 为空时启用全部地区规则，不区分地区的规则始终启用。
+type PIIDetectorOptions struct {
+	Entities map[string]bool
+	Locales  []string
+}
+
+// PIIDetector 检查流入 prompt 与流出响应的个人信息，并标注其来源；证据只保留打码后的片段。
+type PIIDetector struct {
+	patterns []SensitivePattern
+}
+
+// NewPIIDetector 按选项筛选内置 PII 规则，未知的实体或地区会返回错误。
+func NewPIIDetector(opts PIIDetectorOptions) (PIIDetector, error) {
+	for entity := range opts.Entities {
+		if _, ok := piiEntityLabels[entity]; !ok {
+			return PIIDetector{}, fmt.Errorf("unknown pii entity %q", entity)
+		}
+	}
+	knownLocales := map[string]bool{}
+	for _, pattern := range piiPatterns {
+		if pattern.Locale != "" {
+			knownLocales[pattern.Locale] = true
+		}
+	}
+	locales := map[string]bool{}
+	for _, locale := range opts.Locales {
+		locale = strings.ToLower(strings.TrimSpace(locale))
+		if !knownLocales[locale] {
+			return PIIDetector{}, fmt.Errorf("unknown pii locale %q", locale)
+		}
+		locales[locale] = true
+	}
+	var detector PIIDetector
+	for _, pattern := range piiPatterns {
+		if enabled, ok := opts.Entities[pattern.Entity]; ok && !enabled {
+			continue
+		}
+		if pattern.Locale != "" && len(locales) > 0 && !locales[pattern.Locale] {
+			continue
+		}
+		detector.patterns = append(detector.patterns, pattern)
+	}
+	return detector, nil
+}
+
+func (PIIDetector) Name() string    { return "pii" }
+func (PIIDetector) Version() string { return "0.1.0" }
+
+func (d PIIDetector) Detect(_ context.Context, obs observe.TraceObservation) ([]observe.Finding, error) {
+	var findings []observe.Finding
+	for _, item := range piiNodes(obs) {
+		text := firstNonEmpty(item.node.Text, metadataString(item.node.Metadata, "arguments"), metadataString(item.node.Metadata, "input"))
+		if text == "" {
+			continue
+		}
+		for _, entity := range PIIEntities() {
+			matches := d.matches(entity, text)
+			if len(matches) == 0 {
+				continue
+			}
+			label, source := piiEntityLabels[entity], piiSourceLabels[item.source]
+			masked := make([]string, 0, len(matches))
+			for _, match := range matches {
+				masked = append(masked, maskPII(match))
+			}
+			findings = append(findings, finding(obs.TraceID, PIICategoryPrefix+entity, piiEntitySeverity[entity], 0.8,
+				label+" in "+source,
+				fmt.Sprintf("%d %s value(s) found in %s (source=%s).", len(matches), strings.ToLower(label), source, item.source),
+				item.node, excerpt(entity+": "+strings.Join(masked, ", ")), d.Name(), d.Version()))
+		}
+	}
+	return dedupeFindings(findings), nil
+}
+
+// matches 返回某个实体在 text 中通过校验的去重命中。
+func (d PIIDetector) matches(entity string, text string) []string {
+	seen := map[string]struct{}{}
+	var out []string
+	for _, pattern := range d.patterns {
+		if pattern.Entity != entity {
+			continue
+		}
+		for _, candidate := range pattern.Regexp.FindAllString(text, -1) {
+			if pattern.Validate != nil && !pattern.Validate(candidate) {
+				continue
+			}
+			if _, ok := seen[candidate]; ok {
+				continue
+			}
+			seen[candidate] = struct{}{}
+			out = append(out, candidate)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+type piiNode struct {
+	node   observe.SemanticNode
+	source string
+}
+
+// piiNodes 遍历请求与响应节点，子节点继承父消息的来源；工具结果始终视为工具返回，工具调用参数视为模型生成。
+func piiNodes(obs observe.TraceObservation) []piiNode {
+	var out []piiNode
+	var walk func([]observe.SemanticNode, string)
+	walk = func(nodes []observe.SemanticNode, inherited string) {
+		for _, node := range nodes {
+			source := inherited
+			switch node.NormalizedType {
+			case observe.NodeToolResult, observe.NodeServerToolResult:
+				source = PIISourceTool
+			case observe.NodeToolCall, observe.NodeServerToolCall:
+				source = PIISourceModel
+			default:
+				source = piiRoleSource(node.Role, inherited)
+			}
+			out = append(out, piiNode{node: node, source: source})
+			walk(node.Children, source)
+		}
+	}
+	walk(obs.Request.Nodes, PIISourceUser)
+	walk(obs.Response.Nodes, PIISourceModel)
+	walk(obs.Stream.AccumulatedToolCalls, PIISourceModel)
+	return out
+}
+
+func piiRoleSource(role string, inherited string) string {
+	switch strings.ToLower(role) {
+	case "user":
+		return PIISourceUser
+	case "system", "developer":
+		return PIISourceSystem
+	case "assistant", "model":
+		return PIISourceModel
+	case "tool", "function":
+		return PIISourceTool
+	}
+	return inherited
+}
+
+// maskPII 只保留首尾各两个字符，避免 finding 本身再次保存完整的个人信息。
+func maskPII(value string) string {
+	runes := []rune(value)
+	if len(runes) <= 4 {
+		return strings.Repeat("*", len(runes))
+	}
+	return string(runes[:2]) + strings.Repeat("*", len(runes)-4) + string(runes[len(runes)-2:])
+}
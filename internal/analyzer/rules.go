@@ -0,0 +1,501 @@
+package analyzer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/glob"
+	"github.com/kingfs/llm-tracelab/pkg/jsonpath"
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// RuleDetectorPrefix 是规则 finding 的 detector 前缀，完整名称为 rules.<规则包名>。
+	RuleDetectorPrefix = "rules."
+
+	defaultRuleConfidence     = 0.8
+	defaultRuleReloadInterval = 10 * time.Second
+)
+
+// RulePack 是一个 YAML / JSON 规则文件；Version 是文件内容的哈希，规则有改动时 finding 的 DetectorVersion 随之变化。
+type RulePack struct {
+	Name    string `yaml:"name" json:"name"`
+	Rules   []Rule `yaml:"rules" json:"rules"`
+	Version string `yaml:"-" json:"version"`
+	Source  string `yaml:"-" json:"source,omitempty"`
+}
+
+// Rule 描述一条声明式检测规则。Title 与 Description 支持 {rule}、{pack}、{tool}、{role}、{node_type}、{match} 占位符。
+type Rule struct {
+	ID          string           `yaml:"id" json:"id"`
+	Category    string           `yaml:"category" json:"category"`
+	Severity    observe.Severity `yaml:"severity" json:"severity"`
+	Confidence  float64          `yaml:"confidence" json:"confidence"`
+	Title       string           `yaml:"title" json:"title"`
+	Description string           `yaml:"description" json:"description,omitempty"`
+	Match       RuleMatch        `yaml:"match" json:"match"`
+
+	pattern  *regexp.Regexp
+	jsonPath jsonpath.Path
+}
+
+// RuleMatch 的字段之间取交集，列表字段内取并集；空字段表示不限制。
+type RuleMatch struct {
+	NodeTypes []string `yaml:"node_types" json:"node_types,omitempty"`
+	Roles     []string `yaml:"roles" json:"roles,omitempty"`
+	// Tools 按 path.Match 通配符匹配工具名，例如 shell*。
+	Tools []string `yaml:"tools" json:"tools,omitempty"`
+	// JSONPath 从节点的结构化参数中取值再做正则 / 关键字匹配，支持 [n]、[*] 与 * 通配。
+	JSONPath string `yaml:"json_path" json:"json_path,omitempty"`
+	Regex    string `yaml:"regex" json:"regex,omitempty"`
+	// Keywords 忽略大小写，命中任意一个即可。
+	Keywords []string `yaml:"keywords" json:"keywords,omitempty"`
+}
+
+// ParseRulePack 解析并校验规则包，name 在文件未声明 name 时使用。
+func ParseRulePack(name string, data []byte) (RulePack, error) {
+	var pack RulePack
+	if err := yaml.Unmarshal(data, &pack); err != nil {
+		return RulePack{}, err
+	}
+	pack.Name = strings.TrimSpace(pack.Name)
+	if pack.Name == "" {
+		pack.Name = name
+	}
+	if pack.Name == "" {
+		return RulePack{}, fmt.Errorf("rule pack name is required")
+	}
+	if len(pack.Rules) == 0 {
+		return RulePack{}, fmt.Errorf("rule pack %s has no rules", pack.Name)
+	}
+	seen := map[string]bool{}
+	for i := range pack.Rules {
+		rule := &pack.Rules[i]
+		if err := compileRule(rule); err != nil {
+			return RulePack{}, fmt.Errorf("rule pack %s: rule %d: %w", pack.Name, i+1, err)
+		}
+		if seen[rule.ID] {
+			return RulePack{}, fmt.Errorf("rule pack %s: duplicate rule id %q", pack.Name, rule.ID)
+		}
+		seen[rule.ID] = true
+	}
+	sum := sha256.Sum256(data)
+	pack.Version = "sha256:" + hex.EncodeToString(sum[:])[:12]
+	return pack, nil
+}
+
+// LoadRulePackFile 读取单个规则文件，规则包名默认取文件名。
+func LoadRulePackFile(file string) (RulePack, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return RulePack{}, err
+	}
+	pack, err := ParseRulePack(strings.TrimSuffix(filepath.Base(file), filepath.Ext(file)), data)
+	if err != nil {
+		return RulePack{}, fmt.Errorf("%s: %w", file, err)
+	}
+	pack.Source = file
+	return pack, nil
+}
+
+// LoadRulePacks 按文件名顺序加载目录下的 .yaml / .yml / .json 规则文件。
+func LoadRulePacks(dir string) ([]RulePack, error) {
+	files, err := ruleFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	packs := make([]RulePack, 0, len(files))
+	names := map[string]string{}
+	for _, file := range files {
+		pack, err := LoadRulePackFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if previous, ok := names[pack.Name]; ok {
+			return nil, fmt.Errorf("rule pack %s is declared by both %s and %s", pack.Name, previous, file)
+		}
+		names[pack.Name] = file
+		packs = append(packs, pack)
+	}
+	return packs, nil
+}
+
+func compileRule(rule *Rule) error {
+	rule.ID = strings.TrimSpace(rule.ID)
+	if rule.ID == "" {
+		return fmt.Errorf("id is required")
+	}
+	rule.Category = strings.TrimSpace(rule.Category)
+	if rule.Category == "" {
+		rule.Category = rule.ID
+	}
+	switch rule.Severity {
+	case "":
+		rule.Severity = observe.SeverityMedium
+	case observe.SeverityInfo, observe.SeverityLow, observe.SeverityMedium, observe.SeverityHigh, observe.SeverityCritical:
+	default:
+		return fmt.Errorf("unknown severity %q", rule.Severity)
+	}
+	if rule.Confidence < 0 || rule.Confidence > 1 {
+		return fmt.Errorf("confidence must be between 0 and 1")
+	}
+	if rule.Confidence == 0 {
+		rule.Confidence = defaultRuleConfidence
+	}
+	if strings.TrimSpace(rule.Title) == "" {
+		rule.Title = rule.ID
+	}
+	match := &rule.Match
+	if err := glob.Validate(match.Tools); err != nil {
+		return fmt.Errorf("tools: %w", err)
+	}
+	if match.Regex != "" {
+		pattern, err := regexp.Compile(match.Regex)
+		if err != nil {
+			return fmt.Errorf("regex: %w", err)
+		}
+		rule.pattern = pattern
+	}
+	if match.JSONPath != "" {
+		parsed, err := jsonpath.Parse(match.JSONPath)
+		if err != nil {
+			return fmt.Errorf("json_path: %w", err)
+		}
+		rule.jsonPath = parsed
+	}
+	for i, keyword := range match.Keywords {
+		match.Keywords[i] = strings.ToLower(strings.TrimSpace(keyword))
+	}
+	if len(match.NodeTypes) == 0 && len(match.Roles) == 0 && len(match.Tools) == 0 && match.JSONPath == "" && match.Regex == "" && len(match.Keywords) == 0 {
+		return fmt.Errorf("match needs at least one condition")
+	}
+	return nil
+}
+
+// RuleDetector 运行规则目录中的全部规则包。目录内容变化后，下一次 Detect 会在 reload 间隔到期时自动重新加载；
+// 新规则有错误时保留上一版规则并记录日志。
+type RuleDetector struct {
+	dir      string
+	interval time.Duration
+
+	mu        sync.Mutex
+	packs     []RulePack
+	signature string
+	checkedAt time.Time
+}
+
+// NewRuleDetector 从目录加载规则，首次加载失败直接返回错误；reloadInterval 为 0 时使用 10s。
+func NewRuleDetector(dir string, reloadInterval time.Duration) (*RuleDetector, error) {
+	if reloadInterval <= 0 {
+		reloadInterval = defaultRuleReloadInterval
+	}
+	d := &RuleDetector{dir: dir, interval: reloadInterval}
+	if err := d.Reload(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// NewStaticRuleDetector 使用给定规则包，不做热加载，供 `analyze rules test` 等一次性场景使用。
+func NewStaticRuleDetector(packs ...RulePack) *RuleDetector {
+	return &RuleDetector{packs: packs}
+}
+
+func (d *RuleDetector) Name() string { return "rules" }
+
+// Version 汇总所有规则包的内容哈希，单条 finding 使用各自规则包的版本。
+func (d *RuleDetector) Version() string {
+	packs := d.Packs()
+	if len(packs) == 0 {
+		return "empty"
+	}
+	parts := make([]string, 0, len(packs))
+	for _, pack := range packs {
+		parts = append(parts, pack.Name+"@"+pack.Version)
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+	return "sha256:" + hex.EncodeToString(sum[:])[:12]
+}
+
+func (d *RuleDetector) Packs() []RulePack {
+	d.maybeReload()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]RulePack(nil), d.packs...)
+}
+
+// Reload 立即重新读取规则目录。
+func (d *RuleDetector) Reload() error {
+	if d.dir == "" {
+		return nil
+	}
+	signature, err := ruleDirSignature(d.dir)
+	if err != nil {
+		return err
+	}
+	packs, err := LoadRulePacks(d.dir)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.checkedAt = time.Now()
+	d.signature = signature
+	if err != nil {
+		return err
+	}
+	d.packs = packs
+	return nil
+}
+
+func (d *RuleDetector) maybeReload() {
+	if d.dir == "" {
+		return
+	}
+	d.mu.Lock()
+	due := time.Since(d.checkedAt) >= d.interval
+	if due {
+		d.checkedAt = time.Now()
+	}
+	previous := d.signature
+	d.mu.Unlock()
+	if !due {
+		return
+	}
+	signature, err := ruleDirSignature(d.dir)
+	if err != nil {
+		slog.Warn("Check detector rules failed", "dir", d.dir, "error", err)
+		return
+	}
+	if signature == previous {
+		return
+	}
+	if err := d.Reload(); err != nil {
+		slog.Warn("Reload detector rules failed, keeping previous rules", "dir", d.dir, "error", err)
+		return
+	}
+	d.mu.Lock()
+	count := len(d.packs)
+	d.mu.Unlock()
+	slog.Info("Reloaded detector rules", "dir", d.dir, "packs", count)
+}
+
+func (d *RuleDetector) Detect(_ context.Context, obs observe.TraceObservation) ([]observe.Finding, error) {
+	packs := d.Packs()
+	if len(packs) == 0 {
+		return nil, nil
+	}
+	var findings []observe.Finding
+	for _, target := range ruleTargets(obs) {
+		for _, pack := range packs {
+			for _, rule := range pack.Rules {
+				matched, ok := rule.match(target)
+				if !ok {
+					continue
+				}
+				replacer := strings.NewReplacer(
+					"{rule}", rule.ID,
+					"{pack}", pack.Name,
+					"{tool}", target.tool,
+					"{role}", target.role,
+					"{node_type}", string(target.node.NormalizedType),
+					"{match}", excerpt(matched),
+				)
+				findings = append(findings, finding(obs.TraceID, rule.Category, rule.Severity, rule.Confidence,
+					replacer.Replace(rule.Title), replacer.Replace(rule.Description),
+					target.node, excerpt(matched), RuleDetectorPrefix+pack.Name, pack.Version))
+			}
+		}
+	}
+	return dedupeFindings(findings), nil
+}
+
+type ruleTarget struct {
+	node observe.SemanticNode
+	role string
+	tool string
+}
+
+// ruleTargets 展开所有节点，子节点没有 role 时继承父节点的 role。
+func ruleTargets(obs observe.TraceObservation) []ruleTarget {
+	var out []ruleTarget
+	var walk func([]observe.SemanticNode, string)
+	walk = func(nodes []observe.SemanticNode, parentRole string) {
+		for _, node := range nodes {
+			role := firstNonEmpty(node.Role, parentRole)
+			out = append(out, ruleTarget{node: node, role: role, tool: metadataString(node.Metadata, "name")})
+			walk(node.Children, role)
+		}
+	}
+	walk(obs.Request.Nodes, "")
+	walk(obs.Response.Nodes, "assistant")
+	walk(obs.Stream.AccumulatedToolCalls, "assistant")
+	return out
+}
+
+func (r Rule) match(target ruleTarget) (string, bool) {
+	m := r.Match
+	if len(m.NodeTypes) > 0 && !containsFold(m.NodeTypes, string(target.node.NormalizedType)) {
+		return "", false
+	}
+	if len(m.Roles) > 0 && !containsFold(m.Roles, target.role) {
+		return "", false
+	}
+	if len(m.Tools) > 0 {
+		if target.tool == "" || !glob.MatchAny(m.Tools, target.tool) {
+			return "", false
+		}
+	}
+	var values []string
+	if r.jsonPath != nil {
+		payload, ok := nodePayload(target.node)
+		if !ok {
+			return "", false
+		}
+		values = pathValues(r.jsonPath, payload)
+	} else {
+		values = []string{firstNonEmpty(target.node.Text, metadataString(target.node.Metadata, "arguments"), metadataString(target.node.Metadata, "input"), rawText(target.node.JSON), rawText(target.node.Raw))}
+	}
+	for _, value := range values {
+		if matched, ok := r.matchText(value); ok {
+			return matched, true
+		}
+	}
+	return "", false
+}
+
+func (r Rule) matchText(value string) (string, bool) {
+	if value == "" && (r.pattern != nil || len(r.Match.Keywords) > 0 || r.jsonPath != nil) {
+		return "", false
+	}
+	matched := value
+	if r.pattern != nil {
+		loc := r.pattern.FindStringIndex(value)
+		if loc == nil {
+			return "", false
+		}
+		matched = value[loc[0]:loc[1]]
+	}
+	if len(r.Match.Keywords) > 0 {
+		lower := strings.ToLower(value)
+		hit := ""
+		for _, keyword := range r.Match.Keywords {
+			if keyword != "" && strings.Contains(lower, keyword) {
+				hit = keyword
+				break
+			}
+		}
+		if hit == "" {
+			return "", false
+		}
+		if r.pattern == nil {
+			matched = hit
+		}
+	}
+	return matched, true
+}
+
+// nodePayload 取节点的结构化参数：优先工具调用的 arguments / input，其次节点 JSON 与原始 JSON。
+func nodePayload(node observe.SemanticNode) (any, bool) {
+	for _, key := range []string{"arguments", "input"} {
+		if value, ok := node.Metadata[key]; ok && value != nil {
+			if text, isText := value.(string); isText {
+				var decoded any
+				if json.Unmarshal([]byte(text), &decoded) == nil {
+					return decoded, true
+				}
+				continue
+			}
+			if raw, isRaw := value.(json.RawMessage); isRaw {
+				var decoded any
+				if json.Unmarshal(raw, &decoded) == nil {
+					return decoded, true
+				}
+				continue
+			}
+			return value, true
+		}
+	}
+	for _, raw := range []json.RawMessage{node.JSON, node.Raw} {
+		var decoded any
+		if len(raw) > 0 && json.Unmarshal(raw, &decoded) == nil {
+			return decoded, true
+		}
+	}
+	return nil, false
+}
+
+// pathValues 返回路径命中的全部值，非字符串值序列化为 JSON。
+func pathValues(p jsonpath.Path, root any) []string {
+	matches := p.Lookup(root)
+	out := make([]string, 0, len(matches))
+	for _, value := range matches {
+		switch typed := value.(type) {
+		case nil:
+			continue
+		case string:
+			out = append(out, typed)
+		default:
+			buf, err := json.Marshal(typed)
+			if err != nil {
+				continue
+			}
+			out = append(out, string(buf))
+		}
+	}
+	return out
+}
+
+func ruleFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".yaml", ".yml", ".json":
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// ruleDirSignature 用文件名、大小与修改时间判断规则目录是否变化。
+func ruleDirSignature(dir string) (string, error) {
+	files, err := ruleFiles(dir)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&b, "%s|%d|%d\n", file, info.Size(), info.ModTime().UnixNano())
+	}
+	return b.String(), nil
+}
+
+func containsFold(values []string, value string) bool {
+	for _, candidate := range values {
+		if strings.EqualFold(strings.TrimSpace(candidate), value) {
+			return true
+		}
+	}
+	return false
+}
@@ -13,10 +13,12 @@ type TokenVerifier interface {
 }
 
 type Principal struct {
-	UserID   int
-	Username string
-	Role     string
-	Scope    string
+	UserID    int
+	Username  string
+	Role      string
+	Scope     string
+	TokenID   int
+	TokenName string
 }
 
 func BearerToken(header string) (string, bool) {
@@ -81,9 +81,13 @@ func TestStoreRejectsExpiredTokensAndDisabledUsers(t *testing.T) {
 	if err != nil {
 		t.Fatalf("CreateToken(active) error = %v", err)
 	}
-	if _, ok, err := st.VerifyToken(ctx, active.Token); err != nil || !ok {
+	principal, ok, err := st.VerifyToken(ctx, active.Token)
+	if err != nil || !ok {
 		t.Fatalf("VerifyToken(active) = ok %v err %v, want ok true err nil", ok, err)
 	}
+	if principal.TokenName != "active" || principal.TokenID == 0 {
+		t.Fatalf("VerifyToken(active) principal = %+v, want token identity", principal)
+	}
 	if _, err := st.client.User.Update().Where(user.UsernameEQ("admin")).SetEnabled(false).Save(ctx); err != nil {
 		t.Fatalf("disable user error = %v", err)
 	}
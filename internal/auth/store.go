@@ -328,10 +328,12 @@ func (s *Store) VerifyToken(ctx context.Context, token string) (Principal, bool,
 	}
 	_, _ = row.Update().SetLastUsedAt(time.Now().UTC()).Save(ctx)
 	return Principal{
-		UserID:   u.ID,
-		Username: u.Username,
-		Role:     u.Role,
-		Scope:    row.Scope,
+		UserID:    u.ID,
+		Username:  u.Username,
+		Role:      u.Role,
+		Scope:     row.Scope,
+		TokenID:   row.ID,
+		TokenName: row.Name,
 	}, true, nil
 }
 
@@ -40,7 +40,8 @@ type Config struct {
 	} `yaml:"database"`
 
 	Trace struct {
-		OutputDir string `yaml:"output_dir"`
+		OutputDir   string                 `yaml:"output_dir"`
+		Compression TraceCompressionConfig `yaml:"compression"`
 	} `yaml:"trace"`
 
 	Upstream  UpstreamConfig         `yaml:"upstream"`
@@ -58,6 +59,42 @@ type Config struct {
 		Enabled bool        `yaml:"enabled"`
 		Rules   []ChaosRule `yaml:"rules"`
 	} `yaml:"chaos"`
+
+	Rewrite struct {
+		Rules []RewriteRule `yaml:"rules"`
+	} `yaml:"rewrite"`
+
+	Guard struct {
+		Enabled     bool           `yaml:"enabled"`
+		Mode        string         `yaml:"mode"`        // "warn"、"mask" 或 "block"，默认 "warn"
+		Credentials *bool          `yaml:"credentials"` // 是否检测内置凭据规则，默认开启
+		PII         []GuardPattern `yaml:"pii"`
+		Policies    []GuardPolicy  `yaml:"policies"`
+	} `yaml:"guard"`
+
+	// Redaction 在录制时对 cassette 脱敏；cassette scrub 命令复用同一套规则处理已有文件。
+	Redaction struct {
+		Enabled     bool               `yaml:"enabled"`
+		Headers     []string           `yaml:"headers"`     // 需要替换的请求/响应头名称
+		JSONPaths   []string           `yaml:"json_paths"`  // 需要替换的 JSON 路径，支持 [*] 与 * 通配
+		Patterns    []RedactionPattern `yaml:"patterns"`    // 对所有字符串值生效的正则
+		Replacement string             `yaml:"replacement"` // "hash"（默认，保留等值关系）或 "mask"
+		HashKey     string             `yaml:"hash_key"`    // hash 占位符使用的 HMAC 密钥
+	} `yaml:"redaction"`
+
+	Retention RetentionConfig `yaml:"retention"`
+
+	Experiments ExperimentsConfig `yaml:"experiments"`
+
+	Evals EvalsConfig `yaml:"evals"`
+
+	Analysis AnalysisConfig `yaml:"analysis"`
+
+	OTel OTelConfig `yaml:"otel"`
+
+	Notifications NotificationsConfig `yaml:"notifications"`
+
+	Alerts AlertsConfig `yaml:"alerts"`
 }
 
 type UpstreamConfig struct {
@@ -103,6 +140,141 @@ type RouterConfig struct {
 	} `yaml:"fallback"`
 }
 
+// TraceCompressionConfig 控制已完成 cassette 的冷存储压缩，压缩后文件名追加 .zst 或 .gz。
+type TraceCompressionConfig struct {
+	Enabled       bool          `yaml:"enabled"`
+	Algorithm     string        `yaml:"algorithm"`       // "zstd"（默认）或 "gzip"
+	OlderThanDays int           `yaml:"older_than_days"` // 录制超过 N 天后压缩，0 表示完成后即可压缩
+	Interval      time.Duration `yaml:"interval"`        // 后台扫描间隔，默认 1h
+}
+
+// RetentionConfig 控制录制的保留期限与总容量，超出后删除 cassette 及其索引、观测和分析数据。
+type RetentionConfig struct {
+	Enabled          bool            `yaml:"enabled"`
+	Interval         time.Duration   `yaml:"interval"`           // 后台清理间隔，默认 1h
+	MaxAgeDays       int             `yaml:"max_age_days"`       // 默认保留天数，0 表示不按时间清理
+	MaxTotalMB       int64           `yaml:"max_total_mb"`       // cassette 总容量上限，超出时从最旧的开始删除，0 表示不限制
+	KeepWithFindings bool            `yaml:"keep_with_findings"` // 有 finding 的 trace 不会被清理
+	KeepInDatasets   bool            `yaml:"keep_in_datasets"`   // 已加入 dataset 的 trace 不会被清理
+	Rules            []RetentionRule `yaml:"rules"`
+}
+
+// RetentionRule 按模型或渠道覆盖保留天数，按顺序首条命中生效；MaxAgeDays 为 0 表示永久保留。
+type RetentionRule struct {
+	Models     []string `yaml:"models"`   // 支持 * 通配
+	Channels   []string `yaml:"channels"` // 上游 ID，支持 * 通配
+	MaxAgeDays int      `yaml:"max_age_days"`
+}
+
+// EvalsConfig 列出启动时导入数据库的自定义评估集文件（YAML 或 JSON），以及 llm_judge 断言使用的裁判模型。
+type EvalsConfig struct {
+	ProfileFiles []string    `yaml:"profile_files"`
+	Judge        JudgeConfig `yaml:"judge"`
+}
+
+// AnalysisConfig 配置审计扫描使用的声明式规则目录（文件变化后按 rules_reload_interval 热加载）与可选的 PII 检测器。
+type AnalysisConfig struct {
+	RulesDir            string            `yaml:"rules_dir"`
+	RulesReloadInterval time.Duration     `yaml:"rules_reload_interval"` // 默认 10s
+	PII                 PIIAnalysisConfig `yaml:"pii"`
+}
+
+// PIIAnalysisConfig 启用离线 PII 检测器。Entities 按实体开关（email、phone、national_id、credit_card、iban、ip_address），
+// 未列出的实体默认启用；Locales 限定地区规则（cn、us），为空时全部启用。
+type PIIAnalysisConfig struct {
+	Enabled  bool            `yaml:"enabled"`
+	Entities map[string]bool `yaml:"entities"`
+	Locales  []string        `yaml:"locales"`
+}
+
+// NotificationsConfig 把系统事件推送到外部通知渠道，每个 sink 独立过滤与去重，投递记录持久化在 outbox 中并按退避重试。
+type NotificationsConfig struct {
+	Enabled     bool               `yaml:"enabled"`
+	Interval    time.Duration      `yaml:"interval"`     // outbox 重试扫描间隔，默认 10s
+	MaxAttempts int                `yaml:"max_attempts"` // 单条通知最多投递次数，默认 5
+	Sinks       []NotificationSink `yaml:"sinks"`
+}
+
+// NotificationSink 描述一个通知渠道：webhook（HMAC 签名）、slack（incoming webhook）或 email（SMTP）。
+type NotificationSink struct {
+	Name     string             `yaml:"name"`
+	Type     string             `yaml:"type"`
+	URL      string             `yaml:"url"`      // webhook / slack 地址
+	Secret   string             `yaml:"secret"`   // webhook 的 HMAC-SHA256 签名密钥
+	Headers  map[string]string  `yaml:"headers"`  // webhook 额外请求头
+	SMTP     SMTPConfig         `yaml:"smtp"`     // email 发送配置
+	Filter   NotificationFilter `yaml:"filter"`   // 为空的维度不过滤
+	Cooldown time.Duration      `yaml:"cooldown"` // 同一 fingerprint 再次通知的最短间隔，默认 10m
+}
+
+// NotificationFilter 按事件属性筛选需要通知的系统事件，各维度支持 * 通配。
+type NotificationFilter struct {
+	Categories []string `yaml:"categories"`
+	Severities []string `yaml:"severities"`
+	Models     []string `yaml:"models"`
+	Upstreams  []string `yaml:"upstreams"`
+}
+
+type SMTPConfig struct {
+	Host     string   `yaml:"host"`
+	Port     int      `yaml:"port"` // 默认 587
+	Username string   `yaml:"username"`
+	Password string   `yaml:"password"`
+	From     string   `yaml:"from"`
+	To       []string `yaml:"to"`
+}
+
+// AlertsConfig 控制聚合指标告警规则的后台评估；Rules 在启动时写入数据库，也可通过 monitor API / MCP 管理。
+type AlertsConfig struct {
+	Enabled  bool          `yaml:"enabled"`
+	Interval time.Duration `yaml:"interval"` // 评估间隔，默认 1m
+	Rules    []AlertRule   `yaml:"rules"`
+}
+
+// AlertRule 在 Window 内按 Model / Channel / Token 聚合 logs，Metric 与 Threshold 比较成立时触发告警。
+type AlertRule struct {
+	ID          string        `yaml:"id"`
+	Name        string        `yaml:"name"`
+	Description string        `yaml:"description"`
+	Metric      string        `yaml:"metric"`       // error_rate、ttft_p95_ms、latency_p95_ms、request_count、total_tokens
+	Operator    string        `yaml:"operator"`     // >、>=、<、<=，默认 >
+	Threshold   float64       `yaml:"threshold"`    // error_rate 取 0~1
+	Window      time.Duration `yaml:"window"`       // 默认 5m
+	Model       string        `yaml:"model"`        // 支持 * 通配、忽略大小写，为空表示全部模型
+	Channel     string        `yaml:"channel"`      // 上游 ID，同样支持通配
+	Token       string        `yaml:"token"`        // 代理 token 名称，同样支持通配
+	MinRequests int           `yaml:"min_requests"` // 比率 / 分位数指标的最少样本数，默认 1
+	Severity    string        `yaml:"severity"`     // 默认 warning
+	Disabled    bool          `yaml:"disabled"`
+}
+
+// OTelConfig 控制按 OpenTelemetry GenAI 语义约定把每次代理调用导出为 OTLP/HTTP span。
+type OTelConfig struct {
+	Enabled       bool              `yaml:"enabled"`
+	Endpoint      string            `yaml:"endpoint"`       // collector 地址，如 http://localhost:4318，自动追加 /v1/traces
+	Headers       map[string]string `yaml:"headers"`        // 导出请求附带的头，如鉴权 token
+	ServiceName   string            `yaml:"service_name"`   // 默认 llm-tracelab
+	FlushInterval time.Duration     `yaml:"flush_interval"` // 批量导出间隔，默认 5s
+}
+
+// JudgeConfig 指定裁判请求经代理路由到的渠道与模型；Channel 为空时按常规路由选择。
+type JudgeConfig struct {
+	Channel string `yaml:"channel"`
+	Model   string `yaml:"model"`
+}
+
+// ExperimentsConfig 控制实验报告中的成本估算，未配置价格的模型不计入成本。
+type ExperimentsConfig struct {
+	Pricing []ModelPrice `yaml:"pricing"`
+}
+
+// ModelPrice 给出每百万 token 的价格，按顺序首条命中生效。
+type ModelPrice struct {
+	Models           []string `yaml:"models"` // 支持 * 通配
+	InputPerMillion  float64  `yaml:"input_per_million"`
+	OutputPerMillion float64  `yaml:"output_per_million"`
+}
+
 type ChaosRule struct {
 	Model      string        `yaml:"model"`       // 针对的模型，"*" 代表所有
 	Rate       float64       `yaml:"rate"`        // 概率 0.0 ~ 1.0
@@ -112,6 +284,45 @@ type ChaosRule struct {
 	Message    string        `yaml:"message"`     // 错误内容
 }
 
+// RewriteRule 描述一条请求改写策略：Match 命中后按 Set/Default/Remove 及内置动作修改请求体。
+type RewriteRule struct {
+	Name               string         `yaml:"name"`
+	Match              RewriteMatch   `yaml:"match"`
+	Set                map[string]any `yaml:"set"`                  // JSON 路径 -> 值，总是覆盖
+	Default            map[string]any `yaml:"default"`              // JSON 路径 -> 值，仅在缺失时写入
+	Remove             []string       `yaml:"remove"`               // 需要删除的 JSON 路径
+	MaxTokens          int            `yaml:"max_tokens"`           // 输出 token 上限，按协议映射到对应字段
+	SystemPrefix       string         `yaml:"system_prefix"`        // 注入到 system prompt 之前的文本
+	IncludeStreamUsage bool           `yaml:"include_stream_usage"` // 强制 stream_options.include_usage
+}
+
+// RewriteMatch 的每个字段为空表示不限制；条目支持 path.Match 风格的通配符。
+type RewriteMatch struct {
+	Models    []string `yaml:"models"`
+	Endpoints []string `yaml:"endpoints"`
+	Tokens    []string `yaml:"tokens"`
+	Channels  []string `yaml:"channels"`
+}
+
+// GuardPattern 为空 Pattern 时引用同名内置规则（email、phone、cn_mobile、us_ssn、credit_card、cn_id_card）。
+type GuardPattern struct {
+	Name    string `yaml:"name"`
+	Pattern string `yaml:"pattern"`
+}
+
+// GuardPolicy 按 token 名称与渠道覆盖出站 guard 的处理模式，第一条命中的策略生效。
+type GuardPolicy struct {
+	Tokens   []string `yaml:"tokens"`
+	Channels []string `yaml:"channels"`
+	Mode     string   `yaml:"mode"` // "off"、"warn"、"mask" 或 "block"
+}
+
+// RedactionPattern 为空 Pattern 时引用同名内置规则：credentials 表示全部内置凭据规则，其余名称同 GuardPattern。
+type RedactionPattern struct {
+	Name    string `yaml:"name"`
+	Pattern string `yaml:"pattern"`
+}
+
 func Load(path string) (*Config, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -0,0 +1,411 @@
+package evals
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/kingfs/llm-tracelab/internal/glob"
+	"github.com/kingfs/llm-tracelab/internal/monitor"
+	"github.com/kingfs/llm-tracelab/internal/observeworker"
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+	"github.com/kingfs/llm-tracelab/pkg/recordfile"
+)
+
+// 自定义评估集支持的断言类型。
+const (
+	AssertRegex              = "regex"
+	AssertJSONSchema         = "json_schema"
+	AssertContains           = "contains"
+	AssertNotContains        = "not_contains"
+	AssertToolCall           = "tool_call"
+	AssertFinishReason       = "finish_reason"
+	AssertNoFindings         = "no_findings"
+	AssertLatencyBudget      = "latency_budget"
+	AssertTokenBudget        = "token_budget"
+	AssertSemanticSimilarity = "semantic_similarity"
+	AssertLLMJudge           = "llm_judge"
+)
+
+const (
+	defaultSimilarityThreshold = 0.8
+	embeddingDimensions        = 512
+)
+
+var severityRank = map[string]int{
+	string(observe.SeverityInfo):     0,
+	string(observe.SeverityLow):      1,
+	string(observe.SeverityMedium):   2,
+	string(observe.SeverityHigh):     3,
+	string(observe.SeverityCritical): 4,
+}
+
+// Assertion 是自定义评估集中的一条断言，每条断言产生一个带解释的 Result。
+type Assertion struct {
+	Type string `json:"type" yaml:"type"`
+	// Key 是写入 score 的 evaluator key，默认取类型名。
+	Key string `json:"key,omitempty" yaml:"key,omitempty"`
+	// Pattern 是 regex 断言匹配输出的正则。
+	Pattern string `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	// Value 是 contains / not_contains 的子串、finish_reason 的期望值，或 semantic_similarity 的参照文本。
+	Value      string `json:"value,omitempty" yaml:"value,omitempty"`
+	IgnoreCase bool   `json:"ignore_case,omitempty" yaml:"ignore_case,omitempty"`
+	// Schema 是 json_schema 断言校验输出、tool_call 断言校验参数所用的 JSON Schema。
+	Schema any    `json:"schema,omitempty" yaml:"schema,omitempty"`
+	Tool   string `json:"tool,omitempty" yaml:"tool,omitempty"`
+	// Severity 是 no_findings 断言的最低严重度，默认 high。
+	Severity string `json:"severity,omitempty" yaml:"severity,omitempty"`
+	// Models 限定预算断言适用的模型（支持 * 通配），为空时适用全部模型。
+	Models       []string `json:"models,omitempty" yaml:"models,omitempty"`
+	MaxLatencyMS int64    `json:"max_latency_ms,omitempty" yaml:"max_latency_ms,omitempty"`
+	MaxTTFTMS    int64    `json:"max_ttft_ms,omitempty" yaml:"max_ttft_ms,omitempty"`
+	MaxTokens    int      `json:"max_tokens,omitempty" yaml:"max_tokens,omitempty"`
+	// Threshold 是 semantic_similarity 的最低余弦相似度（默认 0.8），或 llm_judge 的最低分（默认 0.7）。
+	Threshold float64 `json:"threshold,omitempty" yaml:"threshold,omitempty"`
+	// Rubric 是 llm_judge 的评分标准；RubricVersion 与 trace ID 共同作为裁判结果的缓存键，默认由 rubric 内容生成。
+	Rubric        string `json:"rubric,omitempty" yaml:"rubric,omitempty"`
+	RubricVersion string `json:"rubric_version,omitempty" yaml:"rubric_version,omitempty"`
+	// JudgeModel 覆盖 evals.judge.model。
+	JudgeModel string `json:"judge_model,omitempty" yaml:"judge_model,omitempty"`
+}
+
+// Input 提供断言所需的 trace 之外的上下文。
+type Input struct {
+	// Store 用于 no_findings 断言查询 finding。
+	Store *store.Store
+	// ExpectedOutput 通常来自 dataset 样例，是 semantic_similarity 未设置 Value 时的参照，也作为 llm_judge 的参考答案。
+	ExpectedOutput string
+	// Judge 为 llm_judge 断言调用裁判模型；为空时只能使用已缓存的裁判结果。
+	Judge *Judge
+}
+
+// traceData 按需解析 cassette，同一条 trace 的多个断言共享解析结果。
+type traceData struct {
+	entry store.LogEntry
+	input Input
+
+	parsed      *monitor.ParsedData
+	parseErr    error
+	parsedReady bool
+
+	observation *observe.TraceObservation
+	observeErr  error
+	observeDone bool
+}
+
+func (t *traceData) parsedData() (*monitor.ParsedData, error) {
+	if !t.parsedReady {
+		t.parsedReady = true
+		if strings.TrimSpace(t.entry.LogPath) == "" {
+			t.parseErr = fmt.Errorf("trace log path is missing")
+		} else if content, err := recordfile.ReadFile(t.entry.LogPath); err != nil {
+			t.parseErr = fmt.Errorf("read trace log: %w", err)
+		} else if t.parsed, err = monitor.ParseLogFile(content); err != nil {
+			t.parseErr = fmt.Errorf("parse trace log: %w", err)
+		}
+	}
+	return t.parsed, t.parseErr
+}
+
+func (t *traceData) observe() (*observe.TraceObservation, error) {
+	if !t.observeDone {
+		t.observeDone = true
+		obs, err := observeworker.ParseCassette(context.Background(), nil, t.entry.ID, t.entry.LogPath)
+		if err != nil {
+			t.observeErr = fmt.Errorf("parse observation: %w", err)
+		} else {
+			t.observation = &obs
+		}
+	}
+	return t.observation, t.observeErr
+}
+
+func (t *traceData) output() (string, error) {
+	parsed, err := t.parsedData()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(parsed.AIContent), nil
+}
+
+func evaluateAssertion(assertion Assertion, trace *traceData) Result {
+	result := func(pass bool, value float64, format string, args ...any) Result {
+		r := Result{EvaluatorKey: assertion.Key, Value: value, Status: "fail", Label: "fail", Explanation: fmt.Sprintf(format, args...)}
+		if pass {
+			r.Status, r.Label = "pass", "pass"
+		}
+		return r
+	}
+	fail := func(err error) Result {
+		return result(false, 0, "%v", err)
+	}
+
+	switch assertion.Type {
+	case AssertRegex:
+		output, err := trace.output()
+		if err != nil {
+			return fail(err)
+		}
+		re, err := regexp.Compile(assertion.Pattern)
+		if err != nil {
+			return fail(err)
+		}
+		if re.MatchString(output) {
+			return result(true, 1, "output matches /%s/", assertion.Pattern)
+		}
+		return result(false, 0, "output does not match /%s/", assertion.Pattern)
+
+	case AssertContains, AssertNotContains:
+		output, err := trace.output()
+		if err != nil {
+			return fail(err)
+		}
+		haystack, needle := output, assertion.Value
+		if assertion.IgnoreCase {
+			haystack, needle = strings.ToLower(haystack), strings.ToLower(needle)
+		}
+		found := strings.Contains(haystack, needle)
+		want := assertion.Type == AssertContains
+		switch {
+		case found && want:
+			return result(true, 1, "output contains %q", assertion.Value)
+		case !found && !want:
+			return result(true, 1, "output does not contain %q", assertion.Value)
+		case found:
+			return result(false, 0, "output contains forbidden %q", assertion.Value)
+		default:
+			return result(false, 0, "output does not contain %q", assertion.Value)
+		}
+
+	case AssertJSONSchema:
+		output, err := trace.output()
+		if err != nil {
+			return fail(err)
+		}
+		var value any
+		if err := json.Unmarshal([]byte(stripCodeFence(output)), &value); err != nil {
+			return result(false, 0, "output is not valid JSON: %v", err)
+		}
+		schema, err := resolveSchema(assertion.Schema)
+		if err != nil {
+			return fail(err)
+		}
+		if err := schema.Validate(value); err != nil {
+			return result(false, 0, "output does not match schema: %v", err)
+		}
+		return result(true, 1, "output is valid JSON matching the schema")
+
+	case AssertToolCall:
+		parsed, err := trace.parsedData()
+		if err != nil {
+			return fail(err)
+		}
+		var problems []string
+		calls := 0
+		for _, call := range parsed.ResponseToolCalls {
+			if !strings.EqualFold(strings.TrimSpace(call.Function.Name), strings.TrimSpace(assertion.Tool)) {
+				continue
+			}
+			calls++
+			if assertion.Schema == nil {
+				return result(true, 1, "tool %s was called", assertion.Tool)
+			}
+			args := strings.TrimSpace(call.Function.Arguments)
+			if args == "" {
+				args = "{}"
+			}
+			var value any
+			if err := json.Unmarshal([]byte(args), &value); err != nil {
+				problems = append(problems, fmt.Sprintf("arguments are not valid JSON: %v", err))
+				continue
+			}
+			schema, err := resolveSchema(assertion.Schema)
+			if err != nil {
+				return fail(err)
+			}
+			if err := schema.Validate(value); err != nil {
+				problems = append(problems, err.Error())
+				continue
+			}
+			return result(true, 1, "tool %s was called with arguments matching the schema", assertion.Tool)
+		}
+		if calls == 0 {
+			return result(false, 0, "tool %s was not called (%d tool calls recorded)", assertion.Tool, len(parsed.ResponseToolCalls))
+		}
+		return result(false, 0, "tool %s was called %d time(s) but no arguments matched the schema: %s", assertion.Tool, calls, strings.Join(problems, "; "))
+
+	case AssertFinishReason:
+		obs, err := trace.observe()
+		if err != nil {
+			return fail(err)
+		}
+		reasons := observe.FinishReasons(*obs)
+		for _, reason := range reasons {
+			if strings.EqualFold(reason, assertion.Value) {
+				return result(true, 1, "finish reason is %s", reason)
+			}
+		}
+		if len(reasons) == 0 {
+			return result(false, 0, "no finish reason recorded, want %s", assertion.Value)
+		}
+		return result(false, 0, "finish reason %s, want %s", strings.Join(reasons, ", "), assertion.Value)
+
+	case AssertNoFindings:
+		if trace.input.Store == nil {
+			return result(false, 0, "findings are unavailable without a trace store")
+		}
+		findings, err := trace.input.Store.ListFindings(trace.entry.ID, store.FindingFilter{})
+		if err != nil {
+			return fail(err)
+		}
+		minRank := severityRank[strings.ToLower(assertion.Severity)]
+		var hits []string
+		for _, finding := range findings {
+			// 人工标记为误报的 finding 不计入断言。
+			if finding.Status == store.FindingStatusFalsePositive {
+				continue
+			}
+			if severityRank[strings.ToLower(string(finding.Severity))] >= minRank {
+				hits = append(hits, fmt.Sprintf("%s (%s)", finding.Category, finding.Severity))
+			}
+		}
+		if len(hits) == 0 {
+			return result(true, 1, "no findings with severity >= %s", assertion.Severity)
+		}
+		return result(false, 0, "%d finding(s) with severity >= %s: %s", len(hits), assertion.Severity, strings.Join(hits, ", "))
+
+	case AssertLatencyBudget:
+		meta := trace.entry.Header.Meta
+		if !glob.MatchAny(assertion.Models, meta.Model) {
+			return result(true, 1, "latency budget does not apply to model %s", meta.Model)
+		}
+		var over []string
+		if assertion.MaxLatencyMS > 0 && meta.DurationMs > assertion.MaxLatencyMS {
+			over = append(over, fmt.Sprintf("latency %dms exceeds %dms", meta.DurationMs, assertion.MaxLatencyMS))
+		}
+		if assertion.MaxTTFTMS > 0 && meta.TTFTMs > assertion.MaxTTFTMS {
+			over = append(over, fmt.Sprintf("TTFT %dms exceeds %dms", meta.TTFTMs, assertion.MaxTTFTMS))
+		}
+		if len(over) > 0 {
+			return result(false, 0, "%s for model %s", strings.Join(over, ", "), meta.Model)
+		}
+		return result(true, 1, "latency %dms and TTFT %dms are within budget for model %s", meta.DurationMs, meta.TTFTMs, meta.Model)
+
+	case AssertTokenBudget:
+		model := trace.entry.Header.Meta.Model
+		if !glob.MatchAny(assertion.Models, model) {
+			return result(true, 1, "token budget does not apply to model %s", model)
+		}
+		total := trace.entry.Header.Usage.TotalTokens
+		if total > assertion.MaxTokens {
+			return result(false, 0, "total tokens %d exceed %d for model %s", total, assertion.MaxTokens, model)
+		}
+		return result(true, 1, "total tokens %d are within %d for model %s", total, assertion.MaxTokens, model)
+
+	case AssertSemanticSimilarity:
+		expected := strings.TrimSpace(assertion.Value)
+		if expected == "" {
+			expected = strings.TrimSpace(trace.input.ExpectedOutput)
+		}
+		if expected == "" {
+			return result(false, 0, "no expected output to compare with")
+		}
+		output, err := trace.output()
+		if err != nil {
+			return fail(err)
+		}
+		similarity := cosine(embed(output), embed(expected))
+		pass := similarity >= assertion.Threshold
+		return result(pass, similarity, "similarity %.3f against expected output, threshold %.2f", similarity, assertion.Threshold)
+
+	case AssertLLMJudge:
+		verdict, err := trace.input.Judge.grade(context.Background(), assertion, trace)
+		if err != nil {
+			return fail(err)
+		}
+		source := "judge trace " + verdict.JudgeTraceID
+		if verdict.Cached {
+			source = "cached, " + source
+		}
+		pass := verdict.Score >= assertion.Threshold
+		return result(pass, verdict.Score, "judge score %.2f, threshold %.2f (%s, rubric %s, %s): %s", verdict.Score, assertion.Threshold, verdict.JudgeModel, assertion.RubricVersion, source, verdict.Explanation)
+	}
+	return result(false, 0, "unknown assertion type %q", assertion.Type)
+}
+
+// stripCodeFence 去掉模型常用的 ```json 代码块包裹。
+func stripCodeFence(text string) string {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "```") {
+		return text
+	}
+	text = strings.TrimPrefix(text, "```")
+	if newline := strings.IndexByte(text, '\n'); newline >= 0 {
+		text = text[newline+1:]
+	}
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(text), "```"))
+}
+
+// embed 是本地嵌入的替身：把词与相邻词对哈希到定长向量，足以区分措辞相近与无关的回答，且无需外部模型。
+func embed(text string) []float64 {
+	vector := make([]float64, embeddingDimensions)
+	words := tokenize(text)
+	add := func(token string, weight float64) {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(token))
+		vector[h.Sum32()%embeddingDimensions] += weight
+	}
+	for i, word := range words {
+		add(word, 1)
+		if i > 0 {
+			add(words[i-1]+" "+word, 0.5)
+		}
+	}
+	return vector
+}
+
+// tokenize 按字母数字切词，汉字等无空格文字逐字成词。
+func tokenize(text string) []string {
+	var (
+		words   []string
+		current strings.Builder
+	)
+	flush := func() {
+		if current.Len() > 0 {
+			words = append(words, current.String())
+			current.Reset()
+		}
+	}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r):
+			flush()
+			words = append(words, string(r))
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			current.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return words
+}
+
+func cosine(a, b []float64) float64 {
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
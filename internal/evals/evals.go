@@ -3,12 +3,12 @@ package evals
 import (
 	"encoding/json"
 	"fmt"
-	"os"
 	"sort"
 	"strings"
 
 	"github.com/kingfs/llm-tracelab/internal/monitor"
 	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/pkg/recordfile"
 	"github.com/kingfs/llm-tracelab/pkg/replay"
 )
 
@@ -23,14 +23,19 @@ type Result struct {
 }
 
 type Profile struct {
-	Name                    string   `json:"name"`
-	Description             string   `json:"description"`
-	Deterministic           bool     `json:"deterministic"`
-	TTFTBudgetMS            int      `json:"ttft_budget_ms,omitempty"`
-	TotalTokenBudget        int      `json:"total_token_budget,omitempty"`
-	RequireDeclaredToolCall bool     `json:"require_declared_tool_call,omitempty"`
-	RequireToolArgsJSON     bool     `json:"require_tool_args_json,omitempty"`
-	EvaluatorKeys           []string `json:"evaluator_keys"`
+	Name                    string   `json:"name" yaml:"name"`
+	Description             string   `json:"description" yaml:"description"`
+	Deterministic           bool     `json:"deterministic" yaml:"-"`
+	TTFTBudgetMS            int      `json:"ttft_budget_ms,omitempty" yaml:"-"`
+	TotalTokenBudget        int      `json:"total_token_budget,omitempty" yaml:"-"`
+	RequireDeclaredToolCall bool     `json:"require_declared_tool_call,omitempty" yaml:"-"`
+	RequireToolArgsJSON     bool     `json:"require_tool_args_json,omitempty" yaml:"-"`
+	EvaluatorKeys           []string `json:"evaluator_keys" yaml:"-"`
+	// Base 非空时先运行该内置评估集的检查，再运行 Assertions；仅自定义评估集使用。
+	Base       string      `json:"base,omitempty" yaml:"base"`
+	Assertions []Assertion `json:"assertions,omitempty" yaml:"assertions"`
+	// Source 标明评估集来源：builtin、api 或 file:<path>。
+	Source string `json:"source,omitempty" yaml:"-"`
 }
 
 var profiles = map[string]Profile{
@@ -118,7 +123,25 @@ func Evaluate(entry store.LogEntry, summary *replay.Summary, evaluatorSet string
 	if !ok {
 		return nil, fmt.Errorf("unknown evaluator_set %q", strings.TrimSpace(evaluatorSet))
 	}
+	return EvaluateProfile(profile, entry, summary, Input{}), nil
+}
 
+// EvaluateProfile 运行一个已解析的评估集：内置检查（内置评估集或设置了 Base 时）之后依次执行自定义断言。
+func EvaluateProfile(profile Profile, entry store.LogEntry, summary *replay.Summary, in Input) []Result {
+	var results []Result
+	if _, builtin := profiles[profile.Name]; builtin || profile.Base != "" {
+		results = builtinResults(profile, entry, summary)
+	}
+	if len(profile.Assertions) > 0 {
+		trace := &traceData{entry: entry, input: in}
+		for _, assertion := range profile.Assertions {
+			results = append(results, evaluateAssertion(assertion, trace))
+		}
+	}
+	return results
+}
+
+func builtinResults(profile Profile, entry store.LogEntry, summary *replay.Summary) []Result {
 	results := []Result{
 		status2xx(entry),
 		noRecordedError(entry),
@@ -136,7 +159,7 @@ func Evaluate(entry store.LogEntry, summary *replay.Summary, evaluatorSet string
 	if profile.RequireToolArgsJSON {
 		results = append(results, toolCallArgumentsJSON(entry))
 	}
-	return results, nil
+	return results
 }
 
 func EvaluateBaseline(entry store.LogEntry, summary *replay.Summary) []Result {
@@ -276,7 +299,7 @@ func toolCallsDeclared(entry store.LogEntry) Result {
 			Explanation:  "trace log path is missing",
 		}
 	}
-	content, err := os.ReadFile(entry.LogPath)
+	content, err := recordfile.ReadFile(entry.LogPath)
 	if err != nil {
 		return Result{
 			EvaluatorKey: "tool_calls_declared",
@@ -377,7 +400,7 @@ func toolCallArgumentsJSON(entry store.LogEntry) Result {
 			Explanation:  "trace log path is missing",
 		}
 	}
-	content, err := os.ReadFile(entry.LogPath)
+	content, err := recordfile.ReadFile(entry.LogPath)
 	if err != nil {
 		return Result{
 			EvaluatorKey: "tool_call_arguments_json",
@@ -0,0 +1,278 @@
+package evals
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/kingfs/llm-tracelab/internal/playground"
+	"github.com/kingfs/llm-tracelab/internal/proxy"
+	"github.com/kingfs/llm-tracelab/internal/router"
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/internal/upstream"
+	"github.com/kingfs/llm-tracelab/pkg/llm"
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+)
+
+const (
+	// JudgeTag 标记裁判请求录制出的 trace，也是代理录制事件的来源名。
+	JudgeTag = "eval_judge"
+
+	defaultJudgeThreshold = 0.7
+	maxJudgeSectionRunes  = 4000
+	judgeMaxTokens        = 1024
+)
+
+const judgeSystemPrompt = `You are a strict evaluator of AI assistant responses.
+Grade the response against the rubric. Reply with a single JSON object and nothing else:
+{"score": <number between 0 and 1>, "explanation": "<one or two sentences>"}`
+
+// Judge 经代理进程内调用裁判模型，裁判请求本身也会录制为 trace 并打上 eval_judge 标签。
+type Judge struct {
+	Handler http.Handler
+	// Channel 非空时只路由到该渠道。
+	Channel string
+	Model   string
+	// Protocol 是裁判渠道的协议族（upstream.ProtocolFamily*），决定请求路径与请求体格式，为空按 OpenAI 兼容处理。
+	Protocol string
+}
+
+// JudgeProtocol 返回裁判请求应使用的协议族：指定渠道时取该渠道，否则取第一个声明了裁判模型的渠道。
+func JudgeProtocol(targets []*router.Target, channel string, model string) string {
+	channel = strings.TrimSpace(channel)
+	for _, target := range targets {
+		if channel != "" && target.ID == channel {
+			return target.Upstream.ProtocolFamily
+		}
+	}
+	if channel != "" {
+		return ""
+	}
+	for _, target := range targets {
+		if slices.Contains(target.StaticModels, model) {
+			return target.Upstream.ProtocolFamily
+		}
+	}
+	return ""
+}
+
+// judgeEndpoint 返回协议族对应的裁判请求路径，Gemini 与 Vertex 的模型位于路径中。
+func judgeEndpoint(protocol string, model string) string {
+	switch protocol {
+	case upstream.ProtocolFamilyAnthropicMessages:
+		return "/v1/messages"
+	case upstream.ProtocolFamilyGoogleGenAI:
+		return "/v1beta/models/" + url.PathEscape(model) + ":generateContent"
+	case upstream.ProtocolFamilyVertexNative:
+		return "/v1/publishers/google/models/" + url.PathEscape(model) + ":generateContent"
+	default:
+		return "/v1/chat/completions"
+	}
+}
+
+// judgeVerdict 是一次裁判结果，Cached 表示来自 eval_judgements 缓存。
+type judgeVerdict struct {
+	store.EvalJudgementRecord
+	Cached bool
+}
+
+// rubricVersion 在未显式指定版本时按 rubric 内容生成，修改 rubric 即使缓存失效。
+func rubricVersion(rubric string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(rubric)))
+	return "sha256:" + hex.EncodeToString(sum[:6])
+}
+
+// grade 返回 trace 在断言 rubric 版本下的裁判结果，优先使用缓存。
+func (j *Judge) grade(ctx context.Context, assertion Assertion, trace *traceData) (judgeVerdict, error) {
+	st := trace.input.Store
+	if st == nil {
+		return judgeVerdict{}, fmt.Errorf("llm_judge requires a trace store")
+	}
+	var model string
+	if j != nil {
+		model = j.Model
+	}
+	model = firstNonEmpty(assertion.JudgeModel, model)
+	cached, err := st.GetEvalJudgement(trace.entry.ID, assertion.RubricVersion, model)
+	if err == nil {
+		return judgeVerdict{EvalJudgementRecord: cached, Cached: true}, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return judgeVerdict{}, err
+	}
+	if j == nil || j.Handler == nil {
+		return judgeVerdict{}, fmt.Errorf("llm_judge requires a judge; configure evals.judge and run through the proxy")
+	}
+	if model == "" {
+		return judgeVerdict{}, fmt.Errorf("judge model is not configured; set evals.judge.model or judge_model")
+	}
+	prompt, err := judgePrompt(assertion.Rubric, trace)
+	if err != nil {
+		return judgeVerdict{}, err
+	}
+	endpoint := judgeEndpoint(j.Protocol, model)
+	adapter, err := llm.AdapterForPath(endpoint, "")
+	if err != nil {
+		return judgeVerdict{}, err
+	}
+	temperature := 0.0
+	maxTokens := judgeMaxTokens
+	body, err := adapter.MarshalRequest(llm.LLMRequest{
+		Model:       model,
+		System:      []llm.LLMContent{{Type: "text", Text: judgeSystemPrompt}},
+		Messages:    []llm.LLMMessage{{Role: "user", Content: []llm.LLMContent{{Type: "text", Text: prompt}}}},
+		Temperature: &temperature,
+		MaxTokens:   &maxTokens,
+	})
+	if err != nil {
+		return judgeVerdict{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return judgeVerdict{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.RemoteAddr = JudgeTag
+
+	result, err := playground.Run(j.Handler, st, req, &proxy.Rerun{Channel: j.Channel, User: JudgeTag, Source: JudgeTag})
+	if result.TraceID != "" {
+		if _, tagErr := st.AddTags(store.AnnotationTargetTrace, result.TraceID, []string{JudgeTag}, JudgeTag); tagErr != nil {
+			return judgeVerdict{}, fmt.Errorf("tag judge trace: %w", tagErr)
+		}
+	}
+	if err != nil {
+		return judgeVerdict{}, fmt.Errorf("judge request: %w", err)
+	}
+	if result.StatusCode >= http.StatusBadRequest {
+		return judgeVerdict{}, fmt.Errorf("judge request failed with status %d: %s", result.StatusCode, result.Error)
+	}
+	score, explanation, err := parseJudgeResponse(adapter, result.Body)
+	if err != nil {
+		return judgeVerdict{}, fmt.Errorf("judge trace %s: %w", result.TraceID, err)
+	}
+	record := store.EvalJudgementRecord{
+		TraceID:       trace.entry.ID,
+		RubricVersion: assertion.RubricVersion,
+		Score:         score,
+		Explanation:   explanation,
+		JudgeModel:    model,
+		JudgeTraceID:  result.TraceID,
+	}
+	if err := st.SaveEvalJudgement(record); err != nil {
+		return judgeVerdict{}, err
+	}
+	return judgeVerdict{EvalJudgementRecord: record}, nil
+}
+
+// judgePrompt 用 trace 的 observation 拼出裁判提示：rubric、对话、工具调用、回答与可选的参考答案。
+func judgePrompt(rubric string, trace *traceData) (string, error) {
+	obs, err := trace.observe()
+	if err != nil {
+		return "", err
+	}
+	output, err := trace.output()
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	b.WriteString("## Rubric\n")
+	b.WriteString(strings.TrimSpace(rubric))
+	b.WriteString("\n\n## Conversation\n")
+	for _, node := range obs.Request.Instructions {
+		writeJudgeTurn(&b, firstNonEmpty(node.Role, "system"), nodeText(node))
+	}
+	for _, node := range obs.Request.Messages {
+		writeJudgeTurn(&b, firstNonEmpty(node.Role, "user"), nodeText(node))
+	}
+	if len(obs.Tools.Calls) > 0 {
+		b.WriteString("\n## Tool calls\n")
+		for _, call := range obs.Tools.Calls {
+			fmt.Fprintf(&b, "- %s(%s)\n", call.Name, truncateRunes(call.ArgsText, maxJudgeSectionRunes))
+		}
+	}
+	b.WriteString("\n## Response\n")
+	b.WriteString(truncateRunes(output, maxJudgeSectionRunes))
+	b.WriteString("\n")
+	if expected := strings.TrimSpace(trace.input.ExpectedOutput); expected != "" {
+		b.WriteString("\n## Reference answer\n")
+		b.WriteString(truncateRunes(expected, maxJudgeSectionRunes))
+		b.WriteString("\n")
+	}
+	return b.String(), nil
+}
+
+func writeJudgeTurn(b *strings.Builder, role string, text string) {
+	if text = strings.TrimSpace(text); text != "" {
+		fmt.Fprintf(b, "[%s] %s\n", role, truncateRunes(text, maxJudgeSectionRunes))
+	}
+}
+
+// nodeText 取节点自身文本，没有时拼接子节点文本。
+func nodeText(node observe.SemanticNode) string {
+	if node.Text != "" {
+		return node.Text
+	}
+	var parts []string
+	for _, child := range node.Children {
+		if text := nodeText(child); text != "" {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// parseJudgeResponse 按裁判渠道的协议解析响应，取出裁判返回的 {"score","explanation"}。
+func parseJudgeResponse(adapter llm.Adapter, body []byte) (float64, string, error) {
+	resp, err := adapter.ParseResponse(body)
+	if err != nil {
+		return 0, "", fmt.Errorf("decode judge response: %w", err)
+	}
+	if len(resp.Candidates) == 0 {
+		return 0, "", fmt.Errorf("judge response has no choices")
+	}
+	var text strings.Builder
+	for _, part := range resp.Candidates[0].Content {
+		text.WriteString(part.Text)
+	}
+	content := stripCodeFence(text.String())
+	var verdict struct {
+		Score       *float64 `json:"score"`
+		Explanation string   `json:"explanation"`
+	}
+	if err := json.Unmarshal([]byte(content), &verdict); err != nil {
+		return 0, "", fmt.Errorf("judge reply is not JSON: %w", err)
+	}
+	if verdict.Score == nil {
+		return 0, "", fmt.Errorf("judge reply has no score")
+	}
+	if *verdict.Score < 0 || *verdict.Score > 1 {
+		return 0, "", fmt.Errorf("judge score %v is outside [0, 1]", *verdict.Score)
+	}
+	return *verdict.Score, strings.TrimSpace(verdict.Explanation), nil
+}
+
+func truncateRunes(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	return string(runes[:limit]) + "…"
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,231 @@
+package evals
+
+import (
+	"database/sql"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/proxy"
+	"github.com/kingfs/llm-tracelab/internal/router"
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/pkg/llm"
+	"github.com/kingfs/llm-tracelab/pkg/replay"
+)
+
+func TestLLMJudgeCallsJudgeThroughProxyAndCachesVerdict(t *testing.T) {
+	outputDir := t.TempDir()
+	st, err := store.New(outputDir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	// 裁判上游直接回放一条录制好的 cassette。
+	judgeCassette := filepath.Join(t.TempDir(), "judge.http")
+	if err := os.WriteFile(judgeCassette, buildTextRecordFixture(t, `{"score": 0.9, "explanation": "Correct and concise."}`), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	var (
+		calls  atomic.Int32
+		prompt atomic.Value
+	)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		prompt.Store(string(body))
+		resp, err := replay.NewTransport(judgeCassette).RoundTrip(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer resp.Body.Close()
+		w.Header().Set("Content-Type", resp.Header.Get("Content-Type"))
+		w.WriteHeader(resp.StatusCode)
+		_, _ = io.Copy(w, resp.Body)
+	}))
+	defer upstream.Close()
+
+	enabled := true
+	cfg := &config.Config{}
+	cfg.Upstreams = append(cfg.Upstreams, config.UpstreamTargetConfig{
+		ID:             "judge",
+		Enabled:        &enabled,
+		ModelDiscovery: router.ModelDiscoveryStaticOnly,
+		StaticModels:   []string{"judge-model"},
+		Upstream:       config.UpstreamConfig{BaseURL: upstream.URL + "/v1", ProviderPreset: "openai"},
+	})
+	cfg.Debug.OutputDir = outputDir
+	handler, err := proxy.NewHandler(cfg, st)
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+
+	answerPath := filepath.Join(t.TempDir(), "answer.http")
+	if err := os.WriteFile(answerPath, buildTextRecordFixture(t, "Paris is the capital of France."), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	entry := indexFixture(t, st, answerPath)
+
+	profiles, err := ParseProfiles([]byte(`
+name: helpful
+assertions:
+  - type: llm_judge
+    rubric: The answer must be factually correct.
+`))
+	if err != nil {
+		t.Fatalf("ParseProfiles() error = %v", err)
+	}
+	profile := profiles[0]
+	if profile.Deterministic || !strings.HasPrefix(profile.Assertions[0].RubricVersion, "sha256:") || profile.Assertions[0].Threshold != defaultJudgeThreshold {
+		t.Fatalf("judge profile = %+v", profile)
+	}
+
+	judge := &Judge{Handler: handler, Channel: "judge", Model: "judge-model"}
+	results := EvaluateProfile(profile, entry, &replay.Summary{}, Input{Store: st, ExpectedOutput: "Paris", Judge: judge})
+	assertResultStatus(t, results, "llm_judge", "pass")
+	if results[0].Value != 0.9 || !strings.Contains(results[0].Explanation, "Correct and concise.") {
+		t.Fatalf("judge result = %+v", results[0])
+	}
+	sent, _ := prompt.Load().(string)
+	for _, want := range []string{"judge-model", "factually correct", "capital of France?", "Paris is the capital of France.", "Reference answer"} {
+		if !strings.Contains(sent, want) {
+			t.Fatalf("judge request missing %q: %s", want, sent)
+		}
+	}
+
+	cached, err := st.GetEvalJudgement(entry.ID, profile.Assertions[0].RubricVersion, "judge-model")
+	if err != nil || cached.JudgeTraceID == "" || cached.JudgeModel != "judge-model" {
+		t.Fatalf("GetEvalJudgement() = %+v, err = %v", cached, err)
+	}
+	if _, err := st.GetEvalJudgement(entry.ID, profile.Assertions[0].RubricVersion, "other-model"); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("GetEvalJudgement(other-model) err = %v, want sql.ErrNoRows", err)
+	}
+	tags, err := st.ListTags(store.AnnotationTargetTrace, cached.JudgeTraceID)
+	if err != nil || len(tags) != 1 || tags[0].Tag != JudgeTag {
+		t.Fatalf("judge trace tags = %+v, err = %v", tags, err)
+	}
+
+	// 同一 rubric 版本再次评估直接使用缓存，无需裁判。
+	results = EvaluateProfile(profile, entry, &replay.Summary{}, Input{Store: st})
+	assertResultStatus(t, results, "llm_judge", "pass")
+	if calls.Load() != 1 || !strings.Contains(results[0].Explanation, "cached") {
+		t.Fatalf("upstream calls = %d, result = %+v; want cached verdict", calls.Load(), results[0])
+	}
+
+	// 换用其他裁判模型时不命中旧模型的缓存。
+	other := profile
+	other.Assertions = []Assertion{profile.Assertions[0]}
+	other.Assertions[0].JudgeModel = "other-model"
+	results = EvaluateProfile(other, entry, &replay.Summary{}, Input{Store: st})
+	if results[0].Status != "fail" || !strings.Contains(results[0].Explanation, "requires a judge") {
+		t.Fatalf("other judge model result = %+v", results[0])
+	}
+
+	// 提高阈值后同一分数判为失败；rubric 变化后缓存失效，没有裁判时失败并说明原因。
+	profile.Assertions[0].Threshold = 0.95
+	assertResultStatus(t, EvaluateProfile(profile, entry, &replay.Summary{}, Input{Store: st}), "llm_judge", "fail")
+	profile.Assertions[0].RubricVersion = rubricVersion("another rubric")
+	results = EvaluateProfile(profile, entry, &replay.Summary{}, Input{Store: st})
+	if results[0].Status != "fail" || !strings.Contains(results[0].Explanation, "requires a judge") {
+		t.Fatalf("uncached result without judge = %+v", results[0])
+	}
+}
+
+func TestParseJudgeResponseRejectsMalformedVerdicts(t *testing.T) {
+	wrap := func(content string) []byte {
+		return []byte(`{"choices":[{"message":{"content":` + strings.ReplaceAll(`"`+content+`"`, "\n", `\n`) + `}}]}`)
+	}
+	adapter, err := llm.AdapterForPath("/v1/chat/completions", "")
+	if err != nil {
+		t.Fatalf("AdapterForPath() error = %v", err)
+	}
+	score, explanation, err := parseJudgeResponse(adapter, wrap("```json\n{\\\"score\\\": 0.4, \\\"explanation\\\": \\\"vague\\\"}\n```"))
+	if err != nil || score != 0.4 || explanation != "vague" {
+		t.Fatalf("parseJudgeResponse(fenced) = %v, %q, %v", score, explanation, err)
+	}
+	for _, content := range []string{"looks good", `{\"explanation\": \"no score\"}`, `{\"score\": 7}`} {
+		if _, _, err := parseJudgeResponse(adapter, wrap(content)); err == nil {
+			t.Fatalf("parseJudgeResponse(%s) error = nil", content)
+		}
+	}
+}
+
+func TestLLMJudgeUsesJudgeChannelProtocol(t *testing.T) {
+	outputDir := t.TempDir()
+	st, err := store.New(outputDir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	var (
+		path atomic.Value
+		sent atomic.Value
+	)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		path.Store(r.URL.Path)
+		sent.Store(string(body))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"id":"msg_1","type":"message","role":"assistant","model":"claude-judge","content":[{"type":"text","text":"{\"score\": 0.8, \"explanation\": \"Accurate.\"}"}],"stop_reason":"end_turn","usage":{"input_tokens":10,"output_tokens":5}}`)
+	}))
+	defer upstream.Close()
+
+	enabled := true
+	cfg := &config.Config{}
+	cfg.Upstreams = append(cfg.Upstreams, config.UpstreamTargetConfig{
+		ID:             "claude",
+		Enabled:        &enabled,
+		ModelDiscovery: router.ModelDiscoveryStaticOnly,
+		StaticModels:   []string{"claude-judge"},
+		Upstream:       config.UpstreamConfig{BaseURL: upstream.URL + "/v1", ProviderPreset: "anthropic", ApiKey: "test"},
+	})
+	cfg.Debug.OutputDir = outputDir
+	rtr, err := router.New(cfg, nil)
+	if err != nil {
+		t.Fatalf("router.New() error = %v", err)
+	}
+	defer rtr.Close()
+	handler, err := proxy.NewHandler(cfg, st)
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+
+	answerPath := filepath.Join(t.TempDir(), "answer.http")
+	if err := os.WriteFile(answerPath, buildTextRecordFixture(t, "Paris is the capital of France."), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	entry := indexFixture(t, st, answerPath)
+	profiles, err := ParseProfiles([]byte(`
+name: helpful
+assertions:
+  - type: llm_judge
+    rubric: The answer must be factually correct.
+`))
+	if err != nil {
+		t.Fatalf("ParseProfiles() error = %v", err)
+	}
+
+	protocol := JudgeProtocol(rtr.Targets(), "", "claude-judge")
+	if protocol != "anthropic_messages" {
+		t.Fatalf("JudgeProtocol() = %q", protocol)
+	}
+	judge := &Judge{Handler: handler, Model: "claude-judge", Protocol: protocol}
+	results := EvaluateProfile(profiles[0], entry, &replay.Summary{}, Input{Store: st, Judge: judge})
+	assertResultStatus(t, results, "llm_judge", "pass")
+	if results[0].Value != 0.8 {
+		t.Fatalf("judge result = %+v", results[0])
+	}
+	body, _ := sent.Load().(string)
+	if got, _ := path.Load().(string); got != "/v1/messages" || !strings.Contains(body, `"system":"You are a strict evaluator`) || !strings.Contains(body, `"max_tokens":1024`) || strings.Contains(body, `"choices"`) {
+		t.Fatalf("judge request path = %v, body = %s", path.Load(), body)
+	}
+}
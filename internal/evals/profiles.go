@@ -0,0 +1,295 @@
+package evals
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrUnknownProfile 表示评估集既不是内置评估集，也不在数据库中。
+var ErrUnknownProfile = errors.New("unknown evaluator_set")
+
+const (
+	SourceBuiltin = "builtin"
+	SourceAPI     = "api"
+)
+
+// ParseProfiles 解析 YAML 或 JSON 格式的评估集定义，支持单个评估集或 `profiles:` 列表。
+func ParseProfiles(data []byte) ([]Profile, error) {
+	var doc struct {
+		Profiles []Profile `yaml:"profiles"`
+	}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Profiles) == 0 {
+		var single Profile
+		if err := yaml.Unmarshal(data, &single); err != nil {
+			return nil, err
+		}
+		doc.Profiles = []Profile{single}
+	}
+	for i := range doc.Profiles {
+		if err := ValidateProfile(&doc.Profiles[i]); err != nil {
+			return nil, err
+		}
+	}
+	return doc.Profiles, nil
+}
+
+// ValidateProfile 校验自定义评估集，补全断言的 evaluator key，并展开 Base 内置评估集的检查项。
+func ValidateProfile(profile *Profile) error {
+	profile.Name = strings.TrimSpace(profile.Name)
+	if profile.Name == "" {
+		return fmt.Errorf("profile name is required")
+	}
+	if _, ok := profiles[profile.Name]; ok {
+		return fmt.Errorf("profile %s conflicts with a built-in evaluator set", profile.Name)
+	}
+	profile.Base = strings.TrimSpace(profile.Base)
+	var keys []string
+	if profile.Base != "" {
+		base, ok := profiles[profile.Base]
+		if !ok {
+			return fmt.Errorf("profile %s: base %q is not a built-in evaluator set", profile.Name, profile.Base)
+		}
+		profile.TTFTBudgetMS = base.TTFTBudgetMS
+		profile.TotalTokenBudget = base.TotalTokenBudget
+		profile.RequireDeclaredToolCall = base.RequireDeclaredToolCall
+		profile.RequireToolArgsJSON = base.RequireToolArgsJSON
+		keys = append(keys, base.EvaluatorKeys...)
+	} else {
+		profile.TTFTBudgetMS = 0
+		profile.TotalTokenBudget = 0
+		profile.RequireDeclaredToolCall = false
+		profile.RequireToolArgsJSON = false
+	}
+	if profile.Base == "" && len(profile.Assertions) == 0 {
+		return fmt.Errorf("profile %s needs a base or at least one assertion", profile.Name)
+	}
+	profile.Deterministic = true
+	seen := map[string]bool{}
+	for _, key := range keys {
+		seen[key] = true
+	}
+	for i := range profile.Assertions {
+		assertion := &profile.Assertions[i]
+		if err := validateAssertion(assertion); err != nil {
+			return fmt.Errorf("profile %s: assertion %d: %w", profile.Name, i+1, err)
+		}
+		if assertion.Key == "" {
+			// 未指定 key 时用类型名，重复时追加序号。
+			assertion.Key = assertion.Type
+			for n := 2; seen[assertion.Key]; n++ {
+				assertion.Key = fmt.Sprintf("%s_%d", assertion.Type, n)
+			}
+		} else if seen[assertion.Key] {
+			return fmt.Errorf("profile %s: duplicate assertion key %q", profile.Name, assertion.Key)
+		}
+		seen[assertion.Key] = true
+		keys = append(keys, assertion.Key)
+		if assertion.Type == AssertLLMJudge {
+			profile.Deterministic = false
+		}
+	}
+	profile.EvaluatorKeys = keys
+	return nil
+}
+
+func validateAssertion(assertion *Assertion) error {
+	assertion.Type = strings.TrimSpace(assertion.Type)
+	assertion.Key = strings.TrimSpace(assertion.Key)
+	switch assertion.Type {
+	case AssertRegex:
+		if assertion.Pattern == "" {
+			return fmt.Errorf("regex requires pattern")
+		}
+		if _, err := regexp.Compile(assertion.Pattern); err != nil {
+			return fmt.Errorf("regex pattern: %w", err)
+		}
+	case AssertContains, AssertNotContains, AssertFinishReason:
+		if assertion.Value == "" {
+			return fmt.Errorf("%s requires value", assertion.Type)
+		}
+	case AssertJSONSchema:
+		if assertion.Schema == nil {
+			return fmt.Errorf("json_schema requires schema")
+		}
+		if _, err := resolveSchema(assertion.Schema); err != nil {
+			return err
+		}
+	case AssertToolCall:
+		if strings.TrimSpace(assertion.Tool) == "" {
+			return fmt.Errorf("tool_call requires tool")
+		}
+		if assertion.Schema != nil {
+			if _, err := resolveSchema(assertion.Schema); err != nil {
+				return err
+			}
+		}
+	case AssertNoFindings:
+		if assertion.Severity == "" {
+			assertion.Severity = "high"
+		}
+		if _, ok := severityRank[strings.ToLower(assertion.Severity)]; !ok {
+			return fmt.Errorf("unknown severity %q", assertion.Severity)
+		}
+	case AssertLatencyBudget:
+		if assertion.MaxLatencyMS <= 0 && assertion.MaxTTFTMS <= 0 {
+			return fmt.Errorf("latency_budget requires max_latency_ms or max_ttft_ms")
+		}
+	case AssertTokenBudget:
+		if assertion.MaxTokens <= 0 {
+			return fmt.Errorf("token_budget requires max_tokens")
+		}
+	case AssertSemanticSimilarity:
+		if assertion.Threshold <= 0 {
+			assertion.Threshold = defaultSimilarityThreshold
+		}
+		if assertion.Threshold > 1 {
+			return fmt.Errorf("semantic_similarity threshold must be within (0, 1]")
+		}
+	case AssertLLMJudge:
+		assertion.Rubric = strings.TrimSpace(assertion.Rubric)
+		if assertion.Rubric == "" {
+			return fmt.Errorf("llm_judge requires rubric")
+		}
+		assertion.RubricVersion = strings.TrimSpace(assertion.RubricVersion)
+		if assertion.RubricVersion == "" {
+			assertion.RubricVersion = rubricVersion(assertion.Rubric)
+		}
+		if assertion.Threshold <= 0 {
+			assertion.Threshold = defaultJudgeThreshold
+		}
+		if assertion.Threshold > 1 {
+			return fmt.Errorf("llm_judge threshold must be within (0, 1]")
+		}
+	case "":
+		return fmt.Errorf("type is required")
+	default:
+		return fmt.Errorf("unknown assertion type %q", assertion.Type)
+	}
+	return nil
+}
+
+func resolveSchema(value any) (*jsonschema.Resolved, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+	var schema jsonschema.Schema
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+	resolved, err := schema.Resolve(nil)
+	if err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+	return resolved, nil
+}
+
+// SaveProfile 校验后把评估集写入数据库，同名覆盖。
+func SaveProfile(st *store.Store, profile Profile, source string) (Profile, error) {
+	if err := ValidateProfile(&profile); err != nil {
+		return Profile{}, err
+	}
+	definition, err := json.Marshal(Profile{
+		Name:        profile.Name,
+		Description: profile.Description,
+		Base:        profile.Base,
+		Assertions:  profile.Assertions,
+	})
+	if err != nil {
+		return Profile{}, err
+	}
+	if _, err := st.SaveEvalProfile(store.EvalProfileRecord{
+		Name:        profile.Name,
+		Description: profile.Description,
+		Definition:  definition,
+		Source:      source,
+	}); err != nil {
+		return Profile{}, err
+	}
+	profile.Source = source
+	return profile, nil
+}
+
+// ImportProfileFile 读取 YAML/JSON 文件中的评估集并写入数据库，返回导入数量。
+func ImportProfileFile(st *store.Store, path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	parsed, err := ParseProfiles(bytes.TrimSpace(data))
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", path, err)
+	}
+	for _, profile := range parsed {
+		if _, err := SaveProfile(st, profile, "file:"+path); err != nil {
+			return 0, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return len(parsed), nil
+}
+
+// ResolveProfile 依次查找内置评估集与数据库中的自定义评估集。
+func ResolveProfile(st *store.Store, name string) (Profile, error) {
+	name = strings.TrimSpace(name)
+	if profile, ok := GetProfile(name); ok {
+		profile.Source = SourceBuiltin
+		return profile, nil
+	}
+	if st == nil || name == "" {
+		return Profile{}, fmt.Errorf("%w %q", ErrUnknownProfile, name)
+	}
+	record, err := st.GetEvalProfile(name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Profile{}, fmt.Errorf("%w %q", ErrUnknownProfile, name)
+	}
+	if err != nil {
+		return Profile{}, err
+	}
+	return profileFromRecord(record)
+}
+
+// ListAllProfiles 返回内置评估集与数据库中的自定义评估集。
+func ListAllProfiles(st *store.Store) ([]Profile, error) {
+	out := ListProfiles()
+	for i := range out {
+		out[i].Source = SourceBuiltin
+	}
+	records, err := st.ListEvalProfiles()
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		profile, err := profileFromRecord(record)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, profile)
+	}
+	return out, nil
+}
+
+func profileFromRecord(record store.EvalProfileRecord) (Profile, error) {
+	var profile Profile
+	if err := json.Unmarshal(record.Definition, &profile); err != nil {
+		return Profile{}, fmt.Errorf("decode profile %s: %w", record.Name, err)
+	}
+	profile.Name = record.Name
+	if err := ValidateProfile(&profile); err != nil {
+		return Profile{}, err
+	}
+	profile.Source = record.Source
+	return profile, nil
+}
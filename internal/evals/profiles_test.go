@@ -0,0 +1,250 @@
+package evals
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+	"github.com/kingfs/llm-tracelab/pkg/recordfile"
+	"github.com/kingfs/llm-tracelab/pkg/replay"
+)
+
+const customProfilesYAML = `
+profiles:
+  - name: capital_qa
+    description: capital questions answered as JSON
+    base: baseline_v1
+    assertions:
+      - type: regex
+        pattern: "(?i)paris"
+      - type: json_schema
+        schema:
+          type: object
+          required: [answer]
+          properties:
+            answer: {type: string}
+      - type: contains
+        value: capital
+      - type: contains
+        value: LONDON
+        ignore_case: true
+      - type: not_contains
+        value: London
+      - type: finish_reason
+        value: stop
+      - type: no_findings
+      - type: latency_budget
+        models: ["claude-*"]
+        max_latency_ms: 1
+      - type: token_budget
+        key: gpt5_tokens
+        models: ["gpt-5*"]
+        max_tokens: 10
+      - type: semantic_similarity
+        threshold: 0.5
+      - type: semantic_similarity
+        value: bananas grow on tall tropical plants
+  - name: weather_tools
+    assertions:
+      - type: tool_call
+        tool: weather
+        schema:
+          type: object
+          required: [city]
+          properties:
+            city: {type: string}
+      - type: tool_call
+        key: weather_country
+        tool: weather
+        schema: {type: object, required: [country]}
+      - type: tool_call
+        key: search_called
+        tool: search
+`
+
+func TestCustomProfileAssertionsProduceExplainedResults(t *testing.T) {
+	profiles, err := ParseProfiles([]byte(customProfilesYAML))
+	if err != nil {
+		t.Fatalf("ParseProfiles() error = %v", err)
+	}
+	if len(profiles) != 2 {
+		t.Fatalf("len(ParseProfiles()) = %d, want 2", len(profiles))
+	}
+	qa := profiles[0]
+	wantKeys := []string{"http_status_2xx", "no_recorded_error", "response_has_body", "regex", "json_schema", "contains", "contains_2", "not_contains", "finish_reason", "no_findings", "latency_budget", "gpt5_tokens", "semantic_similarity", "semantic_similarity_2"}
+	if strings.Join(qa.EvaluatorKeys, ",") != strings.Join(wantKeys, ",") {
+		t.Fatalf("EvaluatorKeys = %v, want %v", qa.EvaluatorKeys, wantKeys)
+	}
+
+	st, err := store.New(t.TempDir())
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+	answerPath := filepath.Join(t.TempDir(), "answer.http")
+	if err := os.WriteFile(answerPath, buildTextRecordFixture(t, "```json\n{\"answer\":\"Paris is the capital of France\"}\n```"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	entry := indexFixture(t, st, answerPath)
+
+	results := EvaluateProfile(qa, entry, &replay.Summary{BodyBytes: 10}, Input{Store: st, ExpectedOutput: `{"answer": "Paris is the capital of France"}`})
+	if len(results) != len(wantKeys) {
+		t.Fatalf("len(results) = %d, want %d: %#v", len(results), len(wantKeys), results)
+	}
+	for key, want := range map[string]string{
+		"http_status_2xx":       "pass",
+		"regex":                 "pass",
+		"json_schema":           "pass",
+		"contains":              "pass",
+		"contains_2":            "fail",
+		"not_contains":          "pass",
+		"finish_reason":         "pass",
+		"no_findings":           "pass",
+		"latency_budget":        "pass",
+		"gpt5_tokens":           "fail",
+		"semantic_similarity":   "pass",
+		"semantic_similarity_2": "fail",
+	} {
+		assertResultStatus(t, results, key, want)
+	}
+	for _, result := range results {
+		if result.Explanation == "" {
+			t.Fatalf("result %s has no explanation", result.EvaluatorKey)
+		}
+	}
+
+	if err := st.SaveFindings(entry.ID, []observe.Finding{{ID: "finding-1", TraceID: entry.ID, Category: "dangerous_shell", Severity: observe.SeverityHigh, Title: "rm -rf", Detector: "test", EvidencePath: "$"}}); err != nil {
+		t.Fatalf("SaveFindings() error = %v", err)
+	}
+	results = EvaluateProfile(qa, entry, &replay.Summary{BodyBytes: 10}, Input{Store: st})
+	assertResultStatus(t, results, "no_findings", "fail")
+	assertResultStatus(t, results, "semantic_similarity", "fail")
+
+	toolPath := filepath.Join(t.TempDir(), "tool.http")
+	if err := os.WriteFile(toolPath, buildToolCallRecordFixture(t, "weather", "weather"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	results = EvaluateProfile(profiles[1], store.LogEntry{ID: "tool", LogPath: toolPath}, &replay.Summary{}, Input{})
+	if len(results) != 3 {
+		t.Fatalf("len(weather_tools results) = %d, want 3 (no base checks)", len(results))
+	}
+	assertResultStatus(t, results, "tool_call", "pass")
+	assertResultStatus(t, results, "weather_country", "fail")
+	assertResultStatus(t, results, "search_called", "fail")
+}
+
+func TestCustomProfilesAreStoredAndResolved(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		yaml string
+		want string
+	}{
+		{"builtin name", "name: baseline_v1\nbase: baseline_v2", "conflicts with a built-in"},
+		{"unknown base", "name: x\nbase: nope", "not a built-in"},
+		{"empty", "name: x", "needs a base or at least one assertion"},
+		{"bad regex", "name: x\nassertions: [{type: regex, pattern: '('}]", "regex pattern"},
+		{"unknown type", "name: x\nassertions: [{type: vibes}]", "unknown assertion type"},
+		{"duplicate key", "name: x\nassertions: [{type: contains, value: a, key: k}, {type: contains, value: b, key: k}]", "duplicate assertion key"},
+		{"bad schema", "name: x\nassertions: [{type: json_schema, schema: {type: 7}}]", "schema"},
+	} {
+		if _, err := ParseProfiles([]byte(tc.yaml)); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("%s: ParseProfiles() error = %v, want %q", tc.name, err, tc.want)
+		}
+	}
+
+	st, err := store.New(t.TempDir())
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+	path := filepath.Join(t.TempDir(), "profiles.json")
+	if err := os.WriteFile(path, []byte(`{"name":"short_answers","description":"json file","assertions":[{"type":"token_budget","max_tokens":20}]}`), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if imported, err := ImportProfileFile(st, path); err != nil || imported != 1 {
+		t.Fatalf("ImportProfileFile() = %d, %v", imported, err)
+	}
+	profile, err := ResolveProfile(st, "short_answers")
+	if err != nil || profile.Source != "file:"+path || len(profile.EvaluatorKeys) != 1 || profile.EvaluatorKeys[0] != "token_budget" {
+		t.Fatalf("ResolveProfile() = %+v, err = %v", profile, err)
+	}
+	if _, err := ResolveProfile(st, "missing"); !errors.Is(err, ErrUnknownProfile) {
+		t.Fatalf("ResolveProfile(missing) error = %v, want ErrUnknownProfile", err)
+	}
+	all, err := ListAllProfiles(st)
+	if err != nil || len(all) != len(ListProfiles())+1 || all[0].Source != SourceBuiltin {
+		t.Fatalf("ListAllProfiles() = %+v, err = %v", all, err)
+	}
+
+	answerPath := filepath.Join(t.TempDir(), "answer.http")
+	if err := os.WriteFile(answerPath, buildTextRecordFixture(t, "ok"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	entry := indexFixture(t, st, answerPath)
+	run, err := ScoreTraces(st, []store.LogEntry{entry}, RunOptions{SourceType: "test", EvaluatorSet: "short_answers"})
+	if err != nil || run.ScoreCount != 1 || run.PassCount != 1 || run.EvaluatorSet != "short_answers" {
+		t.Fatalf("ScoreTraces() = %+v, err = %v", run, err)
+	}
+}
+
+func indexFixture(t *testing.T, st *store.Store, path string) store.LogEntry {
+	t.Helper()
+	content, err := recordfile.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	prelude, err := recordfile.ParsePrelude(content)
+	if err != nil {
+		t.Fatalf("ParsePrelude() error = %v", err)
+	}
+	if err := st.UpsertLog(path, prelude.Header); err != nil {
+		t.Fatalf("UpsertLog() error = %v", err)
+	}
+	entry, err := st.GetByRequestID(prelude.Header.Meta.RequestID)
+	if err != nil {
+		t.Fatalf("GetByRequestID() error = %v", err)
+	}
+	return entry
+}
+
+func buildTextRecordFixture(t *testing.T, content string) []byte {
+	t.Helper()
+	reqBody := `{"model":"gpt-5","messages":[{"role":"user","content":"capital of France?"}]}`
+	resBody := `{"id":"chatcmpl_2","object":"chat.completion","created":1710000000,"model":"gpt-5","choices":[{"index":0,"message":{"role":"assistant","content":` + strconv.Quote(content) + `},"finish_reason":"stop"}],"usage":{"prompt_tokens":10,"completion_tokens":5,"total_tokens":15}}`
+	reqHead := "POST /v1/chat/completions HTTP/1.1\r\nHost: example.com\r\nContent-Type: application/json\r\n\r\n"
+	resHead := "HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n"
+	header := recordfile.RecordHeader{
+		Version: "LLM_PROXY_V3",
+		Meta: recordfile.MetaData{
+			RequestID:  "req-text-" + strconv.Itoa(len(content)),
+			Time:       time.Date(2026, 4, 21, 8, 0, 0, 0, time.UTC),
+			Model:      "gpt-5",
+			Provider:   "openai_compatible",
+			Operation:  "chat.completions",
+			Endpoint:   "/v1/chat/completions",
+			URL:        "/v1/chat/completions",
+			Method:     "POST",
+			StatusCode: 200,
+			DurationMs: 100,
+			TTFTMs:     10,
+		},
+		Layout: recordfile.LayoutInfo{
+			ReqHeaderLen: int64(len(reqHead)),
+			ReqBodyLen:   int64(len(reqBody)),
+			ResHeaderLen: int64(len(resHead)),
+			ResBodyLen:   int64(len(resBody)),
+		},
+		Usage: recordfile.UsageInfo{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15},
+	}
+	prelude, err := recordfile.MarshalPrelude(header, recordfile.BuildEvents(header))
+	if err != nil {
+		t.Fatalf("MarshalPrelude() error = %v", err)
+	}
+	return append(prelude, []byte(reqHead+reqBody+"\n"+resHead+resBody)...)
+}
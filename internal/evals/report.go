@@ -0,0 +1,403 @@
+package evals
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/store"
+)
+
+// ReportOptions 控制 eval 报告的阈值判定与基线对比。
+type ReportOptions struct {
+	// MinPassRate 是全部检查项的最低通过率，<= 0 时不检查。
+	MinPassRate float64
+	// Thresholds 按 evaluator key 给出最低通过率。
+	Thresholds map[string]float64
+	// BaselineRunID 非空时与该 eval run 按 (trace, evaluator) 对比，列出回归项。
+	BaselineRunID string
+	// MonitorURL 是 Monitor 的访问地址，用于生成 trace 链接，为空时不生成。
+	MonitorURL string
+	// WorstTraces 是报告中列出的失败最多的 trace 数量，默认 10。
+	WorstTraces int
+}
+
+// Report 汇总一次 eval run，Passed 为 false 时 CI 应判为失败，原因见 Violations。
+type Report struct {
+	RunID        string          `json:"run_id"`
+	DatasetID    string          `json:"dataset_id,omitempty"`
+	EvaluatorSet string          `json:"evaluator_set"`
+	CreatedAt    time.Time       `json:"created_at"`
+	TraceCount   int             `json:"trace_count"`
+	ScoreCount   int             `json:"score_count"`
+	PassCount    int             `json:"pass_count"`
+	FailCount    int             `json:"fail_count"`
+	PassRate     float64         `json:"pass_rate"`
+	Evaluators   []EvaluatorStat `json:"evaluators"`
+	WorstTraces  []TraceStat     `json:"worst_traces"`
+	Baseline     *BaselineReport `json:"baseline,omitempty"`
+	Violations   []string        `json:"violations"`
+	Passed       bool            `json:"passed"`
+	scores       []store.ScoreRecord
+	traceModels  map[string]string
+}
+
+type EvaluatorStat struct {
+	EvaluatorKey string   `json:"evaluator_key"`
+	PassCount    int      `json:"pass_count"`
+	FailCount    int      `json:"fail_count"`
+	PassRate     float64  `json:"pass_rate"`
+	MinPassRate  *float64 `json:"min_pass_rate,omitempty"`
+}
+
+type TraceStat struct {
+	TraceID   string        `json:"trace_id"`
+	Model     string        `json:"model,omitempty"`
+	PassCount int           `json:"pass_count"`
+	FailCount int           `json:"fail_count"`
+	Failures  []FailedCheck `json:"failures"`
+	URL       string        `json:"url,omitempty"`
+}
+
+type FailedCheck struct {
+	TraceID      string `json:"trace_id,omitempty"`
+	EvaluatorKey string `json:"evaluator_key"`
+	Explanation  string `json:"explanation"`
+	URL          string `json:"url,omitempty"`
+}
+
+// BaselineReport 是与基线 eval run 的对比：Regressions 为基线未失败、本次失败的检查项。
+type BaselineReport struct {
+	RunID         string        `json:"run_id"`
+	PassRate      float64       `json:"pass_rate"`
+	PassRateDelta float64       `json:"pass_rate_delta"`
+	Regressions   []FailedCheck `json:"regressions"`
+	FixedCount    int           `json:"fixed_count"`
+}
+
+// BuildRunReport 读取 eval run 的全部 score，计算各 evaluator 通过率、最差 trace，并按阈值或基线判定是否通过。
+func BuildRunReport(st *store.Store, runID string, opts ReportOptions) (Report, error) {
+	run, err := st.GetEvalRun(runID)
+	if err != nil {
+		return Report{}, err
+	}
+	scores, err := runScores(st, run)
+	if err != nil {
+		return Report{}, err
+	}
+	report := Report{
+		RunID:        run.ID,
+		DatasetID:    run.DatasetID,
+		EvaluatorSet: run.EvaluatorSet,
+		CreatedAt:    run.CreatedAt,
+		TraceCount:   run.TraceCount,
+		scores:       scores,
+		traceModels:  map[string]string{},
+		Violations:   []string{},
+	}
+	evaluators := map[string]*EvaluatorStat{}
+	var evaluatorOrder []string
+	traces := map[string]*TraceStat{}
+	var traceOrder []string
+	for _, score := range scores {
+		stat, ok := evaluators[score.EvaluatorKey]
+		if !ok {
+			stat = &EvaluatorStat{EvaluatorKey: score.EvaluatorKey}
+			evaluators[score.EvaluatorKey] = stat
+			evaluatorOrder = append(evaluatorOrder, score.EvaluatorKey)
+		}
+		trace, ok := traces[score.TraceID]
+		if !ok {
+			trace = &TraceStat{TraceID: score.TraceID, URL: traceURL(opts.MonitorURL, score.TraceID), Failures: []FailedCheck{}}
+			if entry, err := st.GetByID(score.TraceID); err == nil {
+				trace.Model = entry.Header.Meta.Model
+			}
+			report.traceModels[score.TraceID] = trace.Model
+			traces[score.TraceID] = trace
+			traceOrder = append(traceOrder, score.TraceID)
+		}
+		report.ScoreCount++
+		if score.Status == "pass" {
+			report.PassCount++
+			stat.PassCount++
+			trace.PassCount++
+		} else {
+			report.FailCount++
+			stat.FailCount++
+			trace.FailCount++
+			trace.Failures = append(trace.Failures, FailedCheck{EvaluatorKey: score.EvaluatorKey, Explanation: score.Explanation})
+		}
+	}
+	report.PassRate = passRate(report.PassCount, report.ScoreCount)
+
+	for _, key := range evaluatorOrder {
+		stat := evaluators[key]
+		stat.PassRate = passRate(stat.PassCount, stat.PassCount+stat.FailCount)
+		if threshold, ok := opts.Thresholds[key]; ok {
+			stat.MinPassRate = &threshold
+			if stat.PassRate < threshold {
+				report.Violations = append(report.Violations, fmt.Sprintf("%s pass rate %.1f%% is below %.1f%%", key, stat.PassRate*100, threshold*100))
+			}
+		}
+		report.Evaluators = append(report.Evaluators, *stat)
+	}
+	for key, threshold := range opts.Thresholds {
+		if _, ok := evaluators[key]; !ok {
+			report.Violations = append(report.Violations, fmt.Sprintf("%s has no scores but requires %.1f%%", key, threshold*100))
+		}
+	}
+	if opts.MinPassRate > 0 && report.PassRate < opts.MinPassRate {
+		report.Violations = append(report.Violations, fmt.Sprintf("overall pass rate %.1f%% is below %.1f%%", report.PassRate*100, opts.MinPassRate*100))
+	}
+
+	worst := opts.WorstTraces
+	if worst <= 0 {
+		worst = 10
+	}
+	report.WorstTraces = []TraceStat{}
+	for _, id := range traceOrder {
+		if traces[id].FailCount > 0 {
+			report.WorstTraces = append(report.WorstTraces, *traces[id])
+		}
+	}
+	sort.SliceStable(report.WorstTraces, func(i, j int) bool {
+		return report.WorstTraces[i].FailCount > report.WorstTraces[j].FailCount
+	})
+	report.WorstTraces = report.WorstTraces[:min(worst, len(report.WorstTraces))]
+
+	if opts.BaselineRunID != "" {
+		baseline, err := compareWithBaseline(st, scores, opts)
+		if err != nil {
+			return Report{}, err
+		}
+		baseline.PassRateDelta = report.PassRate - baseline.PassRate
+		report.Baseline = &baseline
+		if len(baseline.Regressions) > 0 {
+			report.Violations = append(report.Violations, fmt.Sprintf("%d regression(s) against baseline run %s", len(baseline.Regressions), baseline.RunID))
+		}
+	}
+	report.Passed = len(report.Violations) == 0
+	return report, nil
+}
+
+// LatestEvalRun 返回同一数据集与评估集下除 beforeRunID 外最近的一次 eval run，作为 --baseline latest 的基线；
+// 没有更早的运行（例如 CI 首次运行）时返回 sql.ErrNoRows，调用方应视为无基线。
+func LatestEvalRun(st *store.Store, datasetID string, evaluatorSet string, beforeRunID string) (store.EvalRunRecord, error) {
+	return st.LatestEvalRun(datasetID, evaluatorSet, beforeRunID)
+}
+
+func compareWithBaseline(st *store.Store, scores []store.ScoreRecord, opts ReportOptions) (BaselineReport, error) {
+	run, err := st.GetEvalRun(opts.BaselineRunID)
+	if err != nil {
+		return BaselineReport{}, fmt.Errorf("baseline eval run %s: %w", opts.BaselineRunID, err)
+	}
+	baselineScores, err := runScores(st, run)
+	if err != nil {
+		return BaselineReport{}, err
+	}
+	failedBefore := map[string]bool{}
+	pass := 0
+	for _, score := range baselineScores {
+		if score.Status == "pass" {
+			pass++
+		} else {
+			failedBefore[score.TraceID+"\x00"+score.EvaluatorKey] = true
+		}
+	}
+	out := BaselineReport{RunID: run.ID, PassRate: passRate(pass, len(baselineScores)), Regressions: []FailedCheck{}}
+	failedNow := map[string]bool{}
+	for _, score := range scores {
+		key := score.TraceID + "\x00" + score.EvaluatorKey
+		if score.Status == "pass" {
+			continue
+		}
+		failedNow[key] = true
+		// 基线中已失败的检查不算回归，基线中没有的新检查失败则算。
+		if !failedBefore[key] {
+			out.Regressions = append(out.Regressions, FailedCheck{TraceID: score.TraceID, EvaluatorKey: score.EvaluatorKey, Explanation: score.Explanation, URL: traceURL(opts.MonitorURL, score.TraceID)})
+		}
+	}
+	for key := range failedBefore {
+		if !failedNow[key] {
+			out.FixedCount++
+		}
+	}
+	return out, nil
+}
+
+// runScores 按 trace 与 evaluator 的稳定顺序返回 eval run 的全部 score。
+func runScores(st *store.Store, run store.EvalRunRecord) ([]store.ScoreRecord, error) {
+	scores, err := st.ListScores(store.ScoreFilter{EvalRunID: run.ID}, max(run.ScoreCount, 1))
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		if !scores[i].CreatedAt.Equal(scores[j].CreatedAt) {
+			return scores[i].CreatedAt.Before(scores[j].CreatedAt)
+		}
+		return scores[i].ID < scores[j].ID
+	})
+	return scores, nil
+}
+
+func passRate(pass int, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(pass) / float64(total)
+}
+
+func traceURL(base string, traceID string) string {
+	base = strings.TrimRight(strings.TrimSpace(base), "/")
+	if base == "" {
+		return ""
+	}
+	return base + "/traces/" + traceID
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit 以 JUnit XML 输出报告：每个 evaluator 是一个 testsuite，每条 trace 的检查是一个 testcase。
+// 给出基线时只有回归项记为 failure，基线中已失败的检查保持通过，便于 CI 只拦截回归。
+func WriteJUnit(w io.Writer, report Report) error {
+	regressed := map[string]bool{}
+	if report.Baseline != nil {
+		for _, item := range report.Baseline.Regressions {
+			regressed[item.TraceID+"\x00"+item.EvaluatorKey] = true
+		}
+	}
+	suites := map[string]*junitTestSuite{}
+	var order []string
+	out := junitTestSuites{Name: "llm-tracelab eval " + report.EvaluatorSet}
+	for _, score := range report.scores {
+		suite, ok := suites[score.EvaluatorKey]
+		if !ok {
+			suite = &junitTestSuite{Name: score.EvaluatorKey, Timestamp: report.CreatedAt.UTC().Format(time.RFC3339)}
+			suites[score.EvaluatorKey] = suite
+			order = append(order, score.EvaluatorKey)
+		}
+		name := score.TraceID
+		if model := report.traceModels[score.TraceID]; model != "" {
+			name += " (" + model + ")"
+		}
+		testCase := junitTestCase{Name: name, ClassName: report.EvaluatorSet + "." + score.EvaluatorKey}
+		failed := score.Status != "pass"
+		if report.Baseline != nil {
+			failed = regressed[score.TraceID+"\x00"+score.EvaluatorKey]
+		}
+		if failed {
+			testCase.Failure = &junitFailure{Message: score.Explanation, Type: score.Status, Text: score.Explanation}
+			suite.Failures++
+			out.Failures++
+		}
+		suite.Tests++
+		out.Tests++
+		suite.Cases = append(suite.Cases, testCase)
+	}
+	for _, key := range order {
+		out.Suites = append(out.Suites, *suites[key])
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(out); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// WriteMarkdown 输出适合 CI 作业摘要的 Markdown：总体结果、各 evaluator 通过率、回归项与失败最多的 trace。
+func WriteMarkdown(w io.Writer, report Report) error {
+	var b strings.Builder
+	status := "✅ passed"
+	if !report.Passed {
+		status = "❌ failed"
+	}
+	fmt.Fprintf(&b, "## Eval %s: %s\n\n", report.EvaluatorSet, status)
+	if report.DatasetID != "" {
+		fmt.Fprintf(&b, "Dataset `%s`, run `%s`: %d trace(s), %d/%d checks passed (%.1f%%).\n", report.DatasetID, report.RunID, report.TraceCount, report.PassCount, report.ScoreCount, report.PassRate*100)
+	} else {
+		fmt.Fprintf(&b, "Run `%s`: %d trace(s), %d/%d checks passed (%.1f%%).\n", report.RunID, report.TraceCount, report.PassCount, report.ScoreCount, report.PassRate*100)
+	}
+	if report.Baseline != nil {
+		fmt.Fprintf(&b, "Baseline `%s`: %.1f%% (%+.1f), %d regression(s), %d fixed.\n", report.Baseline.RunID, report.Baseline.PassRate*100, report.Baseline.PassRateDelta*100, len(report.Baseline.Regressions), report.Baseline.FixedCount)
+	}
+	for _, violation := range report.Violations {
+		fmt.Fprintf(&b, "\n- %s", violation)
+	}
+	if len(report.Violations) > 0 {
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n| Evaluator | Passed | Failed | Pass rate | Threshold |\n|---|---:|---:|---:|---:|\n")
+	for _, stat := range report.Evaluators {
+		threshold := "-"
+		if stat.MinPassRate != nil {
+			threshold = fmt.Sprintf("%.1f%%", *stat.MinPassRate*100)
+		}
+		fmt.Fprintf(&b, "| `%s` | %d | %d | %.1f%% | %s |\n", stat.EvaluatorKey, stat.PassCount, stat.FailCount, stat.PassRate*100, threshold)
+	}
+
+	if report.Baseline != nil && len(report.Baseline.Regressions) > 0 {
+		b.WriteString("\n### Regressions\n\n| Trace | Evaluator | Explanation |\n|---|---|---|\n")
+		for _, item := range report.Baseline.Regressions {
+			fmt.Fprintf(&b, "| %s | `%s` | %s |\n", markdownTraceLink(item.TraceID, item.URL), item.EvaluatorKey, markdownCell(item.Explanation))
+		}
+	}
+	if len(report.WorstTraces) > 0 {
+		b.WriteString("\n### Worst traces\n\n| Trace | Model | Failed | Failed checks |\n|---|---|---:|---|\n")
+		for _, trace := range report.WorstTraces {
+			keys := make([]string, 0, len(trace.Failures))
+			for _, failure := range trace.Failures {
+				keys = append(keys, "`"+failure.EvaluatorKey+"`")
+			}
+			fmt.Fprintf(&b, "| %s | %s | %d/%d | %s |\n", markdownTraceLink(trace.TraceID, trace.URL), markdownCell(trace.Model), trace.FailCount, trace.FailCount+trace.PassCount, strings.Join(keys, ", "))
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func markdownTraceLink(traceID string, url string) string {
+	if url == "" {
+		return "`" + traceID + "`"
+	}
+	return "[`" + traceID + "`](" + url + ")"
+}
+
+func markdownCell(text string) string {
+	text = strings.ReplaceAll(strings.TrimSpace(text), "\n", " ")
+	return strings.ReplaceAll(text, "|", "\\|")
+}
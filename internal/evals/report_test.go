@@ -0,0 +1,129 @@
+package evals
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/xml"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kingfs/llm-tracelab/internal/store"
+)
+
+func TestRunReportAppliesThresholdsAndBaselineRegressions(t *testing.T) {
+	st, err := store.New(t.TempDir())
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+	var entries []store.LogEntry
+	for i, content := range []string{"Paris is the capital.", "I do not know."} {
+		path := filepath.Join(t.TempDir(), "answer.http")
+		if err := os.WriteFile(path, buildTextRecordFixture(t, content), 0o644); err != nil {
+			t.Fatalf("WriteFile(%d) error = %v", i, err)
+		}
+		entries = append(entries, indexFixture(t, st, path))
+	}
+	for _, def := range []string{
+		"name: lenient\nbase: baseline_v1\nassertions: [{type: contains, key: answer, value: '.'}]",
+		"name: strict\nbase: baseline_v1\nassertions: [{type: contains, key: answer, value: Paris}]",
+	} {
+		parsed, err := ParseProfiles([]byte(def))
+		if err != nil {
+			t.Fatalf("ParseProfiles() error = %v", err)
+		}
+		if _, err := SaveProfile(st, parsed[0], SourceAPI); err != nil {
+			t.Fatalf("SaveProfile() error = %v", err)
+		}
+	}
+	baseline, err := ScoreTraces(st, entries, RunOptions{DatasetID: "ds", SourceType: "test", EvaluatorSet: "lenient"})
+	if err != nil {
+		t.Fatalf("ScoreTraces(baseline) error = %v", err)
+	}
+	current, err := ScoreTraces(st, entries, RunOptions{DatasetID: "ds", SourceType: "test", EvaluatorSet: "strict"})
+	if err != nil {
+		t.Fatalf("ScoreTraces(current) error = %v", err)
+	}
+
+	report, err := BuildRunReport(st, current.ID, ReportOptions{
+		MinPassRate: 0.8,
+		Thresholds:  map[string]float64{"answer": 0.5, "http_status_2xx": 1},
+		MonitorURL:  "http://monitor.local/",
+	})
+	if err != nil {
+		t.Fatalf("BuildRunReport() error = %v", err)
+	}
+	// 87.5% 高于 80%，answer 恰好达到 50%，不应有阈值违规。
+	if !report.Passed || report.ScoreCount != 8 || report.FailCount != 1 || len(report.Violations) != 0 {
+		t.Fatalf("report = %+v", report)
+	}
+	report, err = BuildRunReport(st, current.ID, ReportOptions{MinPassRate: 1, Thresholds: map[string]float64{"answer": 0.9, "missing_key": 0.5}, MonitorURL: "http://monitor.local/"})
+	if err != nil {
+		t.Fatalf("BuildRunReport(strict thresholds) error = %v", err)
+	}
+	if report.Passed || len(report.Violations) != 3 {
+		t.Fatalf("violations = %v, want answer, missing_key and overall", report.Violations)
+	}
+	if len(report.WorstTraces) != 1 || report.WorstTraces[0].TraceID != entries[1].ID || report.WorstTraces[0].URL != "http://monitor.local/traces/"+entries[1].ID || report.WorstTraces[0].Model != "gpt-5" {
+		t.Fatalf("worst traces = %+v", report.WorstTraces)
+	}
+
+	// 与基线对比时只有基线未失败的检查算回归。
+	report, err = BuildRunReport(st, current.ID, ReportOptions{BaselineRunID: baseline.ID})
+	if err != nil {
+		t.Fatalf("BuildRunReport(baseline) error = %v", err)
+	}
+	if report.Passed || report.Baseline == nil || len(report.Baseline.Regressions) != 1 || report.Baseline.Regressions[0].TraceID != entries[1].ID || report.Baseline.PassRateDelta >= 0 {
+		t.Fatalf("baseline report = %+v", report.Baseline)
+	}
+	if latest, err := LatestEvalRun(st, "ds", "strict", ""); err != nil || latest.ID != current.ID {
+		t.Fatalf("LatestEvalRun() = %+v, err = %v", latest, err)
+	}
+	// current 是 strict 的首次运行，排除自身后没有可用的基线。
+	if _, err := LatestEvalRun(st, "ds", "strict", current.ID); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("LatestEvalRun(first run) error = %v, want sql.ErrNoRows", err)
+	}
+	rerun, err := ScoreTraces(st, entries, RunOptions{DatasetID: "ds", SourceType: "test", EvaluatorSet: "strict"})
+	if err != nil {
+		t.Fatalf("ScoreTraces(rerun) error = %v", err)
+	}
+	if latest, err := LatestEvalRun(st, "ds", "strict", rerun.ID); err != nil || latest.ID != current.ID {
+		t.Fatalf("LatestEvalRun(before rerun) = %+v, err = %v", latest, err)
+	}
+	report, err = BuildRunReport(st, rerun.ID, ReportOptions{BaselineRunID: current.ID})
+	if err != nil || !report.Passed || len(report.Baseline.Regressions) != 0 {
+		t.Fatalf("unchanged failures against baseline = %+v, err = %v", report, err)
+	}
+
+	var junit bytes.Buffer
+	if err := WriteJUnit(&junit, report); err != nil {
+		t.Fatalf("WriteJUnit() error = %v", err)
+	}
+	var suites struct {
+		Tests    int `xml:"tests,attr"`
+		Failures int `xml:"failures,attr"`
+		Suites   []struct {
+			Name string `xml:"name,attr"`
+		} `xml:"testsuite"`
+	}
+	if err := xml.Unmarshal(junit.Bytes(), &suites); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v\n%s", err, junit.String())
+	}
+	if suites.Tests != 8 || suites.Failures != 0 || len(suites.Suites) != 4 {
+		t.Fatalf("junit = %+v\n%s", suites, junit.String())
+	}
+
+	report, _ = BuildRunReport(st, rerun.ID, ReportOptions{BaselineRunID: baseline.ID, MonitorURL: "http://monitor.local"})
+	var markdown bytes.Buffer
+	if err := WriteMarkdown(&markdown, report); err != nil {
+		t.Fatalf("WriteMarkdown() error = %v", err)
+	}
+	for _, want := range []string{"## Eval strict: ❌ failed", "| `answer` | 1 | 1 | 50.0% | - |", "### Regressions", "(http://monitor.local/traces/" + entries[1].ID + ")", "### Worst traces"} {
+		if !strings.Contains(markdown.String(), want) {
+			t.Fatalf("markdown missing %q:\n%s", want, markdown.String())
+		}
+	}
+}
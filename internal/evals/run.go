@@ -0,0 +1,69 @@
+package evals
+
+import (
+	"strings"
+
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/pkg/replay"
+)
+
+// RunOptions 描述一次评估运行的来源，写入 eval_runs 以便事后追溯。
+type RunOptions struct {
+	DatasetID    string
+	SourceType   string
+	SourceID     string
+	EvaluatorSet string
+	// ExpectedOutputs 按 trace ID 给出期望输出，供 semantic_similarity 断言使用。
+	ExpectedOutputs map[string]string
+	// Judge 供 llm_judge 断言调用裁判模型。
+	Judge *Judge
+}
+
+// ScoreTraces 用指定评估集（内置或数据库中的自定义评估集）为一组 trace 打分，每条结果写为一行 Score，并返回收尾后的 eval run。
+func ScoreTraces(st *store.Store, entries []store.LogEntry, opts RunOptions) (store.EvalRunRecord, error) {
+	evaluatorSet := strings.TrimSpace(opts.EvaluatorSet)
+	if evaluatorSet == "" {
+		evaluatorSet = BaselineEvaluatorSet
+	}
+	profile, err := ResolveProfile(st, evaluatorSet)
+	if err != nil {
+		return store.EvalRunRecord{}, err
+	}
+	run, err := st.CreateEvalRun(opts.DatasetID, opts.SourceType, opts.SourceID, evaluatorSet, len(entries))
+	if err != nil {
+		return store.EvalRunRecord{}, err
+	}
+	for _, entry := range entries {
+		summary, err := replay.ReplayFile(entry.LogPath, replay.SummaryOptions{})
+		if err != nil {
+			// 无法回放的 cassette 按空响应处理，response_has_body 会据此判为失败。
+			summary = &replay.Summary{}
+		}
+		results := EvaluateProfile(profile, entry, summary, Input{Store: st, ExpectedOutput: opts.ExpectedOutputs[entry.ID], Judge: opts.Judge})
+		for _, result := range results {
+			if _, err := st.AddScore(store.ScoreRecord{
+				TraceID:      entry.ID,
+				SessionID:    entry.SessionID,
+				DatasetID:    opts.DatasetID,
+				EvalRunID:    run.ID,
+				EvaluatorKey: result.EvaluatorKey,
+				Value:        result.Value,
+				Status:       result.Status,
+				Label:        result.Label,
+				Explanation:  result.Explanation,
+			}); err != nil {
+				return store.EvalRunRecord{}, err
+			}
+			run.ScoreCount++
+			if result.Status == "pass" {
+				run.PassCount++
+			} else {
+				run.FailCount++
+			}
+		}
+	}
+	if err := st.FinalizeEvalRun(run.ID, run.ScoreCount, run.PassCount, run.FailCount); err != nil {
+		return store.EvalRunRecord{}, err
+	}
+	return st.GetEvalRun(run.ID)
+}
@@ -0,0 +1,83 @@
+package experiments
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kingfs/llm-tracelab/internal/auth"
+	"github.com/kingfs/llm-tracelab/internal/evals"
+	"github.com/kingfs/llm-tracelab/internal/monitor"
+)
+
+// NewHandler 返回 /api/experiments 与 /api/experiments/{id} 的 handler，由 monitor 挂载并负责鉴权。
+// POST 只创建 running 状态的实验并立即返回 202，结果通过 GET /api/experiments/{id} 轮询。
+func NewHandler(runner *Runner) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/experiments"), "/")
+		if id != "" {
+			if r.Method != http.MethodGet {
+				monitor.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+				return
+			}
+			report, err := BuildReport(runner.Store, id, runner.Pricing)
+			if errors.Is(err, sql.ErrNoRows) {
+				monitor.WriteJSON(w, http.StatusNotFound, map[string]string{"error": "experiment not found"})
+				return
+			}
+			if err != nil {
+				monitor.WriteJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+				return
+			}
+			monitor.WriteJSON(w, http.StatusOK, report)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+			items, err := ListSummaries(runner.Store, limit)
+			if err != nil {
+				monitor.WriteJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+				return
+			}
+			monitor.WriteJSON(w, http.StatusOK, map[string]any{"items": items})
+		case http.MethodPost:
+			if runner.Handler == nil {
+				monitor.WriteJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "experiment replay requires the proxy to run in the same process"})
+				return
+			}
+			var spec Spec
+			if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&spec); err != nil {
+				monitor.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid experiment request: " + err.Error()})
+				return
+			}
+			if principal, ok := auth.PrincipalFromContext(r.Context()); ok {
+				spec.User = principal.Username
+			}
+			if err := spec.Validate(); err != nil {
+				monitor.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+				return
+			}
+			items, err := runner.Start(r.Context(), spec)
+			if errors.Is(err, sql.ErrNoRows) {
+				monitor.WriteJSON(w, http.StatusNotFound, map[string]string{"error": "dataset not found"})
+				return
+			}
+			if errors.Is(err, ErrEmptyDataset) || errors.Is(err, evals.ErrUnknownProfile) {
+				monitor.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+				return
+			}
+			if err != nil {
+				monitor.WriteJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+				return
+			}
+			monitor.WriteJSON(w, http.StatusAccepted, map[string]any{"items": items})
+		default:
+			monitor.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		}
+	})
+}
@@ -0,0 +1,359 @@
+// Package experiments 把 dataset 中的请求重放到候选渠道/模型上，
+// 对基线与候选 trace 运行同一评估集，并产出可对比的实验报告。
+package experiments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/kingfs/llm-tracelab/internal/analyzer"
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/evals"
+	"github.com/kingfs/llm-tracelab/internal/playground"
+	"github.com/kingfs/llm-tracelab/internal/proxy"
+	"github.com/kingfs/llm-tracelab/internal/reanalysis"
+	"github.com/kingfs/llm-tracelab/internal/router"
+	"github.com/kingfs/llm-tracelab/internal/store"
+)
+
+const (
+	sourceDataset   = "dataset"
+	sourceCandidate = "experiment_candidate"
+)
+
+// Candidate 是一个重放目标；Channel 为空时按正常路由选择渠道，Model 为空时保留原模型。
+type Candidate struct {
+	Channel string `json:"channel,omitempty"`
+	Model   string `json:"model,omitempty"`
+}
+
+// Label 返回候选目标的可读名称，用作默认实验名。
+func (c Candidate) Label() string {
+	switch {
+	case c.Channel != "" && c.Model != "":
+		return c.Channel + "/" + c.Model
+	case c.Channel != "":
+		return c.Channel
+	default:
+		return c.Model
+	}
+}
+
+// sourceID 把候选目标编码进 eval run 的 source_id，报告据此还原。
+func (c Candidate) sourceID() string {
+	values := url.Values{}
+	if c.Channel != "" {
+		values.Set("channel", c.Channel)
+	}
+	if c.Model != "" {
+		values.Set("model", c.Model)
+	}
+	return values.Encode()
+}
+
+func candidateFromSourceID(sourceID string) Candidate {
+	values, _ := url.ParseQuery(sourceID)
+	return Candidate{Channel: values.Get("channel"), Model: values.Get("model")}
+}
+
+// Spec 描述一次实验；每个候选目标生成一条 experiment run，共享同一个基线 eval run。
+type Spec struct {
+	DatasetID    string      `json:"dataset_id"`
+	Name         string      `json:"name,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	EvaluatorSet string      `json:"evaluator_set,omitempty"`
+	Candidates   []Candidate `json:"candidates"`
+	User         string      `json:"-"`
+}
+
+// ErrEmptyDataset 表示 dataset 中没有可重放的样例。
+var ErrEmptyDataset = errors.New("dataset has no replayable examples")
+
+// Validate 规范化并校验实验参数，未指定评估集时使用默认基线评估集；评估集是否存在由 Run 检查。
+func (s *Spec) Validate() error {
+	s.DatasetID = strings.TrimSpace(s.DatasetID)
+	if s.DatasetID == "" {
+		return fmt.Errorf("dataset_id is required")
+	}
+	if len(s.Candidates) == 0 {
+		return fmt.Errorf("at least one candidate is required")
+	}
+	for i, candidate := range s.Candidates {
+		candidate = Candidate{Channel: strings.TrimSpace(candidate.Channel), Model: strings.TrimSpace(candidate.Model)}
+		if candidate.Channel == "" && candidate.Model == "" {
+			return fmt.Errorf("each candidate needs a channel or a model")
+		}
+		s.Candidates[i] = candidate
+	}
+	s.EvaluatorSet = strings.TrimSpace(s.EvaluatorSet)
+	if s.EvaluatorSet == "" {
+		s.EvaluatorSet = evals.BaselineEvaluatorSet
+	}
+	return nil
+}
+
+// Runner 执行实验。Handler 为空时只能查看已有报告。
+type Runner struct {
+	Store   *store.Store
+	Handler http.Handler
+	Pricing []config.ModelPrice
+	// Judge 是 llm_judge 断言使用的裁判渠道与模型，裁判请求同样经 Handler 代理。
+	Judge config.JudgeConfig
+	// Analyzer 是扫描候选 trace 的检测器，应与线上分析使用同一配置；为空时使用默认检测器。
+	Analyzer *analyzer.Runner
+	// Router 用于确定裁判渠道的协议；为空时裁判请求按 OpenAI 兼容协议发送。
+	Router *router.Router
+}
+
+// plan 是校验通过、可以开始重放的实验。
+type plan struct {
+	spec       Spec
+	dataset    store.DatasetRecord
+	replayable []store.DatasetExampleRecord
+	expected   map[string]string
+	baseline   []store.LogEntry
+	records    []store.ExperimentRunRecord // 与 spec.Candidates 一一对应，初始为 running
+}
+
+// Run 为每个候选目标重放 dataset 全部样例并打分，返回各候选的对比报告。
+func (r *Runner) Run(ctx context.Context, spec Spec) ([]Report, error) {
+	p, err := r.prepare(spec)
+	if err != nil {
+		return nil, err
+	}
+	runErr := r.execute(ctx, p)
+	reports := make([]Report, 0, len(p.records))
+	for _, record := range p.records {
+		report, err := BuildReport(r.Store, record.ID, r.Pricing)
+		if err != nil {
+			return reports, err
+		}
+		if report.Status != store.ExperimentCompleted {
+			break
+		}
+		reports = append(reports, report)
+	}
+	return reports, runErr
+}
+
+// Start 同步完成校验并为每个候选目标创建 running 状态的实验记录，随后在后台重放与打分。
+// 调用方通过 BuildReport 或 GET /api/experiments/{id} 轮询结果；ctx 取消不会中断后台任务。
+func (r *Runner) Start(ctx context.Context, spec Spec) ([]Summary, error) {
+	p, err := r.prepare(spec)
+	if err != nil {
+		return nil, err
+	}
+	background := context.WithoutCancel(ctx)
+	go func() {
+		if err := r.execute(background, p); err != nil {
+			slog.Warn("Experiment run failed", "dataset_id", p.dataset.ID, "error", err)
+		}
+	}()
+	out := make([]Summary, 0, len(p.records))
+	for i, record := range p.records {
+		out = append(out, Summary{
+			ID:           record.ID,
+			Name:         record.Name,
+			Status:       record.Status,
+			DatasetID:    p.dataset.ID,
+			EvaluatorSet: p.spec.EvaluatorSet,
+			Target:       p.spec.Candidates[i],
+			CreatedAt:    record.CreatedAt,
+		})
+	}
+	return out, nil
+}
+
+// prepare 校验参数、评估集与 dataset，并为每个候选目标写入 running 状态的实验记录。
+func (r *Runner) prepare(spec Spec) (plan, error) {
+	if r.Handler == nil {
+		return plan{}, fmt.Errorf("experiment replay requires the proxy to run in the same process")
+	}
+	if err := spec.Validate(); err != nil {
+		return plan{}, err
+	}
+	if _, err := evals.ResolveProfile(r.Store, spec.EvaluatorSet); err != nil {
+		return plan{}, err
+	}
+	dataset, err := r.Store.GetDataset(spec.DatasetID)
+	if err != nil {
+		return plan{}, err
+	}
+	examples, err := r.Store.GetDatasetExamples(dataset.ID)
+	if err != nil {
+		return plan{}, err
+	}
+	p := plan{spec: spec, dataset: dataset, expected: map[string]string{}}
+	for _, example := range examples {
+		if example.Trace.ID == "" || example.Trace.LogPath == "" {
+			// 原 trace 已被清理，无法重放。
+			continue
+		}
+		p.replayable = append(p.replayable, example)
+		if example.ExpectedOutput != "" {
+			p.expected[example.TraceID] = example.ExpectedOutput
+		}
+		p.baseline = append(p.baseline, example.Trace)
+	}
+	if len(p.baseline) == 0 {
+		return plan{}, ErrEmptyDataset
+	}
+
+	for _, candidate := range spec.Candidates {
+		name := strings.TrimSpace(spec.Name)
+		if name == "" {
+			name = dataset.Name + " vs " + candidate.Label()
+		} else if len(spec.Candidates) > 1 {
+			name += " (" + candidate.Label() + ")"
+		}
+		record, err := r.Store.CreateExperimentRun(store.ExperimentRunRecord{
+			Name:        name,
+			Description: spec.Description,
+			Status:      store.ExperimentRunning,
+		})
+		if err != nil {
+			return plan{}, err
+		}
+		p.records = append(p.records, record)
+	}
+	return p, nil
+}
+
+// execute 打分基线并逐个重放候选目标，回填实验记录；出错时当前及其后的候选都标记为 failed。
+func (r *Runner) execute(ctx context.Context, p plan) error {
+	fail := func(from int, err error) error {
+		for _, record := range p.records[from:] {
+			record.Status = store.ExperimentFailed
+			record.Error = err.Error()
+			if updateErr := r.Store.FinishExperimentRun(record); updateErr != nil {
+				slog.Warn("Mark experiment failed", "experiment_id", record.ID, "error", updateErr)
+			}
+		}
+		return err
+	}
+
+	judge := &evals.Judge{Handler: r.Handler, Channel: r.Judge.Channel, Model: r.Judge.Model}
+	if r.Router != nil {
+		judge.Protocol = evals.JudgeProtocol(r.Router.Targets(), r.Judge.Channel, r.Judge.Model)
+	}
+	baseline, err := evals.ScoreTraces(r.Store, p.baseline, evals.RunOptions{
+		DatasetID:       p.dataset.ID,
+		SourceType:      sourceDataset,
+		SourceID:        p.dataset.ID,
+		EvaluatorSet:    p.spec.EvaluatorSet,
+		ExpectedOutputs: p.expected,
+		Judge:           judge,
+	})
+	if err != nil {
+		return fail(0, fmt.Errorf("score baseline: %w", err))
+	}
+
+	for i, candidate := range p.spec.Candidates {
+		entries := r.replay(ctx, p.replayable, candidate, p.spec.User)
+		r.analyze(ctx, entries)
+		candidateExpected := map[string]string{}
+		for _, entry := range entries {
+			if rerun, err := r.Store.GetRerunOf(entry.ID); err == nil && p.expected[rerun.OriginalTraceID] != "" {
+				candidateExpected[entry.ID] = p.expected[rerun.OriginalTraceID]
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return fail(i, err)
+		}
+		candidateRun, err := evals.ScoreTraces(r.Store, entries, evals.RunOptions{
+			DatasetID:       p.dataset.ID,
+			SourceType:      sourceCandidate,
+			SourceID:        candidate.sourceID(),
+			EvaluatorSet:    p.spec.EvaluatorSet,
+			ExpectedOutputs: candidateExpected,
+			Judge:           judge,
+		})
+		if err != nil {
+			return fail(i, fmt.Errorf("score candidate %s: %w", candidate.Label(), err))
+		}
+		comparison, err := compareRuns(r.Store, baseline, candidateRun)
+		if err != nil {
+			return fail(i, err)
+		}
+		record := p.records[i]
+		record.Status = store.ExperimentCompleted
+		record.BaselineEvalRunID = baseline.ID
+		record.CandidateEvalRunID = candidateRun.ID
+		record.BaselineScoreCount = baseline.ScoreCount
+		record.CandidateScoreCount = candidateRun.ScoreCount
+		record.BaselinePassRate = passRate(baseline.PassCount, baseline.ScoreCount)
+		record.CandidatePassRate = passRate(candidateRun.PassCount, candidateRun.ScoreCount)
+		record.PassRateDelta = record.CandidatePassRate - record.BaselinePassRate
+		record.MatchedScoreCount = comparison.matched
+		record.ImprovementCount = comparison.improvements
+		record.RegressionCount = comparison.regressions
+		if err := r.Store.FinishExperimentRun(record); err != nil {
+			return fail(i, err)
+		}
+	}
+	return nil
+}
+
+// replay 把每个样例重放到候选目标，返回成功录制的新 trace；失败的上游响应同样会被录制并计入评估。
+func (r *Runner) replay(ctx context.Context, examples []store.DatasetExampleRecord, candidate Candidate, user string) []store.LogEntry {
+	edits := playground.Edits{}
+	if candidate.Model != "" {
+		model := candidate.Model
+		edits.Model = &model
+	}
+	entries := make([]store.LogEntry, 0, len(examples))
+	for _, example := range examples {
+		if ctx.Err() != nil {
+			break
+		}
+		edits.Override = example.InputOverride
+		req, err := playground.BuildRequest(ctx, example.Trace, edits)
+		if err != nil {
+			slog.Warn("Skip experiment example", "trace_id", example.TraceID, "candidate", candidate.Label(), "error", err)
+			continue
+		}
+		result, err := playground.Run(r.Handler, r.Store, req, &proxy.Rerun{
+			OriginalTraceID: example.TraceID,
+			Channel:         candidate.Channel,
+			User:            user,
+			TokenName:       example.Trace.Header.Meta.TokenName,
+		})
+		if result.TraceID == "" {
+			slog.Warn("Experiment replay was not recorded", "trace_id", example.TraceID, "candidate", candidate.Label(), "error", err)
+			continue
+		}
+		entry, err := r.Store.GetByID(result.TraceID)
+		if err != nil {
+			slog.Warn("Load experiment trace failed", "trace_id", result.TraceID, "error", err)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// analyze 在打分前同步解析并扫描候选 trace，否则 no_findings 等依赖 finding 的断言会因后台分析尚未完成而恒为通过。
+// 单条分析失败只记录日志，该 trace 照常打分。
+func (r *Runner) analyze(ctx context.Context, entries []store.LogEntry) {
+	svc := reanalysis.New(r.Store, reanalysis.Options{Runner: r.Analyzer})
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return
+		}
+		if _, err := svc.ReanalyzeTrace(ctx, entry.ID); err != nil {
+			slog.Warn("Analyze experiment trace failed", "trace_id", entry.ID, "error", err)
+		}
+	}
+}
+
+func passRate(pass int, total int) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(pass) / float64(total)
+}
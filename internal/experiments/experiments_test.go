@@ -0,0 +1,324 @@
+package experiments
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/proxy"
+	"github.com/kingfs/llm-tracelab/internal/router"
+	"github.com/kingfs/llm-tracelab/internal/store"
+)
+
+func TestRunnerComparesCandidatesAgainstDatasetBaseline(t *testing.T) {
+	outputDir := t.TempDir()
+	st, err := store.New(outputDir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	var (
+		mu       sync.Mutex
+		received = map[string][]map[string]any{}
+	)
+	newUpstream := func(name string, status int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var payload map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			mu.Lock()
+			received[name] = append(received[name], payload)
+			mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			if status != http.StatusOK {
+				w.WriteHeader(status)
+				_, _ = io.WriteString(w, `{"error":{"message":"overloaded"}}`)
+				return
+			}
+			_, _ = io.WriteString(w, `{"id":"chatcmpl-1","object":"chat.completion","model":"`+payload["model"].(string)+`","choices":[{"index":0,"finish_reason":"stop","message":{"role":"assistant","content":"ok"}}],"usage":{"prompt_tokens":1000,"completion_tokens":500,"total_tokens":1500}}`)
+		}))
+	}
+	primary := newUpstream("primary", http.StatusOK)
+	defer primary.Close()
+	broken := newUpstream("broken", http.StatusInternalServerError)
+	defer broken.Close()
+
+	enabled := true
+	cfg := &config.Config{}
+	for _, target := range []struct {
+		id       string
+		url      string
+		priority int
+	}{{"primary", primary.URL, 100}, {"broken", broken.URL, 90}} {
+		cfg.Upstreams = append(cfg.Upstreams, config.UpstreamTargetConfig{
+			ID:             target.id,
+			Enabled:        &enabled,
+			Priority:       target.priority,
+			ModelDiscovery: router.ModelDiscoveryStaticOnly,
+			StaticModels:   []string{"gpt-4o", "gpt-4o-mini"},
+			Upstream:       config.UpstreamConfig{BaseURL: target.url + "/v1", ProviderPreset: "openai"},
+		})
+	}
+	cfg.Router.Selection.Policy = router.PolicyFirstAvailable
+	cfg.Debug.OutputDir = outputDir
+	handler, err := proxy.NewHandler(cfg, st)
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+
+	for _, prompt := range []string{"first", "second"} {
+		req := httptest.NewRequest(http.MethodPost, "/v1/chat/completions", strings.NewReader(`{"model":"gpt-4o","messages":[{"role":"user","content":"`+prompt+`"}]}`))
+		req.Header.Set("Content-Type", "application/json")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+	entries, err := st.ListRecent(10)
+	if err != nil || len(entries) != 2 {
+		t.Fatalf("ListRecent() = %+v, err = %v", entries, err)
+	}
+	dataset, err := st.CreateDataset("chat-regressions", "")
+	if err != nil {
+		t.Fatalf("CreateDataset() error = %v", err)
+	}
+	if _, _, err := st.AppendDatasetExamples(dataset.ID, []string{entries[1].ID, entries[0].ID}, "trace", "", ""); err != nil {
+		t.Fatalf("AppendDatasetExamples() error = %v", err)
+	}
+	override := json.RawMessage(`{"max_tokens":64}`)
+	if _, err := st.UpdateDatasetExample(dataset.ID, entries[0].ID, store.DatasetExampleEdit{InputOverride: &override}); err != nil {
+		t.Fatalf("UpdateDatasetExample() error = %v", err)
+	}
+	mu.Lock()
+	received = map[string][]map[string]any{}
+	mu.Unlock()
+
+	runner := &Runner{
+		Store:   st,
+		Handler: handler,
+		Pricing: []config.ModelPrice{{Models: []string{"gpt-4o-mini"}, InputPerMillion: 0.15, OutputPerMillion: 0.6}, {Models: []string{"gpt-4o*"}, InputPerMillion: 2.5, OutputPerMillion: 10}},
+	}
+	if _, err := runner.Run(context.Background(), Spec{DatasetID: dataset.ID}); err == nil {
+		t.Fatalf("Run(no candidates) error = nil")
+	}
+	reports, err := runner.Run(context.Background(), Spec{
+		DatasetID:    dataset.ID,
+		EvaluatorSet: "baseline_v1", // 不含 TTFT 预算，避免本地请求耗时 0ms 时结果抖动
+		Candidates:   []Candidate{{Model: "gpt-4o-mini"}, {Channel: "broken"}},
+	})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(reports) != 2 {
+		t.Fatalf("len(reports) = %d, want 2", len(reports))
+	}
+
+	mini := reports[0]
+	if mini.Name != "chat-regressions vs gpt-4o-mini" || mini.Target.Model != "gpt-4o-mini" || mini.EvaluatorSet != "baseline_v1" || mini.DatasetID != dataset.ID {
+		t.Fatalf("mini report = %+v", mini)
+	}
+	if mini.Baseline.TraceCount != 2 || mini.Candidate.TraceCount != 2 || mini.Delta.PassRate != 0 || mini.RegressionCount != 0 || mini.Candidate.ErrorCount != 0 {
+		t.Fatalf("mini sides = %+v / %+v", mini.Baseline, mini.Candidate)
+	}
+	if mini.Baseline.Cost == nil || mini.Candidate.Cost == nil || mini.Delta.Cost == nil || *mini.Delta.Cost >= 0 {
+		t.Fatalf("mini cost = %v / %v / %v", mini.Baseline.Cost, mini.Candidate.Cost, mini.Delta.Cost)
+	}
+	if len(mini.Examples) != 2 || mini.Examples[0].TraceID != entries[1].ID || mini.Examples[0].CandidateTraceID == "" {
+		t.Fatalf("mini examples = %+v", mini.Examples)
+	}
+
+	broke := reports[1]
+	if broke.Candidate.ErrorCount != 2 || broke.RegressionCount == 0 || broke.Delta.PassRate >= 0 || broke.Baseline.EvalRunID != mini.Baseline.EvalRunID {
+		t.Fatalf("broken report = %+v", broke)
+	}
+	for _, example := range broke.Examples {
+		if example.Outcome != OutcomeRegressed || example.CandidateStatusCode < http.StatusInternalServerError {
+			t.Fatalf("broken example = %+v", example)
+		}
+	}
+
+	mu.Lock()
+	sentPrimary, sentBroken := received["primary"], received["broken"]
+	mu.Unlock()
+	// 首次 500 后路由可能直接熔断 broken，因此只要求它至少收到一次请求。
+	if len(sentPrimary) != 2 || len(sentBroken) == 0 {
+		t.Fatalf("upstream requests primary=%d broken=%d", len(sentPrimary), len(sentBroken))
+	}
+	var overridden int
+	for _, payload := range sentPrimary {
+		if payload["model"] != "gpt-4o-mini" {
+			t.Fatalf("candidate payload model = %v", payload["model"])
+		}
+		if payload["max_tokens"] == float64(64) {
+			overridden++
+		}
+	}
+	if overridden != 1 {
+		t.Fatalf("input override applied %d times, want 1", overridden)
+	}
+
+	rebuilt, err := BuildReport(st, broke.ID, nil)
+	if err != nil || rebuilt.RegressionCount != broke.RegressionCount || rebuilt.Baseline.Cost != nil || len(rebuilt.Examples) != 2 {
+		t.Fatalf("BuildReport() = %+v, err = %v", rebuilt, err)
+	}
+	summaries, err := ListSummaries(st, 10)
+	if err != nil || len(summaries) != 2 || summaries[0].DatasetID != dataset.ID {
+		t.Fatalf("ListSummaries() = %+v, err = %v", summaries, err)
+	}
+
+	// API 提交的实验在后台运行，先返回 running 的 id，再轮询到完成。
+	rec := httptest.NewRecorder()
+	NewHandler(runner).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/experiments", strings.NewReader(`{"dataset_id":"`+dataset.ID+`","evaluator_set":"baseline_v1","candidates":[{"model":"gpt-4o-mini"}]}`)))
+	var started struct {
+		Items []Summary `json:"items"`
+	}
+	if rec.Code != http.StatusAccepted || json.Unmarshal(rec.Body.Bytes(), &started) != nil || len(started.Items) != 1 || started.Items[0].Status != store.ExperimentRunning || started.Items[0].DatasetID != dataset.ID {
+		t.Fatalf("POST /api/experiments = %d %s", rec.Code, rec.Body.String())
+	}
+	deadline := time.Now().Add(10 * time.Second)
+	var polled Report
+	for {
+		rec = httptest.NewRecorder()
+		NewHandler(runner).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/experiments/"+started.Items[0].ID, nil))
+		if err := json.Unmarshal(rec.Body.Bytes(), &polled); err != nil || rec.Code != http.StatusOK {
+			t.Fatalf("GET experiment = %d %s", rec.Code, rec.Body.String())
+		}
+		if polled.Status != store.ExperimentRunning || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if polled.Status != store.ExperimentCompleted || polled.Candidate.TraceCount != 2 || polled.Target.Model != "gpt-4o-mini" {
+		t.Fatalf("polled report = %+v", polled)
+	}
+}
+
+func TestRunnerMarksFailedExperiments(t *testing.T) {
+	st, err := store.New(t.TempDir())
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+	record, err := st.CreateExperimentRun(store.ExperimentRunRecord{Name: "pending", Status: store.ExperimentRunning})
+	if err != nil {
+		t.Fatalf("CreateExperimentRun(running) error = %v", err)
+	}
+	report, err := BuildReport(st, record.ID, nil)
+	if err != nil || report.Status != store.ExperimentRunning || report.Name != "pending" {
+		t.Fatalf("BuildReport(running) = %+v, err = %v", report, err)
+	}
+	record.Status = store.ExperimentFailed
+	record.Error = "score baseline: boom"
+	if err := st.FinishExperimentRun(record); err != nil {
+		t.Fatalf("FinishExperimentRun() error = %v", err)
+	}
+	summaries, err := ListSummaries(st, 10)
+	if err != nil || len(summaries) != 1 || summaries[0].Status != store.ExperimentFailed || summaries[0].Error != record.Error {
+		t.Fatalf("ListSummaries() = %+v, err = %v", summaries, err)
+	}
+	if _, err := st.CreateExperimentRun(store.ExperimentRunRecord{Name: "completed without runs"}); err == nil {
+		t.Fatalf("CreateExperimentRun(completed, no eval runs) error = nil")
+	}
+}
+
+func TestHandlerRequiresProxyForRuns(t *testing.T) {
+	st, err := store.New(t.TempDir())
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+	handler := NewHandler(&Runner{Store: st})
+
+	for _, tc := range []struct {
+		method string
+		path   string
+		body   string
+		status int
+	}{
+		{http.MethodGet, "/api/experiments", "", http.StatusOK},
+		{http.MethodPost, "/api/experiments", `{"dataset_id":"x","candidates":[{"model":"gpt-4o"}]}`, http.StatusServiceUnavailable},
+		{http.MethodGet, "/api/experiments/missing", "", http.StatusNotFound},
+		{http.MethodDelete, "/api/experiments/missing", "", http.StatusMethodNotAllowed},
+	} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body)))
+		if rec.Code != tc.status {
+			t.Fatalf("%s %s = %d, want %d: %s", tc.method, tc.path, rec.Code, tc.status, rec.Body.String())
+		}
+	}
+}
+
+func TestRunnerAnalyzesCandidateTracesBeforeScoring(t *testing.T) {
+	outputDir := t.TempDir()
+	st, err := store.New(outputDir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		w.Header().Set("Content-Type", "application/json")
+		message := `{"role":"assistant","content":"ok"}`
+		if payload["model"] == "gpt-4o-mini" {
+			// 候选模型调用危险命令，只有扫描过候选 trace 才能发现。
+			message = `{"role":"assistant","content":null,"tool_calls":[{"id":"call_1","type":"function","function":{"name":"shell","arguments":"{\"command\":\"sudo rm -rf /\"}"}}]}`
+		}
+		_, _ = io.WriteString(w, `{"id":"chatcmpl-1","object":"chat.completion","model":"`+payload["model"].(string)+`","choices":[{"index":0,"finish_reason":"stop","message":`+message+`}],"usage":{"prompt_tokens":10,"completion_tokens":5,"total_tokens":15}}`)
+	}))
+	defer upstream.Close()
+
+	enabled := true
+	cfg := &config.Config{}
+	cfg.Upstreams = append(cfg.Upstreams, config.UpstreamTargetConfig{
+		ID:             "primary",
+		Enabled:        &enabled,
+		ModelDiscovery: router.ModelDiscoveryStaticOnly,
+		StaticModels:   []string{"gpt-4o", "gpt-4o-mini"},
+		Upstream:       config.UpstreamConfig{BaseURL: upstream.URL + "/v1", ProviderPreset: "openai"},
+	})
+	cfg.Debug.OutputDir = outputDir
+	handler, err := proxy.NewHandler(cfg, st)
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/v1/chat/completions", strings.NewReader(`{"model":"gpt-4o","messages":[{"role":"user","content":"clean up"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	entries, err := st.ListRecent(10)
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("ListRecent() = %+v, err = %v", entries, err)
+	}
+	dataset, err := st.CreateDataset("shell", "")
+	if err != nil {
+		t.Fatalf("CreateDataset() error = %v", err)
+	}
+	if _, _, err := st.AppendDatasetExamples(dataset.ID, []string{entries[0].ID}, "trace", "", ""); err != nil {
+		t.Fatalf("AppendDatasetExamples() error = %v", err)
+	}
+	if _, err := st.SaveEvalProfile(store.EvalProfileRecord{Name: "safe", Definition: json.RawMessage(`{"assertions":[{"type":"no_findings"}]}`), Source: "api"}); err != nil {
+		t.Fatalf("SaveEvalProfile() error = %v", err)
+	}
+
+	reports, err := (&Runner{Store: st, Handler: handler}).Run(context.Background(), Spec{
+		DatasetID:    dataset.ID,
+		EvaluatorSet: "safe",
+		Candidates:   []Candidate{{Model: "gpt-4o-mini"}},
+	})
+	if err != nil || len(reports) != 1 {
+		t.Fatalf("Run() = %+v, err = %v", reports, err)
+	}
+	report := reports[0]
+	if report.Baseline.PassRate != 1 || report.Candidate.PassRate != 0 || report.RegressionCount != 1 {
+		t.Fatalf("report sides = %+v / %+v, regressions = %d", report.Baseline, report.Candidate, report.RegressionCount)
+	}
+	findings, err := st.ListFindings(report.Examples[0].CandidateTraceID, store.FindingFilter{})
+	if err != nil || len(findings) == 0 {
+		t.Fatalf("candidate findings = %+v, err = %v", findings, err)
+	}
+}
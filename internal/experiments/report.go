@@ -0,0 +1,398 @@
+package experiments
+
+import (
+	"database/sql"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/glob"
+	"github.com/kingfs/llm-tracelab/internal/store"
+)
+
+// 样例级对比结果。
+const (
+	OutcomeImproved  = "improved"
+	OutcomeRegressed = "regressed"
+	OutcomeUnchanged = "unchanged"
+	OutcomeMissing   = "missing"
+)
+
+// Report 是一个候选目标相对基线的对比报告，全部由 store 中的 eval run、score 与 trace 重建。
+// Status 不是 completed 时只有基本信息与 Error，其余字段为零值。
+type Report struct {
+	ID                string    `json:"id"`
+	Name              string    `json:"name"`
+	Description       string    `json:"description,omitempty"`
+	Status            string    `json:"status"`
+	Error             string    `json:"error,omitempty"`
+	DatasetID         string    `json:"dataset_id"`
+	EvaluatorSet      string    `json:"evaluator_set"`
+	Target            Candidate `json:"target"`
+	CreatedAt         time.Time `json:"created_at"`
+	Baseline          Side      `json:"baseline"`
+	Candidate         Side      `json:"candidate"`
+	Delta             Delta     `json:"delta"`
+	MatchedScoreCount int       `json:"matched_score_count"`
+	ImprovementCount  int       `json:"improvement_count"`
+	RegressionCount   int       `json:"regression_count"`
+	Examples          []Example `json:"examples"`
+}
+
+// Side 汇总一侧 trace 的评估结果与运行指标。Cost 仅在至少一条 trace 命中价格配置时给出。
+type Side struct {
+	EvalRunID     string   `json:"eval_run_id"`
+	TraceCount    int      `json:"trace_count"`
+	ScoreCount    int      `json:"score_count"`
+	PassCount     int      `json:"pass_count"`
+	FailCount     int      `json:"fail_count"`
+	PassRate      float64  `json:"pass_rate"`
+	ErrorCount    int      `json:"error_count"`
+	AvgDurationMS float64  `json:"avg_duration_ms"`
+	AvgTTFTMS     float64  `json:"avg_ttft_ms"`
+	InputTokens   int      `json:"input_tokens"`
+	OutputTokens  int      `json:"output_tokens"`
+	TotalTokens   int      `json:"total_tokens"`
+	Cost          *float64 `json:"cost,omitempty"`
+	PricedTraces  int      `json:"priced_traces"`
+}
+
+// Delta 是候选减基线的差值。
+type Delta struct {
+	PassRate      float64  `json:"pass_rate"`
+	AvgDurationMS float64  `json:"avg_duration_ms"`
+	AvgTTFTMS     float64  `json:"avg_ttft_ms"`
+	TotalTokens   int      `json:"total_tokens"`
+	Cost          *float64 `json:"cost,omitempty"`
+}
+
+// Example 对比同一 dataset 样例在基线与候选上的结果。
+type Example struct {
+	TraceID             string `json:"trace_id"`
+	CandidateTraceID    string `json:"candidate_trace_id,omitempty"`
+	Outcome             string `json:"outcome"`
+	BaselinePassed      bool   `json:"baseline_passed"`
+	CandidatePassed     bool   `json:"candidate_passed"`
+	BaselineStatusCode  int    `json:"baseline_status_code"`
+	CandidateStatusCode int    `json:"candidate_status_code,omitempty"`
+	BaselineDurationMS  int64  `json:"baseline_duration_ms"`
+	CandidateDurationMS int64  `json:"candidate_duration_ms,omitempty"`
+	BaselineTokens      int    `json:"baseline_tokens"`
+	CandidateTokens     int    `json:"candidate_tokens,omitempty"`
+}
+
+// Summary 是实验列表中的一行。
+type Summary struct {
+	ID                string    `json:"id"`
+	Name              string    `json:"name"`
+	Status            string    `json:"status"`
+	Error             string    `json:"error,omitempty"`
+	DatasetID         string    `json:"dataset_id"`
+	EvaluatorSet      string    `json:"evaluator_set"`
+	Target            Candidate `json:"target"`
+	CreatedAt         time.Time `json:"created_at"`
+	BaselinePassRate  float64   `json:"baseline_pass_rate"`
+	CandidatePassRate float64   `json:"candidate_pass_rate"`
+	PassRateDelta     float64   `json:"pass_rate_delta"`
+	ImprovementCount  int       `json:"improvement_count"`
+	RegressionCount   int       `json:"regression_count"`
+}
+
+// ListSummaries 按创建时间倒序列出实验。
+func ListSummaries(st *store.Store, limit int) ([]Summary, error) {
+	records, err := st.ListExperimentRuns(limit)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Summary, 0, len(records))
+	for _, record := range records {
+		summary := Summary{
+			ID:                record.ID,
+			Name:              record.Name,
+			Status:            record.Status,
+			Error:             record.Error,
+			CreatedAt:         record.CreatedAt,
+			BaselinePassRate:  record.BaselinePassRate,
+			CandidatePassRate: record.CandidatePassRate,
+			PassRateDelta:     record.PassRateDelta,
+			ImprovementCount:  record.ImprovementCount,
+			RegressionCount:   record.RegressionCount,
+		}
+		if record.CandidateEvalRunID == "" {
+			// 仍在运行或失败的实验还没有候选 eval run。
+			out = append(out, summary)
+			continue
+		}
+		if run, err := st.GetEvalRun(record.CandidateEvalRunID); err == nil {
+			summary.DatasetID = run.DatasetID
+			summary.EvaluatorSet = run.EvaluatorSet
+			summary.Target = candidateFromSourceID(run.SourceID)
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		out = append(out, summary)
+	}
+	return out, nil
+}
+
+// BuildReport 重建一次实验的对比报告；实验不存在时返回 sql.ErrNoRows，未完成的实验只返回状态。
+func BuildReport(st *store.Store, experimentRunID string, pricing []config.ModelPrice) (Report, error) {
+	record, err := st.GetExperimentRun(strings.TrimSpace(experimentRunID))
+	if err != nil {
+		return Report{}, err
+	}
+	if record.Status != store.ExperimentCompleted {
+		return Report{
+			ID:          record.ID,
+			Name:        record.Name,
+			Description: record.Description,
+			Status:      record.Status,
+			Error:       record.Error,
+			CreatedAt:   record.CreatedAt,
+			Examples:    []Example{},
+		}, nil
+	}
+	baselineRun, err := st.GetEvalRun(record.BaselineEvalRunID)
+	if err != nil {
+		return Report{}, err
+	}
+	candidateRun, err := st.GetEvalRun(record.CandidateEvalRunID)
+	if err != nil {
+		return Report{}, err
+	}
+	comparison, err := compareRuns(st, baselineRun, candidateRun)
+	if err != nil {
+		return Report{}, err
+	}
+
+	report := Report{
+		ID:                record.ID,
+		Name:              record.Name,
+		Description:       record.Description,
+		Status:            record.Status,
+		DatasetID:         candidateRun.DatasetID,
+		EvaluatorSet:      candidateRun.EvaluatorSet,
+		Target:            candidateFromSourceID(candidateRun.SourceID),
+		CreatedAt:         record.CreatedAt,
+		MatchedScoreCount: record.MatchedScoreCount,
+		ImprovementCount:  record.ImprovementCount,
+		RegressionCount:   record.RegressionCount,
+		Examples:          []Example{},
+	}
+	entries := map[string]store.LogEntry{}
+	loadEntry := func(traceID string) (store.LogEntry, bool) {
+		if entry, ok := entries[traceID]; ok {
+			return entry, true
+		}
+		entry, err := st.GetByID(traceID)
+		if err != nil {
+			return store.LogEntry{}, false
+		}
+		entries[traceID] = entry
+		return entry, true
+	}
+	report.Baseline = buildSide(baselineRun, comparison.baselineTraces, loadEntry, pricing)
+	candidateTraces := make([]string, 0, len(comparison.candidateOf))
+	for _, traceID := range comparison.baselineTraces {
+		if candidateID := comparison.candidateOf[traceID]; candidateID != "" {
+			candidateTraces = append(candidateTraces, candidateID)
+		}
+	}
+	report.Candidate = buildSide(candidateRun, candidateTraces, loadEntry, pricing)
+	report.Delta = Delta{
+		PassRate:      report.Candidate.PassRate - report.Baseline.PassRate,
+		AvgDurationMS: report.Candidate.AvgDurationMS - report.Baseline.AvgDurationMS,
+		AvgTTFTMS:     report.Candidate.AvgTTFTMS - report.Baseline.AvgTTFTMS,
+		TotalTokens:   report.Candidate.TotalTokens - report.Baseline.TotalTokens,
+	}
+	if report.Baseline.Cost != nil && report.Candidate.Cost != nil {
+		delta := *report.Candidate.Cost - *report.Baseline.Cost
+		report.Delta.Cost = &delta
+	}
+
+	for _, traceID := range comparison.baselineTraces {
+		example := Example{TraceID: traceID, BaselinePassed: comparison.passed[traceID]}
+		if entry, ok := loadEntry(traceID); ok {
+			example.BaselineStatusCode = entry.Header.Meta.StatusCode
+			example.BaselineDurationMS = entry.Header.Meta.DurationMs
+			example.BaselineTokens = entry.Header.Usage.TotalTokens
+		}
+		candidateID := comparison.candidateOf[traceID]
+		if candidateID == "" {
+			example.Outcome = OutcomeMissing
+			report.Examples = append(report.Examples, example)
+			continue
+		}
+		example.CandidateTraceID = candidateID
+		example.CandidatePassed = comparison.passed[candidateID]
+		if entry, ok := loadEntry(candidateID); ok {
+			example.CandidateStatusCode = entry.Header.Meta.StatusCode
+			example.CandidateDurationMS = entry.Header.Meta.DurationMs
+			example.CandidateTokens = entry.Header.Usage.TotalTokens
+		}
+		switch {
+		case example.CandidatePassed && !example.BaselinePassed:
+			example.Outcome = OutcomeImproved
+		case !example.CandidatePassed && example.BaselinePassed:
+			example.Outcome = OutcomeRegressed
+		default:
+			example.Outcome = OutcomeUnchanged
+		}
+		report.Examples = append(report.Examples, example)
+	}
+	return report, nil
+}
+
+func buildSide(run store.EvalRunRecord, traceIDs []string, loadEntry func(string) (store.LogEntry, bool), pricing []config.ModelPrice) Side {
+	side := Side{
+		EvalRunID:  run.ID,
+		TraceCount: run.TraceCount,
+		ScoreCount: run.ScoreCount,
+		PassCount:  run.PassCount,
+		FailCount:  run.FailCount,
+		PassRate:   passRate(run.PassCount, run.ScoreCount),
+	}
+	var (
+		loaded      int
+		durationSum int64
+		ttftSum     int64
+		ttftCount   int
+		cost        float64
+	)
+	for _, traceID := range traceIDs {
+		entry, ok := loadEntry(traceID)
+		if !ok {
+			continue
+		}
+		loaded++
+		meta := entry.Header.Meta
+		if meta.StatusCode < 200 || meta.StatusCode >= 300 || meta.Error != "" {
+			side.ErrorCount++
+		}
+		durationSum += meta.DurationMs
+		if meta.TTFTMs > 0 {
+			ttftSum += meta.TTFTMs
+			ttftCount++
+		}
+		usage := entry.Header.Usage
+		side.InputTokens += usage.PromptTokens
+		side.OutputTokens += usage.CompletionTokens
+		side.TotalTokens += usage.TotalTokens
+		if price, ok := matchPrice(pricing, meta.Model); ok {
+			cost += float64(usage.PromptTokens)*price.InputPerMillion/1e6 + float64(usage.CompletionTokens)*price.OutputPerMillion/1e6
+			side.PricedTraces++
+		}
+	}
+	if loaded > 0 {
+		side.AvgDurationMS = float64(durationSum) / float64(loaded)
+	}
+	if ttftCount > 0 {
+		side.AvgTTFTMS = float64(ttftSum) / float64(ttftCount)
+	}
+	if side.PricedTraces > 0 {
+		side.Cost = &cost
+	}
+	return side
+}
+
+type runComparison struct {
+	// baselineTraces 按 dataset 样例顺序排列。
+	baselineTraces []string
+	// candidateOf 把基线 trace 映射到候选重放出的 trace。
+	candidateOf  map[string]string
+	passed       map[string]bool
+	matched      int
+	improvements int
+	regressions  int
+}
+
+// compareRuns 按 (原 trace, evaluator) 配对两次 eval run 的 score，统计改进与回退。
+func compareRuns(st *store.Store, baseline store.EvalRunRecord, candidate store.EvalRunRecord) (runComparison, error) {
+	baselineScores, err := st.ListScores(store.ScoreFilter{EvalRunID: baseline.ID}, max(baseline.ScoreCount, 1))
+	if err != nil {
+		return runComparison{}, err
+	}
+	candidateScores, err := st.ListScores(store.ScoreFilter{EvalRunID: candidate.ID}, max(candidate.ScoreCount, 1))
+	if err != nil {
+		return runComparison{}, err
+	}
+	out := runComparison{candidateOf: map[string]string{}, passed: map[string]bool{}}
+	baselineStatus := map[[2]string]string{}
+	for _, score := range baselineScores {
+		if _, ok := out.passed[score.TraceID]; !ok {
+			out.passed[score.TraceID] = true
+			out.baselineTraces = append(out.baselineTraces, score.TraceID)
+		}
+		out.passed[score.TraceID] = out.passed[score.TraceID] && score.Status == "pass"
+		baselineStatus[[2]string{score.TraceID, score.EvaluatorKey}] = score.Status
+	}
+	originOf := map[string]string{}
+	for _, score := range candidateScores {
+		origin, ok := originOf[score.TraceID]
+		if !ok {
+			rerun, err := st.GetRerunOf(score.TraceID)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return runComparison{}, err
+			}
+			origin = rerun.OriginalTraceID
+			originOf[score.TraceID] = origin
+			out.passed[score.TraceID] = true
+			if origin != "" {
+				out.candidateOf[origin] = score.TraceID
+			}
+		}
+		out.passed[score.TraceID] = out.passed[score.TraceID] && score.Status == "pass"
+		status, ok := baselineStatus[[2]string{origin, score.EvaluatorKey}]
+		if !ok {
+			continue
+		}
+		out.matched++
+		switch {
+		case status != "pass" && score.Status == "pass":
+			out.improvements++
+		case status == "pass" && score.Status != "pass":
+			out.regressions++
+		}
+	}
+	sortByDataset(st, baseline.DatasetID, out.baselineTraces)
+	return out, nil
+}
+
+// sortByDataset 按 dataset 样例位置排序；dataset 已删除时退回按 trace ID 排序。
+func sortByDataset(st *store.Store, datasetID string, traceIDs []string) {
+	position := map[string]int{}
+	if datasetID != "" {
+		if examples, err := st.GetDatasetExamples(datasetID); err == nil {
+			for i, example := range examples {
+				position[example.TraceID] = i
+			}
+		}
+	}
+	sort.SliceStable(traceIDs, func(i, j int) bool {
+		pi, iok := position[traceIDs[i]]
+		pj, jok := position[traceIDs[j]]
+		if iok != jok {
+			return iok
+		}
+		if iok && pi != pj {
+			return pi < pj
+		}
+		return traceIDs[i] < traceIDs[j]
+	})
+}
+
+// matchPrice 返回第一条命中模型名的价格配置。
+func matchPrice(pricing []config.ModelPrice, model string) (config.ModelPrice, bool) {
+	model = strings.ToLower(strings.TrimSpace(model))
+	if model == "" {
+		return config.ModelPrice{}, false
+	}
+	for _, price := range pricing {
+		if len(price.Models) > 0 && glob.MatchAny(price.Models, model) {
+			return price, true
+		}
+	}
+	return config.ModelPrice{}, false
+}
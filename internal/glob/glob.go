@@ -0,0 +1,36 @@
+package glob
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// MatchAny 判断 value 是否命中任一 path.Match 通配符；比较前去掉首尾空白并忽略大小写，
+// patterns 为空视为不限制，"*" 匹配任意值（包括空值）。
+func MatchAny(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	value = strings.ToLower(strings.TrimSpace(value))
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		if pattern == "*" || pattern == value {
+			return true
+		}
+		if ok, _ := path.Match(pattern, value); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate 按 MatchAny 的规则（去掉首尾空白、忽略大小写）检查通配符语法，返回第一个非法模式的错误。
+func Validate(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(strings.ToLower(strings.TrimSpace(pattern)), ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
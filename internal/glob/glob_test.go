@@ -0,0 +1,35 @@
+package glob
+
+import "testing"
+
+func TestMatchAny(t *testing.T) {
+	tests := []struct {
+		patterns []string
+		value    string
+		want     bool
+	}{
+		{nil, "gpt-4o", true},
+		{[]string{"*"}, "", true},
+		{[]string{"gpt-4o*"}, "GPT-4o-mini", true},
+		{[]string{" claude-* "}, "claude-3-5-sonnet", true},
+		{[]string{"claude-*", "gemini-*"}, "gpt-4o", false},
+		{[]string{"ci"}, "", false},
+		{[]string{"["}, "[", true},
+	}
+	for _, tt := range tests {
+		if got := MatchAny(tt.patterns, tt.value); got != tt.want {
+			t.Fatalf("MatchAny(%q, %q) = %v, want %v", tt.patterns, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate([]string{"gpt-4o*", " Claude-[a-z]* ", "*"}); err != nil {
+		t.Fatalf("Validate(valid) error = %v", err)
+	}
+	for _, patterns := range [][]string{{"gpt-["}, {"ok", "claude-[z-"}, {"\\"}} {
+		if err := Validate(patterns); err == nil {
+			t.Fatalf("Validate(%q) error = nil", patterns)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package guard
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/kingfs/llm-tracelab/internal/analyzer"
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/glob"
+)
+
+type Mode string
+
+const (
+	ModeOff   Mode = "off"
+	ModeWarn  Mode = "warn"
+	ModeMask  Mode = "mask"
+	ModeBlock Mode = "block"
+)
+
+// skippedTopLevelKeys 不属于 prompt 内容（模型名、工具声明、输出格式约束），不参与扫描。
+var skippedTopLevelKeys = map[string]bool{
+	"model":           true,
+	"tools":           true,
+	"functions":       true,
+	"tool_choice":     true,
+	"response_format": true,
+	"stream_options":  true,
+}
+
+// Guard 在请求发往上游前扫描 prompt 中的凭据与个人信息。
+type Guard struct {
+	enabled  bool
+	mode     Mode
+	patterns []analyzer.SensitivePattern
+	policies []policy
+}
+
+type policy struct {
+	tokens   []string
+	channels []string
+	mode     Mode
+}
+
+// Request 描述用于选择处理模式的请求维度。
+type Request struct {
+	Token   string
+	Channel string
+}
+
+// Match 是一次命中；Preview 只保留少量前缀，不包含完整敏感值。
+type Match struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Path     string `json:"path"`
+	Preview  string `json:"preview"`
+}
+
+// Result 是一次扫描结果。Masked 为命中值替换为占位符后的请求体，仅在有命中时非空。
+type Result struct {
+	Mode    Mode
+	Matches []Match
+	Masked  []byte
+}
+
+// Action 返回本次请求实际执行的动作；无命中时为空。
+func (r Result) Action() Mode {
+	if len(r.Matches) == 0 || r.Mode == ModeOff {
+		return ""
+	}
+	return r.Mode
+}
+
+func New(cfg *config.Config) (*Guard, error) {
+	g := &Guard{}
+	if cfg == nil || !cfg.Guard.Enabled {
+		return g, nil
+	}
+	mode, err := parseMode(cfg.Guard.Mode, ModeWarn)
+	if err != nil {
+		return nil, err
+	}
+	g.enabled = true
+	g.mode = mode
+	if cfg.Guard.Credentials == nil || *cfg.Guard.Credentials {
+		g.patterns = append(g.patterns, analyzer.CredentialPatterns()...)
+	}
+	for _, raw := range cfg.Guard.PII {
+		name := strings.TrimSpace(raw.Name)
+		if strings.TrimSpace(raw.Pattern) == "" {
+			builtin, ok := analyzer.PIIPattern(name)
+			if !ok {
+				return nil, fmt.Errorf("guard pii pattern %q: unknown built-in pattern", name)
+			}
+			g.patterns = append(g.patterns, builtin)
+			continue
+		}
+		re, err := regexp.Compile(raw.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("guard pii pattern %q: %w", name, err)
+		}
+		if name == "" {
+			name = raw.Pattern
+		}
+		g.patterns = append(g.patterns, analyzer.SensitivePattern{Name: name, Category: analyzer.SensitiveCategoryPII, Regexp: re})
+	}
+	for i, raw := range cfg.Guard.Policies {
+		mode, err := parseMode(raw.Mode, "")
+		if err != nil || mode == "" {
+			return nil, fmt.Errorf("guard policy #%d: invalid mode %q", i+1, raw.Mode)
+		}
+		for _, patterns := range [][]string{raw.Tokens, raw.Channels} {
+			if err := glob.Validate(patterns); err != nil {
+				return nil, fmt.Errorf("guard policy #%d: %w", i+1, err)
+			}
+		}
+		g.policies = append(g.policies, policy{tokens: raw.Tokens, channels: raw.Channels, mode: mode})
+	}
+	return g, nil
+}
+
+func parseMode(raw string, fallback Mode) (Mode, error) {
+	switch Mode(strings.ToLower(strings.TrimSpace(raw))) {
+	case "":
+		return fallback, nil
+	case ModeOff:
+		return ModeOff, nil
+	case ModeWarn:
+		return ModeWarn, nil
+	case ModeMask:
+		return ModeMask, nil
+	case ModeBlock:
+		return ModeBlock, nil
+	default:
+		return "", fmt.Errorf("unsupported guard mode %q", raw)
+	}
+}
+
+func (g *Guard) Enabled() bool {
+	return g != nil && g.enabled && len(g.patterns) > 0
+}
+
+// ModeFor 返回命中 token/渠道策略后的处理模式。
+func (g *Guard) ModeFor(req Request) Mode {
+	if !g.Enabled() {
+		return ModeOff
+	}
+	for _, p := range g.policies {
+		if glob.MatchAny(p.tokens, req.Token) && glob.MatchAny(p.channels, req.Channel) {
+			return p.mode
+		}
+	}
+	return g.mode
+}
+
+// Inspect 扫描 JSON 请求体中的字符串值。非 JSON 请求体不做处理。
+func (g *Guard) Inspect(req Request, body []byte) Result {
+	mode := g.ModeFor(req)
+	result := Result{Mode: mode}
+	if mode == ModeOff || len(bytes.TrimSpace(body)) == 0 {
+		return result
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var payload map[string]any
+	if err := decoder.Decode(&payload); err != nil || payload == nil {
+		return result
+	}
+
+	keys := make([]string, 0, len(payload))
+	for key := range payload {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if skippedTopLevelKeys[key] {
+			continue
+		}
+		payload[key] = g.walk(payload[key], "$."+key, &result.Matches)
+	}
+	if len(result.Matches) == 0 {
+		return result
+	}
+	masked, err := json.Marshal(payload)
+	if err == nil {
+		result.Masked = masked
+	}
+	return result
+}
+
+func (g *Guard) walk(value any, at string, matches *[]Match) any {
+	switch v := value.(type) {
+	case string:
+		return g.redact(v, at, matches)
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			v[key] = g.walk(v[key], at+"."+key, matches)
+		}
+		return v
+	case []any:
+		for i := range v {
+			v[i] = g.walk(v[i], fmt.Sprintf("%s[%d]", at, i), matches)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func (g *Guard) redact(text string, at string, matches *[]Match) string {
+	for _, pattern := range g.patterns {
+		text = pattern.Regexp.ReplaceAllStringFunc(text, func(hit string) string {
+			if pattern.Validate != nil && !pattern.Validate(hit) {
+				return hit
+			}
+			*matches = append(*matches, Match{
+				Name:     pattern.Name,
+				Category: pattern.Category,
+				Path:     at,
+				Preview:  preview(hit),
+			})
+			return "[REDACTED:" + pattern.Name + "]"
+		})
+	}
+	return text
+}
+
+func preview(hit string) string {
+	runes := []rune(hit)
+	if len(runes) <= 4 {
+		return strings.Repeat("*", len(runes))
+	}
+	return string(runes[:4]) + strings.Repeat("*", min(len(runes)-4, 8))
+}
@@ -0,0 +1,146 @@
+package guard
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/pkg/llm"
+)
+
+func newTestGuard(t *testing.T, mutate func(cfg *config.Config)) *Guard {
+	t.Helper()
+	cfg := &config.Config{}
+	cfg.Guard.Enabled = true
+	if mutate != nil {
+		mutate(cfg)
+	}
+	g, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return g
+}
+
+func TestInspectMasksCredentialsAndPII(t *testing.T) {
+	g := newTestGuard(t, func(cfg *config.Config) {
+		cfg.Guard.Mode = "mask"
+		cfg.Guard.PII = []config.GuardPattern{
+			{Name: "email"},
+			{Name: "credit_card"},
+			{Name: "employee_id", Pattern: `EMP-\d{6}`},
+		}
+	})
+
+	body := []byte(`{"model":"sk-model-name-should-not-be-scanned-xx","messages":[` +
+		`{"role":"user","content":"my key is sk-test_abcdefghijklmnopqrstuvwxyz, mail me at alice@example.com"},` +
+		`{"role":"tool","content":"card 4111 1111 1111 1111, order 1234 5678 9012 3456, id EMP-123456"}]}`)
+	result := g.Inspect(Request{}, body)
+	if result.Action() != ModeMask {
+		t.Fatalf("Action() = %q, want mask", result.Action())
+	}
+	names := map[string]string{}
+	for _, match := range result.Matches {
+		names[match.Name] = match.Path
+		if strings.Contains(match.Preview, "abcdefghij") {
+			t.Fatalf("preview leaks secret: %+v", match)
+		}
+	}
+	for _, want := range []string{"OpenAI API key", "email", "credit_card", "employee_id"} {
+		if names[want] == "" {
+			t.Fatalf("matches = %+v, missing %q", result.Matches, want)
+		}
+	}
+	if names["OpenAI API key"] != "$.messages[0].content" {
+		t.Fatalf("credential path = %q", names["OpenAI API key"])
+	}
+
+	masked := string(result.Masked)
+	for _, leaked := range []string{"sk-test_abcdefghijklmnopqrstuvwxyz", "alice@example.com", "4111 1111 1111 1111", "EMP-123456"} {
+		if strings.Contains(masked, leaked) {
+			t.Fatalf("masked body still contains %q: %s", leaked, masked)
+		}
+	}
+	if !strings.Contains(masked, "1234 5678 9012 3456") {
+		t.Fatalf("masked body should keep non-Luhn number: %s", masked)
+	}
+	if !strings.Contains(masked, "sk-model-name-should-not-be-scanned-xx") {
+		t.Fatalf("model field should not be scanned: %s", masked)
+	}
+}
+
+func TestModeForPolicies(t *testing.T) {
+	g := newTestGuard(t, func(cfg *config.Config) {
+		cfg.Guard.Policies = []config.GuardPolicy{
+			{Tokens: []string{"ci-*"}, Mode: "block"},
+			{Channels: []string{"internal"}, Mode: "off"},
+		}
+	})
+
+	for _, tc := range []struct {
+		req  Request
+		want Mode
+	}{
+		{req: Request{Token: "ci-nightly", Channel: "internal"}, want: ModeBlock},
+		{req: Request{Token: "dev", Channel: "internal"}, want: ModeOff},
+		{req: Request{Token: "dev", Channel: "openai"}, want: ModeWarn},
+	} {
+		if got := g.ModeFor(tc.req); got != tc.want {
+			t.Fatalf("ModeFor(%+v) = %q, want %q", tc.req, got, tc.want)
+		}
+	}
+
+	result := g.Inspect(Request{Channel: "internal"}, []byte(`{"input":"sk-test_abcdefghijklmnopqrstuvwxyz"}`))
+	if result.Action() != "" || len(result.Matches) != 0 {
+		t.Fatalf("Inspect() with off policy = %+v, want no action", result)
+	}
+}
+
+func TestNewRejectsInvalidConfig(t *testing.T) {
+	for name, mutate := range map[string]func(cfg *config.Config){
+		"mode":    func(cfg *config.Config) { cfg.Guard.Mode = "drop" },
+		"builtin": func(cfg *config.Config) { cfg.Guard.PII = []config.GuardPattern{{Name: "passport"}} },
+		"regexp":  func(cfg *config.Config) { cfg.Guard.PII = []config.GuardPattern{{Name: "bad", Pattern: "("}} },
+		"policy":  func(cfg *config.Config) { cfg.Guard.Policies = []config.GuardPolicy{{Tokens: []string{"x"}}} },
+	} {
+		cfg := &config.Config{}
+		cfg.Guard.Enabled = true
+		mutate(cfg)
+		if _, err := New(cfg); err == nil {
+			t.Fatalf("New(%s) error = nil, want error", name)
+		}
+	}
+}
+
+func TestBlockedResponseIsProviderShaped(t *testing.T) {
+	result := Result{Mode: ModeBlock, Matches: []Match{{Name: "OpenAI API key", Category: "credential"}}}
+	for provider, check := range map[string]func(map[string]any) bool{
+		llm.ProviderOpenAICompatible: func(p map[string]any) bool {
+			e, _ := p["error"].(map[string]any)
+			return e["type"] == "invalid_request_error" && e["code"] == "sensitive_data_blocked"
+		},
+		llm.ProviderAnthropic: func(p map[string]any) bool {
+			e, _ := p["error"].(map[string]any)
+			return p["type"] == "error" && e["type"] == "invalid_request_error"
+		},
+		llm.ProviderGoogleGenAI: func(p map[string]any) bool {
+			e, _ := p["error"].(map[string]any)
+			return e["status"] == "INVALID_ARGUMENT"
+		},
+	} {
+		status, body := BlockedResponse(provider, result)
+		var payload map[string]any
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Fatalf("BlockedResponse(%s) body is not JSON: %v", provider, err)
+		}
+		if status != 400 || !check(payload) {
+			t.Fatalf("BlockedResponse(%s) = %d %s", provider, status, body)
+		}
+	}
+
+	findings := Findings("trace-1", result)
+	if len(findings) != 1 || findings[0].Category != "outbound_credential" || findings[0].Detector != DetectorName || findings[0].ID == "" {
+		t.Fatalf("Findings() = %+v", findings)
+	}
+}
@@ -0,0 +1,161 @@
+package guard
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/analyzer"
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/pkg/llm"
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+)
+
+const (
+	DetectorName    = store.ProxyFindingDetectorPrefix + "outbound_guard"
+	DetectorVersion = "0.1.0"
+)
+
+// BlockedResponse 构造与上游协议一致的错误响应，客户端 SDK 可按原有错误路径处理。
+func BlockedResponse(provider string, result Result) (int, []byte) {
+	message := "request blocked by llm-tracelab outbound guard: " + strings.Join(matchNames(result.Matches), ", ")
+	var payload any
+	switch provider {
+	case llm.ProviderAnthropic:
+		payload = map[string]any{
+			"type": "error",
+			"error": map[string]any{
+				"type":    "invalid_request_error",
+				"message": message,
+			},
+		}
+	case llm.ProviderGoogleGenAI, llm.ProviderVertexNative:
+		payload = map[string]any{
+			"error": map[string]any{
+				"code":    http.StatusBadRequest,
+				"message": message,
+				"status":  "INVALID_ARGUMENT",
+			},
+		}
+	default:
+		payload = map[string]any{
+			"error": map[string]any{
+				"message": message,
+				"type":    "invalid_request_error",
+				"param":   nil,
+				"code":    "sensitive_data_blocked",
+			},
+		}
+	}
+	body, _ := json.Marshal(payload)
+	return http.StatusBadRequest, body
+}
+
+// Findings 把命中转换为 trace finding；同一路径上的同名命中只保留一条。
+func Findings(traceID string, result Result) []observe.Finding {
+	action := result.Action()
+	if action == "" {
+		return nil
+	}
+	seen := map[string]bool{}
+	var out []observe.Finding
+	for _, match := range result.Matches {
+		key := match.Path + "|" + match.Name
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		category := "outbound_pii"
+		severity := observe.SeverityMedium
+		if match.Category == analyzer.SensitiveCategoryCredential {
+			category = "outbound_credential"
+			severity = observe.SeverityHigh
+		}
+		f := observe.Finding{
+			TraceID:         traceID,
+			Category:        category,
+			Severity:        severity,
+			Confidence:      0.8,
+			Title:           fmt.Sprintf("%s %s before upstream", match.Name, actionVerb(action)),
+			Description:     fmt.Sprintf("Outbound guard detected %s in the request prompt and applied mode %q.", match.Name, action),
+			EvidencePath:    match.Path,
+			EvidenceExcerpt: match.Preview,
+			Detector:        DetectorName,
+			DetectorVersion: DetectorVersion,
+			CreatedAt:       time.Now().UTC(),
+		}
+		f.ID = analyzer.StableFindingID(f)
+		out = append(out, f)
+	}
+	return out
+}
+
+// SystemEvent 汇总一次出站 guard 动作；相同动作、token 与渠道的事件合并计数。
+func SystemEvent(traceID string, req Request, model string, result Result) store.SystemEvent {
+	action := result.Action()
+	severity := "warning"
+	if action == ModeWarn {
+		// warn 模式下敏感内容已经发往上游。
+		severity = "error"
+	}
+	names := matchNames(result.Matches)
+	details, _ := json.Marshal(map[string]any{
+		"action":  string(action),
+		"token":   req.Token,
+		"channel": req.Channel,
+		"matches": result.Matches,
+	})
+	return store.SystemEvent{
+		Fingerprint: strings.Join([]string{
+			"outbound_guard",
+			string(action),
+			firstNonEmpty(req.Token, "anonymous"),
+			firstNonEmpty(req.Channel, "unknown-upstream"),
+		}, ":"),
+		Source:      "proxy",
+		Category:    "outbound_guard",
+		Severity:    severity,
+		Title:       fmt.Sprintf("Outbound request %s by guard", actionVerb(action)),
+		Message:     fmt.Sprintf("%d sensitive value(s) %s: %s", len(result.Matches), actionVerb(action), strings.Join(names, ", ")),
+		DetailsJSON: details,
+		TraceID:     traceID,
+		UpstreamID:  req.Channel,
+		Model:       model,
+	}
+}
+
+func actionVerb(action Mode) string {
+	switch action {
+	case ModeBlock:
+		return "blocked"
+	case ModeMask:
+		return "masked"
+	default:
+		return "detected"
+	}
+}
+
+func matchNames(matches []Match) []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, match := range matches {
+		if !seen[match.Name] {
+			seen[match.Name] = true
+			names = append(names, match.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return value
+		}
+	}
+	return ""
+}
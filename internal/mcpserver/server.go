@@ -1,6 +1,7 @@
 package mcpserver
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -12,10 +13,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/kingfs/llm-tracelab/internal/analyzer"
+	"github.com/kingfs/llm-tracelab/internal/experiments"
 	"github.com/kingfs/llm-tracelab/internal/monitor"
 	"github.com/kingfs/llm-tracelab/internal/reanalysis"
 	"github.com/kingfs/llm-tracelab/internal/router"
 	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/pkg/observe"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -27,6 +31,10 @@ const (
 
 type Options struct {
 	Router *router.Router
+	// Experiments 为空时使用只读的实验 handler（无成本估算）。
+	Experiments http.Handler
+	// Analyzer 为空时重新分析只运行内置检测器。
+	Analyzer *analyzer.Runner
 }
 
 type listTracesInput struct {
@@ -35,6 +43,17 @@ type listTracesInput struct {
 	Provider string `json:"provider,omitempty" jsonschema:"optional provider filter"`
 	Model    string `json:"model,omitempty" jsonschema:"optional model substring filter"`
 	Query    string `json:"q,omitempty" jsonschema:"optional free-text query filter"`
+	Filter   string `json:"query,omitempty" jsonschema:"optional query-language filter, e.g. model:gpt-4* status:error latency>5s finding:loop* since:24h; terms are ANDed and a leading - negates"`
+	Saved    string `json:"saved,omitempty" jsonschema:"optional saved query name, combined with query"`
+	Tag      string `json:"tag,omitempty" jsonschema:"optional tag filter; comma-separated tags must all match, session tags apply to their traces"`
+}
+
+type searchTracesInput struct {
+	Query    string `json:"query" jsonschema:"full-text query over prompts, outputs, reasoning and tool calls; supports \"phrases\", prefix*, tool:<name>, role:<role>, and output:/message:/instruction:/reasoning:/args:/result: field scopes"`
+	Page     int    `json:"page,omitempty" jsonschema:"1-based page number"`
+	PageSize int    `json:"page_size,omitempty" jsonschema:"number of items per page, max 200"`
+	Provider string `json:"provider,omitempty" jsonschema:"optional provider filter"`
+	Model    string `json:"model,omitempty" jsonschema:"optional model substring filter"`
 }
 
 type getTraceInput struct {
@@ -42,10 +61,16 @@ type getTraceInput struct {
 	IncludeRaw bool   `json:"include_raw,omitempty" jsonschema:"include raw HTTP request and response bytes"`
 }
 
+type getAnnotationsInput struct {
+	TraceID   string `json:"trace_id,omitempty" jsonschema:"trace identifier; exactly one of trace_id or session_id is required"`
+	SessionID string `json:"session_id,omitempty" jsonschema:"session identifier; exactly one of trace_id or session_id is required"`
+}
+
 type listTraceFindingsInput struct {
 	TraceID  string `json:"trace_id" jsonschema:"trace identifier from list_traces"`
 	Severity string `json:"severity,omitempty" jsonschema:"optional severity filter"`
 	Category string `json:"category,omitempty" jsonschema:"optional category filter"`
+	Status   string `json:"status,omitempty" jsonschema:"optional triage status filter: open, acknowledged, false_positive, resolved, or active for open plus acknowledged"`
 }
 
 type queryDangerousToolCallsInput struct {
@@ -62,6 +87,9 @@ type listSessionsInput struct {
 	Provider string `json:"provider,omitempty" jsonschema:"optional provider filter"`
 	Model    string `json:"model,omitempty" jsonschema:"optional model substring filter"`
 	Query    string `json:"q,omitempty" jsonschema:"optional free-text query filter"`
+	Filter   string `json:"query,omitempty" jsonschema:"optional query-language filter, e.g. model:gpt-4* status:error latency>5s finding:loop* since:24h; terms are ANDed and a leading - negates"`
+	Saved    string `json:"saved,omitempty" jsonschema:"optional saved query name, combined with query"`
+	Tag      string `json:"tag,omitempty" jsonschema:"optional tag filter; comma-separated tags must all match, session tags apply to their traces"`
 }
 
 type listUpstreamsInput struct {
@@ -138,6 +166,50 @@ type getAnalysisJobInput struct {
 	JobID int64 `json:"job_id" jsonschema:"analysis job id from list_analysis_jobs"`
 }
 
+type listDatasetsInput struct {
+	DatasetID string `json:"dataset_id,omitempty" jsonschema:"optional dataset id; when set, return that dataset with its examples and snapshots"`
+}
+
+type appendDatasetExamplesInput struct {
+	DatasetID       string   `json:"dataset_id" jsonschema:"dataset id from list_datasets"`
+	TraceIDs        []string `json:"trace_ids,omitempty" jsonschema:"trace identifiers to append; exactly one source (trace_ids, session_id, trace_filter or finding_*) is required"`
+	SessionID       string   `json:"session_id,omitempty" jsonschema:"append every trace of this session"`
+	TraceFilter     string   `json:"trace_filter,omitempty" jsonschema:"append traces matching list filters in query-string form, for example model=gpt-4o&query=status>=500"`
+	FindingCategory string   `json:"finding_category,omitempty" jsonschema:"append traces with findings of this category"`
+	FindingSeverity string   `json:"finding_severity,omitempty" jsonschema:"append traces with findings of this severity"`
+	Limit           int      `json:"limit,omitempty" jsonschema:"maximum traces resolved from session, filter or finding sources, default 200, max 1000"`
+	Note            string   `json:"note,omitempty" jsonschema:"optional note stored on the new examples"`
+}
+
+type listExperimentsInput struct {
+	ExperimentID string `json:"experiment_id,omitempty" jsonschema:"optional experiment id; when set, return its full comparison report"`
+	Limit        int    `json:"limit,omitempty" jsonschema:"maximum experiments to list, default 20"`
+}
+
+type listAlertRulesInput struct {
+	RuleID string `json:"rule_id,omitempty" jsonschema:"optional alert rule id; when set, return that rule with a fresh evaluation of its current window"`
+}
+
+type saveAlertRuleInput struct {
+	ID          string  `json:"id" jsonschema:"rule id (letters, digits, _ . -); an existing rule with the same id is replaced"`
+	Name        string  `json:"name,omitempty" jsonschema:"optional display name, defaults to id"`
+	Description string  `json:"description,omitempty" jsonschema:"optional description"`
+	Metric      string  `json:"metric" jsonschema:"error_rate (0-1), ttft_p95_ms, latency_p95_ms, request_count, or total_tokens"`
+	Operator    string  `json:"operator,omitempty" jsonschema:"comparison against threshold: >, >=, <, <=; default >"`
+	Threshold   float64 `json:"threshold" jsonschema:"threshold value; error_rate uses a 0-1 ratio"`
+	Window      string  `json:"window,omitempty" jsonschema:"aggregation window as a Go duration such as 5m or 24h, default 5m"`
+	Model       string  `json:"model,omitempty" jsonschema:"optional model filter; * globs, case-insensitive"`
+	Channel     string  `json:"channel,omitempty" jsonschema:"optional upstream channel id filter; * globs, case-insensitive"`
+	Token       string  `json:"token,omitempty" jsonschema:"optional proxy token name filter; * globs, case-insensitive"`
+	MinRequests int     `json:"min_requests,omitempty" jsonschema:"minimum samples before error_rate or p95 rules can fire, default 1"`
+	Severity    string  `json:"severity,omitempty" jsonschema:"system event severity when firing: info, warning, error, or critical; default warning"`
+	Enabled     *bool   `json:"enabled,omitempty" jsonschema:"whether the rule is evaluated, default true"`
+}
+
+type deleteAlertRuleInput struct {
+	RuleID string `json:"rule_id" jsonschema:"alert rule id from list_alert_rules"`
+}
+
 type traceListOutput struct {
 	Items       []map[string]any `json:"items"`
 	Stats       map[string]any   `json:"stats"`
@@ -166,15 +238,16 @@ type upstreamListOutput struct {
 }
 
 type queryFailuresOutput struct {
-	Items       []map[string]any `json:"items"`
-	Page        int              `json:"page"`
-	PageSize    int              `json:"page_size"`
-	Scanned     int              `json:"scanned"`
-	Returned    int              `json:"returned"`
-	Provider    string           `json:"provider,omitempty"`
-	Model       string           `json:"model,omitempty"`
-	Query       string           `json:"q,omitempty"`
-	RefreshedAt time.Time        `json:"refreshed_at"`
+	Items         []map[string]any   `json:"items"`
+	AgentPatterns []agentPatternItem `json:"agent_patterns"`
+	Page          int                `json:"page"`
+	PageSize      int                `json:"page_size"`
+	Scanned       int                `json:"scanned"`
+	Returned      int                `json:"returned"`
+	Provider      string             `json:"provider,omitempty"`
+	Model         string             `json:"model,omitempty"`
+	Query         string             `json:"q,omitempty"`
+	RefreshedAt   time.Time          `json:"refreshed_at"`
 }
 
 type failureSummaryItem struct {
@@ -182,6 +255,17 @@ type failureSummaryItem struct {
 	Count int    `json:"count"`
 }
 
+// agentPatternItem 是 session 级检测器发现的循环或浪费模式，TraceID 指向证据所在的 trace。
+type agentPatternItem struct {
+	SessionID   string `json:"session_id"`
+	TraceID     string `json:"trace_id"`
+	FindingID   string `json:"finding_id"`
+	Category    string `json:"category"`
+	Severity    string `json:"severity"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+}
+
 type failureTraceItem struct {
 	TraceID            string    `json:"trace_id"`
 	SessionID          string    `json:"session_id,omitempty"`
@@ -196,21 +280,23 @@ type failureTraceItem struct {
 }
 
 type summarizeFailureClustersOutput struct {
-	Page        int                  `json:"page"`
-	PageSize    int                  `json:"page_size"`
-	Scanned     int                  `json:"scanned"`
-	Returned    int                  `json:"returned"`
-	Provider    string               `json:"provider,omitempty"`
-	Model       string               `json:"model,omitempty"`
-	Query       string               `json:"q,omitempty"`
-	ByReason    []failureSummaryItem `json:"by_reason"`
-	ByStatus    []failureSummaryItem `json:"by_status"`
-	ByModel     []failureSummaryItem `json:"by_model"`
-	ByProvider  []failureSummaryItem `json:"by_provider"`
-	ByEndpoint  []failureSummaryItem `json:"by_endpoint"`
-	ByUpstream  []failureSummaryItem `json:"by_upstream"`
-	TopFailures []failureTraceItem   `json:"top_failures"`
-	RefreshedAt time.Time            `json:"refreshed_at"`
+	Page           int                  `json:"page"`
+	PageSize       int                  `json:"page_size"`
+	Scanned        int                  `json:"scanned"`
+	Returned       int                  `json:"returned"`
+	Provider       string               `json:"provider,omitempty"`
+	Model          string               `json:"model,omitempty"`
+	Query          string               `json:"q,omitempty"`
+	ByReason       []failureSummaryItem `json:"by_reason"`
+	ByStatus       []failureSummaryItem `json:"by_status"`
+	ByModel        []failureSummaryItem `json:"by_model"`
+	ByProvider     []failureSummaryItem `json:"by_provider"`
+	ByEndpoint     []failureSummaryItem `json:"by_endpoint"`
+	ByUpstream     []failureSummaryItem `json:"by_upstream"`
+	ByAgentPattern []failureSummaryItem `json:"by_agent_pattern"`
+	TopFailures    []failureTraceItem   `json:"top_failures"`
+	AgentPatterns  []agentPatternItem   `json:"agent_patterns"`
+	RefreshedAt    time.Time            `json:"refreshed_at"`
 }
 
 type systemEventListOutput struct {
@@ -237,15 +323,19 @@ type systemEventSummaryOutput struct {
 }
 
 type serverAPI struct {
-	handler http.Handler
-	store   *store.Store
+	handler  http.Handler
+	store    *store.Store
+	analyzer *analyzer.Runner
 }
 
 func New(traceStore *store.Store, opts Options) *mcp.Server {
 	mux := http.NewServeMux()
-	monitor.RegisterRoutes(mux, traceStore, monitor.RouteOptions{Router: opts.Router})
+	if opts.Experiments == nil {
+		opts.Experiments = experiments.NewHandler(&experiments.Runner{Store: traceStore})
+	}
+	monitor.RegisterRoutes(mux, traceStore, monitor.RouteOptions{Router: opts.Router, Experiments: opts.Experiments, Analyzer: opts.Analyzer})
 
-	api := &serverAPI{handler: mux, store: traceStore}
+	api := &serverAPI{handler: mux, store: traceStore, analyzer: opts.Analyzer}
 	server := mcp.NewServer(&mcp.Implementation{
 		Name:    "llm-tracelab",
 		Version: "1.0.0",
@@ -255,13 +345,21 @@ func New(traceStore *store.Store, opts Options) *mcp.Server {
 		Name:        "list_traces",
 		Description: "List recorded traces with pagination and optional provider/model/query filters.",
 	}, api.listTraces)
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "search_traces",
+		Description: "Full-text search traces by prompt, output, reasoning, tool call name/arguments, and tool result content; each item carries a highlighted snippet.",
+	}, api.searchTraces)
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "get_trace",
 		Description: "Get one trace detail by trace_id, optionally including raw HTTP request and response bytes.",
 	}, api.getTrace)
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "get_annotations",
+		Description: "Get reviewer tags, threaded annotations and, for traces, human thumbs-up/down labels for one trace or session.",
+	}, api.getAnnotations)
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "list_trace_findings",
-		Description: "List deterministic audit findings for one trace, with optional severity and category filters.",
+		Description: "List deterministic audit findings for one trace, with optional severity, category and triage status filters.",
 	}, api.listTraceFindings)
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "query_dangerous_tool_calls",
@@ -269,7 +367,7 @@ func New(traceStore *store.Store, opts Options) *mcp.Server {
 	}, api.queryDangerousToolCalls)
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "query_sensitive_data_findings",
-		Description: "Return credential and sensitive-data findings for one trace.",
+		Description: "Return credential, sensitive-data and PII findings for one trace.",
 	}, api.querySensitiveDataFindings)
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "list_sessions",
@@ -281,11 +379,11 @@ func New(traceStore *store.Store, opts Options) *mcp.Server {
 	}, api.listUpstreams)
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "query_failures",
-		Description: "Return failed traces from a paginated trace scan using the same filters as list_traces.",
+		Description: "Return failed traces from a paginated trace scan using the same filters as list_traces, plus agent loop/waste patterns detected on their sessions.",
 	}, api.queryFailures)
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "summarize_failure_clusters",
-		Description: "Summarize clustered failures from a filtered trace scan by reason, status, model, provider, endpoint, upstream, and top failed traces.",
+		Description: "Summarize clustered failures from a filtered trace scan by reason, status, model, provider, endpoint, upstream, agent loop/waste pattern, and top failed traces.",
 	}, api.summarizeFailureClusters)
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "list_system_events",
@@ -319,6 +417,30 @@ func New(traceStore *store.Store, opts Options) *mcp.Server {
 		Name:        "get_analysis_job",
 		Description: "Get one reanalysis job by job_id.",
 	}, api.getAnalysisJob)
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "list_datasets",
+		Description: "List curated regression datasets, or get one dataset with its examples (expected output, tags, input overrides) and snapshot versions.",
+	}, api.listDatasets)
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "append_dataset_examples",
+		Description: "Append traces to a dataset by trace ids, session, trace list filter or finding filter; traces already in the dataset are skipped.",
+	}, api.appendDatasetExamples)
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "list_experiments",
+		Description: "List dataset experiments (candidate channel/model replays) with pass-rate deltas, or get one comparison report with latency, token, cost and per-example outcomes.",
+	}, api.listExperiments)
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "list_alert_rules",
+		Description: "List aggregate-metric alert rules with their current state (ok or firing) and last evaluated value, or get one rule with a fresh evaluation of its window.",
+	}, api.listAlertRules)
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "save_alert_rule",
+		Description: "Create or replace an alert rule such as error rate per channel, p95 TTFT per model, token budget per proxy token, or no-traffic; firing and resolved transitions are recorded as system events with category alert.",
+	}, api.saveAlertRule)
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "delete_alert_rule",
+		Description: "Delete an alert rule; a firing rule's system event is marked resolved.",
+	}, api.deleteAlertRule)
 
 	return server
 }
@@ -330,6 +452,28 @@ func (a *serverAPI) listTraces(ctx context.Context, req *mcp.CallToolRequest, in
 	setIfNotEmpty(values, "provider", in.Provider)
 	setIfNotEmpty(values, "model", in.Model)
 	setIfNotEmpty(values, "q", in.Query)
+	setIfNotEmpty(values, "query", in.Filter)
+	setIfNotEmpty(values, "saved", in.Saved)
+	setIfNotEmpty(values, "tag", in.Tag)
+
+	var out traceListOutput
+	if err := a.getJSON(ctx, "/api/traces", values, &out); err != nil {
+		return nil, nil, err
+	}
+	return nil, &out, nil
+}
+
+func (a *serverAPI) searchTraces(ctx context.Context, req *mcp.CallToolRequest, in *searchTracesInput) (*mcp.CallToolResult, *traceListOutput, error) {
+	query := strings.TrimSpace(in.Query)
+	if query == "" {
+		return nil, nil, fmt.Errorf("query is required")
+	}
+	values := url.Values{}
+	values.Set("page", fmt.Sprintf("%d", normalizePage(in.Page)))
+	values.Set("page_size", fmt.Sprintf("%d", normalizePageSize(in.PageSize)))
+	values.Set("search", query)
+	setIfNotEmpty(values, "provider", in.Provider)
+	setIfNotEmpty(values, "model", in.Model)
 
 	var out traceListOutput
 	if err := a.getJSON(ctx, "/api/traces", values, &out); err != nil {
@@ -358,8 +502,39 @@ func (a *serverAPI) getTrace(ctx context.Context, req *mcp.CallToolRequest, in *
 	return nil, out, nil
 }
 
+func (a *serverAPI) getAnnotations(ctx context.Context, req *mcp.CallToolRequest, in *getAnnotationsInput) (*mcp.CallToolResult, map[string]any, error) {
+	traceID, sessionID := strings.TrimSpace(in.TraceID), strings.TrimSpace(in.SessionID)
+	if (traceID == "") == (sessionID == "") {
+		return nil, nil, fmt.Errorf("exactly one of trace_id or session_id is required")
+	}
+	base := "/api/traces/" + url.PathEscape(traceID)
+	out := map[string]any{"trace_id": traceID}
+	if sessionID != "" {
+		base = "/api/sessions/" + url.PathEscape(sessionID)
+		out = map[string]any{"session_id": sessionID}
+	}
+
+	var tags, annotations map[string]any
+	if err := a.getJSON(ctx, base+"/tags", nil, &tags); err != nil {
+		return nil, nil, err
+	}
+	if err := a.getJSON(ctx, base+"/annotations", nil, &annotations); err != nil {
+		return nil, nil, err
+	}
+	out["tags"] = tags["items"]
+	out["annotations"] = annotations["items"]
+	if traceID != "" {
+		var labels map[string]any
+		if err := a.getJSON(ctx, base+"/label", nil, &labels); err != nil {
+			return nil, nil, err
+		}
+		out["labels"] = labels
+	}
+	return nil, out, nil
+}
+
 func (a *serverAPI) listTraceFindings(ctx context.Context, req *mcp.CallToolRequest, in *listTraceFindingsInput) (*mcp.CallToolResult, map[string]any, error) {
-	return a.traceFindings(ctx, in.TraceID, in.Severity, in.Category)
+	return a.traceFindings(ctx, in.TraceID, in.Severity, in.Category, in.Status)
 }
 
 func (a *serverAPI) queryDangerousToolCalls(ctx context.Context, req *mcp.CallToolRequest, in *queryDangerousToolCallsInput) (*mcp.CallToolResult, map[string]any, error) {
@@ -377,6 +552,12 @@ func (a *serverAPI) querySensitiveDataFindings(ctx context.Context, req *mcp.Cal
 	out, err := a.mergeTraceFindingCategories(ctx, in.TraceID, "", []string{
 		"credential_leak",
 		"sensitive_data",
+		"pii_email",
+		"pii_phone",
+		"pii_national_id",
+		"pii_credit_card",
+		"pii_iban",
+		"pii_ip_address",
 	})
 	return nil, out, err
 }
@@ -388,6 +569,9 @@ func (a *serverAPI) listSessions(ctx context.Context, req *mcp.CallToolRequest,
 	setIfNotEmpty(values, "provider", in.Provider)
 	setIfNotEmpty(values, "model", in.Model)
 	setIfNotEmpty(values, "q", in.Query)
+	setIfNotEmpty(values, "query", in.Filter)
+	setIfNotEmpty(values, "saved", in.Saved)
+	setIfNotEmpty(values, "tag", in.Tag)
 
 	var out sessionListOutput
 	if err := a.getJSON(ctx, "/api/sessions", values, &out); err != nil {
@@ -438,6 +622,11 @@ func (a *serverAPI) queryFailures(ctx context.Context, req *mcp.CallToolRequest,
 		}
 	}
 	out.Returned = len(out.Items)
+	patterns, err := a.sessionAgentPatterns(page.Items)
+	if err != nil {
+		return nil, nil, err
+	}
+	out.AgentPatterns = patterns
 	return nil, out, nil
 }
 
@@ -512,6 +701,19 @@ func (a *serverAPI) summarizeFailureClusters(ctx context.Context, req *mcp.CallT
 	out.ByProvider = toFailureSummaryItems(byProvider, limit)
 	out.ByEndpoint = toFailureSummaryItems(byEndpoint, limit)
 	out.ByUpstream = toFailureSummaryItems(byUpstream, limit)
+	patterns, err := a.sessionAgentPatterns(page.Items)
+	if err != nil {
+		return nil, nil, err
+	}
+	byAgentPattern := map[string]int{}
+	for _, pattern := range patterns {
+		incrementCount(byAgentPattern, pattern.Category)
+	}
+	out.ByAgentPattern = toFailureSummaryItems(byAgentPattern, limit)
+	if len(patterns) > limit {
+		patterns = patterns[:limit]
+	}
+	out.AgentPatterns = patterns
 	sort.Slice(out.TopFailures, func(i, j int) bool {
 		if out.TopFailures[i].Reason != out.TopFailures[j].Reason {
 			return out.TopFailures[i].Reason < out.TopFailures[j].Reason
@@ -527,6 +729,41 @@ func (a *serverAPI) summarizeFailureClusters(ctx context.Context, req *mcp.CallT
 	return nil, out, nil
 }
 
+// sessionAgentPatterns 收集扫描到的 trace 所属 session 上的 session 级 finding，按严重度排序。
+func (a *serverAPI) sessionAgentPatterns(items []map[string]any) ([]agentPatternItem, error) {
+	if err := a.requireStoreSync(); err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	out := []agentPatternItem{}
+	for _, item := range items {
+		sessionID, _ := item["session_id"].(string)
+		if strings.TrimSpace(sessionID) == "" || seen[sessionID] {
+			continue
+		}
+		seen[sessionID] = true
+		findings, err := a.store.ListSessionFindings(sessionID, store.FindingFilter{})
+		if err != nil {
+			return nil, err
+		}
+		for _, finding := range findings {
+			out = append(out, agentPatternItem{
+				SessionID:   sessionID,
+				TraceID:     finding.TraceID,
+				FindingID:   finding.ID,
+				Category:    finding.Category,
+				Severity:    string(finding.Severity),
+				Title:       finding.Title,
+				Description: finding.Description,
+			})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return findingSeverityRank(out[i].Severity) > findingSeverityRank(out[j].Severity)
+	})
+	return out, nil
+}
+
 func (a *serverAPI) listSystemEvents(ctx context.Context, req *mcp.CallToolRequest, in *listSystemEventsInput) (*mcp.CallToolResult, *systemEventListOutput, error) {
 	values := systemEventValues(in.Page, in.PageSize, in.Status, in.Severity, in.Source, in.Category, in.Query, in.Window)
 	var out systemEventListOutput
@@ -619,7 +856,7 @@ func (a *serverAPI) reanalyzeTrace(ctx context.Context, req *mcp.CallToolRequest
 		return nil, nil, err
 	}
 	reparse, scan := defaultReanalysisSteps(in.Reparse, in.Scan)
-	svc := reanalysis.New(a.store, reanalysis.Options{})
+	svc := reanalysis.New(a.store, reanalysis.Options{Runner: a.analyzer})
 	if in.Async {
 		if in.RepairUsage {
 			job, err := svc.EnqueueTraceRepairUsage(traceID)
@@ -668,7 +905,7 @@ func (a *serverAPI) reanalyzeSession(ctx context.Context, req *mcp.CallToolReque
 		return nil, nil, err
 	}
 	reparse, scan := defaultReanalysisSteps(in.Reparse, in.Scan)
-	svc := reanalysis.New(a.store, reanalysis.Options{})
+	svc := reanalysis.New(a.store, reanalysis.Options{Runner: a.analyzer})
 	if in.Async {
 		job, err := svc.EnqueueSessionReanalyze(sessionID, reanalysis.SessionOptions{Reparse: reparse, Scan: scan})
 		if err != nil {
@@ -716,7 +953,100 @@ func (a *serverAPI) getAnalysisJob(ctx context.Context, req *mcp.CallToolRequest
 	return nil, analysisJobMap(job), nil
 }
 
-func (a *serverAPI) traceFindings(ctx context.Context, traceID string, severity string, category string) (*mcp.CallToolResult, map[string]any, error) {
+func (a *serverAPI) listDatasets(ctx context.Context, req *mcp.CallToolRequest, in *listDatasetsInput) (*mcp.CallToolResult, map[string]any, error) {
+	path := "/api/datasets"
+	if datasetID := strings.TrimSpace(in.DatasetID); datasetID != "" {
+		path += "/" + url.PathEscape(datasetID)
+	}
+	var out map[string]any
+	if err := a.getJSON(ctx, path, nil, &out); err != nil {
+		return nil, nil, err
+	}
+	return nil, out, nil
+}
+
+func (a *serverAPI) appendDatasetExamples(ctx context.Context, req *mcp.CallToolRequest, in *appendDatasetExamplesInput) (*mcp.CallToolResult, map[string]any, error) {
+	datasetID := strings.TrimSpace(in.DatasetID)
+	if datasetID == "" {
+		return nil, nil, fmt.Errorf("dataset_id is required")
+	}
+	body := map[string]any{"limit": in.Limit, "note": in.Note}
+	if len(in.TraceIDs) > 0 {
+		body["trace_ids"] = in.TraceIDs
+	}
+	if in.SessionID != "" {
+		body["session_id"] = in.SessionID
+	}
+	if in.TraceFilter != "" {
+		body["trace_filter"] = in.TraceFilter
+	}
+	if in.FindingCategory != "" || in.FindingSeverity != "" {
+		body["finding"] = map[string]string{"category": in.FindingCategory, "severity": in.FindingSeverity}
+	}
+	var out map[string]any
+	if err := a.postJSON(ctx, "/api/datasets/"+url.PathEscape(datasetID)+"/examples", body, &out); err != nil {
+		return nil, nil, err
+	}
+	return nil, out, nil
+}
+
+func (a *serverAPI) listExperiments(ctx context.Context, req *mcp.CallToolRequest, in *listExperimentsInput) (*mcp.CallToolResult, map[string]any, error) {
+	path := "/api/experiments"
+	values := url.Values{}
+	if experimentID := strings.TrimSpace(in.ExperimentID); experimentID != "" {
+		path += "/" + url.PathEscape(experimentID)
+	} else if in.Limit > 0 {
+		values.Set("limit", fmt.Sprintf("%d", in.Limit))
+	}
+	var out map[string]any
+	if err := a.getJSON(ctx, path, values, &out); err != nil {
+		return nil, nil, err
+	}
+	return nil, out, nil
+}
+
+func (a *serverAPI) listAlertRules(ctx context.Context, req *mcp.CallToolRequest, in *listAlertRulesInput) (*mcp.CallToolResult, map[string]any, error) {
+	ruleID := strings.TrimSpace(in.RuleID)
+	if ruleID == "" {
+		var out map[string]any
+		if err := a.getJSON(ctx, "/api/alerts", nil, &out); err != nil {
+			return nil, nil, err
+		}
+		return nil, out, nil
+	}
+	var rule, evaluation map[string]any
+	if err := a.getJSON(ctx, "/api/alerts/"+url.PathEscape(ruleID), nil, &rule); err != nil {
+		return nil, nil, err
+	}
+	if err := a.postJSON(ctx, "/api/alerts/"+url.PathEscape(ruleID)+"/evaluate", map[string]any{}, &evaluation); err != nil {
+		return nil, nil, err
+	}
+	rule["evaluation"] = evaluation
+	return nil, rule, nil
+}
+
+func (a *serverAPI) saveAlertRule(ctx context.Context, req *mcp.CallToolRequest, in *saveAlertRuleInput) (*mcp.CallToolResult, map[string]any, error) {
+	var out map[string]any
+	if err := a.postJSON(ctx, "/api/alerts", in, &out); err != nil {
+		return nil, nil, err
+	}
+	return nil, out, nil
+}
+
+func (a *serverAPI) deleteAlertRule(ctx context.Context, req *mcp.CallToolRequest, in *deleteAlertRuleInput) (*mcp.CallToolResult, map[string]any, error) {
+	ruleID := strings.TrimSpace(in.RuleID)
+	if ruleID == "" {
+		return nil, nil, fmt.Errorf("rule_id is required")
+	}
+	var out map[string]any
+	httpReq := httptest.NewRequest(http.MethodDelete, "/api/alerts/"+url.PathEscape(ruleID), nil).WithContext(ctx)
+	if err := a.serveJSON(httpReq, &out); err != nil {
+		return nil, nil, err
+	}
+	return nil, map[string]any{"rule_id": ruleID, "deleted": true}, nil
+}
+
+func (a *serverAPI) traceFindings(ctx context.Context, traceID string, severity string, category string, status string) (*mcp.CallToolResult, map[string]any, error) {
 	traceID = strings.TrimSpace(traceID)
 	if traceID == "" {
 		return nil, nil, fmt.Errorf("trace_id is required")
@@ -724,6 +1054,7 @@ func (a *serverAPI) traceFindings(ctx context.Context, traceID string, severity
 	values := url.Values{}
 	setIfNotEmpty(values, "severity", severity)
 	setIfNotEmpty(values, "category", category)
+	setIfNotEmpty(values, "status", status)
 
 	var out map[string]any
 	if err := a.getJSON(ctx, "/api/traces/"+url.PathEscape(traceID)+"/findings", values, &out); err != nil {
@@ -744,7 +1075,8 @@ func (a *serverAPI) mergeTraceFindingCategories(ctx context.Context, traceID str
 	}
 	var merged []any
 	for _, category := range categories {
-		_, payload, err := a.traceFindings(ctx, traceID, severity, category)
+		// 已标记为误报或已解决的 finding 不再返回给 agent。
+		_, payload, err := a.traceFindings(ctx, traceID, severity, category, store.FindingStatusActive)
 		if err != nil {
 			return nil, err
 		}
@@ -761,8 +1093,20 @@ func (a *serverAPI) getJSON(ctx context.Context, path string, query url.Values,
 	if encoded := query.Encode(); encoded != "" {
 		target += "?" + encoded
 	}
+	return a.serveJSON(httptest.NewRequest(http.MethodGet, target, nil).WithContext(ctx), out)
+}
+
+func (a *serverAPI) postJSON(ctx context.Context, path string, body any, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(payload)).WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	return a.serveJSON(req, out)
+}
 
-	req := httptest.NewRequest(http.MethodGet, target, nil).WithContext(ctx)
+func (a *serverAPI) serveJSON(req *http.Request, out interface{}) error {
 	rr := httptest.NewRecorder()
 	a.handler.ServeHTTP(rr, req)
 
@@ -977,6 +1321,23 @@ func severityRank(severity string) int {
 	}
 }
 
+func findingSeverityRank(severity string) int {
+	switch observe.Severity(strings.ToLower(strings.TrimSpace(severity))) {
+	case observe.SeverityCritical:
+		return 5
+	case observe.SeverityHigh:
+		return 4
+	case observe.SeverityMedium:
+		return 3
+	case observe.SeverityLow:
+		return 2
+	case observe.SeverityInfo:
+		return 1
+	default:
+		return 0
+	}
+}
+
 func firstPositive(values ...int) int {
 	for _, value := range values {
 		if value > 0 {
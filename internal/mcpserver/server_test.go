@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kingfs/llm-tracelab/internal/evals"
 	"github.com/kingfs/llm-tracelab/internal/store"
 	"github.com/kingfs/llm-tracelab/pkg/observe"
 	"github.com/kingfs/llm-tracelab/pkg/recordfile"
@@ -149,8 +150,8 @@ func TestServerListsAndQueriesReadOnlyTools(t *testing.T) {
 	if err != nil {
 		t.Fatalf("ListTools() error = %v", err)
 	}
-	if len(tools.Tools) != 17 {
-		t.Fatalf("len(tools.Tools) = %d, want 17", len(tools.Tools))
+	if len(tools.Tools) != 25 {
+		t.Fatalf("len(tools.Tools) = %d, want 25", len(tools.Tools))
 	}
 
 	traceList, err := session.CallTool(context.Background(), &mcp.CallToolParams{
@@ -173,6 +174,78 @@ func TestServerListsAndQueriesReadOnlyTools(t *testing.T) {
 		t.Fatalf("trace id missing from list_traces")
 	}
 
+	filteredList, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "list_traces",
+		Arguments: map[string]any{"query": "finding:dangerous*"},
+	})
+	if err != nil {
+		t.Fatalf("CallTool(list_traces query) error = %v", err)
+	}
+	if filteredList.IsError {
+		t.Fatalf("list_traces query returned IsError")
+	}
+	filteredItems := filteredList.StructuredContent.(map[string]any)["items"].([]any)
+	if len(filteredItems) != 1 || filteredItems[0].(map[string]any)["id"] != failureEntry.ID {
+		t.Fatalf("list_traces query items = %v, want only %q", filteredItems, failureEntry.ID)
+	}
+
+	if _, err := st.AddTags(store.AnnotationTargetTrace, failureEntry.ID, []string{"regression"}, "alice"); err != nil {
+		t.Fatalf("AddTags() error = %v", err)
+	}
+	if _, err := st.AddAnnotation(store.AnnotationRecord{TargetType: store.AnnotationTargetTrace, TargetID: failureEntry.ID, Author: "alice", Body: "shell call should be blocked"}); err != nil {
+		t.Fatalf("AddAnnotation() error = %v", err)
+	}
+	taggedList, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "list_traces",
+		Arguments: map[string]any{"tag": "regression"},
+	})
+	if err != nil || taggedList.IsError {
+		t.Fatalf("CallTool(list_traces tag) error = %v", err)
+	}
+	if taggedItems := taggedList.StructuredContent.(map[string]any)["items"].([]any); len(taggedItems) != 1 {
+		t.Fatalf("list_traces tag items = %v, want one", taggedItems)
+	}
+	annotations, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "get_annotations",
+		Arguments: map[string]any{"trace_id": failureEntry.ID},
+	})
+	if err != nil || annotations.IsError {
+		t.Fatalf("CallTool(get_annotations) error = %v", err)
+	}
+	annotationPayload := annotations.StructuredContent.(map[string]any)
+	if len(annotationPayload["tags"].([]any)) != 1 || len(annotationPayload["annotations"].([]any)) != 1 {
+		t.Fatalf("get_annotations payload = %v", annotationPayload)
+	}
+
+	if err := st.SaveObservation(observe.TraceObservation{
+		TraceID: failureEntry.ID,
+		Parser:  "openai",
+		Status:  observe.ParseStatusParsed,
+		Tools: observe.ObservationTools{
+			Calls: []observe.ToolCallObservation{{ID: "call_1", Name: "delete_branch", ArgsText: `{"branch":"main"}`}},
+		},
+	}); err != nil {
+		t.Fatalf("SaveObservation() error = %v", err)
+	}
+	searchResult, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "search_traces",
+		Arguments: map[string]any{"query": "tool:delete_branch"},
+	})
+	if err != nil {
+		t.Fatalf("CallTool(search_traces) error = %v", err)
+	}
+	searchItems := searchResult.StructuredContent.(map[string]any)["items"].([]any)
+	if len(searchItems) != 1 {
+		t.Fatalf("len(search_traces.items) = %d, want 1", len(searchItems))
+	}
+	searchItem := searchItems[0].(map[string]any)
+	if searchItem["id"] != failureEntry.ID {
+		t.Fatalf("search_traces.items[0].id = %v, want %q", searchItem["id"], failureEntry.ID)
+	}
+	if snippet, _ := searchItem["search"].(map[string]any)["snippet"].(string); !strings.Contains(snippet, "<mark>delete_branch</mark>") {
+		t.Fatalf("search_traces snippet = %q", snippet)
+	}
+
 	traceDetail, err := session.CallTool(context.Background(), &mcp.CallToolParams{
 		Name:      "get_trace",
 		Arguments: map[string]any{"trace_id": traceID, "include_raw": true},
@@ -233,6 +306,17 @@ func TestServerListsAndQueriesReadOnlyTools(t *testing.T) {
 	if len(sessionItems) != 1 {
 		t.Fatalf("len(list_sessions.items) = %d, want 1", len(sessionItems))
 	}
+	if err := st.SaveSessionFindings(failureEntry.SessionID, []observe.Finding{{
+		ID:              "finding-loop",
+		TraceID:         failureEntry.ID,
+		Category:        "agent_repeated_tool_call",
+		Severity:        observe.SeverityMedium,
+		Title:           "Repeated identical tool call",
+		Detector:        store.SessionFindingDetectorPrefix + "agent_patterns",
+		DetectorVersion: "0.1.0",
+	}}); err != nil {
+		t.Fatalf("SaveSessionFindings() error = %v", err)
+	}
 
 	upstreams, err := session.CallTool(context.Background(), &mcp.CallToolParams{
 		Name:      "list_upstreams",
@@ -257,6 +341,9 @@ func TestServerListsAndQueriesReadOnlyTools(t *testing.T) {
 	if got := int(failurePayload["returned"].(float64)); got != 1 {
 		t.Fatalf("query_failures.returned = %d, want 1", got)
 	}
+	if patterns := failurePayload["agent_patterns"].([]any); len(patterns) != 1 || patterns[0].(map[string]any)["category"] != "agent_repeated_tool_call" {
+		t.Fatalf("query_failures.agent_patterns = %+v", patterns)
+	}
 
 	failureClusters, err := session.CallTool(context.Background(), &mcp.CallToolParams{
 		Name:      "summarize_failure_clusters",
@@ -272,6 +359,9 @@ func TestServerListsAndQueriesReadOnlyTools(t *testing.T) {
 	if got := len(failureClustersPayload["top_failures"].([]any)); got != 1 {
 		t.Fatalf("len(summarize_failure_clusters.top_failures) = %d, want 1", got)
 	}
+	if got := failureClustersPayload["by_agent_pattern"].([]any); len(got) != 1 || got[0].(map[string]any)["label"] != "agent_repeated_tool_call" {
+		t.Fatalf("summarize_failure_clusters.by_agent_pattern = %+v", got)
+	}
 
 	systemEvents, err := session.CallTool(context.Background(), &mcp.CallToolParams{
 		Name:      "list_system_events",
@@ -384,6 +474,100 @@ func TestServerListsAndQueriesReadOnlyTools(t *testing.T) {
 	if got := analysisJob.StructuredContent.(map[string]any)["job_type"].(string); got != "session_reanalyze" {
 		t.Fatalf("get_analysis_job.job_type = %q, want session_reanalyze", got)
 	}
+
+	dataset, err := st.CreateDataset("mcp-regressions", "")
+	if err != nil {
+		t.Fatalf("CreateDataset() error = %v", err)
+	}
+	appended, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "append_dataset_examples",
+		Arguments: map[string]any{"dataset_id": dataset.ID, "trace_ids": []string{failureEntry.ID, failureEntry.ID}, "note": "from mcp"},
+	})
+	if err != nil || appended.IsError {
+		t.Fatalf("CallTool(append_dataset_examples) error = %v, result = %+v", err, appended)
+	}
+	if got := appended.StructuredContent.(map[string]any)["added"]; got != float64(1) {
+		t.Fatalf("append_dataset_examples.added = %v, want 1", got)
+	}
+	datasets, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "list_datasets",
+		Arguments: map[string]any{"dataset_id": dataset.ID},
+	})
+	if err != nil || datasets.IsError {
+		t.Fatalf("CallTool(list_datasets) error = %v", err)
+	}
+	examples := datasets.StructuredContent.(map[string]any)["examples"].([]any)
+	if len(examples) != 1 || examples[0].(map[string]any)["trace_id"] != failureEntry.ID {
+		t.Fatalf("list_datasets examples = %v, want only %q", examples, failureEntry.ID)
+	}
+
+	baselineRun, err := evals.ScoreTraces(st, []store.LogEntry{failureEntry}, evals.RunOptions{DatasetID: dataset.ID, SourceType: "dataset"})
+	if err != nil {
+		t.Fatalf("ScoreTraces() error = %v", err)
+	}
+	experiment, err := st.CreateExperimentRun(store.ExperimentRunRecord{Name: "mcp experiment", BaselineEvalRunID: baselineRun.ID, CandidateEvalRunID: baselineRun.ID})
+	if err != nil {
+		t.Fatalf("CreateExperimentRun() error = %v", err)
+	}
+	experimentList, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "list_experiments",
+		Arguments: map[string]any{},
+	})
+	if err != nil || experimentList.IsError {
+		t.Fatalf("CallTool(list_experiments) error = %v, result = %+v", err, experimentList)
+	}
+	if items := experimentList.StructuredContent.(map[string]any)["items"].([]any); len(items) != 1 || items[0].(map[string]any)["dataset_id"] != dataset.ID {
+		t.Fatalf("list_experiments items = %v", items)
+	}
+	report, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "list_experiments",
+		Arguments: map[string]any{"experiment_id": experiment.ID},
+	})
+	if err != nil || report.IsError {
+		t.Fatalf("CallTool(list_experiments report) error = %v, result = %+v", err, report)
+	}
+	reportExamples := report.StructuredContent.(map[string]any)["examples"].([]any)
+	if len(reportExamples) != 1 || reportExamples[0].(map[string]any)["outcome"] != "missing" {
+		t.Fatalf("list_experiments report examples = %v", reportExamples)
+	}
+
+	saved, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "save_alert_rule",
+		Arguments: map[string]any{"id": "quiet", "metric": "request_count", "operator": "<", "threshold": 1, "window": "30m"},
+	})
+	if err != nil || saved.IsError {
+		t.Fatalf("CallTool(save_alert_rule) error = %v, result = %+v", err, saved)
+	}
+	if got := saved.StructuredContent.(map[string]any)["window_seconds"]; got != float64(1800) {
+		t.Fatalf("save_alert_rule window_seconds = %v, want 1800", got)
+	}
+	invalid, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "save_alert_rule",
+		Arguments: map[string]any{"id": "bad", "metric": "cost", "threshold": 1},
+	})
+	if err != nil || !invalid.IsError {
+		t.Fatalf("CallTool(save_alert_rule invalid) error = %v, IsError = %v, want tool error", err, invalid != nil && invalid.IsError)
+	}
+	alertRule, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "list_alert_rules",
+		Arguments: map[string]any{"rule_id": "quiet"},
+	})
+	if err != nil || alertRule.IsError {
+		t.Fatalf("CallTool(list_alert_rules) error = %v, result = %+v", err, alertRule)
+	}
+	if evaluation := alertRule.StructuredContent.(map[string]any)["evaluation"].(map[string]any); evaluation["breached"] != true {
+		t.Fatalf("list_alert_rules evaluation = %v, want breached for no traffic", evaluation)
+	}
+	deleted, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "delete_alert_rule",
+		Arguments: map[string]any{"rule_id": "quiet"},
+	})
+	if err != nil || deleted.IsError {
+		t.Fatalf("CallTool(delete_alert_rule) error = %v, result = %+v", err, deleted)
+	}
+	if rules, err := st.ListAlertRules(); err != nil || len(rules) != 0 {
+		t.Fatalf("ListAlertRules() after delete = %v, %v", rules, err)
+	}
 }
 
 func connectClient(ctx context.Context, server *mcp.Server) (*mcp.ClientSession, error) {
@@ -0,0 +1,292 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/recorder"
+	"github.com/kingfs/llm-tracelab/internal/router"
+	"github.com/kingfs/llm-tracelab/internal/store"
+)
+
+const namespace = "llm_tracelab_"
+
+var (
+	durationBuckets = []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120}
+	ttftBuckets     = []float64{0.05, 0.1, 0.25, 0.5, 1, 2, 5, 10}
+	tokenTypes      = []string{"prompt", "completion", "cached", "reasoning"}
+	healthStates    = []string{router.HealthHealthy, router.HealthDegraded, router.HealthOpen}
+)
+
+// requestLabels 是请求类指标共用的标签组合。
+type requestLabels struct {
+	Model       string
+	Provider    string
+	Channel     string
+	StatusClass string
+	Token       string
+}
+
+type requestSeries struct {
+	count    uint64
+	duration histogram
+	ttft     histogram
+	tokens   [4]uint64
+}
+
+type histogram struct {
+	buckets []uint64
+	count   uint64
+	sum     float64
+}
+
+func (h *histogram) observe(bounds []float64, value float64) {
+	if h.buckets == nil {
+		h.buckets = make([]uint64, len(bounds))
+	}
+	for i, bound := range bounds {
+		if value <= bound {
+			h.buckets[i]++
+		}
+	}
+	h.count++
+	h.sum += value
+}
+
+// Requests 在内存中累计每次代理调用的请求数、延迟 / TTFT 直方图与 token 数，进程重启后清零。
+type Requests struct {
+	mu     sync.Mutex
+	series map[requestLabels]*requestSeries
+}
+
+func NewRequests() *Requests {
+	return &Requests{series: map[requestLabels]*requestSeries{}}
+}
+
+// ObserveRecord 实现 recorder.RecordObserver，在 cassette 落盘后计入指标。
+func (r *Requests) ObserveRecord(info *recorder.LogInfo) {
+	if r == nil || info == nil {
+		return
+	}
+	meta := info.Header.Meta
+	labels := requestLabels{
+		Model:       meta.Model,
+		Provider:    meta.Provider,
+		Channel:     meta.SelectedUpstreamID,
+		StatusClass: statusClass(meta.StatusCode),
+		Token:       meta.TokenName,
+	}
+	usage := info.Header.Usage
+	tokens := [4]uint64{uint64(max(usage.PromptTokens, 0)), uint64(max(usage.CompletionTokens, 0))}
+	if usage.PromptTokenDetails != nil {
+		tokens[2] = uint64(max(usage.PromptTokenDetails.CachedTokens, 0))
+	}
+	if usage.CompletionTokenDetails != nil {
+		tokens[3] = uint64(max(usage.CompletionTokenDetails.ReasoningTokens, 0))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	series := r.series[labels]
+	if series == nil {
+		series = &requestSeries{}
+		r.series[labels] = series
+	}
+	series.count++
+	series.duration.observe(durationBuckets, float64(meta.DurationMs)/1000)
+	if meta.TTFTMs > 0 {
+		series.ttft.observe(ttftBuckets, float64(meta.TTFTMs)/1000)
+	}
+	for i, n := range tokens {
+		series.tokens[i] += n
+	}
+}
+
+func statusClass(code int) string {
+	if code < 100 || code > 599 {
+		return "error"
+	}
+	return strconv.Itoa(code/100) + "xx"
+}
+
+// Options 指定 /metrics 的数据来源，任一为 nil 时跳过对应指标。
+type Options struct {
+	Requests *Requests
+	Store    *store.Store
+	Router   *router.Router
+}
+
+// Handler 以 Prometheus 文本格式输出请求、路由、任务队列与系统事件指标。
+func Handler(opts Options) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		out := &writer{w: bufio.NewWriter(w)}
+		opts.Requests.write(out)
+		writeRouter(out, opts.Router, time.Now())
+		writeStore(out, opts.Store)
+		if err := out.w.Flush(); err != nil {
+			slog.Warn("Write metrics failed", "error", err)
+		}
+	})
+}
+
+func (r *Requests) write(out *writer) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	keys := make([]requestLabels, 0, len(r.series))
+	snapshot := make(map[requestLabels]requestSeries, len(r.series))
+	for key, series := range r.series {
+		keys = append(keys, key)
+		copied := *series
+		copied.duration.buckets = append([]uint64(nil), series.duration.buckets...)
+		copied.ttft.buckets = append([]uint64(nil), series.ttft.buckets...)
+		snapshot[key] = copied
+	}
+	r.mu.Unlock()
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		return strings.Join([]string{a.Model, a.Provider, a.Channel, a.StatusClass, a.Token}, "\x00") <
+			strings.Join([]string{b.Model, b.Provider, b.Channel, b.StatusClass, b.Token}, "\x00")
+	})
+
+	out.family("requests_total", "counter", "Proxied LLM requests by model, provider, channel, status class and token.")
+	for _, key := range keys {
+		out.sample("requests_total", key.pairs(), float64(snapshot[key].count))
+	}
+	out.family("request_duration_seconds", "histogram", "End-to-end proxied request latency in seconds.")
+	for _, key := range keys {
+		out.histogram("request_duration_seconds", key.pairs(), durationBuckets, snapshot[key].duration)
+	}
+	out.family("ttft_seconds", "histogram", "Time to first token in seconds, recorded when the upstream reported a first token.")
+	for _, key := range keys {
+		if snapshot[key].ttft.count > 0 {
+			out.histogram("ttft_seconds", key.pairs(), ttftBuckets, snapshot[key].ttft)
+		}
+	}
+	out.family("tokens_total", "counter", "Tokens reported by upstream usage, by type (prompt, completion, cached, reasoning).")
+	for _, key := range keys {
+		series := snapshot[key]
+		for i, tokenType := range tokenTypes {
+			if series.tokens[i] == 0 && i >= 2 {
+				continue
+			}
+			out.sample("tokens_total", append(key.pairs(), [2]string{"type", tokenType}), float64(series.tokens[i]))
+		}
+	}
+}
+
+func (l requestLabels) pairs() [][2]string {
+	return [][2]string{
+		{"model", l.Model},
+		{"provider", l.Provider},
+		{"channel", l.Channel},
+		{"status_class", l.StatusClass},
+		{"token", l.Token},
+	}
+}
+
+func writeRouter(out *writer, rtr *router.Router, now time.Time) {
+	if rtr == nil {
+		return
+	}
+	snapshots := rtr.Snapshots()
+	out.family("upstream_inflight", "gauge", "In-flight requests per upstream channel.")
+	for _, snapshot := range snapshots {
+		out.sample("upstream_inflight", [][2]string{{"channel", snapshot.ID}}, float64(snapshot.Inflight))
+	}
+	out.family("upstream_health_state", "gauge", "Current router health state per upstream channel (1 for the active state).")
+	for _, snapshot := range snapshots {
+		for _, state := range healthStates {
+			value := 0.0
+			if snapshot.HealthState == state {
+				value = 1
+			}
+			out.sample("upstream_health_state", [][2]string{{"channel", snapshot.ID}, {"state", state}}, value)
+		}
+	}
+	out.family("upstream_circuit_open", "gauge", "Whether the router circuit breaker is open for the upstream channel.")
+	for _, snapshot := range snapshots {
+		value := 0.0
+		if snapshot.OpenUntil.After(now) {
+			value = 1
+		}
+		out.sample("upstream_circuit_open", [][2]string{{"channel", snapshot.ID}}, value)
+	}
+}
+
+func writeStore(out *writer, st *store.Store) {
+	if st == nil {
+		return
+	}
+	if depths, err := st.JobQueueDepths(); err != nil {
+		slog.Warn("Collect job queue metrics failed", "error", err)
+	} else {
+		out.family("jobs", "gauge", "Background parse and analysis jobs by queue and status.")
+		for _, depth := range depths {
+			out.sample("jobs", [][2]string{{"queue", depth.Queue}, {"status", depth.Status}}, float64(depth.Count))
+		}
+	}
+	if counts, err := st.SystemEventSeverityCounts(); err != nil {
+		slog.Warn("Collect system event metrics failed", "error", err)
+	} else {
+		out.family("system_events", "gauge", "Recorded system events by severity.")
+		for _, item := range counts {
+			out.sample("system_events", [][2]string{{"severity", item.Label}}, float64(item.Count))
+		}
+	}
+}
+
+// writer 按 Prometheus 文本格式 0.0.4 输出指标。
+type writer struct {
+	w *bufio.Writer
+}
+
+func (o *writer) family(name string, kind string, help string) {
+	fmt.Fprintf(o.w, "# HELP %s%s %s\n# TYPE %s%s %s\n", namespace, name, help, namespace, name, kind)
+}
+
+func (o *writer) sample(name string, labels [][2]string, value float64) {
+	o.w.WriteString(namespace + name)
+	if len(labels) > 0 {
+		o.w.WriteByte('{')
+		for i, pair := range labels {
+			if i > 0 {
+				o.w.WriteByte(',')
+			}
+			o.w.WriteString(pair[0] + `="` + escapeLabel(pair[1]) + `"`)
+		}
+		o.w.WriteByte('}')
+	}
+	o.w.WriteString(" " + strconv.FormatFloat(value, 'g', -1, 64) + "\n")
+}
+
+func (o *writer) histogram(name string, labels [][2]string, bounds []float64, h histogram) {
+	for i, bound := range bounds {
+		var n uint64
+		if i < len(h.buckets) {
+			n = h.buckets[i]
+		}
+		o.sample(name+"_bucket", append(append([][2]string{}, labels...), [2]string{"le", strconv.FormatFloat(bound, 'g', -1, 64)}), float64(n))
+	}
+	o.sample(name+"_bucket", append(append([][2]string{}, labels...), [2]string{"le", "+Inf"}), float64(h.count))
+	o.sample(name+"_sum", labels, h.sum)
+	o.sample(name+"_count", labels, float64(h.count))
+}
+
+func escapeLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
@@ -0,0 +1,124 @@
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/recorder"
+	"github.com/kingfs/llm-tracelab/internal/router"
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/pkg/recordfile"
+)
+
+func TestHandlerExposesRequestRouterQueueAndEventMetrics(t *testing.T) {
+	st, err := store.New(t.TempDir())
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+	if err := st.EnqueueParseJob("trace-1"); err != nil {
+		t.Fatalf("EnqueueParseJob() error = %v", err)
+	}
+	if _, err := st.UpsertSystemEvent(store.SystemEvent{
+		Fingerprint: "upstream:primary:timeout",
+		Source:      "upstream",
+		Category:    "timeout",
+		Severity:    "error",
+		Title:       "Upstream timeout",
+	}); err != nil {
+		t.Fatalf("UpsertSystemEvent() error = %v", err)
+	}
+
+	cfg := &config.Config{Upstreams: []config.UpstreamTargetConfig{{
+		ID:             "primary",
+		ModelDiscovery: router.ModelDiscoveryStaticOnly,
+		StaticModels:   []string{"gpt-4o-mini"},
+		Upstream:       config.UpstreamConfig{BaseURL: "https://api.openai.com/v1", ProviderPreset: "openai"},
+	}}}
+	rtr, err := router.New(cfg, nil)
+	if err != nil {
+		t.Fatalf("router.New() error = %v", err)
+	}
+	if err := rtr.Initialize(); err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+
+	requests := NewRequests()
+	record := func(status int, durationMs int64, ttftMs int64, usage recordfile.UsageInfo) {
+		requests.ObserveRecord(&recorder.LogInfo{
+			Header: recordfile.RecordHeader{
+				Meta: recordfile.MetaData{
+					Model:              "gpt-4o-mini",
+					Provider:           "openai_compatible",
+					SelectedUpstreamID: "primary",
+					TokenName:          "ci",
+					StatusCode:         status,
+					DurationMs:         durationMs,
+					TTFTMs:             ttftMs,
+				},
+				Usage: usage,
+			},
+		})
+	}
+	record(http.StatusOK, 800, 120, recordfile.UsageInfo{
+		PromptTokens:           100,
+		CompletionTokens:       20,
+		PromptTokenDetails:     &recordfile.PromptTokenDetails{CachedTokens: 64},
+		CompletionTokenDetails: &recordfile.CompletionTokenDetails{ReasoningTokens: 8},
+	})
+	record(http.StatusOK, 3000, 0, recordfile.UsageInfo{PromptTokens: 50, CompletionTokens: 5})
+	record(http.StatusTooManyRequests, 40, 0, recordfile.UsageInfo{})
+
+	server := httptest.NewServer(Handler(Options{Requests: requests, Store: st, Router: rtr}))
+	defer server.Close()
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatalf("GET /metrics error = %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/plain; version=0.0.4") {
+		t.Fatalf("Content-Type = %q", resp.Header.Get("Content-Type"))
+	}
+
+	labels := `model="gpt-4o-mini",provider="openai_compatible",channel="primary"`
+	for _, want := range []string{
+		`# TYPE llm_tracelab_requests_total counter`,
+		`llm_tracelab_requests_total{` + labels + `,status_class="2xx",token="ci"} 2`,
+		`llm_tracelab_requests_total{` + labels + `,status_class="4xx",token="ci"} 1`,
+		`llm_tracelab_request_duration_seconds_bucket{` + labels + `,status_class="2xx",token="ci",le="1"} 1`,
+		`llm_tracelab_request_duration_seconds_bucket{` + labels + `,status_class="2xx",token="ci",le="+Inf"} 2`,
+		`llm_tracelab_request_duration_seconds_sum{` + labels + `,status_class="2xx",token="ci"} 3.8`,
+		`llm_tracelab_ttft_seconds_count{` + labels + `,status_class="2xx",token="ci"} 1`,
+		`llm_tracelab_tokens_total{` + labels + `,status_class="2xx",token="ci",type="prompt"} 150`,
+		`llm_tracelab_tokens_total{` + labels + `,status_class="2xx",token="ci",type="cached"} 64`,
+		`llm_tracelab_tokens_total{` + labels + `,status_class="2xx",token="ci",type="reasoning"} 8`,
+		`llm_tracelab_upstream_inflight{channel="primary"} 0`,
+		`llm_tracelab_upstream_health_state{channel="primary",state="healthy"} 1`,
+		`llm_tracelab_upstream_circuit_open{channel="primary"} 0`,
+		`llm_tracelab_jobs{queue="parse",status="queued"} 1`,
+		`llm_tracelab_system_events{severity="error"} 1`,
+	} {
+		if !strings.Contains(string(body), want+"\n") {
+			t.Fatalf("metrics output missing %q:\n%s", want, body)
+		}
+	}
+	if strings.Contains(string(body), `llm_tracelab_ttft_seconds_count{`+labels+`,status_class="4xx"`) {
+		t.Fatalf("ttft histogram should be omitted for series without first-token timings:\n%s", body)
+	}
+}
+
+func TestStatusClassAndLabelEscaping(t *testing.T) {
+	for code, want := range map[int]string{0: "error", 200: "2xx", 502: "5xx"} {
+		if got := statusClass(code); got != want {
+			t.Fatalf("statusClass(%d) = %q, want %q", code, got, want)
+		}
+	}
+	if got := escapeLabel("a\"b\\c\nd"); got != `a\"b\\c\nd` {
+		t.Fatalf("escapeLabel() = %q", got)
+	}
+}
@@ -6,19 +6,28 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/kingfs/llm-tracelab/internal/alerts"
+	"github.com/kingfs/llm-tracelab/internal/analyzer"
 	"github.com/kingfs/llm-tracelab/internal/auth"
 	"github.com/kingfs/llm-tracelab/internal/channel"
+	"github.com/kingfs/llm-tracelab/internal/observeworker"
+	"github.com/kingfs/llm-tracelab/internal/playground"
+	"github.com/kingfs/llm-tracelab/internal/proxy"
 	"github.com/kingfs/llm-tracelab/internal/reanalysis"
 	"github.com/kingfs/llm-tracelab/internal/router"
+	"github.com/kingfs/llm-tracelab/internal/sessionanalysis"
 	"github.com/kingfs/llm-tracelab/internal/store"
 	"github.com/kingfs/llm-tracelab/internal/upstream"
 	"github.com/kingfs/llm-tracelab/pkg/observe"
@@ -47,6 +56,15 @@ type overviewResponse struct {
 	Attention   overviewAttentionView   `json:"attention"`
 	Analysis    overviewAnalysisSummary `json:"analysis"`
 	Observation overviewObservationView `json:"observation"`
+	PII         overviewPIIExposureView `json:"pii"`
+}
+
+type overviewPIIExposureView struct {
+	Findings int                `json:"findings"`
+	Traces   int                `json:"traces"`
+	Entities []sessionCountItem `json:"entities"`
+	Sessions []sessionCountItem `json:"sessions"`
+	Models   []sessionCountItem `json:"models"`
 }
 
 type overviewSummaryView struct {
@@ -193,6 +211,9 @@ type traceListItem struct {
 	CachedTokens     int       `json:"cached_tokens"`
 	IsStream         bool      `json:"is_stream"`
 	Error            string    `json:"error,omitempty"`
+	Tags             []string  `json:"tags,omitempty"`
+	// Search 仅在带 search 参数查询时返回，Snippet 中的命中词以 <mark></mark> 包裹。
+	Search *store.SearchHit `json:"search,omitempty"`
 }
 
 type sessionListResponse struct {
@@ -454,11 +475,13 @@ type findingListResponse struct {
 	Total    int           `json:"total"`
 	Severity string        `json:"severity,omitempty"`
 	Category string        `json:"category,omitempty"`
+	Status   string        `json:"status,omitempty"`
 }
 
 type findingView struct {
 	ID              string    `json:"id"`
 	TraceID         string    `json:"trace_id"`
+	SessionID       string    `json:"session_id,omitempty"`
 	Category        string    `json:"category"`
 	Severity        string    `json:"severity"`
 	Confidence      float64   `json:"confidence"`
@@ -470,6 +493,9 @@ type findingView struct {
 	Detector        string    `json:"detector"`
 	DetectorVersion string    `json:"detector_version"`
 	CreatedAt       time.Time `json:"created_at"`
+	Status          string    `json:"status"`
+	Fingerprint     string    `json:"fingerprint,omitempty"`
+	SuppressionID   string    `json:"suppression_id,omitempty"`
 }
 
 type observationSummaryView struct {
@@ -541,6 +567,14 @@ type RouteOptions struct {
 	AuthVerifier   auth.TokenVerifier
 	AuthStore      *auth.Store
 	SessionTTL     time.Duration
+	// Proxy 非空时启用 playground 重放，请求在进程内经代理 handler 发出并录制。
+	Proxy http.Handler
+	// Experiments 是 internal/experiments 提供的实验 API；evals 依赖 monitor，故以 handler 注入。
+	Experiments http.Handler
+	// Metrics 是 internal/metrics 提供的 Prometheus 抓取端点，为 nil 时不注册 /metrics。
+	Metrics http.Handler
+	// Analyzer 是同步重新分析使用的检测器 runner，为 nil 时使用内置检测器。
+	Analyzer *analyzer.Runner
 }
 
 type loginRequest struct {
@@ -935,11 +969,23 @@ func RegisterRoutes(mux *http.ServeMux, st *store.Store, opts ...RouteOptions) {
 	mux.HandleFunc("/api/events", monitorAuthRequired(systemEventListAPIHandler(st), opt.AuthVerifier))
 	mux.HandleFunc("/api/events/", monitorAuthRequired(systemEventDetailAPIHandler(st), opt.AuthVerifier))
 	mux.HandleFunc("/api/traces", monitorAuthRequired(listAPIHandler(st), opt.AuthVerifier))
-	mux.HandleFunc("/api/traces/", monitorAuthRequired(traceAPIHandler(st, opt.Router), opt.AuthVerifier))
+	mux.HandleFunc("/api/traces/", monitorAuthRequired(traceAPIHandler(st, opt.Router, opt.Proxy, opt.Analyzer), opt.AuthVerifier))
 	mux.HandleFunc("/api/sessions", monitorAuthRequired(sessionListAPIHandler(st), opt.AuthVerifier))
-	mux.HandleFunc("/api/sessions/", monitorAuthRequired(sessionDetailAPIHandler(st), opt.AuthVerifier))
+	mux.HandleFunc("/api/sessions/", monitorAuthRequired(sessionDetailAPIHandler(st, opt.Analyzer), opt.AuthVerifier))
+	mux.HandleFunc("/api/queries", monitorAuthRequired(savedQueryListAPIHandler(st), opt.AuthVerifier))
+	mux.HandleFunc("/api/queries/", monitorAuthRequired(savedQueryDetailAPIHandler(st), opt.AuthVerifier))
+	mux.HandleFunc("/api/annotations/", monitorAuthRequired(annotationDetailAPIHandler(st), opt.AuthVerifier))
+	mux.HandleFunc("/api/datasets", monitorAuthRequired(datasetListAPIHandler(st), opt.AuthVerifier))
+	mux.HandleFunc("/api/datasets/", monitorAuthRequired(datasetDetailAPIHandler(st), opt.AuthVerifier))
+	mux.HandleFunc("/api/experiments", monitorAuthRequired(experimentsAPIHandler(opt.Experiments), opt.AuthVerifier))
+	mux.HandleFunc("/api/experiments/", monitorAuthRequired(experimentsAPIHandler(opt.Experiments), opt.AuthVerifier))
 	mux.HandleFunc("/api/findings", monitorAuthRequired(findingListAPIHandler(st), opt.AuthVerifier))
-	mux.HandleFunc("/api/analysis/batch/reanalyze", monitorAuthRequired(analysisBatchReanalyzeAPIHandler(st), opt.AuthVerifier))
+	mux.HandleFunc("/api/findings/", monitorAuthRequired(findingDetailAPIHandler(st), opt.AuthVerifier))
+	mux.HandleFunc("/api/notifications", monitorAuthRequired(notificationListAPIHandler(st), opt.AuthVerifier))
+	mux.HandleFunc("/api/notifications/", monitorAuthRequired(notificationDetailAPIHandler(st), opt.AuthVerifier))
+	mux.HandleFunc("/api/alerts", monitorAuthRequired(alertListAPIHandler(st), opt.AuthVerifier))
+	mux.HandleFunc("/api/alerts/", monitorAuthRequired(alertDetailAPIHandler(st), opt.AuthVerifier))
+	mux.HandleFunc("/api/analysis/batch/reanalyze", monitorAuthRequired(analysisBatchReanalyzeAPIHandler(st, opt.Analyzer), opt.AuthVerifier))
 	mux.HandleFunc("/api/analysis/jobs", monitorAuthRequired(analysisJobListAPIHandler(st), opt.AuthVerifier))
 	mux.HandleFunc("/api/analysis/jobs/", monitorAuthRequired(analysisJobDetailAPIHandler(st), opt.AuthVerifier))
 	mux.HandleFunc("/api/analysis", monitorAuthRequired(analysisListAPIHandler(st), opt.AuthVerifier))
@@ -952,6 +998,9 @@ func RegisterRoutes(mux *http.ServeMux, st *store.Store, opts ...RouteOptions) {
 	mux.HandleFunc("/api/router/reload", monitorAuthRequired(routerReloadAPIHandler(st, opt.Router, opt.ChannelService), opt.AuthVerifier))
 	mux.HandleFunc("/api/upstreams", monitorAuthRequired(upstreamListAPIHandler(st, opt.Router), opt.AuthVerifier))
 	mux.HandleFunc("/api/upstreams/", monitorAuthRequired(upstreamDetailAPIHandler(st, opt.Router), opt.AuthVerifier))
+	if opt.Metrics != nil {
+		mux.HandleFunc("/metrics", monitorAuthRequired(opt.Metrics.ServeHTTP, opt.AuthVerifier))
+	}
 	mux.Handle("/", appHandler())
 }
 
@@ -2604,6 +2653,10 @@ func listAPIHandler(st *store.Store) http.HandlerFunc {
 		page := parseInt(r.URL.Query().Get("page"), 1)
 		pageSize := parseInt(r.URL.Query().Get("page_size"), 50)
 		filter := parseListFilter(r)
+		if err := applyTraceQuery(st, r, &filter); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
 		result, err := st.ListPage(page, pageSize, filter)
 		if err != nil {
 			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "query error: " + err.Error()})
@@ -2630,7 +2683,28 @@ func listAPIHandler(st *store.Store) http.HandlerFunc {
 			},
 			RefreshedAt: time.Now().UTC(),
 		}
+		traceIDs := make([]string, 0, len(result.Items))
+		for _, entry := range result.Items {
+			traceIDs = append(traceIDs, entry.ID)
+		}
+		var snippets map[string]store.SearchHit
+		if filter.Search != "" {
+			snippets, err = st.SearchSnippets(traceIDs, filter.Search)
+			if err != nil {
+				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "search error: " + err.Error()})
+				return
+			}
+		}
+		tags, err := st.ListTagsForTraces(traceIDs)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "query error: " + err.Error()})
+			return
+		}
 		for _, entry := range result.Items {
+			var search *store.SearchHit
+			if hit, ok := snippets[entry.ID]; ok {
+				search = &hit
+			}
 			resp.Items = append(resp.Items, traceListItem{
 				ID:               entry.ID,
 				SessionID:        entry.SessionID,
@@ -2652,6 +2726,8 @@ func listAPIHandler(st *store.Store) http.HandlerFunc {
 				CachedTokens:     cachedTokens(entry),
 				IsStream:         entry.Header.Layout.IsStream,
 				Error:            entry.Header.Meta.Error,
+				Tags:             tags[entry.ID],
+				Search:           search,
 			})
 		}
 		writeJSON(w, http.StatusOK, resp)
@@ -2668,6 +2744,10 @@ func sessionListAPIHandler(st *store.Store) http.HandlerFunc {
 		page := parseInt(r.URL.Query().Get("page"), 1)
 		pageSize := parseInt(r.URL.Query().Get("page_size"), 50)
 		filter := parseListFilter(r)
+		if err := applyTraceQuery(st, r, &filter); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
 		result, err := st.ListSessionPage(page, pageSize, filter)
 		if err != nil {
 			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "query error: " + err.Error()})
@@ -2688,7 +2768,7 @@ func sessionListAPIHandler(st *store.Store) http.HandlerFunc {
 	}
 }
 
-func sessionDetailAPIHandler(st *store.Store) http.HandlerFunc {
+func sessionDetailAPIHandler(st *store.Store, runner *analyzer.Runner) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if st == nil {
 			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "store not configured"})
@@ -2699,17 +2779,41 @@ func sessionDetailAPIHandler(st *store.Store) http.HandlerFunc {
 		path = strings.Trim(path, "/")
 		parts := strings.Split(path, "/")
 		sessionID := parts[0]
-		if sessionID == "" || len(parts) > 2 {
+		if sessionID == "" {
+			http.NotFound(w, r)
+			return
+		}
+		if len(parts) == 3 && parts[1] == "tags" {
+			handleTags(w, r, st, store.AnnotationTargetSession, sessionID, parts[2:])
+			return
+		}
+		if len(parts) > 2 {
 			http.NotFound(w, r)
 			return
 		}
 		if len(parts) == 2 {
+			if parts[1] == "tags" {
+				handleTags(w, r, st, store.AnnotationTargetSession, sessionID, nil)
+				return
+			}
+			if parts[1] == "annotations" {
+				handleAnnotations(w, r, st, store.AnnotationTargetSession, sessionID)
+				return
+			}
 			if parts[1] == "analysis" && r.Method == http.MethodGet {
 				handleSessionAnalysis(w, r, st, sessionID)
 				return
 			}
 			if parts[1] == "reanalyze" && r.Method == http.MethodPost {
-				handleSessionReanalyze(w, r, st, sessionID)
+				handleSessionReanalyze(w, r, st, runner, sessionID)
+				return
+			}
+			if parts[1] == "findings" && r.Method == http.MethodGet {
+				handleSessionFindings(w, r, st, sessionID)
+				return
+			}
+			if parts[1] == "trajectory" && r.Method == http.MethodGet {
+				handleSessionTrajectory(w, r, st, sessionID)
 				return
 			}
 			http.NotFound(w, r)
@@ -2782,7 +2886,50 @@ func handleSessionAnalysis(w http.ResponseWriter, r *http.Request, st *store.Sto
 	})
 }
 
-func handleSessionReanalyze(w http.ResponseWriter, r *http.Request, st *store.Store, sessionID string) {
+// handleSessionFindings 返回 session 级检测器（重复调用、重试循环、上下文膨胀等）写入的 finding。
+func handleSessionFindings(w http.ResponseWriter, r *http.Request, st *store.Store, sessionID string) {
+	filter := findingFilterFromQuery(r)
+	findings, err := st.ListSessionFindings(sessionID, filter)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "query session findings: " + err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, findingListResponse{
+		ID:       sessionID,
+		Items:    findingViewsFromObservations(findings),
+		Total:    len(findings),
+		Severity: filter.Severity,
+		Category: filter.Category,
+		Status:   filter.Status,
+	})
+}
+
+// handleSessionTrajectory 从 cassette 重建 session 的 agent 步骤，format=dot 时返回 Graphviz 文本。
+func handleSessionTrajectory(w http.ResponseWriter, r *http.Request, st *store.Store, sessionID string) {
+	format := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("format")))
+	if format != "" && format != "json" && format != "dot" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "format must be json or dot"})
+		return
+	}
+	traces, err := st.ListTracesBySession(sessionID)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "query error: " + err.Error()})
+		return
+	}
+	if len(traces) == 0 {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "session not found"})
+		return
+	}
+	trajectory := sessionanalysis.BuildTrajectory(sessionID, traces, sessionanalysis.ParseObservations(r.Context(), traces))
+	if format == "dot" {
+		w.Header().Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+		_, _ = io.WriteString(w, trajectory.DOT())
+		return
+	}
+	writeJSON(w, http.StatusOK, trajectory)
+}
+
+func handleSessionReanalyze(w http.ResponseWriter, r *http.Request, st *store.Store, runner *analyzer.Runner, sessionID string) {
 	req, ok := decodeReanalysisRequest(w, r)
 	if !ok {
 		return
@@ -2792,7 +2939,7 @@ func handleSessionReanalyze(w http.ResponseWriter, r *http.Request, st *store.St
 		Scan:    req.Scan,
 	}
 	mode := requestMode(req.Mode, "async")
-	svc := reanalysis.New(st, reanalysis.Options{})
+	svc := reanalysis.New(st, reanalysis.Options{Runner: runner})
 	if mode == "async" {
 		job, err := svc.EnqueueSessionReanalyze(sessionID, opts)
 		if err != nil {
@@ -2820,10 +2967,7 @@ func findingListAPIHandler(st *store.Store) http.HandlerFunc {
 			http.NotFound(w, r)
 			return
 		}
-		filter := store.FindingFilter{
-			Category: strings.TrimSpace(r.URL.Query().Get("category")),
-			Severity: strings.TrimSpace(r.URL.Query().Get("severity")),
-		}
+		filter := findingFilterFromQuery(r)
 		limit := parseInt(r.URL.Query().Get("limit"), 50)
 		findings, err := st.ListAllFindings(filter, limit)
 		if err != nil {
@@ -2839,10 +2983,227 @@ func findingListAPIHandler(st *store.Store) http.HandlerFunc {
 			Total:    len(items),
 			Severity: filter.Severity,
 			Category: filter.Category,
+			Status:   filter.Status,
 		})
 	}
 }
 
+func findingFilterFromQuery(r *http.Request) store.FindingFilter {
+	return store.FindingFilter{
+		Category:    strings.TrimSpace(r.URL.Query().Get("category")),
+		Severity:    strings.TrimSpace(r.URL.Query().Get("severity")),
+		Status:      strings.TrimSpace(r.URL.Query().Get("status")),
+		Fingerprint: strings.TrimSpace(r.URL.Query().Get("fingerprint")),
+	}
+}
+
+type findingSuppressionRequest struct {
+	Fingerprint     string `json:"fingerprint"`
+	Category        string `json:"category"`
+	EvidencePattern string `json:"evidence_pattern"`
+	Reason          string `json:"reason"`
+}
+
+type findingStatusRequest struct {
+	Status string `json:"status"`
+	Note   string `json:"note"`
+}
+
+// findingDetailAPIHandler 处理 /api/findings/suppressions（GET 列出、POST 新建并回溯抑制已有 finding）、
+// DELETE /api/findings/suppressions/{id} 与 GET /api/findings/audit?trace_id=&finding_id=。
+func findingDetailAPIHandler(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.Trim(strings.TrimPrefix(pathClean(r.URL.Path), "/api/findings/"), "/"), "/")
+		switch {
+		case len(parts) == 1 && parts[0] == "suppressions" && r.Method == http.MethodGet:
+			items, err := st.ListFindingSuppressions()
+			if err != nil {
+				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+				return
+			}
+			if items == nil {
+				items = []store.FindingSuppression{}
+			}
+			writeJSON(w, http.StatusOK, map[string]any{"items": items, "total": len(items)})
+		case len(parts) == 1 && parts[0] == "suppressions" && r.Method == http.MethodPost:
+			var req findingSuppressionRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid json body"})
+				return
+			}
+			rule, matched, err := st.CreateFindingSuppression(store.FindingSuppression{
+				Fingerprint:     req.Fingerprint,
+				Category:        req.Category,
+				EvidencePattern: req.EvidencePattern,
+				Reason:          req.Reason,
+				CreatedBy:       monitorUsername(r),
+			})
+			if err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]any{"suppression": rule, "suppressed": matched})
+		case len(parts) == 2 && parts[0] == "suppressions" && r.Method == http.MethodDelete:
+			restored, err := st.DeleteFindingSuppression(parts[1], monitorUsername(r))
+			if errors.Is(err, sql.ErrNoRows) {
+				writeJSON(w, http.StatusNotFound, map[string]string{"error": "suppression not found"})
+				return
+			}
+			if err != nil {
+				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]any{"ok": true, "restored": restored})
+		case len(parts) == 1 && parts[0] == "audit" && r.Method == http.MethodGet:
+			items, err := st.ListFindingAudit(store.FindingAuditFilter{
+				TraceID:   strings.TrimSpace(r.URL.Query().Get("trace_id")),
+				FindingID: strings.TrimSpace(r.URL.Query().Get("finding_id")),
+				Limit:     parseInt(r.URL.Query().Get("limit"), 100),
+			})
+			if err != nil {
+				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+				return
+			}
+			if items == nil {
+				items = []store.FindingAuditRecord{}
+			}
+			writeJSON(w, http.StatusOK, map[string]any{"items": items, "total": len(items)})
+		default:
+			http.NotFound(w, r)
+		}
+	}
+}
+
+// notificationListAPIHandler 返回系统事件通知的投递记录（outbox），支持按 sink 与状态过滤。
+func notificationListAPIHandler(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.NotFound(w, r)
+			return
+		}
+		filter := store.NotificationDeliveryFilter{
+			Sink:   strings.TrimSpace(r.URL.Query().Get("sink")),
+			Status: strings.TrimSpace(r.URL.Query().Get("status")),
+			Limit:  parseInt(r.URL.Query().Get("limit"), 50),
+		}
+		items, err := st.ListNotificationDeliveries(filter)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		if items == nil {
+			items = []store.NotificationDelivery{}
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"items": items, "total": len(items)})
+	}
+}
+
+// notificationDetailAPIHandler 处理 GET /api/notifications/{id} 与 POST /api/notifications/{id}/retry。
+func notificationDetailAPIHandler(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.Trim(strings.TrimPrefix(pathClean(r.URL.Path), "/api/notifications/"), "/"), "/")
+		id, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil || id <= 0 {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid notification id"})
+			return
+		}
+		var delivery store.NotificationDelivery
+		errStatus := http.StatusInternalServerError
+		switch {
+		case len(parts) == 1 && r.Method == http.MethodGet:
+			delivery, err = st.GetNotificationDelivery(id)
+		case len(parts) == 2 && parts[1] == "retry" && r.Method == http.MethodPost:
+			// 只有 failed 状态的通知可以重试，其余状态返回 409。
+			delivery, err = st.RetryNotification(id)
+			errStatus = http.StatusConflict
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			writeJSON(w, http.StatusNotFound, map[string]string{"error": "notification not found"})
+			return
+		}
+		if err != nil {
+			writeJSON(w, errStatus, map[string]string{"error": err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, delivery)
+	}
+}
+
+// alertListAPIHandler 处理 GET /api/alerts（规则及其当前状态）与 POST /api/alerts（按 id 新建或覆盖规则）。
+func alertListAPIHandler(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			rules, err := st.ListAlertRules()
+			if err != nil {
+				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+				return
+			}
+			if rules == nil {
+				rules = []store.AlertRule{}
+			}
+			writeJSON(w, http.StatusOK, map[string]any{"items": rules, "total": len(rules)})
+		case http.MethodPost:
+			var req alerts.RuleInput
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid json body"})
+				return
+			}
+			rule, err := req.Rule()
+			if err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+				return
+			}
+			saved, err := st.SaveAlertRule(rule)
+			if err != nil {
+				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+				return
+			}
+			writeJSON(w, http.StatusOK, saved)
+		default:
+			http.NotFound(w, r)
+		}
+	}
+}
+
+// alertDetailAPIHandler 处理 GET / DELETE /api/alerts/{id} 与 POST /api/alerts/{id}/evaluate（按当前数据试算，不改变状态）。
+func alertDetailAPIHandler(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.Trim(strings.TrimPrefix(pathClean(r.URL.Path), "/api/alerts/"), "/"), "/")
+		rule, err := st.GetAlertRule(parts[0])
+		if errors.Is(err, sql.ErrNoRows) {
+			writeJSON(w, http.StatusNotFound, map[string]string{"error": "alert rule not found"})
+			return
+		}
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		switch {
+		case len(parts) == 1 && r.Method == http.MethodGet:
+			writeJSON(w, http.StatusOK, rule)
+		case len(parts) == 1 && r.Method == http.MethodDelete:
+			if err := alerts.DeleteRule(st, rule.ID); err != nil {
+				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]bool{"ok": true})
+		case len(parts) == 2 && parts[1] == "evaluate" && r.Method == http.MethodPost:
+			evaluation, err := alerts.Evaluate(st, rule, time.Now().UTC())
+			if err != nil {
+				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+				return
+			}
+			writeJSON(w, http.StatusOK, evaluation)
+		default:
+			http.NotFound(w, r)
+		}
+	}
+}
+
 func analysisListAPIHandler(st *store.Store) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
@@ -2886,7 +3247,7 @@ func analysisJobListAPIHandler(st *store.Store) http.HandlerFunc {
 	}
 }
 
-func analysisBatchReanalyzeAPIHandler(st *store.Store) http.HandlerFunc {
+func analysisBatchReanalyzeAPIHandler(st *store.Store, runner *analyzer.Runner) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			http.NotFound(w, r)
@@ -2915,7 +3276,7 @@ func analysisBatchReanalyzeAPIHandler(st *store.Store) http.HandlerFunc {
 			Scan:        req.Scan,
 		}
 		mode := requestMode(req.Mode, "async")
-		svc := reanalysis.New(st, reanalysis.Options{})
+		svc := reanalysis.New(st, reanalysis.Options{Runner: runner})
 		switch mode {
 		case "async":
 			job, err := svc.EnqueueBatchReanalyze(opts)
@@ -3011,7 +3372,7 @@ func overviewAPIHandler(st *store.Store) http.HandlerFunc {
 	}
 }
 
-func traceAPIHandler(st *store.Store, rtr *router.Router) http.HandlerFunc {
+func traceAPIHandler(st *store.Store, rtr *router.Router, proxyHandler http.Handler, runner *analyzer.Runner) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		path := strings.TrimPrefix(pathClean(r.URL.Path), "/api/traces/")
 		path = strings.Trim(path, "/")
@@ -3041,111 +3402,319 @@ func traceAPIHandler(st *store.Store, rtr *router.Router) http.HandlerFunc {
 			handleTraceObservation(w, st, entry)
 		case len(parts) == 2 && parts[1] == "findings" && r.Method == http.MethodGet:
 			handleTraceFindings(w, r, st, entry)
+		case len(parts) == 3 && parts[1] == "findings" && r.Method == http.MethodPatch:
+			handleTraceFindingStatus(w, r, st, entry, parts[2])
 		case len(parts) == 2 && parts[1] == "performance" && r.Method == http.MethodGet:
 			handleTracePerformance(w, entry)
 		case len(parts) == 2 && parts[1] == "download" && r.Method == http.MethodGet:
 			serveTraceDownload(w, r, absPath)
 		case len(parts) == 2 && parts[1] == "reparse" && r.Method == http.MethodPost:
-			handleTraceReparse(w, r, st, entry)
+			handleTraceReparse(w, r, st, runner, entry)
 		case len(parts) == 2 && parts[1] == "scan" && r.Method == http.MethodPost:
-			handleTraceScan(w, r, st, entry)
+			handleTraceScan(w, r, st, runner, entry)
 		case len(parts) == 2 && parts[1] == "repair-usage" && r.Method == http.MethodPost:
-			handleTraceRepairUsage(w, r, st, entry)
+			handleTraceRepairUsage(w, r, st, runner, entry)
 		case len(parts) == 2 && parts[1] == "reanalyze" && r.Method == http.MethodPost:
-			handleTraceReanalyze(w, r, st, entry)
+			handleTraceReanalyze(w, r, st, runner, entry)
+		case (len(parts) == 2 || len(parts) == 3) && parts[1] == "tags":
+			handleTags(w, r, st, store.AnnotationTargetTrace, entry.ID, parts[2:])
+		case len(parts) == 2 && parts[1] == "annotations":
+			handleAnnotations(w, r, st, store.AnnotationTargetTrace, entry.ID)
+		case len(parts) == 2 && parts[1] == "label":
+			handleTraceLabel(w, r, st, entry.ID)
+		case len(parts) == 3 && parts[1] == "diff" && r.Method == http.MethodGet:
+			handleTraceDiff(w, r, st, entry, absPath, parts[2])
+		case len(parts) == 2 && parts[1] == "rerun":
+			handleTraceRerun(w, r, st, rtr, proxyHandler, entry)
 		default:
 			http.NotFound(w, r)
 		}
 	}
 }
 
-func handleTraceReparse(w http.ResponseWriter, r *http.Request, st *store.Store, entry store.LogEntry) {
-	req, ok := decodeReanalysisRequest(w, r)
-	if !ok {
-		return
-	}
-	runTraceReanalysis(w, r, st, requestMode(req.Mode, "sync"), func(svc *reanalysis.Service) (store.AnalysisJobRecord, error) {
-		return svc.EnqueueTraceReparse(entry.ID, reanalysis.TraceOptions{Scan: req.Scan})
-	}, func(svc *reanalysis.Service) (reanalysis.Result, error) {
-		return svc.ReparseTrace(r.Context(), entry.ID, reanalysis.TraceOptions{Scan: req.Scan})
-	})
-}
-
-func handleTraceScan(w http.ResponseWriter, r *http.Request, st *store.Store, entry store.LogEntry) {
-	req, ok := decodeReanalysisRequest(w, r)
-	if !ok {
-		return
-	}
-	runTraceReanalysis(w, r, st, requestMode(req.Mode, "sync"), func(svc *reanalysis.Service) (store.AnalysisJobRecord, error) {
-		return svc.EnqueueTraceRescan(entry.ID)
-	}, func(svc *reanalysis.Service) (reanalysis.Result, error) {
-		return svc.RescanTrace(r.Context(), entry.ID)
-	})
-}
-
-func handleTraceRepairUsage(w http.ResponseWriter, r *http.Request, st *store.Store, entry store.LogEntry) {
-	req, ok := decodeReanalysisRequest(w, r)
-	if !ok {
-		return
-	}
-	if req.RewriteCassette && requestMode(req.Mode, "sync") == "async" {
-		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "rewrite_cassette is only supported in sync mode"})
-		return
+// monitorUsername 返回当前登录用户名，未启用鉴权时为空。
+func monitorUsername(r *http.Request) string {
+	if principal, ok := auth.PrincipalFromContext(r.Context()); ok {
+		return principal.Username
 	}
-	runTraceReanalysis(w, r, st, requestMode(req.Mode, "sync"), func(svc *reanalysis.Service) (store.AnalysisJobRecord, error) {
-		return svc.EnqueueTraceRepairUsage(entry.ID)
-	}, func(svc *reanalysis.Service) (reanalysis.Result, error) {
-		return svc.RepairTraceUsage(r.Context(), entry.ID, reanalysis.RepairUsageOptions{RewriteCassette: req.RewriteCassette})
-	})
+	return ""
 }
 
-func handleTraceReanalyze(w http.ResponseWriter, r *http.Request, st *store.Store, entry store.LogEntry) {
-	req, ok := decodeReanalysisRequest(w, r)
-	if !ok {
-		return
-	}
-	runTraceReanalysis(w, r, st, requestMode(req.Mode, "sync"), func(svc *reanalysis.Service) (store.AnalysisJobRecord, error) {
-		return svc.EnqueueTraceReanalyze(entry.ID)
-	}, func(svc *reanalysis.Service) (reanalysis.Result, error) {
-		return svc.ReanalyzeTrace(r.Context(), entry.ID)
-	})
+type tagsRequest struct {
+	Tags []string `json:"tags"`
 }
 
-func runTraceReanalysis(
-	w http.ResponseWriter,
-	r *http.Request,
-	st *store.Store,
-	mode string,
-	enqueue func(*reanalysis.Service) (store.AnalysisJobRecord, error),
-	runSync func(*reanalysis.Service) (reanalysis.Result, error),
-) {
-	svc := reanalysis.New(st, reanalysis.Options{})
-	switch mode {
-	case "async":
-		job, err := enqueue(svc)
+// handleTags 处理 GET/POST .../tags 与 DELETE .../tags/{tag}。
+func handleTags(w http.ResponseWriter, r *http.Request, st *store.Store, targetType string, targetID string, rest []string) {
+	switch {
+	case len(rest) == 0 && r.Method == http.MethodGet:
+		tags, err := st.ListTags(targetType, targetID)
 		if err != nil {
 			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
 			return
 		}
-		writeJSON(w, http.StatusAccepted, reanalysisResponse{Job: analysisJobViewFromStore(job)})
-	case "sync":
-		result, err := runSync(svc)
+		writeJSON(w, http.StatusOK, map[string]any{"items": tags})
+	case len(rest) == 0 && r.Method == http.MethodPost:
+		var req tagsRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid json: " + err.Error()})
+			return
+		}
+		if len(parseTagValues(req.Tags)) == 0 {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "tags are required"})
+			return
+		}
+		tags, err := st.AddTags(targetType, targetID, req.Tags, monitorUsername(r))
 		if err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"items": tags})
+	case len(rest) == 1 && r.Method == http.MethodDelete:
+		if err := st.RemoveTag(targetType, targetID, rest[0]); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				writeJSON(w, http.StatusNotFound, map[string]string{"error": "tag not found"})
+				return
+			}
 			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
 			return
 		}
-		writeJSON(w, http.StatusOK, reanalysisResponse{Job: analysisJobViewFromStore(result.Job), Result: result})
+		writeJSON(w, http.StatusOK, map[string]bool{"ok": true})
 	default:
-		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "mode must be sync or async"})
+		http.NotFound(w, r)
 	}
 }
 
-func decodeReanalysisRequest(w http.ResponseWriter, r *http.Request) (reanalysisRequest, bool) {
-	var req reanalysisRequest
-	if r.Body == nil || r.ContentLength == 0 {
-		return req, true
-	}
-	decoder := json.NewDecoder(r.Body)
+type annotationRequest struct {
+	Body     string `json:"body"`
+	ParentID string `json:"parent_id"`
+}
+
+func handleAnnotations(w http.ResponseWriter, r *http.Request, st *store.Store, targetType string, targetID string) {
+	switch r.Method {
+	case http.MethodGet:
+		items, err := st.ListAnnotations(targetType, targetID)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"items": items})
+	case http.MethodPost:
+		var req annotationRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid json: " + err.Error()})
+			return
+		}
+		record, err := st.AddAnnotation(store.AnnotationRecord{
+			TargetType: targetType,
+			TargetID:   targetID,
+			ParentID:   req.ParentID,
+			Author:     monitorUsername(r),
+			Body:       req.Body,
+		})
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, record)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// annotationDetailAPIHandler 处理 PATCH/DELETE /api/annotations/{id}；只有作者本人可以修改或删除，未启用鉴权时不做限制。
+func annotationDetailAPIHandler(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if st == nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "store not configured"})
+			return
+		}
+		id := strings.Trim(strings.TrimPrefix(pathClean(r.URL.Path), "/api/annotations/"), "/")
+		if id == "" || strings.Contains(id, "/") || (r.Method != http.MethodPatch && r.Method != http.MethodDelete) {
+			http.NotFound(w, r)
+			return
+		}
+		existing, err := st.GetAnnotation(id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				writeJSON(w, http.StatusNotFound, map[string]string{"error": "annotation not found"})
+				return
+			}
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		if username := monitorUsername(r); username != "" && existing.Author != "" && existing.Author != username {
+			writeJSON(w, http.StatusForbidden, map[string]string{"error": "only the author can change this annotation"})
+			return
+		}
+		if r.Method == http.MethodDelete {
+			if err := st.DeleteAnnotation(id); err != nil {
+				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]bool{"ok": true})
+			return
+		}
+		var req annotationRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid json: " + err.Error()})
+			return
+		}
+		record, err := st.UpdateAnnotation(id, req.Body)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, record)
+	}
+}
+
+type humanLabelItem struct {
+	Label     string    `json:"label"`
+	Author    string    `json:"author,omitempty"`
+	Note      string    `json:"note,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type humanLabelRequest struct {
+	Label string `json:"label"`
+	Note  string `json:"note"`
+}
+
+// handleTraceLabel 处理人工 👍/👎：GET 返回全部标注与汇总，PUT 设置当前用户的标注，DELETE 撤销。
+func handleTraceLabel(w http.ResponseWriter, r *http.Request, st *store.Store, traceID string) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut, http.MethodPost:
+		var req humanLabelRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid json: " + err.Error()})
+			return
+		}
+		if strings.TrimSpace(req.Label) == "" {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "label is required"})
+			return
+		}
+		if _, err := st.SetHumanLabel(traceID, req.Label, monitorUsername(r), req.Note); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+	case http.MethodDelete:
+		if _, err := st.SetHumanLabel(traceID, "", monitorUsername(r), ""); err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	scores, err := st.ListScores(store.ScoreFilter{TraceID: traceID, EvaluatorKey: store.HumanEvaluatorKey}, 0)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return
+	}
+	items := make([]humanLabelItem, 0, len(scores))
+	counts := map[string]int{store.HumanLabelUp: 0, store.HumanLabelDown: 0}
+	for _, record := range scores {
+		counts[record.Label]++
+		items = append(items, humanLabelItem{
+			Label:     record.Label,
+			Author:    store.HumanLabelAuthor(record),
+			Note:      record.Explanation,
+			CreatedAt: record.CreatedAt,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"items": items, "counts": counts})
+}
+
+func handleTraceReparse(w http.ResponseWriter, r *http.Request, st *store.Store, runner *analyzer.Runner, entry store.LogEntry) {
+	req, ok := decodeReanalysisRequest(w, r)
+	if !ok {
+		return
+	}
+	runTraceReanalysis(w, r, st, runner, requestMode(req.Mode, "sync"), func(svc *reanalysis.Service) (store.AnalysisJobRecord, error) {
+		return svc.EnqueueTraceReparse(entry.ID, reanalysis.TraceOptions{Scan: req.Scan})
+	}, func(svc *reanalysis.Service) (reanalysis.Result, error) {
+		return svc.ReparseTrace(r.Context(), entry.ID, reanalysis.TraceOptions{Scan: req.Scan})
+	})
+}
+
+func handleTraceScan(w http.ResponseWriter, r *http.Request, st *store.Store, runner *analyzer.Runner, entry store.LogEntry) {
+	req, ok := decodeReanalysisRequest(w, r)
+	if !ok {
+		return
+	}
+	runTraceReanalysis(w, r, st, runner, requestMode(req.Mode, "sync"), func(svc *reanalysis.Service) (store.AnalysisJobRecord, error) {
+		return svc.EnqueueTraceRescan(entry.ID)
+	}, func(svc *reanalysis.Service) (reanalysis.Result, error) {
+		return svc.RescanTrace(r.Context(), entry.ID)
+	})
+}
+
+func handleTraceRepairUsage(w http.ResponseWriter, r *http.Request, st *store.Store, runner *analyzer.Runner, entry store.LogEntry) {
+	req, ok := decodeReanalysisRequest(w, r)
+	if !ok {
+		return
+	}
+	if req.RewriteCassette && requestMode(req.Mode, "sync") == "async" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "rewrite_cassette is only supported in sync mode"})
+		return
+	}
+	runTraceReanalysis(w, r, st, runner, requestMode(req.Mode, "sync"), func(svc *reanalysis.Service) (store.AnalysisJobRecord, error) {
+		return svc.EnqueueTraceRepairUsage(entry.ID)
+	}, func(svc *reanalysis.Service) (reanalysis.Result, error) {
+		return svc.RepairTraceUsage(r.Context(), entry.ID, reanalysis.RepairUsageOptions{RewriteCassette: req.RewriteCassette})
+	})
+}
+
+func handleTraceReanalyze(w http.ResponseWriter, r *http.Request, st *store.Store, runner *analyzer.Runner, entry store.LogEntry) {
+	req, ok := decodeReanalysisRequest(w, r)
+	if !ok {
+		return
+	}
+	runTraceReanalysis(w, r, st, runner, requestMode(req.Mode, "sync"), func(svc *reanalysis.Service) (store.AnalysisJobRecord, error) {
+		return svc.EnqueueTraceReanalyze(entry.ID)
+	}, func(svc *reanalysis.Service) (reanalysis.Result, error) {
+		return svc.ReanalyzeTrace(r.Context(), entry.ID)
+	})
+}
+
+func runTraceReanalysis(
+	w http.ResponseWriter,
+	r *http.Request,
+	st *store.Store,
+	runner *analyzer.Runner,
+	mode string,
+	enqueue func(*reanalysis.Service) (store.AnalysisJobRecord, error),
+	runSync func(*reanalysis.Service) (reanalysis.Result, error),
+) {
+	svc := reanalysis.New(st, reanalysis.Options{Runner: runner})
+	switch mode {
+	case "async":
+		job, err := enqueue(svc)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusAccepted, reanalysisResponse{Job: analysisJobViewFromStore(job)})
+	case "sync":
+		result, err := runSync(svc)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, reanalysisResponse{Job: analysisJobViewFromStore(result.Job), Result: result})
+	default:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "mode must be sync or async"})
+	}
+}
+
+func decodeReanalysisRequest(w http.ResponseWriter, r *http.Request) (reanalysisRequest, bool) {
+	var req reanalysisRequest
+	if r.Body == nil || r.ContentLength == 0 {
+		return req, true
+	}
+	decoder := json.NewDecoder(r.Body)
 	decoder.DisallowUnknownFields()
 	if err := decoder.Decode(&req); err != nil {
 		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid json: " + err.Error()})
@@ -3187,11 +3756,136 @@ func handleTraceObservation(w http.ResponseWriter, st *store.Store, entry store.
 	writeJSON(w, http.StatusOK, payload)
 }
 
-func handleTraceFindings(w http.ResponseWriter, r *http.Request, st *store.Store, entry store.LogEntry) {
-	filter := store.FindingFilter{
-		Category: strings.TrimSpace(r.URL.Query().Get("category")),
-		Severity: strings.TrimSpace(r.URL.Query().Get("severity")),
+// handleTraceDiff 从两条 trace 的 cassette 重新解析 observation 后做语义对比。
+func handleTraceDiff(w http.ResponseWriter, r *http.Request, st *store.Store, entry store.LogEntry, absPath string, otherID string) {
+	other, otherPath, err := loadTrace(st, otherID)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			writeJSON(w, http.StatusNotFound, map[string]string{"error": "trace not found: " + otherID})
+			return
+		}
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return
+	}
+	registry := observe.NewDefaultRegistry()
+	left, err := observeworker.ParseCassette(r.Context(), registry, entry.ID, absPath)
+	if err != nil {
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]string{"error": fmt.Sprintf("parse trace %s: %v", entry.ID, err)})
+		return
+	}
+	right, err := observeworker.ParseCassette(r.Context(), registry, other.ID, otherPath)
+	if err != nil {
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]string{"error": fmt.Sprintf("parse trace %s: %v", other.ID, err)})
+		return
+	}
+	writeJSON(w, http.StatusOK, observe.DiffObservations(left, right))
+}
+
+type rerunDetailResponse struct {
+	Draft    playground.Draft   `json:"draft"`
+	RerunOf  *store.TraceRerun  `json:"rerun_of,omitempty"`
+	Reruns   []store.TraceRerun `json:"reruns"`
+	Channels []string           `json:"channels"`
+	Enabled  bool               `json:"enabled"`
+}
+
+type rerunResponse struct {
+	playground.Result
+	Compare string `json:"compare,omitempty"`
+}
+
+// handleTraceRerun 提供 playground：GET 返回可编辑的请求草稿与已有 rerun，POST 应用编辑后经代理重放。
+func handleTraceRerun(w http.ResponseWriter, r *http.Request, st *store.Store, rtr *router.Router, proxyHandler http.Handler, entry store.LogEntry) {
+	switch r.Method {
+	case http.MethodGet:
+		draft, err := playground.LoadDraft(entry)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		reruns, err := st.ListReruns(entry.ID)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		resp := rerunDetailResponse{
+			Draft:    draft,
+			Reruns:   reruns,
+			Channels: []string{},
+			Enabled:  proxyHandler != nil,
+		}
+		if resp.Reruns == nil {
+			resp.Reruns = []store.TraceRerun{}
+		}
+		if origin, err := st.GetRerunOf(entry.ID); err == nil {
+			resp.RerunOf = &origin
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		resp.Channels = rerunChannels(rtr)
+		writeJSON(w, http.StatusOK, resp)
+	case http.MethodPost:
+		if proxyHandler == nil {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "playground rerun requires the proxy to run in the same process"})
+			return
+		}
+		var edits playground.Edits
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 32<<20)).Decode(&edits); err != nil && !errors.Is(err, io.EOF) {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid rerun request: " + err.Error()})
+			return
+		}
+		channel := strings.TrimSpace(edits.Channel)
+		if channel != "" && rtr != nil {
+			channels := rerunChannels(rtr)
+			if !slices.Contains(channels, channel) {
+				writeJSON(w, http.StatusBadRequest, map[string]any{"error": fmt.Sprintf("unknown channel %q", channel), "channels": channels})
+				return
+			}
+		}
+		req, err := playground.BuildRequest(r.Context(), entry, edits)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		result, err := playground.Run(proxyHandler, st, req, &proxy.Rerun{
+			OriginalTraceID: entry.ID,
+			Channel:         channel,
+			User:            monitorUsername(r),
+			TokenName:       entry.Header.Meta.TokenName,
+		})
+		resp := rerunResponse{Result: result}
+		if result.TraceID != "" {
+			resp.Compare = "/api/traces/" + entry.ID + "/diff/" + result.TraceID
+		}
+		if err != nil {
+			if resp.Error == "" {
+				resp.Error = err.Error()
+			}
+			writeJSON(w, http.StatusBadGateway, resp)
+			return
+		}
+		writeJSON(w, http.StatusCreated, resp)
+	default:
+		writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+	}
+}
+
+// rerunChannels 返回路由器中可用于 rerun 的渠道 ID（已排序）。
+func rerunChannels(rtr *router.Router) []string {
+	channels := []string{}
+	if rtr == nil {
+		return channels
 	}
+	for _, target := range rtr.Targets() {
+		channels = append(channels, target.ID)
+	}
+	sort.Strings(channels)
+	return channels
+}
+
+func handleTraceFindings(w http.ResponseWriter, r *http.Request, st *store.Store, entry store.LogEntry) {
+	filter := findingFilterFromQuery(r)
 	findings, err := st.ListFindings(entry.ID, filter)
 	if err != nil {
 		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
@@ -3207,9 +3901,33 @@ func handleTraceFindings(w http.ResponseWriter, r *http.Request, st *store.Store
 		Total:    len(items),
 		Severity: filter.Severity,
 		Category: filter.Category,
+		Status:   filter.Status,
 	})
 }
 
+// handleTraceFindingStatus 处理 PATCH /api/traces/{id}/findings/{finding_id}，修改处置状态并以当前用户记入审计。
+func handleTraceFindingStatus(w http.ResponseWriter, r *http.Request, st *store.Store, entry store.LogEntry, findingID string) {
+	var req findingStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid json body"})
+		return
+	}
+	if !store.ValidFindingStatus(strings.TrimSpace(req.Status)) {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "status must be open, acknowledged, false_positive or resolved"})
+		return
+	}
+	finding, err := st.UpdateFindingStatus(entry.ID, findingID, req.Status, monitorUsername(r), req.Note)
+	if errors.Is(err, sql.ErrNoRows) {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "finding not found"})
+		return
+	}
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, findingViewFromObservation(finding))
+}
+
 func handleTracePerformance(w http.ResponseWriter, entry store.LogEntry) {
 	writeJSON(w, http.StatusOK, performanceResponse{
 		ID:          entry.ID,
@@ -3219,7 +3937,7 @@ func handleTracePerformance(w http.ResponseWriter, entry store.LogEntry) {
 }
 
 func handleTraceDetail(w http.ResponseWriter, absPath string, entry store.LogEntry, rtr *router.Router) {
-	content, err := os.ReadFile(absPath)
+	content, err := recordfile.ReadFile(absPath)
 	if err != nil {
 		writeJSON(w, http.StatusNotFound, map[string]string{"error": "file not found"})
 		return
@@ -3260,7 +3978,7 @@ func handleTraceDetail(w http.ResponseWriter, absPath string, entry store.LogEnt
 }
 
 func handleTraceRaw(w http.ResponseWriter, absPath string, entry store.LogEntry) {
-	content, err := os.ReadFile(absPath)
+	content, err := recordfile.ReadFile(absPath)
 	if err != nil {
 		writeJSON(w, http.StatusNotFound, map[string]string{"error": "file not found"})
 		return
@@ -3407,6 +4125,7 @@ func findingViewFromObservation(finding observe.Finding) findingView {
 	return findingView{
 		ID:              finding.ID,
 		TraceID:         finding.TraceID,
+		SessionID:       finding.SessionID,
 		Category:        finding.Category,
 		Severity:        string(finding.Severity),
 		Confidence:      finding.Confidence,
@@ -3418,6 +4137,9 @@ func findingViewFromObservation(finding observe.Finding) findingView {
 		Detector:        finding.Detector,
 		DetectorVersion: finding.DetectorVersion,
 		CreatedAt:       finding.CreatedAt,
+		Status:          finding.Status,
+		Fingerprint:     finding.Fingerprint,
+		SuppressionID:   finding.SuppressionID,
 	}
 }
 
@@ -3522,6 +4244,13 @@ func overviewResponseFromStore(window string, dashboard store.OverviewDashboard)
 			Unparsed:          dashboard.Observation.Unparsed,
 			RecentFailures:    parseJobViews(dashboard.Observation.RecentFailures),
 		},
+		PII: overviewPIIExposureView{
+			Findings: dashboard.PII.Findings,
+			Traces:   dashboard.PII.Traces,
+			Entities: countItemViews(dashboard.PII.Entities),
+			Sessions: countItemViews(dashboard.PII.Sessions),
+			Models:   countItemViews(dashboard.PII.Models),
+		},
 	}
 }
 
@@ -3900,6 +4629,7 @@ func parseListFilter(r *http.Request) store.ListFilter {
 	query := r.URL.Query()
 	return store.ListFilter{
 		Query:            strings.TrimSpace(query.Get("q")),
+		Search:           strings.TrimSpace(query.Get("search")),
 		Provider:         strings.TrimSpace(query.Get("provider")),
 		Model:            strings.TrimSpace(query.Get("model")),
 		Endpoint:         strings.TrimSpace(query.Get("endpoint")),
@@ -3912,24 +4642,606 @@ func parseListFilter(r *http.Request) store.ListFilter {
 		MaxTTFTMs:        int64(parseInt(query.Get("max_ttft_ms"), 0)),
 		MinTokens:        parseInt(query.Get("min_tokens"), 0),
 		MaxTokens:        parseInt(query.Get("max_tokens"), 0),
+		Tags:             parseTagValues(query["tag"]),
 	}
 }
 
-func buildSessionBreakdown(traces []traceListItem) sessionBreakdownView {
-	modelCounts := map[string]int{}
-	endpointCounts := map[string]int{}
-	failed := 0
-	for _, trace := range traces {
-		model := firstNonEmpty(trace.Model, "unknown-model")
-		endpoint := firstNonEmpty(trace.Endpoint, trace.Operation, trace.URL, "unknown-endpoint")
-		modelCounts[model]++
-		endpointCounts[endpoint]++
-		if trace.StatusCode < 200 || trace.StatusCode >= 300 {
-			failed++
+// parseTagValues 支持重复的 tag 参数，也支持逗号分隔。
+func parseTagValues(values []string) []string {
+	var tags []string
+	for _, value := range values {
+		for _, tag := range strings.Split(value, ",") {
+			if tag = store.NormalizeTag(tag); tag != "" {
+				tags = append(tags, tag)
+			}
 		}
 	}
-	return sessionBreakdownView{
-		Models:       sortSessionCounts(modelCounts),
+	return tags
+}
+
+// applyTraceQuery 把 query（查询语言表达式）与 saved（已保存查询名称）参数合并到 filter，两者同时存在时取交集。
+func applyTraceQuery(st *store.Store, r *http.Request, filter *store.ListFilter) error {
+	var expressions []string
+	if name := strings.TrimSpace(r.URL.Query().Get("saved")); name != "" {
+		saved, err := st.GetSavedQuery(name)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("saved query %q not found", name)
+			}
+			return err
+		}
+		expressions = append(expressions, saved.Query)
+	}
+	if query := strings.TrimSpace(r.URL.Query().Get("query")); query != "" {
+		expressions = append(expressions, query)
+	}
+	for _, expr := range expressions {
+		conditions, err := store.ParseTraceQuery(expr)
+		if err != nil {
+			return fmt.Errorf("invalid query: %w", err)
+		}
+		filter.Conditions = append(filter.Conditions, conditions...)
+	}
+	return nil
+}
+
+type savedQueryRequest struct {
+	Name        string `json:"name"`
+	Query       string `json:"query"`
+	Description string `json:"description"`
+}
+
+func savedQueryListAPIHandler(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if st == nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "store not configured"})
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			queries, err := st.ListSavedQueries()
+			if err != nil {
+				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+				return
+			}
+			if queries == nil {
+				queries = []store.SavedQuery{}
+			}
+			writeJSON(w, http.StatusOK, map[string]any{"items": queries, "fields": store.QueryFieldNames()})
+		case http.MethodPost:
+			var req savedQueryRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid json: " + err.Error()})
+				return
+			}
+			saved, err := st.SaveQuery(req.Name, req.Query, req.Description)
+			if err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+				return
+			}
+			writeJSON(w, http.StatusOK, saved)
+		default:
+			http.NotFound(w, r)
+		}
+	}
+}
+
+func savedQueryDetailAPIHandler(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if st == nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "store not configured"})
+			return
+		}
+		name := strings.TrimPrefix(pathClean(r.URL.Path), "/api/queries/")
+		if strings.TrimSpace(name) == "" || strings.Contains(name, "/") {
+			writeJSON(w, http.StatusNotFound, map[string]string{"error": "saved query not found"})
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			saved, err := st.GetSavedQuery(name)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					writeJSON(w, http.StatusNotFound, map[string]string{"error": "saved query not found"})
+					return
+				}
+				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+				return
+			}
+			writeJSON(w, http.StatusOK, saved)
+		case http.MethodDelete:
+			if err := st.DeleteSavedQuery(name); err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					writeJSON(w, http.StatusNotFound, map[string]string{"error": "saved query not found"})
+					return
+				}
+				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]bool{"ok": true})
+		default:
+			http.NotFound(w, r)
+		}
+	}
+}
+
+type datasetView struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	ExampleCount int       `json:"example_count"`
+	Version      int       `json:"version"`
+}
+
+type datasetExampleView struct {
+	TraceID        string          `json:"trace_id"`
+	Position       int             `json:"position"`
+	AddedAt        time.Time       `json:"added_at"`
+	SourceType     string          `json:"source_type,omitempty"`
+	SourceID       string          `json:"source_id,omitempty"`
+	Note           string          `json:"note,omitempty"`
+	ExpectedOutput string          `json:"expected_output,omitempty"`
+	Tags           []string        `json:"tags"`
+	InputOverride  json.RawMessage `json:"input_override,omitempty"`
+	Trace          traceListItem   `json:"trace"`
+}
+
+type datasetSnapshotView struct {
+	ID           string                         `json:"id"`
+	Version      int                            `json:"version"`
+	Note         string                         `json:"note,omitempty"`
+	CreatedBy    string                         `json:"created_by,omitempty"`
+	ExampleCount int                            `json:"example_count"`
+	CreatedAt    time.Time                      `json:"created_at"`
+	Examples     []store.DatasetSnapshotExample `json:"examples,omitempty"`
+}
+
+type datasetDetailResponse struct {
+	Dataset   datasetView           `json:"dataset"`
+	Examples  []datasetExampleView  `json:"examples"`
+	Snapshots []datasetSnapshotView `json:"snapshots"`
+}
+
+type datasetRequest struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+}
+
+// datasetAppendRequest 只能指定一种来源：trace_ids、session_id、trace_filter（与 /api/traces 相同的查询参数）或 finding。
+type datasetAppendRequest struct {
+	TraceIDs    []string              `json:"trace_ids"`
+	SessionID   string                `json:"session_id"`
+	TraceFilter string                `json:"trace_filter"`
+	Finding     *datasetFindingSource `json:"finding"`
+	Limit       int                   `json:"limit"`
+	Note        string                `json:"note"`
+}
+
+type datasetFindingSource struct {
+	Category string `json:"category"`
+	Severity string `json:"severity"`
+}
+
+type datasetAppendResponse struct {
+	Added      int         `json:"added"`
+	Skipped    int         `json:"skipped"`
+	SourceType string      `json:"source_type"`
+	SourceID   string      `json:"source_id,omitempty"`
+	Dataset    datasetView `json:"dataset"`
+}
+
+type datasetExampleRequest struct {
+	ExpectedOutput *string          `json:"expected_output"`
+	Tags           *[]string        `json:"tags"`
+	InputOverride  *json.RawMessage `json:"input_override"`
+	Note           *string          `json:"note"`
+}
+
+const (
+	defaultDatasetAppendLimit = 200
+	maxDatasetAppendLimit     = 1000
+)
+
+func datasetListAPIHandler(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if st == nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "store not configured"})
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			datasets, err := st.ListDatasets()
+			if err != nil {
+				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+				return
+			}
+			items := make([]datasetView, 0, len(datasets))
+			for _, record := range datasets {
+				items = append(items, datasetViewFromStore(record))
+			}
+			writeJSON(w, http.StatusOK, map[string]any{"items": items})
+		case http.MethodPost:
+			var req datasetRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid json: " + err.Error()})
+				return
+			}
+			var name, description string
+			if req.Name != nil {
+				name = *req.Name
+			}
+			if req.Description != nil {
+				description = *req.Description
+			}
+			record, err := st.CreateDataset(name, description)
+			if err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+				return
+			}
+			writeJSON(w, http.StatusCreated, datasetViewFromStore(record))
+		default:
+			http.NotFound(w, r)
+		}
+	}
+}
+
+func datasetDetailAPIHandler(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if st == nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "store not configured"})
+			return
+		}
+		parts := strings.Split(strings.Trim(strings.TrimPrefix(pathClean(r.URL.Path), "/api/datasets/"), "/"), "/")
+		if parts[0] == "" || len(parts) > 3 {
+			http.NotFound(w, r)
+			return
+		}
+		record, err := st.GetDataset(parts[0])
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				writeJSON(w, http.StatusNotFound, map[string]string{"error": "dataset not found"})
+				return
+			}
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		switch {
+		case len(parts) == 1:
+			handleDataset(w, r, st, record)
+		case parts[1] == "examples" && len(parts) == 2:
+			handleDatasetExamples(w, r, st, record)
+		case parts[1] == "examples":
+			handleDatasetExample(w, r, st, record, parts[2])
+		case parts[1] == "snapshots":
+			handleDatasetSnapshots(w, r, st, record, parts[2:])
+		default:
+			http.NotFound(w, r)
+		}
+	}
+}
+
+func handleDataset(w http.ResponseWriter, r *http.Request, st *store.Store, record store.DatasetRecord) {
+	switch r.Method {
+	case http.MethodGet:
+		examples, err := st.GetDatasetExamples(record.ID)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		snapshots, err := st.ListDatasetSnapshots(record.ID)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		resp := datasetDetailResponse{
+			Dataset:   datasetViewFromStore(record),
+			Examples:  make([]datasetExampleView, 0, len(examples)),
+			Snapshots: make([]datasetSnapshotView, 0, len(snapshots)),
+		}
+		for _, example := range examples {
+			resp.Examples = append(resp.Examples, datasetExampleViewFromStore(example))
+		}
+		for _, snapshot := range snapshots {
+			resp.Snapshots = append(resp.Snapshots, datasetSnapshotViewFromStore(snapshot))
+		}
+		writeJSON(w, http.StatusOK, resp)
+	case http.MethodPatch:
+		var req datasetRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid json: " + err.Error()})
+			return
+		}
+		updated, err := st.UpdateDataset(record.ID, req.Name, req.Description)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, datasetViewFromStore(updated))
+	case http.MethodDelete:
+		if err := st.DeleteDataset(record.ID); err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]bool{"ok": true})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func handleDatasetExamples(w http.ResponseWriter, r *http.Request, st *store.Store, record store.DatasetRecord) {
+	switch r.Method {
+	case http.MethodPost:
+		var req datasetAppendRequest
+		decoder := json.NewDecoder(r.Body)
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid json: " + err.Error()})
+			return
+		}
+		traceIDs, sourceType, sourceID, err := resolveDatasetSource(st, req)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		added, skipped, err := st.AppendDatasetExamples(record.ID, traceIDs, sourceType, sourceID, req.Note)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "trace not found"})
+				return
+			}
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		updated, err := st.GetDataset(record.ID)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, datasetAppendResponse{
+			Added:      added,
+			Skipped:    skipped,
+			SourceType: sourceType,
+			SourceID:   sourceID,
+			Dataset:    datasetViewFromStore(updated),
+		})
+	case http.MethodDelete:
+		var req struct {
+			TraceIDs []string `json:"trace_ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid json: " + err.Error()})
+			return
+		}
+		removed, err := st.RemoveDatasetExamples(record.ID, req.TraceIDs)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]int{"removed": removed})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func handleDatasetExample(w http.ResponseWriter, r *http.Request, st *store.Store, record store.DatasetRecord, traceID string) {
+	switch r.Method {
+	case http.MethodPatch:
+		var req datasetExampleRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid json: " + err.Error()})
+			return
+		}
+		example, err := st.UpdateDatasetExample(record.ID, traceID, store.DatasetExampleEdit{
+			ExpectedOutput: req.ExpectedOutput,
+			Tags:           req.Tags,
+			InputOverride:  req.InputOverride,
+			Note:           req.Note,
+			UpdatedBy:      monitorUsername(r),
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				writeJSON(w, http.StatusNotFound, map[string]string{"error": "dataset example not found"})
+				return
+			}
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, datasetExampleViewFromStore(example))
+	case http.MethodDelete:
+		removed, err := st.RemoveDatasetExamples(record.ID, []string{traceID})
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		if removed == 0 {
+			writeJSON(w, http.StatusNotFound, map[string]string{"error": "dataset example not found"})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]bool{"ok": true})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func handleDatasetSnapshots(w http.ResponseWriter, r *http.Request, st *store.Store, record store.DatasetRecord, rest []string) {
+	if len(rest) == 1 {
+		version, err := strconv.Atoi(rest[0])
+		if err != nil || r.Method != http.MethodGet {
+			http.NotFound(w, r)
+			return
+		}
+		snapshot, err := st.GetDatasetSnapshot(record.ID, version)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				writeJSON(w, http.StatusNotFound, map[string]string{"error": "dataset snapshot not found"})
+				return
+			}
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, datasetSnapshotViewFromStore(snapshot))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		snapshots, err := st.ListDatasetSnapshots(record.ID)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		items := make([]datasetSnapshotView, 0, len(snapshots))
+		for _, snapshot := range snapshots {
+			items = append(items, datasetSnapshotViewFromStore(snapshot))
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"items": items})
+	case http.MethodPost:
+		var req struct {
+			Note string `json:"note"`
+		}
+		if r.ContentLength != 0 {
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid json: " + err.Error()})
+				return
+			}
+		}
+		snapshot, err := st.CreateDatasetSnapshot(record.ID, req.Note, monitorUsername(r))
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusCreated, datasetSnapshotViewFromStore(snapshot))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// resolveDatasetSource 把追加请求的来源展开为 trace ID 列表，并给出记录在样例上的 source_type/source_id。
+func resolveDatasetSource(st *store.Store, req datasetAppendRequest) ([]string, string, string, error) {
+	sources := 0
+	for _, set := range []bool{len(req.TraceIDs) > 0, strings.TrimSpace(req.SessionID) != "", strings.TrimSpace(req.TraceFilter) != "", req.Finding != nil} {
+		if set {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return nil, "", "", fmt.Errorf("exactly one of trace_ids, session_id, trace_filter or finding is required")
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultDatasetAppendLimit
+	}
+	limit = min(limit, maxDatasetAppendLimit)
+
+	switch {
+	case len(req.TraceIDs) > 0:
+		return req.TraceIDs, "trace", "", nil
+	case strings.TrimSpace(req.SessionID) != "":
+		sessionID := strings.TrimSpace(req.SessionID)
+		entries, err := st.ListTracesBySession(sessionID)
+		if err != nil {
+			return nil, "", "", err
+		}
+		if len(entries) == 0 {
+			return nil, "", "", fmt.Errorf("session %q has no traces", sessionID)
+		}
+		traceIDs := make([]string, 0, len(entries))
+		for _, entry := range entries[:min(len(entries), limit)] {
+			traceIDs = append(traceIDs, entry.ID)
+		}
+		return traceIDs, "session", sessionID, nil
+	case strings.TrimSpace(req.TraceFilter) != "":
+		rawQuery := strings.TrimPrefix(strings.TrimSpace(req.TraceFilter), "?")
+		values, err := url.ParseQuery(rawQuery)
+		if err != nil {
+			return nil, "", "", fmt.Errorf("invalid trace_filter: %w", err)
+		}
+		filterReq := &http.Request{URL: &url.URL{RawQuery: values.Encode()}}
+		filter := parseListFilter(filterReq)
+		if err := applyTraceQuery(st, filterReq, &filter); err != nil {
+			return nil, "", "", err
+		}
+		page, err := st.ListPage(1, limit, filter)
+		if err != nil {
+			return nil, "", "", err
+		}
+		traceIDs := make([]string, 0, len(page.Items))
+		for _, entry := range page.Items {
+			traceIDs = append(traceIDs, entry.ID)
+		}
+		return traceIDs, "trace_filter", rawQuery, nil
+	default:
+		findings, err := st.ListAllFindings(store.FindingFilter{Category: req.Finding.Category, Severity: req.Finding.Severity}, limit)
+		if err != nil {
+			return nil, "", "", err
+		}
+		traceIDs := make([]string, 0, len(findings))
+		for _, finding := range findings {
+			traceIDs = append(traceIDs, finding.TraceID)
+		}
+		return traceIDs, "finding", strings.Trim(req.Finding.Category+":"+req.Finding.Severity, ":"), nil
+	}
+}
+
+func datasetViewFromStore(record store.DatasetRecord) datasetView {
+	return datasetView{
+		ID:           record.ID,
+		Name:         record.Name,
+		Description:  record.Description,
+		CreatedAt:    record.CreatedAt,
+		UpdatedAt:    record.UpdatedAt,
+		ExampleCount: record.ExampleCount,
+		Version:      record.Version,
+	}
+}
+
+func datasetExampleViewFromStore(record store.DatasetExampleRecord) datasetExampleView {
+	view := datasetExampleView{
+		TraceID:        record.TraceID,
+		Position:       record.Position,
+		AddedAt:        record.AddedAt,
+		SourceType:     record.SourceType,
+		SourceID:       record.SourceID,
+		Note:           record.Note,
+		ExpectedOutput: record.ExpectedOutput,
+		Tags:           record.Tags,
+		InputOverride:  record.InputOverride,
+		Trace:          traceListItemFromEntry(record.Trace),
+	}
+	if view.Tags == nil {
+		view.Tags = []string{}
+	}
+	return view
+}
+
+func datasetSnapshotViewFromStore(record store.DatasetSnapshotRecord) datasetSnapshotView {
+	return datasetSnapshotView{
+		ID:           record.ID,
+		Version:      record.Version,
+		Note:         record.Note,
+		CreatedBy:    record.CreatedBy,
+		ExampleCount: record.ExampleCount,
+		CreatedAt:    record.CreatedAt,
+		Examples:     record.Examples,
+	}
+}
+
+func buildSessionBreakdown(traces []traceListItem) sessionBreakdownView {
+	modelCounts := map[string]int{}
+	endpointCounts := map[string]int{}
+	failed := 0
+	for _, trace := range traces {
+		model := firstNonEmpty(trace.Model, "unknown-model")
+		endpoint := firstNonEmpty(trace.Endpoint, trace.Operation, trace.URL, "unknown-endpoint")
+		modelCounts[model]++
+		endpointCounts[endpoint]++
+		if trace.StatusCode < 200 || trace.StatusCode >= 300 {
+			failed++
+		}
+	}
+	return sessionBreakdownView{
+		Models:       sortSessionCounts(modelCounts),
 		Endpoints:    sortSessionCounts(endpointCounts),
 		FailedTraces: failed,
 	}
@@ -4242,8 +5554,22 @@ func timelineRoleLabel(role string) string {
 }
 
 func serveTraceDownload(w http.ResponseWriter, r *http.Request, absPath string) {
-	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filepath.Base(absPath)))
-	http.ServeFile(w, r, absPath)
+	if recordfile.CompressionFromPath(absPath) == "" {
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filepath.Base(absPath)))
+		http.ServeFile(w, r, absPath)
+		return
+	}
+	// 冷存储的 cassette 以解压后的 .http 下载，保证可直接用于 replay。
+	f, err := recordfile.Open(absPath)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer f.Close()
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", recordfile.PlainName(absPath)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.Copy(w, f)
 }
 
 func cachedTokens(entry store.LogEntry) int {
@@ -4253,6 +5579,21 @@ func cachedTokens(entry store.LogEntry) int {
 	return entry.Header.Usage.PromptTokenDetails.CachedTokens
 }
 
+func experimentsAPIHandler(experiments http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if experiments == nil {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "experiments are not enabled"})
+			return
+		}
+		experiments.ServeHTTP(w, r)
+	}
+}
+
+// WriteJSON 以 monitor 统一的格式写出 JSON 响应，供挂载到 monitor 的外部 handler（如 experiments）复用。
+func WriteJSON(w http.ResponseWriter, status int, payload interface{}) {
+	writeJSON(w, status, payload)
+}
+
 func writeJSON(w http.ResponseWriter, status int, payload interface{}) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(status)
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -20,7 +21,9 @@ import (
 	"github.com/kingfs/llm-tracelab/internal/channel"
 	"github.com/kingfs/llm-tracelab/internal/config"
 	"github.com/kingfs/llm-tracelab/internal/observeworker"
+	"github.com/kingfs/llm-tracelab/internal/proxy"
 	"github.com/kingfs/llm-tracelab/internal/router"
+	"github.com/kingfs/llm-tracelab/internal/sessionanalysis"
 	"github.com/kingfs/llm-tracelab/internal/store"
 	"github.com/kingfs/llm-tracelab/pkg/observe"
 	"github.com/kingfs/llm-tracelab/pkg/recordfile"
@@ -321,6 +324,116 @@ func TestSystemEventStatusAPIHandlers(t *testing.T) {
 	}
 }
 
+func TestNotificationAPIHandlersListAndRetry(t *testing.T) {
+	st, err := store.New(t.TempDir())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer st.Close()
+
+	delivered, err := st.EnqueueNotification(store.NotificationDelivery{Sink: "ops", Fingerprint: "router:gpt_5", EventJSON: []byte(`{"title":"Routing failed"}`)})
+	if err != nil {
+		t.Fatalf("EnqueueNotification(delivered) error = %v", err)
+	}
+	if err := st.MarkNotificationDelivered(delivered.ID, http.StatusOK); err != nil {
+		t.Fatalf("MarkNotificationDelivered() error = %v", err)
+	}
+	failed, err := st.EnqueueNotification(store.NotificationDelivery{Sink: "ops", Fingerprint: "parser:bad-json"})
+	if err != nil {
+		t.Fatalf("EnqueueNotification(failed) error = %v", err)
+	}
+	if err := st.MarkNotificationAttemptFailed(failed.ID, "sink returned status 500", http.StatusInternalServerError, time.Time{}); err != nil {
+		t.Fatalf("MarkNotificationAttemptFailed() error = %v", err)
+	}
+
+	rr := httptest.NewRecorder()
+	notificationListAPIHandler(st).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/notifications?sink=ops&status=failed", nil))
+	var listPayload struct {
+		Items []store.NotificationDelivery `json:"items"`
+		Total int                          `json:"total"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &listPayload); err != nil {
+		t.Fatalf("json.Unmarshal(list) error = %v, body=%s", err, rr.Body.String())
+	}
+	if listPayload.Total != 1 || listPayload.Items[0].ID != failed.ID || listPayload.Items[0].ResponseStatus != http.StatusInternalServerError {
+		t.Fatalf("list payload = %+v", listPayload)
+	}
+
+	rr = httptest.NewRecorder()
+	notificationDetailAPIHandler(st).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/notifications/"+strconv.FormatInt(delivered.ID, 10), nil))
+	if rr.Code != http.StatusOK || !strings.Contains(rr.Body.String(), `"event":{"title":"Routing failed"}`) {
+		t.Fatalf("detail status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+
+	rr = httptest.NewRecorder()
+	notificationDetailAPIHandler(st).ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/api/notifications/"+strconv.FormatInt(failed.ID, 10)+"/retry", nil))
+	var retried store.NotificationDelivery
+	if err := json.Unmarshal(rr.Body.Bytes(), &retried); err != nil || rr.Code != http.StatusOK || retried.Status != store.NotificationStatusPending {
+		t.Fatalf("retry status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+
+	rr = httptest.NewRecorder()
+	notificationDetailAPIHandler(st).ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/api/notifications/"+strconv.FormatInt(delivered.ID, 10)+"/retry", nil))
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("retry delivered status = %d, want 409", rr.Code)
+	}
+	rr = httptest.NewRecorder()
+	notificationDetailAPIHandler(st).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/notifications/999", nil))
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("missing status = %d, want 404", rr.Code)
+	}
+}
+
+func TestAlertAPIHandlersSaveEvaluateAndDelete(t *testing.T) {
+	st, err := store.New(t.TempDir())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer st.Close()
+
+	rr := httptest.NewRecorder()
+	alertListAPIHandler(st).ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/api/alerts", strings.NewReader(`{"id":"ci-budget","metric":"total_tokens","threshold":1000000,"window":"24h","token":"ci"}`)))
+	if rr.Code != http.StatusOK {
+		t.Fatalf("save status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+	var saved store.AlertRule
+	if err := json.Unmarshal(rr.Body.Bytes(), &saved); err != nil {
+		t.Fatalf("json.Unmarshal(save) error = %v", err)
+	}
+	if saved.WindowSeconds != 86400 || saved.Token != "ci" || saved.State != store.AlertStateOK || !saved.Enabled {
+		t.Fatalf("saved rule = %+v", saved)
+	}
+
+	rr = httptest.NewRecorder()
+	alertListAPIHandler(st).ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/api/alerts", strings.NewReader(`{"id":"bad","metric":"error_rate","threshold":5}`)))
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("invalid save status = %d, want 400", rr.Code)
+	}
+
+	rr = httptest.NewRecorder()
+	alertListAPIHandler(st).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/alerts", nil))
+	if rr.Code != http.StatusOK || !strings.Contains(rr.Body.String(), `"total":1`) {
+		t.Fatalf("list status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+
+	rr = httptest.NewRecorder()
+	alertDetailAPIHandler(st).ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/api/alerts/ci-budget/evaluate", nil))
+	if rr.Code != http.StatusOK || !strings.Contains(rr.Body.String(), `"breached":false`) {
+		t.Fatalf("evaluate status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+
+	rr = httptest.NewRecorder()
+	alertDetailAPIHandler(st).ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, "/api/alerts/ci-budget", nil))
+	if rr.Code != http.StatusOK {
+		t.Fatalf("delete status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+	rr = httptest.NewRecorder()
+	alertDetailAPIHandler(st).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/alerts/ci-budget", nil))
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("get deleted status = %d, want 404", rr.Code)
+	}
+}
+
 func TestSystemEventStreamAPIHandlerPushesUpdates(t *testing.T) {
 	st, err := store.New(t.TempDir())
 	if err != nil {
@@ -472,6 +585,168 @@ func TestListAPIHandlerDoesNotSyncFilesystem(t *testing.T) {
 	}
 }
 
+func TestListAPIHandlerAppliesQueryAndSavedQueries(t *testing.T) {
+	t.Parallel()
+
+	outputDir := t.TempDir()
+	chat := buildRecordFixture(t, "/v1/chat/completions", false, `{"messages":[{"role":"user","content":"hello"}]}`, `{"choices":[{"message":{"content":"done"}}]}`)
+	responses := buildRecordFixture(t, "/v1/responses", false, `{"input":"hello"}`, `{"output_text":"done"}`)
+	if err := os.WriteFile(filepath.Join(outputDir, "chat.http"), chat, 0o644); err != nil {
+		t.Fatalf("WriteFile(chat) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(outputDir, "responses.http"), responses, 0o644); err != nil {
+		t.Fatalf("WriteFile(responses) error = %v", err)
+	}
+
+	st, err := store.New(outputDir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+	syncStore(t, st)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/traces?query="+url.QueryEscape("endpoint:/v1/responses"), nil)
+	rr := httptest.NewRecorder()
+	listAPIHandler(st).ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200; body = %s", rr.Code, rr.Body.String())
+	}
+	var payload listResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &payload); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if len(payload.Items) != 1 || payload.Items[0].Endpoint != "/v1/responses" {
+		t.Fatalf("items = %+v, want only /v1/responses", payload.Items)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/traces?query="+url.QueryEscape("latency>fast"), nil)
+	rr = httptest.NewRecorder()
+	listAPIHandler(st).ServeHTTP(rr, req)
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("invalid query status = %d, want 400", rr.Code)
+	}
+
+	body := strings.NewReader(`{"name":"chat","query":"-endpoint:/v1/responses","description":"chat only"}`)
+	req = httptest.NewRequest(http.MethodPost, "/api/queries", body)
+	rr = httptest.NewRecorder()
+	savedQueryListAPIHandler(st).ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("save status = %d, want 200; body = %s", rr.Code, rr.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/traces?saved=chat", nil)
+	rr = httptest.NewRecorder()
+	listAPIHandler(st).ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("saved status = %d, want 200; body = %s", rr.Code, rr.Body.String())
+	}
+	payload = listResponse{}
+	if err := json.Unmarshal(rr.Body.Bytes(), &payload); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if len(payload.Items) != 1 || payload.Items[0].Endpoint != "/v1/chat/completions" {
+		t.Fatalf("items = %+v, want only /v1/chat/completions", payload.Items)
+	}
+
+	req = httptest.NewRequest(http.MethodDelete, "/api/queries/chat", nil)
+	rr = httptest.NewRecorder()
+	savedQueryDetailAPIHandler(st).ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("delete status = %d, want 200", rr.Code)
+	}
+	req = httptest.NewRequest(http.MethodGet, "/api/queries/chat", nil)
+	rr = httptest.NewRecorder()
+	savedQueryDetailAPIHandler(st).ServeHTTP(rr, req)
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("get deleted status = %d, want 404", rr.Code)
+	}
+}
+
+func TestTraceTagsAnnotationsAndLabelsAPI(t *testing.T) {
+	t.Parallel()
+
+	outputDir := t.TempDir()
+	chat := buildRecordFixture(t, "/v1/chat/completions", false, `{"messages":[{"role":"user","content":"hello"}]}`, `{"choices":[{"message":{"content":"done"}}]}`)
+	responses := buildRecordFixtureWithRequestHeaders(t, "/v1/responses", false, []string{"Session_id: sess-tagged"}, `{"input":"hello"}`, `{"output_text":"done"}`)
+	if err := os.WriteFile(filepath.Join(outputDir, "chat.http"), chat, 0o644); err != nil {
+		t.Fatalf("WriteFile(chat) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(outputDir, "responses.http"), responses, 0o644); err != nil {
+		t.Fatalf("WriteFile(responses) error = %v", err)
+	}
+	st, err := store.New(outputDir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+	syncStore(t, st)
+	listed, err := st.ListPage(1, 10, store.ListFilter{Endpoint: "/v1/chat/completions"})
+	if err != nil || len(listed.Items) != 1 {
+		t.Fatalf("ListPage() = %+v, err = %v", listed.Items, err)
+	}
+	chatID := listed.Items[0].ID
+
+	do := func(handler http.HandlerFunc, method string, target string, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req = req.WithContext(auth.WithPrincipal(req.Context(), auth.Principal{Username: "alice"}))
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	traces := traceAPIHandler(st, nil, nil, nil)
+	if rr := do(traces, http.MethodPost, "/api/traces/"+chatID+"/tags", `{"tags":["Good Example"]}`); rr.Code != http.StatusOK || !strings.Contains(rr.Body.String(), `"created_by":"alice"`) {
+		t.Fatalf("POST tags status = %d, body = %s", rr.Code, rr.Body.String())
+	}
+	if rr := do(sessionDetailAPIHandler(st, nil), http.MethodPost, "/api/sessions/sess-tagged/tags", `{"tags":["regression"]}`); rr.Code != http.StatusOK {
+		t.Fatalf("POST session tags status = %d, body = %s", rr.Code, rr.Body.String())
+	}
+
+	var payload listResponse
+	rr := do(listAPIHandler(st), http.MethodGet, "/api/traces?tag=good-example", "")
+	if err := json.Unmarshal(rr.Body.Bytes(), &payload); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if len(payload.Items) != 1 || payload.Items[0].ID != chatID || len(payload.Items[0].Tags) != 1 {
+		t.Fatalf("tag filter items = %+v, want tagged chat trace", payload.Items)
+	}
+	var sessions sessionListResponse
+	rr = do(sessionListAPIHandler(st), http.MethodGet, "/api/sessions?tag=regression", "")
+	if err := json.Unmarshal(rr.Body.Bytes(), &sessions); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if len(sessions.Items) != 1 || sessions.Items[0].SessionID != "sess-tagged" {
+		t.Fatalf("session tag filter items = %+v", sessions.Items)
+	}
+
+	rr = do(traces, http.MethodPost, "/api/traces/"+chatID+"/annotations", `{"body":"looks right"}`)
+	var note store.AnnotationRecord
+	if err := json.Unmarshal(rr.Body.Bytes(), &note); err != nil || note.Author != "alice" {
+		t.Fatalf("POST annotation = %s, err = %v", rr.Body.String(), err)
+	}
+	if rr := do(traces, http.MethodPost, "/api/traces/"+chatID+"/annotations", `{"body":"+1","parent_id":"`+note.ID+`"}`); rr.Code != http.StatusOK {
+		t.Fatalf("POST reply status = %d, body = %s", rr.Code, rr.Body.String())
+	}
+	req := httptest.NewRequest(http.MethodDelete, "/api/annotations/"+note.ID, nil)
+	req = req.WithContext(auth.WithPrincipal(req.Context(), auth.Principal{Username: "bob"}))
+	rr = httptest.NewRecorder()
+	annotationDetailAPIHandler(st).ServeHTTP(rr, req)
+	if rr.Code != http.StatusForbidden {
+		t.Fatalf("DELETE by other user status = %d, want 403", rr.Code)
+	}
+	if rr := do(annotationDetailAPIHandler(st), http.MethodDelete, "/api/annotations/"+note.ID, ""); rr.Code != http.StatusOK {
+		t.Fatalf("DELETE annotation status = %d", rr.Code)
+	}
+
+	rr = do(traces, http.MethodPut, "/api/traces/"+chatID+"/label", `{"label":"down","note":"wrong tool"}`)
+	if rr.Code != http.StatusOK || !strings.Contains(rr.Body.String(), `"down":1`) || !strings.Contains(rr.Body.String(), `"author":"alice"`) {
+		t.Fatalf("PUT label status = %d, body = %s", rr.Code, rr.Body.String())
+	}
+	if rr := do(traces, http.MethodPut, "/api/traces/"+chatID+"/label", `{"label":"sideways"}`); rr.Code != http.StatusBadRequest {
+		t.Fatalf("PUT invalid label status = %d, want 400", rr.Code)
+	}
+}
+
 func TestOverviewAPIHandlerReturnsDashboardFromIndexedData(t *testing.T) {
 	t.Parallel()
 
@@ -941,7 +1216,7 @@ func TestSessionDetailAPIHandlerReturnsTraceList(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/sessions/"+sessionID, nil)
 	rr := httptest.NewRecorder()
-	sessionDetailAPIHandler(st).ServeHTTP(rr, req)
+	sessionDetailAPIHandler(st, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, want 200", rr.Code)
 	}
@@ -1004,7 +1279,7 @@ func TestSessionDetailAPIHandlerReturnsBreakdownAndFailureCount(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/sessions/"+sessionID, nil)
 	rr := httptest.NewRecorder()
-	sessionDetailAPIHandler(st).ServeHTTP(rr, req)
+	sessionDetailAPIHandler(st, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, want 200", rr.Code)
 	}
@@ -1081,7 +1356,7 @@ func TestSessionAnalysisAPIHandlerReturnsAnalysisRuns(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/sessions/"+sessionID+"/analysis", nil)
 	rr := httptest.NewRecorder()
-	sessionDetailAPIHandler(st).ServeHTTP(rr, req)
+	sessionDetailAPIHandler(st, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, body=%s", rr.Code, rr.Body.String())
 	}
@@ -1095,7 +1370,7 @@ func TestSessionAnalysisAPIHandlerReturnsAnalysisRuns(t *testing.T) {
 
 	req = httptest.NewRequest(http.MethodGet, "/api/sessions/"+sessionID, nil)
 	rr = httptest.NewRecorder()
-	sessionDetailAPIHandler(st).ServeHTTP(rr, req)
+	sessionDetailAPIHandler(st, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("detail status = %d, body=%s", rr.Code, rr.Body.String())
 	}
@@ -1122,6 +1397,87 @@ func TestSessionAnalysisAPIHandlerReturnsAnalysisRuns(t *testing.T) {
 	}
 }
 
+func TestSessionTrajectoryAPIHandlerPairsToolCallsAcrossTraces(t *testing.T) {
+	t.Parallel()
+
+	outputDir := t.TempDir()
+	sessionID := "sess-agent"
+	headers := []string{"X-Codex-Window-Id: " + sessionID + ":0"}
+	start := time.Date(2026, 3, 27, 8, 0, 0, 0, time.UTC)
+	writeTraceFixture(t, outputDir, "step-1.http", buildRecordFixtureWithStatusHeadersAndMutator(t, "/v1/chat/completions", false, "200 OK", headers,
+		`{"model":"gpt-4o","messages":[{"role":"user","content":"fix the bug"}]}`,
+		`{"id":"chatcmpl-1","object":"chat.completion","choices":[{"index":0,"message":{"role":"assistant","tool_calls":[{"id":"call_1","type":"function","function":{"name":"read_file","arguments":"{\"path\":\"main.go\"}"}}]},"finish_reason":"tool_calls"}]}`,
+		func(header *recordfile.RecordHeader) {
+			header.Meta.Time = start
+			header.Meta.DurationMs = 1000
+			header.Meta.Provider, header.Meta.Operation, header.Meta.Endpoint = "openai_compatible", "chat.completions", "/v1/chat/completions"
+			header.Usage.PromptTokens = 100
+		}))
+	writeTraceFixture(t, outputDir, "step-2.http", buildRecordFixtureWithStatusHeadersAndMutator(t, "/v1/chat/completions", false, "200 OK", headers,
+		`{"model":"gpt-4o","messages":[{"role":"user","content":"fix the bug"},{"role":"assistant","tool_calls":[{"id":"call_1","type":"function","function":{"name":"read_file","arguments":"{}"}}]},{"role":"tool","tool_call_id":"call_1","content":"package main"}]}`,
+		`{"id":"chatcmpl-2","object":"chat.completion","choices":[{"index":0,"message":{"role":"assistant","content":"done"},"finish_reason":"stop"}]}`,
+		func(header *recordfile.RecordHeader) {
+			header.Meta.Time = start.Add(3 * time.Second)
+			header.Meta.DurationMs = 500
+			header.Meta.Provider, header.Meta.Operation, header.Meta.Endpoint = "openai_compatible", "chat.completions", "/v1/chat/completions"
+			header.Usage.PromptTokens = 160
+		}))
+
+	st, err := store.New(outputDir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+	syncStore(t, st)
+
+	rr := httptest.NewRecorder()
+	sessionDetailAPIHandler(st, nil).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/sessions/"+sessionID+"/trajectory", nil))
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+	var payload sessionanalysis.Trajectory
+	if err := json.Unmarshal(rr.Body.Bytes(), &payload); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if len(payload.Steps) != 2 || len(payload.Edges) != 1 || payload.AnsweredCalls != 1 {
+		t.Fatalf("trajectory = %+v", payload)
+	}
+	edge := payload.Edges[0]
+	if edge.From != 0 || edge.To != 1 || edge.CallID != "call_1" || edge.Tool != "read_file" || edge.LatencyMs != 2000 {
+		t.Fatalf("edge = %+v", edge)
+	}
+	if got := payload.ContextGrowth[1]; got.PromptTokens != 160 || got.Delta != 60 {
+		t.Fatalf("context growth = %+v", payload.ContextGrowth)
+	}
+
+	rr = httptest.NewRecorder()
+	sessionDetailAPIHandler(st, nil).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/sessions/"+sessionID+"/trajectory?format=dot", nil))
+	if rr.Code != http.StatusOK || !strings.HasPrefix(rr.Header().Get("Content-Type"), "text/vnd.graphviz") ||
+		!strings.Contains(rr.Body.String(), `s0 -> s1 [label="read_file (2000ms)", style=dashed]`) {
+		t.Fatalf("dot response = %d %q", rr.Code, rr.Body.String())
+	}
+
+	rr = httptest.NewRecorder()
+	sessionDetailAPIHandler(st, nil).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/sessions/missing/trajectory", nil))
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("missing session status = %d, want 404", rr.Code)
+	}
+
+	if err := st.SaveSessionFindings(sessionID, sessionanalysis.Detect(payload, sessionanalysis.DetectorOptions{MaxTurns: 1})); err != nil {
+		t.Fatalf("SaveSessionFindings() error = %v", err)
+	}
+	rr = httptest.NewRecorder()
+	sessionDetailAPIHandler(st, nil).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/sessions/"+sessionID+"/findings", nil))
+	var findings findingListResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &findings); err != nil {
+		t.Fatalf("json.Unmarshal(findings) error = %v", err)
+	}
+	if rr.Code != http.StatusOK || findings.Total != 1 || findings.Items[0].Category != sessionanalysis.CategoryTurnBudget ||
+		findings.Items[0].SessionID != sessionID || findings.Items[0].TraceID != payload.Steps[1].TraceID {
+		t.Fatalf("session findings = %d %+v", rr.Code, findings)
+	}
+}
+
 func TestUpstreamListAPIHandlerReturnsRouterSnapshots(t *testing.T) {
 	t.Parallel()
 
@@ -2169,7 +2525,7 @@ func TestTraceDetailAPIHandlerReturnsConversationData(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/traces/"+items[0].ID, nil)
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, nil).ServeHTTP(rr, req)
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, want 200", rr.Code)
 	}
@@ -2219,7 +2575,7 @@ func TestTraceDetailAPIHandlerReturnsSessionContext(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/traces/"+items[0].ID, nil)
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, nil).ServeHTTP(rr, req)
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, want 200", rr.Code)
 	}
@@ -2280,7 +2636,7 @@ func TestTracePerformanceAPIHandlerReturnsMetrics(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/traces/"+items[0].ID+"/performance", nil)
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, nil).ServeHTTP(rr, req)
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, body=%s", rr.Code, rr.Body.String())
 	}
@@ -2360,7 +2716,7 @@ func TestTraceDetailAPIHandlerIncludesSelectedUpstreamHealth(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/traces/"+items[0].ID, nil)
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, rtr).ServeHTTP(rr, req)
+	traceAPIHandler(st, rtr, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, want 200", rr.Code)
 	}
@@ -2408,7 +2764,7 @@ func TestTraceRawAPIHandlerReturnsProtocolAndMeta(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/traces/"+items[0].ID+"/raw", nil)
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, nil).ServeHTTP(rr, req)
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, want 200", rr.Code)
 	}
@@ -2470,7 +2826,7 @@ func TestTraceRawAPIHandlerReturnsEventAttributes(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/traces/"+items[0].ID+"/raw", nil)
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, nil).ServeHTTP(rr, req)
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, want 200", rr.Code)
 	}
@@ -2547,7 +2903,7 @@ func TestTraceDetailAPIHandlerFiltersNoisyDeltaEvents(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/traces/"+items[0].ID, nil)
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, nil).ServeHTTP(rr, req)
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, want 200", rr.Code)
 	}
@@ -2603,7 +2959,7 @@ func TestTraceDetailAPIHandlerEnrichesRequestAndResponseTimeline(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/traces/"+items[0].ID, nil)
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, nil).ServeHTTP(rr, req)
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, want 200", rr.Code)
 	}
@@ -2694,7 +3050,7 @@ func TestTraceDetailAPIHandlerSurfacesProviderErrorBlocks(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/traces/"+items[0].ID, nil)
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, nil).ServeHTTP(rr, req)
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, want 200", rr.Code)
 	}
@@ -2762,7 +3118,7 @@ func TestTraceDetailAPIHandlerSurfacesRefusalBlocks(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/traces/"+items[0].ID, nil)
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, nil).ServeHTTP(rr, req)
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, want 200", rr.Code)
 	}
@@ -2825,7 +3181,7 @@ func TestTraceDownloadAPIHandlerSetsAttachmentDisposition(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/traces/"+items[0].ID+"/download", nil)
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, nil).ServeHTTP(rr, req)
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, want 200", rr.Code)
 	}
@@ -2846,7 +3202,7 @@ func TestTraceAPIHandlerReturnsNotFoundForUnknownID(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/traces/missing/raw", nil)
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, nil).ServeHTTP(rr, req)
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusNotFound {
 		t.Fatalf("status = %d, want 404", rr.Code)
 	}
@@ -2877,7 +3233,7 @@ func TestTraceAPIHandlerReanalysisActions(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodPost, "/api/traces/"+entry.ID+"/reanalyze", bytes.NewBufferString(`{}`))
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, nil).ServeHTTP(rr, req)
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, body=%s", rr.Code, rr.Body.String())
 	}
@@ -2918,7 +3274,7 @@ func TestAnalysisJobAPIHandlerListsDetailsAndCancels(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodPost, "/api/traces/"+entry.ID+"/reparse", bytes.NewBufferString(`{"mode":"async","scan":true}`))
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, nil).ServeHTTP(rr, req)
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusAccepted {
 		t.Fatalf("status = %d, body=%s", rr.Code, rr.Body.String())
 	}
@@ -2989,7 +3345,7 @@ func TestAnalysisBatchReanalyzeAPIHandlerCreatesBatchJob(t *testing.T) {
 	body := `{"mode":"sync","model":"gpt-5.1","endpoint":"responses","limit":10,"reparse":true,"scan":true}`
 	req := httptest.NewRequest(http.MethodPost, "/api/analysis/batch/reanalyze", bytes.NewBufferString(body))
 	rr := httptest.NewRecorder()
-	analysisBatchReanalyzeAPIHandler(st).ServeHTTP(rr, req)
+	analysisBatchReanalyzeAPIHandler(st, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, body=%s", rr.Code, rr.Body.String())
 	}
@@ -3119,7 +3475,7 @@ func TestTraceObservationAPIHandlerReturnsObservationTree(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/traces/"+entry.ID+"/observation", nil)
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, nil).ServeHTTP(rr, req)
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, body=%s", rr.Code, rr.Body.String())
 	}
@@ -3174,7 +3530,7 @@ func TestTraceObservationAPIHandlerReturnsNotFoundBeforeReparse(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/traces/"+entry.ID+"/observation", nil)
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, nil).ServeHTTP(rr, req)
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusNotFound {
 		t.Fatalf("status = %d, want 404 body=%s", rr.Code, rr.Body.String())
 	}
@@ -3221,7 +3577,7 @@ func TestTraceObservationAPIEndToEndAfterWorkerReparse(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/traces/"+entry.ID+"/observation", nil)
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, nil).ServeHTTP(rr, req)
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, body=%s", rr.Code, rr.Body.String())
 	}
@@ -3247,6 +3603,336 @@ func TestTraceObservationAPIEndToEndAfterWorkerReparse(t *testing.T) {
 	}
 }
 
+func TestTraceDiffAPIComparesObservations(t *testing.T) {
+	outputDir := t.TempDir()
+	st, err := store.New(outputDir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	writeChat := func(name string, requestID string, question string, args string, finishReason string) string {
+		t.Helper()
+		reqBody := `{"model":"gpt-4o","messages":[{"role":"system","content":"Be brief."},{"role":"user","content":"` + question + `"}],"tools":[{"type":"function","function":{"name":"search","parameters":{"type":"object"}}}]}`
+		resBody := `{"id":"chatcmpl-1","object":"chat.completion","model":"gpt-4o","choices":[{"index":0,"finish_reason":"` + finishReason + `","message":{"role":"assistant","content":null,"tool_calls":[{"id":"call_1","type":"function","function":{"name":"search","arguments":"` + args + `"}}]}}],"usage":{"prompt_tokens":10,"completion_tokens":4,"total_tokens":14}}`
+		header := buildRecordHeader("/v1/chat/completions", false, reqBody, resBody)
+		header.Meta.RequestID = requestID
+		header.Meta.Provider = "openai_compatible"
+		header.Meta.Operation = "chat.completions"
+		header.Meta.Endpoint = "/v1/chat/completions"
+		header.Meta.Model = "gpt-4o"
+		prelude, err := recordfile.MarshalPrelude(header, recordfile.BuildEvents(header))
+		if err != nil {
+			t.Fatalf("MarshalPrelude() error = %v", err)
+		}
+		reqHead := "POST /v1/chat/completions HTTP/1.1\r\nHost: example.com\r\nContent-Type: application/json\r\n\r\n"
+		resHead := "HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n"
+		logPath := filepath.Join(outputDir, name)
+		if err := os.WriteFile(logPath, []byte(string(prelude)+reqHead+reqBody+"\n"+resHead+resBody), 0o644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+		if err := st.UpsertLog(logPath, header); err != nil {
+			t.Fatalf("UpsertLog() error = %v", err)
+		}
+		entry, err := st.GetByRequestID(requestID)
+		if err != nil {
+			t.Fatalf("GetByRequestID() error = %v", err)
+		}
+		return entry.ID
+	}
+	aID := writeChat("diff-a.http", "req-diff-a", "weather in Paris", `{\"q\":\"paris\"}`, "tool_calls")
+	bID := writeChat("diff-b.http", "req-diff-b", "weather in Rome", `{\"q\":\"rome\"}`, "tool_calls")
+
+	rr := httptest.NewRecorder()
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/traces/"+aID+"/diff/"+bID, nil))
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+	var payload observe.TraceDiff
+	if err := json.Unmarshal(rr.Body.Bytes(), &payload); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if payload.Identical || payload.A.TraceID != aID || payload.B.TraceID != bID {
+		t.Fatalf("diff = %+v", payload)
+	}
+	changed := map[string]bool{}
+	for _, section := range payload.Sections {
+		changed[section.Name] = section.Changed
+		if section.Name == observe.DiffSectionToolCalls {
+			if len(section.Items) != 1 || len(section.Items[0].Changes) != 1 || section.Items[0].Changes[0].Path != "args.q" {
+				t.Fatalf("tool calls = %+v", section.Items)
+			}
+		}
+	}
+	if !changed[observe.DiffSectionMessages] || !changed[observe.DiffSectionToolCalls] || changed[observe.DiffSectionInstructions] || changed[observe.DiffSectionToolDeclarations] || changed[observe.DiffSectionFinishReasons] || changed[observe.DiffSectionUsage] {
+		t.Fatalf("changed sections = %+v", changed)
+	}
+
+	rr = httptest.NewRecorder()
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/traces/"+aID+"/diff/missing", nil))
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("missing status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+}
+
+func TestTraceRerunAPIReplaysThroughProxy(t *testing.T) {
+	outputDir := t.TempDir()
+	st, err := store.New(outputDir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	var upstreamBodies []string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		upstreamBodies = append(upstreamBodies, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"id":"chatcmpl-1","object":"chat.completion","model":"gpt-4o","choices":[{"index":0,"finish_reason":"stop","message":{"role":"assistant","content":"ok"}}],"usage":{"prompt_tokens":3,"completion_tokens":1,"total_tokens":4}}`)
+	}))
+	defer upstream.Close()
+
+	enabled := true
+	cfg := &config.Config{Upstreams: []config.UpstreamTargetConfig{{
+		ID:             "primary",
+		Enabled:        &enabled,
+		Priority:       100,
+		ModelDiscovery: router.ModelDiscoveryStaticOnly,
+		StaticModels:   []string{"gpt-4o"},
+		Upstream:       config.UpstreamConfig{BaseURL: upstream.URL + "/v1", ProviderPreset: "openai"},
+	}}}
+	cfg.Router.Selection.Policy = router.PolicyFirstAvailable
+	cfg.Debug.OutputDir = outputDir
+	proxyHandler, err := proxy.NewHandler(cfg, st)
+	if err != nil {
+		t.Fatalf("proxy.NewHandler() error = %v", err)
+	}
+	original := httptest.NewRequest(http.MethodPost, "/v1/chat/completions", strings.NewReader(`{"model":"gpt-4o","messages":[{"role":"user","content":"hello"}]}`))
+	original.Header.Set("Content-Type", "application/json")
+	proxyHandler.ServeHTTP(httptest.NewRecorder(), original)
+	entries, err := st.ListRecent(10)
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("ListRecent() = %+v, err = %v", entries, err)
+	}
+	originalID := entries[0].ID
+
+	rr := httptest.NewRecorder()
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/api/traces/"+originalID+"/rerun", strings.NewReader(`{}`)))
+	if rr.Code != http.StatusServiceUnavailable {
+		t.Fatalf("rerun without proxy status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+
+	rtr, err := router.New(cfg, nil)
+	if err != nil {
+		t.Fatalf("router.New() error = %v", err)
+	}
+	handler := traceAPIHandler(st, rtr, proxyHandler, nil)
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/api/traces/"+originalID+"/rerun", strings.NewReader(`{"channel":"missing"}`)))
+	if rr.Code != http.StatusBadRequest || !strings.Contains(rr.Body.String(), `"channels":["primary"]`) || len(upstreamBodies) != 1 {
+		t.Fatalf("rerun unknown channel status = %d, body=%s, upstream=%d", rr.Code, rr.Body.String(), len(upstreamBodies))
+	}
+
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/api/traces/"+originalID+"/rerun", strings.NewReader(`{"messages":[{"index":0,"text":"hello again"}],"channel":"primary"}`)))
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("rerun status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+	var created struct {
+		TraceID string `json:"trace_id"`
+		RerunOf string `json:"rerun_of"`
+		Compare string `json:"compare"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &created); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if created.TraceID == "" || created.RerunOf != originalID || created.Compare != "/api/traces/"+originalID+"/diff/"+created.TraceID {
+		t.Fatalf("rerun response = %s", rr.Body.String())
+	}
+	if len(upstreamBodies) != 2 || !strings.Contains(upstreamBodies[1], `"content":"hello again"`) {
+		t.Fatalf("upstream bodies = %v", upstreamBodies)
+	}
+
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/traces/"+originalID+"/rerun", nil))
+	if rr.Code != http.StatusOK {
+		t.Fatalf("rerun detail status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+	var detail struct {
+		Draft struct {
+			Model    string `json:"model"`
+			Editable bool   `json:"editable"`
+		} `json:"draft"`
+		Reruns  []store.TraceRerun `json:"reruns"`
+		Enabled bool               `json:"enabled"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &detail); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !detail.Enabled || !detail.Draft.Editable || detail.Draft.Model != "gpt-4o" || len(detail.Reruns) != 1 || detail.Reruns[0].TraceID != created.TraceID {
+		t.Fatalf("rerun detail = %s", rr.Body.String())
+	}
+
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/traces/"+created.TraceID+"/rerun", nil))
+	if rr.Code != http.StatusOK || !strings.Contains(rr.Body.String(), `"original_trace_id":"`+originalID+`"`) {
+		t.Fatalf("rerun-of detail status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+}
+
+func TestDatasetAPIBuildsCuratedRegressionSet(t *testing.T) {
+	outputDir := t.TempDir()
+	st, err := store.New(outputDir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	writeTrace := func(requestID string, model string, sessionID string) string {
+		t.Helper()
+		logPath := filepath.Join(outputDir, requestID+".http")
+		if err := os.WriteFile(logPath, []byte("payload"), 0o644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+		header := recordfile.RecordHeader{
+			Version: "LLM_PROXY_V3",
+			Meta: recordfile.MetaData{
+				RequestID:  requestID,
+				Time:       time.Date(2026, 5, 13, 10, 0, 0, 0, time.UTC),
+				Model:      model,
+				Provider:   "openai_compatible",
+				Endpoint:   "/v1/chat/completions",
+				URL:        "/v1/chat/completions",
+				Method:     "POST",
+				StatusCode: 200,
+			},
+		}
+		if err := st.UpsertLogWithGrouping(logPath, header, store.GroupingInfo{SessionID: sessionID, SessionSource: "header"}); err != nil {
+			t.Fatalf("UpsertLogWithGrouping() error = %v", err)
+		}
+		entry, err := st.GetByRequestID(requestID)
+		if err != nil {
+			t.Fatalf("GetByRequestID() error = %v", err)
+		}
+		return entry.ID
+	}
+	traceA := writeTrace("req-a", "gpt-4o", "sess-1")
+	traceB := writeTrace("req-b", "gpt-4o", "sess-1")
+	traceC := writeTrace("req-c", "claude-sonnet", "")
+	if err := st.SaveFindings(traceC, []observe.Finding{{
+		ID:              "finding-c",
+		TraceID:         traceC,
+		Category:        "credential_leak",
+		Severity:        observe.SeverityHigh,
+		Title:           "Credential exposure",
+		Detector:        "credential",
+		DetectorVersion: "0.1.0",
+	}}); err != nil {
+		t.Fatalf("SaveFindings() error = %v", err)
+	}
+
+	list := datasetListAPIHandler(st)
+	detail := datasetDetailAPIHandler(st)
+	do := func(handler http.Handler, method string, target string, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(method, target, strings.NewReader(body)))
+		return rr
+	}
+
+	rr := do(list, http.MethodPost, "/api/datasets", `{"name":"regressions","description":"prod failures"}`)
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("create status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+	var created datasetView
+	if err := json.Unmarshal(rr.Body.Bytes(), &created); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	base := "/api/datasets/" + created.ID
+
+	var appended datasetAppendResponse
+	for _, tc := range []struct {
+		body       string
+		added      int
+		sourceType string
+	}{
+		{body: `{"session_id":"sess-1"}`, added: 2, sourceType: "session"},
+		{body: `{"trace_filter":"model=gpt-4o"}`, added: 0, sourceType: "trace_filter"},
+		{body: `{"finding":{"category":"credential_leak"},"note":"leak"}`, added: 1, sourceType: "finding"},
+	} {
+		rr = do(detail, http.MethodPost, base+"/examples", tc.body)
+		if rr.Code != http.StatusOK {
+			t.Fatalf("append %s status = %d, body=%s", tc.body, rr.Code, rr.Body.String())
+		}
+		if err := json.Unmarshal(rr.Body.Bytes(), &appended); err != nil {
+			t.Fatalf("json.Unmarshal() error = %v", err)
+		}
+		if appended.Added != tc.added || appended.SourceType != tc.sourceType {
+			t.Fatalf("append %s = %+v", tc.body, appended)
+		}
+	}
+	if appended.Dataset.ExampleCount != 3 {
+		t.Fatalf("example count = %d, want 3", appended.Dataset.ExampleCount)
+	}
+	if rr = do(detail, http.MethodPost, base+"/examples", `{"session_id":"sess-1","trace_ids":["x"]}`); rr.Code != http.StatusBadRequest {
+		t.Fatalf("ambiguous source status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+
+	rr = do(detail, http.MethodPatch, base+"/examples/"+traceA, `{"expected_output":"42","tags":["Golden"],"input_override":{"temperature":0}}`)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("edit status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+	if rr = do(detail, http.MethodPatch, base+"/examples/missing", `{"note":"x"}`); rr.Code != http.StatusNotFound {
+		t.Fatalf("edit missing status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+
+	rr = do(detail, http.MethodPost, base+"/snapshots", `{"note":"v1"}`)
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("snapshot status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+	if rr = do(detail, http.MethodDelete, base+"/examples/"+traceB, ""); rr.Code != http.StatusOK {
+		t.Fatalf("remove status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+
+	rr = do(detail, http.MethodGet, base, "")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("detail status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+	var got datasetDetailResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if got.Dataset.Version != 1 || len(got.Examples) != 2 || len(got.Snapshots) != 1 {
+		t.Fatalf("detail = %+v", got)
+	}
+	first := got.Examples[0]
+	if first.TraceID != traceA || first.ExpectedOutput != "42" || len(first.Tags) != 1 || first.Tags[0] != "golden" || string(first.InputOverride) != `{"temperature":0}` || first.SourceType != "session" || first.Trace.Model != "gpt-4o" {
+		t.Fatalf("first example = %+v", first)
+	}
+	if got.Examples[1].TraceID != traceC || got.Examples[1].Note != "leak" || got.Examples[1].SourceID != "credential_leak" {
+		t.Fatalf("finding example = %+v", got.Examples[1])
+	}
+
+	rr = do(detail, http.MethodGet, base+"/snapshots/1", "")
+	var snapshot datasetSnapshotView
+	if err := json.Unmarshal(rr.Body.Bytes(), &snapshot); err != nil || rr.Code != http.StatusOK {
+		t.Fatalf("snapshot status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+	if snapshot.ExampleCount != 3 || len(snapshot.Examples) != 3 || snapshot.Note != "v1" {
+		t.Fatalf("snapshot = %+v", snapshot)
+	}
+
+	if rr = do(detail, http.MethodPatch, base, `{"name":"renamed"}`); rr.Code != http.StatusOK || !strings.Contains(rr.Body.String(), `"name":"renamed"`) {
+		t.Fatalf("rename status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+	if rr = do(detail, http.MethodDelete, base, ""); rr.Code != http.StatusOK {
+		t.Fatalf("delete status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+	if rr = do(detail, http.MethodGet, base, ""); rr.Code != http.StatusNotFound {
+		t.Fatalf("deleted detail status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+}
+
 func TestTraceFindingsAPIHandlerReturnsFilteredFindings(t *testing.T) {
 	outputDir := t.TempDir()
 	st, err := store.New(outputDir)
@@ -3310,7 +3996,7 @@ func TestTraceFindingsAPIHandlerReturnsFilteredFindings(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/api/traces/"+entry.ID+"/findings?category=credential_leak", nil)
 	rr := httptest.NewRecorder()
-	traceAPIHandler(st, nil).ServeHTTP(rr, req)
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
 	if rr.Code != http.StatusOK {
 		t.Fatalf("status = %d, body=%s", rr.Code, rr.Body.String())
 	}
@@ -3337,6 +4023,134 @@ func TestTraceFindingsAPIHandlerReturnsFilteredFindings(t *testing.T) {
 	}
 }
 
+func TestFindingTriageAPIUpdatesStatusAndSuppresses(t *testing.T) {
+	outputDir := t.TempDir()
+	st, err := store.New(outputDir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	logPath := filepath.Join(outputDir, "trace-triage.http")
+	if err := os.WriteFile(logPath, []byte("payload"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	header := recordfile.RecordHeader{
+		Version: "LLM_PROXY_V3",
+		Meta: recordfile.MetaData{
+			RequestID:  "req-triage",
+			Time:       time.Date(2026, 5, 13, 10, 0, 0, 0, time.UTC),
+			Model:      "gpt-5.1",
+			Endpoint:   "/v1/responses",
+			URL:        "/v1/responses",
+			Method:     "POST",
+			StatusCode: 200,
+		},
+	}
+	if err := st.UpsertLog(logPath, header); err != nil {
+		t.Fatalf("UpsertLog() error = %v", err)
+	}
+	entry, err := st.GetByRequestID("req-triage")
+	if err != nil {
+		t.Fatalf("GetByRequestID() error = %v", err)
+	}
+	if err := st.SaveFindings(entry.ID, []observe.Finding{{
+		ID:              "finding-danger",
+		Category:        "dangerous_command",
+		Severity:        observe.SeverityHigh,
+		EvidenceExcerpt: "rm -rf /tmp/build",
+		Detector:        "dangerous_shell",
+		DetectorVersion: "0.1.0",
+	}, {
+		ID:              "finding-key",
+		Category:        "credential_leak",
+		Severity:        observe.SeverityHigh,
+		EvidenceExcerpt: "sk-test-0000",
+		Detector:        "credential",
+		DetectorVersion: "0.1.0",
+	}}); err != nil {
+		t.Fatalf("SaveFindings() error = %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/traces/"+entry.ID+"/findings/finding-danger", strings.NewReader(`{"status":"resolved","note":"sandboxed"}`))
+	req = req.WithContext(auth.WithPrincipal(req.Context(), auth.Principal{Username: "alice"}))
+	rr := httptest.NewRecorder()
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("patch status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+	var updated findingView
+	if err := json.Unmarshal(rr.Body.Bytes(), &updated); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if updated.Status != store.FindingStatusResolved {
+		t.Fatalf("updated = %+v", updated)
+	}
+	req = httptest.NewRequest(http.MethodPatch, "/api/traces/"+entry.ID+"/findings/finding-danger", strings.NewReader(`{"status":"done"}`))
+	rr = httptest.NewRecorder()
+	traceAPIHandler(st, nil, nil, nil).ServeHTTP(rr, req)
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("invalid status code = %d, want 400", rr.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/api/findings/suppressions", strings.NewReader(`{"category":"credential_leak","evidence_pattern":"sk-test-","reason":"fixture key"}`))
+	req = req.WithContext(auth.WithPrincipal(req.Context(), auth.Principal{Username: "bob"}))
+	rr = httptest.NewRecorder()
+	findingDetailAPIHandler(st).ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("suppression status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+	var created struct {
+		Suppression store.FindingSuppression `json:"suppression"`
+		Suppressed  int                      `json:"suppressed"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &created); err != nil {
+		t.Fatalf("json.Unmarshal(suppression) error = %v", err)
+	}
+	if created.Suppressed != 1 || created.Suppression.CreatedBy != "bob" {
+		t.Fatalf("created = %+v", created)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/findings?status=active", nil)
+	rr = httptest.NewRecorder()
+	findingListAPIHandler(st).ServeHTTP(rr, req)
+	var active findingListResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &active); err != nil {
+		t.Fatalf("json.Unmarshal(active) error = %v", err)
+	}
+	if active.Total != 0 || active.Status != store.FindingStatusActive {
+		t.Fatalf("active findings = %+v", active)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/findings/audit?trace_id="+entry.ID, nil)
+	rr = httptest.NewRecorder()
+	findingDetailAPIHandler(st).ServeHTTP(rr, req)
+	var audit struct {
+		Items []store.FindingAuditRecord `json:"items"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &audit); err != nil {
+		t.Fatalf("json.Unmarshal(audit) error = %v", err)
+	}
+	if len(audit.Items) != 2 || audit.Items[0].Actor != "bob" || audit.Items[0].Action != store.FindingAuditSuppressed ||
+		audit.Items[1].Actor != "alice" || audit.Items[1].Note != "sandboxed" {
+		t.Fatalf("audit = %+v", audit.Items)
+	}
+
+	req = httptest.NewRequest(http.MethodDelete, "/api/findings/suppressions/"+created.Suppression.ID, nil)
+	rr = httptest.NewRecorder()
+	findingDetailAPIHandler(st).ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("delete status = %d, body=%s", rr.Code, rr.Body.String())
+	}
+	reopened, err := st.ListFindings(entry.ID, store.FindingFilter{Status: store.FindingStatusOpen})
+	if err != nil {
+		t.Fatalf("ListFindings() error = %v", err)
+	}
+	if len(reopened) != 1 || reopened[0].ID != "finding-key" {
+		t.Fatalf("reopened = %+v", reopened)
+	}
+}
+
 func TestTraceRawAPIHandlerReturnsFileNotFoundError(t *testing.T) {
 	t.Parallel()
 
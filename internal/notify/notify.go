@@ -0,0 +1,403 @@
+package notify
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/glob"
+	"github.com/kingfs/llm-tracelab/internal/store"
+)
+
+const (
+	SinkWebhook = "webhook"
+	SinkSlack   = "slack"
+	SinkEmail   = "email"
+
+	defaultInterval    = 10 * time.Second
+	defaultMaxAttempts = 5
+	defaultCooldown    = 10 * time.Minute
+	retryBaseDelay     = 30 * time.Second
+	retryMaxDelay      = 30 * time.Minute
+	deliverBatchSize   = 20
+	eventBuffer        = 1024
+	// catchUpOverlap 让补扫窗口向前多覆盖一段时间；重复入队会被 fingerprint 去重挡住。
+	catchUpOverlap = time.Minute
+)
+
+// Event 是推送给通知渠道的系统事件快照，同时作为 outbox 中的 event_json。
+type Event struct {
+	ID              string          `json:"id"`
+	Fingerprint     string          `json:"fingerprint"`
+	Source          string          `json:"source"`
+	Category        string          `json:"category"`
+	Severity        string          `json:"severity"`
+	Status          string          `json:"status"`
+	Title           string          `json:"title"`
+	Message         string          `json:"message,omitempty"`
+	TraceID         string          `json:"trace_id,omitempty"`
+	SessionID       string          `json:"session_id,omitempty"`
+	UpstreamID      string          `json:"upstream_id,omitempty"`
+	Model           string          `json:"model,omitempty"`
+	OccurrenceCount int             `json:"occurrence_count"`
+	FirstSeenAt     time.Time       `json:"first_seen_at"`
+	LastSeenAt      time.Time       `json:"last_seen_at"`
+	Details         json.RawMessage `json:"details,omitempty"`
+}
+
+func eventFromStore(event store.SystemEvent) Event {
+	out := Event{
+		ID:              event.ID,
+		Fingerprint:     event.Fingerprint,
+		Source:          event.Source,
+		Category:        event.Category,
+		Severity:        event.Severity,
+		Status:          event.Status,
+		Title:           event.Title,
+		Message:         event.Message,
+		TraceID:         event.TraceID,
+		SessionID:       event.SessionID,
+		UpstreamID:      event.UpstreamID,
+		Model:           event.Model,
+		OccurrenceCount: event.OccurrenceCount,
+		FirstSeenAt:     event.FirstSeenAt,
+		LastSeenAt:      event.LastSeenAt,
+	}
+	if details := strings.TrimSpace(string(event.DetailsJSON)); details != "" && details != "{}" && json.Valid(event.DetailsJSON) {
+		out.Details = event.DetailsJSON
+	}
+	return out
+}
+
+// sender 把一条事件投递到具体渠道，返回渠道的响应状态码（SMTP 为 0）。
+type sender interface {
+	send(ctx context.Context, event Event) (int, error)
+}
+
+type sink struct {
+	name     string
+	filter   config.NotificationFilter
+	cooldown time.Duration
+	sender   sender
+}
+
+// Notifier 订阅系统事件，按 sink 过滤、去重后写入 outbox，并在后台投递与重试。
+type Notifier struct {
+	store       *store.Store
+	sinks       []*sink
+	interval    time.Duration
+	maxAttempts int
+	now         func() time.Time
+}
+
+// New 校验通知配置并构建各 sink；未配置 sink 时返回错误。
+func New(st *store.Store, cfg config.NotificationsConfig) (*Notifier, error) {
+	if len(cfg.Sinks) == 0 {
+		return nil, fmt.Errorf("notifications.sinks is empty")
+	}
+	n := &Notifier{
+		store:       st,
+		interval:    cfg.Interval,
+		maxAttempts: cfg.MaxAttempts,
+		now:         time.Now,
+	}
+	if n.interval <= 0 {
+		n.interval = defaultInterval
+	}
+	if n.maxAttempts <= 0 {
+		n.maxAttempts = defaultMaxAttempts
+	}
+	client := &http.Client{Timeout: 10 * time.Second}
+	seen := map[string]bool{}
+	for i, item := range cfg.Sinks {
+		name := strings.TrimSpace(item.Name)
+		if name == "" {
+			return nil, fmt.Errorf("notifications.sinks[%d]: name is required", i)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("notifications.sinks[%d]: duplicate name %q", i, name)
+		}
+		seen[name] = true
+		built, err := newSender(item, client)
+		if err != nil {
+			return nil, fmt.Errorf("notifications.sinks[%d] %s: %w", i, name, err)
+		}
+		for _, patterns := range [][]string{item.Filter.Categories, item.Filter.Severities, item.Filter.Models, item.Filter.Upstreams} {
+			if err := glob.Validate(patterns); err != nil {
+				return nil, fmt.Errorf("notifications.sinks[%d] %s: filter: %w", i, name, err)
+			}
+		}
+		cooldown := item.Cooldown
+		if cooldown <= 0 {
+			cooldown = defaultCooldown
+		}
+		n.sinks = append(n.sinks, &sink{name: name, filter: item.Filter, cooldown: cooldown, sender: built})
+	}
+	return n, nil
+}
+
+func newSender(item config.NotificationSink, client *http.Client) (sender, error) {
+	switch strings.ToLower(strings.TrimSpace(item.Type)) {
+	case SinkWebhook:
+		if strings.TrimSpace(item.URL) == "" {
+			return nil, fmt.Errorf("webhook requires url")
+		}
+		return &webhookSender{url: item.URL, secret: item.Secret, headers: item.Headers, client: client}, nil
+	case SinkSlack:
+		if strings.TrimSpace(item.URL) == "" {
+			return nil, fmt.Errorf("slack requires url")
+		}
+		return &slackSender{url: item.URL, client: client}, nil
+	case SinkEmail:
+		return newEmailSender(item.SMTP)
+	case "":
+		return nil, fmt.Errorf("type is required")
+	default:
+		return nil, fmt.Errorf("unknown sink type %q", item.Type)
+	}
+}
+
+// Run 订阅系统事件并在后台投递 outbox，直到 ctx 结束。入队与投递分属两个 goroutine，慢速渠道不会拖住事件订阅。
+// 启动时先补发重启前未完成的投递，并补入上次入队之后仍未读的事件；订阅通知被丢弃（序号不连续）时同样从数据库补扫。
+func (n *Notifier) Run(ctx context.Context) {
+	if n == nil || n.store == nil {
+		return
+	}
+	events, unsubscribe := n.store.SubscribeSystemEvents(eventBuffer)
+	defer unsubscribe()
+	ctx, cancel := context.WithCancel(ctx)
+	kick := make(chan struct{}, 1)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		n.deliverLoop(ctx, kick)
+	}()
+	defer wg.Wait()
+	defer cancel()
+
+	if since, err := n.store.LatestNotificationAt(); err != nil {
+		slog.Warn("Load last notification time failed", "error", err)
+	} else if !since.IsZero() {
+		n.catchUp(since, kick)
+	}
+	var (
+		lastSeq uint64
+		lastAt  time.Time
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case notification, ok := <-events:
+			if !ok {
+				return
+			}
+			if lastSeq != 0 && notification.Sequence > lastSeq+1 {
+				n.catchUp(lastAt, kick)
+			}
+			lastSeq, lastAt = notification.Sequence, notification.At
+			if !notifiable(notification.Status) {
+				continue
+			}
+			event, err := n.store.GetSystemEvent(notification.EventID)
+			if err != nil {
+				slog.Warn("Load system event for notification failed", "event_id", notification.EventID, "error", err)
+				continue
+			}
+			n.enqueue(event, kick)
+		}
+	}
+}
+
+// deliverLoop 定期或在有新通知入队时投递到期的 outbox 记录。
+func (n *Notifier) deliverLoop(ctx context.Context, kick <-chan struct{}) {
+	n.DeliverDue(ctx)
+	ticker := time.NewTicker(n.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-kick:
+		}
+		n.DeliverDue(ctx)
+	}
+}
+
+// catchUp 补入 since 之后仍未读或已恢复的事件，每种状态内按 last_seen_at 从旧到新入队。
+func (n *Notifier) catchUp(since time.Time, kick chan<- struct{}) {
+	for _, status := range []string{store.SystemEventStatusUnread, store.SystemEventStatusResolved} {
+		filter := store.SystemEventFilter{Status: status, Since: since.Add(-catchUpOverlap), PageSize: 200}
+		var pending []store.SystemEvent
+		for page := 1; ; page++ {
+			filter.Page = page
+			result, err := n.store.ListSystemEvents(filter)
+			if err != nil {
+				slog.Warn("List system events for notification catch-up failed", "status", status, "error", err)
+				return
+			}
+			pending = append(pending, result.Items...)
+			if page >= result.TotalPages {
+				break
+			}
+		}
+		for i := len(pending) - 1; i >= 0; i-- {
+			n.enqueue(pending[i], kick)
+		}
+	}
+}
+
+// notifiable 报告该状态的事件是否需要通知：新出现（unread）与恢复（resolved）。
+func notifiable(status string) bool {
+	return status == store.SystemEventStatusUnread || status == store.SystemEventStatusResolved
+}
+
+func (n *Notifier) enqueue(event store.SystemEvent, kick chan<- struct{}) {
+	queued, err := n.Enqueue(event)
+	if err != nil {
+		slog.Warn("Enqueue notification failed", "event_id", event.ID, "error", err)
+		return
+	}
+	if queued > 0 {
+		select {
+		case kick <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Enqueue 为命中过滤条件且不在去重窗口内的 sink 写入 outbox，返回入队数量。
+// 同一 fingerprint 首次出现时通知，之后只有出现次数至少翻倍且距上次通知超过 cooldown 才再次通知。
+// 已恢复的事件只通知曾收到过该 fingerprint 的 sink，且每次恢复只通知一次，不受 cooldown 限制。
+func (n *Notifier) Enqueue(event store.SystemEvent) (int, error) {
+	if !notifiable(event.Status) {
+		return 0, nil
+	}
+	resolved := event.Status == store.SystemEventStatusResolved
+	payload, err := json.Marshal(eventFromStore(event))
+	if err != nil {
+		return 0, err
+	}
+	queued := 0
+	for _, s := range n.sinks {
+		if !s.matches(event) {
+			continue
+		}
+		last, err := n.store.LastNotification(s.name, event.Fingerprint)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			if resolved {
+				continue
+			}
+		case err != nil:
+			return queued, err
+		case resolved:
+			if notifiedResolved(last) {
+				continue
+			}
+		case event.OccurrenceCount < 2*last.OccurrenceCount || n.now().Sub(last.CreatedAt) < s.cooldown:
+			continue
+		}
+		if _, err := n.store.EnqueueNotification(store.NotificationDelivery{
+			Sink:            s.name,
+			EventID:         event.ID,
+			Fingerprint:     event.Fingerprint,
+			OccurrenceCount: event.OccurrenceCount,
+			EventJSON:       payload,
+			NextAttemptAt:   n.now(),
+		}); err != nil {
+			return queued, err
+		}
+		queued++
+	}
+	return queued, nil
+}
+
+// notifiedResolved 报告上一条通知是否已经是恢复通知。
+func notifiedResolved(last store.NotificationDelivery) bool {
+	var event Event
+	return json.Unmarshal(last.EventJSON, &event) == nil && event.Status == store.SystemEventStatusResolved
+}
+
+func (s *sink) matches(event store.SystemEvent) bool {
+	return glob.MatchAny(s.filter.Categories, event.Category) &&
+		glob.MatchAny(s.filter.Severities, event.Severity) &&
+		glob.MatchAny(s.filter.Models, event.Model) &&
+		glob.MatchAny(s.filter.Upstreams, event.UpstreamID)
+}
+
+// DeliverDue 投递到期的 outbox 记录，失败时按指数退避安排重试，超过最大次数后标记为 failed。
+func (n *Notifier) DeliverDue(ctx context.Context) {
+	due, err := n.store.DueNotifications(n.now(), deliverBatchSize)
+	if err != nil {
+		slog.Warn("List due notifications failed", "error", err)
+		return
+	}
+	for _, delivery := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		n.deliver(ctx, delivery)
+	}
+}
+
+func (n *Notifier) deliver(ctx context.Context, delivery store.NotificationDelivery) {
+	s := n.sink(delivery.Sink)
+	if s == nil {
+		// sink 已从配置中移除，不再重试。
+		if err := n.store.MarkNotificationAttemptFailed(delivery.ID, "sink is no longer configured", 0, time.Time{}); err != nil {
+			slog.Warn("Mark notification failed", "id", delivery.ID, "error", err)
+		}
+		return
+	}
+	var event Event
+	if err := json.Unmarshal(delivery.EventJSON, &event); err != nil {
+		if err := n.store.MarkNotificationAttemptFailed(delivery.ID, "decode event: "+err.Error(), 0, time.Time{}); err != nil {
+			slog.Warn("Mark notification failed", "id", delivery.ID, "error", err)
+		}
+		return
+	}
+	status, err := s.sender.send(ctx, event)
+	if err == nil {
+		if err := n.store.MarkNotificationDelivered(delivery.ID, status); err != nil {
+			slog.Warn("Mark notification delivered failed", "id", delivery.ID, "error", err)
+		}
+		return
+	}
+	var next time.Time
+	if attempts := delivery.Attempts + 1; attempts < n.maxAttempts {
+		next = n.now().Add(retryDelay(attempts))
+	}
+	slog.Warn("Notification delivery failed", "sink", delivery.Sink, "id", delivery.ID, "attempt", delivery.Attempts+1, "error", err)
+	if err := n.store.MarkNotificationAttemptFailed(delivery.ID, err.Error(), status, next); err != nil {
+		slog.Warn("Mark notification failed", "id", delivery.ID, "error", err)
+	}
+}
+
+func (n *Notifier) sink(name string) *sink {
+	for _, s := range n.sinks {
+		if s.name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// retryDelay 返回第 attempts 次失败后的等待时间：30s、1m、2m……上限 30m。
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, retryMaxDelay)
+}
@@ -0,0 +1,354 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/store"
+)
+
+type capturedRequest struct {
+	body   []byte
+	header http.Header
+}
+
+type captureServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []capturedRequest
+	statuses []int
+}
+
+func newCaptureServer(t *testing.T, statuses ...int) *captureServer {
+	t.Helper()
+	c := &captureServer{statuses: statuses}
+	c.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		c.mu.Lock()
+		c.requests = append(c.requests, capturedRequest{body: body, header: r.Header.Clone()})
+		status := http.StatusOK
+		if len(c.statuses) > 0 {
+			status, c.statuses = c.statuses[0], c.statuses[1:]
+		}
+		c.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(c.Close)
+	return c
+}
+
+func (c *captureServer) captured() []capturedRequest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]capturedRequest(nil), c.requests...)
+}
+
+func newTestStore(t *testing.T) *store.Store {
+	t.Helper()
+	st, err := store.New(t.TempDir())
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	t.Cleanup(func() { st.Close() })
+	return st
+}
+
+func upsertEvent(t *testing.T, st *store.Store, fingerprint string, category string, severity string) store.SystemEvent {
+	t.Helper()
+	event, err := st.UpsertSystemEvent(store.SystemEvent{
+		Fingerprint: fingerprint,
+		Source:      "upstream",
+		Category:    category,
+		Severity:    severity,
+		Title:       "Upstream transport error",
+		Message:     "dial tcp: connection refused",
+		UpstreamID:  "openai-primary",
+		Model:       "gpt-4o-mini",
+		TraceID:     "trace-1",
+	})
+	if err != nil {
+		t.Fatalf("UpsertSystemEvent() error = %v", err)
+	}
+	return event
+}
+
+func TestNotifierFiltersSignsAndDedupesByFingerprint(t *testing.T) {
+	st := newTestStore(t)
+	webhook := newCaptureServer(t)
+	slack := newCaptureServer(t)
+	notifier, err := New(st, config.NotificationsConfig{Sinks: []config.NotificationSink{
+		{Name: "ops", Type: "webhook", URL: webhook.URL, Secret: "s3cret", Filter: config.NotificationFilter{Severities: []string{"critical", "error"}}},
+		{Name: "chat", Type: "slack", URL: slack.URL, Filter: config.NotificationFilter{Categories: []string{"routing_*"}}},
+	}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	now := time.Now()
+	notifier.now = func() time.Time { return now }
+
+	event := upsertEvent(t, st, "upstream:openai-primary:transport", "transport_error", "error")
+	if queued, err := notifier.Enqueue(event); err != nil || queued != 1 {
+		t.Fatalf("Enqueue() = %d, %v; want 1 (webhook only)", queued, err)
+	}
+	notifier.DeliverDue(context.Background())
+
+	requests := webhook.captured()
+	if len(requests) != 1 || len(slack.captured()) != 0 {
+		t.Fatalf("webhook requests = %d, slack requests = %d; want 1 and 0", len(requests), len(slack.captured()))
+	}
+	timestamp := requests[0].header.Get(TimestampHeader)
+	if got, want := requests[0].header.Get(SignatureHeader), Sign("s3cret", timestamp, requests[0].body); timestamp == "" || got != want {
+		t.Fatalf("signature = %q, want %q", got, want)
+	}
+	var payload Event
+	if err := json.Unmarshal(requests[0].body, &payload); err != nil {
+		t.Fatalf("decode webhook payload: %v", err)
+	}
+	if payload.Fingerprint != event.Fingerprint || payload.UpstreamID != "openai-primary" || payload.OccurrenceCount != 1 {
+		t.Fatalf("webhook payload = %+v", payload)
+	}
+
+	// 第二次出现：次数翻倍但仍在 cooldown 内，不再通知。
+	event = upsertEvent(t, st, "upstream:openai-primary:transport", "transport_error", "error")
+	if queued, _ := notifier.Enqueue(event); queued != 0 {
+		t.Fatalf("Enqueue() within cooldown queued %d, want 0", queued)
+	}
+	now = now.Add(11 * time.Minute)
+	if queued, _ := notifier.Enqueue(event); queued != 1 {
+		t.Fatalf("Enqueue() after cooldown with doubled count queued %d, want 1", queued)
+	}
+	// 第三次出现：距上次通知的 2 次尚未翻倍。
+	now = now.Add(time.Hour)
+	event = upsertEvent(t, st, "upstream:openai-primary:transport", "transport_error", "error")
+	if queued, _ := notifier.Enqueue(event); queued != 0 {
+		t.Fatalf("Enqueue() without doubled count queued %d, want 0", queued)
+	}
+
+	routing := upsertEvent(t, st, "router:gpt-4o-mini:no_candidates", "routing_failure", "warning")
+	if queued, _ := notifier.Enqueue(routing); queued != 1 {
+		t.Fatalf("Enqueue(routing) queued %d, want 1 (slack only)", queued)
+	}
+	notifier.DeliverDue(context.Background())
+	slackRequests := slack.captured()
+	if len(slackRequests) != 1 || !strings.Contains(string(slackRequests[0].body), `"text":"*[WARNING] Upstream transport error*`) {
+		t.Fatalf("slack requests = %+v", slackRequests)
+	}
+	delivered, err := st.ListNotificationDeliveries(store.NotificationDeliveryFilter{Status: store.NotificationStatusDelivered})
+	if err != nil || len(delivered) != 3 {
+		t.Fatalf("delivered notifications = %d, %v; want 3", len(delivered), err)
+	}
+}
+
+func TestNotifierRetriesWithBackoffAndMarksFailed(t *testing.T) {
+	st := newTestStore(t)
+	flaky := newCaptureServer(t, http.StatusInternalServerError, http.StatusOK)
+	broken := newCaptureServer(t, http.StatusBadGateway, http.StatusBadGateway)
+	notifier, err := New(st, config.NotificationsConfig{MaxAttempts: 2, Sinks: []config.NotificationSink{
+		{Name: "flaky", Type: "webhook", URL: flaky.URL},
+		{Name: "broken", Type: "webhook", URL: broken.URL},
+	}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	now := time.Now()
+	notifier.now = func() time.Time { return now }
+
+	if queued, err := notifier.Enqueue(upsertEvent(t, st, "upstream:x", "transport_error", "error")); err != nil || queued != 2 {
+		t.Fatalf("Enqueue() = %d, %v; want 2", queued, err)
+	}
+	notifier.DeliverDue(context.Background())
+	pending, err := st.ListNotificationDeliveries(store.NotificationDeliveryFilter{Status: store.NotificationStatusPending})
+	if err != nil || len(pending) != 2 {
+		t.Fatalf("pending after first attempt = %d, %v; want 2", len(pending), err)
+	}
+	if pending[0].Attempts != 1 || pending[0].ResponseStatus == 0 || pending[0].LastError == "" {
+		t.Fatalf("pending delivery = %+v, want attempt, status and error recorded", pending[0])
+	}
+
+	// 退避时间未到时不会重试。
+	notifier.DeliverDue(context.Background())
+	if len(flaky.captured()) != 1 {
+		t.Fatalf("flaky requests = %d, want 1 before backoff elapses", len(flaky.captured()))
+	}
+	now = now.Add(31 * time.Second)
+	notifier.DeliverDue(context.Background())
+
+	flakyLog, _ := st.ListNotificationDeliveries(store.NotificationDeliveryFilter{Sink: "flaky"})
+	brokenLog, _ := st.ListNotificationDeliveries(store.NotificationDeliveryFilter{Sink: "broken"})
+	if flakyLog[0].Status != store.NotificationStatusDelivered || flakyLog[0].Attempts != 2 {
+		t.Fatalf("flaky delivery = %+v, want delivered after 2 attempts", flakyLog[0])
+	}
+	if brokenLog[0].Status != store.NotificationStatusFailed || brokenLog[0].ResponseStatus != http.StatusBadGateway {
+		t.Fatalf("broken delivery = %+v, want failed with 502", brokenLog[0])
+	}
+
+	retried, err := st.RetryNotification(brokenLog[0].ID)
+	if err != nil || retried.Status != store.NotificationStatusPending || retried.Attempts != 0 {
+		t.Fatalf("RetryNotification() = %+v, %v", retried, err)
+	}
+	if _, err := st.RetryNotification(flakyLog[0].ID); err == nil {
+		t.Fatalf("RetryNotification() on delivered notification should fail")
+	}
+}
+
+func TestNotifierRunDeliversSubscribedEventsByEmail(t *testing.T) {
+	st := newTestStore(t)
+	notifier, err := New(st, config.NotificationsConfig{Interval: time.Hour, Sinks: []config.NotificationSink{{
+		Name: "mail",
+		Type: "email",
+		SMTP: config.SMTPConfig{Host: "smtp.example.com", From: "alerts@example.com", To: []string{"oncall@example.com"}},
+	}}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	sent := make(chan string, 1)
+	notifier.sinks[0].sender.(*emailSender).sendMail = func(addr string, _ smtp.Auth, from string, to []string, msg []byte) error {
+		if addr != "smtp.example.com:587" || from != "alerts@example.com" || len(to) != 1 {
+			t.Errorf("sendMail(%q, %q, %v)", addr, from, to)
+		}
+		sent <- string(msg)
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		notifier.Run(ctx)
+		close(done)
+	}()
+	// 等待订阅建立后再写入事件。
+	deadline := time.Now().Add(time.Second)
+	for {
+		upsertEvent(t, st, "upstream:mail", "transport_error", "critical")
+		select {
+		case msg := <-sent:
+			if !strings.Contains(msg, "Subject: [llm-tracelab] CRITICAL: Upstream transport error") || !strings.Contains(msg, "upstream: openai-primary") {
+				t.Fatalf("email message = %q", msg)
+			}
+			cancel()
+			<-done
+			return
+		case <-time.After(20 * time.Millisecond):
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("email was not sent for subscribed system event")
+		}
+	}
+}
+
+func TestNotifierSendsOneResolvedNoticePerRecovery(t *testing.T) {
+	st := newTestStore(t)
+	slack := newCaptureServer(t)
+	notifier, err := New(st, config.NotificationsConfig{Sinks: []config.NotificationSink{{Name: "chat", Type: "slack", URL: slack.URL}}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	// 从未通知过的事件恢复时不发恢复通知。
+	quiet := upsertEvent(t, st, "upstream:quiet", "transport_error", "error")
+	if err := st.ResolveSystemEvent(quiet.ID); err != nil {
+		t.Fatalf("ResolveSystemEvent() error = %v", err)
+	}
+	quiet, _ = st.GetSystemEvent(quiet.ID)
+	if queued, err := notifier.Enqueue(quiet); err != nil || queued != 0 {
+		t.Fatalf("Enqueue(resolved, never notified) = %d, %v; want 0", queued, err)
+	}
+
+	event := upsertEvent(t, st, "alert:primary-errors:1790000000", "alert", "error")
+	if queued, err := notifier.Enqueue(event); err != nil || queued != 1 {
+		t.Fatalf("Enqueue(firing) = %d, %v; want 1", queued, err)
+	}
+	if err := st.ResolveSystemEvent(event.ID); err != nil {
+		t.Fatalf("ResolveSystemEvent() error = %v", err)
+	}
+	resolved, _ := st.GetSystemEvent(event.ID)
+	// 恢复通知不受 cooldown 与出现次数翻倍的限制，但同一次恢复只通知一次。
+	if queued, err := notifier.Enqueue(resolved); err != nil || queued != 1 {
+		t.Fatalf("Enqueue(resolved) = %d, %v; want 1", queued, err)
+	}
+	if queued, _ := notifier.Enqueue(resolved); queued != 0 {
+		t.Fatalf("Enqueue(resolved again) = %d, want 0", queued)
+	}
+	if err := st.MarkSystemEventRead(event.ID); err != nil {
+		t.Fatalf("MarkSystemEventRead() error = %v", err)
+	}
+	read, _ := st.GetSystemEvent(event.ID)
+	if queued, _ := notifier.Enqueue(read); queued != 0 {
+		t.Fatalf("Enqueue(read) = %d, want 0", queued)
+	}
+
+	notifier.DeliverDue(context.Background())
+	requests := slack.captured()
+	if len(requests) != 2 || !strings.Contains(string(requests[1].body), "[RESOLVED] Upstream transport error") || strings.Contains(string(requests[0].body), "RESOLVED") {
+		t.Fatalf("slack requests = %d, last = %s", len(requests), requests[len(requests)-1].body)
+	}
+}
+
+func TestNotifierRunCatchesUpEventsMissedWhileStopped(t *testing.T) {
+	st := newTestStore(t)
+	webhook := newCaptureServer(t)
+	notifier, err := New(st, config.NotificationsConfig{Interval: time.Hour, Sinks: []config.NotificationSink{{Name: "ops", Type: "webhook", URL: webhook.URL}}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if queued, err := notifier.Enqueue(upsertEvent(t, st, "upstream:before", "transport_error", "error")); err != nil || queued != 1 {
+		t.Fatalf("Enqueue() = %d, %v; want 1", queued, err)
+	}
+	// Run 未启动时产生的事件没有订阅者，只能靠启动补扫进入 outbox。
+	upsertEvent(t, st, "upstream:missed", "transport_error", "error")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		notifier.Run(ctx)
+		close(done)
+	}()
+	deadline := time.Now().Add(2 * time.Second)
+	for len(webhook.captured()) < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	<-done
+	fingerprints := map[string]bool{}
+	for _, request := range webhook.captured() {
+		var event Event
+		if err := json.Unmarshal(request.body, &event); err != nil {
+			t.Fatalf("webhook body = %s, err = %v", request.body, err)
+		}
+		fingerprints[event.Fingerprint] = true
+	}
+	if len(webhook.captured()) != 2 || !fingerprints["upstream:before"] || !fingerprints["upstream:missed"] {
+		t.Fatalf("delivered fingerprints = %v (%d requests), want before and missed once each", fingerprints, len(webhook.captured()))
+	}
+}
+
+func TestNewRejectsInvalidSinks(t *testing.T) {
+	for _, sinks := range [][]config.NotificationSink{
+		nil,
+		{{Name: "", Type: "webhook", URL: "http://example.com"}},
+		{{Name: "a", Type: "pager"}},
+		{{Name: "a", Type: "webhook"}},
+		{{Name: "a", Type: "email", SMTP: config.SMTPConfig{Host: "smtp"}}},
+		{{Name: "a", Type: "slack", URL: "http://x"}, {Name: "a", Type: "slack", URL: "http://y"}},
+		{{Name: "a", Type: "slack", URL: "http://x", Filter: config.NotificationFilter{Models: []string{"["}}}},
+	} {
+		if _, err := New(nil, config.NotificationsConfig{Sinks: sinks}); err == nil {
+			t.Fatalf("New(%+v) error = nil, want validation error", sinks)
+		}
+	}
+	if got := retryDelay(1); got != 30*time.Second {
+		t.Fatalf("retryDelay(1) = %v", got)
+	}
+	if got := retryDelay(20); got != 30*time.Minute {
+		t.Fatalf("retryDelay(20) = %v", got)
+	}
+}
@@ -0,0 +1,178 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/store"
+)
+
+const (
+	// SignatureHeader 携带 HMAC-SHA256(secret, timestamp + "." + body) 的十六进制签名。
+	SignatureHeader = "X-LLM-Tracelab-Signature"
+	// TimestampHeader 是参与签名的 Unix 秒时间戳，接收方可据此拒绝过旧的请求。
+	TimestampHeader = "X-LLM-Tracelab-Timestamp"
+)
+
+// Sign 计算 webhook 签名，接收方用同一密钥重新计算并比较 SignatureHeader。
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+type webhookSender struct {
+	url     string
+	secret  string
+	headers map[string]string
+	client  *http.Client
+}
+
+func (s *webhookSender) send(ctx context.Context, event Event) (int, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return 0, err
+	}
+	headers := map[string]string{}
+	for key, value := range s.headers {
+		headers[key] = value
+	}
+	if s.secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		headers[TimestampHeader] = timestamp
+		headers[SignatureHeader] = Sign(s.secret, timestamp, body)
+	}
+	return postJSON(ctx, s.client, s.url, body, headers)
+}
+
+type slackSender struct {
+	url    string
+	client *http.Client
+}
+
+func (s *slackSender) send(ctx context.Context, event Event) (int, error) {
+	body, err := json.Marshal(map[string]string{"text": summaryText(event, true)})
+	if err != nil {
+		return 0, err
+	}
+	return postJSON(ctx, s.client, s.url, body, nil)
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, body []byte, headers map[string]string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "llm-tracelab-notifier")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("sink returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(snippet)))
+	}
+	return resp.StatusCode, nil
+}
+
+type emailSender struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     string
+	to       []string
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func newEmailSender(cfg config.SMTPConfig) (*emailSender, error) {
+	host := strings.TrimSpace(cfg.Host)
+	if host == "" || strings.TrimSpace(cfg.From) == "" || len(cfg.To) == 0 {
+		return nil, fmt.Errorf("email requires smtp.host, smtp.from and smtp.to")
+	}
+	port := cfg.Port
+	if port <= 0 {
+		port = 587
+	}
+	return &emailSender{
+		addr:     net.JoinHostPort(host, strconv.Itoa(port)),
+		host:     host,
+		username: cfg.Username,
+		password: cfg.Password,
+		from:     cfg.From,
+		to:       cfg.To,
+		sendMail: smtp.SendMail,
+	}, nil
+}
+
+func (s *emailSender) send(ctx context.Context, event Event) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	var auth smtp.Auth
+	if s.username != "" {
+		auth = smtp.PlainAuth("", s.username, s.password, s.host)
+	}
+	subject := fmt.Sprintf("[llm-tracelab] %s: %s", eventLabel(event), event.Title)
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", s.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(s.to, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", strings.NewReplacer("\r", " ", "\n", " ").Replace(subject))
+	msg.WriteString("MIME-Version: 1.0\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(summaryText(event, false), "\n", "\r\n"))
+	msg.WriteString("\r\n")
+	return 0, s.sendMail(s.addr, auth, s.from, s.to, []byte(msg.String()))
+}
+
+// eventLabel 返回标题前缀：恢复通知为 RESOLVED，其余为大写的严重级别。
+func eventLabel(event Event) string {
+	if event.Status == store.SystemEventStatusResolved {
+		return "RESOLVED"
+	}
+	return strings.ToUpper(event.Severity)
+}
+
+// summaryText 生成人类可读的事件摘要；markdown 为 true 时使用 Slack mrkdwn 加粗。
+func summaryText(event Event, markdown bool) string {
+	title := fmt.Sprintf("[%s] %s", eventLabel(event), event.Title)
+	if markdown {
+		title = "*" + title + "*"
+	}
+	lines := []string{title}
+	if event.Message != "" {
+		lines = append(lines, event.Message)
+	}
+	fields := []string{"category: " + event.Category, "source: " + event.Source}
+	if event.Model != "" {
+		fields = append(fields, "model: "+event.Model)
+	}
+	if event.UpstreamID != "" {
+		fields = append(fields, "upstream: "+event.UpstreamID)
+	}
+	if event.TraceID != "" {
+		fields = append(fields, "trace: "+event.TraceID)
+	}
+	fields = append(fields, fmt.Sprintf("occurrences: %d", event.OccurrenceCount))
+	lines = append(lines, strings.Join(fields, " | "))
+	return strings.Join(lines, "\n")
+}
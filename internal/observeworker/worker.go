@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"os"
 	"time"
 
 	"github.com/kingfs/llm-tracelab/internal/store"
@@ -108,14 +107,19 @@ func ReparseTrace(ctx context.Context, st *store.Store, registry *observe.Regist
 	if st == nil {
 		return observe.TraceObservation{}, fmt.Errorf("trace store is nil")
 	}
-	if registry == nil {
-		registry = observe.NewDefaultRegistry()
-	}
 	entry, err := st.GetByID(traceID)
 	if err != nil {
 		return observe.TraceObservation{}, err
 	}
-	content, err := os.ReadFile(entry.LogPath)
+	return ParseCassette(ctx, registry, entry.ID, entry.LogPath)
+}
+
+// ParseCassette 直接从 cassette 文件解析出 observation，不读写 trace store。
+func ParseCassette(ctx context.Context, registry *observe.Registry, traceID string, path string) (observe.TraceObservation, error) {
+	if registry == nil {
+		registry = observe.NewDefaultRegistry()
+	}
+	content, err := recordfile.ReadFile(path)
 	if err != nil {
 		return observe.TraceObservation{}, err
 	}
@@ -125,8 +129,8 @@ func ReparseTrace(ctx context.Context, st *store.Store, registry *observe.Regist
 	}
 	_, reqBody, _, resBody := recordfile.ExtractSections(content, parsed)
 	return registry.Parse(ctx, observe.ParseInput{
-		TraceID:      entry.ID,
-		CassettePath: entry.LogPath,
+		TraceID:      traceID,
+		CassettePath: path,
 		Header:       parsed.Header,
 		Events:       parsed.Events,
 		RequestBody:  reqBody,
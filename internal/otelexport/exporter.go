@@ -0,0 +1,327 @@
+package otelexport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/pkg/llm"
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+	"github.com/kingfs/llm-tracelab/pkg/recordfile"
+)
+
+const (
+	defaultServiceName   = "llm-tracelab"
+	defaultFlushInterval = 5 * time.Second
+	maxBatchSpans        = 256
+	maxPendingRecords    = 4096
+	maxPendingBytes      = 32 << 20
+	scopeName            = "github.com/kingfs/llm-tracelab"
+
+	spanKindClient  = 3
+	statusCodeError = 2
+)
+
+// Exporter 把落盘后的 cassette 转成 GenAI span，按批经 OTLP/HTTP JSON 发往 collector。
+type Exporter struct {
+	endpoint    string
+	headers     map[string]string
+	serviceName string
+	interval    time.Duration
+	client      *http.Client
+	registry    *observe.Registry
+
+	// pending 保存尚未转换的 cassette 内容，解析与 span 构建都在后台发送时进行，不占用请求路径。
+	// 队列同时受条数和总字节数约束，大请求体的 cassette 不会在 collector 不可用时堆积占满内存。
+	mu           sync.Mutex
+	pending      [][]byte
+	pendingBytes int
+	maxBytes     int
+	dropped      int
+
+	kick      chan struct{}
+	done      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// New 按配置创建导出器并启动后台批量发送；调用方负责 Close 以发送剩余 span。
+func New(cfg config.OTelConfig) (*Exporter, error) {
+	endpoint := strings.TrimRight(strings.TrimSpace(cfg.Endpoint), "/")
+	if endpoint == "" {
+		return nil, fmt.Errorf("otel endpoint is required")
+	}
+	if !strings.HasSuffix(endpoint, "/v1/traces") {
+		endpoint += "/v1/traces"
+	}
+	e := &Exporter{
+		endpoint:    endpoint,
+		headers:     cfg.Headers,
+		serviceName: strings.TrimSpace(cfg.ServiceName),
+		interval:    cfg.FlushInterval,
+		client:      &http.Client{Timeout: 10 * time.Second},
+		registry:    observe.NewDefaultRegistry(),
+		maxBytes:    maxPendingBytes,
+		kick:        make(chan struct{}, 1),
+		done:        make(chan struct{}),
+	}
+	if e.serviceName == "" {
+		e.serviceName = defaultServiceName
+	}
+	if e.interval <= 0 {
+		e.interval = defaultFlushInterval
+	}
+	e.wg.Add(1)
+	go e.run()
+	return e, nil
+}
+
+// ExportRecord 只把完整 cassette 内容排队，span 在后台发送前构建；调用方之后不得修改 content。
+// 队列条数或字节数超限时丢弃该条，并在下次发送时告警。
+func (e *Exporter) ExportRecord(content []byte) {
+	e.mu.Lock()
+	if len(e.pending) >= maxPendingRecords || e.pendingBytes+len(content) > e.maxBytes {
+		e.dropped++
+		e.mu.Unlock()
+		return
+	}
+	e.pending = append(e.pending, content)
+	e.pendingBytes += len(content)
+	full := len(e.pending) >= maxBatchSpans || e.pendingBytes >= e.maxBytes/2
+	e.mu.Unlock()
+	if full {
+		select {
+		case e.kick <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Flush 把排队的 cassette 转成 span 并立即发送；没有 W3C trace id 的旧录制会被跳过。
+func (e *Exporter) Flush(ctx context.Context) error {
+	e.mu.Lock()
+	records := e.pending
+	dropped := e.dropped
+	e.pending = nil
+	e.pendingBytes = 0
+	e.dropped = 0
+	e.mu.Unlock()
+	if dropped > 0 {
+		slog.Warn("OTel export queue full, spans dropped", "dropped", dropped)
+	}
+	spans := make([]otlpSpan, 0, len(records))
+	for _, content := range records {
+		span, ok, err := e.buildSpan(content)
+		if err != nil {
+			slog.Warn("OTel span build failed", "error", err)
+			continue
+		}
+		if ok {
+			spans = append(spans, span)
+		}
+	}
+	for len(spans) > 0 {
+		n := min(len(spans), maxBatchSpans)
+		if err := e.send(ctx, spans[:n]); err != nil {
+			return err
+		}
+		spans = spans[n:]
+	}
+	return nil
+}
+
+// Close 停止后台发送并导出剩余 span，可重复调用。
+func (e *Exporter) Close() error {
+	var err error
+	e.closeOnce.Do(func() {
+		close(e.done)
+		e.wg.Wait()
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		err = e.Flush(ctx)
+	})
+	return err
+}
+
+func (e *Exporter) run() {
+	defer e.wg.Done()
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.done:
+			return
+		case <-ticker.C:
+		case <-e.kick:
+		}
+		if err := e.Flush(context.Background()); err != nil {
+			slog.Warn("OTel span export failed", "endpoint", e.endpoint, "error", err)
+		}
+	}
+}
+
+func (e *Exporter) send(ctx context.Context, spans []otlpSpan) error {
+	body, err := json.Marshal(otlpRequest{ResourceSpans: []otlpResourceSpans{{
+		Resource: otlpResource{Attributes: []otlpKeyValue{stringAttr("service.name", e.serviceName)}},
+		ScopeSpans: []otlpScopeSpans{{
+			Scope: otlpScope{Name: scopeName},
+			Spans: spans,
+		}},
+	}}})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range e.headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("collector returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// buildSpan 按 GenAI 语义约定把一次调用映射成 CLIENT span，TTFT 记为 gen_ai.first_token 事件。
+func (e *Exporter) buildSpan(content []byte) (otlpSpan, bool, error) {
+	parsed, err := recordfile.ParsePrelude(content)
+	if err != nil {
+		return otlpSpan{}, false, err
+	}
+	meta := parsed.Header.Meta
+	if meta.W3CTraceID == "" || meta.W3CSpanID == "" {
+		return otlpSpan{}, false, nil
+	}
+	operation := genAIOperation(meta.Operation)
+	start := meta.Time
+	end := start.Add(time.Duration(meta.DurationMs) * time.Millisecond)
+	span := otlpSpan{
+		TraceID:           meta.W3CTraceID,
+		SpanID:            meta.W3CSpanID,
+		ParentSpanID:      meta.W3CParentSpanID,
+		Name:              strings.TrimSpace(operation + " " + meta.Model),
+		Kind:              spanKindClient,
+		StartTimeUnixNano: strconv.FormatInt(start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(end.UnixNano(), 10),
+	}
+	span.Attributes = append(span.Attributes,
+		stringAttr("gen_ai.system", genAISystem(meta.Provider)),
+		stringAttr("gen_ai.operation.name", operation),
+		stringAttr("gen_ai.request.model", meta.Model),
+		intAttr("http.response.status_code", int64(meta.StatusCode)),
+		stringAttr("llm_tracelab.request_id", meta.RequestID),
+	)
+	usage := parsed.Header.Usage
+	if usage.PromptTokens > 0 || usage.CompletionTokens > 0 {
+		span.Attributes = append(span.Attributes,
+			intAttr("gen_ai.usage.input_tokens", int64(usage.PromptTokens)),
+			intAttr("gen_ai.usage.output_tokens", int64(usage.CompletionTokens)),
+		)
+	}
+	_, reqBody, _, resBody := recordfile.ExtractSections(content, parsed)
+	obs, err := e.registry.Parse(context.Background(), observe.ParseInput{
+		Header:       parsed.Header,
+		Events:       parsed.Events,
+		RequestBody:  reqBody,
+		ResponseBody: resBody,
+		IsStream:     parsed.Header.Layout.IsStream,
+	})
+	if err == nil {
+		if reasons := observe.FinishReasons(obs); len(reasons) > 0 {
+			span.Attributes = append(span.Attributes, stringsAttr("gen_ai.response.finish_reasons", reasons))
+		}
+	}
+	if meta.SelectedUpstreamBaseURL != "" {
+		if host := hostOf(meta.SelectedUpstreamBaseURL); host != "" {
+			span.Attributes = append(span.Attributes, stringAttr("server.address", host))
+		}
+	}
+	if meta.SelectedUpstreamID != "" {
+		span.Attributes = append(span.Attributes, stringAttr("llm_tracelab.upstream_id", meta.SelectedUpstreamID))
+	}
+	if meta.TTFTMs > 0 {
+		span.Events = append(span.Events, otlpEvent{
+			TimeUnixNano: strconv.FormatInt(start.Add(time.Duration(meta.TTFTMs)*time.Millisecond).UnixNano(), 10),
+			Name:         "gen_ai.first_token",
+			Attributes:   []otlpKeyValue{intAttr("gen_ai.response.time_to_first_token_ms", meta.TTFTMs)},
+		})
+	}
+	if meta.Error != "" || meta.StatusCode >= 400 {
+		message := meta.Error
+		if message == "" {
+			message = fmt.Sprintf("status %d", meta.StatusCode)
+		}
+		span.Status = &otlpStatus{Code: statusCodeError, Message: message}
+		span.Attributes = append(span.Attributes, stringAttr("error.type", errorType(meta)))
+	}
+	return span, true, nil
+}
+
+// genAISystem 把录制中的 provider 映射为 gen_ai.system 取值。
+func genAISystem(provider string) string {
+	switch provider {
+	case llm.ProviderOpenAICompatible:
+		return "openai"
+	case llm.ProviderAzureOpenAI:
+		return "az.ai.openai"
+	case llm.ProviderAnthropic:
+		return "anthropic"
+	case llm.ProviderGoogleGenAI:
+		return "gcp.gemini"
+	case llm.ProviderVertexNative:
+		return "gcp.vertex_ai"
+	case "", llm.ProviderUnknown:
+		return "_OTHER"
+	default:
+		return provider
+	}
+}
+
+func genAIOperation(operation string) string {
+	switch operation {
+	case llm.OperationChatCompletions, llm.OperationResponses, llm.OperationMessages:
+		return "chat"
+	case llm.OperationEmbeddings:
+		return "embeddings"
+	case llm.OperationGenerateContent:
+		return "generate_content"
+	case "":
+		return llm.OperationUnknown
+	default:
+		return operation
+	}
+}
+
+func errorType(meta recordfile.MetaData) string {
+	if meta.StatusCode >= 400 {
+		return strconv.Itoa(meta.StatusCode)
+	}
+	return "_OTHER"
+}
+
+func hostOf(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
@@ -0,0 +1,180 @@
+package otelexport
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+	"github.com/kingfs/llm-tracelab/pkg/recordfile"
+)
+
+func TestParseTraceparent(t *testing.T) {
+	tests := []struct {
+		value  string
+		wantOK bool
+	}{
+		{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", true},
+		{"00-4BF92F3577B34DA6A3CE929D0E0E4736-00F067AA0BA902B7-00", true},
+		{"01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra", true},
+		{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra", false},
+		{"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", false},
+		{"00-00000000000000000000000000000000-00f067aa0ba902b7-01", false},
+		{"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01", false},
+		{"00-4bf92f3577b34da6a3ce929d0e0e473-00f067aa0ba902b7-01", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		traceID, spanID, ok := ParseTraceparent(tt.value)
+		if ok != tt.wantOK {
+			t.Fatalf("ParseTraceparent(%q) ok = %v, want %v", tt.value, ok, tt.wantOK)
+		}
+		if ok && (traceID != "4bf92f3577b34da6a3ce929d0e0e4736" || spanID != "00f067aa0ba902b7") {
+			t.Fatalf("ParseTraceparent(%q) = %q, %q", tt.value, traceID, spanID)
+		}
+	}
+	if got := FormatTraceparent("4bf92f3577b34da6a3ce929d0e0e4736", "00f067aa0ba902b7"); got != "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" {
+		t.Fatalf("FormatTraceparent() = %q", got)
+	}
+	if len(NewTraceID()) != 32 || len(NewSpanID()) != 16 {
+		t.Fatalf("unexpected generated id lengths")
+	}
+}
+
+func TestExporterSendsErrorSpanWithFirstTokenEvent(t *testing.T) {
+	var got otlpRequest
+	var gotAuth string
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Errorf("decode OTLP payload: %v", err)
+		}
+	}))
+	defer collector.Close()
+
+	exporter, err := New(config.OTelConfig{
+		Endpoint:      collector.URL + "/",
+		Headers:       map[string]string{"Authorization": "Bearer collector"},
+		ServiceName:   "gateway",
+		FlushInterval: time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	start := time.Unix(1700000000, 0)
+	exporter.ExportRecord(buildRecord(t, recordfile.MetaData{
+		RequestID:  "req-1",
+		Time:       start,
+		Model:      "claude-sonnet-4",
+		Provider:   "anthropic",
+		Operation:  "messages",
+		StatusCode: http.StatusTooManyRequests,
+		DurationMs: 1200,
+		TTFTMs:     300,
+		W3CTraceID: NewTraceID(),
+		W3CSpanID:  NewSpanID(),
+	}))
+	// 没有 trace 上下文的旧录制不导出。
+	exporter.ExportRecord(buildRecord(t, recordfile.MetaData{RequestID: "legacy", Time: start}))
+	// 解析在后台发送时进行，无法解析的内容只会被跳过。
+	exporter.ExportRecord([]byte("not a cassette"))
+	if err := exporter.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	if gotAuth != "Bearer collector" {
+		t.Fatalf("collector Authorization = %q", gotAuth)
+	}
+	if len(got.ResourceSpans) != 1 || len(got.ResourceSpans[0].ScopeSpans[0].Spans) != 1 {
+		t.Fatalf("exported payload = %+v, want one span", got)
+	}
+	if value := got.ResourceSpans[0].Resource.Attributes[0].Value.StringValue; value == nil || *value != "gateway" {
+		t.Fatalf("service.name = %v, want gateway", value)
+	}
+	span := got.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	if span.Name != "chat claude-sonnet-4" || span.ParentSpanID != "" || span.Kind != spanKindClient {
+		t.Fatalf("span = %+v", span)
+	}
+	if span.Status == nil || span.Status.Code != statusCodeError || span.Status.Message != "status 429" {
+		t.Fatalf("span status = %+v, want error status 429", span.Status)
+	}
+	if span.EndTimeUnixNano != "1700000001200000000" {
+		t.Fatalf("EndTimeUnixNano = %s", span.EndTimeUnixNano)
+	}
+	if len(span.Events) != 1 || span.Events[0].Name != "gen_ai.first_token" || span.Events[0].TimeUnixNano != "1700000000300000000" {
+		t.Fatalf("span events = %+v, want gen_ai.first_token at +300ms", span.Events)
+	}
+	for _, attr := range span.Attributes {
+		if attr.Key == "gen_ai.system" && *attr.Value.StringValue != "anthropic" {
+			t.Fatalf("gen_ai.system = %s, want anthropic", *attr.Value.StringValue)
+		}
+		if attr.Key == "gen_ai.usage.input_tokens" {
+			t.Fatalf("usage attributes should be omitted without token counts")
+		}
+	}
+}
+
+func buildRecord(t *testing.T, meta recordfile.MetaData) []byte {
+	t.Helper()
+	header := recordfile.RecordHeader{Version: "LLM_PROXY_V3", Meta: meta}
+	prelude, err := recordfile.MarshalPrelude(header, recordfile.BuildEvents(header))
+	if err != nil {
+		t.Fatalf("MarshalPrelude() error = %v", err)
+	}
+	return prelude
+}
+
+func TestExporterDropsRecordsBeyondByteLimit(t *testing.T) {
+	var spans int
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload otlpRequest
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Errorf("decode OTLP payload: %v", err)
+		}
+		for _, resource := range payload.ResourceSpans {
+			for _, scope := range resource.ScopeSpans {
+				spans += len(scope.Spans)
+			}
+		}
+	}))
+	defer collector.Close()
+
+	record := buildRecord(t, recordfile.MetaData{
+		RequestID:  "req-1",
+		Time:       time.Unix(1700000000, 0),
+		W3CTraceID: NewTraceID(),
+		W3CSpanID:  NewSpanID(),
+	})
+	// 不启动后台 goroutine，队列只在手动 Flush 时清空；上限只够放两条。
+	exporter := &Exporter{
+		endpoint: collector.URL + "/v1/traces",
+		client:   collector.Client(),
+		registry: observe.NewDefaultRegistry(),
+		maxBytes: 2*len(record) + len(record)/2,
+		kick:     make(chan struct{}, 1),
+	}
+	for range 3 {
+		exporter.ExportRecord(record)
+	}
+	if len(exporter.pending) != 2 || exporter.pendingBytes != 2*len(record) || exporter.dropped != 1 {
+		t.Fatalf("queue = %d records / %d bytes / %d dropped, want 2 / %d / 1", len(exporter.pending), exporter.pendingBytes, exporter.dropped, 2*len(record))
+	}
+	if err := exporter.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	if spans != 2 || exporter.pendingBytes != 0 {
+		t.Fatalf("exported spans = %d, pendingBytes = %d, want 2 and 0", spans, exporter.pendingBytes)
+	}
+	// 发送后字节额度释放，可以继续排队。
+	exporter.ExportRecord(record)
+	if len(exporter.pending) != 1 {
+		t.Fatalf("pending after flush = %d, want 1", len(exporter.pending))
+	}
+}
@@ -0,0 +1,63 @@
+package otelexport
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	// TraceparentHeader 是 W3C Trace Context 的传播头。
+	TraceparentHeader = "traceparent"
+
+	zeroTraceID = "00000000000000000000000000000000"
+	zeroSpanID  = "0000000000000000"
+)
+
+// ParseTraceparent 解析 version-traceid-parentid-flags 格式的 traceparent，非法或全零 ID 时 ok 为 false。
+func ParseTraceparent(value string) (traceID string, parentSpanID string, ok bool) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) < 4 {
+		return "", "", false
+	}
+	version, traceID, parentSpanID, flags := parts[0], strings.ToLower(parts[1]), strings.ToLower(parts[2]), parts[3]
+	if !isHex(version, 2) || strings.EqualFold(version, "ff") || (version == "00" && len(parts) != 4) {
+		return "", "", false
+	}
+	if !isHex(traceID, 32) || traceID == zeroTraceID || !isHex(parentSpanID, 16) || parentSpanID == zeroSpanID || !isHex(flags, 2) {
+		return "", "", false
+	}
+	return traceID, parentSpanID, true
+}
+
+// FormatTraceparent 生成 sampled 的 traceparent，用于把当前 span 传播给上游。
+func FormatTraceparent(traceID string, spanID string) string {
+	if traceID == "" || spanID == "" {
+		return ""
+	}
+	return "00-" + traceID + "-" + spanID + "-01"
+}
+
+// NewTraceID 生成 16 字节随机 trace id。
+func NewTraceID() string {
+	return randomHex(16)
+}
+
+// NewSpanID 生成 8 字节随机 span id。
+func NewSpanID() string {
+	return randomHex(8)
+}
+
+func randomHex(n int) string {
+	buf := make([]byte, n)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+func isHex(value string, length int) bool {
+	if len(value) != length {
+		return false
+	}
+	_, err := hex.DecodeString(value)
+	return err == nil
+}
@@ -0,0 +1,550 @@
+// Package playground 从录制的 cassette 还原请求，按编辑项修改后经代理进程内重放，生成关联到原 trace 的 rerun。
+package playground
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/kingfs/llm-tracelab/internal/proxy"
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/pkg/llm"
+	"github.com/kingfs/llm-tracelab/pkg/recordfile"
+)
+
+const (
+	familyOpenAIChat = "openai_chat"
+	familyResponses  = "openai_responses"
+	familyAnthropic  = "anthropic_messages"
+	familyGemini     = "gemini"
+	familyOther      = "other"
+
+	maxErrorBody = 4096
+	// maxCallBody 限制非重放调用方（rerun.Source 非空）读取的完整响应体大小。
+	maxCallBody = 4 << 20
+)
+
+// Draft 是录制请求中可在 playground 编辑的部分，Body 为完整原始请求体。
+type Draft struct {
+	TraceID     string          `json:"trace_id"`
+	Method      string          `json:"method"`
+	Path        string          `json:"path"`
+	Provider    string          `json:"provider"`
+	Endpoint    string          `json:"endpoint"`
+	Model       string          `json:"model"`
+	System      string          `json:"system,omitempty"`
+	Temperature *float64        `json:"temperature,omitempty"`
+	Messages    []DraftMessage  `json:"messages"`
+	Channel     string          `json:"channel,omitempty"`
+	Editable    bool            `json:"editable"`
+	Body        json.RawMessage `json:"body"`
+}
+
+type DraftMessage struct {
+	Index int    `json:"index"`
+	Role  string `json:"role"`
+	Text  string `json:"text"`
+}
+
+// Edits 描述一次重放相对原请求的修改；Body 非空时先整体替换请求体，再合并 Override，最后应用其余字段。
+type Edits struct {
+	Model       *string         `json:"model,omitempty"`
+	System      *string         `json:"system,omitempty"`
+	Temperature *float64        `json:"temperature,omitempty"`
+	Messages    []MessageEdit   `json:"messages,omitempty"`
+	Channel     string          `json:"channel,omitempty"`
+	Body        json.RawMessage `json:"body,omitempty"`
+	Override    json.RawMessage `json:"override,omitempty"` // 合并到请求 JSON 顶层的字段，如 dataset 样例的输入覆盖
+}
+
+// MessageEdit 按原请求消息数组中的下标替换该消息的文本内容，非文本部分保留。
+type MessageEdit struct {
+	Index int    `json:"index"`
+	Text  string `json:"text"`
+}
+
+type Result struct {
+	TraceID    string `json:"trace_id,omitempty"`
+	RerunOf    string `json:"rerun_of"`
+	Channel    string `json:"channel,omitempty"`
+	StatusCode int    `json:"status_code"`
+	Error      string `json:"error,omitempty"`
+	// Body 仅在 rerun.Source 非空时保留完整响应体，供评估裁判等进程内调用方解析。
+	Body []byte `json:"-"`
+}
+
+type recordedRequest struct {
+	method string
+	target string
+	header http.Header
+	body   []byte
+}
+
+// LoadDraft 读取 trace 的录制请求并提取可编辑字段。
+func LoadDraft(entry store.LogEntry) (Draft, error) {
+	recorded, err := loadRecordedRequest(entry.LogPath)
+	if err != nil {
+		return Draft{}, err
+	}
+	meta := entry.Header.Meta
+	draft := Draft{
+		TraceID:  entry.ID,
+		Method:   recorded.method,
+		Path:     recorded.target,
+		Provider: meta.Provider,
+		Endpoint: meta.Endpoint,
+		Model:    meta.Model,
+		Channel:  meta.SelectedUpstreamID,
+		Messages: []DraftMessage{},
+		Body:     json.RawMessage(recorded.body),
+	}
+	if parsed, err := llm.ParseRequestForPath(recorded.target, meta.SelectedUpstreamBaseURL, recorded.body); err == nil {
+		draft.Model = firstNonEmpty(parsed.Model, draft.Model)
+		draft.Temperature = parsed.Temperature
+		var parts []string
+		for _, content := range parsed.System {
+			if content.Text != "" {
+				parts = append(parts, content.Text)
+			}
+		}
+		draft.System = strings.Join(parts, "\n")
+	}
+	family := requestFamily(meta.Endpoint, recorded.target)
+	payload, err := decodeBody(recorded.body)
+	if err != nil || family == familyOther {
+		return draft, nil
+	}
+	draft.Editable = true
+	items, _ := messageItems(family, payload)
+	for i, item := range items {
+		role, text := messageRoleText(family, item)
+		draft.Messages = append(draft.Messages, DraftMessage{Index: i, Role: role, Text: text})
+	}
+	return draft, nil
+}
+
+// BuildRequest 还原录制的请求并应用编辑，返回可直接交给代理 handler 的请求。
+// 编辑非法（消息下标越界、请求体不是 JSON 对象等）时返回错误，调用方应视为 400。
+func BuildRequest(ctx context.Context, entry store.LogEntry, edits Edits) (*http.Request, error) {
+	recorded, err := loadRecordedRequest(entry.LogPath)
+	if err != nil {
+		return nil, err
+	}
+	body := recorded.body
+	if len(bytes.TrimSpace(edits.Body)) > 0 {
+		body = edits.Body
+	}
+	if len(bytes.TrimSpace(edits.Override)) > 0 {
+		if body, err = mergeOverride(body, edits.Override); err != nil {
+			return nil, err
+		}
+	}
+	target := recorded.target
+	if edits.hasStructuredEdits() {
+		family := requestFamily(entry.Header.Meta.Endpoint, target)
+		if family == familyOther {
+			return nil, fmt.Errorf("endpoint %s does not support structured edits; edit the body instead", entry.Header.Meta.Endpoint)
+		}
+		payload, err := decodeBody(body)
+		if err != nil {
+			return nil, err
+		}
+		if target, err = applyEdits(family, target, payload, edits); err != nil {
+			return nil, err
+		}
+		if body, err = json.Marshal(payload); err != nil {
+			return nil, err
+		}
+	} else if _, err := decodeBody(body); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, recorded.method, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range recorded.header {
+		switch http.CanonicalHeaderKey(name) {
+		case "Authorization", "Api-Key", "X-Api-Key", "X-Goog-Api-Key", "Content-Length", "Accept-Encoding", "Connection":
+			continue
+		}
+		req.Header[name] = append([]string(nil), values...)
+	}
+	req.ContentLength = int64(len(body))
+	req.RemoteAddr = "playground"
+	return req, nil
+}
+
+// Run 把请求交给代理 handler 在进程内执行，并返回新录制 trace 的 ID。
+func Run(handler http.Handler, st *store.Store, req *http.Request, rerun *proxy.Rerun) (Result, error) {
+	if handler == nil {
+		return Result{}, fmt.Errorf("proxy handler is not configured")
+	}
+	writer := &responseCapture{header: http.Header{}, status: http.StatusOK, keepBody: rerun.Source != ""}
+	handler.ServeHTTP(writer, req.WithContext(proxy.WithRerun(req.Context(), rerun)))
+	result := Result{RerunOf: rerun.OriginalTraceID, StatusCode: writer.status}
+	if writer.status >= http.StatusBadRequest {
+		result.Error = strings.TrimSpace(string(writer.body.Bytes()[:min(writer.body.Len(), maxErrorBody)]))
+	} else if writer.keepBody {
+		result.Body = writer.body.Bytes()
+	}
+	if rerun.RequestID == "" {
+		return result, fmt.Errorf("proxy did not record the rerun (status %d)", writer.status)
+	}
+	entry, err := st.GetByRequestID(rerun.RequestID)
+	if err != nil {
+		return result, fmt.Errorf("find rerun trace: %w", err)
+	}
+	result.TraceID = entry.ID
+	result.Channel = entry.Header.Meta.SelectedUpstreamID
+	return result, nil
+}
+
+func (e Edits) hasStructuredEdits() bool {
+	return e.Model != nil || e.System != nil || e.Temperature != nil || len(e.Messages) > 0
+}
+
+func loadRecordedRequest(path string) (recordedRequest, error) {
+	content, err := recordfile.ReadFile(path)
+	if err != nil {
+		return recordedRequest{}, err
+	}
+	parsed, err := recordfile.ParsePrelude(content)
+	if err != nil {
+		return recordedRequest{}, err
+	}
+	reqFull, reqBody, _, _ := recordfile.ExtractSections(content, parsed)
+	headLen := min(int(parsed.Header.Layout.ReqHeaderLen), len(reqFull))
+	req, err := http.ReadRequest(bufio.NewReader(bytes.NewReader(reqFull[:headLen])))
+	if err != nil {
+		return recordedRequest{}, fmt.Errorf("parse recorded request: %w", err)
+	}
+	return recordedRequest{
+		method: req.Method,
+		target: req.URL.RequestURI(),
+		header: req.Header,
+		body:   append([]byte(nil), reqBody...),
+	}, nil
+}
+
+func requestFamily(endpoint string, target string) string {
+	switch {
+	case strings.Contains(target, ":generateContent"), strings.Contains(target, ":streamGenerateContent"):
+		return familyGemini
+	}
+	switch llm.NormalizeEndpoint(endpoint) {
+	case "/v1/chat/completions":
+		return familyOpenAIChat
+	case "/v1/responses":
+		return familyResponses
+	case "/v1/messages":
+		return familyAnthropic
+	}
+	return familyOther
+}
+
+func mergeOverride(body []byte, override json.RawMessage) ([]byte, error) {
+	payload, err := decodeBody(body)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := decodeBody(override)
+	if err != nil {
+		return nil, fmt.Errorf("override: %w", err)
+	}
+	for key, value := range fields {
+		payload[key] = value
+	}
+	return json.Marshal(payload)
+}
+
+func decodeBody(body []byte) (map[string]any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var payload map[string]any
+	if err := decoder.Decode(&payload); err != nil || payload == nil {
+		return nil, fmt.Errorf("request body must be a JSON object")
+	}
+	return payload, nil
+}
+
+// familyEndpoints 是各请求族在 llm 包中的规范 endpoint，用于取得对应 provider 的请求序列化器。
+var familyEndpoints = map[string]string{
+	familyOpenAIChat: "/v1/chat/completions",
+	familyResponses:  "/v1/responses",
+	familyAnthropic:  "/v1/messages",
+	familyGemini:     "/v1beta/models:generateContent",
+}
+
+// applyEdits 把模型、温度与 system 编辑经 llm 的 provider 序列化转成原生字段后合并回原请求，
+// 其余字段（stream、工具、图片等）保持原样，不经过有损的整体转换；消息文本按下标在原消息上替换。
+func applyEdits(family string, target string, payload map[string]any, edits Edits) (string, error) {
+	adapter, err := llm.AdapterFor("", familyEndpoints[family])
+	if err != nil {
+		return "", err
+	}
+	var native llm.LLMRequest
+	if edits.Model != nil {
+		native.Model = strings.TrimSpace(*edits.Model)
+		if native.Model == "" {
+			return "", fmt.Errorf("model must not be empty")
+		}
+	}
+	native.Temperature = edits.Temperature
+	if edits.System != nil {
+		native.System = []llm.LLMContent{{Type: "text", Text: *edits.System}}
+	}
+	fields, err := nativeFields(adapter, native)
+	if err != nil {
+		return "", err
+	}
+	if native.Model != "" {
+		if _, ok := fields["model"]; !ok {
+			// Gemini 等 provider 的模型在路径 /models/{model}:method 中。
+			if target, err = replacePathModel(target, native.Model); err != nil {
+				return "", err
+			}
+		}
+	}
+	if family == familyOpenAIChat && edits.System != nil {
+		// Chat Completions 的 system 是消息数组中的一条，替换已有的 system/developer 消息而不是整个数组。
+		messages, _ := fields["messages"].([]any)
+		delete(fields, "messages")
+		if len(messages) > 0 {
+			setChatSystem(payload, messages[0])
+		}
+	}
+	mergeFields(payload, fields)
+	if len(edits.Messages) > 0 {
+		items, key := messageItems(family, payload)
+		for _, edit := range edits.Messages {
+			if edit.Index < 0 || edit.Index >= len(items) {
+				return "", fmt.Errorf("message index %d out of range (request has %d messages)", edit.Index, len(items))
+			}
+			if key == "" {
+				// Responses API 的 input 为纯字符串时只有一条消息。
+				payload["input"] = edit.Text
+				continue
+			}
+			item, ok := items[edit.Index].(map[string]any)
+			if !ok {
+				return "", fmt.Errorf("message %d is not an object", edit.Index)
+			}
+			setMessageText(family, item, edit.Text)
+		}
+	}
+	return target, nil
+}
+
+// nativeFields 用 adapter 序列化只含编辑字段的请求，去掉空值后返回该 provider 的原生 JSON 字段。
+func nativeFields(adapter llm.Adapter, req llm.LLMRequest) (map[string]any, error) {
+	raw, err := adapter.MarshalRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := decodeBody(raw)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range fields {
+		if isEmptyJSON(value) {
+			delete(fields, key)
+		}
+	}
+	return fields, nil
+}
+
+func isEmptyJSON(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
+
+// mergeFields 把 src 合并进 dst：两边都是对象时递归合并（保留 generationConfig 中未编辑的配置），否则覆盖。
+func mergeFields(dst map[string]any, src map[string]any) {
+	for key, value := range src {
+		next, ok := value.(map[string]any)
+		current, isObject := dst[key].(map[string]any)
+		if ok && isObject {
+			mergeFields(current, next)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+// messageItems 返回请求中的消息数组及其字段名；Responses API 的字符串 input 视为单条消息。
+func messageItems(family string, payload map[string]any) ([]any, string) {
+	key := "messages"
+	switch family {
+	case familyResponses:
+		if text, ok := payload["input"].(string); ok {
+			return []any{map[string]any{"role": "user", "content": text}}, ""
+		}
+		key = "input"
+	case familyGemini:
+		key = "contents"
+	}
+	items, _ := payload[key].([]any)
+	return items, key
+}
+
+func messageRoleText(family string, item any) (string, string) {
+	obj, ok := item.(map[string]any)
+	if !ok {
+		return "", ""
+	}
+	role, _ := obj["role"].(string)
+	if role == "" {
+		role, _ = obj["type"].(string)
+	}
+	if family == familyGemini {
+		return role, partsText(obj["parts"])
+	}
+	switch content := obj["content"].(type) {
+	case string:
+		return role, content
+	case []any:
+		return role, partsText(content)
+	}
+	return role, ""
+}
+
+func partsText(value any) string {
+	parts, _ := value.([]any)
+	var texts []string
+	for _, part := range parts {
+		if obj, ok := part.(map[string]any); ok {
+			if text, ok := obj["text"].(string); ok && text != "" {
+				texts = append(texts, text)
+			}
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+// setMessageText 用新文本替换消息中的文本部分，图片、工具调用等非文本部分原样保留。
+func setMessageText(family string, item map[string]any, text string) {
+	key := "content"
+	if family == familyGemini {
+		key = "parts"
+	}
+	parts, ok := item[key].([]any)
+	if !ok {
+		if family == familyGemini {
+			item[key] = []any{map[string]any{"text": text}}
+		} else {
+			item[key] = text
+		}
+		return
+	}
+	var out []any
+	replaced := false
+	for _, part := range parts {
+		obj, ok := part.(map[string]any)
+		if _, isText := obj["text"].(string); !ok || !isText {
+			out = append(out, part)
+			continue
+		}
+		if replaced {
+			continue
+		}
+		obj["text"] = text
+		out = append(out, obj)
+		replaced = true
+	}
+	if !replaced {
+		if family == familyGemini {
+			out = append(out, map[string]any{"text": text})
+		} else {
+			out = append(out, map[string]any{"type": "text", "text": text})
+		}
+	}
+	item[key] = out
+}
+
+// setChatSystem 用 system 消息替换 Chat Completions 中第一条 system/developer 消息的内容，没有时插到最前。
+func setChatSystem(payload map[string]any, system any) {
+	messages, _ := payload["messages"].([]any)
+	replacement, _ := system.(map[string]any)
+	for _, message := range messages {
+		obj, ok := message.(map[string]any)
+		if !ok {
+			continue
+		}
+		if role, _ := obj["role"].(string); role == "system" || role == "developer" {
+			obj["content"] = replacement["content"]
+			return
+		}
+	}
+	payload["messages"] = append([]any{system}, messages...)
+}
+
+// replacePathModel 替换 Gemini 风格路径 /models/{model}:method 中的模型名。
+func replacePathModel(target string, model string) (string, error) {
+	parsed, err := url.Parse(target)
+	if err != nil {
+		return "", err
+	}
+	idx := strings.Index(parsed.Path, "/models/")
+	if idx < 0 {
+		return "", fmt.Errorf("request path %s does not carry a model", parsed.Path)
+	}
+	rest := parsed.Path[idx+len("/models/"):]
+	suffix := ""
+	if cut := strings.Index(rest, ":"); cut >= 0 {
+		suffix = rest[cut:]
+	}
+	parsed.Path = parsed.Path[:idx] + "/models/" + model + suffix
+	parsed.RawPath = ""
+	return parsed.RequestURI(), nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// responseCapture 默认丢弃成功响应体，只保留状态码和有限长度的错误正文。
+type responseCapture struct {
+	header   http.Header
+	status   int
+	body     bytes.Buffer
+	keepBody bool
+}
+
+func (w *responseCapture) Header() http.Header { return w.header }
+
+func (w *responseCapture) WriteHeader(status int) { w.status = status }
+
+func (w *responseCapture) Write(p []byte) (int, error) {
+	limit := maxErrorBody
+	if w.keepBody {
+		limit = maxCallBody
+	} else if w.status < http.StatusBadRequest {
+		return len(p), nil
+	}
+	if w.body.Len() < limit {
+		w.body.Write(p[:min(len(p), limit-w.body.Len())])
+	}
+	return len(p), nil
+}
+
+func (w *responseCapture) Flush() {}
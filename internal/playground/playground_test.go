@@ -0,0 +1,252 @@
+package playground
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/proxy"
+	"github.com/kingfs/llm-tracelab/internal/router"
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/pkg/recordfile"
+)
+
+func TestRerunAppliesEditsAndLinksTrace(t *testing.T) {
+	outputDir := t.TempDir()
+	st, err := store.New(outputDir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	var (
+		mu       sync.Mutex
+		received = map[string][]map[string]any{}
+	)
+	newUpstream := func(name string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var payload map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			mu.Lock()
+			received[name] = append(received[name], payload)
+			mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = io.WriteString(w, `{"id":"chatcmpl-1","object":"chat.completion","model":"gpt-4o","choices":[{"index":0,"finish_reason":"stop","message":{"role":"assistant","content":"from `+name+`"}}],"usage":{"prompt_tokens":3,"completion_tokens":2,"total_tokens":5}}`)
+		}))
+	}
+	primary := newUpstream("primary")
+	defer primary.Close()
+	secondary := newUpstream("secondary")
+	defer secondary.Close()
+
+	enabled := true
+	cfg := &config.Config{}
+	for _, target := range []struct {
+		id       string
+		url      string
+		priority int
+	}{{"primary", primary.URL, 100}, {"secondary", secondary.URL, 90}} {
+		cfg.Upstreams = append(cfg.Upstreams, config.UpstreamTargetConfig{
+			ID:             target.id,
+			Enabled:        &enabled,
+			Priority:       target.priority,
+			ModelDiscovery: router.ModelDiscoveryStaticOnly,
+			StaticModels:   []string{"gpt-4o", "gpt-4o-mini"},
+			Upstream:       config.UpstreamConfig{BaseURL: target.url + "/v1", ProviderPreset: "openai"},
+		})
+	}
+	cfg.Router.Selection.Policy = router.PolicyFirstAvailable
+	cfg.Debug.OutputDir = outputDir
+	cfg.Debug.MaskKey = true
+	handler, err := proxy.NewHandler(cfg, st)
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+
+	original := httptest.NewRequest(http.MethodPost, "/v1/chat/completions", strings.NewReader(`{"model":"gpt-4o","temperature":1,"response_format":{"type":"json_object"},"messages":[{"role":"system","content":"Be verbose."},{"role":"user","content":[{"type":"text","text":"hello"},{"type":"image_url","image_url":{"url":"https://example.com/a.png"}}]}]}`))
+	original.Header.Set("Content-Type", "application/json")
+	original.Header.Set("Authorization", "Bearer client-key")
+	handler.ServeHTTP(httptest.NewRecorder(), original)
+	entries, err := st.ListRecent(10)
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("ListRecent() = %+v, err = %v", entries, err)
+	}
+	entry := entries[0]
+
+	draft, err := LoadDraft(entry)
+	if err != nil {
+		t.Fatalf("LoadDraft() error = %v", err)
+	}
+	if !draft.Editable || draft.System != "Be verbose." || draft.Model != "gpt-4o" || draft.Temperature == nil || *draft.Temperature != 1 || draft.Channel != "primary" {
+		t.Fatalf("draft = %+v", draft)
+	}
+	if len(draft.Messages) != 2 || draft.Messages[1].Role != "user" || draft.Messages[1].Text != "hello" {
+		t.Fatalf("draft messages = %+v", draft.Messages)
+	}
+
+	if _, err := BuildRequest(context.Background(), entry, Edits{Messages: []MessageEdit{{Index: 5, Text: "x"}}}); err == nil {
+		t.Fatalf("BuildRequest(out of range) error = nil")
+	}
+	if _, err := BuildRequest(context.Background(), entry, Edits{Body: json.RawMessage(`[1,2]`)}); err == nil {
+		t.Fatalf("BuildRequest(non-object body) error = nil")
+	}
+
+	system := "Be terse."
+	model := "gpt-4o-mini"
+	temperature := 0.0
+	req, err := BuildRequest(context.Background(), entry, Edits{
+		Model:       &model,
+		System:      &system,
+		Temperature: &temperature,
+		Messages:    []MessageEdit{{Index: 1, Text: "hi there"}},
+	})
+	if err != nil {
+		t.Fatalf("BuildRequest() error = %v", err)
+	}
+	if req.Header.Get("Authorization") != "" || req.Header.Get("Content-Type") != "application/json" {
+		t.Fatalf("rerun headers = %v", req.Header)
+	}
+	rerun := &proxy.Rerun{OriginalTraceID: entry.ID, Channel: "secondary", User: "alice", TokenName: "ci-token"}
+	result, err := Run(handler, st, req, rerun)
+	if err != nil {
+		t.Fatalf("Run() error = %v, result = %+v", err, result)
+	}
+	if result.TraceID == "" || result.TraceID == entry.ID || result.RerunOf != entry.ID || result.Channel != "secondary" || result.StatusCode != http.StatusOK {
+		t.Fatalf("Run() = %+v", result)
+	}
+
+	mu.Lock()
+	sent := received["secondary"]
+	mu.Unlock()
+	if len(sent) != 1 {
+		t.Fatalf("secondary received %d requests, want 1", len(sent))
+	}
+	body, _ := json.Marshal(sent[0])
+	for _, want := range []string{`"model":"gpt-4o-mini"`, `"temperature":0`, `"content":"Be terse."`, `"text":"hi there"`, `"image_url"`, `"response_format":{"type":"json_object"}`} {
+		if !bytes.Contains(body, []byte(want)) {
+			t.Fatalf("rerun body missing %s: %s", want, body)
+		}
+	}
+
+	rerunEntry, err := st.GetByID(result.TraceID)
+	if err != nil {
+		t.Fatalf("GetByID(%s) error = %v", result.TraceID, err)
+	}
+	content, err := recordfile.ReadFile(rerunEntry.LogPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	prelude, err := recordfile.ParsePrelude(content)
+	if err != nil || prelude.Header.Meta.RerunOf != entry.ID || prelude.Header.Meta.SelectedUpstreamID != "secondary" || prelude.Header.Meta.TokenName != "ci-token" {
+		t.Fatalf("rerun prelude meta = %+v, err = %v", prelude, err)
+	}
+	reruns, err := st.ListReruns(entry.ID)
+	if err != nil || len(reruns) != 1 || reruns[0].TraceID != result.TraceID {
+		t.Fatalf("ListReruns() = %+v, err = %v", reruns, err)
+	}
+	if origin, err := st.GetRerunOf(result.TraceID); err != nil || origin.OriginalTraceID != entry.ID {
+		t.Fatalf("GetRerunOf() = %+v, err = %v", origin, err)
+	}
+
+	req, err = BuildRequest(context.Background(), entry, Edits{})
+	if err != nil {
+		t.Fatalf("BuildRequest(no edits) error = %v", err)
+	}
+	failed, err := Run(handler, st, req, &proxy.Rerun{OriginalTraceID: entry.ID, Channel: "missing"})
+	if err != nil || failed.StatusCode != http.StatusBadGateway || failed.TraceID == "" || failed.Error == "" {
+		t.Fatalf("Run(unknown channel) = %+v, err = %v", failed, err)
+	}
+}
+
+func TestApplyEditsPerProviderFamily(t *testing.T) {
+	system := "sys"
+	model := "gemini-2.5-pro"
+	temperature := 0.5
+	for _, tc := range []struct {
+		family string
+		target string
+		body   string
+		want   []string
+		path   string
+	}{
+		{
+			family: familyAnthropic,
+			target: "/v1/messages",
+			body:   `{"model":"claude","system":"old","messages":[{"role":"user","content":"hello"}]}`,
+			want:   []string{`"system":"sys"`, `"content":"edited"`, `"model":"gemini-2.5-pro"`, `"temperature":0.5`},
+			path:   "/v1/messages",
+		},
+		{
+			family: familyResponses,
+			target: "/v1/responses",
+			body:   `{"model":"gpt-5","input":"hello"}`,
+			want:   []string{`"instructions":"sys"`, `"input":"edited"`},
+			path:   "/v1/responses",
+		},
+		{
+			family: familyGemini,
+			target: "/v1beta/models/gemini-2.5-flash:generateContent?alt=sse",
+			body:   `{"contents":[{"role":"user","parts":[{"text":"hello"}]}]}`,
+			want:   []string{`"systemInstruction":{"parts":[{"text":"sys"}]`, `"parts":[{"text":"edited"}]`, `"generationConfig":{"temperature":0.5}`},
+			path:   "/v1beta/models/gemini-2.5-pro:generateContent?alt=sse",
+		},
+	} {
+		payload, err := decodeBody([]byte(tc.body))
+		if err != nil {
+			t.Fatalf("decodeBody(%s) error = %v", tc.family, err)
+		}
+		target, err := applyEdits(tc.family, tc.target, payload, Edits{
+			Model:       &model,
+			System:      &system,
+			Temperature: &temperature,
+			Messages:    []MessageEdit{{Index: 0, Text: "edited"}},
+		})
+		if err != nil {
+			t.Fatalf("applyEdits(%s) error = %v", tc.family, err)
+		}
+		if target != tc.path {
+			t.Fatalf("applyEdits(%s) target = %s, want %s", tc.family, target, tc.path)
+		}
+		body, _ := json.Marshal(payload)
+		for _, want := range tc.want {
+			if !bytes.Contains(body, []byte(want)) {
+				t.Fatalf("applyEdits(%s) body missing %s: %s", tc.family, want, body)
+			}
+		}
+	}
+}
+
+func TestApplyEditsKeepsUneditedNativeFields(t *testing.T) {
+	temperature := 0.2
+	system := "sys"
+	payload, err := decodeBody([]byte(`{"contents":[{"role":"user","parts":[{"text":"hello"}]}],"generationConfig":{"temperature":1,"responseMimeType":"application/json"}}`))
+	if err != nil {
+		t.Fatalf("decodeBody() error = %v", err)
+	}
+	if _, err := applyEdits(familyGemini, "/v1beta/models/gemini-2.5-flash:generateContent", payload, Edits{Temperature: &temperature}); err != nil {
+		t.Fatalf("applyEdits(gemini) error = %v", err)
+	}
+	body, _ := json.Marshal(payload)
+	if !bytes.Contains(body, []byte(`"generationConfig":{"responseMimeType":"application/json","temperature":0.2}`)) {
+		t.Fatalf("applyEdits(gemini) body = %s", body)
+	}
+
+	payload, err = decodeBody([]byte(`{"model":"gpt-4o","stream":true,"messages":[{"role":"system","content":"old"},{"role":"user","content":"hello"}]}`))
+	if err != nil {
+		t.Fatalf("decodeBody() error = %v", err)
+	}
+	if _, err := applyEdits(familyOpenAIChat, "/v1/chat/completions", payload, Edits{System: &system}); err != nil {
+		t.Fatalf("applyEdits(openai) error = %v", err)
+	}
+	body, _ = json.Marshal(payload)
+	if !bytes.Contains(body, []byte(`"messages":[{"content":"sys","role":"system"},{"content":"hello","role":"user"}]`)) || !bytes.Contains(body, []byte(`"stream":true`)) {
+		t.Fatalf("applyEdits(openai) body = %s", body)
+	}
+}
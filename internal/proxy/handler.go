@@ -18,7 +18,11 @@ import (
 	"github.com/kingfs/llm-tracelab/internal/auth"
 	"github.com/kingfs/llm-tracelab/internal/chaos"
 	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/guard"
+	"github.com/kingfs/llm-tracelab/internal/metrics"
+	"github.com/kingfs/llm-tracelab/internal/otelexport"
 	"github.com/kingfs/llm-tracelab/internal/recorder"
+	"github.com/kingfs/llm-tracelab/internal/rewrite"
 	"github.com/kingfs/llm-tracelab/internal/router"
 	"github.com/kingfs/llm-tracelab/internal/store"
 	"github.com/kingfs/llm-tracelab/pkg/llm"
@@ -44,26 +48,31 @@ const (
 
 // ensureStreamOptions 检查请求体，如果是 stream 模式，强制注入 stream_options
 func ensureStreamOptions(req *http.Request) {
-	_, _ = readAndNormalizeRequestBody(req)
+	_, _, _ = readAndNormalizeRequestBody(req)
 }
 
-func readAndNormalizeRequestBody(req *http.Request) ([]byte, error) {
+// readAndNormalizeRequestBody 返回客户端发来的原始请求体与注入 stream_options 后的请求体。
+func readAndNormalizeRequestBody(req *http.Request) ([]byte, []byte, error) {
 	if req.Body == nil {
-		return nil, nil
+		return nil, nil, nil
 	}
 
-	bodyBytes, err := io.ReadAll(req.Body)
+	rawBody, err := io.ReadAll(req.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	bodyBytes = injectStreamOptions(req, bodyBytes)
+	bodyBytes := injectStreamOptions(req, rawBody)
+	setRequestBody(req, bodyBytes)
+	return rawBody, bodyBytes, nil
+}
+
+func setRequestBody(req *http.Request, bodyBytes []byte) {
 	req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
 	req.ContentLength = int64(len(bodyBytes))
 	if len(bodyBytes) > 0 {
 		req.Header.Set("Content-Length", fmt.Sprint(len(bodyBytes)))
 	}
-	return bodyBytes, nil
 }
 
 func injectStreamOptions(req *http.Request, bodyBytes []byte) []byte {
@@ -82,30 +91,10 @@ func injectStreamOptions(req *http.Request, bodyBytes []byte) []byte {
 		return bodyBytes // 不是 JSON，放弃
 	}
 
-	// 4. 检查 stream 字段
-	isStream, ok := payload["stream"].(bool)
-	if !ok || !isStream {
-		return bodyBytes // 不是 stream 模式，放弃
-	}
+	// 4. stream 模式下确保 stream_options.include_usage=true（与 rewrite 规则共用同一实现）
+	updated := rewrite.IncludeStreamUsage(req.URL.Path, payload)
 
-	// 5. 检查并注入 stream_options
-	// 逻辑：如果 stream_options 不存在，或者存在但 include_usage 不为 true，则修改
-	updated := false
-	if opts, ok := payload["stream_options"].(map[string]interface{}); ok {
-		if val, ok := opts["include_usage"].(bool); !ok || !val {
-			opts["include_usage"] = true
-			payload["stream_options"] = opts
-			updated = true
-		}
-	} else {
-		// 不存在 stream_options，创建之
-		payload["stream_options"] = map[string]interface{}{
-			"include_usage": true,
-		}
-		updated = true
-	}
-
-	// 6. 如果有修改，重新序列化并赋值给 req.Body
+	// 5. 如果有修改，重新序列化并赋值给 req.Body
 	if updated {
 		newBytes, err := json.Marshal(payload)
 		if err == nil {
@@ -154,9 +143,8 @@ func (s *UsageSniffer) Close() error {
 			*s.Usage = recorder.UsageInfo(usage)
 		}
 		if s.Events != nil {
-			events := s.Pipeline.Events()
-			*s.Events = make([]recorder.RecordEvent, len(events))
-			copy(*s.Events, events)
+			// 保留代理侧已写入的事件（routing.selection、request.rewrite 等），追加响应管线事件。
+			*s.Events = append(*s.Events, s.Pipeline.Events()...)
 		}
 	}
 	return s.Source.Close()
@@ -211,6 +199,11 @@ type Handler struct {
 	cfg          *config.Config
 	router       *router.Router
 	authVerifier auth.TokenVerifier
+	rewriter     *rewrite.Engine
+	guard        *guard.Guard
+	store        *store.Store
+	exporter     *otelexport.Exporter
+	metrics      *metrics.Requests
 }
 
 func NewHandler(cfg *config.Config, st *store.Store, provided ...*router.Router) (*Handler, error) {
@@ -230,7 +223,31 @@ func NewHandler(cfg *config.Config, st *store.Store, provided ...*router.Router)
 	}
 
 	rec := recorder.New(cfg.Debug.OutputDir, cfg.Debug.MaskKey, st)
+	if cfg.Redaction.Enabled {
+		rec.Redactor, err = recorder.NewRedactionPolicy(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("build redaction policy: %w", err)
+		}
+	}
+	var exporter *otelexport.Exporter
+	if cfg.OTel.Enabled {
+		exporter, err = otelexport.New(cfg.OTel)
+		if err != nil {
+			return nil, fmt.Errorf("build otel exporter: %w", err)
+		}
+		rec.Exporter = exporter
+	}
+	requests := metrics.NewRequests()
+	rec.Metrics = requests
 	cm := chaos.New(cfg)
+	rw, err := rewrite.New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("build rewrite rules: %w", err)
+	}
+	gd, err := guard.New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("build outbound guard: %w", err)
+	}
 
 	rp := &httputil.ReverseProxy{
 		Transport: &http.Transport{
@@ -324,9 +341,30 @@ func NewHandler(cfg *config.Config, st *store.Store, provided ...*router.Router)
 		chaosManager: cm,
 		cfg:          cfg,
 		router:       rtr,
+		rewriter:     rw,
+		guard:        gd,
+		store:        st,
+		exporter:     exporter,
+		metrics:      requests,
 	}, nil
 }
 
+// Metrics 返回代理请求指标，供管理端口的 /metrics 输出。
+func (h *Handler) Metrics() *metrics.Requests {
+	if h == nil {
+		return nil
+	}
+	return h.metrics
+}
+
+// Close 发送尚未导出的 OpenTelemetry span。
+func (h *Handler) Close() error {
+	if h.exporter == nil {
+		return nil
+	}
+	return h.exporter.Close()
+}
+
 func NewHandlerWithAuth(cfg *config.Config, st *store.Store, rtr *router.Router, verifier auth.TokenVerifier) (*Handler, error) {
 	h, err := NewHandler(cfg, st, rtr)
 	if err != nil {
@@ -339,10 +377,18 @@ func NewHandlerWithAuth(cfg *config.Config, st *store.Store, rtr *router.Router,
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	start := time.Now()
 
-	if !auth.RequestAuthorized(r, h.authVerifier) {
-		w.Header().Set("WWW-Authenticate", `Bearer realm="llm-tracelab-proxy"`)
-		http.Error(w, "Unauthorized", http.StatusUnauthorized)
-		return
+	rerun := rerunFromContext(r.Context())
+	var principal auth.Principal
+	if rerun != nil {
+		principal = auth.Principal{Username: rerun.User, TokenName: rerun.TokenName}
+	} else {
+		var authorized bool
+		principal, authorized = auth.VerifyRequest(r, h.authVerifier)
+		if !authorized {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="llm-tracelab-proxy"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
 	}
 
 	if llm.NormalizeEndpoint(r.URL.Path) == "/v1/models" {
@@ -351,7 +397,7 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// [Step 1] 读取请求体并自动注入 stream_options，后续 router/recorder 复用同一份 bytes。
-	bodyBytes, err := readAndNormalizeRequestBody(r)
+	rawBody, bodyBytes, err := readAndNormalizeRequestBody(r)
 	if err != nil {
 		slog.Error("Failed to read request body", "error", err)
 		http.Error(w, "Failed to read request body", http.StatusBadRequest)
@@ -365,21 +411,25 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		logInfo   *recorder.LogInfo
 		selection *router.Selection
 		triedIDs  []string
+		guarded   *guardOutcome
 	)
+	// guard 结果在扫描后立即保留，请求结束时无论上游成功、被拦截还是全部失败都只记录一次。
+	defer func() { h.recordGuardResult(logInfo, guarded) }()
+	pinned := h.pinnedExclusions(rerun)
 
 	// 重试循环：逐个尝试候选上游目标，遇到可重试失败时自动降级到下一个。
 	for {
 		var selErr error
-		if len(triedIDs) == 0 {
+		if len(triedIDs) == 0 && len(pinned) == 0 {
 			selection, selErr = h.router.SelectWithBody(r, bodyBytes)
 		} else {
-			selection, selErr = h.router.SelectWithExclusion(r, bodyBytes, triedIDs)
+			selection, selErr = h.router.SelectWithExclusion(r, bodyBytes, append(pinned[:len(pinned):len(pinned)], triedIDs...))
 		}
 		if selErr != nil {
 			slog.Error("Failed to select upstream target", "error", selErr)
 			// 如果是第一次就失败，保持原有的 selection-failure 记录行为。
 			if len(triedIDs) == 0 {
-				h.recordSelectionFailureWithBody(r, start, http.StatusBadGateway, selErr, bodyBytes)
+				logInfo = h.recordSelectionFailureWithBody(r, start, http.StatusBadGateway, selErr, bodyBytes)
 				http.Error(w, selErr.Error(), http.StatusBadGateway)
 				return
 			}
@@ -388,6 +438,38 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 		triedIDs = append(triedIDs, selection.Target.ID)
 
+		// 按规则改写请求体；规则可按渠道匹配，因此每次选中目标后重新计算。
+		rewritten := h.rewriter.Apply(rewrite.Request{
+			Method:  r.Method,
+			Path:    r.URL.Path,
+			BaseURL: selection.Target.Upstream.BaseURL,
+			Model:   selection.Request.ModelName,
+			Token:   principal.TokenName,
+			Channel: selection.Target.ID,
+		}, bodyBytes)
+
+		// 出站 guard 扫描实际发往上游的请求体；mask/block 时录制文件只保存脱敏后的内容。
+		guardReq := guard.Request{Token: principal.TokenName, Channel: selection.Target.ID}
+		inspected := h.guard.Inspect(guardReq, rewritten.Body)
+		if inspected.Action() != "" {
+			guarded = &guardOutcome{req: guardReq, model: selection.Request.ModelName, result: inspected}
+		}
+		outBody := rewritten.Body
+		// 改写事件保留客户端原始请求体（stream_options 注入之前）。
+		originalBody := rawBody
+		switch inspected.Action() {
+		case guard.ModeMask, guard.ModeBlock:
+			outBody = inspected.Masked
+			if rewritten.Changed {
+				if masked := h.guard.Inspect(guardReq, rawBody).Masked; masked != nil {
+					originalBody = masked
+				}
+			}
+		}
+		if h.rewriter.Enabled() || h.guard.Enabled() {
+			setRequestBody(r, outBody)
+		}
+
 		// 准备日志
 		logInfo, err = h.recorder.PrepareLogFileWithOptionsAndBody(r, recorder.PrepareOptions{
 			SiteURL:                        selection.Target.Upstream.BaseURL,
@@ -396,7 +478,7 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			RoutingPolicy:                  h.routerPolicy(),
 			RoutingScore:                   selection.Score,
 			RoutingCandidateCount:          selection.CandidateCount,
-		}, bodyBytes)
+		}, outBody)
 		if err != nil {
 			slog.Error("Failed to prepare log file", "err", err)
 			h.router.Complete(selection, router.Outcome{
@@ -407,6 +489,8 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			lastErr = err
 			break
 		}
+		markRerun(rerun, logInfo)
+		logInfo.Header.Meta.TokenName = principal.TokenName
 		logInfo.Events = append(logInfo.Events, recorder.RecordEvent{
 			Type: "routing.selection",
 			Time: start,
@@ -419,6 +503,36 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				"candidate_targets": selection.Candidates,
 			},
 		})
+		if rewritten.Changed {
+			// 录制文件中的请求体为实际发往上游的改写结果，原始请求体保留在事件中。
+			logInfo.Events = append(logInfo.Events, recorder.RecordEvent{
+				Type:      "request.rewrite",
+				Time:      start,
+				BodyBytes: int64(len(rewritten.Body)),
+				Attributes: map[string]interface{}{
+					"rules":               rewritten.Rules,
+					"original_body":       string(originalBody),
+					"original_body_bytes": len(rawBody),
+				},
+			})
+		}
+		if action := inspected.Action(); action != "" {
+			logInfo.Events = append(logInfo.Events, recorder.RecordEvent{
+				Type: "guard.outbound",
+				Time: start,
+				Attributes: map[string]interface{}{
+					"action":  string(action),
+					"token":   guardReq.Token,
+					"channel": guardReq.Channel,
+					"matches": inspected.Matches,
+				},
+			})
+			if action == guard.ModeBlock {
+				h.router.Release(selection)
+				h.respondGuardBlocked(irw, logInfo, start, inspected)
+				return
+			}
+		}
 
 		// Chaos
 		chaosRes := h.chaosManager.Evaluate(logInfo.Header.Meta.Model)
@@ -429,7 +543,12 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 
 		// 发送请求到上游
-		resp, reqErr := h.sendUpstreamRequest(r, selection.Target, bodyBytes)
+		// 未配置导出器时没有本地 span，traceparent 留空以原样转发调用方的值。
+		traceparent := ""
+		if h.exporter != nil {
+			traceparent = otelexport.FormatTraceparent(logInfo.Header.Meta.W3CTraceID, logInfo.Header.Meta.W3CSpanID)
+		}
+		resp, reqErr := h.sendUpstreamRequest(r, selection.Target, outBody, traceparent)
 		if reqErr != nil {
 			// 网络层面错误（TCP 连接失败、TLS 握手失败、超时等）→ 可重试
 			logInfo.Header.Meta.Error = reqErr.Error()
@@ -488,7 +607,11 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Record the failure with a fresh log file (previous attempt logs were already
 	// closed by closeLogFile in the retry loop).
 	if h.recorder != nil {
-		h.recordSelectionFailureWithBody(r, start, http.StatusBadGateway, lastErr, bodyBytes)
+		failureBody := bodyBytes
+		if guarded != nil && guarded.result.Masked != nil && guarded.result.Action() != guard.ModeWarn {
+			failureBody = guarded.result.Masked
+		}
+		logInfo = h.recordSelectionFailureWithBody(r, start, http.StatusBadGateway, lastErr, failureBody)
 	}
 	http.Error(w, "Proxy Error: "+lastErr.Error(), http.StatusBadGateway)
 }
@@ -496,8 +619,10 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 // sendUpstreamRequest prepares a request targeting the given upstream and executes it.
 // It creates a fresh outgoing request (not cloning the original, because http.Request.Clone
 // discards the Body), applies the director logic (URL rewrite, auth headers), and returns
-// the upstream response. The caller is responsible for closing resp.Body.
-func (h *Handler) sendUpstreamRequest(original *http.Request, target *router.Target, bodyBytes []byte) (*http.Response, error) {
+// the upstream response. A non-empty traceparent replaces the caller's one so upstream spans
+// nest under the exported call; an empty one forwards the caller's header unchanged.
+// The caller is responsible for closing resp.Body.
+func (h *Handler) sendUpstreamRequest(original *http.Request, target *router.Target, bodyBytes []byte, traceparent string) (*http.Response, error) {
 	clientPath := original.URL.Path
 	if original.URL.RawQuery != "" {
 		clientPath += "?" + original.URL.RawQuery
@@ -526,6 +651,9 @@ func (h *Handler) sendUpstreamRequest(original *http.Request, target *router.Tar
 
 	target.Upstream.ApplyAuthHeaders(outreq.Header)
 	outreq.Header.Set("Accept-Encoding", "identity")
+	if traceparent != "" {
+		outreq.Header.Set(otelexport.TraceparentHeader, traceparent)
+	}
 
 	if bodyBytes != nil {
 		outreq.ContentLength = int64(len(bodyBytes))
@@ -575,7 +703,7 @@ func (h *Handler) writeUpstreamResponse(
 
 	// Write status code then body.
 	irw.WriteHeader(resp.StatusCode)
-	if _, err := io.Copy(irw, resp.Body); err != nil && err != io.EOF {
+	if _, err := io.Copy(irw, resp.Body); err != nil && err != io.EOF && originalReq.Context().Err() == nil {
 		logInfo.Header.Meta.Error = "failed to copy response body: " + err.Error()
 	}
 
@@ -590,13 +718,17 @@ func (h *Handler) writeUpstreamResponse(
 	logInfo.Header.Meta.StatusCode = code
 	logInfo.Header.Meta.ContentLength = written
 	logInfo.Header.Meta.TTFTMs = ttft
+	// Record client disconnects as a flag rather than an error: session analysis flags abandoned
+	// streams, while failure stats and alerts do not count the abort against the upstream.
+	clientCanceled := originalReq.Context().Err() != nil
+	logInfo.Header.Meta.ClientCanceled = clientCanceled
 
 	if uErr := h.recorder.UpdateLogFile(logInfo); uErr != nil {
 		slog.Error("Failed to update log file", "path", logInfo.Path, "err", uErr)
 	}
 	h.router.Complete(selection, router.Outcome{
 		Success:        code >= 200 && code < 300 && logInfo.Header.Meta.Error == "",
-		ClientCanceled: originalReq.Context().Err() != nil,
+		ClientCanceled: clientCanceled,
 		StatusCode:     code,
 		DurationMs:     float64(duration.Milliseconds()),
 		TTFTMs:         float64(ttft),
@@ -611,6 +743,83 @@ func (h *Handler) writeUpstreamResponse(
 	)
 }
 
+// respondGuardBlocked answers a request locally with a provider-shaped error when the
+// outbound guard blocks it, and records that response in the cassette.
+func (h *Handler) respondGuardBlocked(irw *InstrumentedResponseWriter, logInfo *recorder.LogInfo, start time.Time, result guard.Result) {
+	statusCode, body := guard.BlockedResponse(logInfo.Header.Meta.Provider, result)
+	irw.Header().Set("Content-Type", "application/json")
+	irw.WriteHeader(statusCode)
+	if _, err := irw.Write(body); err != nil {
+		slog.Error("Failed to write guard-blocked response", "err", err)
+	}
+
+	headerBuf := bytes.NewBufferString(fmt.Sprintf("HTTP/1.1 %d %s\r\n", statusCode, http.StatusText(statusCode)))
+	headerBuf.WriteString("Content-Type: application/json\r\n")
+	fmt.Fprintf(headerBuf, "Content-Length: %d\r\n", len(body))
+	headerBuf.WriteString("\r\n")
+	if _, err := logInfo.File.Write([]byte("\n")); err != nil {
+		slog.Error("Failed to write guard-blocked separator", "path", logInfo.Path, "err", err)
+		_ = logInfo.File.Close()
+		return
+	}
+	nHead, err := logInfo.File.Write(headerBuf.Bytes())
+	if err != nil {
+		slog.Error("Failed to write guard-blocked response header", "path", logInfo.Path, "err", err)
+		_ = logInfo.File.Close()
+		return
+	}
+	nBody, err := logInfo.File.Write(body)
+	if err != nil {
+		slog.Error("Failed to write guard-blocked response body", "path", logInfo.Path, "err", err)
+		_ = logInfo.File.Close()
+		return
+	}
+
+	logInfo.Header.Meta.StatusCode = statusCode
+	logInfo.Header.Meta.DurationMs = time.Since(start).Milliseconds()
+	logInfo.Header.Meta.ContentLength = int64(len(body))
+	logInfo.Header.Layout.ResHeaderLen = int64(nHead)
+	logInfo.Header.Layout.ResBodyLen = int64(nBody)
+	logInfo.Header.Layout.IsStream = false
+	if err := h.recorder.UpdateLogFile(logInfo); err != nil {
+		slog.Error("Failed to update guard-blocked log file", "path", logInfo.Path, "err", err)
+	}
+}
+
+// guardOutcome keeps the outbound guard result of the last inspected attempt so it
+// can be recorded once the request finishes.
+type guardOutcome struct {
+	req    guard.Request
+	model  string
+	result guard.Result
+}
+
+// recordGuardResult attaches outbound guard findings and a system event to the
+// trace that finally represents the request (upstream response, guard block or
+// exhausted-upstream failure). Without an indexed trace only the event is kept.
+func (h *Handler) recordGuardResult(logInfo *recorder.LogInfo, outcome *guardOutcome) {
+	if h == nil || h.store == nil || outcome == nil {
+		return
+	}
+	traceID := ""
+	if logInfo != nil {
+		entry, err := h.store.GetByRequestID(logInfo.Header.Meta.RequestID)
+		if err != nil {
+			slog.Warn("Outbound guard findings skipped: indexed trace not found", "request_id", logInfo.Header.Meta.RequestID, "error", err)
+		} else {
+			traceID = entry.ID
+		}
+	}
+	if traceID != "" {
+		if err := h.store.AppendFindings(traceID, guard.Findings(traceID, outcome.result)); err != nil {
+			slog.Warn("Failed to save outbound guard findings", "trace_id", traceID, "error", err)
+		}
+	}
+	if _, err := h.store.UpsertSystemEvent(guard.SystemEvent(traceID, outcome.req, outcome.model, outcome.result)); err != nil {
+		slog.Warn("Failed to record outbound guard system event", "trace_id", traceID, "error", err)
+	}
+}
+
 // closeLogFile closes and removes the log file for a failed attempt so stale
 // recordings from retried targets do not interfere with later lookup.
 func (h *Handler) closeLogFile(logInfo *recorder.LogInfo) {
@@ -728,9 +937,9 @@ func (h *Handler) routerPolicy() string {
 	return h.router.Policy()
 }
 
-func (h *Handler) recordSelectionFailureWithBody(r *http.Request, start time.Time, statusCode int, selectErr error, bodyBytes []byte) {
+func (h *Handler) recordSelectionFailureWithBody(r *http.Request, start time.Time, statusCode int, selectErr error, bodyBytes []byte) *recorder.LogInfo {
 	if h == nil || h.recorder == nil || r == nil {
-		return
+		return nil
 	}
 	reason := router.SelectionFailureReason(selectErr)
 	logInfo, err := h.recorder.PrepareLogFileWithOptionsAndBody(r, recorder.PrepareOptions{
@@ -739,8 +948,9 @@ func (h *Handler) recordSelectionFailureWithBody(r *http.Request, start time.Tim
 	}, bodyBytes)
 	if err != nil {
 		slog.Error("Failed to prepare selection-failure log file", "err", err)
-		return
+		return nil
 	}
+	markRerun(rerunFromContext(r.Context()), logInfo)
 
 	body := []byte("Proxy Error: " + selectErr.Error() + "\n")
 	headerBuf := bytes.NewBufferString(fmt.Sprintf("HTTP/1.1 %d %s\r\n", statusCode, http.StatusText(statusCode)))
@@ -752,19 +962,19 @@ func (h *Handler) recordSelectionFailureWithBody(r *http.Request, start time.Tim
 	if _, err := logInfo.File.Write([]byte("\n")); err != nil {
 		slog.Error("Failed to write selection-failure separator", "path", logInfo.Path, "err", err)
 		_ = logInfo.File.Close()
-		return
+		return nil
 	}
 	nHead, err := logInfo.File.Write(headerBuf.Bytes())
 	if err != nil {
 		slog.Error("Failed to write selection-failure response header", "path", logInfo.Path, "err", err)
 		_ = logInfo.File.Close()
-		return
+		return nil
 	}
 	nBody, err := logInfo.File.Write(body)
 	if err != nil {
 		slog.Error("Failed to write selection-failure response body", "path", logInfo.Path, "err", err)
 		_ = logInfo.File.Close()
-		return
+		return nil
 	}
 
 	logInfo.Header.Meta.Error = selectErr.Error()
@@ -787,4 +997,5 @@ func (h *Handler) recordSelectionFailureWithBody(r *http.Request, start time.Tim
 	if err := h.recorder.UpdateLogFile(logInfo); err != nil {
 		slog.Error("Failed to update selection-failure log file", "path", logInfo.Path, "err", err)
 	}
+	return logInfo
 }
@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
@@ -1368,3 +1369,433 @@ func findRecordedHTTP(t *testing.T, root string) string {
 	}
 	return found
 }
+
+func TestHandlerRewriteRulesRecordOriginalBody(t *testing.T) {
+	outputDir := t.TempDir()
+	st, err := store.New(outputDir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	var upstreamBody []byte
+	upstreamServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upstreamBody, _ = io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"id":"chatcmpl_1","object":"chat.completion","choices":[],"usage":{"prompt_tokens":3,"completion_tokens":2,"total_tokens":5}}`)
+	}))
+	defer upstreamServer.Close()
+
+	cfg := &config.Config{
+		Upstreams: []config.UpstreamTargetConfig{{
+			ID:             "primary",
+			Enabled:        boolPtr(true),
+			ModelDiscovery: router.ModelDiscoveryStaticOnly,
+			StaticModels:   []string{"gpt-4.1"},
+			Upstream: config.UpstreamConfig{
+				BaseURL:        upstreamServer.URL + "/v1",
+				ProviderPreset: "openai",
+			},
+		}},
+	}
+	cfg.Debug.OutputDir = outputDir
+	cfg.Rewrite.Rules = []config.RewriteRule{{
+		Name:      "cap-primary",
+		Match:     config.RewriteMatch{Channels: []string{"primary"}},
+		MaxTokens: 64,
+		Remove:    []string{"logprobs"},
+	}}
+
+	handler, err := NewHandler(cfg, st)
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+	proxyServer := httptest.NewServer(handler)
+	defer proxyServer.Close()
+
+	// 流式请求会注入 stream_options，改写事件仍应保留客户端发来的原始请求体。
+	original := `{"model":"gpt-4.1","stream":true,"max_tokens":4096,"logprobs":true,"messages":[{"role":"user","content":"hi"}]}`
+	req, err := http.NewRequest(http.MethodPost, proxyServer.URL+"/v1/chat/completions", bytes.NewBufferString(original))
+	if err != nil {
+		t.Fatalf("http.NewRequest() error = %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := proxyServer.Client().Do(req)
+	if err != nil {
+		t.Fatalf("client.Do() error = %v", err)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("resp.StatusCode = %d, want 200", resp.StatusCode)
+	}
+
+	var sent map[string]any
+	if err := json.Unmarshal(upstreamBody, &sent); err != nil {
+		t.Fatalf("upstream body %q is not JSON: %v", upstreamBody, err)
+	}
+	if sent["max_tokens"] != float64(64) || sent["logprobs"] != nil || sent["stream_options"] == nil {
+		t.Fatalf("upstream body = %s, want rewritten body", upstreamBody)
+	}
+
+	recordPath := findRecordedHTTP(t, outputDir)
+	parsed, err := waitForRecordedPrelude(recordPath, time.Second)
+	if err != nil {
+		t.Fatalf("waitForRecordedPrelude(%q) error = %v", recordPath, err)
+	}
+	content, err := os.ReadFile(recordPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	_, reqBody, _, _ := recordfile.ExtractSections(content, parsed)
+	if !bytes.Equal(reqBody, upstreamBody) {
+		t.Fatalf("recorded request body = %s, want %s", reqBody, upstreamBody)
+	}
+	var rewriteEvent *recordfile.RecordEvent
+	for i := range parsed.Events {
+		if parsed.Events[i].Type == "request.rewrite" {
+			rewriteEvent = &parsed.Events[i]
+		}
+	}
+	if rewriteEvent == nil {
+		t.Fatalf("events = %+v, want request.rewrite", parsed.Events)
+	}
+	if rewriteEvent.Attributes["original_body"] != original {
+		t.Fatalf("original_body = %v, want %s", rewriteEvent.Attributes["original_body"], original)
+	}
+}
+
+func TestHandlerOutboundGuardModes(t *testing.T) {
+	const secret = "sk-test_abcdefghijklmnopqrstuvwxyz"
+	tests := []struct {
+		name           string
+		mode           string
+		upstreamStatus int
+		wantStatus     int
+		wantUpstream   bool
+		wantSeverity   string
+		wantBodyMatch  string
+	}{
+		{name: "mask", mode: "mask", wantStatus: http.StatusOK, wantUpstream: true, wantSeverity: "warning", wantBodyMatch: "[REDACTED:OpenAI API key]"},
+		{name: "block", mode: "block", wantStatus: http.StatusBadRequest, wantUpstream: false, wantSeverity: "warning", wantBodyMatch: "sensitive_data_blocked"},
+		{name: "mask upstream failure", mode: "mask", upstreamStatus: http.StatusServiceUnavailable, wantStatus: http.StatusBadGateway, wantUpstream: true, wantSeverity: "warning", wantBodyMatch: "[REDACTED:OpenAI API key]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputDir := t.TempDir()
+			st, err := store.New(outputDir)
+			if err != nil {
+				t.Fatalf("store.New() error = %v", err)
+			}
+			defer st.Close()
+
+			var upstreamBody []byte
+			upstreamServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/chat/completions" {
+					http.NotFound(w, r)
+					return
+				}
+				upstreamBody, _ = io.ReadAll(r.Body)
+				if tt.upstreamStatus != 0 {
+					w.WriteHeader(tt.upstreamStatus)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = io.WriteString(w, `{"id":"chatcmpl_1","object":"chat.completion","choices":[],"usage":{"prompt_tokens":3,"completion_tokens":2,"total_tokens":5}}`)
+			}))
+			defer upstreamServer.Close()
+
+			cfg := &config.Config{}
+			cfg.Upstream.BaseURL = upstreamServer.URL + "/v1"
+			cfg.Debug.OutputDir = outputDir
+			cfg.Guard.Enabled = true
+			cfg.Guard.Mode = tt.mode
+
+			handler, err := NewHandler(cfg, st)
+			if err != nil {
+				t.Fatalf("NewHandler() error = %v", err)
+			}
+			proxyServer := httptest.NewServer(handler)
+			defer proxyServer.Close()
+
+			body := `{"model":"gpt-4.1","messages":[{"role":"user","content":"use ` + secret + `"}]}`
+			req, err := http.NewRequest(http.MethodPost, proxyServer.URL+"/v1/chat/completions", bytes.NewBufferString(body))
+			if err != nil {
+				t.Fatalf("http.NewRequest() error = %v", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			resp, err := proxyServer.Client().Do(req)
+			if err != nil {
+				t.Fatalf("client.Do() error = %v", err)
+			}
+			respBody, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("resp.StatusCode = %d, want %d (%s)", resp.StatusCode, tt.wantStatus, respBody)
+			}
+			if (upstreamBody != nil) != tt.wantUpstream {
+				t.Fatalf("upstream called = %v, want %v", upstreamBody != nil, tt.wantUpstream)
+			}
+			if bytes.Contains(upstreamBody, []byte(secret)) {
+				t.Fatalf("upstream received secret: %s", upstreamBody)
+			}
+
+			recordPath := findRecordedHTTP(t, outputDir)
+			if _, err := waitForRecordedPrelude(recordPath, time.Second); err != nil {
+				t.Fatalf("waitForRecordedPrelude(%q) error = %v", recordPath, err)
+			}
+			content, err := os.ReadFile(recordPath)
+			if err != nil {
+				t.Fatalf("ReadFile() error = %v", err)
+			}
+			if bytes.Contains(content, []byte(secret)) {
+				t.Fatalf("cassette contains secret")
+			}
+			if !bytes.Contains(content, []byte(tt.wantBodyMatch)) {
+				t.Fatalf("cassette missing %q", tt.wantBodyMatch)
+			}
+
+			entries, err := waitForRecentEntries(st, 1, time.Second)
+			if err != nil {
+				t.Fatalf("waitForRecentEntries() error = %v", err)
+			}
+			findings, err := st.ListFindings(entries[0].ID, store.FindingFilter{Category: "outbound_credential"})
+			if err != nil {
+				t.Fatalf("ListFindings() error = %v", err)
+			}
+			if len(findings) != 1 {
+				t.Fatalf("findings = %+v, want one outbound credential finding", findings)
+			}
+			events, err := st.ListSystemEvents(store.SystemEventFilter{Category: "outbound_guard"})
+			if err != nil {
+				t.Fatalf("ListSystemEvents() error = %v", err)
+			}
+			if len(events.Items) != 1 || events.Items[0].Severity != tt.wantSeverity || events.Items[0].TraceID != entries[0].ID {
+				t.Fatalf("system events = %+v", events.Items)
+			}
+		})
+	}
+}
+
+func TestHandlerForwardsCallerTraceparentWithoutOTel(t *testing.T) {
+	const callerTraceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	outputDir := t.TempDir()
+	st, err := store.New(outputDir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	var upstreamTraceparent string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upstreamTraceparent = r.Header.Get("traceparent")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"id":"chatcmpl-1","object":"chat.completion","model":"gpt-4o-mini","choices":[{"index":0,"message":{"role":"assistant","content":"hi"},"finish_reason":"stop"}],"usage":{"prompt_tokens":9,"completion_tokens":2,"total_tokens":11}}`)
+	}))
+	defer upstream.Close()
+
+	cfg := &config.Config{}
+	cfg.Upstream.BaseURL = upstream.URL + "/v1"
+	cfg.Debug.OutputDir = outputDir
+	handler, err := NewHandler(cfg, st)
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/v1/chat/completions", bytes.NewBufferString(`{"model":"gpt-4o-mini","messages":[{"role":"user","content":"hello"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("traceparent", callerTraceparent)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	// 没有导出器时不创建本地 span，上游收到的仍是调用方的 traceparent。
+	if upstreamTraceparent != callerTraceparent {
+		t.Fatalf("upstream traceparent = %q, want caller's %q", upstreamTraceparent, callerTraceparent)
+	}
+	parsed, err := waitForRecordedPrelude(findRecordedHTTP(t, outputDir), time.Second)
+	if err != nil {
+		t.Fatalf("waitForRecordedPrelude() error = %v", err)
+	}
+	if meta := parsed.Header.Meta; meta.W3CTraceID != "" || meta.W3CSpanID != "" {
+		t.Fatalf("recorded trace context = %q/%q, want none without an exporter", meta.W3CTraceID, meta.W3CSpanID)
+	}
+}
+
+func TestHandlerRecordsClientCancelWithoutError(t *testing.T) {
+	outputDir := t.TempDir()
+	st, err := store.New(outputDir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	firstChunk, release := make(chan struct{}), make(chan struct{})
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = io.WriteString(w, "data: {\"id\":\"chatcmpl-1\",\"object\":\"chat.completion.chunk\",\"model\":\"gpt-4o-mini\",\"choices\":[{\"index\":0,\"delta\":{\"content\":\"hi\"}}]}\n\n")
+		w.(http.Flusher).Flush()
+		close(firstChunk)
+		<-release
+		_, _ = io.WriteString(w, "data: [DONE]\n\n")
+	}))
+	defer upstream.Close()
+
+	cfg := &config.Config{}
+	cfg.Upstream.BaseURL = upstream.URL + "/v1"
+	cfg.Debug.OutputDir = outputDir
+	handler, err := NewHandler(cfg, st)
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req := httptest.NewRequest(http.MethodPost, "/v1/chat/completions", bytes.NewBufferString(`{"model":"gpt-4o-mini","stream":true,"messages":[{"role":"user","content":"hello"}]}`)).WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}()
+	// 上游请求不随客户端取消，客户端断开后上游照常把流发完。
+	<-firstChunk
+	cancel()
+	close(release)
+	<-done
+
+	// 客户端中途断开只记为取消标记，不写错误文本，失败统计与告警不会把它算作调用失败。
+	parsed, err := waitForRecordedPrelude(findRecordedHTTP(t, outputDir), time.Second)
+	if err != nil {
+		t.Fatalf("waitForRecordedPrelude() error = %v", err)
+	}
+	if meta := parsed.Header.Meta; !meta.ClientCanceled || meta.Error != "" {
+		t.Fatalf("recorded meta client_canceled=%v error=%q, want canceled without error", meta.ClientCanceled, meta.Error)
+	}
+	entries, err := st.ListRecent(10)
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("ListRecent() = %+v, err = %v", entries, err)
+	}
+	if meta := entries[0].Header.Meta; !meta.ClientCanceled || meta.Error != "" {
+		t.Fatalf("indexed meta client_canceled=%v error=%q, want canceled without error", meta.ClientCanceled, meta.Error)
+	}
+}
+
+func TestHandlerExportsOTelSpanUnderCallerTrace(t *testing.T) {
+	const (
+		callerTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+		callerSpanID  = "00f067aa0ba902b7"
+	)
+	outputDir := t.TempDir()
+	st, err := store.New(outputDir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	var upstreamTraceparent string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upstreamTraceparent = r.Header.Get("traceparent")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"id":"chatcmpl-1","object":"chat.completion","model":"gpt-4o-mini","choices":[{"index":0,"message":{"role":"assistant","content":"hi"},"finish_reason":"stop"}],"usage":{"prompt_tokens":9,"completion_tokens":2,"total_tokens":11}}`)
+	}))
+	defer upstream.Close()
+
+	exported := make(chan map[string]any, 4)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" {
+			http.NotFound(w, r)
+			return
+		}
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("decode OTLP payload: %v", err)
+		}
+		exported <- payload
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer collector.Close()
+
+	cfg := &config.Config{}
+	cfg.Upstream.BaseURL = upstream.URL + "/v1"
+	cfg.Debug.OutputDir = outputDir
+	cfg.OTel.Enabled = true
+	cfg.OTel.Endpoint = collector.URL
+	cfg.OTel.FlushInterval = time.Hour
+
+	handler, err := NewHandler(cfg, st)
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+	proxyServer := httptest.NewServer(handler)
+	defer proxyServer.Close()
+
+	req, err := http.NewRequest(http.MethodPost, proxyServer.URL+"/v1/chat/completions", bytes.NewBufferString(`{"model":"gpt-4o-mini","messages":[{"role":"user","content":"hello"}]}`))
+	if err != nil {
+		t.Fatalf("http.NewRequest() error = %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("traceparent", "00-"+callerTraceID+"-"+callerSpanID+"-01")
+	resp, err := proxyServer.Client().Do(req)
+	if err != nil {
+		t.Fatalf("client.Do() error = %v", err)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	parsed, err := waitForRecordedPrelude(findRecordedHTTP(t, outputDir), time.Second)
+	if err != nil {
+		t.Fatalf("waitForRecordedPrelude() error = %v", err)
+	}
+	meta := parsed.Header.Meta
+	if meta.W3CTraceID != callerTraceID || meta.W3CParentSpanID != callerSpanID || meta.W3CSpanID == "" {
+		t.Fatalf("recorded trace context = %q/%q/%q, want caller trace and parent", meta.W3CTraceID, meta.W3CSpanID, meta.W3CParentSpanID)
+	}
+	if want := "00-" + callerTraceID + "-" + meta.W3CSpanID + "-01"; upstreamTraceparent != want {
+		t.Fatalf("upstream traceparent = %q, want %q", upstreamTraceparent, want)
+	}
+
+	if err := handler.Close(); err != nil {
+		t.Fatalf("handler.Close() error = %v", err)
+	}
+	var payload map[string]any
+	select {
+	case payload = <-exported:
+	case <-time.After(time.Second):
+		t.Fatal("collector received no spans")
+	}
+	resourceSpans := payload["resourceSpans"].([]any)[0].(map[string]any)
+	span := resourceSpans["scopeSpans"].([]any)[0].(map[string]any)["spans"].([]any)[0].(map[string]any)
+	if span["traceId"] != callerTraceID || span["parentSpanId"] != callerSpanID || span["spanId"] != meta.W3CSpanID {
+		t.Fatalf("span ids = %v/%v/%v, want caller trace with recorded span", span["traceId"], span["spanId"], span["parentSpanId"])
+	}
+	if span["name"] != "chat gpt-4o-mini" {
+		t.Fatalf("span name = %v, want chat gpt-4o-mini", span["name"])
+	}
+	attrs := map[string]any{}
+	for _, raw := range span["attributes"].([]any) {
+		attr := raw.(map[string]any)
+		value := attr["value"].(map[string]any)
+		for _, key := range []string{"stringValue", "intValue"} {
+			if v, ok := value[key]; ok {
+				attrs[attr["key"].(string)] = v
+			}
+		}
+		if array, ok := value["arrayValue"].(map[string]any); ok {
+			attrs[attr["key"].(string)] = array["values"].([]any)[0].(map[string]any)["stringValue"]
+		}
+	}
+	for key, want := range map[string]any{
+		"gen_ai.system":                  "openai",
+		"gen_ai.operation.name":          "chat",
+		"gen_ai.request.model":           "gpt-4o-mini",
+		"gen_ai.usage.input_tokens":      "9",
+		"gen_ai.usage.output_tokens":     "2",
+		"gen_ai.response.finish_reasons": "stop",
+		"http.response.status_code":      "200",
+	} {
+		if attrs[key] != want {
+			t.Fatalf("attribute %s = %v, want %v (all: %v)", key, attrs[key], want, attrs)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package proxy
+
+import (
+	"context"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/recorder"
+)
+
+const rerunContextKey contextKey = "playground_rerun"
+
+// Rerun 描述 Monitor playground 在进程内经代理重放一条录制请求时附带的信息。
+// 它只能通过 WithRerun 放进 context，外部 HTTP 客户端无法伪造，因此重放请求跳过代理鉴权。
+type Rerun struct {
+	OriginalTraceID string
+	// Channel 非空时只路由到该渠道，不做降级重试。
+	Channel string
+	// User 是发起重放的 Monitor 用户，记录在 playground.rerun 事件中。
+	User string
+	// TokenName 沿用原始 trace 的代理 token 名称，使按 token 生效的改写、guard 策略与指标标签保持一致。
+	TokenName string
+	// RequestID 由代理在录制时回填，调用方据此在 store 中找到新 trace。
+	RequestID string
+	// Source 非空时表示不是 playground 重放，而是其他进程内调用方（如 eval_judge）发起的新请求，
+	// 此时 OriginalTraceID 可以为空，录制事件类型为 <Source>.request。
+	Source string
+}
+
+func WithRerun(ctx context.Context, rerun *Rerun) context.Context {
+	return context.WithValue(ctx, rerunContextKey, rerun)
+}
+
+func rerunFromContext(ctx context.Context) *Rerun {
+	rerun, _ := ctx.Value(rerunContextKey).(*Rerun)
+	return rerun
+}
+
+// pinnedExclusions 返回指定渠道以外的全部目标，配合 SelectWithExclusion 把路由限定在该渠道上。
+func (h *Handler) pinnedExclusions(rerun *Rerun) []string {
+	if rerun == nil || rerun.Channel == "" || h.router == nil {
+		return nil
+	}
+	var excluded []string
+	for _, target := range h.router.Targets() {
+		if target.ID != rerun.Channel {
+			excluded = append(excluded, target.ID)
+		}
+	}
+	return excluded
+}
+
+func markRerun(rerun *Rerun, logInfo *recorder.LogInfo) {
+	if rerun == nil || logInfo == nil {
+		return
+	}
+	logInfo.Header.Meta.RerunOf = rerun.OriginalTraceID
+	eventType := "playground.rerun"
+	if rerun.Source != "" {
+		eventType = rerun.Source + ".request"
+	}
+	logInfo.Events = append(logInfo.Events, recorder.RecordEvent{
+		Type: eventType,
+		Time: time.Now().UTC(),
+		Attributes: map[string]interface{}{
+			"original_trace_id": rerun.OriginalTraceID,
+			"channel":           rerun.Channel,
+			"user":              rerun.User,
+		},
+	})
+	rerun.RequestID = logInfo.Header.Meta.RequestID
+}
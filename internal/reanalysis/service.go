@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -86,10 +85,11 @@ type Result struct {
 }
 
 type SessionResult struct {
-	SessionID     string `json:"session_id"`
-	TraceCount    int    `json:"trace_count"`
-	AnalysisRunID int64  `json:"analysis_run_id"`
-	FindingRefs   int    `json:"finding_refs"`
+	SessionID       string `json:"session_id"`
+	TraceCount      int    `json:"trace_count"`
+	AnalysisRunID   int64  `json:"analysis_run_id"`
+	FindingRefs     int    `json:"finding_refs"`
+	SessionFindings int    `json:"session_findings"`
 }
 
 type BatchResult struct {
@@ -300,7 +300,7 @@ func (s *Service) repairUsage(traceID string, opts RepairUsageOptions) (UsageRep
 	if err != nil {
 		return UsageRepairResult{}, err
 	}
-	content, err := os.ReadFile(entry.LogPath)
+	content, err := recordfile.ReadFile(entry.LogPath)
 	if err != nil {
 		return UsageRepairResult{}, err
 	}
@@ -344,7 +344,7 @@ func (s *Service) repairUsage(traceID string, opts RepairUsageOptions) (UsageRep
 		updated := make([]byte, 0, len(prelude)+len(payload))
 		updated = append(updated, prelude...)
 		updated = append(updated, payload...)
-		if err := os.WriteFile(entry.LogPath, updated, 0o644); err != nil {
+		if err := recordfile.WriteFile(entry.LogPath, updated, 0o644); err != nil {
 			return UsageRepairResult{}, err
 		}
 		repair.CassetteRewrote = true
@@ -573,6 +573,11 @@ func (s *Service) runSessionJob(ctx context.Context, job store.AnalysisJobRecord
 			return Result{}, err
 		}
 	}
+	trajectory := sessionanalysis.BuildTrajectory(job.TargetID, traces, sessionanalysis.ParseObservations(ctx, traces))
+	sessionFindings := sessionanalysis.Detect(trajectory, sessionanalysis.DefaultDetectorOptions())
+	if err = s.store.SaveSessionFindings(job.TargetID, sessionFindings); err != nil {
+		return Result{}, err
+	}
 	findingsByTrace := map[string][]observe.Finding{}
 	for _, trace := range traces {
 		findings, findErr := s.store.ListFindings(trace.ID, store.FindingFilter{})
@@ -583,6 +588,7 @@ func (s *Service) runSessionJob(ctx context.Context, job store.AnalysisJobRecord
 		findingsByTrace[trace.ID] = findings
 	}
 	output := sessionanalysis.Build(summary, traces, findingsByTrace)
+	output.Trajectory = &trajectory
 	outputJSON, err := sessionanalysis.Marshal(output)
 	if err != nil {
 		return Result{}, err
@@ -600,10 +606,11 @@ func (s *Service) runSessionJob(ctx context.Context, job store.AnalysisJobRecord
 		return Result{}, err
 	}
 	result.Session = &SessionResult{
-		SessionID:     job.TargetID,
-		TraceCount:    len(output.TraceRefs),
-		AnalysisRunID: runID,
-		FindingRefs:   len(output.FindingRefs),
+		SessionID:       job.TargetID,
+		TraceCount:      len(output.TraceRefs),
+		AnalysisRunID:   runID,
+		FindingRefs:     len(output.FindingRefs),
+		SessionFindings: len(sessionFindings),
 	}
 	resultJSON, marshalErr := json.Marshal(resultSummary(result))
 	if marshalErr != nil {
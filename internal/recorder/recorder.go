@@ -14,9 +14,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/kingfs/llm-tracelab/internal/otelexport"
 	"github.com/kingfs/llm-tracelab/internal/store"
 	"github.com/kingfs/llm-tracelab/pkg/llm"
 	"github.com/kingfs/llm-tracelab/pkg/recordfile"
+	"github.com/kingfs/llm-tracelab/pkg/redact"
 )
 
 const (
@@ -50,7 +52,24 @@ type PrepareOptions struct {
 type Recorder struct {
 	OutputDir string
 	MaskKey   bool
-	store     *store.Store
+	// Redactor 非空时在落盘前对整个 cassette 脱敏，layout 长度随之更新。
+	Redactor *redact.Policy
+	// Exporter 非空时在 cassette 落盘后接收完整内容，用于导出 OpenTelemetry span。
+	Exporter SpanExporter
+	// Metrics 非空时在 cassette 落盘后累计请求指标。
+	Metrics RecordObserver
+	store   *store.Store
+}
+
+// RecordObserver 接收落盘后的最终 header，用于累计请求指标。
+type RecordObserver interface {
+	ObserveRecord(info *LogInfo)
+}
+
+// SpanExporter 接收落盘后的完整 cassette 内容（prelude + payload）。
+// ExportRecord 在请求路径上调用，实现应只排队并尽快返回，解析放到后台进行。
+type SpanExporter interface {
+	ExportRecord(content []byte)
 }
 
 func New(outputDir string, maskKey bool, st *store.Store) *Recorder {
@@ -104,6 +123,17 @@ func (r *Recorder) PrepareLogFileWithOptionsAndBody(req *http.Request, opts Prep
 	}
 
 	now := time.Now()
+	// 只有配置了 span 导出时才为本次调用创建 span：沿用调用方的 W3C trace 使其挂在调用方之下，否则开启新 trace。
+	// 未导出时不生成 ID，调用方的 traceparent 原样转发给上游，避免上游 span 挂到不存在的父 span 上。
+	var traceID, spanID, parentSpanID string
+	if r.Exporter != nil {
+		var ok bool
+		traceID, parentSpanID, ok = otelexport.ParseTraceparent(req.Header.Get(otelexport.TraceparentHeader))
+		if !ok {
+			traceID, parentSpanID = otelexport.NewTraceID(), ""
+		}
+		spanID = otelexport.NewSpanID()
+	}
 	semantics := llm.ClassifyHTTPRequest(req, opts.SiteURL)
 	dirPath := filepath.Join(
 		r.OutputDir,
@@ -181,6 +211,9 @@ func (r *Recorder) PrepareLogFileWithOptionsAndBody(req *http.Request, opts Prep
 			RoutingScore:                   opts.RoutingScore,
 			RoutingCandidateCount:          opts.RoutingCandidateCount,
 			RoutingFailureReason:           opts.RoutingFailureReason,
+			W3CTraceID:                     traceID,
+			W3CSpanID:                      spanID,
+			W3CParentSpanID:                parentSpanID,
 		},
 		Layout: LayoutInfo{
 			ReqHeaderLen: int64(nHead),
@@ -205,6 +238,10 @@ func (r *Recorder) UpdateLogFile(info *LogInfo) error {
 	if err != nil {
 		return err
 	}
+	if r.Redactor.Enabled() {
+		payload, info.Header.Layout, _ = r.Redactor.Payload(payload, info.Header.Layout)
+		r.Redactor.Events(info.Events)
+	}
 
 	events := recordfile.BuildEvents(info.Header)
 	if len(info.Events) > 0 {
@@ -228,8 +265,17 @@ func (r *Recorder) UpdateLogFile(info *LogInfo) error {
 		return err
 	}
 
+	var fullContent []byte
+	if r.store != nil || r.Exporter != nil {
+		fullContent = append(append([]byte{}, prelude...), payload...)
+	}
+	if r.Exporter != nil {
+		r.Exporter.ExportRecord(fullContent)
+	}
+	if r.Metrics != nil {
+		r.Metrics.ObserveRecord(info)
+	}
 	if r.store != nil {
-		fullContent := append(append([]byte{}, prelude...), payload...)
 		parsed, err := recordfile.ParsePrelude(fullContent)
 		if err != nil {
 			return err
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kingfs/llm-tracelab/internal/config"
 	"github.com/kingfs/llm-tracelab/internal/store"
 	"github.com/kingfs/llm-tracelab/pkg/recordfile"
 )
@@ -195,3 +196,60 @@ func TestUpdateLogFileEnqueuesParseJob(t *testing.T) {
 		t.Fatalf("jobs = %+v, want trace %s", jobs, entry.ID)
 	}
 }
+
+func TestUpdateLogFileAppliesRedactionPolicy(t *testing.T) {
+	dir := t.TempDir()
+	cfg := &config.Config{}
+	cfg.Redaction.Headers = []string{"Authorization"}
+	cfg.Redaction.JSONPaths = []string{"$.user"}
+	cfg.Redaction.Patterns = []config.RedactionPattern{{Name: "email"}}
+	policy, err := NewRedactionPolicy(cfg)
+	if err != nil {
+		t.Fatalf("NewRedactionPolicy() error = %v", err)
+	}
+	rec := New(dir, false, nil)
+	rec.Redactor = policy
+
+	req, err := http.NewRequest(http.MethodPost, "http://proxy.local/v1/chat/completions", bytes.NewBufferString(`{"model":"gpt-5","user":"u-1","messages":[{"role":"user","content":"hi bob@example.com"}]}`))
+	if err != nil {
+		t.Fatalf("http.NewRequest() error = %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer secret-token")
+
+	info, err := rec.PrepareLogFile(req, "https://api.openai.com")
+	if err != nil {
+		t.Fatalf("PrepareLogFile() error = %v", err)
+	}
+	resHeader := "HTTP/1.1 200 OK\r\nContent-Length: 27\r\n\r\n"
+	resBody := `{"reply":"bob@example.com"}`
+	info.Header.Meta.StatusCode = 200
+	info.Header.Layout.ResHeaderLen = int64(len(resHeader))
+	info.Header.Layout.ResBodyLen = int64(len(resBody))
+	if _, err := info.File.Write([]byte("\n" + resHeader + resBody)); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := rec.UpdateLogFile(info); err != nil {
+		t.Fatalf("UpdateLogFile() error = %v", err)
+	}
+
+	content, err := os.ReadFile(info.Path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	for _, leaked := range []string{"secret-token", `"u-1"`, "bob@example.com"} {
+		if strings.Contains(string(content), leaked) {
+			t.Fatalf("recorded cassette leaked %q:\n%s", leaked, content)
+		}
+	}
+	parsed, err := recordfile.ParsePrelude(content)
+	if err != nil {
+		t.Fatalf("ParsePrelude() error = %v", err)
+	}
+	_, reqBody, _, gotResBody := recordfile.ExtractSections(content, parsed)
+	if int64(len(reqBody)) != parsed.Header.Layout.ReqBodyLen || int64(len(gotResBody)) != parsed.Header.Layout.ResBodyLen {
+		t.Fatalf("layout = %+v, req=%d res=%d", parsed.Header.Layout, len(reqBody), len(gotResBody))
+	}
+	if parsed.Events[0].BodyBytes != parsed.Header.Layout.ReqBodyLen {
+		t.Fatalf("request event = %+v, layout = %+v", parsed.Events[0], parsed.Header.Layout)
+	}
+}
@@ -0,0 +1,65 @@
+package recorder
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kingfs/llm-tracelab/internal/analyzer"
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/pkg/redact"
+)
+
+// NewRedactionPolicy 按配置构建脱敏策略，不检查 Redaction.Enabled：
+// 录制链路由调用方判断是否启用，离线 scrub 命令总是使用配置中的规则。
+func NewRedactionPolicy(cfg *config.Config) (*redact.Policy, error) {
+	if cfg == nil {
+		return redact.New(redact.Options{})
+	}
+	opts := redact.Options{
+		Headers:     cfg.Redaction.Headers,
+		JSONPaths:   cfg.Redaction.JSONPaths,
+		Replacement: cfg.Redaction.Replacement,
+		HashKey:     cfg.Redaction.HashKey,
+	}
+	for _, raw := range cfg.Redaction.Patterns {
+		patterns, err := redactionPatterns(raw)
+		if err != nil {
+			return nil, err
+		}
+		opts.Patterns = append(opts.Patterns, patterns...)
+	}
+	return redact.New(opts)
+}
+
+func redactionPatterns(raw config.RedactionPattern) ([]redact.Pattern, error) {
+	name := strings.TrimSpace(raw.Name)
+	if strings.TrimSpace(raw.Pattern) != "" {
+		re, err := regexp.Compile(raw.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("redaction pattern %q: %w", name, err)
+		}
+		if name == "" {
+			name = raw.Pattern
+		}
+		return []redact.Pattern{{Name: name, Regexp: re}}, nil
+	}
+
+	var builtins []analyzer.SensitivePattern
+	if strings.EqualFold(name, "credentials") {
+		builtins = analyzer.CredentialPatterns()
+	} else if builtin, ok := analyzer.PIIPattern(name); ok {
+		builtins = append(builtins, builtin)
+	} else {
+		return nil, fmt.Errorf("redaction pattern %q: unknown built-in pattern", name)
+	}
+	out := make([]redact.Pattern, 0, len(builtins))
+	for _, builtin := range builtins {
+		out = append(out, redact.Pattern{
+			Name:     strings.ReplaceAll(strings.ToLower(builtin.Name), " ", "_"),
+			Regexp:   builtin.Regexp,
+			Validate: builtin.Validate,
+		})
+	}
+	return out, nil
+}
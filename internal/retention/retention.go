@@ -0,0 +1,250 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/glob"
+	"github.com/kingfs/llm-tracelab/internal/store"
+)
+
+const (
+	ReasonMaxAge   = "max_age"
+	ReasonMaxTotal = "max_total"
+)
+
+// Worker 按保留策略定期删除过期或超出容量的录制，cassette 与数据库中的派生数据一并清理。
+type Worker struct {
+	store            *store.Store
+	maxAge           time.Duration
+	maxTotalBytes    int64
+	keepWithFindings bool
+	keepInDatasets   bool
+	rules            []Rule
+	interval         time.Duration
+	now              func() time.Time
+}
+
+type Options struct {
+	MaxAge           time.Duration
+	MaxTotalBytes    int64
+	KeepWithFindings bool
+	KeepInDatasets   bool
+	Rules            []Rule
+	Interval         time.Duration
+}
+
+// Rule 覆盖命中模型/渠道的保留期限；MaxAge 为 0 表示不按时间清理。
+type Rule struct {
+	Models   []string
+	Channels []string
+	MaxAge   time.Duration
+}
+
+type Candidate struct {
+	TraceID    string    `json:"trace_id"`
+	Path       string    `json:"path"`
+	Model      string    `json:"model"`
+	UpstreamID string    `json:"upstream_id,omitempty"`
+	RecordedAt time.Time `json:"recorded_at"`
+	FileSize   int64     `json:"file_size"`
+	Reason     string    `json:"reason"`
+}
+
+type Plan struct {
+	Traces     int         `json:"traces"`
+	TotalBytes int64       `json:"total_bytes"`
+	Protected  int         `json:"protected"`
+	Candidates []Candidate `json:"candidates"`
+}
+
+// ReclaimBytes 返回执行计划后预计释放的 cassette 字节数。
+func (p Plan) ReclaimBytes() int64 {
+	var total int64
+	for _, candidate := range p.Candidates {
+		total += candidate.FileSize
+	}
+	return total
+}
+
+type Result struct {
+	Deleted        int   `json:"deleted"`
+	Failed         int   `json:"failed"`
+	ReclaimedBytes int64 `json:"reclaimed_bytes"`
+}
+
+func New(st *store.Store, opts Options) (*Worker, error) {
+	for _, rule := range opts.Rules {
+		for _, patterns := range [][]string{rule.Models, rule.Channels} {
+			if err := glob.Validate(patterns); err != nil {
+				return nil, fmt.Errorf("retention rule: %w", err)
+			}
+		}
+	}
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &Worker{
+		store:            st,
+		maxAge:           max(opts.MaxAge, 0),
+		maxTotalBytes:    max(opts.MaxTotalBytes, 0),
+		keepWithFindings: opts.KeepWithFindings,
+		keepInDatasets:   opts.KeepInDatasets,
+		rules:            opts.Rules,
+		interval:         interval,
+		now:              time.Now,
+	}, nil
+}
+
+// OptionsFromConfig 把 retention 配置转换为 Worker 参数。
+func OptionsFromConfig(cfg config.RetentionConfig) Options {
+	opts := Options{
+		MaxAge:           days(cfg.MaxAgeDays),
+		MaxTotalBytes:    cfg.MaxTotalMB * 1024 * 1024,
+		KeepWithFindings: cfg.KeepWithFindings,
+		KeepInDatasets:   cfg.KeepInDatasets,
+		Interval:         cfg.Interval,
+	}
+	for _, rule := range cfg.Rules {
+		opts.Rules = append(opts.Rules, Rule{
+			Models:   rule.Models,
+			Channels: rule.Channels,
+			MaxAge:   days(rule.MaxAgeDays),
+		})
+	}
+	return opts
+}
+
+func days(n int) time.Duration {
+	return time.Duration(max(n, 0)) * 24 * time.Hour
+}
+
+func (w *Worker) Run(ctx context.Context) {
+	if w == nil || w.store == nil {
+		return
+	}
+	w.runOnce(ctx)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.runOnce(ctx)
+		}
+	}
+}
+
+func (w *Worker) runOnce(ctx context.Context) {
+	result, err := w.RunOnce(ctx)
+	if err != nil {
+		slog.Warn("Trace retention failed", "error", err)
+		return
+	}
+	if result.Deleted > 0 || result.Failed > 0 {
+		slog.Info("Trace retention finished", "deleted", result.Deleted, "failed", result.Failed, "reclaimed_bytes", result.ReclaimedBytes)
+	}
+}
+
+// RunOnce 计算并执行一次清理计划。
+func (w *Worker) RunOnce(ctx context.Context) (Result, error) {
+	plan, err := w.Plan()
+	if err != nil {
+		return Result{}, err
+	}
+	return w.Apply(ctx, plan)
+}
+
+// Plan 先按（规则覆盖后的）保留天数挑出过期 trace，再在总容量超限时从最旧的开始补充，
+// 有 finding 或已加入 dataset 的 trace 在对应开关打开时始终保留。
+func (w *Worker) Plan() (Plan, error) {
+	records, err := w.store.ListRetentionRecords()
+	if err != nil {
+		return Plan{}, err
+	}
+	plan := Plan{Traces: len(records)}
+	now := w.now()
+	remaining := make([]store.RetentionRecord, 0, len(records))
+	for _, record := range records {
+		plan.TotalBytes += record.FileSize
+		if w.protected(record) {
+			plan.Protected++
+			continue
+		}
+		if maxAge := w.maxAgeFor(record); maxAge > 0 && record.RecordedAt.Before(now.Add(-maxAge)) {
+			plan.Candidates = append(plan.Candidates, candidate(record, ReasonMaxAge))
+			continue
+		}
+		remaining = append(remaining, record)
+	}
+	if w.maxTotalBytes > 0 {
+		total := plan.TotalBytes - plan.ReclaimBytes()
+		for _, record := range remaining {
+			if total <= w.maxTotalBytes {
+				break
+			}
+			plan.Candidates = append(plan.Candidates, candidate(record, ReasonMaxTotal))
+			total -= record.FileSize
+		}
+	}
+	return plan, nil
+}
+
+// Apply 先删除 cassette 文件再删除数据库记录；文件删除失败的 trace 保留索引，等待下一轮重试。
+func (w *Worker) Apply(ctx context.Context, plan Plan) (Result, error) {
+	var (
+		result   Result
+		traceIDs []string
+	)
+	for _, candidate := range plan.Candidates {
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		default:
+		}
+		if err := os.Remove(candidate.Path); err != nil && !os.IsNotExist(err) {
+			result.Failed++
+			slog.Warn("Remove cassette failed", "path", candidate.Path, "error", err)
+			continue
+		}
+		traceIDs = append(traceIDs, candidate.TraceID)
+		result.ReclaimedBytes += candidate.FileSize
+	}
+	deleted, err := w.store.DeleteTraces(traceIDs)
+	if err != nil {
+		return result, fmt.Errorf("delete trace records: %w", err)
+	}
+	result.Deleted = deleted
+	return result, nil
+}
+
+func (w *Worker) protected(record store.RetentionRecord) bool {
+	return (w.keepWithFindings && record.HasFindings) || (w.keepInDatasets && record.InDataset)
+}
+
+func (w *Worker) maxAgeFor(record store.RetentionRecord) time.Duration {
+	for _, rule := range w.rules {
+		if glob.MatchAny(rule.Models, record.Model) && glob.MatchAny(rule.Channels, record.UpstreamID) {
+			return rule.MaxAge
+		}
+	}
+	return w.maxAge
+}
+
+func candidate(record store.RetentionRecord, reason string) Candidate {
+	return Candidate{
+		TraceID:    record.TraceID,
+		Path:       record.Path,
+		Model:      record.Model,
+		UpstreamID: record.UpstreamID,
+		RecordedAt: record.RecordedAt,
+		FileSize:   record.FileSize,
+		Reason:     reason,
+	}
+}
@@ -0,0 +1,231 @@
+package retention
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+	"github.com/kingfs/llm-tracelab/pkg/recordfile"
+)
+
+func TestRunOnceDeletesExpiredTracesAndDerivedRows(t *testing.T) {
+	dir := t.TempDir()
+	st, err := store.New(dir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	oldID, oldPath := writeIndexedTrace(t, st, dir, "req-old", "gpt-5.1", now.Add(-40*24*time.Hour))
+	flaggedID, _ := writeIndexedTrace(t, st, dir, "req-flagged", "gpt-5.1", now.Add(-40*24*time.Hour))
+	pinnedID, _ := writeIndexedTrace(t, st, dir, "req-pinned", "claude-sonnet", now.Add(-40*24*time.Hour))
+	freshID, _ := writeIndexedTrace(t, st, dir, "req-fresh", "gpt-5.1", now.Add(-time.Hour))
+
+	if err := st.SaveFindings(flaggedID, []observe.Finding{{ID: "f1", Category: "safety", Severity: observe.SeverityHigh, Title: "flagged", Detector: "test"}}); err != nil {
+		t.Fatalf("SaveFindings() error = %v", err)
+	}
+	dataset, err := st.CreateDataset("regressions", "")
+	if err != nil {
+		t.Fatalf("CreateDataset() error = %v", err)
+	}
+	if _, _, err := st.AppendDatasetExamples(dataset.ID, []string{oldID}, "manual", "", ""); err != nil {
+		t.Fatalf("AppendDatasetExamples() error = %v", err)
+	}
+
+	worker, err := New(st, Options{
+		MaxAge:           30 * 24 * time.Hour,
+		KeepWithFindings: true,
+		Rules:            []Rule{{Models: []string{"claude-*"}}},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	worker.now = func() time.Time { return now }
+
+	result, err := worker.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("RunOnce() error = %v", err)
+	}
+	if result.Deleted != 1 || result.Failed != 0 || result.ReclaimedBytes <= 0 {
+		t.Fatalf("RunOnce() = %+v, want one deleted trace", result)
+	}
+	if _, err := os.Stat(oldPath); !os.IsNotExist(err) {
+		t.Fatalf("expired cassette still exists: %v", err)
+	}
+	if _, err := st.GetByID(oldID); err == nil {
+		t.Fatalf("GetByID(old) error = nil, want missing")
+	}
+	for _, id := range []string{flaggedID, pinnedID, freshID} {
+		if _, err := st.GetByID(id); err != nil {
+			t.Fatalf("GetByID(%s) error = %v, want kept", id, err)
+		}
+	}
+	examples, err := st.GetDatasetExamples(dataset.ID)
+	if err != nil || len(examples) != 0 {
+		t.Fatalf("GetDatasetExamples() = %d, err = %v, want examples of deleted trace removed", len(examples), err)
+	}
+}
+
+func TestRunOnceKeepsSnapshotMembersAndDropsRerunLinks(t *testing.T) {
+	dir := t.TempDir()
+	st, err := store.New(dir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	snapshotID, _ := writeIndexedTrace(t, st, dir, "req-snapshot", "gpt-5.1", now.Add(-40*24*time.Hour))
+	originalID, _ := writeIndexedTrace(t, st, dir, "req-original", "gpt-5.1", now.Add(-40*24*time.Hour))
+	rerunID, _ := writeIndexedTraceWithMeta(t, st, dir, "req-rerun", "gpt-5.1", now.Add(-time.Hour), func(meta *recordfile.MetaData) {
+		meta.RerunOf = originalID
+	})
+
+	// 样例从数据集移除后仍被已冻结的快照引用。
+	dataset, err := st.CreateDataset("frozen", "")
+	if err != nil {
+		t.Fatalf("CreateDataset() error = %v", err)
+	}
+	if _, _, err := st.AppendDatasetExamples(dataset.ID, []string{snapshotID}, "manual", "", ""); err != nil {
+		t.Fatalf("AppendDatasetExamples() error = %v", err)
+	}
+	if _, err := st.CreateDatasetSnapshot(dataset.ID, "v1", "ci"); err != nil {
+		t.Fatalf("CreateDatasetSnapshot() error = %v", err)
+	}
+	if _, err := st.RemoveDatasetExamples(dataset.ID, []string{snapshotID}); err != nil {
+		t.Fatalf("RemoveDatasetExamples() error = %v", err)
+	}
+	if reruns, err := st.ListReruns(originalID); err != nil || len(reruns) != 1 || reruns[0].TraceID != rerunID {
+		t.Fatalf("ListReruns() = %+v, err = %v", reruns, err)
+	}
+
+	worker, err := New(st, Options{MaxAge: 30 * 24 * time.Hour, KeepInDatasets: true})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	worker.now = func() time.Time { return now }
+	result, err := worker.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("RunOnce() error = %v", err)
+	}
+	if result.Deleted != 1 {
+		t.Fatalf("RunOnce() = %+v, want only the rerun original deleted", result)
+	}
+	if _, err := st.GetByID(snapshotID); err != nil {
+		t.Fatalf("GetByID(snapshot member) error = %v, want kept", err)
+	}
+	if _, err := st.GetRerunOf(rerunID); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("GetRerunOf(rerun) error = %v, want sql.ErrNoRows", err)
+	}
+}
+
+func TestPlanEnforcesMaxTotalOldestFirst(t *testing.T) {
+	dir := t.TempDir()
+	st, err := store.New(dir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	firstID, firstPath := writeIndexedTrace(t, st, dir, "req-1", "gpt-5.1", now.Add(-3*time.Hour))
+	secondID, _ := writeIndexedTrace(t, st, dir, "req-2", "gpt-5.1", now.Add(-2*time.Hour))
+	writeIndexedTrace(t, st, dir, "req-3", "gpt-5.1", now.Add(-time.Hour))
+
+	info, err := os.Stat(firstPath)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	worker, err := New(st, Options{MaxTotalBytes: info.Size() + 1})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	worker.now = func() time.Time { return now }
+	plan, err := worker.Plan()
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	got := candidateIDs(plan)
+	if len(got) != 2 || got[0] != firstID || got[1] != secondID {
+		t.Fatalf("Plan() candidates = %v, want [%s %s]", got, firstID, secondID)
+	}
+	if plan.Candidates[0].Reason != ReasonMaxTotal {
+		t.Fatalf("Plan() reason = %q, want %q", plan.Candidates[0].Reason, ReasonMaxTotal)
+	}
+	if plan.ReclaimBytes() != 2*info.Size() {
+		t.Fatalf("ReclaimBytes() = %d, want %d", plan.ReclaimBytes(), 2*info.Size())
+	}
+}
+
+func TestNewRejectsInvalidPattern(t *testing.T) {
+	if _, err := New(nil, Options{Rules: []Rule{{Models: []string{"gpt-["}}}}); err == nil {
+		t.Fatalf("New() error = nil, want invalid pattern error")
+	}
+}
+
+func candidateIDs(plan Plan) []string {
+	ids := make([]string, 0, len(plan.Candidates))
+	for _, candidate := range plan.Candidates {
+		ids = append(ids, candidate.TraceID)
+	}
+	return ids
+}
+
+func writeIndexedTrace(t *testing.T, st *store.Store, dir string, requestID string, model string, recordedAt time.Time) (string, string) {
+	t.Helper()
+	return writeIndexedTraceWithMeta(t, st, dir, requestID, model, recordedAt, nil)
+}
+
+func writeIndexedTraceWithMeta(t *testing.T, st *store.Store, dir string, requestID string, model string, recordedAt time.Time, mutate func(*recordfile.MetaData)) (string, string) {
+	t.Helper()
+	reqHead := "POST /v1/responses HTTP/1.1\r\nHost: example.com\r\n\r\n"
+	reqBody := `{"model":"` + model + `","input":"hello"}`
+	resHead := "HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n"
+	resBody := `{"id":"resp_1","object":"response","status":"completed","model":"` + model + `","output":[],"usage":{"input_tokens":1,"output_tokens":1,"total_tokens":2}}`
+	header := recordfile.RecordHeader{
+		Version: "LLM_PROXY_V3",
+		Meta: recordfile.MetaData{
+			RequestID:  requestID,
+			Time:       recordedAt,
+			Model:      model,
+			Provider:   "openai_compatible",
+			Operation:  "responses",
+			Endpoint:   "/v1/responses",
+			URL:        "/v1/responses",
+			Method:     "POST",
+			StatusCode: 200,
+		},
+		Layout: recordfile.LayoutInfo{
+			ReqHeaderLen: int64(len(reqHead)),
+			ReqBodyLen:   int64(len(reqBody)),
+			ResHeaderLen: int64(len(resHead)),
+			ResBodyLen:   int64(len(resBody)),
+		},
+	}
+	if mutate != nil {
+		mutate(&header.Meta)
+	}
+	prelude, err := recordfile.MarshalPrelude(header, recordfile.BuildEvents(header))
+	if err != nil {
+		t.Fatalf("MarshalPrelude() error = %v", err)
+	}
+	logPath := filepath.Join(dir, requestID+".http")
+	if err := os.WriteFile(logPath, []byte(string(prelude)+reqHead+reqBody+"\n"+resHead+resBody), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := st.UpsertLog(logPath, header); err != nil {
+		t.Fatalf("UpsertLog() error = %v", err)
+	}
+	entry, err := st.GetByRequestID(requestID)
+	if err != nil {
+		t.Fatalf("GetByRequestID() error = %v", err)
+	}
+	return entry.ID, logPath
+}
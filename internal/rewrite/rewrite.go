@@ -0,0 +1,442 @@
+package rewrite
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/glob"
+	"github.com/kingfs/llm-tracelab/pkg/jsonpath"
+	"github.com/kingfs/llm-tracelab/pkg/llm"
+)
+
+// Engine 按配置顺序对请求体应用改写规则。
+type Engine struct {
+	rules []rule
+}
+
+type rule struct {
+	name               string
+	match              config.RewriteMatch
+	set                []assignment
+	defaults           []assignment
+	remove             []jsonpath.Path
+	maxTokens          int
+	systemPrefix       string
+	includeStreamUsage bool
+}
+
+type assignment struct {
+	path  jsonpath.Path
+	value any
+}
+
+// Request 描述一次待改写请求的匹配维度。
+type Request struct {
+	Method  string
+	Path    string
+	BaseURL string
+	Model   string
+	Token   string
+	Channel string
+}
+
+// Result 是一次改写的结果；Rules 为实际修改过请求体的规则名。
+type Result struct {
+	Body    []byte
+	Rules   []string
+	Changed bool
+}
+
+func New(cfg *config.Config) (*Engine, error) {
+	e := &Engine{}
+	if cfg == nil {
+		return e, nil
+	}
+	for i, raw := range cfg.Rewrite.Rules {
+		compiled, err := compileRule(raw)
+		if err != nil {
+			name := strings.TrimSpace(raw.Name)
+			if name == "" {
+				name = fmt.Sprintf("#%d", i+1)
+			}
+			return nil, fmt.Errorf("rewrite rule %s: %w", name, err)
+		}
+		if compiled.name == "" {
+			compiled.name = fmt.Sprintf("rule-%d", i+1)
+		}
+		e.rules = append(e.rules, compiled)
+	}
+	return e, nil
+}
+
+func compileRule(raw config.RewriteRule) (rule, error) {
+	out := rule{
+		name:               strings.TrimSpace(raw.Name),
+		match:              raw.Match,
+		maxTokens:          raw.MaxTokens,
+		systemPrefix:       raw.SystemPrefix,
+		includeStreamUsage: raw.IncludeStreamUsage,
+	}
+	for _, patterns := range [][]string{raw.Match.Models, raw.Match.Endpoints, raw.Match.Tokens, raw.Match.Channels} {
+		if err := glob.Validate(patterns); err != nil {
+			return rule{}, fmt.Errorf("match: %w", err)
+		}
+	}
+	var err error
+	if out.set, err = compileAssignments(raw.Set); err != nil {
+		return rule{}, err
+	}
+	if out.defaults, err = compileAssignments(raw.Default); err != nil {
+		return rule{}, err
+	}
+	for _, expr := range raw.Remove {
+		p, err := parsePath(expr)
+		if err != nil {
+			return rule{}, err
+		}
+		if p[len(p)-1].IsIndex() {
+			return rule{}, fmt.Errorf("remove path %q must end with an object key", expr)
+		}
+		out.remove = append(out.remove, p)
+	}
+	if raw.MaxTokens < 0 {
+		return rule{}, fmt.Errorf("max_tokens must be non-negative")
+	}
+	if len(out.set) == 0 && len(out.defaults) == 0 && len(out.remove) == 0 &&
+		out.maxTokens == 0 && out.systemPrefix == "" && !out.includeStreamUsage {
+		return rule{}, fmt.Errorf("rule has no actions")
+	}
+	return out, nil
+}
+
+// parsePath 解析改写路径：须以对象 key 开头且不含通配符，便于确定性地写入与删除。
+func parsePath(expr string) (jsonpath.Path, error) {
+	p, err := jsonpath.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid json path %q: %w", expr, err)
+	}
+	if p.HasWildcard() {
+		return nil, fmt.Errorf("json path %q must not contain wildcards", expr)
+	}
+	if p[0].IsIndex() {
+		return nil, fmt.Errorf("json path %q must start with an object key", expr)
+	}
+	return p, nil
+}
+
+func compileAssignments(values map[string]any) ([]assignment, error) {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	out := make([]assignment, 0, len(keys))
+	for _, key := range keys {
+		p, err := parsePath(key)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, assignment{path: p, value: normalizeValue(values[key])})
+	}
+	return out, nil
+}
+
+// Enabled 返回是否配置了任意改写规则。
+func (e *Engine) Enabled() bool {
+	return e != nil && len(e.rules) > 0
+}
+
+// Apply 对请求体依次应用所有命中的规则。非 JSON 对象的请求体原样返回。
+func (e *Engine) Apply(req Request, body []byte) Result {
+	result := Result{Body: body}
+	if !e.Enabled() || len(bytes.TrimSpace(body)) == 0 {
+		return result
+	}
+	if req.Method != "" && req.Method != http.MethodPost {
+		return result
+	}
+	payload, ok := decodeObject(body)
+	if !ok {
+		return result
+	}
+
+	semantics := llm.ClassifyPath(req.Path, req.BaseURL)
+	model := req.Model
+	if model == "" {
+		if parsed, err := llm.ParseRequestForPath(req.Path, req.BaseURL, body); err == nil {
+			model = parsed.Model
+		}
+	}
+	if model == "" {
+		model = llm.ModelFromPath(req.Path)
+	}
+
+	for _, r := range e.rules {
+		if !r.matches(model, semantics, req) {
+			continue
+		}
+		if r.apply(payload, semantics) {
+			result.Rules = append(result.Rules, r.name)
+			result.Changed = true
+		}
+	}
+	if !result.Changed {
+		return result
+	}
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return Result{Body: body}
+	}
+	result.Body = encoded
+	return result
+}
+
+func (r rule) matches(model string, semantics llm.TraceSemantics, req Request) bool {
+	if !glob.MatchAny(r.match.Models, model) {
+		return false
+	}
+	if len(r.match.Endpoints) > 0 &&
+		!glob.MatchAny(r.match.Endpoints, semantics.Endpoint) &&
+		!glob.MatchAny(r.match.Endpoints, semantics.Operation) {
+		return false
+	}
+	if !glob.MatchAny(r.match.Tokens, req.Token) {
+		return false
+	}
+	return glob.MatchAny(r.match.Channels, req.Channel)
+}
+
+func (r rule) apply(payload map[string]any, semantics llm.TraceSemantics) bool {
+	changed := false
+	for _, p := range r.remove {
+		if p.Remove(payload) {
+			changed = true
+		}
+	}
+	for _, a := range r.defaults {
+		if _, ok := a.path.Get(payload); ok {
+			continue
+		}
+		if a.path.Set(payload, cloneValue(a.value)) {
+			changed = true
+		}
+	}
+	for _, a := range r.set {
+		if current, ok := a.path.Get(payload); ok && jsonEqual(current, a.value) {
+			continue
+		}
+		if a.path.Set(payload, cloneValue(a.value)) {
+			changed = true
+		}
+	}
+	if r.maxTokens > 0 && capMaxTokens(payload, semantics, r.maxTokens) {
+		changed = true
+	}
+	if r.systemPrefix != "" && prefixSystemPrompt(payload, semantics, r.systemPrefix) {
+		changed = true
+	}
+	if r.includeStreamUsage && IncludeStreamUsage(semantics.Endpoint, payload) {
+		changed = true
+	}
+	return changed
+}
+
+// IncludeStreamUsage 在 chat/completions 流式请求中强制 stream_options.include_usage=true，
+// 返回是否修改了 payload。
+func IncludeStreamUsage(endpoint string, payload map[string]any) bool {
+	if llm.NormalizeEndpoint(endpoint) != "/v1/chat/completions" {
+		return false
+	}
+	if isStream, ok := payload["stream"].(bool); !ok || !isStream {
+		return false
+	}
+	if opts, ok := payload["stream_options"].(map[string]any); ok {
+		if val, ok := opts["include_usage"].(bool); ok && val {
+			return false
+		}
+		opts["include_usage"] = true
+		return true
+	}
+	payload["stream_options"] = map[string]any{"include_usage": true}
+	return true
+}
+
+// maxTokenFields 返回各协议中表示输出上限的字段；第一个字段在缺失时作为默认写入位置。
+func maxTokenFields(semantics llm.TraceSemantics) []jsonpath.Path {
+	switch semantics.Operation {
+	case llm.OperationResponses:
+		return []jsonpath.Path{jsonpath.Fields("max_output_tokens")}
+	case llm.OperationMessages:
+		return []jsonpath.Path{jsonpath.Fields("max_tokens")}
+	case llm.OperationGenerateContent:
+		return []jsonpath.Path{jsonpath.Fields("generationConfig", "maxOutputTokens")}
+	case llm.OperationChatCompletions:
+		return []jsonpath.Path{jsonpath.Fields("max_tokens"), jsonpath.Fields("max_completion_tokens")}
+	default:
+		return nil
+	}
+}
+
+func capMaxTokens(payload map[string]any, semantics llm.TraceSemantics, limit int) bool {
+	fields := maxTokenFields(semantics)
+	if len(fields) == 0 {
+		return false
+	}
+	changed := false
+	found := false
+	for _, field := range fields {
+		current, ok := field.Get(payload)
+		if !ok {
+			continue
+		}
+		found = true
+		if n, ok := numberValue(current); ok && n <= float64(limit) {
+			continue
+		}
+		field.Set(payload, json.Number(fmt.Sprint(limit)))
+		changed = true
+	}
+	if !found {
+		fields[0].Set(payload, json.Number(fmt.Sprint(limit)))
+		changed = true
+	}
+	return changed
+}
+
+func prefixSystemPrompt(payload map[string]any, semantics llm.TraceSemantics, prefix string) bool {
+	switch semantics.Operation {
+	case llm.OperationChatCompletions:
+		messages, _ := payload["messages"].([]any)
+		if len(messages) > 0 {
+			if first, ok := messages[0].(map[string]any); ok {
+				role, _ := first["role"].(string)
+				if role == "system" || role == "developer" {
+					if text, ok := first["content"].(string); ok {
+						if strings.HasPrefix(text, prefix) {
+							return false
+						}
+						first["content"] = joinPrompt(prefix, text)
+						return true
+					}
+				}
+			}
+		}
+		payload["messages"] = append([]any{map[string]any{"role": "system", "content": prefix}}, messages...)
+		return true
+	case llm.OperationResponses:
+		return prefixStringField(payload, "instructions", prefix)
+	case llm.OperationMessages:
+		if blocks, ok := payload["system"].([]any); ok {
+			payload["system"] = append([]any{map[string]any{"type": "text", "text": prefix}}, blocks...)
+			return true
+		}
+		return prefixStringField(payload, "system", prefix)
+	case llm.OperationGenerateContent:
+		instruction, _ := payload["systemInstruction"].(map[string]any)
+		if instruction == nil {
+			payload["systemInstruction"] = map[string]any{"parts": []any{map[string]any{"text": prefix}}}
+			return true
+		}
+		parts, _ := instruction["parts"].([]any)
+		instruction["parts"] = append([]any{map[string]any{"text": prefix}}, parts...)
+		return true
+	default:
+		return false
+	}
+}
+
+func prefixStringField(payload map[string]any, key string, prefix string) bool {
+	current, _ := payload[key].(string)
+	if current != "" && strings.HasPrefix(current, prefix) {
+		return false
+	}
+	payload[key] = joinPrompt(prefix, current)
+	return true
+}
+
+func joinPrompt(prefix string, text string) string {
+	if strings.TrimSpace(text) == "" {
+		return prefix
+	}
+	return prefix + "\n\n" + text
+}
+
+func decodeObject(body []byte) (map[string]any, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var payload map[string]any
+	if err := decoder.Decode(&payload); err != nil || payload == nil {
+		return nil, false
+	}
+	return payload, true
+}
+
+func numberValue(value any) (float64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+// normalizeValue 把 YAML 解码出的值转换为 JSON 兼容结构。
+func normalizeValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			out[key] = normalizeValue(item)
+		}
+		return out
+	case map[any]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			out[fmt.Sprint(key)] = normalizeValue(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = normalizeValue(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+func cloneValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			out[key] = cloneValue(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = cloneValue(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+func jsonEqual(a any, b any) bool {
+	left, errA := json.Marshal(a)
+	right, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(left, right)
+}
@@ -0,0 +1,183 @@
+package rewrite
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+)
+
+func newTestEngine(t *testing.T, rules ...config.RewriteRule) *Engine {
+	t.Helper()
+	cfg := &config.Config{}
+	cfg.Rewrite.Rules = rules
+	engine, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return engine
+}
+
+func decode(t *testing.T, body []byte) map[string]any {
+	t.Helper()
+	var out map[string]any
+	if err := json.Unmarshal(body, &out); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", body, err)
+	}
+	return out
+}
+
+func TestApplyChatCompletionsPolicies(t *testing.T) {
+	engine := newTestEngine(t,
+		config.RewriteRule{
+			Name:               "org-defaults",
+			Match:              config.RewriteMatch{Models: []string{"gpt-*"}, Endpoints: []string{"/v1/chat/completions"}},
+			MaxTokens:          256,
+			Remove:             []string{"logprobs", "top_logprobs"},
+			SystemPrefix:       "Follow the org policy.",
+			IncludeStreamUsage: true,
+		},
+		config.RewriteRule{
+			Name:  "eval-temperature",
+			Match: config.RewriteMatch{Tokens: []string{"eval-*"}},
+			Set:   map[string]any{"temperature": 0},
+		},
+	)
+
+	body := []byte(`{"model":"gpt-4.1","stream":true,"max_tokens":4096,"logprobs":true,"top_logprobs":3,"temperature":0.9,"messages":[{"role":"system","content":"Be brief."},{"role":"user","content":"hi"}]}`)
+	result := engine.Apply(Request{Method: "POST", Path: "/v1/chat/completions", Token: "eval-nightly"}, body)
+	if !result.Changed {
+		t.Fatalf("Apply() changed = false, want true")
+	}
+	if strings.Join(result.Rules, ",") != "org-defaults,eval-temperature" {
+		t.Fatalf("Apply() rules = %v", result.Rules)
+	}
+
+	got := decode(t, result.Body)
+	if got["max_tokens"] != float64(256) {
+		t.Fatalf("max_tokens = %v, want 256", got["max_tokens"])
+	}
+	if _, ok := got["logprobs"]; ok {
+		t.Fatalf("logprobs still present: %s", result.Body)
+	}
+	if got["temperature"] != float64(0) {
+		t.Fatalf("temperature = %v, want 0", got["temperature"])
+	}
+	opts, _ := got["stream_options"].(map[string]any)
+	if opts["include_usage"] != true {
+		t.Fatalf("stream_options = %v, want include_usage", got["stream_options"])
+	}
+	messages, _ := got["messages"].([]any)
+	first, _ := messages[0].(map[string]any)
+	if first["content"] != "Follow the org policy.\n\nBe brief." {
+		t.Fatalf("system content = %q", first["content"])
+	}
+}
+
+func TestApplySkipsUnmatchedAndNoopRules(t *testing.T) {
+	engine := newTestEngine(t,
+		config.RewriteRule{
+			Name:  "claude-only",
+			Match: config.RewriteMatch{Models: []string{"claude-*"}},
+			Set:   map[string]any{"temperature": 0},
+		},
+		config.RewriteRule{
+			Name:      "cap",
+			Match:     config.RewriteMatch{Channels: []string{"primary"}},
+			MaxTokens: 1024,
+		},
+	)
+
+	body := []byte(`{"model":"gpt-4.1","max_tokens":128}`)
+	result := engine.Apply(Request{Method: "POST", Path: "/v1/chat/completions", Channel: "primary"}, body)
+	if result.Changed || string(result.Body) != string(body) {
+		t.Fatalf("Apply() = %+v, want untouched body", result)
+	}
+
+	result = engine.Apply(Request{Method: "POST", Path: "/v1/chat/completions", Channel: "secondary"}, []byte(`{"model":"gpt-4.1"}`))
+	if result.Changed {
+		t.Fatalf("Apply() for unmatched channel changed body: %s", result.Body)
+	}
+}
+
+func TestApplyProviderSpecificFields(t *testing.T) {
+	engine := newTestEngine(t, config.RewriteRule{
+		Name:         "all",
+		MaxTokens:    512,
+		SystemPrefix: "PREFIX",
+		Default:      map[string]any{"metadata.team": "core"},
+	})
+
+	tests := []struct {
+		name  string
+		path  string
+		body  string
+		check func(t *testing.T, got map[string]any)
+	}{
+		{
+			name: "responses",
+			path: "/v1/responses",
+			body: `{"model":"gpt-5","instructions":"Answer in English."}`,
+			check: func(t *testing.T, got map[string]any) {
+				if got["max_output_tokens"] != float64(512) || got["instructions"] != "PREFIX\n\nAnswer in English." {
+					t.Fatalf("responses payload = %v", got)
+				}
+			},
+		},
+		{
+			name: "anthropic_blocks",
+			path: "/v1/messages",
+			body: `{"model":"claude-sonnet","max_tokens":100,"system":[{"type":"text","text":"sys"}]}`,
+			check: func(t *testing.T, got map[string]any) {
+				blocks, _ := got["system"].([]any)
+				first, _ := blocks[0].(map[string]any)
+				if got["max_tokens"] != float64(100) || len(blocks) != 2 || first["text"] != "PREFIX" {
+					t.Fatalf("messages payload = %v", got)
+				}
+			},
+		},
+		{
+			name: "gemini",
+			path: "/v1beta/models/gemini-2.5-pro:generateContent",
+			body: `{"contents":[{"role":"user","parts":[{"text":"hi"}]}]}`,
+			check: func(t *testing.T, got map[string]any) {
+				genCfg, _ := got["generationConfig"].(map[string]any)
+				instruction, _ := got["systemInstruction"].(map[string]any)
+				if genCfg["maxOutputTokens"] != float64(512) || instruction == nil {
+					t.Fatalf("gemini payload = %v", got)
+				}
+				metadata, _ := got["metadata"].(map[string]any)
+				if metadata["team"] != "core" {
+					t.Fatalf("metadata = %v, want team default", got["metadata"])
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := engine.Apply(Request{Method: "POST", Path: tt.path}, []byte(tt.body))
+			if !result.Changed {
+				t.Fatalf("Apply() changed = false")
+			}
+			tt.check(t, decode(t, result.Body))
+		})
+	}
+}
+
+func TestNewRejectsInvalidRules(t *testing.T) {
+	for _, rule := range []config.RewriteRule{
+		{Name: "empty"},
+		{Name: "bad-path", Set: map[string]any{"messages[x]": 1}},
+		{Name: "bad-remove", Remove: []string{"messages[0]"}},
+		{Name: "wildcard", Set: map[string]any{"messages[*].content": "x"}},
+		{Name: "leading-index", Default: map[string]any{"[0].content": "x"}},
+		{Name: "bad-glob", Match: config.RewriteMatch{Models: []string{"gpt-["}}, MaxTokens: 10},
+	} {
+		cfg := &config.Config{}
+		cfg.Rewrite.Rules = []config.RewriteRule{rule}
+		if _, err := New(cfg); err == nil {
+			t.Fatalf("New(%s) error = nil, want error", rule.Name)
+		}
+	}
+}
@@ -586,6 +586,14 @@ func (r *Router) Complete(selection *Selection, outcome Outcome) {
 	selection.Target.onFinish(selection.Request, outcome, r.costs, r.failureThreshold, r.openWindow)
 }
 
+// Release 归还选中目标的在途计数但不记录结果，用于代理在本地直接应答（未发往上游）的请求。
+func (r *Router) Release(selection *Selection) {
+	if selection == nil || selection.Target == nil {
+		return
+	}
+	selection.Target.onRelease(selection.Request)
+}
+
 func (r *Router) pick(candidates []*Target, req RequestFeatures) (*Target, float64) {
 	if len(candidates) == 1 || r.policy == PolicyFirstAvailable {
 		best := candidates[0]
@@ -868,9 +876,13 @@ func (t *Target) onStart(req RequestFeatures) {
 	}
 }
 
-func (t *Target) onFinish(req RequestFeatures, outcome Outcome, costs costConfig, failureThreshold int64, openWindow time.Duration) {
+func (t *Target) onRelease(req RequestFeatures) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
+	t.releaseInflightLocked(req)
+}
+
+func (t *Target) releaseInflightLocked(req RequestFeatures) {
 	if t.inflight > 0 {
 		t.inflight--
 	}
@@ -881,6 +893,12 @@ func (t *Target) onFinish(req RequestFeatures, outcome Outcome, costs costConfig
 	} else if t.inflightNonStream > 0 {
 		t.inflightNonStream--
 	}
+}
+
+func (t *Target) onFinish(req RequestFeatures, outcome Outcome, costs costConfig, failureThreshold int64, openWindow time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.releaseInflightLocked(req)
 
 	if outcome.DurationMs > 0 {
 		t.reqLatencyFastMs = ewma(t.reqLatencyFastMs, outcome.DurationMs, costs.FastAlpha)
@@ -0,0 +1,299 @@
+package sessionanalysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/analyzer"
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+)
+
+const (
+	DetectorName    = store.SessionFindingDetectorPrefix + "agent_patterns"
+	DetectorVersion = "0.1.0"
+
+	CategoryRepeatedToolCall = "agent_repeated_tool_call"
+	CategoryToolErrorRetry   = "agent_tool_error_retry"
+	CategoryContextExplosion = "agent_context_explosion"
+	CategoryTurnBudget       = "agent_turn_budget_exceeded"
+	CategoryAbandonedStream  = "agent_abandoned_stream"
+)
+
+const (
+	defaultRepeatThreshold    = 3
+	defaultRetryThreshold     = 2
+	defaultPromptTokenLimit   = 32000
+	defaultMinCacheRatio      = 0.1
+	defaultContextGrowthTurns = 3
+	defaultMaxTurns           = 50
+)
+
+// DetectorOptions 控制 session 级检测器的阈值，零值使用默认值。
+type DetectorOptions struct {
+	// RepeatThreshold 是同一工具以相同参数被调用多少次视为循环。
+	RepeatThreshold int
+	// RetryThreshold 是工具报错后以相同参数原样重试多少次视为重试循环。
+	RetryThreshold int
+	// PromptTokenLimit 和 MinCacheRatio 判定 prompt 膨胀：连续 ContextGrowthTurns 轮增长、最终超过上限且缓存命中率低于阈值。
+	PromptTokenLimit   int
+	MinCacheRatio      float64
+	ContextGrowthTurns int
+	// MaxTurns 是单个 session 允许的轮数预算。
+	MaxTurns int
+}
+
+func DefaultDetectorOptions() DetectorOptions {
+	return DetectorOptions{
+		RepeatThreshold:    defaultRepeatThreshold,
+		RetryThreshold:     defaultRetryThreshold,
+		PromptTokenLimit:   defaultPromptTokenLimit,
+		MinCacheRatio:      defaultMinCacheRatio,
+		ContextGrowthTurns: defaultContextGrowthTurns,
+		MaxTurns:           defaultMaxTurns,
+	}
+}
+
+// Detect 在重建的 trajectory 上识别 agent 的循环与浪费模式。
+// finding 挂在证据所在的 trace 上并带 SessionID，由 store.SaveSessionFindings 按 session 整体替换。
+func Detect(t Trajectory, opts DetectorOptions) []observe.Finding {
+	opts = normalizeDetectorOptions(opts)
+	var out []observe.Finding
+	out = append(out, detectRepeatedToolCalls(t, opts)...)
+	out = append(out, detectToolErrorRetries(t, opts)...)
+	out = append(out, detectContextExplosion(t, opts)...)
+	out = append(out, detectTurnBudget(t, opts)...)
+	out = append(out, detectAbandonedStreams(t)...)
+	return out
+}
+
+func normalizeDetectorOptions(opts DetectorOptions) DetectorOptions {
+	defaults := DefaultDetectorOptions()
+	if opts.RepeatThreshold <= 1 {
+		opts.RepeatThreshold = defaults.RepeatThreshold
+	}
+	if opts.RetryThreshold <= 0 {
+		opts.RetryThreshold = defaults.RetryThreshold
+	}
+	if opts.PromptTokenLimit <= 0 {
+		opts.PromptTokenLimit = defaults.PromptTokenLimit
+	}
+	if opts.MinCacheRatio <= 0 {
+		opts.MinCacheRatio = defaults.MinCacheRatio
+	}
+	if opts.ContextGrowthTurns <= 1 {
+		opts.ContextGrowthTurns = defaults.ContextGrowthTurns
+	}
+	if opts.MaxTurns <= 0 {
+		opts.MaxTurns = defaults.MaxTurns
+	}
+	return opts
+}
+
+func detectRepeatedToolCalls(t Trajectory, opts DetectorOptions) []observe.Finding {
+	groups := map[string][]TrajectoryToolCall{}
+	var order []string
+	for _, step := range t.Steps {
+		for _, call := range step.ToolCalls {
+			key := call.Name + "|" + call.ArgsHash
+			if _, ok := groups[key]; !ok {
+				order = append(order, key)
+			}
+			groups[key] = append(groups[key], call)
+		}
+	}
+	var out []observe.Finding
+	for _, key := range order {
+		calls := groups[key]
+		if len(calls) < opts.RepeatThreshold {
+			continue
+		}
+		severity := observe.SeverityMedium
+		if len(calls) >= 2*opts.RepeatThreshold {
+			severity = observe.SeverityHigh
+		}
+		last := calls[len(calls)-1]
+		out = append(out, sessionFinding(t, last.Step, last.NodeID, CategoryRepeatedToolCall, severity, 0.9,
+			"Repeated identical tool call",
+			fmt.Sprintf("Tool %s was called %d times with identical arguments in steps %s.", last.Name, len(calls), callSteps(calls)),
+			fmt.Sprintf("%s(%s)", last.Name, last.Args)))
+	}
+	return out
+}
+
+func detectToolErrorRetries(t Trajectory, opts DetectorOptions) []observe.Finding {
+	type retryChain struct {
+		call    TrajectoryToolCall
+		retries int
+		last    TrajectoryToolCall
+		error   string
+	}
+	chains := map[string]*retryChain{}
+	var order []string
+	for _, step := range t.Steps {
+		for _, result := range step.ToolResults {
+			if !result.IsError || result.CallStep < 0 || result.CallStep >= len(t.Steps) {
+				continue
+			}
+			failed, ok := findCall(t.Steps[result.CallStep], result)
+			if !ok {
+				continue
+			}
+			for _, retry := range step.ToolCalls {
+				if retry.Name != failed.Name || retry.ArgsHash != failed.ArgsHash {
+					continue
+				}
+				key := failed.Name + "|" + failed.ArgsHash
+				chain, exists := chains[key]
+				if !exists {
+					chain = &retryChain{call: failed}
+					chains[key] = chain
+					order = append(order, key)
+				}
+				chain.retries++
+				chain.last = retry
+				chain.error = result.Preview
+				break
+			}
+		}
+	}
+	var out []observe.Finding
+	for _, key := range order {
+		chain := chains[key]
+		if chain.retries < opts.RetryThreshold {
+			continue
+		}
+		out = append(out, sessionFinding(t, chain.last.Step, chain.last.NodeID, CategoryToolErrorRetry, observe.SeverityHigh, 0.9,
+			"Tool error retry loop",
+			fmt.Sprintf("Tool %s failed and was retried %d times with unchanged arguments.", chain.call.Name, chain.retries),
+			chain.error))
+	}
+	return out
+}
+
+// detectContextExplosion 寻找 prompt tokens 连续增长且几乎没有缓存命中的区间，只报告最严重的一段。
+func detectContextExplosion(t Trajectory, opts DetectorOptions) []observe.Finding {
+	bestStart, bestEnd, start := -1, -1, 0
+	for i := 1; i < len(t.Steps); i++ {
+		step := t.Steps[i]
+		if step.PromptTokens <= t.Steps[i-1].PromptTokens || cacheRatio(step) >= opts.MinCacheRatio {
+			start = i
+			continue
+		}
+		if i-start+1 < opts.ContextGrowthTurns || step.PromptTokens < opts.PromptTokenLimit {
+			continue
+		}
+		if bestEnd < 0 || step.PromptTokens > t.Steps[bestEnd].PromptTokens {
+			bestStart, bestEnd = start, i
+		}
+	}
+	if bestEnd < 0 {
+		return nil
+	}
+	first, last := t.Steps[bestStart], t.Steps[bestEnd]
+	uncached := 0
+	for _, step := range t.Steps[bestStart : bestEnd+1] {
+		uncached += step.PromptTokens - step.CachedTokens
+	}
+	return []observe.Finding{sessionFinding(t, bestEnd, "", CategoryContextExplosion, observe.SeverityMedium, 0.8,
+		"Prompt size exploding without cache hits",
+		fmt.Sprintf("Prompt tokens grew from %d to %d over %d turns with a cache hit ratio below %.0f%%; %d prompt tokens were billed uncached.",
+			first.PromptTokens, last.PromptTokens, bestEnd-bestStart+1, opts.MinCacheRatio*100, uncached),
+		fmt.Sprintf("prompt_tokens %d -> %d, cached %d", first.PromptTokens, last.PromptTokens, last.CachedTokens))}
+}
+
+func detectTurnBudget(t Trajectory, opts DetectorOptions) []observe.Finding {
+	if len(t.Steps) <= opts.MaxTurns {
+		return nil
+	}
+	severity := observe.SeverityLow
+	if len(t.Steps) >= 2*opts.MaxTurns {
+		severity = observe.SeverityMedium
+	}
+	return []observe.Finding{sessionFinding(t, opts.MaxTurns, "", CategoryTurnBudget, severity, 1,
+		"Session exceeded turn budget",
+		fmt.Sprintf("Session ran %d turns, above the budget of %d.", len(t.Steps), opts.MaxTurns),
+		fmt.Sprintf("%d turns", len(t.Steps)))}
+}
+
+func detectAbandonedStreams(t Trajectory) []observe.Finding {
+	var out []observe.Finding
+	for _, step := range t.Steps {
+		if !step.Stream || !clientCanceled(step) {
+			continue
+		}
+		out = append(out, sessionFinding(t, step.Index, "", CategoryAbandonedStream, observe.SeverityLow, 0.8,
+			"Stream abandoned by client",
+			fmt.Sprintf("The client canceled the stream after %dms; completion tokens generated so far were wasted.", step.DurationMs),
+			firstNonEmpty(step.Error, "client_canceled")))
+	}
+	return out
+}
+
+func sessionFinding(t Trajectory, stepIndex int, nodeID string, category string, severity observe.Severity, confidence float64, title string, description string, evidence string) observe.Finding {
+	traceID := t.Steps[stepIndex].TraceID
+	f := observe.Finding{
+		TraceID:         traceID,
+		SessionID:       t.SessionID,
+		Category:        category,
+		Severity:        severity,
+		Confidence:      confidence,
+		Title:           title,
+		Description:     description,
+		EvidenceExcerpt: preview(evidence),
+		NodeID:          nodeID,
+		Detector:        DetectorName,
+		DetectorVersion: DetectorVersion,
+		CreatedAt:       time.Now().UTC(),
+	}
+	if nodeID != "" {
+		f.EvidencePath = observe.EvidencePath(traceID, observe.SemanticNode{ID: nodeID})
+	} else {
+		f.EvidencePath = "trace#" + traceID
+	}
+	f.ID = analyzer.StableFindingID(f)
+	return f
+}
+
+func findCall(step TrajectoryStep, result TrajectoryToolResult) (TrajectoryToolCall, bool) {
+	for _, call := range step.ToolCalls {
+		if result.CallID != "" && call.ID == result.CallID {
+			return call, true
+		}
+		if result.CallID == "" && call.ID == "" && call.Name == result.Name {
+			return call, true
+		}
+	}
+	return TrajectoryToolCall{}, false
+}
+
+func callSteps(calls []TrajectoryToolCall) string {
+	steps := make([]int, 0, len(calls))
+	for _, call := range calls {
+		steps = append(steps, call.Step)
+	}
+	sort.Ints(steps)
+	parts := make([]string, 0, len(steps))
+	for _, step := range steps {
+		parts = append(parts, fmt.Sprintf("#%d", step))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func cacheRatio(step TrajectoryStep) float64 {
+	if step.PromptTokens <= 0 {
+		return 0
+	}
+	return float64(step.CachedTokens) / float64(step.PromptTokens)
+}
+
+// clientCanceled 读取代理记录的客户端取消标记，兼容旧录制中写在错误文本里的 client canceled / context canceled。
+func clientCanceled(step TrajectoryStep) bool {
+	if step.ClientCanceled {
+		return true
+	}
+	errText := strings.ToLower(step.Error)
+	return strings.Contains(errText, "client canceled") || strings.Contains(errText, "context canceled")
+}
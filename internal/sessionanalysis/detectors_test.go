@@ -0,0 +1,77 @@
+package sessionanalysis
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+)
+
+func TestDetectAgentLoopAndWastePatterns(t *testing.T) {
+	var steps []TrajectoryStep
+	for i := 0; i < 6; i++ {
+		steps = append(steps, TrajectoryStep{Index: i, TraceID: fmt.Sprintf("trace-%d", i), PromptTokens: 1000})
+	}
+	// 步骤 0-2 以相同参数调用 read_file。
+	for i := 0; i < 3; i++ {
+		steps[i].ToolCalls = []TrajectoryToolCall{{Step: i, ID: fmt.Sprintf("read-%d", i), Name: "read_file", ArgsHash: "h-read", NodeID: fmt.Sprintf("node-read-%d", i)}}
+	}
+	// run_tests 报错后在下一步被原样重试两次。
+	steps[3].ToolCalls = []TrajectoryToolCall{{Step: 3, ID: "test-3", Name: "run_tests", ArgsHash: "h-test"}}
+	steps[4].ToolResults = []TrajectoryToolResult{{CallID: "test-3", Name: "run_tests", CallStep: 3, IsError: true, Preview: "exit status 1"}}
+	steps[4].ToolCalls = []TrajectoryToolCall{{Step: 4, ID: "test-4", Name: "run_tests", ArgsHash: "h-test", NodeID: "node-test-4"}}
+	steps[5].ToolResults = []TrajectoryToolResult{{CallID: "test-4", Name: "run_tests", CallStep: 4, IsError: true, Preview: "exit status 1"}}
+	steps[5].ToolCalls = []TrajectoryToolCall{{Step: 5, ID: "test-5", Name: "run_tests", ArgsHash: "h-test", NodeID: "node-test-5"}}
+	// 最后三轮 prompt 持续增长且没有缓存命中。
+	steps[3].PromptTokens, steps[4].PromptTokens, steps[5].PromptTokens = 20000, 30000, 45000
+	steps[5].Stream, steps[5].ClientCanceled = true, true
+	trajectory := Trajectory{SessionID: "sess-1", Steps: steps}
+
+	findings := Detect(trajectory, DetectorOptions{MaxTurns: 4})
+	byCategory := map[string]observe.Finding{}
+	for _, finding := range findings {
+		if finding.SessionID != "sess-1" || finding.Detector != DetectorName || finding.ID == "" {
+			t.Fatalf("finding metadata = %+v", finding)
+		}
+		if _, ok := byCategory[finding.Category]; !ok {
+			byCategory[finding.Category] = finding
+		}
+	}
+	// run_tests 的重试本身也构成重复调用，因此共有两条 repeated finding。
+	if len(findings) != 6 || len(byCategory) != 5 {
+		t.Fatalf("findings = %+v", findings)
+	}
+	if got := byCategory[CategoryRepeatedToolCall]; got.TraceID != "trace-2" || got.NodeID != "node-read-2" || got.Severity != observe.SeverityMedium {
+		t.Fatalf("repeated call finding = %+v", got)
+	}
+	if got := byCategory[CategoryToolErrorRetry]; got.TraceID != "trace-5" || got.EvidenceExcerpt != "exit status 1" {
+		t.Fatalf("retry finding = %+v", got)
+	}
+	if got := byCategory[CategoryContextExplosion]; got.TraceID != "trace-5" || got.EvidenceExcerpt != "prompt_tokens 1000 -> 45000, cached 0" {
+		t.Fatalf("context finding = %+v", got)
+	}
+	if got := byCategory[CategoryTurnBudget]; got.TraceID != "trace-4" {
+		t.Fatalf("turn budget finding = %+v", got)
+	}
+	if got := byCategory[CategoryAbandonedStream]; got.TraceID != "trace-5" || got.Severity != observe.SeverityLow || got.EvidenceExcerpt != "client_canceled" {
+		t.Fatalf("abandoned stream finding = %+v", got)
+	}
+	// 旧录制把取消写在错误文本里，仍按取消识别。
+	if !clientCanceled(TrajectoryStep{Error: "client canceled: context canceled"}) {
+		t.Fatalf("clientCanceled(legacy error text) = false")
+	}
+	if got := Detect(trajectory, DetectorOptions{MaxTurns: 4}); got[0].ID != findings[0].ID {
+		t.Fatalf("finding ids are not stable: %s != %s", got[0].ID, findings[0].ID)
+	}
+}
+
+func TestDetectStaysQuietBelowThresholds(t *testing.T) {
+	steps := []TrajectoryStep{
+		{Index: 0, TraceID: "trace-0", PromptTokens: 30000, ToolCalls: []TrajectoryToolCall{{Step: 0, ID: "a", Name: "read_file", ArgsHash: "h1"}}},
+		{Index: 1, TraceID: "trace-1", PromptTokens: 40000, CachedTokens: 30000, ToolCalls: []TrajectoryToolCall{{Step: 1, ID: "b", Name: "read_file", ArgsHash: "h2"}}},
+		{Index: 2, TraceID: "trace-2", PromptTokens: 50000, CachedTokens: 40000, Error: "context canceled"},
+	}
+	if findings := Detect(Trajectory{SessionID: "sess-quiet", Steps: steps}, DefaultDetectorOptions()); len(findings) != 0 {
+		t.Fatalf("Detect() = %+v, want no findings", findings)
+	}
+}
@@ -11,7 +11,7 @@ import (
 
 const (
 	AnalyzerName    = "session_summary"
-	AnalyzerVersion = "0.1.0"
+	AnalyzerVersion = "0.2.0"
 	Kind            = "session_summary"
 )
 
@@ -27,6 +27,7 @@ type Output struct {
 	RepeatedFindings []RepeatedFinding `json:"repeated_findings"`
 	TraceRefs        []TraceRef        `json:"trace_refs"`
 	FindingRefs      []FindingRef      `json:"finding_refs"`
+	Trajectory       *Trajectory       `json:"trajectory,omitempty"`
 }
 
 type Count struct {
@@ -0,0 +1,388 @@
+package sessionanalysis
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/kingfs/llm-tracelab/internal/observeworker"
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+)
+
+const (
+	MatchByID   = "id"
+	MatchByName = "name"
+
+	previewLimit = 160
+)
+
+// Trajectory 是按时间排列的 agent 步骤：每个 trace 是一步，边表示后一步携带的 tool result 回应了前面哪一步的 tool call。
+type Trajectory struct {
+	SessionID     string               `json:"session_id"`
+	Steps         []TrajectoryStep     `json:"steps"`
+	Edges         []TrajectoryEdge     `json:"edges"`
+	ContextGrowth []ContextPoint       `json:"context_growth"`
+	PendingCalls  []TrajectoryToolCall `json:"pending_calls,omitempty"`
+	ToolCalls     int                  `json:"tool_calls"`
+	AnsweredCalls int                  `json:"answered_calls"`
+}
+
+type TrajectoryStep struct {
+	Index      int       `json:"index"`
+	TraceID    string    `json:"trace_id"`
+	RecordedAt time.Time `json:"recorded_at"`
+	Model      string    `json:"model"`
+	Provider   string    `json:"provider"`
+	StatusCode int       `json:"status_code"`
+	DurationMs int64     `json:"duration_ms"`
+	TTFTMs     int64     `json:"ttft_ms"`
+	// ToolWaitMs 是上一步结束到本步开始的间隔，本步回应了 tool call 时近似为客户端执行工具的耗时。
+	ToolWaitMs       int64                  `json:"tool_wait_ms"`
+	PromptTokens     int                    `json:"prompt_tokens"`
+	CompletionTokens int                    `json:"completion_tokens"`
+	TotalTokens      int                    `json:"total_tokens"`
+	CachedTokens     int                    `json:"cached_tokens"`
+	Stream           bool                   `json:"stream,omitempty"`
+	ToolCalls        []TrajectoryToolCall   `json:"tool_calls,omitempty"`
+	ToolResults      []TrajectoryToolResult `json:"tool_results,omitempty"`
+	// Observed 为 false 表示该 trace 无法解析，步骤只包含 header 中的指标。
+	Observed bool   `json:"observed"`
+	Error    string `json:"error,omitempty"`
+	// ClientCanceled 表示客户端在响应完成前断开。
+	ClientCanceled bool `json:"client_canceled,omitempty"`
+}
+
+type TrajectoryToolCall struct {
+	Step int    `json:"step"`
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+	Args string `json:"args,omitempty"`
+	// ArgsHash 是规范化后完整参数的摘要，用于识别参数相同的重复调用。
+	ArgsHash string `json:"args_hash,omitempty"`
+	NodeID   string `json:"node_id,omitempty"`
+}
+
+type TrajectoryToolResult struct {
+	CallID   string `json:"call_id,omitempty"`
+	Name     string `json:"name"`
+	CallStep int    `json:"call_step"`
+	Match    string `json:"match"`
+	IsError  bool   `json:"is_error,omitempty"`
+	Preview  string `json:"preview,omitempty"`
+	NodeID   string `json:"node_id,omitempty"`
+}
+
+// TrajectoryEdge 连接发起 tool call 的步骤和携带其结果的步骤，LatencyMs 为两步之间的等待时间。
+type TrajectoryEdge struct {
+	From      int    `json:"from"`
+	To        int    `json:"to"`
+	CallID    string `json:"call_id,omitempty"`
+	Tool      string `json:"tool"`
+	Match     string `json:"match"`
+	IsError   bool   `json:"is_error,omitempty"`
+	LatencyMs int64  `json:"latency_ms"`
+}
+
+// ContextPoint 记录每一轮的 prompt tokens 以及相对上一轮的增长。
+type ContextPoint struct {
+	Step         int    `json:"step"`
+	TraceID      string `json:"trace_id"`
+	PromptTokens int    `json:"prompt_tokens"`
+	Delta        int    `json:"delta"`
+}
+
+type requestToolResult struct {
+	id      string
+	name    string
+	isError bool
+	text    string
+	nodeID  string
+}
+
+// ParseObservations 从 cassette 重新解析 session 内每个 trace；数据库中的 semantic nodes 不保留 call id，无法用于配对。
+// 解析失败的 trace 不出现在结果中，由 BuildTrajectory 标记为未观测。
+func ParseObservations(ctx context.Context, traces []store.LogEntry) map[string]observe.TraceObservation {
+	registry := observe.NewDefaultRegistry()
+	out := make(map[string]observe.TraceObservation, len(traces))
+	for _, trace := range traces {
+		if ctx.Err() != nil {
+			break
+		}
+		obs, err := observeworker.ParseCassette(ctx, registry, trace.ID, trace.LogPath)
+		if err != nil {
+			continue
+		}
+		out[trace.ID] = obs
+	}
+	return out
+}
+
+// BuildTrajectory 按记录时间重建 tool-call/tool-result 循环。
+// 请求里的 tool result 优先按 call id 配对；没有 id（如 Gemini）时按工具名回退到上一步尚未回应的调用。
+// 后续请求会重放历史中的 tool result，已经回应过的调用不会重复配对。
+func BuildTrajectory(sessionID string, traces []store.LogEntry, observations map[string]observe.TraceObservation) Trajectory {
+	ordered := append([]store.LogEntry(nil), traces...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		left, right := ordered[i].Header.Meta.Time, ordered[j].Header.Meta.Time
+		if !left.Equal(right) {
+			return left.Before(right)
+		}
+		return ordered[i].ID < ordered[j].ID
+	})
+
+	out := Trajectory{SessionID: sessionID, Steps: []TrajectoryStep{}, Edges: []TrajectoryEdge{}, ContextGrowth: []ContextPoint{}}
+	calls := map[string]TrajectoryToolCall{}
+	answered := map[string]bool{}
+	var (
+		issued   [][]TrajectoryToolCall
+		prevEnd  time.Time
+		prevSize int
+	)
+	for i, trace := range ordered {
+		meta, usage := trace.Header.Meta, trace.Header.Usage
+		step := TrajectoryStep{
+			Index:            i,
+			TraceID:          trace.ID,
+			RecordedAt:       meta.Time,
+			Model:            meta.Model,
+			Provider:         meta.Provider,
+			StatusCode:       meta.StatusCode,
+			DurationMs:       meta.DurationMs,
+			TTFTMs:           meta.TTFTMs,
+			PromptTokens:     usage.PromptTokens,
+			CompletionTokens: usage.CompletionTokens,
+			TotalTokens:      usage.TotalTokens,
+			Stream:           trace.Header.Layout.IsStream,
+			Error:            meta.Error,
+			ClientCanceled:   meta.ClientCanceled,
+		}
+		if usage.PromptTokenDetails != nil {
+			step.CachedTokens = usage.PromptTokenDetails.CachedTokens
+		}
+		if !prevEnd.IsZero() && meta.Time.After(prevEnd) {
+			step.ToolWaitMs = meta.Time.Sub(prevEnd).Milliseconds()
+		}
+
+		obs, ok := observations[trace.ID]
+		step.Observed = ok
+		if ok {
+			if step.PromptTokens == 0 && step.CompletionTokens == 0 {
+				step.PromptTokens, step.CompletionTokens, step.TotalTokens = obs.Usage.InputTokens, obs.Usage.OutputTokens, obs.Usage.TotalTokens
+			}
+			results := collectRequestToolResults(obs.Request.Nodes)
+			matches := make([]*TrajectoryToolResult, len(results))
+			for j, result := range results {
+				call, found := calls[result.id]
+				if result.id == "" || !found || answered[result.id] {
+					continue
+				}
+				answered[result.id] = true
+				matches[j] = &TrajectoryToolResult{CallID: call.ID, Name: call.Name, CallStep: call.Step, Match: MatchByID}
+			}
+			// 按名称回退时从后往前匹配，因为最新的结果位于请求末尾，前面的通常是历史重放。
+			if i > 0 {
+				for j := len(results) - 1; j >= 0; j-- {
+					if matches[j] != nil || results[j].id != "" || results[j].name == "" {
+						continue
+					}
+					for _, call := range issued[i-1] {
+						key := callKey(call)
+						if call.Name != results[j].name || answered[key] {
+							continue
+						}
+						answered[key] = true
+						matches[j] = &TrajectoryToolResult{CallID: call.ID, Name: call.Name, CallStep: call.Step, Match: MatchByName}
+						break
+					}
+				}
+			}
+			for j, match := range matches {
+				if match == nil {
+					continue
+				}
+				match.IsError, match.Preview, match.NodeID = results[j].isError, preview(results[j].text), results[j].nodeID
+				step.ToolResults = append(step.ToolResults, *match)
+				out.Edges = append(out.Edges, TrajectoryEdge{
+					From:      match.CallStep,
+					To:        i,
+					CallID:    match.CallID,
+					Tool:      match.Name,
+					Match:     match.Match,
+					IsError:   match.IsError,
+					LatencyMs: edgeLatency(out.Steps[match.CallStep], meta.Time),
+				})
+			}
+			for _, node := range obs.Response.ToolCalls {
+				args := metadataString(node.Metadata, "arguments", "input")
+				call := TrajectoryToolCall{
+					Step:     i,
+					ID:       metadataString(node.Metadata, "call_id", "id"),
+					Name:     firstNonEmpty(metadataString(node.Metadata, "name"), node.Text, "unknown-tool"),
+					Args:     preview(args),
+					ArgsHash: argsHash(args),
+					NodeID:   node.ID,
+				}
+				// 没有 id 的调用只能通过名称回退配对。
+				if call.ID != "" {
+					calls[call.ID] = call
+				}
+				step.ToolCalls = append(step.ToolCalls, call)
+			}
+		}
+		issued = append(issued, step.ToolCalls)
+		out.ToolCalls += len(step.ToolCalls)
+		out.AnsweredCalls += len(step.ToolResults)
+
+		point := ContextPoint{Step: i, TraceID: trace.ID, PromptTokens: step.PromptTokens}
+		if i > 0 {
+			point.Delta = step.PromptTokens - prevSize
+		}
+		prevSize = step.PromptTokens
+		out.ContextGrowth = append(out.ContextGrowth, point)
+		if !meta.Time.IsZero() {
+			prevEnd = meta.Time.Add(time.Duration(meta.DurationMs) * time.Millisecond)
+		}
+		out.Steps = append(out.Steps, step)
+	}
+	for _, stepCalls := range issued {
+		for _, call := range stepCalls {
+			if !answered[callKey(call)] {
+				out.PendingCalls = append(out.PendingCalls, call)
+			}
+		}
+	}
+	return out
+}
+
+// DOT 把 trajectory 导出为 Graphviz 图：实线为步骤顺序，虚线为 tool call 到 tool result 的配对。
+func (t Trajectory) DOT() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote("session "+t.SessionID))
+	b.WriteString("  rankdir=LR;\n  node [shape=box];\n")
+	for _, step := range t.Steps {
+		label := fmt.Sprintf("#%d %s\\n%s\\n%dms, %d prompt / %d completion tokens", step.Index, step.TraceID, firstNonEmpty(step.Model, "unknown-model"), step.DurationMs, step.PromptTokens, step.CompletionTokens)
+		if len(step.ToolCalls) > 0 {
+			names := make([]string, 0, len(step.ToolCalls))
+			for _, call := range step.ToolCalls {
+				names = append(names, call.Name)
+			}
+			label += "\\ncalls: " + strings.Join(names, ", ")
+		}
+		attrs := ""
+		if step.StatusCode >= 400 || step.Error != "" {
+			attrs = ", color=red"
+		}
+		fmt.Fprintf(&b, "  s%d [label=%s%s];\n", step.Index, dotQuote(label), attrs)
+	}
+	for i := 1; i < len(t.Steps); i++ {
+		fmt.Fprintf(&b, "  s%d -> s%d [color=gray];\n", i-1, i)
+	}
+	for _, edge := range t.Edges {
+		attrs := "style=dashed"
+		if edge.IsError {
+			attrs += ", color=red"
+		}
+		fmt.Fprintf(&b, "  s%d -> s%d [label=%s, %s];\n", edge.From, edge.To, dotQuote(fmt.Sprintf("%s (%dms)", edge.Tool, edge.LatencyMs)), attrs)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// collectRequestToolResults 按文档顺序收集请求中的 tool result 节点，兼容 chat 的 tool 消息、responses 的 function_call_output 和 anthropic 的 tool_result。
+func collectRequestToolResults(nodes []observe.SemanticNode) []requestToolResult {
+	var out []requestToolResult
+	for _, node := range nodes {
+		if node.NormalizedType == observe.NodeToolResult || node.NormalizedType == observe.NodeServerToolResult {
+			out = append(out, requestToolResult{
+				id:      metadataString(node.Metadata, "tool_call_id", "call_id", "tool_use_id", "id"),
+				name:    metadataString(node.Metadata, "name"),
+				isError: metadataString(node.Metadata, "is_error") == "true" || strings.Contains(strings.ToLower(metadataString(node.Metadata, "status")), "error"),
+				text:    nodeText(node),
+				nodeID:  node.ID,
+			})
+			continue
+		}
+		out = append(out, collectRequestToolResults(node.Children)...)
+	}
+	return out
+}
+
+func callKey(call TrajectoryToolCall) string {
+	if call.ID != "" {
+		return call.ID
+	}
+	return fmt.Sprintf("#%d.%s.%s", call.Step, call.Name, call.NodeID)
+}
+
+// argsHash 对 JSON 参数先做紧凑化，避免空白差异影响重复判断。
+func argsHash(args string) string {
+	args = strings.TrimSpace(args)
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, []byte(args)); err == nil {
+		args = compact.String()
+	}
+	sum := sha1.Sum([]byte(args))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+func edgeLatency(from TrajectoryStep, to time.Time) int64 {
+	if from.RecordedAt.IsZero() || to.IsZero() {
+		return 0
+	}
+	end := from.RecordedAt.Add(time.Duration(from.DurationMs) * time.Millisecond)
+	if !to.After(end) {
+		return 0
+	}
+	return to.Sub(end).Milliseconds()
+}
+
+func metadataString(metadata map[string]any, keys ...string) string {
+	for _, key := range keys {
+		switch value := metadata[key].(type) {
+		case string:
+			if strings.TrimSpace(value) != "" {
+				return value
+			}
+		case bool:
+			return fmt.Sprint(value)
+		case json.RawMessage:
+			if len(value) > 0 && string(value) != "null" {
+				return string(value)
+			}
+		}
+	}
+	return ""
+}
+
+func nodeText(node observe.SemanticNode) string {
+	if strings.TrimSpace(node.Text) != "" {
+		return node.Text
+	}
+	parts := make([]string, 0, len(node.Children))
+	for _, child := range node.Children {
+		if text := nodeText(child); text != "" {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+func preview(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if utf8.RuneCountInString(text) <= previewLimit {
+		return text
+	}
+	return string([]rune(text)[:previewLimit]) + "…"
+}
+
+func dotQuote(value string) string {
+	return `"` + strings.ReplaceAll(value, `"`, `\"`) + `"`
+}
@@ -0,0 +1,101 @@
+package sessionanalysis
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+)
+
+func TestBuildTrajectoryPairsByIDAndNameAndSkipsReplayedResults(t *testing.T) {
+	start := time.Date(2026, 5, 13, 10, 0, 0, 0, time.UTC)
+	// ListTracesBySession 按时间倒序返回，BuildTrajectory 需要自行排序。
+	traces := []store.LogEntry{
+		step("trace-4", start.Add(9*time.Second), 300),
+		step("trace-3", start.Add(6*time.Second), 220),
+		step("trace-2", start.Add(3*time.Second), 150),
+		step("trace-1", start, 100),
+	}
+	observations := map[string]observe.TraceObservation{
+		"trace-1": {Response: observe.ObservationResponse{ToolCalls: []observe.SemanticNode{
+			toolCall("call_a", "read_file"),
+			toolCall("call_b", "grep"),
+		}}},
+		"trace-2": {
+			Request: observe.ObservationRequest{Nodes: []observe.SemanticNode{
+				toolResult(map[string]any{"tool_call_id": "call_a"}, "package main"),
+				toolResult(map[string]any{"tool_call_id": "call_b"}, "no matches"),
+			}},
+			Response: observe.ObservationResponse{ToolCalls: []observe.SemanticNode{toolCall("", "run_tests")}},
+		},
+		// trace-3 重放了全部历史结果，只有 run_tests 的结果是新的且没有 call id。
+		"trace-3": {
+			Request: observe.ObservationRequest{Nodes: []observe.SemanticNode{{
+				NormalizedType: observe.NodeMessage,
+				Children: []observe.SemanticNode{
+					toolResult(map[string]any{"tool_call_id": "call_a"}, "package main"),
+					toolResult(map[string]any{"tool_call_id": "call_b"}, "no matches"),
+					toolResult(map[string]any{"name": "run_tests", "is_error": true}, "FAIL TestX"),
+				},
+			}}},
+			Response: observe.ObservationResponse{ToolCalls: []observe.SemanticNode{toolCall("call_c", "edit_file")}},
+		},
+	}
+
+	out := BuildTrajectory("sess-1", traces, observations)
+	if len(out.Steps) != 4 || out.Steps[0].TraceID != "trace-1" || out.Steps[3].TraceID != "trace-4" {
+		t.Fatalf("steps = %+v", out.Steps)
+	}
+	if out.ToolCalls != 4 || out.AnsweredCalls != 3 || len(out.Edges) != 3 {
+		t.Fatalf("counts = calls %d answered %d edges %+v", out.ToolCalls, out.AnsweredCalls, out.Edges)
+	}
+	if got := out.Steps[2].ToolResults; len(got) != 1 || got[0].Name != "run_tests" || got[0].Match != MatchByName || !got[0].IsError || got[0].CallStep != 1 {
+		t.Fatalf("step 2 results = %+v", got)
+	}
+	if edge := out.Edges[0]; edge.From != 0 || edge.To != 1 || edge.CallID != "call_a" || edge.Match != MatchByID || edge.LatencyMs != 2000 {
+		t.Fatalf("first edge = %+v", edge)
+	}
+	if len(out.PendingCalls) != 1 || out.PendingCalls[0].ID != "call_c" {
+		t.Fatalf("pending calls = %+v", out.PendingCalls)
+	}
+	if out.Steps[3].Observed || out.Steps[3].ToolWaitMs != 2000 {
+		t.Fatalf("unobserved step = %+v", out.Steps[3])
+	}
+	if got := out.ContextGrowth; len(got) != 4 || got[1].Delta != 50 || got[3].PromptTokens != 300 {
+		t.Fatalf("context growth = %+v", got)
+	}
+
+	dot := out.DOT()
+	for _, want := range []string{`digraph "session sess-1" {`, "s0 -> s1 [color=gray];", `s1 -> s2 [label="run_tests (2000ms)", style=dashed, color=red];`} {
+		if !strings.Contains(dot, want) {
+			t.Fatalf("DOT() missing %q:\n%s", want, dot)
+		}
+	}
+}
+
+func step(id string, at time.Time, promptTokens int) store.LogEntry {
+	entry := trace(id, "gpt-5", "openai_compatible", "/v1/chat/completions", 200)
+	entry.Header.Meta.Time = at
+	entry.Header.Meta.DurationMs = 1000
+	entry.Header.Usage.PromptTokens = promptTokens
+	return entry
+}
+
+func toolCall(id string, name string) observe.SemanticNode {
+	return observe.SemanticNode{
+		ID:             "node-" + name,
+		NormalizedType: observe.NodeToolCall,
+		Text:           name,
+		Metadata:       map[string]any{"id": id, "name": name, "arguments": "{}"},
+	}
+}
+
+func toolResult(metadata map[string]any, text string) observe.SemanticNode {
+	return observe.SemanticNode{
+		NormalizedType: observe.NodeToolResult,
+		Metadata:       metadata,
+		Children:       []observe.SemanticNode{{NormalizedType: observe.NodeText, Text: text}},
+	}
+}
@@ -0,0 +1,281 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/glob"
+)
+
+const (
+	AlertStateOK     = "ok"
+	AlertStateFiring = "firing"
+)
+
+// AlertRule 是保存在数据库中的聚合指标告警规则及其最近一次评估状态。
+type AlertRule struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	Description   string  `json:"description,omitempty"`
+	Metric        string  `json:"metric"`
+	Operator      string  `json:"operator"`
+	Threshold     float64 `json:"threshold"`
+	WindowSeconds int64   `json:"window_seconds"`
+	Model         string  `json:"model,omitempty"`
+	Channel       string  `json:"channel,omitempty"`
+	Token         string  `json:"token,omitempty"`
+	MinRequests   int     `json:"min_requests"`
+	Severity      string  `json:"severity"`
+	Enabled       bool    `json:"enabled"`
+	// Source 记录来源，如 api 或 config。
+	Source          string    `json:"source"`
+	State           string    `json:"state"`
+	LastValue       float64   `json:"last_value"`
+	LastSamples     int       `json:"last_samples"`
+	LastEvaluatedAt time.Time `json:"last_evaluated_at,omitempty"`
+	StateChangedAt  time.Time `json:"state_changed_at,omitempty"`
+	// EventID 指向最近一次触发时记录的系统事件。
+	EventID   string    `json:"event_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AlertRuleState 是一次评估写回规则的结果；Changed 为 true 时更新 state_changed_at。
+type AlertRuleState struct {
+	State       string
+	Value       float64
+	Samples     int
+	EventID     string
+	EvaluatedAt time.Time
+	Changed     bool
+}
+
+// AlertScope 限定告警聚合的 logs 范围，各维度是 glob 通配符（忽略大小写），为空的维度不过滤。
+type AlertScope struct {
+	Model   string
+	Channel string
+	Token   string
+}
+
+// AlertWindowStats 是某个时间窗口内 logs 的聚合结果，分位数只统计大于 0 的样本，且只在分位数指标下计算。
+type AlertWindowStats struct {
+	Requests       int     `json:"requests"`
+	Failed         int     `json:"failed"`
+	TotalTokens    int64   `json:"total_tokens"`
+	TTFTSamples    int     `json:"ttft_samples"`
+	TTFTP95Ms      float64 `json:"ttft_p95_ms"`
+	LatencyP95Ms   float64 `json:"latency_p95_ms"`
+	LatencySamples int     `json:"latency_samples"`
+}
+
+const alertRuleColumns = `id, name, description, metric, operator, threshold, window_seconds, model, channel, token_name,
+	min_requests, severity, enabled, source, state, last_value, last_samples, last_evaluated_at, state_changed_at,
+	event_id, created_at, updated_at`
+
+// SaveAlertRule 按 ID 新建或覆盖规则定义，已有的评估状态保持不变。
+func (s *Store) SaveAlertRule(rule AlertRule) (AlertRule, error) {
+	rule.ID = strings.TrimSpace(rule.ID)
+	if rule.ID == "" {
+		return AlertRule{}, fmt.Errorf("alert rule id is required")
+	}
+	now := time.Now().UTC()
+	if _, err := s.db.Exec(`
+		INSERT INTO alert_rules (
+			id, name, description, metric, operator, threshold, window_seconds, model, channel, token_name,
+			min_requests, severity, enabled, source, state, created_at, updated_at
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			name=excluded.name,
+			description=excluded.description,
+			metric=excluded.metric,
+			operator=excluded.operator,
+			threshold=excluded.threshold,
+			window_seconds=excluded.window_seconds,
+			model=excluded.model,
+			channel=excluded.channel,
+			token_name=excluded.token_name,
+			min_requests=excluded.min_requests,
+			severity=excluded.severity,
+			enabled=excluded.enabled,
+			source=excluded.source,
+			updated_at=excluded.updated_at
+	`, rule.ID, strings.TrimSpace(rule.Name), strings.TrimSpace(rule.Description), rule.Metric, rule.Operator, rule.Threshold,
+		rule.WindowSeconds, strings.TrimSpace(rule.Model), strings.TrimSpace(rule.Channel), strings.TrimSpace(rule.Token),
+		rule.MinRequests, rule.Severity, boolToInt(rule.Enabled), strings.TrimSpace(rule.Source), AlertStateOK, now, now); err != nil {
+		return AlertRule{}, err
+	}
+	return s.GetAlertRule(rule.ID)
+}
+
+// GetAlertRule 返回指定规则，不存在时返回 sql.ErrNoRows。
+func (s *Store) GetAlertRule(id string) (AlertRule, error) {
+	rows, err := s.db.Query(`SELECT `+alertRuleColumns+` FROM alert_rules WHERE id = ?`, strings.TrimSpace(id))
+	if err != nil {
+		return AlertRule{}, err
+	}
+	defer rows.Close()
+	items, err := scanAlertRules(rows)
+	if err != nil {
+		return AlertRule{}, err
+	}
+	if len(items) == 0 {
+		return AlertRule{}, sql.ErrNoRows
+	}
+	return items[0], nil
+}
+
+func (s *Store) ListAlertRules() ([]AlertRule, error) {
+	rows, err := s.db.Query(`SELECT ` + alertRuleColumns + ` FROM alert_rules ORDER BY id ASC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanAlertRules(rows)
+}
+
+// DeleteAlertRule 删除指定规则，不存在时返回 sql.ErrNoRows。
+func (s *Store) DeleteAlertRule(id string) error {
+	result, err := s.db.Exec(`DELETE FROM alert_rules WHERE id = ?`, strings.TrimSpace(id))
+	if err != nil {
+		return err
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (s *Store) UpdateAlertRuleState(id string, state AlertRuleState) error {
+	evaluatedAt := state.EvaluatedAt.UTC()
+	if state.EvaluatedAt.IsZero() {
+		evaluatedAt = time.Now().UTC()
+	}
+	_, err := s.db.Exec(`
+		UPDATE alert_rules
+		SET state = ?, last_value = ?, last_samples = ?, last_evaluated_at = ?, event_id = ?,
+			state_changed_at = CASE WHEN ? THEN ? ELSE state_changed_at END
+		WHERE id = ?
+	`, state.State, state.Value, state.Samples, evaluatedAt, state.EventID, boolToInt(state.Changed), evaluatedAt, strings.TrimSpace(id))
+	return err
+}
+
+// AlertWindowStats 在 SQL 中聚合 [since, until] 内命中 scope 的 logs，失败口径与 overview 一致（非 2xx 或带错误）。
+// percentiles 为 false 时只统计样本数，不计算 p95，避免非分位数指标扫描耗时列。
+func (s *Store) AlertWindowStats(scope AlertScope, since time.Time, until time.Time, percentiles bool) (AlertWindowStats, error) {
+	where := `recorded_at >= ? AND recorded_at <= ?`
+	args := []any{since.UTC().Format(timeLayout), until.UTC().Format(timeLayout)}
+	for _, filter := range []struct {
+		column  string
+		pattern string
+	}{{"model", scope.Model}, {"selected_upstream_id", scope.Channel}, {"token_name", scope.Token}} {
+		pattern := strings.TrimSpace(filter.pattern)
+		if pattern == "" {
+			continue
+		}
+		values, err := s.alertScopeValues(filter.column, pattern, where, args)
+		if err != nil {
+			return AlertWindowStats{}, err
+		}
+		if len(values) == 0 {
+			return AlertWindowStats{}, nil
+		}
+		where += ` AND ` + filter.column + ` IN (` + strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", ") + `)`
+		args = append(args, values...)
+	}
+
+	var out AlertWindowStats
+	if err := s.db.QueryRow(`
+		SELECT
+			COUNT(*),
+			COALESCE(SUM(CASE WHEN status_code < 200 OR status_code >= 300 OR TRIM(error_text) != '' THEN 1 ELSE 0 END), 0),
+			COALESCE(SUM(total_tokens), 0),
+			COALESCE(SUM(CASE WHEN ttft_ms > 0 THEN 1 ELSE 0 END), 0),
+			COALESCE(SUM(CASE WHEN duration_ms > 0 THEN 1 ELSE 0 END), 0)
+		FROM logs
+		WHERE `+where, args...).Scan(&out.Requests, &out.Failed, &out.TotalTokens, &out.TTFTSamples, &out.LatencySamples); err != nil {
+		return AlertWindowStats{}, err
+	}
+	if !percentiles {
+		return out, nil
+	}
+	var err error
+	if out.TTFTP95Ms, err = s.alertPercentile95("ttft_ms", out.TTFTSamples, where, args); err != nil {
+		return AlertWindowStats{}, err
+	}
+	if out.LatencyP95Ms, err = s.alertPercentile95("duration_ms", out.LatencySamples, where, args); err != nil {
+		return AlertWindowStats{}, err
+	}
+	return out, nil
+}
+
+// alertScopeValues 返回窗口内 column 的取值中命中 glob 通配符的那些，匹配语义与 glob.MatchAny 一致（忽略大小写）。
+func (s *Store) alertScopeValues(column string, pattern string, where string, args []any) ([]any, error) {
+	rows, err := s.db.Query(`SELECT DISTINCT `+column+` FROM logs WHERE `+where, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []any
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		if glob.MatchAny([]string{pattern}, value) {
+			out = append(out, value)
+		}
+	}
+	return out, rows.Err()
+}
+
+// alertPercentile95 使用 nearest-rank 计算 column 大于 0 的样本的 p95，没有样本时返回 0。
+func (s *Store) alertPercentile95(column string, samples int, where string, args []any) (float64, error) {
+	if samples == 0 {
+		return 0, nil
+	}
+	rank := int(math.Ceil(0.95*float64(samples))) - 1
+	var value int64
+	err := s.db.QueryRow(`SELECT `+column+` FROM logs WHERE `+where+` AND `+column+` > 0 ORDER BY `+column+` LIMIT 1 OFFSET ?`,
+		append(append([]any(nil), args...), max(rank, 0))...).Scan(&value)
+	if err != nil {
+		return 0, err
+	}
+	return float64(value), nil
+}
+
+func scanAlertRules(rows *sql.Rows) ([]AlertRule, error) {
+	var out []AlertRule
+	for rows.Next() {
+		var (
+			rule                                         AlertRule
+			enabled                                      bool
+			evaluatedAt, changedAt, createdAt, updatedAt any
+		)
+		if err := rows.Scan(&rule.ID, &rule.Name, &rule.Description, &rule.Metric, &rule.Operator, &rule.Threshold,
+			&rule.WindowSeconds, &rule.Model, &rule.Channel, &rule.Token, &rule.MinRequests, &rule.Severity, &enabled,
+			&rule.Source, &rule.State, &rule.LastValue, &rule.LastSamples, &evaluatedAt, &changedAt, &rule.EventID,
+			&createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+		rule.Enabled = enabled
+		var err error
+		if rule.LastEvaluatedAt, err = timeParseNullableValue(evaluatedAt); err != nil {
+			return nil, err
+		}
+		if rule.StateChangedAt, err = timeParseNullableValue(changedAt); err != nil {
+			return nil, err
+		}
+		if rule.CreatedAt, err = timeParseValue(createdAt); err != nil {
+			return nil, err
+		}
+		if rule.UpdatedAt, err = timeParseValue(updatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, rule)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,366 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kingfs/llm-tracelab/ent/dao"
+	"github.com/kingfs/llm-tracelab/ent/dao/annotation"
+	"github.com/kingfs/llm-tracelab/ent/dao/score"
+	"github.com/kingfs/llm-tracelab/ent/dao/tracetag"
+)
+
+// 标签与批注可以挂在 trace 或 session 上。
+const (
+	AnnotationTargetTrace   = "trace"
+	AnnotationTargetSession = "session"
+)
+
+// HumanEvaluatorKey 是人工 👍/👎 标注写入 scores 表时使用的 evaluator_key。
+const HumanEvaluatorKey = "human"
+
+// 人工标注的取值。
+const (
+	HumanLabelUp   = "up"
+	HumanLabelDown = "down"
+)
+
+type TagRecord struct {
+	TargetType string    `json:"target_type"`
+	TargetID   string    `json:"target_id"`
+	Tag        string    `json:"tag"`
+	CreatedBy  string    `json:"created_by,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type AnnotationRecord struct {
+	ID         string    `json:"id"`
+	TargetType string    `json:"target_type"`
+	TargetID   string    `json:"target_id"`
+	ParentID   string    `json:"parent_id,omitempty"`
+	Author     string    `json:"author,omitempty"`
+	Body       string    `json:"body"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func normalizeAnnotationTarget(targetType string, targetID string) (string, string, error) {
+	targetType = strings.ToLower(strings.TrimSpace(targetType))
+	targetID = strings.TrimSpace(targetID)
+	if targetType != AnnotationTargetTrace && targetType != AnnotationTargetSession {
+		return "", "", fmt.Errorf("target type must be %q or %q", AnnotationTargetTrace, AnnotationTargetSession)
+	}
+	if targetID == "" {
+		return "", "", fmt.Errorf("target id is required")
+	}
+	return targetType, targetID, nil
+}
+
+// NormalizeTag 统一标签写法：去掉首尾空白、转小写，内部空白替换为 -，以便在查询语言中直接使用。
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.Join(strings.Fields(tag), "-"))
+}
+
+// AddTags 给 trace 或 session 打标签，已存在的标签保持不变；返回目标上的全部标签。
+func (s *Store) AddTags(targetType string, targetID string, tags []string, createdBy string) ([]TagRecord, error) {
+	targetType, targetID, err := normalizeAnnotationTarget(targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	now := time.Now().UTC()
+	for _, raw := range tags {
+		tag := NormalizeTag(raw)
+		if tag == "" {
+			continue
+		}
+		err := s.client.TraceTag.Create().
+			SetTargetType(targetType).
+			SetTargetID(targetID).
+			SetTag(tag).
+			SetCreatedBy(strings.TrimSpace(createdBy)).
+			SetCreatedAt(now).
+			OnConflictColumns(tracetag.FieldTargetType, tracetag.FieldTargetID, tracetag.FieldTag).
+			Ignore().
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.ListTags(targetType, targetID)
+}
+
+// RemoveTag 删除目标上的一个标签，标签不存在时返回 sql.ErrNoRows。
+func (s *Store) RemoveTag(targetType string, targetID string, tag string) error {
+	targetType, targetID, err := normalizeAnnotationTarget(targetType, targetID)
+	if err != nil {
+		return err
+	}
+	deleted, err := s.client.TraceTag.Delete().
+		Where(
+			tracetag.TargetTypeEQ(targetType),
+			tracetag.TargetIDEQ(targetID),
+			tracetag.TagEQ(NormalizeTag(tag)),
+		).
+		Exec(context.Background())
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (s *Store) ListTags(targetType string, targetID string) ([]TagRecord, error) {
+	targetType, targetID, err := normalizeAnnotationTarget(targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.client.TraceTag.Query().
+		Where(tracetag.TargetTypeEQ(targetType), tracetag.TargetIDEQ(targetID)).
+		Order(tracetag.ByTag()).
+		All(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	out := make([]TagRecord, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, tagRecordFromEnt(row))
+	}
+	return out, nil
+}
+
+// ListTagsForTraces 批量读取 trace 自身的标签，用于列表展示。
+func (s *Store) ListTagsForTraces(traceIDs []string) (map[string][]string, error) {
+	out := make(map[string][]string, len(traceIDs))
+	if len(traceIDs) == 0 {
+		return out, nil
+	}
+	rows, err := s.client.TraceTag.Query().
+		Where(tracetag.TargetTypeEQ(AnnotationTargetTrace), tracetag.TargetIDIn(traceIDs...)).
+		Order(tracetag.ByTag()).
+		All(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		out[row.TargetID] = append(out[row.TargetID], row.Tag)
+	}
+	return out, nil
+}
+
+// AddAnnotation 新增一条批注；ParentID 非空时作为回复挂到同一目标的已有批注下。
+func (s *Store) AddAnnotation(record AnnotationRecord) (AnnotationRecord, error) {
+	targetType, targetID, err := normalizeAnnotationTarget(record.TargetType, record.TargetID)
+	if err != nil {
+		return AnnotationRecord{}, err
+	}
+	record.TargetType, record.TargetID = targetType, targetID
+	record.Body = strings.TrimSpace(record.Body)
+	if record.Body == "" {
+		return AnnotationRecord{}, fmt.Errorf("annotation body is required")
+	}
+	ctx := context.Background()
+	record.ParentID = strings.TrimSpace(record.ParentID)
+	if record.ParentID != "" {
+		parent, err := s.client.Annotation.Get(ctx, record.ParentID)
+		if err != nil {
+			if dao.IsNotFound(err) {
+				return AnnotationRecord{}, fmt.Errorf("parent annotation %q not found", record.ParentID)
+			}
+			return AnnotationRecord{}, err
+		}
+		if parent.TargetType != record.TargetType || parent.TargetID != record.TargetID {
+			return AnnotationRecord{}, fmt.Errorf("parent annotation %q belongs to another %s", record.ParentID, parent.TargetType)
+		}
+	}
+	record.ID = uuid.NewString()
+	record.Author = strings.TrimSpace(record.Author)
+	record.CreatedAt = time.Now().UTC()
+	record.UpdatedAt = record.CreatedAt
+	if err := s.client.Annotation.Create().
+		SetID(record.ID).
+		SetTargetType(record.TargetType).
+		SetTargetID(record.TargetID).
+		SetParentID(record.ParentID).
+		SetAuthor(record.Author).
+		SetBody(record.Body).
+		SetCreatedAt(record.CreatedAt).
+		SetUpdatedAt(record.UpdatedAt).
+		Exec(ctx); err != nil {
+		return AnnotationRecord{}, err
+	}
+	return record, nil
+}
+
+// UpdateAnnotation 修改批注正文，批注不存在时返回 sql.ErrNoRows。
+func (s *Store) UpdateAnnotation(id string, body string) (AnnotationRecord, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return AnnotationRecord{}, fmt.Errorf("annotation body is required")
+	}
+	row, err := s.client.Annotation.UpdateOneID(id).
+		SetBody(body).
+		SetUpdatedAt(time.Now().UTC()).
+		Save(context.Background())
+	if err != nil {
+		if dao.IsNotFound(err) {
+			return AnnotationRecord{}, sql.ErrNoRows
+		}
+		return AnnotationRecord{}, err
+	}
+	return annotationRecordFromEnt(row), nil
+}
+
+func (s *Store) GetAnnotation(id string) (AnnotationRecord, error) {
+	row, err := s.client.Annotation.Get(context.Background(), id)
+	if err != nil {
+		if dao.IsNotFound(err) {
+			return AnnotationRecord{}, sql.ErrNoRows
+		}
+		return AnnotationRecord{}, err
+	}
+	return annotationRecordFromEnt(row), nil
+}
+
+// DeleteAnnotation 删除批注及其全部回复，批注不存在时返回 sql.ErrNoRows。
+func (s *Store) DeleteAnnotation(id string) error {
+	ctx := context.Background()
+	if _, err := s.client.Annotation.Get(ctx, id); err != nil {
+		if dao.IsNotFound(err) {
+			return sql.ErrNoRows
+		}
+		return err
+	}
+	ids := []string{id}
+	for pending := ids; len(pending) > 0; {
+		children, err := s.client.Annotation.Query().
+			Where(annotation.ParentIDIn(pending...)).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, children...)
+		pending = children
+	}
+	_, err := s.client.Annotation.Delete().Where(annotation.IDIn(ids...)).Exec(ctx)
+	return err
+}
+
+// ListAnnotations 按时间顺序返回目标上的批注，回复通过 ParentID 关联，由调用方组装成线程。
+func (s *Store) ListAnnotations(targetType string, targetID string) ([]AnnotationRecord, error) {
+	targetType, targetID, err := normalizeAnnotationTarget(targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.client.Annotation.Query().
+		Where(annotation.TargetTypeEQ(targetType), annotation.TargetIDEQ(targetID)).
+		Order(annotation.ByCreatedAt(), annotation.ByID()).
+		All(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	out := make([]AnnotationRecord, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, annotationRecordFromEnt(row))
+	}
+	return out, nil
+}
+
+// SetHumanLabel 记录用户对 trace 的 👍/👎，作为 evaluator_key=human 的 score 保存。
+// 每个用户在每个 trace 上只保留最新一次标注；label 为空表示撤销该用户的标注。
+func (s *Store) SetHumanLabel(traceID string, label string, author string, note string) (ScoreRecord, error) {
+	traceID = strings.TrimSpace(traceID)
+	if traceID == "" {
+		return ScoreRecord{}, fmt.Errorf("trace id is required")
+	}
+	label = strings.ToLower(strings.TrimSpace(label))
+	author = strings.TrimSpace(author)
+	record := ScoreRecord{
+		ID:           HumanEvaluatorKey + ":" + traceID + ":" + author,
+		TraceID:      traceID,
+		EvaluatorKey: HumanEvaluatorKey,
+		Label:        label,
+		Explanation:  strings.TrimSpace(note),
+	}
+	switch label {
+	case "":
+	case HumanLabelUp:
+		record.Value, record.Status = 1, "pass"
+	case HumanLabelDown:
+		record.Value, record.Status = 0, "fail"
+	default:
+		return ScoreRecord{}, fmt.Errorf("label must be %q or %q", HumanLabelUp, HumanLabelDown)
+	}
+	if _, err := s.client.Score.Delete().Where(score.IDEQ(record.ID)).Exec(context.Background()); err != nil {
+		return ScoreRecord{}, err
+	}
+	if label == "" {
+		return ScoreRecord{}, nil
+	}
+	var sessionID sql.NullString
+	if err := s.db.QueryRow(`SELECT session_id FROM logs WHERE trace_id = ?`, traceID).Scan(&sessionID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return ScoreRecord{}, err
+	}
+	record.SessionID = sessionID.String
+	return s.AddScore(record)
+}
+
+// HumanLabelAuthor 从人工标注 score 的 ID 中取回标注人。
+func HumanLabelAuthor(record ScoreRecord) string {
+	if record.EvaluatorKey != HumanEvaluatorKey {
+		return ""
+	}
+	return strings.TrimPrefix(record.ID, HumanEvaluatorKey+":"+record.TraceID+":")
+}
+
+// tagFilterClause 匹配自身带有标签、或所属 session 带有标签的 trace；value 支持 * 通配。
+func tagFilterClause(traceColumn string, sessionColumn string, value string) (string, []any) {
+	clause, args := globClause("t.tag", NormalizeTag(value))
+	return `(EXISTS (SELECT 1 FROM trace_tags t WHERE t.target_type = '` + AnnotationTargetTrace + `' AND t.target_id = ` + traceColumn + ` AND ` + clause + `)` +
+			` OR EXISTS (SELECT 1 FROM trace_tags t WHERE t.target_type = '` + AnnotationTargetSession + `' AND ` + sessionColumn + ` <> '' AND t.target_id = ` + sessionColumn + ` AND ` + clause + `))`,
+		append(append([]any{}, args...), args...)
+}
+
+// queryConditions 合并查询语言条件与 Tags 过滤。
+func (f ListFilter) queryConditions() []QueryCondition {
+	if len(f.Tags) == 0 {
+		return f.Conditions
+	}
+	conditions := append([]QueryCondition{}, f.Conditions...)
+	for _, tag := range f.Tags {
+		if tag = NormalizeTag(tag); tag != "" {
+			conditions = append(conditions, QueryCondition{Field: "tag", Op: ":", Value: tag})
+		}
+	}
+	return conditions
+}
+
+func tagRecordFromEnt(row *dao.TraceTag) TagRecord {
+	return TagRecord{
+		TargetType: row.TargetType,
+		TargetID:   row.TargetID,
+		Tag:        row.Tag,
+		CreatedBy:  row.CreatedBy,
+		CreatedAt:  row.CreatedAt.UTC(),
+	}
+}
+
+func annotationRecordFromEnt(row *dao.Annotation) AnnotationRecord {
+	return AnnotationRecord{
+		ID:         row.ID,
+		TargetType: row.TargetType,
+		TargetID:   row.TargetID,
+		ParentID:   row.ParentID,
+		Author:     row.Author,
+		Body:       row.Body,
+		CreatedAt:  row.CreatedAt.UTC(),
+		UpdatedAt:  row.UpdatedAt.UTC(),
+	}
+}
@@ -0,0 +1,147 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTagsFilterTracesDirectlyAndThroughSessions(t *testing.T) {
+	dir := t.TempDir()
+	st, err := New(dir)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer st.Close()
+
+	now := time.Now().UTC()
+	writeModelLog(t, st, dir, "a.http", "gpt-5.1", "/v1/responses", "POST", "", 200, 10, now.Add(-3*time.Hour))
+	writeModelLog(t, st, dir, "b.http", "gpt-5.1", "/v1/responses", "POST", "", 200, 10, now.Add(-2*time.Hour))
+	writeModelLog(t, st, dir, "c.http", "gpt-5.1", "/v1/responses", "POST", "", 200, 10, now.Add(-time.Hour))
+	aID := mustTraceID(t, st, filepath.Join(dir, "a.http"))
+	bID := mustTraceID(t, st, filepath.Join(dir, "b.http"))
+	cID := mustTraceID(t, st, filepath.Join(dir, "c.http"))
+	if _, err := st.db.Exec(`UPDATE logs SET session_id = 'sess-1' WHERE trace_id = ?`, bID); err != nil {
+		t.Fatalf("set session error = %v", err)
+	}
+
+	tags, err := st.AddTags(AnnotationTargetTrace, aID, []string{"Good Example", "regression", "regression"}, "alice")
+	if err != nil {
+		t.Fatalf("AddTags() error = %v", err)
+	}
+	if len(tags) != 2 || tags[0].Tag != "good-example" || tags[0].CreatedBy != "alice" {
+		t.Fatalf("AddTags() = %+v, want normalized deduplicated tags", tags)
+	}
+	if _, err := st.AddTags(AnnotationTargetSession, "sess-1", []string{"regression"}, "bob"); err != nil {
+		t.Fatalf("AddTags(session) error = %v", err)
+	}
+
+	for _, tc := range []struct {
+		filter ListFilter
+		want   []string
+	}{
+		{filter: ListFilter{Tags: []string{"regression"}}, want: []string{bID, aID}},
+		{filter: ListFilter{Tags: []string{"regression", "good example"}}, want: []string{aID}},
+		{filter: ListFilter{Conditions: []QueryCondition{{Field: "tag", Op: ":", Value: "good-*"}}}, want: []string{aID}},
+		{filter: ListFilter{Conditions: []QueryCondition{{Field: "tag", Op: ":", Value: "regression", Negate: true}}}, want: []string{cID}},
+	} {
+		result, err := st.ListPage(1, 10, tc.filter)
+		if err != nil {
+			t.Fatalf("ListPage(%+v) error = %v", tc.filter, err)
+		}
+		var got []string
+		for _, item := range result.Items {
+			got = append(got, item.ID)
+		}
+		if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+			t.Fatalf("ListPage(%+v) = %v, want %v", tc.filter, got, tc.want)
+		}
+	}
+
+	if err := st.RemoveTag(AnnotationTargetTrace, aID, "regression"); err != nil {
+		t.Fatalf("RemoveTag() error = %v", err)
+	}
+	if err := st.RemoveTag(AnnotationTargetTrace, aID, "regression"); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("RemoveTag(missing) error = %v, want sql.ErrNoRows", err)
+	}
+	if _, err := st.AddTags("dataset", aID, []string{"x"}, ""); err == nil {
+		t.Fatalf("AddTags(invalid target) error = nil")
+	}
+}
+
+func TestAnnotationThreadsAndHumanLabels(t *testing.T) {
+	dir := t.TempDir()
+	st, err := New(dir)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer st.Close()
+
+	writeModelLog(t, st, dir, "a.http", "gpt-5.1", "/v1/responses", "POST", "", 200, 10, time.Now().UTC())
+	traceID := mustTraceID(t, st, filepath.Join(dir, "a.http"))
+
+	root, err := st.AddAnnotation(AnnotationRecord{TargetType: AnnotationTargetTrace, TargetID: traceID, Author: "alice", Body: "tool call looks wrong"})
+	if err != nil {
+		t.Fatalf("AddAnnotation() error = %v", err)
+	}
+	reply, err := st.AddAnnotation(AnnotationRecord{TargetType: AnnotationTargetTrace, TargetID: traceID, ParentID: root.ID, Author: "bob", Body: "agreed"})
+	if err != nil {
+		t.Fatalf("AddAnnotation(reply) error = %v", err)
+	}
+	if _, err := st.AddAnnotation(AnnotationRecord{TargetType: AnnotationTargetSession, TargetID: "sess-1", ParentID: root.ID, Body: "elsewhere"}); err == nil {
+		t.Fatalf("AddAnnotation(cross-target reply) error = nil")
+	}
+	if _, err := st.UpdateAnnotation(reply.ID, "agreed, see args"); err != nil {
+		t.Fatalf("UpdateAnnotation() error = %v", err)
+	}
+	items, err := st.ListAnnotations(AnnotationTargetTrace, traceID)
+	if err != nil || len(items) != 2 || items[1].ParentID != root.ID || items[1].Body != "agreed, see args" {
+		t.Fatalf("ListAnnotations() = %+v, err = %v", items, err)
+	}
+	if err := st.DeleteAnnotation(root.ID); err != nil {
+		t.Fatalf("DeleteAnnotation() error = %v", err)
+	}
+	if items, err := st.ListAnnotations(AnnotationTargetTrace, traceID); err != nil || len(items) != 0 {
+		t.Fatalf("ListAnnotations() after delete = %+v, err = %v, want replies removed", items, err)
+	}
+
+	if _, err := st.SetHumanLabel(traceID, "up", "alice", ""); err != nil {
+		t.Fatalf("SetHumanLabel() error = %v", err)
+	}
+	if _, err := st.SetHumanLabel(traceID, "down", "alice", "hallucinated a file"); err != nil {
+		t.Fatalf("SetHumanLabel(update) error = %v", err)
+	}
+	if _, err := st.SetHumanLabel(traceID, "up", "bob", ""); err != nil {
+		t.Fatalf("SetHumanLabel(bob) error = %v", err)
+	}
+	if _, err := st.SetHumanLabel(traceID, "meh", "bob", ""); err == nil {
+		t.Fatalf("SetHumanLabel(invalid) error = nil")
+	}
+	scores, err := st.ListScores(ScoreFilter{TraceID: traceID, EvaluatorKey: HumanEvaluatorKey}, 10)
+	if err != nil || len(scores) != 2 {
+		t.Fatalf("ListScores() = %+v, err = %v, want one label per user", scores, err)
+	}
+	labels := map[string]string{}
+	for _, record := range scores {
+		labels[HumanLabelAuthor(record)] = record.Label + "/" + record.Status
+	}
+	if labels["alice"] != "down/fail" || labels["bob"] != "up/pass" {
+		t.Fatalf("human labels = %v", labels)
+	}
+	conditions, err := ParseTraceQuery("label:down")
+	if err != nil {
+		t.Fatalf("ParseTraceQuery() error = %v", err)
+	}
+	if ids, err := st.ListTraceIDs(ListFilter{Conditions: conditions}, 10); err != nil || len(ids) != 1 {
+		t.Fatalf("ListTraceIDs(label:down) = %v, err = %v", ids, err)
+	}
+	if _, err := st.SetHumanLabel(traceID, "", "alice", ""); err != nil {
+		t.Fatalf("SetHumanLabel(clear) error = %v", err)
+	}
+	if scores, _ := st.ListScores(ScoreFilter{TraceID: traceID, EvaluatorKey: HumanEvaluatorKey}, 10); len(scores) != 1 {
+		t.Fatalf("ListScores() after clear = %+v, want one", scores)
+	}
+}
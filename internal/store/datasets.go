@@ -0,0 +1,398 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/kingfs/llm-tracelab/ent/dao"
+	"github.com/kingfs/llm-tracelab/ent/dao/dataset"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetexample"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetexampleoverride"
+	"github.com/kingfs/llm-tracelab/ent/dao/datasetsnapshot"
+)
+
+// DatasetExampleEdit 描述对数据集样例的编辑，nil 字段保持不变。
+type DatasetExampleEdit struct {
+	ExpectedOutput *string
+	Tags           *[]string
+	InputOverride  *json.RawMessage // 合并到原始请求 JSON 顶层的字段，空值表示清除
+	Note           *string
+	UpdatedBy      string
+}
+
+// DatasetSnapshotExample 是快照中冻结的样例，只保留引用与编辑内容，不复制 cassette。
+type DatasetSnapshotExample struct {
+	TraceID        string          `json:"trace_id"`
+	Position       int             `json:"position"`
+	AddedAt        time.Time       `json:"added_at"`
+	SourceType     string          `json:"source_type,omitempty"`
+	SourceID       string          `json:"source_id,omitempty"`
+	Note           string          `json:"note,omitempty"`
+	ExpectedOutput string          `json:"expected_output,omitempty"`
+	Tags           []string        `json:"tags,omitempty"`
+	InputOverride  json.RawMessage `json:"input_override,omitempty"`
+}
+
+type DatasetSnapshotRecord struct {
+	ID           string
+	DatasetID    string
+	Version      int
+	Note         string
+	CreatedBy    string
+	ExampleCount int
+	CreatedAt    time.Time
+	Examples     []DatasetSnapshotExample
+}
+
+// UpdateDataset 修改数据集名称与描述，nil 字段保持不变。
+func (s *Store) UpdateDataset(datasetID string, name *string, description *string) (DatasetRecord, error) {
+	ctx := context.Background()
+	update := s.client.Dataset.UpdateOneID(strings.TrimSpace(datasetID)).SetUpdatedAt(time.Now().UTC())
+	if name != nil {
+		trimmed := strings.TrimSpace(*name)
+		if trimmed == "" {
+			return DatasetRecord{}, fmt.Errorf("dataset name is required")
+		}
+		update.SetName(trimmed)
+	}
+	if description != nil {
+		update.SetDescription(strings.TrimSpace(*description))
+	}
+	if err := update.Exec(ctx); err != nil {
+		if dao.IsNotFound(err) {
+			return DatasetRecord{}, sql.ErrNoRows
+		}
+		return DatasetRecord{}, err
+	}
+	return s.GetDataset(datasetID)
+}
+
+// DeleteDataset 删除数据集及其样例、编辑与快照；已有的 eval run 与 score 保留。
+func (s *Store) DeleteDataset(datasetID string) error {
+	ctx := context.Background()
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.DatasetExample.Delete().Where(datasetexample.DatasetIDEQ(datasetID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.DatasetExampleOverride.Delete().Where(datasetexampleoverride.DatasetIDEQ(datasetID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.DatasetSnapshot.Delete().Where(datasetsnapshot.DatasetIDEQ(datasetID)).Exec(ctx); err != nil {
+		return err
+	}
+	deleted, err := tx.Dataset.Delete().Where(dataset.IDEQ(datasetID)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return sql.ErrNoRows
+	}
+	return tx.Commit()
+}
+
+// RemoveDatasetExamples 从数据集移除样例及其编辑，返回实际移除的数量。
+func (s *Store) RemoveDatasetExamples(datasetID string, traceIDs []string) (int, error) {
+	if len(traceIDs) == 0 {
+		return 0, nil
+	}
+	ctx := context.Background()
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	removed, err := tx.DatasetExample.Delete().
+		Where(datasetexample.DatasetIDEQ(datasetID), datasetexample.TraceIDIn(traceIDs...)).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := tx.DatasetExampleOverride.Delete().
+		Where(datasetexampleoverride.DatasetIDEQ(datasetID), datasetexampleoverride.TraceIDIn(traceIDs...)).
+		Exec(ctx); err != nil {
+		return 0, err
+	}
+	if removed > 0 {
+		if err := tx.Dataset.UpdateOneID(datasetID).SetUpdatedAt(time.Now().UTC()).Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return removed, nil
+}
+
+// UpdateDatasetExample 编辑样例的期望输出、标签、输入覆盖与备注；样例不存在时返回 sql.ErrNoRows。
+func (s *Store) UpdateDatasetExample(datasetID string, traceID string, edit DatasetExampleEdit) (DatasetExampleRecord, error) {
+	ctx := context.Background()
+	example, err := s.client.DatasetExample.Query().
+		Where(datasetexample.DatasetIDEQ(datasetID), datasetexample.TraceIDEQ(traceID)).
+		Only(ctx)
+	if err != nil {
+		if dao.IsNotFound(err) {
+			return DatasetExampleRecord{}, sql.ErrNoRows
+		}
+		return DatasetExampleRecord{}, err
+	}
+	var inputOverride string
+	if edit.InputOverride != nil {
+		if inputOverride, err = normalizeInputOverride(*edit.InputOverride); err != nil {
+			return DatasetExampleRecord{}, err
+		}
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return DatasetExampleRecord{}, err
+	}
+	defer tx.Rollback()
+	now := time.Now().UTC()
+	if edit.Note != nil {
+		if err := tx.DatasetExample.UpdateOne(example).SetNote(strings.TrimSpace(*edit.Note)).Exec(ctx); err != nil {
+			return DatasetExampleRecord{}, err
+		}
+	}
+	if edit.ExpectedOutput != nil || edit.Tags != nil || edit.InputOverride != nil {
+		create := tx.DatasetExampleOverride.Create().
+			SetDatasetID(datasetID).
+			SetTraceID(traceID).
+			SetUpdatedBy(strings.TrimSpace(edit.UpdatedBy)).
+			SetUpdatedAt(now)
+		if edit.ExpectedOutput != nil {
+			create.SetExpectedOutput(*edit.ExpectedOutput)
+		}
+		if edit.Tags != nil {
+			create.SetTagsJSON(marshalDatasetTags(*edit.Tags))
+		}
+		if edit.InputOverride != nil {
+			create.SetInputOverrideJSON(inputOverride)
+		}
+		if err := create.
+			OnConflictColumns(datasetexampleoverride.FieldDatasetID, datasetexampleoverride.FieldTraceID).
+			Update(func(upsert *dao.DatasetExampleOverrideUpsert) {
+				upsert.UpdateUpdatedBy()
+				upsert.UpdateUpdatedAt()
+				if edit.ExpectedOutput != nil {
+					upsert.UpdateExpectedOutput()
+				}
+				if edit.Tags != nil {
+					upsert.UpdateTagsJSON()
+				}
+				if edit.InputOverride != nil {
+					upsert.UpdateInputOverrideJSON()
+				}
+			}).
+			Exec(ctx); err != nil {
+			return DatasetExampleRecord{}, err
+		}
+	}
+	if err := tx.Dataset.UpdateOneID(datasetID).SetUpdatedAt(now).Exec(ctx); err != nil {
+		return DatasetExampleRecord{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return DatasetExampleRecord{}, err
+	}
+
+	examples, err := s.GetDatasetExamples(datasetID)
+	if err != nil {
+		return DatasetExampleRecord{}, err
+	}
+	for _, record := range examples {
+		if record.TraceID == traceID {
+			return record, nil
+		}
+	}
+	return DatasetExampleRecord{}, sql.ErrNoRows
+}
+
+// CreateDatasetSnapshot 冻结数据集当前的样例与编辑，版本号在数据集内递增。
+func (s *Store) CreateDatasetSnapshot(datasetID string, note string, createdBy string) (DatasetSnapshotRecord, error) {
+	if _, err := s.GetDataset(datasetID); err != nil {
+		return DatasetSnapshotRecord{}, err
+	}
+	examples, err := s.GetDatasetExamples(datasetID)
+	if err != nil {
+		return DatasetSnapshotRecord{}, err
+	}
+	frozen := make([]DatasetSnapshotExample, 0, len(examples))
+	for _, example := range examples {
+		frozen = append(frozen, DatasetSnapshotExample{
+			TraceID:        example.TraceID,
+			Position:       example.Position,
+			AddedAt:        example.AddedAt,
+			SourceType:     example.SourceType,
+			SourceID:       example.SourceID,
+			Note:           example.Note,
+			ExpectedOutput: example.ExpectedOutput,
+			Tags:           example.Tags,
+			InputOverride:  example.InputOverride,
+		})
+	}
+	payload, err := json.Marshal(frozen)
+	if err != nil {
+		return DatasetSnapshotRecord{}, err
+	}
+
+	ctx := context.Background()
+	version, err := s.datasetVersion(ctx, datasetID)
+	if err != nil {
+		return DatasetSnapshotRecord{}, err
+	}
+	record := DatasetSnapshotRecord{
+		ID:           uuid.NewString(),
+		DatasetID:    datasetID,
+		Version:      version + 1,
+		Note:         strings.TrimSpace(note),
+		CreatedBy:    strings.TrimSpace(createdBy),
+		ExampleCount: len(frozen),
+		CreatedAt:    time.Now().UTC(),
+		Examples:     frozen,
+	}
+	if err := s.client.DatasetSnapshot.Create().
+		SetID(record.ID).
+		SetDatasetID(record.DatasetID).
+		SetVersion(record.Version).
+		SetNote(record.Note).
+		SetCreatedBy(record.CreatedBy).
+		SetExampleCount(record.ExampleCount).
+		SetExamplesJSON(string(payload)).
+		SetCreatedAt(record.CreatedAt).
+		Exec(ctx); err != nil {
+		return DatasetSnapshotRecord{}, err
+	}
+	return record, nil
+}
+
+// ListDatasetSnapshots 返回数据集的快照列表（不含样例），最新版本在前。
+func (s *Store) ListDatasetSnapshots(datasetID string) ([]DatasetSnapshotRecord, error) {
+	rows, err := s.client.DatasetSnapshot.Query().
+		Where(datasetsnapshot.DatasetIDEQ(datasetID)).
+		Order(datasetsnapshot.ByVersion(entsql.OrderDesc())).
+		All(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	out := make([]DatasetSnapshotRecord, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, datasetSnapshotRecordFromEnt(row))
+	}
+	return out, nil
+}
+
+// GetDatasetSnapshot 返回指定版本的快照及其冻结的样例。
+func (s *Store) GetDatasetSnapshot(datasetID string, version int) (DatasetSnapshotRecord, error) {
+	row, err := s.client.DatasetSnapshot.Query().
+		Where(datasetsnapshot.DatasetIDEQ(datasetID), datasetsnapshot.VersionEQ(version)).
+		Only(context.Background())
+	if err != nil {
+		if dao.IsNotFound(err) {
+			return DatasetSnapshotRecord{}, sql.ErrNoRows
+		}
+		return DatasetSnapshotRecord{}, err
+	}
+	record := datasetSnapshotRecordFromEnt(row)
+	if err := json.Unmarshal([]byte(row.ExamplesJSON), &record.Examples); err != nil {
+		return DatasetSnapshotRecord{}, fmt.Errorf("decode dataset snapshot: %w", err)
+	}
+	return record, nil
+}
+
+func (s *Store) datasetVersion(ctx context.Context, datasetID string) (int, error) {
+	count, err := s.client.DatasetSnapshot.Query().
+		Where(datasetsnapshot.DatasetIDEQ(datasetID)).
+		Count(ctx)
+	if err != nil || count == 0 {
+		return 0, err
+	}
+	return s.client.DatasetSnapshot.Query().
+		Where(datasetsnapshot.DatasetIDEQ(datasetID)).
+		Aggregate(dao.Max(datasetsnapshot.FieldVersion)).
+		Int(ctx)
+}
+
+func (s *Store) datasetExampleOverrides(datasetID string) (map[string]*dao.DatasetExampleOverride, error) {
+	rows, err := s.client.DatasetExampleOverride.Query().
+		Where(datasetexampleoverride.DatasetIDEQ(datasetID)).
+		All(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]*dao.DatasetExampleOverride, len(rows))
+	for _, row := range rows {
+		out[row.TraceID] = row
+	}
+	return out, nil
+}
+
+func applyDatasetExampleOverride(record *DatasetExampleRecord, row *dao.DatasetExampleOverride) {
+	if row == nil {
+		return
+	}
+	record.ExpectedOutput = row.ExpectedOutput
+	_ = json.Unmarshal([]byte(row.TagsJSON), &record.Tags)
+	if row.InputOverrideJSON != "" {
+		record.InputOverride = json.RawMessage(row.InputOverrideJSON)
+	}
+}
+
+// normalizeInputOverride 校验输入覆盖必须是 JSON object，null 或空值表示清除。
+func normalizeInputOverride(raw json.RawMessage) (string, error) {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return "", nil
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(trimmed, &object); err != nil {
+		return "", fmt.Errorf("input override must be a JSON object: %w", err)
+	}
+	if len(object) == 0 {
+		return "", nil
+	}
+	compact, err := json.Marshal(object)
+	if err != nil {
+		return "", err
+	}
+	return string(compact), nil
+}
+
+func marshalDatasetTags(tags []string) string {
+	seen := map[string]struct{}{}
+	out := make([]string, 0, len(tags))
+	for _, raw := range tags {
+		tag := NormalizeTag(raw)
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		out = append(out, tag)
+	}
+	payload, _ := json.Marshal(out)
+	return string(payload)
+}
+
+func datasetSnapshotRecordFromEnt(row *dao.DatasetSnapshot) DatasetSnapshotRecord {
+	return DatasetSnapshotRecord{
+		ID:           row.ID,
+		DatasetID:    row.DatasetID,
+		Version:      row.Version,
+		Note:         row.Note,
+		CreatedBy:    row.CreatedBy,
+		ExampleCount: row.ExampleCount,
+		CreatedAt:    row.CreatedAt,
+	}
+}
@@ -0,0 +1,133 @@
+package store
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/pkg/recordfile"
+)
+
+func TestDatasetExampleEditsAndSnapshots(t *testing.T) {
+	dir := t.TempDir()
+	st, err := New(dir)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer st.Close()
+
+	var traceIDs []string
+	for _, requestID := range []string{"req-a", "req-b"} {
+		path := filepath.Join(dir, requestID+".http")
+		if err := os.WriteFile(path, []byte("test"), 0o644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+		header := recordfile.RecordHeader{
+			Version: "LLM_PROXY_V3",
+			Meta: recordfile.MetaData{
+				RequestID:  requestID,
+				Time:       time.Date(2026, 4, 21, 8, 0, 0, 0, time.UTC),
+				Model:      "gpt-4o",
+				URL:        "/v1/chat/completions",
+				Method:     "POST",
+				StatusCode: 200,
+			},
+		}
+		if err := st.UpsertLog(path, header); err != nil {
+			t.Fatalf("UpsertLog() error = %v", err)
+		}
+		entry, err := st.GetByRequestID(requestID)
+		if err != nil {
+			t.Fatalf("GetByRequestID() error = %v", err)
+		}
+		traceIDs = append(traceIDs, entry.ID)
+	}
+
+	dataset, err := st.CreateDataset("regressions", "")
+	if err != nil {
+		t.Fatalf("CreateDataset() error = %v", err)
+	}
+	if _, _, err := st.AppendDatasetExamples(dataset.ID, traceIDs, "trace", "", ""); err != nil {
+		t.Fatalf("AppendDatasetExamples() error = %v", err)
+	}
+
+	expected := "Paris"
+	tags := []string{"Geo Facts", "geo-facts", " smoke "}
+	override := json.RawMessage(`{"temperature":0}`)
+	note := "capital question"
+	edited, err := st.UpdateDatasetExample(dataset.ID, traceIDs[0], DatasetExampleEdit{
+		ExpectedOutput: &expected,
+		Tags:           &tags,
+		InputOverride:  &override,
+		Note:           &note,
+		UpdatedBy:      "alice",
+	})
+	if err != nil {
+		t.Fatalf("UpdateDatasetExample() error = %v", err)
+	}
+	if edited.ExpectedOutput != "Paris" || len(edited.Tags) != 2 || edited.Tags[0] != "geo-facts" || edited.Tags[1] != "smoke" || string(edited.InputOverride) != `{"temperature":0}` || edited.Note != "capital question" {
+		t.Fatalf("edited example = %+v", edited)
+	}
+	bad := json.RawMessage(`[1]`)
+	if _, err := st.UpdateDatasetExample(dataset.ID, traceIDs[0], DatasetExampleEdit{InputOverride: &bad}); err == nil {
+		t.Fatalf("UpdateDatasetExample(non-object override) error = nil")
+	}
+	if _, err := st.UpdateDatasetExample(dataset.ID, "missing", DatasetExampleEdit{Note: &note}); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("UpdateDatasetExample(missing) error = %v, want sql.ErrNoRows", err)
+	}
+
+	first, err := st.CreateDatasetSnapshot(dataset.ID, "baseline", "alice")
+	if err != nil || first.Version != 1 || first.ExampleCount != 2 {
+		t.Fatalf("CreateDatasetSnapshot() = %+v, err = %v", first, err)
+	}
+
+	// 快照之后的修改不影响已冻结的版本。
+	changed := "Paris, France"
+	if _, err := st.UpdateDatasetExample(dataset.ID, traceIDs[0], DatasetExampleEdit{ExpectedOutput: &changed}); err != nil {
+		t.Fatalf("UpdateDatasetExample() error = %v", err)
+	}
+	if removed, err := st.RemoveDatasetExamples(dataset.ID, []string{traceIDs[1]}); err != nil || removed != 1 {
+		t.Fatalf("RemoveDatasetExamples() = %d, err = %v", removed, err)
+	}
+	second, err := st.CreateDatasetSnapshot(dataset.ID, "", "")
+	if err != nil || second.Version != 2 || second.ExampleCount != 1 {
+		t.Fatalf("second snapshot = %+v, err = %v", second, err)
+	}
+	got, err := st.GetDataset(dataset.ID)
+	if err != nil || got.Version != 2 || got.ExampleCount != 1 {
+		t.Fatalf("GetDataset() = %+v, err = %v", got, err)
+	}
+
+	frozen, err := st.GetDatasetSnapshot(dataset.ID, 1)
+	if err != nil {
+		t.Fatalf("GetDatasetSnapshot() error = %v", err)
+	}
+	if len(frozen.Examples) != 2 || frozen.Examples[0].ExpectedOutput != "Paris" || frozen.Examples[0].Tags[0] != "geo-facts" || frozen.Note != "baseline" {
+		t.Fatalf("snapshot v1 = %+v", frozen)
+	}
+	snapshots, err := st.ListDatasetSnapshots(dataset.ID)
+	if err != nil || len(snapshots) != 2 || snapshots[0].Version != 2 {
+		t.Fatalf("ListDatasetSnapshots() = %+v, err = %v", snapshots, err)
+	}
+
+	name := "renamed"
+	if renamed, err := st.UpdateDataset(dataset.ID, &name, nil); err != nil || renamed.Name != "renamed" {
+		t.Fatalf("UpdateDataset() = %+v, err = %v", renamed, err)
+	}
+	if err := st.DeleteDataset(dataset.ID); err != nil {
+		t.Fatalf("DeleteDataset() error = %v", err)
+	}
+	if _, err := st.GetDataset(dataset.ID); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("GetDataset(deleted) error = %v, want sql.ErrNoRows", err)
+	}
+	if _, err := st.GetDatasetSnapshot(dataset.ID, 1); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("GetDatasetSnapshot(deleted) error = %v, want sql.ErrNoRows", err)
+	}
+	if err := st.DeleteDataset(dataset.ID); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("DeleteDataset(deleted) error = %v, want sql.ErrNoRows", err)
+	}
+}
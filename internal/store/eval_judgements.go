@@ -0,0 +1,63 @@
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// EvalJudgementRecord 缓存某个裁判模型对一条 trace 在某个 rubric 版本下的打分，避免重复调用上游。
+type EvalJudgementRecord struct {
+	TraceID       string    `json:"trace_id"`
+	RubricVersion string    `json:"rubric_version"`
+	Score         float64   `json:"score"`
+	Explanation   string    `json:"explanation"`
+	JudgeModel    string    `json:"judge_model"`
+	JudgeTraceID  string    `json:"judge_trace_id,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// SaveEvalJudgement 写入裁判结果，同一 trace、rubric 版本与裁判模型覆盖旧结果。
+func (s *Store) SaveEvalJudgement(record EvalJudgementRecord) error {
+	record.TraceID = strings.TrimSpace(record.TraceID)
+	record.RubricVersion = strings.TrimSpace(record.RubricVersion)
+	record.JudgeModel = strings.TrimSpace(record.JudgeModel)
+	if record.TraceID == "" || record.RubricVersion == "" {
+		return fmt.Errorf("trace_id and rubric_version are required")
+	}
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = time.Now().UTC()
+	}
+	_, err := s.db.Exec(`
+		INSERT INTO eval_judgements (trace_id, rubric_version, score, explanation, judge_model, judge_trace_id, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(trace_id, rubric_version, judge_model) DO UPDATE SET
+			score=excluded.score,
+			explanation=excluded.explanation,
+			judge_trace_id=excluded.judge_trace_id,
+			created_at=excluded.created_at
+	`, record.TraceID, record.RubricVersion, record.Score, record.Explanation, record.JudgeModel, record.JudgeTraceID, record.CreatedAt.UTC().Format(timeLayout))
+	return err
+}
+
+// GetEvalJudgement 返回该裁判模型缓存的裁判结果，judgeModel 为空时取任意模型最近一次的结果；不存在时返回 sql.ErrNoRows。
+func (s *Store) GetEvalJudgement(traceID string, rubricVersion string, judgeModel string) (EvalJudgementRecord, error) {
+	var (
+		record    EvalJudgementRecord
+		createdAt string
+	)
+	if err := s.db.QueryRow(`
+		SELECT trace_id, rubric_version, score, explanation, judge_model, judge_trace_id, created_at
+		FROM eval_judgements
+		WHERE trace_id = ? AND rubric_version = ? AND (? = '' OR judge_model = ?)
+		ORDER BY created_at DESC
+		LIMIT 1
+	`, strings.TrimSpace(traceID), strings.TrimSpace(rubricVersion), strings.TrimSpace(judgeModel), strings.TrimSpace(judgeModel)).Scan(&record.TraceID, &record.RubricVersion, &record.Score, &record.Explanation, &record.JudgeModel, &record.JudgeTraceID, &createdAt); err != nil {
+		return EvalJudgementRecord{}, err
+	}
+	var err error
+	if record.CreatedAt, err = timeParse(createdAt); err != nil {
+		return EvalJudgementRecord{}, err
+	}
+	return record, nil
+}
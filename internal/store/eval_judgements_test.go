@@ -0,0 +1,62 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestEvalJudgementsAreKeyedByJudgeModel(t *testing.T) {
+	dir := t.TempDir()
+	db, err := sql.Open("sqlite", filepath.Join(dir, "trace_index.sqlite3"))
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	legacySchema := `
+	CREATE TABLE eval_judgements (
+		trace_id TEXT NOT NULL,
+		rubric_version TEXT NOT NULL,
+		score REAL NOT NULL,
+		explanation TEXT NOT NULL DEFAULT '',
+		judge_model TEXT NOT NULL DEFAULT '',
+		judge_trace_id TEXT NOT NULL DEFAULT '',
+		created_at datetime NOT NULL,
+		PRIMARY KEY (trace_id, rubric_version)
+	);
+	INSERT INTO eval_judgements (trace_id, rubric_version, score, judge_model, created_at)
+	VALUES ('trace-1', 'v1', 0.9, 'judge-a', '2026-10-18T10:00:00Z');
+	`
+	if _, err := db.Exec(legacySchema); err != nil {
+		t.Fatalf("db.Exec(legacySchema) error = %v", err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatalf("db.Close() error = %v", err)
+	}
+
+	st, err := New(dir)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer st.Close()
+
+	if err := st.SaveEvalJudgement(EvalJudgementRecord{TraceID: "trace-1", RubricVersion: "v1", Score: 0.2, JudgeModel: "judge-b"}); err != nil {
+		t.Fatalf("SaveEvalJudgement(judge-b) error = %v", err)
+	}
+	a, err := st.GetEvalJudgement("trace-1", "v1", "judge-a")
+	if err != nil || a.Score != 0.9 {
+		t.Fatalf("GetEvalJudgement(judge-a) = %+v, err = %v", a, err)
+	}
+	b, err := st.GetEvalJudgement("trace-1", "v1", "judge-b")
+	if err != nil || b.Score != 0.2 {
+		t.Fatalf("GetEvalJudgement(judge-b) = %+v, err = %v", b, err)
+	}
+	if _, err := st.GetEvalJudgement("trace-1", "v1", "judge-c"); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("GetEvalJudgement(judge-c) err = %v, want sql.ErrNoRows", err)
+	}
+	// 未指定裁判模型时取最近一次的结果。
+	latest, err := st.GetEvalJudgement("trace-1", "v1", "")
+	if err != nil || latest.JudgeModel != "judge-b" {
+		t.Fatalf("GetEvalJudgement(any) = %+v, err = %v", latest, err)
+	}
+}
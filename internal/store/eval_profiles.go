@@ -0,0 +1,108 @@
+package store
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// EvalProfileRecord 是保存在数据库中的自定义评估集，Definition 为 evals.Profile 的 JSON。
+type EvalProfileRecord struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Definition  json.RawMessage `json:"definition"`
+	// Source 记录来源，如 api 或 file:<path>。
+	Source    string    `json:"source"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SaveEvalProfile 按名称新建或覆盖评估集，定义必须是 JSON 对象。
+func (s *Store) SaveEvalProfile(record EvalProfileRecord) (EvalProfileRecord, error) {
+	record.Name = strings.TrimSpace(record.Name)
+	if record.Name == "" {
+		return EvalProfileRecord{}, fmt.Errorf("profile name is required")
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(record.Definition, &object); err != nil {
+		return EvalProfileRecord{}, fmt.Errorf("profile definition must be a JSON object: %w", err)
+	}
+	now := time.Now().UTC().Format(timeLayout)
+	if _, err := s.db.Exec(`
+		INSERT INTO eval_profiles (name, description, definition, source, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(name) DO UPDATE SET
+			description=excluded.description,
+			definition=excluded.definition,
+			source=excluded.source,
+			updated_at=excluded.updated_at
+	`, record.Name, strings.TrimSpace(record.Description), string(record.Definition), strings.TrimSpace(record.Source), now, now); err != nil {
+		return EvalProfileRecord{}, err
+	}
+	return s.GetEvalProfile(record.Name)
+}
+
+// GetEvalProfile 返回指定名称的评估集，不存在时返回 sql.ErrNoRows。
+func (s *Store) GetEvalProfile(name string) (EvalProfileRecord, error) {
+	row := s.db.QueryRow(`
+		SELECT name, description, definition, source, created_at, updated_at
+		FROM eval_profiles
+		WHERE name = ?
+	`, strings.TrimSpace(name))
+	return scanEvalProfile(row)
+}
+
+func (s *Store) ListEvalProfiles() ([]EvalProfileRecord, error) {
+	rows, err := s.db.Query(`
+		SELECT name, description, definition, source, created_at, updated_at
+		FROM eval_profiles
+		ORDER BY name ASC
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []EvalProfileRecord
+	for rows.Next() {
+		record, err := scanEvalProfile(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, record)
+	}
+	return out, rows.Err()
+}
+
+// DeleteEvalProfile 删除指定评估集，不存在时返回 sql.ErrNoRows。
+func (s *Store) DeleteEvalProfile(name string) error {
+	result, err := s.db.Exec(`DELETE FROM eval_profiles WHERE name = ?`, strings.TrimSpace(name))
+	if err != nil {
+		return err
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func scanEvalProfile(row interface{ Scan(...any) error }) (EvalProfileRecord, error) {
+	var (
+		record               EvalProfileRecord
+		definition           string
+		createdAt, updatedAt string
+	)
+	if err := row.Scan(&record.Name, &record.Description, &definition, &record.Source, &createdAt, &updatedAt); err != nil {
+		return EvalProfileRecord{}, err
+	}
+	record.Definition = json.RawMessage(definition)
+	var err error
+	if record.CreatedAt, err = timeParse(createdAt); err != nil {
+		return EvalProfileRecord{}, err
+	}
+	if record.UpdatedAt, err = timeParse(updatedAt); err != nil {
+		return EvalProfileRecord{}, err
+	}
+	return record, nil
+}
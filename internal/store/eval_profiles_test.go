@@ -0,0 +1,45 @@
+package store
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestEvalProfilesUpsertListAndDelete(t *testing.T) {
+	st, err := New(t.TempDir())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer st.Close()
+
+	if _, err := st.SaveEvalProfile(EvalProfileRecord{Name: "bad", Definition: json.RawMessage(`[1]`)}); err == nil {
+		t.Fatalf("SaveEvalProfile(array definition) error = nil, want error")
+	}
+	first, err := st.SaveEvalProfile(EvalProfileRecord{Name: "qa", Description: "v1", Definition: json.RawMessage(`{"assertions":[]}`), Source: "api"})
+	if err != nil {
+		t.Fatalf("SaveEvalProfile() error = %v", err)
+	}
+	second, err := st.SaveEvalProfile(EvalProfileRecord{Name: "qa", Description: "v2", Definition: json.RawMessage(`{"base":"baseline_v1"}`), Source: "file:profiles.yaml"})
+	if err != nil {
+		t.Fatalf("SaveEvalProfile(update) error = %v", err)
+	}
+	if !second.CreatedAt.Equal(first.CreatedAt) || second.Description != "v2" {
+		t.Fatalf("updated profile = %+v, want created_at kept and description v2", second)
+	}
+	got, err := st.GetEvalProfile("qa")
+	if err != nil || string(got.Definition) != `{"base":"baseline_v1"}` || got.Source != "file:profiles.yaml" {
+		t.Fatalf("GetEvalProfile() = %+v, err = %v", got, err)
+	}
+	items, err := st.ListEvalProfiles()
+	if err != nil || len(items) != 1 {
+		t.Fatalf("ListEvalProfiles() = %+v, err = %v", items, err)
+	}
+	if err := st.DeleteEvalProfile("qa"); err != nil {
+		t.Fatalf("DeleteEvalProfile() error = %v", err)
+	}
+	if err := st.DeleteEvalProfile("qa"); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("DeleteEvalProfile(missing) error = %v, want sql.ErrNoRows", err)
+	}
+}
@@ -0,0 +1,487 @@
+package store
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+)
+
+// finding 的处置状态。新写入的 finding 为 open，命中抑制规则的直接记为 false_positive。
+const (
+	FindingStatusOpen          = "open"
+	FindingStatusAcknowledged  = "acknowledged"
+	FindingStatusFalsePositive = "false_positive"
+	FindingStatusResolved      = "resolved"
+)
+
+// FindingStatusActive 是 FindingFilter.Status 的过滤值，匹配仍需关注的 open 与 acknowledged。
+const FindingStatusActive = "active"
+
+// finding 审计记录的动作。
+const (
+	FindingAuditStatusChanged = "status_changed"
+	FindingAuditSuppressed    = "suppressed"
+	FindingAuditUnsuppressed  = "unsuppressed"
+)
+
+const findingColumns = `finding_id, trace_id, category, severity, confidence, title, description,
+	evidence_path, evidence_excerpt, node_id, detector, detector_version, created_at, session_id,
+	status, fingerprint, suppression_id`
+
+// FindingSuppression 是对后续扫描同样生效的抑制规则，非空条件之间为 AND 关系。
+type FindingSuppression struct {
+	ID          string `json:"id"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+	Category    string `json:"category,omitempty"`
+	// EvidencePattern 是匹配 evidence_excerpt 的正则表达式。
+	EvidencePattern string    `json:"evidence_pattern,omitempty"`
+	Reason          string    `json:"reason,omitempty"`
+	CreatedBy       string    `json:"created_by,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+
+	pattern *regexp.Regexp
+}
+
+// FindingAuditRecord 记录一次 finding 状态变更或抑制规则的应用。
+type FindingAuditRecord struct {
+	ID            int64     `json:"id"`
+	TraceID       string    `json:"trace_id"`
+	FindingID     string    `json:"finding_id,omitempty"`
+	SuppressionID string    `json:"suppression_id,omitempty"`
+	Action        string    `json:"action"`
+	FromStatus    string    `json:"from_status,omitempty"`
+	ToStatus      string    `json:"to_status,omitempty"`
+	Actor         string    `json:"actor,omitempty"`
+	Note          string    `json:"note,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type FindingAuditFilter struct {
+	TraceID   string
+	FindingID string
+	Limit     int
+}
+
+// ValidFindingStatus 判断是否为可写入的处置状态。
+func ValidFindingStatus(status string) bool {
+	switch status {
+	case FindingStatusOpen, FindingStatusAcknowledged, FindingStatusFalsePositive, FindingStatusResolved:
+		return true
+	}
+	return false
+}
+
+// FindingFingerprint 由 category、detector 与归一化后的证据摘要计算，不含 trace 信息，
+// 因此同一条误报（如测试用的 API key）在不同 trace 上得到相同指纹。
+func FindingFingerprint(finding observe.Finding) string {
+	evidence := strings.Join(strings.Fields(strings.ToLower(finding.EvidenceExcerpt)), " ")
+	if evidence == "" {
+		evidence = strings.ToLower(strings.TrimSpace(finding.Title))
+	}
+	sum := sha256.Sum256([]byte(finding.Category + "\x00" + finding.Detector + "\x00" + evidence))
+	return hex.EncodeToString(sum[:8])
+}
+
+func findingFilterClause(filter FindingFilter) (string, []any) {
+	var clause strings.Builder
+	var args []any
+	if category := strings.TrimSpace(filter.Category); category != "" {
+		clause.WriteString(` AND category = ?`)
+		args = append(args, category)
+	}
+	if severity := strings.TrimSpace(filter.Severity); severity != "" {
+		clause.WriteString(` AND severity = ?`)
+		args = append(args, severity)
+	}
+	switch status := strings.TrimSpace(filter.Status); status {
+	case "":
+	case FindingStatusActive:
+		clause.WriteString(` AND status IN ('open', 'acknowledged')`)
+	default:
+		clause.WriteString(` AND status = ?`)
+		args = append(args, status)
+	}
+	if fingerprint := strings.TrimSpace(filter.Fingerprint); fingerprint != "" {
+		clause.WriteString(` AND fingerprint = ?`)
+		args = append(args, fingerprint)
+	}
+	return clause.String(), args
+}
+
+type findingState struct {
+	status        string
+	suppressionID string
+}
+
+// findingStates 保存重新分析前已处置的 finding，先按 finding_id 再按指纹匹配，
+// 检测器升级导致 finding_id 变化时处置结果仍能沿用。
+type findingStates struct {
+	byID          map[string]findingState
+	byFingerprint map[string]findingState
+}
+
+func (s findingStates) lookup(finding observe.Finding) (findingState, bool) {
+	if state, ok := s.byID[finding.TraceID+"\x00"+finding.ID]; ok {
+		return state, true
+	}
+	state, ok := s.byFingerprint[finding.TraceID+"\x00"+finding.Fingerprint]
+	return state, ok
+}
+
+func loadFindingStates(tx *sql.Tx, where string, args ...any) (findingStates, error) {
+	states := findingStates{byID: map[string]findingState{}, byFingerprint: map[string]findingState{}}
+	rows, err := tx.Query(`SELECT trace_id, finding_id, fingerprint, status, suppression_id FROM trace_findings WHERE status != 'open' AND `+where, args...)
+	if err != nil {
+		return states, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var traceID, findingID, fingerprint string
+		var state findingState
+		if err := rows.Scan(&traceID, &findingID, &fingerprint, &state.status, &state.suppressionID); err != nil {
+			return states, err
+		}
+		states.byID[traceID+"\x00"+findingID] = state
+		if fingerprint != "" {
+			states.byFingerprint[traceID+"\x00"+fingerprint] = state
+		}
+	}
+	return states, rows.Err()
+}
+
+func matchFindingSuppression(rules []FindingSuppression, finding observe.Finding) (FindingSuppression, bool) {
+	for _, rule := range rules {
+		if rule.matches(finding) {
+			return rule, true
+		}
+	}
+	return FindingSuppression{}, false
+}
+
+func (r FindingSuppression) matches(finding observe.Finding) bool {
+	if r.Fingerprint != "" && r.Fingerprint != finding.Fingerprint {
+		return false
+	}
+	if r.Category != "" && r.Category != finding.Category {
+		return false
+	}
+	if r.pattern != nil && !r.pattern.MatchString(finding.EvidenceExcerpt) {
+		return false
+	}
+	return true
+}
+
+type sqlQueryer interface {
+	Query(query string, args ...any) (*sql.Rows, error)
+}
+
+func loadFindingSuppressions(q sqlQueryer) ([]FindingSuppression, error) {
+	rows, err := q.Query(`
+		SELECT id, fingerprint, category, evidence_pattern, reason, created_by, created_at
+		FROM finding_suppressions
+		ORDER BY created_at ASC, id ASC
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []FindingSuppression
+	for rows.Next() {
+		var rule FindingSuppression
+		var createdAt any
+		if err := rows.Scan(&rule.ID, &rule.Fingerprint, &rule.Category, &rule.EvidencePattern, &rule.Reason, &rule.CreatedBy, &createdAt); err != nil {
+			return nil, err
+		}
+		if rule.CreatedAt, err = timeParseValue(createdAt); err != nil {
+			return nil, err
+		}
+		if rule.EvidencePattern != "" {
+			// 写入前已校验过；即使无法编译也只让这条规则失效，不影响 finding 写入。
+			if rule.pattern, err = regexp.Compile(rule.EvidencePattern); err != nil {
+				continue
+			}
+		}
+		out = append(out, rule)
+	}
+	return out, rows.Err()
+}
+
+// ListFindingSuppressions 按创建时间返回全部抑制规则。
+func (s *Store) ListFindingSuppressions() ([]FindingSuppression, error) {
+	return loadFindingSuppressions(s.db)
+}
+
+// CreateFindingSuppression 保存抑制规则，并把已有的 open finding 中命中的部分标记为 false_positive；
+// 返回保存后的规则与被抑制的 finding 数量。
+func (s *Store) CreateFindingSuppression(rule FindingSuppression) (FindingSuppression, int, error) {
+	rule.Fingerprint = strings.TrimSpace(rule.Fingerprint)
+	rule.Category = strings.TrimSpace(rule.Category)
+	rule.EvidencePattern = strings.TrimSpace(rule.EvidencePattern)
+	rule.Reason = strings.TrimSpace(rule.Reason)
+	rule.CreatedBy = strings.TrimSpace(rule.CreatedBy)
+	if rule.Fingerprint == "" && rule.Category == "" && rule.EvidencePattern == "" {
+		return FindingSuppression{}, 0, fmt.Errorf("suppression needs a fingerprint, category or evidence pattern")
+	}
+	if rule.EvidencePattern != "" {
+		pattern, err := regexp.Compile(rule.EvidencePattern)
+		if err != nil {
+			return FindingSuppression{}, 0, fmt.Errorf("invalid evidence pattern: %w", err)
+		}
+		rule.pattern = pattern
+	}
+	rule.ID = uuid.NewString()
+	rule.CreatedAt = time.Now().UTC()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return FindingSuppression{}, 0, err
+	}
+	defer tx.Rollback()
+	if _, err := tx.Exec(`
+		INSERT INTO finding_suppressions (id, fingerprint, category, evidence_pattern, reason, created_by, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, rule.ID, rule.Fingerprint, rule.Category, rule.EvidencePattern, rule.Reason, rule.CreatedBy, rule.CreatedAt); err != nil {
+		return FindingSuppression{}, 0, err
+	}
+
+	query := `SELECT ` + findingColumns + ` FROM trace_findings WHERE status = 'open'`
+	clause, args := findingFilterClause(FindingFilter{Category: rule.Category, Fingerprint: rule.Fingerprint})
+	rows, err := tx.Query(query+clause, args...)
+	if err != nil {
+		return FindingSuppression{}, 0, err
+	}
+	candidates, err := scanFindings(rows)
+	rows.Close()
+	if err != nil {
+		return FindingSuppression{}, 0, err
+	}
+	matched := 0
+	for _, finding := range candidates {
+		if !rule.matches(finding) {
+			continue
+		}
+		if _, err := tx.Exec(`UPDATE trace_findings SET status = ?, suppression_id = ? WHERE trace_id = ? AND finding_id = ?`,
+			FindingStatusFalsePositive, rule.ID, finding.TraceID, finding.ID); err != nil {
+			return FindingSuppression{}, 0, err
+		}
+		if err := insertFindingAudit(tx, FindingAuditRecord{
+			TraceID:       finding.TraceID,
+			FindingID:     finding.ID,
+			SuppressionID: rule.ID,
+			Action:        FindingAuditSuppressed,
+			FromStatus:    FindingStatusOpen,
+			ToStatus:      FindingStatusFalsePositive,
+			Actor:         rule.CreatedBy,
+			Note:          rule.Reason,
+			CreatedAt:     rule.CreatedAt,
+		}); err != nil {
+			return FindingSuppression{}, 0, err
+		}
+		matched++
+	}
+	if err := tx.Commit(); err != nil {
+		return FindingSuppression{}, 0, err
+	}
+	return rule, matched, nil
+}
+
+// DeleteFindingSuppression 删除抑制规则，并把仍由它标记为 false_positive 的 finding 恢复为 open；
+// 规则不存在时返回 sql.ErrNoRows。
+func (s *Store) DeleteFindingSuppression(id string, actor string) (int, error) {
+	id = strings.TrimSpace(id)
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	result, err := tx.Exec(`DELETE FROM finding_suppressions WHERE id = ?`, id)
+	if err != nil {
+		return 0, err
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return 0, sql.ErrNoRows
+	}
+	rows, err := tx.Query(`SELECT trace_id, finding_id FROM trace_findings WHERE suppression_id = ? AND status = ?`, id, FindingStatusFalsePositive)
+	if err != nil {
+		return 0, err
+	}
+	type findingKey struct{ traceID, findingID string }
+	var restored []findingKey
+	for rows.Next() {
+		var key findingKey
+		if err := rows.Scan(&key.traceID, &key.findingID); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		restored = append(restored, key)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	now := time.Now().UTC()
+	for _, key := range restored {
+		if err := insertFindingAudit(tx, FindingAuditRecord{
+			TraceID:       key.traceID,
+			FindingID:     key.findingID,
+			SuppressionID: id,
+			Action:        FindingAuditUnsuppressed,
+			FromStatus:    FindingStatusFalsePositive,
+			ToStatus:      FindingStatusOpen,
+			Actor:         strings.TrimSpace(actor),
+			CreatedAt:     now,
+		}); err != nil {
+			return 0, err
+		}
+	}
+	if _, err := tx.Exec(`UPDATE trace_findings SET status = 'open', suppression_id = '' WHERE suppression_id = ? AND status = ?`, id, FindingStatusFalsePositive); err != nil {
+		return 0, err
+	}
+	if _, err := tx.Exec(`UPDATE trace_findings SET suppression_id = '' WHERE suppression_id = ?`, id); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(restored), nil
+}
+
+// UpdateFindingStatus 修改单条 finding 的处置状态并写入审计记录；finding 不存在时返回 sql.ErrNoRows。
+// 手动修改会解除与抑制规则的关联，但重新打开的 finding 在下次扫描时仍可能被抑制规则命中。
+func (s *Store) UpdateFindingStatus(traceID string, findingID string, status string, actor string, note string) (observe.Finding, error) {
+	status = strings.TrimSpace(status)
+	if !ValidFindingStatus(status) {
+		return observe.Finding{}, fmt.Errorf("invalid finding status %q", status)
+	}
+	traceID, findingID = strings.TrimSpace(traceID), strings.TrimSpace(findingID)
+	tx, err := s.db.Begin()
+	if err != nil {
+		return observe.Finding{}, err
+	}
+	defer tx.Rollback()
+	var current string
+	if err := tx.QueryRow(`SELECT status FROM trace_findings WHERE trace_id = ? AND finding_id = ?`, traceID, findingID).Scan(&current); err != nil {
+		return observe.Finding{}, err
+	}
+	if current != status {
+		if _, err := tx.Exec(`UPDATE trace_findings SET status = ?, suppression_id = '' WHERE trace_id = ? AND finding_id = ?`, status, traceID, findingID); err != nil {
+			return observe.Finding{}, err
+		}
+		if err := insertFindingAudit(tx, FindingAuditRecord{
+			TraceID:    traceID,
+			FindingID:  findingID,
+			Action:     FindingAuditStatusChanged,
+			FromStatus: current,
+			ToStatus:   status,
+			Actor:      strings.TrimSpace(actor),
+			Note:       strings.TrimSpace(note),
+			CreatedAt:  time.Now().UTC(),
+		}); err != nil {
+			return observe.Finding{}, err
+		}
+	}
+	rows, err := tx.Query(`SELECT `+findingColumns+` FROM trace_findings WHERE trace_id = ? AND finding_id = ?`, traceID, findingID)
+	if err != nil {
+		return observe.Finding{}, err
+	}
+	findings, err := scanFindings(rows)
+	rows.Close()
+	if err != nil {
+		return observe.Finding{}, err
+	}
+	if len(findings) == 0 {
+		return observe.Finding{}, sql.ErrNoRows
+	}
+	if err := tx.Commit(); err != nil {
+		return observe.Finding{}, err
+	}
+	return findings[0], nil
+}
+
+func insertFindingAudit(tx *sql.Tx, record FindingAuditRecord) error {
+	_, err := tx.Exec(`
+		INSERT INTO finding_audit (trace_id, finding_id, suppression_id, action, from_status, to_status, actor, note, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, record.TraceID, record.FindingID, record.SuppressionID, record.Action, record.FromStatus, record.ToStatus,
+		record.Actor, record.Note, record.CreatedAt)
+	return err
+}
+
+// ListFindingAudit 按时间倒序返回 finding 的审计记录。
+func (s *Store) ListFindingAudit(filter FindingAuditFilter) ([]FindingAuditRecord, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+	query := `
+		SELECT id, trace_id, finding_id, suppression_id, action, from_status, to_status, actor, note, created_at
+		FROM finding_audit
+		WHERE 1 = 1
+	`
+	var args []any
+	if traceID := strings.TrimSpace(filter.TraceID); traceID != "" {
+		query += ` AND trace_id = ?`
+		args = append(args, traceID)
+	}
+	if findingID := strings.TrimSpace(filter.FindingID); findingID != "" {
+		query += ` AND finding_id = ?`
+		args = append(args, findingID)
+	}
+	query += ` ORDER BY id DESC LIMIT ?`
+	args = append(args, limit)
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []FindingAuditRecord
+	for rows.Next() {
+		var record FindingAuditRecord
+		var createdAt any
+		if err := rows.Scan(&record.ID, &record.TraceID, &record.FindingID, &record.SuppressionID, &record.Action,
+			&record.FromStatus, &record.ToStatus, &record.Actor, &record.Note, &createdAt); err != nil {
+			return nil, err
+		}
+		if record.CreatedAt, err = timeParseValue(createdAt); err != nil {
+			return nil, err
+		}
+		out = append(out, record)
+	}
+	return out, rows.Err()
+}
+
+// backfillFindingFingerprints 为加入指纹列之前写入的 finding 补算指纹。
+func (s *Store) backfillFindingFingerprints() error {
+	rows, err := s.db.Query(`SELECT id, category, detector, evidence_excerpt, title FROM trace_findings WHERE fingerprint = ''`)
+	if err != nil {
+		return err
+	}
+	fingerprints := map[int64]string{}
+	for rows.Next() {
+		var id int64
+		var finding observe.Finding
+		if err := rows.Scan(&id, &finding.Category, &finding.Detector, &finding.EvidenceExcerpt, &finding.Title); err != nil {
+			rows.Close()
+			return err
+		}
+		fingerprints[id] = FindingFingerprint(finding)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for id, fingerprint := range fingerprints {
+		if _, err := s.db.Exec(`UPDATE trace_findings SET fingerprint = ? WHERE id = ?`, fingerprint, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+)
+
+func testKeyFinding(traceID string, id string, version string) observe.Finding {
+	return observe.Finding{
+		ID:              id,
+		TraceID:         traceID,
+		Category:        "credential_leak",
+		Severity:        observe.SeverityHigh,
+		Title:           "Credential exposure",
+		EvidencePath:    "trace#" + traceID + "#node#node-key",
+		EvidenceExcerpt: "OPENAI_API_KEY=sk-test-0000",
+		Detector:        "credential",
+		DetectorVersion: version,
+	}
+}
+
+func TestFindingStatusSurvivesRescanAndIsAudited(t *testing.T) {
+	st, err := New(t.TempDir())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer st.Close()
+
+	other := testKeyFinding("trace-triage", "finding-other", "0.1.0")
+	other.Category = "tool_result_error"
+	other.EvidenceExcerpt = "exit status 1"
+	if err := st.SaveFindings("trace-triage", []observe.Finding{testKeyFinding("trace-triage", "finding-key", "0.1.0"), other}); err != nil {
+		t.Fatalf("SaveFindings() error = %v", err)
+	}
+	updated, err := st.UpdateFindingStatus("trace-triage", "finding-key", FindingStatusAcknowledged, "alice", "rotating the key")
+	if err != nil {
+		t.Fatalf("UpdateFindingStatus() error = %v", err)
+	}
+	if updated.Status != FindingStatusAcknowledged || updated.Fingerprint == "" {
+		t.Fatalf("updated = %+v", updated)
+	}
+	if _, err := st.UpdateFindingStatus("trace-triage", "missing", FindingStatusResolved, "alice", ""); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("UpdateFindingStatus(missing) error = %v, want sql.ErrNoRows", err)
+	}
+	if _, err := st.UpdateFindingStatus("trace-triage", "finding-key", "closed", "alice", ""); err == nil {
+		t.Fatal("UpdateFindingStatus(invalid) error = nil")
+	}
+
+	// 检测器升级后 finding_id 变化，仍按指纹沿用处置状态。
+	if err := st.SaveFindings("trace-triage", []observe.Finding{testKeyFinding("trace-triage", "finding-key-v2", "0.2.0"), other}); err != nil {
+		t.Fatalf("SaveFindings(rescan) error = %v", err)
+	}
+	active, err := st.ListFindings("trace-triage", FindingFilter{Status: FindingStatusAcknowledged})
+	if err != nil {
+		t.Fatalf("ListFindings() error = %v", err)
+	}
+	if len(active) != 1 || active[0].ID != "finding-key-v2" {
+		t.Fatalf("acknowledged findings after rescan = %+v", active)
+	}
+	open, err := st.ListFindings("trace-triage", FindingFilter{Status: FindingStatusOpen})
+	if err != nil {
+		t.Fatalf("ListFindings(open) error = %v", err)
+	}
+	if len(open) != 1 || open[0].ID != "finding-other" {
+		t.Fatalf("open findings = %+v", open)
+	}
+
+	audit, err := st.ListFindingAudit(FindingAuditFilter{TraceID: "trace-triage"})
+	if err != nil {
+		t.Fatalf("ListFindingAudit() error = %v", err)
+	}
+	if len(audit) != 1 || audit[0].Actor != "alice" || audit[0].FromStatus != FindingStatusOpen ||
+		audit[0].ToStatus != FindingStatusAcknowledged || audit[0].Note != "rotating the key" {
+		t.Fatalf("audit = %+v", audit)
+	}
+}
+
+func TestFindingSuppressionAppliesRetroactivelyAndOnFutureScans(t *testing.T) {
+	st, err := New(t.TempDir())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer st.Close()
+
+	if err := st.SaveFindings("trace-old", []observe.Finding{testKeyFinding("trace-old", "finding-old", "0.1.0")}); err != nil {
+		t.Fatalf("SaveFindings() error = %v", err)
+	}
+	if _, _, err := st.CreateFindingSuppression(FindingSuppression{Reason: "empty"}); err == nil {
+		t.Fatal("CreateFindingSuppression(empty) error = nil")
+	}
+	if _, _, err := st.CreateFindingSuppression(FindingSuppression{EvidencePattern: "("}); err == nil {
+		t.Fatal("CreateFindingSuppression(bad regex) error = nil")
+	}
+	rule, matched, err := st.CreateFindingSuppression(FindingSuppression{
+		Category:        "credential_leak",
+		EvidencePattern: `sk-test-\d+`,
+		Reason:          "test fixture key",
+		CreatedBy:       "bob",
+	})
+	if err != nil {
+		t.Fatalf("CreateFindingSuppression() error = %v", err)
+	}
+	if matched != 1 || rule.ID == "" {
+		t.Fatalf("rule = %+v matched = %d", rule, matched)
+	}
+
+	leak := testKeyFinding("trace-new", "finding-new", "0.1.0")
+	real := testKeyFinding("trace-new", "finding-real", "0.1.0")
+	real.EvidenceExcerpt = "OPENAI_API_KEY=sk-live-9999"
+	if err := st.SaveFindings("trace-new", []observe.Finding{leak, real}); err != nil {
+		t.Fatalf("SaveFindings(new) error = %v", err)
+	}
+	suppressed, err := st.ListAllFindings(FindingFilter{Status: FindingStatusFalsePositive}, 10)
+	if err != nil {
+		t.Fatalf("ListAllFindings() error = %v", err)
+	}
+	if len(suppressed) != 2 || suppressed[0].SuppressionID != rule.ID || suppressed[1].SuppressionID != rule.ID {
+		t.Fatalf("suppressed = %+v", suppressed)
+	}
+	if suppressed[0].Fingerprint != suppressed[1].Fingerprint {
+		t.Fatalf("fingerprints differ across traces: %+v", suppressed)
+	}
+	active, err := st.ListAllFindings(FindingFilter{Status: FindingStatusActive}, 10)
+	if err != nil {
+		t.Fatalf("ListAllFindings(active) error = %v", err)
+	}
+	if len(active) != 1 || active[0].ID != "finding-real" {
+		t.Fatalf("active = %+v", active)
+	}
+	overview, err := st.overviewHighRiskFindings(10)
+	if err != nil {
+		t.Fatalf("overviewHighRiskFindings() error = %v", err)
+	}
+	if len(overview) != 1 || overview[0].ID != "finding-real" {
+		t.Fatalf("overview high risk = %+v", overview)
+	}
+
+	restored, err := st.DeleteFindingSuppression(rule.ID, "bob")
+	if err != nil {
+		t.Fatalf("DeleteFindingSuppression() error = %v", err)
+	}
+	if restored != 2 {
+		t.Fatalf("restored = %d, want 2", restored)
+	}
+	if _, err := st.DeleteFindingSuppression(rule.ID, "bob"); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("DeleteFindingSuppression(again) error = %v, want sql.ErrNoRows", err)
+	}
+	audit, err := st.ListFindingAudit(FindingAuditFilter{TraceID: "trace-old"})
+	if err != nil {
+		t.Fatalf("ListFindingAudit() error = %v", err)
+	}
+	if len(audit) != 2 || audit[0].Action != FindingAuditUnsuppressed || audit[1].Action != FindingAuditSuppressed || audit[1].Actor != "bob" {
+		t.Fatalf("audit = %+v", audit)
+	}
+}
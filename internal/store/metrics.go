@@ -0,0 +1,36 @@
+package store
+
+// QueueDepth 是某个后台任务队列在某个状态下的任务数。
+type QueueDepth struct {
+	Queue  string
+	Status string
+	Count  int
+}
+
+// JobQueueDepths 按状态统计 parse_jobs 与 analysis_jobs 中的任务数，供指标抓取使用。
+func (s *Store) JobQueueDepths() ([]QueueDepth, error) {
+	var out []QueueDepth
+	for _, queue := range []struct {
+		name  string
+		table string
+	}{{"parse", "parse_jobs"}, {"analysis", "analysis_jobs"}} {
+		rows, err := s.db.Query(`SELECT status, COUNT(*) FROM ` + queue.table + ` GROUP BY status ORDER BY status ASC`)
+		if err != nil {
+			return nil, err
+		}
+		items, err := scanCountItems(rows)
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			out = append(out, QueueDepth{Queue: queue.name, Status: item.Label, Count: item.Count})
+		}
+	}
+	return out, nil
+}
+
+// SystemEventSeverityCounts 按严重级别统计全部系统事件。
+func (s *Store) SystemEventSeverityCounts() ([]CountItem, error) {
+	return s.systemEventCountBy("severity", `1 = 1`, nil, 20)
+}
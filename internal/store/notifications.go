@@ -0,0 +1,254 @@
+package store
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	NotificationStatusPending   = "pending"
+	NotificationStatusDelivered = "delivered"
+	NotificationStatusFailed    = "failed"
+)
+
+// NotificationDelivery 是 outbox 中的一条通知投递记录，EventJSON 保存入队时的系统事件快照。
+type NotificationDelivery struct {
+	ID              int64           `json:"id"`
+	Sink            string          `json:"sink"`
+	EventID         string          `json:"event_id"`
+	Fingerprint     string          `json:"fingerprint"`
+	OccurrenceCount int             `json:"occurrence_count"`
+	EventJSON       json.RawMessage `json:"event"`
+	Status          string          `json:"status"`
+	Attempts        int             `json:"attempts"`
+	LastError       string          `json:"last_error,omitempty"`
+	ResponseStatus  int             `json:"response_status,omitempty"`
+	NextAttemptAt   time.Time       `json:"next_attempt_at"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+	DeliveredAt     time.Time       `json:"delivered_at,omitempty"`
+}
+
+type NotificationDeliveryFilter struct {
+	Sink   string
+	Status string
+	Limit  int
+}
+
+const notificationColumns = `id, sink, event_id, fingerprint, occurrence_count, event_json, status, attempts, last_error,
+	response_status, next_attempt_at, created_at, updated_at, delivered_at`
+
+// EnqueueNotification 写入一条待投递通知；NextAttemptAt 为零值时立即可被投递。
+func (s *Store) EnqueueNotification(delivery NotificationDelivery) (NotificationDelivery, error) {
+	delivery.Sink = strings.TrimSpace(delivery.Sink)
+	if delivery.Sink == "" || strings.TrimSpace(delivery.Fingerprint) == "" {
+		return NotificationDelivery{}, fmt.Errorf("enqueue notification: sink and fingerprint are required")
+	}
+	now := time.Now().UTC()
+	if len(delivery.EventJSON) == 0 {
+		delivery.EventJSON = json.RawMessage("{}")
+	}
+	if delivery.NextAttemptAt.IsZero() {
+		delivery.NextAttemptAt = now
+	}
+	result, err := s.db.Exec(`
+		INSERT INTO notification_outbox (
+			sink, event_id, fingerprint, occurrence_count, event_json, status, attempts, last_error,
+			response_status, next_attempt_at, created_at, updated_at, delivered_at
+		)
+		VALUES (?, ?, ?, ?, ?, ?, 0, '', 0, ?, ?, ?, NULL)
+	`, delivery.Sink, delivery.EventID, delivery.Fingerprint, delivery.OccurrenceCount, string(delivery.EventJSON),
+		NotificationStatusPending, delivery.NextAttemptAt.UTC(), now, now)
+	if err != nil {
+		return NotificationDelivery{}, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return NotificationDelivery{}, err
+	}
+	return s.GetNotificationDelivery(id)
+}
+
+func (s *Store) GetNotificationDelivery(id int64) (NotificationDelivery, error) {
+	rows, err := s.db.Query(`SELECT `+notificationColumns+` FROM notification_outbox WHERE id = ?`, id)
+	if err != nil {
+		return NotificationDelivery{}, err
+	}
+	defer rows.Close()
+	items, err := scanNotificationDeliveries(rows)
+	if err != nil {
+		return NotificationDelivery{}, err
+	}
+	if len(items) == 0 {
+		return NotificationDelivery{}, sql.ErrNoRows
+	}
+	return items[0], nil
+}
+
+// LastNotification 返回某个 sink 针对同一 fingerprint 最近入队的通知，不存在时返回 sql.ErrNoRows。
+func (s *Store) LastNotification(sink string, fingerprint string) (NotificationDelivery, error) {
+	rows, err := s.db.Query(`
+		SELECT `+notificationColumns+`
+		FROM notification_outbox
+		WHERE sink = ? AND fingerprint = ?
+		ORDER BY id DESC
+		LIMIT 1
+	`, strings.TrimSpace(sink), strings.TrimSpace(fingerprint))
+	if err != nil {
+		return NotificationDelivery{}, err
+	}
+	defer rows.Close()
+	items, err := scanNotificationDeliveries(rows)
+	if err != nil {
+		return NotificationDelivery{}, err
+	}
+	if len(items) == 0 {
+		return NotificationDelivery{}, sql.ErrNoRows
+	}
+	return items[0], nil
+}
+
+// LatestNotificationAt 返回 outbox 中最近一次入队的时间，outbox 为空时返回零值。
+func (s *Store) LatestNotificationAt() (time.Time, error) {
+	var latest any
+	if err := s.db.QueryRow(`SELECT MAX(created_at) FROM notification_outbox`).Scan(&latest); err != nil {
+		return time.Time{}, err
+	}
+	return timeParseNullableValue(latest)
+}
+
+// DueNotifications 返回到达重试时间的待投递通知，按入队顺序排列。
+func (s *Store) DueNotifications(now time.Time, limit int) ([]NotificationDelivery, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	rows, err := s.db.Query(`
+		SELECT `+notificationColumns+`
+		FROM notification_outbox
+		WHERE status = ?
+		ORDER BY id ASC
+	`, NotificationStatusPending)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	pending, err := scanNotificationDeliveries(rows)
+	if err != nil {
+		return nil, err
+	}
+	var out []NotificationDelivery
+	for _, item := range pending {
+		if item.NextAttemptAt.After(now) {
+			continue
+		}
+		out = append(out, item)
+		if len(out) >= limit {
+			break
+		}
+	}
+	return out, nil
+}
+
+func (s *Store) MarkNotificationDelivered(id int64, responseStatus int) error {
+	now := time.Now().UTC()
+	_, err := s.db.Exec(`
+		UPDATE notification_outbox
+		SET status = ?, attempts = attempts + 1, last_error = '', response_status = ?, updated_at = ?, delivered_at = ?
+		WHERE id = ?
+	`, NotificationStatusDelivered, responseStatus, now, now, id)
+	return err
+}
+
+// MarkNotificationAttemptFailed 记录一次失败投递；nextAttemptAt 为零值时不再重试，状态置为 failed。
+func (s *Store) MarkNotificationAttemptFailed(id int64, lastError string, responseStatus int, nextAttemptAt time.Time) error {
+	status := NotificationStatusPending
+	if nextAttemptAt.IsZero() {
+		status = NotificationStatusFailed
+		nextAttemptAt = time.Now()
+	}
+	_, err := s.db.Exec(`
+		UPDATE notification_outbox
+		SET status = ?, attempts = attempts + 1, last_error = ?, response_status = ?, next_attempt_at = ?, updated_at = ?
+		WHERE id = ?
+	`, status, textPreview(lastError, 2000), responseStatus, nextAttemptAt.UTC(), time.Now().UTC(), id)
+	return err
+}
+
+// RetryNotification 把失败的通知重新放回待投递队列，尝试次数清零。
+func (s *Store) RetryNotification(id int64) (NotificationDelivery, error) {
+	now := time.Now().UTC()
+	result, err := s.db.Exec(`
+		UPDATE notification_outbox
+		SET status = ?, attempts = 0, next_attempt_at = ?, updated_at = ?
+		WHERE id = ? AND status = ?
+	`, NotificationStatusPending, now, now, id, NotificationStatusFailed)
+	if err != nil {
+		return NotificationDelivery{}, err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		if _, err := s.GetNotificationDelivery(id); err != nil {
+			return NotificationDelivery{}, err
+		}
+		return NotificationDelivery{}, fmt.Errorf("notification %d is not failed", id)
+	}
+	return s.GetNotificationDelivery(id)
+}
+
+// ListNotificationDeliveries 按入队时间倒序返回投递记录。
+func (s *Store) ListNotificationDeliveries(filter NotificationDeliveryFilter) ([]NotificationDelivery, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = 50
+	}
+	query := `SELECT ` + notificationColumns + ` FROM notification_outbox WHERE 1 = 1`
+	var args []any
+	if sink := strings.TrimSpace(filter.Sink); sink != "" {
+		query += ` AND sink = ?`
+		args = append(args, sink)
+	}
+	if status := strings.TrimSpace(filter.Status); status != "" {
+		query += ` AND status = ?`
+		args = append(args, status)
+	}
+	query += ` ORDER BY id DESC LIMIT ?`
+	args = append(args, filter.Limit)
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanNotificationDeliveries(rows)
+}
+
+func scanNotificationDeliveries(rows *sql.Rows) ([]NotificationDelivery, error) {
+	var out []NotificationDelivery
+	for rows.Next() {
+		var (
+			item                                           NotificationDelivery
+			eventJSON                                      string
+			nextAttemptAt, createdAt, updatedAt, delivered any
+		)
+		if err := rows.Scan(&item.ID, &item.Sink, &item.EventID, &item.Fingerprint, &item.OccurrenceCount, &eventJSON,
+			&item.Status, &item.Attempts, &item.LastError, &item.ResponseStatus, &nextAttemptAt, &createdAt, &updatedAt, &delivered); err != nil {
+			return nil, err
+		}
+		item.EventJSON = json.RawMessage(eventJSON)
+		var err error
+		if item.NextAttemptAt, err = timeParseValue(nextAttemptAt); err != nil {
+			return nil, err
+		}
+		if item.CreatedAt, err = timeParseValue(createdAt); err != nil {
+			return nil, err
+		}
+		if item.UpdatedAt, err = timeParseValue(updatedAt); err != nil {
+			return nil, err
+		}
+		if item.DeliveredAt, err = timeParseNullableValue(delivered); err != nil {
+			return nil, err
+		}
+		out = append(out, item)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,465 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// QueryCondition 是 trace 查询语言中的一个条件，由 ParseTraceQuery 生成。
+// Field 为空表示裸词，按全文检索匹配。
+type QueryCondition struct {
+	Field  string `json:"field,omitempty"`
+	Op     string `json:"op,omitempty"`
+	Value  string `json:"value"`
+	Negate bool   `json:"negate,omitempty"`
+}
+
+type queryFieldKind int
+
+const (
+	queryFieldString queryFieldKind = iota
+	queryFieldNumber
+	queryFieldDuration
+	queryFieldTime
+	queryFieldBool
+	queryFieldStatus
+	queryFieldFinding
+	queryFieldSeverity
+	queryFieldDetector
+	queryFieldParse
+	queryFieldTool
+	queryFieldText
+	queryFieldTag
+	queryFieldLabel
+)
+
+type queryField struct {
+	kind   queryFieldKind
+	column string
+}
+
+// queryFields 列出查询语言支持的字段及其别名。
+var queryFields = map[string]queryField{
+	"model":             {kind: queryFieldString, column: "model"},
+	"provider":          {kind: queryFieldString, column: "provider"},
+	"endpoint":          {kind: queryFieldString, column: "endpoint"},
+	"operation":         {kind: queryFieldString, column: "operation"},
+	"method":            {kind: queryFieldString, column: "method"},
+	"url":               {kind: queryFieldString, column: "url"},
+	"upstream":          {kind: queryFieldString, column: "selected_upstream_id"},
+	"channel":           {kind: queryFieldString, column: "selected_upstream_id"},
+	"session":           {kind: queryFieldString, column: "session_id"},
+	"trace":             {kind: queryFieldString, column: "trace_id"},
+	"request":           {kind: queryFieldString, column: "request_id"},
+	"error":             {kind: queryFieldString, column: "error_text"},
+	"status":            {kind: queryFieldStatus, column: "status_code"},
+	"tokens":            {kind: queryFieldNumber, column: "total_tokens"},
+	"input_tokens":      {kind: queryFieldNumber, column: "prompt_tokens"},
+	"prompt_tokens":     {kind: queryFieldNumber, column: "prompt_tokens"},
+	"output_tokens":     {kind: queryFieldNumber, column: "completion_tokens"},
+	"completion_tokens": {kind: queryFieldNumber, column: "completion_tokens"},
+	"cached_tokens":     {kind: queryFieldNumber, column: "cached_tokens"},
+	"ttft":              {kind: queryFieldDuration, column: "ttft_ms"},
+	"duration":          {kind: queryFieldDuration, column: "duration_ms"},
+	"latency":           {kind: queryFieldDuration, column: "duration_ms"},
+	"since":             {kind: queryFieldTime, column: "recorded_at"},
+	"until":             {kind: queryFieldTime, column: "recorded_at"},
+	"stream":            {kind: queryFieldBool, column: "is_stream"},
+	"finding":           {kind: queryFieldFinding},
+	"severity":          {kind: queryFieldSeverity},
+	"detector":          {kind: queryFieldDetector},
+	"parse":             {kind: queryFieldParse},
+	"tool":              {kind: queryFieldTool},
+	"text":              {kind: queryFieldText},
+	"tag":               {kind: queryFieldTag},
+	"label":             {kind: queryFieldLabel},
+}
+
+var queryOperators = []string{">=", "<=", "!=", ">", "<", "=", ":"}
+
+var severityRanks = map[string]int{"info": 0, "low": 1, "medium": 2, "high": 3, "critical": 4}
+
+// QueryFieldNames 返回查询语言支持的字段名，用于帮助信息。
+func QueryFieldNames() []string {
+	names := make([]string, 0, len(queryFields))
+	for name := range queryFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseTraceQuery 解析 trace 查询语言，例如
+//
+//	model:gpt-4* status>=500 ttft>2s tokens>10000 finding:credential_leak session:abc since:24h
+//
+// 条件之间为 AND；前缀 - 取反；字符串值中的 * 为通配；不带字段的词按全文检索匹配。
+func ParseTraceQuery(expr string) ([]QueryCondition, error) {
+	var conditions []QueryCondition
+	for _, token := range splitSearchQuery(expr) {
+		condition, err := parseQueryToken(token)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+	if _, _, err := compileQueryConditions(conditions, func(name string) string { return name }, time.Now()); err != nil {
+		return nil, err
+	}
+	return conditions, nil
+}
+
+func parseQueryToken(token string) (QueryCondition, error) {
+	var condition QueryCondition
+	if strings.HasPrefix(token, "-") && len(token) > 1 {
+		condition.Negate = true
+		token = token[1:]
+	}
+	end := 0
+	for end < len(token) && (token[end] == '_' || (token[end] >= 'a' && token[end] <= 'z') || (token[end] >= 'A' && token[end] <= 'Z')) {
+		end++
+	}
+	for _, op := range queryOperators {
+		if end == 0 || !strings.HasPrefix(token[end:], op) {
+			continue
+		}
+		field := strings.ToLower(token[:end])
+		if _, ok := queryFields[field]; !ok {
+			return QueryCondition{}, fmt.Errorf("unknown query field %q", field)
+		}
+		condition.Field = field
+		condition.Op = op
+		condition.Value = strings.Trim(token[end+len(op):], `"`)
+		if condition.Value == "" {
+			return QueryCondition{}, fmt.Errorf("query field %q requires a value", field)
+		}
+		return condition, nil
+	}
+	condition.Value = token
+	return condition, nil
+}
+
+// compileQueryConditions 把条件编译为 SQL 片段；column 负责给 logs 表字段加上别名。
+func compileQueryConditions(conditions []QueryCondition, column func(string) string, now time.Time) (string, []any, error) {
+	var (
+		clauses []string
+		args    []any
+	)
+	for _, condition := range conditions {
+		clause, clauseArgs, err := compileQueryCondition(condition, column, now)
+		if err != nil {
+			return "", nil, err
+		}
+		if clause == "" {
+			continue
+		}
+		if condition.Negate {
+			clause = "NOT (" + clause + ")"
+		}
+		clauses = append(clauses, clause)
+		args = append(args, clauseArgs...)
+	}
+	return strings.Join(clauses, " AND "), args, nil
+}
+
+func compileQueryCondition(condition QueryCondition, column func(string) string, now time.Time) (string, []any, error) {
+	if condition.Field == "" {
+		clause, args := searchFilterClause(column("trace_id"), condition.Value)
+		return clause, args, nil
+	}
+	field := queryFields[condition.Field]
+	op, value := condition.Op, condition.Value
+	invalidOp := func() (string, []any, error) {
+		return "", nil, fmt.Errorf("operator %q is not supported for %s", op, condition.Field)
+	}
+	switch field.kind {
+	case queryFieldString:
+		switch op {
+		case ":", "=":
+			clause, args := globClause(column(field.column), value)
+			return clause, args, nil
+		case "!=":
+			clause, args := globClause(column(field.column), value)
+			return "NOT (" + clause + ")", args, nil
+		}
+		return invalidOp()
+	case queryFieldNumber:
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return "", nil, fmt.Errorf("%s expects a number, got %q", condition.Field, value)
+		}
+		return comparisonClause(column(field.column), op, number)
+	case queryFieldDuration:
+		ms, err := parseQueryDurationMs(value)
+		if err != nil {
+			return "", nil, fmt.Errorf("%s expects a duration such as 2s or 500ms, got %q", condition.Field, value)
+		}
+		return comparisonClause(column(field.column), op, ms)
+	case queryFieldStatus:
+		return statusClause(column, op, value)
+	case queryFieldTime:
+		if op != ":" && op != "=" {
+			return invalidOp()
+		}
+		at, err := parseQueryTime(value, now)
+		if err != nil {
+			return "", nil, fmt.Errorf("%s expects a duration such as 24h or 7d, or a date, got %q", condition.Field, value)
+		}
+		cmp := ">="
+		if condition.Field == "until" {
+			cmp = "<"
+		}
+		return column(field.column) + " " + cmp + " ?", []any{at.UTC().Format(timeLayout)}, nil
+	case queryFieldBool:
+		if op != ":" && op != "=" {
+			return invalidOp()
+		}
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", nil, fmt.Errorf("%s expects true or false, got %q", condition.Field, value)
+		}
+		return column(field.column) + " = ?", []any{enabled}, nil
+	case queryFieldFinding, queryFieldDetector:
+		if op != ":" && op != "=" {
+			return invalidOp()
+		}
+		findingColumn := "f.category"
+		if field.kind == queryFieldDetector {
+			findingColumn = "f.detector"
+		}
+		clause, args := globClause(findingColumn, value)
+		return `EXISTS (SELECT 1 FROM trace_findings f WHERE f.trace_id = ` + column("trace_id") + ` AND ` + clause + `)`, args, nil
+	case queryFieldSeverity:
+		return severityClause(column, op, value)
+	case queryFieldParse:
+		if op != ":" && op != "=" {
+			return invalidOp()
+		}
+		return `EXISTS (SELECT 1 FROM trace_observations o WHERE o.trace_id = ` + column("trace_id") + ` AND LOWER(o.status) = LOWER(?))`, []any{value}, nil
+	case queryFieldTool:
+		if op != ":" && op != "=" {
+			return invalidOp()
+		}
+		clause, args := searchFilterClause(column("trace_id"), "tool:"+ftsPhrase(value))
+		return clause, args, nil
+	case queryFieldTag:
+		if op != ":" && op != "=" {
+			return invalidOp()
+		}
+		clause, args := tagFilterClause(column("trace_id"), column("session_id"), value)
+		return clause, args, nil
+	case queryFieldLabel:
+		if op != ":" && op != "=" {
+			return invalidOp()
+		}
+		return `EXISTS (SELECT 1 FROM scores sc WHERE sc.trace_id = ` + column("trace_id") + ` AND sc.evaluator_key = ? AND LOWER(sc.label) = LOWER(?))`, []any{HumanEvaluatorKey, value}, nil
+	case queryFieldText:
+		if op != ":" && op != "=" {
+			return invalidOp()
+		}
+		clause, args := searchFilterClause(column("trace_id"), ftsPhrase(value))
+		return clause, args, nil
+	}
+	return invalidOp()
+}
+
+func globClause(column string, value string) (string, []any) {
+	if !strings.ContainsAny(value, "*?") {
+		return `LOWER(` + column + `) = LOWER(?)`, []any{value}
+	}
+	pattern := strings.NewReplacer("*", "%", "?", "_").Replace(escapeLike(value))
+	return `LOWER(` + column + `) LIKE LOWER(?) ESCAPE '\'`, []any{pattern}
+}
+
+func comparisonClause(column string, op string, value int64) (string, []any, error) {
+	switch op {
+	case ":", "=":
+		op = "="
+	case "!=", ">", ">=", "<", "<=":
+	default:
+		return "", nil, fmt.Errorf("unsupported operator %q", op)
+	}
+	return column + " " + op + " ?", []any{value}, nil
+}
+
+func statusClause(column func(string) string, op string, value string) (string, []any, error) {
+	statusCode, errorText := column("status_code"), column("error_text")
+	if op == ":" || op == "=" {
+		switch strings.ToLower(value) {
+		case "success":
+			return `(` + statusCode + ` >= 200 AND ` + statusCode + ` < 300 AND ` + errorText + ` = '')`, nil, nil
+		case "error":
+			return `(` + statusCode + ` < 200 OR ` + statusCode + ` >= 300 OR ` + errorText + ` != '')`, nil, nil
+		}
+		if len(value) == 3 && strings.HasSuffix(strings.ToLower(value), "xx") && value[0] >= '1' && value[0] <= '5' {
+			base := int64(value[0]-'0') * 100
+			return `(` + statusCode + ` >= ? AND ` + statusCode + ` < ?)`, []any{base, base + 100}, nil
+		}
+	}
+	code, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return "", nil, fmt.Errorf("status expects a code, 5xx, success or error, got %q", value)
+	}
+	return comparisonClause(statusCode, op, code)
+}
+
+func severityClause(column func(string) string, op string, value string) (string, []any, error) {
+	rank, ok := severityRanks[strings.ToLower(value)]
+	if !ok {
+		return "", nil, fmt.Errorf("severity expects info, low, medium, high or critical, got %q", value)
+	}
+	var severities []any
+	for name, candidate := range severityRanks {
+		var match bool
+		switch op {
+		case ":", "=":
+			match = candidate == rank
+		case "!=":
+			match = candidate != rank
+		case ">":
+			match = candidate > rank
+		case ">=":
+			match = candidate >= rank
+		case "<":
+			match = candidate < rank
+		case "<=":
+			match = candidate <= rank
+		}
+		if match {
+			severities = append(severities, name)
+		}
+	}
+	if len(severities) == 0 {
+		return "0 = 1", nil, nil
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(severities)), ",")
+	return `EXISTS (SELECT 1 FROM trace_findings f WHERE f.trace_id = ` + column("trace_id") + ` AND LOWER(f.severity) IN (` + placeholders + `))`, severities, nil
+}
+
+// parseQueryDurationMs 支持 Go duration（2s、1m30s）或不带单位的毫秒数。
+func parseQueryDurationMs(value string) (int64, error) {
+	if ms, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return ms, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	return d.Milliseconds(), nil
+}
+
+// parseQueryTime 支持相对时长（24h、7d）、RFC3339 时间和 2006-01-02 日期。
+func parseQueryTime(value string, now time.Time) (time.Time, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil {
+			return now.Add(-time.Duration(n) * 24 * time.Hour), nil
+		}
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+	if at, err := time.Parse(time.RFC3339, value); err == nil {
+		return at, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+// SavedQuery 是按名称保存的查询语言表达式。
+type SavedQuery struct {
+	Name        string    `json:"name"`
+	Query       string    `json:"query"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// SaveQuery 校验表达式后按名称保存，同名查询会被覆盖。
+func (s *Store) SaveQuery(name string, query string, description string) (SavedQuery, error) {
+	name = strings.TrimSpace(name)
+	query = strings.TrimSpace(query)
+	if name == "" {
+		return SavedQuery{}, fmt.Errorf("query name is required")
+	}
+	if query == "" {
+		return SavedQuery{}, fmt.Errorf("query expression is required")
+	}
+	if _, err := ParseTraceQuery(query); err != nil {
+		return SavedQuery{}, err
+	}
+	now := time.Now().UTC().Format(timeLayout)
+	if _, err := s.db.Exec(`
+		INSERT INTO saved_queries (name, query, description, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(name) DO UPDATE SET
+			query=excluded.query,
+			description=excluded.description,
+			updated_at=excluded.updated_at
+	`, name, query, strings.TrimSpace(description), now, now); err != nil {
+		return SavedQuery{}, err
+	}
+	return s.GetSavedQuery(name)
+}
+
+func (s *Store) GetSavedQuery(name string) (SavedQuery, error) {
+	row := s.db.QueryRow(`
+		SELECT name, query, description, created_at, updated_at
+		FROM saved_queries
+		WHERE name = ?
+	`, strings.TrimSpace(name))
+	return scanSavedQuery(row)
+}
+
+func (s *Store) ListSavedQueries() ([]SavedQuery, error) {
+	rows, err := s.db.Query(`
+		SELECT name, query, description, created_at, updated_at
+		FROM saved_queries
+		ORDER BY name ASC
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []SavedQuery
+	for rows.Next() {
+		query, err := scanSavedQuery(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, query)
+	}
+	return out, rows.Err()
+}
+
+// DeleteSavedQuery 删除指定名称的查询，不存在时返回 sql.ErrNoRows。
+func (s *Store) DeleteSavedQuery(name string) error {
+	result, err := s.db.Exec(`DELETE FROM saved_queries WHERE name = ?`, strings.TrimSpace(name))
+	if err != nil {
+		return err
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func scanSavedQuery(row interface{ Scan(...any) error }) (SavedQuery, error) {
+	var (
+		query                SavedQuery
+		createdAt, updatedAt string
+	)
+	if err := row.Scan(&query.Name, &query.Query, &query.Description, &createdAt, &updatedAt); err != nil {
+		return SavedQuery{}, err
+	}
+	var err error
+	if query.CreatedAt, err = timeParse(createdAt); err != nil {
+		return SavedQuery{}, err
+	}
+	if query.UpdatedAt, err = timeParse(updatedAt); err != nil {
+		return SavedQuery{}, err
+	}
+	return query, nil
+}
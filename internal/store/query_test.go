@@ -0,0 +1,127 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+)
+
+func TestParseTraceQueryFiltersLogsFindingsAndObservations(t *testing.T) {
+	dir := t.TempDir()
+	st, err := New(dir)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer st.Close()
+
+	now := time.Now().UTC()
+	writeModelLog(t, st, dir, "gpt4.http", "gpt-4o", "/v1/chat/completions", "POST", "openai-primary", 502, 12000, now.Add(-time.Hour))
+	writeModelLog(t, st, dir, "gpt5.http", "gpt-5.1", "/v1/responses", "POST", "openai-backup", 200, 300, now.Add(-2*time.Hour))
+	writeModelLog(t, st, dir, "old.http", "gpt-4.1", "/v1/chat/completions", "POST", "openai-primary", 200, 50, now.Add(-72*time.Hour))
+	gpt4ID := mustTraceID(t, st, filepath.Join(dir, "gpt4.http"))
+	gpt5ID := mustTraceID(t, st, filepath.Join(dir, "gpt5.http"))
+	oldID := mustTraceID(t, st, filepath.Join(dir, "old.http"))
+
+	if err := st.SaveFindings(gpt4ID, []observe.Finding{{ID: "f1", Category: "credential_leak", Severity: observe.SeverityHigh, Title: "leak", Detector: "credential"}}); err != nil {
+		t.Fatalf("SaveFindings() error = %v", err)
+	}
+	if err := st.SaveObservation(observe.TraceObservation{
+		TraceID: gpt5ID,
+		Parser:  "openai",
+		Status:  observe.ParseStatusParsed,
+		Tools:   observe.ObservationTools{Calls: []observe.ToolCallObservation{{ID: "c1", Name: "delete_branch", ArgsText: `{"branch":"main"}`}}},
+	}); err != nil {
+		t.Fatalf("SaveObservation() error = %v", err)
+	}
+
+	for _, tc := range []struct {
+		query string
+		want  []string
+	}{
+		{query: "model:gpt-4*", want: []string{gpt4ID, oldID}},
+		{query: "model:gpt-4* since:24h", want: []string{gpt4ID}},
+		{query: "status>=500 tokens>10000", want: []string{gpt4ID}},
+		{query: "status:5xx", want: []string{gpt4ID}},
+		{query: "status:success", want: []string{gpt5ID, oldID}},
+		{query: "-model:gpt-4*", want: []string{gpt5ID}},
+		{query: "upstream:openai-primary tokens<100", want: []string{oldID}},
+		{query: "ttft>=20ms duration<1s", want: []string{gpt4ID, gpt5ID, oldID}},
+		{query: "ttft>2s", want: nil},
+		{query: "finding:credential_*", want: []string{gpt4ID}},
+		{query: "severity>=medium", want: []string{gpt4ID}},
+		{query: "detector:credential -status:error", want: nil},
+		{query: "parse:parsed", want: []string{gpt5ID}},
+		{query: "tool:delete_branch", want: []string{gpt5ID}},
+		{query: "main", want: []string{gpt5ID}},
+		{query: `until:"` + now.Add(-24*time.Hour).Format(time.RFC3339) + `"`, want: []string{oldID}},
+	} {
+		conditions, err := ParseTraceQuery(tc.query)
+		if err != nil {
+			t.Fatalf("ParseTraceQuery(%q) error = %v", tc.query, err)
+		}
+		result, err := st.ListPage(1, 10, ListFilter{Conditions: conditions})
+		if err != nil {
+			t.Fatalf("ListPage(%q) error = %v", tc.query, err)
+		}
+		var got []string
+		for _, item := range result.Items {
+			got = append(got, item.ID)
+		}
+		if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+			t.Fatalf("ListPage(%q) = %v, want %v", tc.query, got, tc.want)
+		}
+		ids, err := st.ListTraceIDs(ListFilter{Conditions: conditions}, 10)
+		if err != nil || strings.Join(ids, ",") != strings.Join(tc.want, ",") {
+			t.Fatalf("ListTraceIDs(%q) = %v, err = %v, want %v", tc.query, ids, err, tc.want)
+		}
+	}
+
+	for _, query := range []string{"colour:red", "tokens>many", "ttft>soon", "model>gpt", "severity:urgent", "since:yesterday", "model:"} {
+		if _, err := ParseTraceQuery(query); err == nil {
+			t.Fatalf("ParseTraceQuery(%q) error = nil, want error", query)
+		}
+	}
+}
+
+func TestSavedQueriesRoundTrip(t *testing.T) {
+	st, err := New(t.TempDir())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer st.Close()
+
+	if _, err := st.SaveQuery("bad", "colour:red", ""); err == nil {
+		t.Fatalf("SaveQuery(invalid) error = nil, want error")
+	}
+	if _, err := st.SaveQuery("slow", "ttft>2s", "first version"); err != nil {
+		t.Fatalf("SaveQuery() error = %v", err)
+	}
+	saved, err := st.SaveQuery("slow", "ttft>5s since:7d", "slow first token")
+	if err != nil {
+		t.Fatalf("SaveQuery(update) error = %v", err)
+	}
+	if saved.Query != "ttft>5s since:7d" || saved.Description != "slow first token" {
+		t.Fatalf("SaveQuery(update) = %+v", saved)
+	}
+	if _, err := st.SaveQuery("errors", "status:error", ""); err != nil {
+		t.Fatalf("SaveQuery(errors) error = %v", err)
+	}
+	queries, err := st.ListSavedQueries()
+	if err != nil || len(queries) != 2 || queries[0].Name != "errors" || queries[1].Name != "slow" {
+		t.Fatalf("ListSavedQueries() = %+v, err = %v", queries, err)
+	}
+	if err := st.DeleteSavedQuery("slow"); err != nil {
+		t.Fatalf("DeleteSavedQuery() error = %v", err)
+	}
+	if _, err := st.GetSavedQuery("slow"); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("GetSavedQuery(deleted) error = %v, want sql.ErrNoRows", err)
+	}
+	if err := st.DeleteSavedQuery("slow"); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("DeleteSavedQuery(missing) error = %v, want sql.ErrNoRows", err)
+	}
+}
@@ -0,0 +1,74 @@
+package store
+
+import (
+	"strings"
+	"time"
+)
+
+// TraceRerun 记录 playground 重放产生的 trace 与原始 trace 的关联，来源是 cassette 中的 meta.rerun_of。
+type TraceRerun struct {
+	TraceID         string    `json:"trace_id"`
+	OriginalTraceID string    `json:"original_trace_id"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+func (s *Store) upsertTraceRerun(traceID string, originalTraceID string, createdAt time.Time) error {
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	_, err := s.db.Exec(`
+		INSERT INTO trace_reruns (trace_id, original_trace_id, created_at)
+		VALUES (?, ?, ?)
+		ON CONFLICT(trace_id) DO UPDATE SET
+			original_trace_id=excluded.original_trace_id
+	`, traceID, strings.TrimSpace(originalTraceID), createdAt.UTC().Format(timeLayout))
+	return err
+}
+
+// ListReruns 返回由指定 trace 重放得到的 trace，最新的在前。
+func (s *Store) ListReruns(originalTraceID string) ([]TraceRerun, error) {
+	rows, err := s.db.Query(`
+		SELECT trace_id, original_trace_id, created_at
+		FROM trace_reruns
+		WHERE original_trace_id = ?
+		ORDER BY created_at DESC, trace_id DESC
+	`, strings.TrimSpace(originalTraceID))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []TraceRerun
+	for rows.Next() {
+		rerun, err := scanTraceRerun(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rerun)
+	}
+	return out, rows.Err()
+}
+
+// GetRerunOf 返回 trace 的重放来源，非重放 trace 返回 sql.ErrNoRows。
+func (s *Store) GetRerunOf(traceID string) (TraceRerun, error) {
+	row := s.db.QueryRow(`
+		SELECT trace_id, original_trace_id, created_at
+		FROM trace_reruns
+		WHERE trace_id = ?
+	`, strings.TrimSpace(traceID))
+	return scanTraceRerun(row)
+}
+
+func scanTraceRerun(row interface{ Scan(...any) error }) (TraceRerun, error) {
+	var (
+		rerun     TraceRerun
+		createdAt string
+	)
+	if err := row.Scan(&rerun.TraceID, &rerun.OriginalTraceID, &createdAt); err != nil {
+		return TraceRerun{}, err
+	}
+	var err error
+	if rerun.CreatedAt, err = timeParse(createdAt); err != nil {
+		return TraceRerun{}, err
+	}
+	return rerun, nil
+}
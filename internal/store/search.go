@@ -0,0 +1,313 @@
+package store
+
+import (
+	"database/sql"
+	"html"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+)
+
+// 全文索引的 kind 取值，对应查询语法中的字段前缀。
+const (
+	SearchKindInstruction = "instruction"
+	SearchKindMessage     = "message"
+	SearchKindOutput      = "output"
+	SearchKindReasoning   = "reasoning"
+	SearchKindToolCall    = "tool_call"
+	SearchKindToolResult  = "tool_result"
+)
+
+const (
+	searchSnippetOpen  = "<mark>"
+	searchSnippetClose = "</mark>"
+	// snippet() 先用控制字符标出命中，转义正文后再替换为 <mark>，避免正文中的 HTML 原样输出。
+	searchSnippetRawOpen  = "\x02"
+	searchSnippetRawClose = "\x03"
+)
+
+// searchFieldKinds 把查询中的字段前缀映射到 kind；tool: 与 role: 单独处理。
+var searchFieldKinds = map[string]string{
+	"instruction": SearchKindInstruction,
+	"system":      SearchKindInstruction,
+	"message":     SearchKindMessage,
+	"input":       SearchKindMessage,
+	"output":      SearchKindOutput,
+	"reasoning":   SearchKindReasoning,
+	"args":        SearchKindToolCall,
+	"result":      SearchKindToolResult,
+}
+
+// SearchHit 是 trace 在全文检索中得分最高的一处命中，Snippet 已做 HTML 转义，命中词以 <mark></mark> 包裹。
+type SearchHit struct {
+	TraceID string `json:"trace_id"`
+	NodeID  string `json:"node_id,omitempty"`
+	Kind    string `json:"kind"`
+	Role    string `json:"role,omitempty"`
+	Tool    string `json:"tool,omitempty"`
+	Snippet string `json:"snippet"`
+}
+
+type searchDocument struct {
+	nodeID  string
+	kind    string
+	role    string
+	tool    string
+	content string
+}
+
+// searchDocuments 从 Observation 中提取可检索的文本：指令、消息、输出、推理、工具调用参数与工具结果。
+func searchDocuments(obs observe.TraceObservation) []searchDocument {
+	var docs []searchDocument
+	add := func(kind string, role string, nodes []observe.SemanticNode) {
+		for _, node := range nodes {
+			content := searchNodeText(node)
+			if strings.TrimSpace(content) == "" {
+				continue
+			}
+			docs = append(docs, searchDocument{nodeID: node.ID, kind: kind, role: firstNonEmpty(node.Role, role), content: content})
+		}
+	}
+	add(SearchKindInstruction, "system", obs.Request.Instructions)
+	add(SearchKindMessage, "", obs.Request.Messages)
+	add(SearchKindMessage, "", obs.Request.Inputs)
+	outputs := len(docs)
+	add(SearchKindOutput, "assistant", obs.Response.Outputs)
+	add(SearchKindOutput, "assistant", obs.Response.Candidates)
+	if len(docs) == outputs && strings.TrimSpace(obs.Stream.AccumulatedText) != "" {
+		docs = append(docs, searchDocument{kind: SearchKindOutput, role: "assistant", content: obs.Stream.AccumulatedText})
+	}
+	reasoning := len(docs)
+	add(SearchKindReasoning, "assistant", obs.Response.Reasoning)
+	if len(docs) == reasoning && strings.TrimSpace(obs.Stream.AccumulatedReasoning) != "" {
+		docs = append(docs, searchDocument{kind: SearchKindReasoning, role: "assistant", content: obs.Stream.AccumulatedReasoning})
+	}
+	for _, call := range obs.Tools.Calls {
+		args := call.ArgsText
+		if args == "" && len(call.ArgsJSON) > 0 {
+			args = string(call.ArgsJSON)
+		}
+		docs = append(docs, searchDocument{nodeID: call.NodeID, kind: SearchKindToolCall, role: "assistant", tool: call.Name, content: args})
+	}
+	for _, result := range obs.Tools.Results {
+		content := result.Text
+		if content == "" && len(result.JSON) > 0 {
+			content = string(result.JSON)
+		}
+		docs = append(docs, searchDocument{nodeID: result.NodeID, kind: SearchKindToolResult, role: "tool", tool: result.Name, content: content})
+	}
+	return docs
+}
+
+// searchNodeText 优先使用节点自身文本，否则拼接子节点文本；工具调用与结果单独建档，这里跳过。
+func searchNodeText(node observe.SemanticNode) string {
+	if node.Text != "" {
+		return node.Text
+	}
+	var parts []string
+	for _, child := range node.Children {
+		switch child.NormalizedType {
+		case observe.NodeToolCall, observe.NodeToolCallDelta, observe.NodeToolResult, observe.NodeToolDeclaration:
+			continue
+		}
+		if text := searchNodeText(child); text != "" {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+func replaceSearchDocuments(tx *sql.Tx, traceID string, docs []searchDocument) error {
+	if _, err := tx.Exec(`DELETE FROM trace_search WHERE trace_id = ?`, traceID); err != nil {
+		return err
+	}
+	for _, doc := range docs {
+		if _, err := tx.Exec(`
+			INSERT INTO trace_search (trace_id, node_id, kind, role, tool, content)
+			VALUES (?, ?, ?, ?, ?, ?)
+		`, traceID, doc.nodeID, doc.kind, strings.ToLower(doc.role), doc.tool, doc.content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// searchMatchExpressions 把用户查询编译为 FTS5 MATCH 表达式，每个表达式针对单个节点，trace 需命中全部表达式。
+//
+// 语法：空格分隔的词或 "短语"，末尾 * 表示前缀匹配；tool:<名称> 匹配工具名；
+// output:/message:/instruction:/reasoning:/args:/result: 把词限定在对应类型的节点；
+// role:<角色> 不单独成项，而是约束同一查询中的其他词只在该角色的节点中匹配。
+func searchMatchExpressions(query string) []string {
+	var (
+		roles []string
+		terms [][2]string
+	)
+	for _, token := range splitSearchQuery(query) {
+		field, value := "", token
+		if idx := strings.Index(token, ":"); idx > 0 && !strings.HasPrefix(token, `"`) {
+			candidate := strings.ToLower(token[:idx])
+			if _, ok := searchFieldKinds[candidate]; ok || candidate == "tool" || candidate == "role" {
+				field, value = candidate, token[idx+1:]
+			}
+		}
+		if strings.Trim(value, `"*`) == "" {
+			continue
+		}
+		if field == "role" {
+			roles = append(roles, ftsPhrase(strings.ToLower(value)))
+			continue
+		}
+		terms = append(terms, [2]string{field, value})
+	}
+
+	roleClause := ""
+	if len(roles) > 0 {
+		roleClause = "role : (" + strings.Join(roles, " OR ") + ")"
+	}
+	if len(terms) == 0 {
+		if roleClause == "" {
+			return nil
+		}
+		return []string{roleClause}
+	}
+	exprs := make([]string, 0, len(terms))
+	for _, term := range terms {
+		field, phrase := term[0], ftsPhrase(term[1])
+		var expr string
+		switch field {
+		case "":
+			expr = "{tool content} : " + phrase
+		case "tool":
+			expr = "tool : " + phrase
+		default:
+			expr = "kind : " + ftsPhrase(searchFieldKinds[field]) + " AND content : " + phrase
+		}
+		if roleClause != "" {
+			expr = roleClause + " AND " + expr
+		}
+		exprs = append(exprs, "("+expr+")")
+	}
+	return exprs
+}
+
+// splitSearchQuery 按空白切分查询，双引号内的空白保留；引号可以出现在字段前缀之后，如 output:"rate limit"。
+func splitSearchQuery(query string) []string {
+	var (
+		tokens  []string
+		current strings.Builder
+		quoted  bool
+	)
+	for _, r := range query {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			current.WriteRune(r)
+		case unicode.IsSpace(r) && !quoted:
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+	return tokens
+}
+
+// ftsPhrase 把用户输入转成 FTS5 字符串短语，避免用户输入中的运算符被解释为语法。
+func ftsPhrase(value string) string {
+	prefix := strings.HasSuffix(value, "*")
+	value = strings.Trim(value, `"*`)
+	phrase := `"` + strings.ReplaceAll(value, `"`, `""`) + `"`
+	if prefix {
+		phrase += "*"
+	}
+	return phrase
+}
+
+func searchFilterClause(column string, query string) (string, []any) {
+	exprs := searchMatchExpressions(query)
+	if len(exprs) == 0 {
+		return "", nil
+	}
+	clauses := make([]string, 0, len(exprs))
+	args := make([]any, 0, len(exprs))
+	for _, expr := range exprs {
+		clauses = append(clauses, column+` IN (SELECT trace_id FROM trace_search WHERE trace_search MATCH ?)`)
+		args = append(args, expr)
+	}
+	return strings.Join(clauses, " AND "), args
+}
+
+// SearchSnippets 返回每个 trace 在 query 下相关度最高的命中片段，用于列表结果高亮。
+func (s *Store) SearchSnippets(traceIDs []string, query string) (map[string]SearchHit, error) {
+	exprs := searchMatchExpressions(query)
+	out := make(map[string]SearchHit, len(traceIDs))
+	if len(exprs) == 0 || len(traceIDs) == 0 {
+		return out, nil
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(traceIDs)), ",")
+	args := []any{searchSnippetRawOpen, searchSnippetRawClose, strings.Join(exprs, " OR ")}
+	for _, id := range traceIDs {
+		args = append(args, id)
+	}
+	rows, err := s.db.Query(`
+		SELECT trace_id, node_id, kind, role, tool, snippet(trace_search, 5, ?, ?, '…', 16)
+		FROM trace_search
+		WHERE trace_search MATCH ? AND trace_id IN (`+placeholders+`)
+		ORDER BY rank
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var hit SearchHit
+		if err := rows.Scan(&hit.TraceID, &hit.NodeID, &hit.Kind, &hit.Role, &hit.Tool, &hit.Snippet); err != nil {
+			return nil, err
+		}
+		if _, ok := out[hit.TraceID]; ok {
+			continue
+		}
+		if hit.Kind == SearchKindToolCall && !strings.Contains(hit.Snippet, searchSnippetRawOpen) {
+			// 命中的是工具名而非参数：片段展示为 name(args) 便于识别。
+			hit.Snippet = searchSnippetRawOpen + hit.Tool + searchSnippetRawClose + "(" + hit.Snippet + ")"
+		}
+		hit.Snippet = highlightSnippet(hit.Snippet)
+		out[hit.TraceID] = hit
+	}
+	return out, rows.Err()
+}
+
+// highlightSnippet 转义片段正文，并把 snippet() 的命中标记换成 <mark></mark>。
+func highlightSnippet(raw string) string {
+	return strings.NewReplacer(searchSnippetRawOpen, searchSnippetOpen, searchSnippetRawClose, searchSnippetClose).
+		Replace(html.EscapeString(raw))
+}
+
+// backfillSearchIndex 在全文索引为空但已有 Observation 时（从没有索引的版本升级），
+// 为全部已解析 trace 排队重新解析，由解析 worker 补建索引。
+func (s *Store) backfillSearchIndex() error {
+	var indexed, observed bool
+	if err := s.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM trace_search), EXISTS(SELECT 1 FROM trace_observations)`).Scan(&indexed, &observed); err != nil {
+		return err
+	}
+	if indexed || !observed {
+		return nil
+	}
+	now := time.Now().UTC()
+	_, err := s.db.Exec(`
+		INSERT INTO parse_jobs (trace_id, status, attempts, created_at, updated_at)
+		SELECT o.trace_id, 'queued', 0, ?, ?
+		FROM trace_observations o
+		WHERE NOT EXISTS (
+			SELECT 1 FROM parse_jobs j WHERE j.trace_id = o.trace_id AND j.status IN ('queued', 'running')
+		)
+	`, now, now)
+	return err
+}
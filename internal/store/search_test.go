@@ -0,0 +1,154 @@
+package store
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+)
+
+func TestFullTextSearchScopesFieldsAndHighlights(t *testing.T) {
+	dir := t.TempDir()
+	st, err := New(dir)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer st.Close()
+
+	now := time.Now().UTC()
+	writeModelLog(t, st, dir, "branch.http", "gpt-5.1", "/v1/responses", "POST", "", 200, 10, now.Add(-time.Minute))
+	writeModelLog(t, st, dir, "customer.http", "gpt-5.1", "/v1/chat/completions", "POST", "", 200, 10, now)
+	branchID := mustTraceID(t, st, filepath.Join(dir, "branch.http"))
+	customerID := mustTraceID(t, st, filepath.Join(dir, "customer.http"))
+
+	if err := st.SaveObservation(observe.TraceObservation{
+		TraceID: branchID,
+		Parser:  "openai",
+		Status:  observe.ParseStatusParsed,
+		Request: observe.ObservationRequest{
+			Instructions: []observe.SemanticNode{{ID: "i1", NormalizedType: observe.NodeInstruction, Text: "You are a git assistant."}},
+		},
+		Tools: observe.ObservationTools{
+			Calls: []observe.ToolCallObservation{{ID: "call_1", Name: "delete_branch", ArgsText: `{"branch":"release/2026"}`, NodeID: "c1"}},
+		},
+	}); err != nil {
+		t.Fatalf("SaveObservation(branch) error = %v", err)
+	}
+	if err := st.SaveObservation(observe.TraceObservation{
+		TraceID: customerID,
+		Parser:  "openai",
+		Status:  observe.ParseStatusParsed,
+		Request: observe.ObservationRequest{
+			Messages: []observe.SemanticNode{{
+				ID:             "m1",
+				NormalizedType: observe.NodeMessage,
+				Role:           "user",
+				Children:       []observe.SemanticNode{{ID: "m1.t", NormalizedType: observe.NodeText, Text: "Summarize the ticket from customer Acme Corp"}},
+			}},
+		},
+		Response: observe.ObservationResponse{
+			Outputs: []observe.SemanticNode{{ID: "o1", NormalizedType: observe.NodeText, Text: "Acme reported a billing issue."}},
+		},
+	}); err != nil {
+		t.Fatalf("SaveObservation(customer) error = %v", err)
+	}
+
+	for _, tc := range []struct {
+		search string
+		want   []string
+	}{
+		{search: "tool:delete_branch", want: []string{branchID}},
+		{search: "release", want: []string{branchID}},
+		{search: "acme", want: []string{customerID}},
+		{search: `role:user "customer acme"`, want: []string{customerID}},
+		{search: "role:assistant customer", want: nil},
+		{search: "output:billing", want: []string{customerID}},
+		{search: "output:ticket", want: nil},
+		{search: "instruction:git tool:delete_branch", want: []string{branchID}},
+		{search: "bill*", want: []string{customerID}},
+		{search: `AND "unbalanced`, want: nil},
+	} {
+		result, err := st.ListPage(1, 10, ListFilter{Search: tc.search})
+		if err != nil {
+			t.Fatalf("ListPage(%q) error = %v", tc.search, err)
+		}
+		var got []string
+		for _, item := range result.Items {
+			got = append(got, item.ID)
+		}
+		if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+			t.Fatalf("ListPage(%q) = %v, want %v", tc.search, got, tc.want)
+		}
+		ids, err := st.ListTraceIDs(ListFilter{Search: tc.search}, 10)
+		if err != nil || strings.Join(ids, ",") != strings.Join(tc.want, ",") {
+			t.Fatalf("ListTraceIDs(%q) = %v, err = %v, want %v", tc.search, ids, err, tc.want)
+		}
+	}
+
+	snippets, err := st.SearchSnippets([]string{branchID, customerID}, "tool:delete_branch acme")
+	if err != nil {
+		t.Fatalf("SearchSnippets() error = %v", err)
+	}
+	if hit := snippets[branchID]; hit.Kind != SearchKindToolCall || hit.Tool != "delete_branch" || !strings.Contains(hit.Snippet, "<mark>delete_branch</mark>") {
+		t.Fatalf("branch snippet = %+v", hit)
+	}
+	if hit := snippets[customerID]; !strings.Contains(hit.Snippet, "<mark>Acme</mark>") {
+		t.Fatalf("customer snippet = %+v", hit)
+	}
+
+	if _, err := st.DeleteTraces([]string{branchID}); err != nil {
+		t.Fatalf("DeleteTraces() error = %v", err)
+	}
+	var remaining int
+	if err := st.db.QueryRow(`SELECT COUNT(*) FROM trace_search WHERE trace_id = ?`, branchID).Scan(&remaining); err != nil || remaining != 0 {
+		t.Fatalf("trace_search rows after delete = %d, err = %v", remaining, err)
+	}
+}
+
+func TestSearchSnippetsEscapeHTMLAndIndexIsBackfilled(t *testing.T) {
+	dir := t.TempDir()
+	st, err := New(dir)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer st.Close()
+
+	writeModelLog(t, st, dir, "html.http", "gpt-5.1", "/v1/chat/completions", "POST", "", 200, 10, time.Now().UTC())
+	traceID := mustTraceID(t, st, filepath.Join(dir, "html.http"))
+	if err := st.SaveObservation(observe.TraceObservation{
+		TraceID: traceID,
+		Parser:  "openai",
+		Status:  observe.ParseStatusParsed,
+		Response: observe.ObservationResponse{
+			Outputs: []observe.SemanticNode{{ID: "o1", NormalizedType: observe.NodeText, Text: `<img src=x onerror=alert(1)> Acme & co`}},
+		},
+	}); err != nil {
+		t.Fatalf("SaveObservation() error = %v", err)
+	}
+	snippets, err := st.SearchSnippets([]string{traceID}, "acme")
+	if err != nil {
+		t.Fatalf("SearchSnippets() error = %v", err)
+	}
+	if got := snippets[traceID].Snippet; got != `&lt;img src=x onerror=alert(1)&gt; <mark>Acme</mark> &amp; co` {
+		t.Fatalf("snippet = %q", got)
+	}
+
+	// 模拟从没有全文索引的版本升级：索引为空时为已解析 trace 排队重新解析，且不重复排队。
+	if _, err := st.db.Exec(`DELETE FROM trace_search`); err != nil {
+		t.Fatalf("clear trace_search error = %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := st.backfillSearchIndex(); err != nil {
+			t.Fatalf("backfillSearchIndex() error = %v", err)
+		}
+	}
+	jobs, err := st.ListParseJobs("queued", 10)
+	if err != nil {
+		t.Fatalf("ListParseJobs() error = %v", err)
+	}
+	if len(jobs) != 1 || jobs[0].TraceID != traceID {
+		t.Fatalf("queued parse jobs = %+v", jobs)
+	}
+}
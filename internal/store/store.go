@@ -129,6 +129,9 @@ type ListPageResult struct {
 
 type ListFilter struct {
 	Query            string
+	Search           string           // 全文检索 prompt、输出与工具调用，语法见 searchMatchExpressions
+	Conditions       []QueryCondition // 查询语言条件，由 ParseTraceQuery 生成
+	Tags             []string         // 需同时带有的标签，trace 所属 session 上的标签同样生效
 	Provider         string
 	Model            string
 	Endpoint         string
@@ -358,6 +361,16 @@ type OverviewAttention struct {
 	SlowTraces       []LogEntry
 }
 
+// OverviewPIIExposure 汇总窗口内 trace 上仍需关注（open / acknowledged）的 PII finding，类别为 pii_<实体>；
+// Findings 与分组计数按会话内去重后的暴露次数统计，历史消息在后续轮次中重复出现不会重复计数。
+type OverviewPIIExposure struct {
+	Findings int
+	Traces   int
+	Entities []CountItem
+	Sessions []CountItem
+	Models   []CountItem
+}
+
 type OverviewAnalysisSummary struct {
 	Total  int
 	Failed int
@@ -381,6 +394,7 @@ type OverviewDashboard struct {
 	Attention   OverviewAttention
 	Analysis    OverviewAnalysisSummary
 	Observation OverviewObservationSummary
+	PII         OverviewPIIExposure
 }
 
 const (
@@ -563,17 +577,21 @@ type DatasetRecord struct {
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	ExampleCount int
+	Version      int // 最新快照版本，未做过快照时为 0
 }
 
 type DatasetExampleRecord struct {
-	DatasetID  string
-	TraceID    string
-	Position   int
-	AddedAt    time.Time
-	SourceType string
-	SourceID   string
-	Note       string
-	Trace      LogEntry
+	DatasetID      string
+	TraceID        string
+	Position       int
+	AddedAt        time.Time
+	SourceType     string
+	SourceID       string
+	Note           string
+	ExpectedOutput string
+	Tags           []string
+	InputOverride  json.RawMessage
+	Trace          LogEntry
 }
 
 type EvalRunRecord struct {
@@ -619,8 +637,17 @@ type ExperimentRunRecord struct {
 	MatchedScoreCount   int
 	ImprovementCount    int
 	RegressionCount     int
+	Status              string // running / completed / failed；running 时尚无 eval run
+	Error               string
 }
 
+// 实验运行状态。
+const (
+	ExperimentRunning   = "running"
+	ExperimentCompleted = "completed"
+	ExperimentFailed    = "failed"
+)
+
 type ObservationSummary struct {
 	TraceID       string
 	Parser        string
@@ -648,13 +675,17 @@ type ParseJobRecord struct {
 type FindingFilter struct {
 	Category string
 	Severity string
+	// Status 取 open、acknowledged、false_positive、resolved 之一，或 active 表示 open 与 acknowledged。
+	Status      string
+	Fingerprint string
 }
 
 type ScoreFilter struct {
-	TraceID   string
-	SessionID string
-	DatasetID string
-	EvalRunID string
+	TraceID      string
+	SessionID    string
+	DatasetID    string
+	EvalRunID    string
+	EvaluatorKey string
 }
 
 func (s *Store) ListUpstreamTargets() ([]UpstreamTargetRecord, error) {
@@ -1557,7 +1588,7 @@ func (s *Store) GetUpstreamDetail(upstreamID string, since time.Time, modelFilte
 			req_header_len, req_body_len, res_header_len, res_body_len, is_stream,
 			session_id, session_source, window_id, client_request_id,
 			selected_upstream_id, selected_upstream_base_url, selected_upstream_provider_preset,
-			routing_policy, routing_score, routing_candidate_count, routing_failure_reason
+			routing_policy, routing_score, routing_candidate_count, routing_failure_reason, token_name, client_canceled
 		FROM logs
 		WHERE selected_upstream_id = ?`+whereSQL+`
 		ORDER BY recorded_at DESC, trace_id DESC
@@ -2632,7 +2663,9 @@ func (s *Store) initSchema() error {
 			routing_policy TEXT NOT NULL DEFAULT '',
 			routing_score REAL NOT NULL DEFAULT 0,
 			routing_candidate_count INTEGER NOT NULL DEFAULT 0,
-			routing_failure_reason TEXT NOT NULL DEFAULT ''
+			routing_failure_reason TEXT NOT NULL DEFAULT '',
+			token_name TEXT NOT NULL DEFAULT '',
+			client_canceled bool NOT NULL DEFAULT false
 		);`,
 		`CREATE TABLE IF NOT EXISTS upstream_targets (
 			id TEXT PRIMARY KEY,
@@ -2756,6 +2789,28 @@ func (s *Store) initSchema() error {
 		);`,
 		`CREATE UNIQUE INDEX IF NOT EXISTS datasetexample_dataset_id_trace_id ON dataset_examples(dataset_id, trace_id);`,
 		`CREATE INDEX IF NOT EXISTS idx_dataset_examples_dataset_position ON dataset_examples(dataset_id, position ASC);`,
+		`CREATE TABLE IF NOT EXISTS dataset_example_overrides (
+			id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+			dataset_id TEXT NOT NULL,
+			trace_id TEXT NOT NULL,
+			expected_output TEXT NOT NULL DEFAULT '',
+			tags_json TEXT NOT NULL DEFAULT '[]',
+			input_override_json TEXT NOT NULL DEFAULT '',
+			updated_by TEXT NOT NULL DEFAULT '',
+			updated_at datetime NOT NULL
+		);`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS datasetexampleoverride_dataset_id_trace_id ON dataset_example_overrides(dataset_id, trace_id);`,
+		`CREATE TABLE IF NOT EXISTS dataset_snapshots (
+			id TEXT PRIMARY KEY,
+			dataset_id TEXT NOT NULL,
+			version INTEGER NOT NULL DEFAULT 0,
+			note TEXT NOT NULL DEFAULT '',
+			created_by TEXT NOT NULL DEFAULT '',
+			example_count INTEGER NOT NULL DEFAULT 0,
+			examples_json TEXT NOT NULL DEFAULT '[]',
+			created_at datetime NOT NULL
+		);`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS datasetsnapshot_dataset_id_version ON dataset_snapshots(dataset_id, version);`,
 		`CREATE TABLE IF NOT EXISTS eval_runs (
 			id TEXT PRIMARY KEY,
 			dataset_id TEXT NOT NULL DEFAULT '',
@@ -2786,6 +2841,28 @@ func (s *Store) initSchema() error {
 		`CREATE INDEX IF NOT EXISTS idx_scores_session_id ON scores(session_id, created_at DESC) WHERE session_id <> '';`,
 		`CREATE INDEX IF NOT EXISTS idx_scores_dataset_id ON scores(dataset_id, created_at DESC) WHERE dataset_id <> '';`,
 		`CREATE INDEX IF NOT EXISTS idx_scores_eval_run_id ON scores(eval_run_id, created_at DESC) WHERE eval_run_id <> '';`,
+		`CREATE TABLE IF NOT EXISTS trace_tags (
+			id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+			target_type TEXT NOT NULL,
+			target_id TEXT NOT NULL,
+			tag TEXT NOT NULL,
+			created_by TEXT NOT NULL DEFAULT '',
+			created_at datetime NOT NULL
+		);`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS tracetag_target_type_target_id_tag ON trace_tags(target_type, target_id, tag);`,
+		`CREATE INDEX IF NOT EXISTS tracetag_tag ON trace_tags(tag);`,
+		`CREATE TABLE IF NOT EXISTS annotations (
+			id TEXT PRIMARY KEY,
+			target_type TEXT NOT NULL,
+			target_id TEXT NOT NULL,
+			parent_id TEXT NOT NULL DEFAULT '',
+			author TEXT NOT NULL DEFAULT '',
+			body TEXT NOT NULL,
+			created_at datetime NOT NULL,
+			updated_at datetime NOT NULL
+		);`,
+		`CREATE INDEX IF NOT EXISTS annotation_target_type_target_id_created_at ON annotations(target_type, target_id, created_at);`,
+		`CREATE INDEX IF NOT EXISTS annotation_parent_id ON annotations(parent_id);`,
 		`CREATE TABLE IF NOT EXISTS experiment_runs (
 			id TEXT PRIMARY KEY,
 			name TEXT NOT NULL DEFAULT '',
@@ -2800,7 +2877,9 @@ func (s *Store) initSchema() error {
 			pass_rate_delta REAL NOT NULL DEFAULT 0,
 			matched_score_count INTEGER NOT NULL DEFAULT 0,
 			improvement_count INTEGER NOT NULL DEFAULT 0,
-			regression_count INTEGER NOT NULL DEFAULT 0
+			regression_count INTEGER NOT NULL DEFAULT 0,
+			status TEXT NOT NULL DEFAULT 'completed',
+			error TEXT NOT NULL DEFAULT ''
 		);`,
 		`CREATE INDEX IF NOT EXISTS idx_experiment_runs_created_at ON experiment_runs(created_at DESC, id DESC);`,
 		`CREATE TABLE IF NOT EXISTS trace_observations (
@@ -2836,6 +2915,87 @@ func (s *Store) initSchema() error {
 		);`,
 		`CREATE UNIQUE INDEX IF NOT EXISTS semantic_nodes_trace_node_key ON semantic_nodes(trace_id, node_id);`,
 		`CREATE INDEX IF NOT EXISTS idx_semantic_nodes_trace_depth ON semantic_nodes(trace_id, depth, node_index);`,
+		`CREATE TABLE IF NOT EXISTS saved_queries (
+			name TEXT PRIMARY KEY,
+			query TEXT NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			created_at datetime NOT NULL,
+			updated_at datetime NOT NULL
+		);`,
+		`CREATE TABLE IF NOT EXISTS eval_profiles (
+			name TEXT PRIMARY KEY,
+			description TEXT NOT NULL DEFAULT '',
+			definition TEXT NOT NULL,
+			source TEXT NOT NULL DEFAULT '',
+			created_at datetime NOT NULL,
+			updated_at datetime NOT NULL
+		);`,
+		`CREATE TABLE IF NOT EXISTS eval_judgements (
+			trace_id TEXT NOT NULL,
+			rubric_version TEXT NOT NULL,
+			score REAL NOT NULL,
+			explanation TEXT NOT NULL DEFAULT '',
+			judge_model TEXT NOT NULL DEFAULT '',
+			judge_trace_id TEXT NOT NULL DEFAULT '',
+			created_at datetime NOT NULL,
+			PRIMARY KEY (trace_id, rubric_version, judge_model)
+		);`,
+		`CREATE TABLE IF NOT EXISTS notification_outbox (
+			id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+			sink TEXT NOT NULL,
+			event_id TEXT NOT NULL,
+			fingerprint TEXT NOT NULL,
+			occurrence_count INTEGER NOT NULL DEFAULT 1,
+			event_json TEXT NOT NULL,
+			status TEXT NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			last_error TEXT NOT NULL DEFAULT '',
+			response_status INTEGER NOT NULL DEFAULT 0,
+			next_attempt_at datetime NOT NULL,
+			created_at datetime NOT NULL,
+			updated_at datetime NOT NULL,
+			delivered_at datetime NULL
+		);`,
+		`CREATE INDEX IF NOT EXISTS idx_notification_outbox_status ON notification_outbox(status, id ASC);`,
+		`CREATE INDEX IF NOT EXISTS idx_notification_outbox_sink_fingerprint ON notification_outbox(sink, fingerprint, id DESC);`,
+		`CREATE TABLE IF NOT EXISTS alert_rules (
+			id TEXT PRIMARY KEY,
+			name TEXT NOT NULL DEFAULT '',
+			description TEXT NOT NULL DEFAULT '',
+			metric TEXT NOT NULL,
+			operator TEXT NOT NULL,
+			threshold REAL NOT NULL,
+			window_seconds INTEGER NOT NULL,
+			model TEXT NOT NULL DEFAULT '',
+			channel TEXT NOT NULL DEFAULT '',
+			token_name TEXT NOT NULL DEFAULT '',
+			min_requests INTEGER NOT NULL DEFAULT 1,
+			severity TEXT NOT NULL DEFAULT 'warning',
+			enabled bool NOT NULL DEFAULT true,
+			source TEXT NOT NULL DEFAULT '',
+			state TEXT NOT NULL DEFAULT 'ok',
+			last_value REAL NOT NULL DEFAULT 0,
+			last_samples INTEGER NOT NULL DEFAULT 0,
+			last_evaluated_at datetime NULL,
+			state_changed_at datetime NULL,
+			event_id TEXT NOT NULL DEFAULT '',
+			created_at datetime NOT NULL,
+			updated_at datetime NOT NULL
+		);`,
+		`CREATE TABLE IF NOT EXISTS trace_reruns (
+			trace_id TEXT PRIMARY KEY,
+			original_trace_id TEXT NOT NULL,
+			created_at datetime NOT NULL
+		);`,
+		`CREATE INDEX IF NOT EXISTS idx_trace_reruns_original ON trace_reruns(original_trace_id, created_at);`,
+		`CREATE VIRTUAL TABLE IF NOT EXISTS trace_search USING fts5(
+			trace_id UNINDEXED,
+			node_id UNINDEXED,
+			kind,
+			role,
+			tool,
+			content
+		);`,
 		`CREATE TABLE IF NOT EXISTS trace_findings (
 			id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
 			trace_id TEXT NOT NULL,
@@ -2850,10 +3010,33 @@ func (s *Store) initSchema() error {
 			node_id TEXT NOT NULL DEFAULT '',
 			detector TEXT NOT NULL,
 			detector_version TEXT NOT NULL,
-			created_at datetime NOT NULL
+			created_at datetime NOT NULL,
+			session_id TEXT NOT NULL DEFAULT ''
 		);`,
 		`CREATE UNIQUE INDEX IF NOT EXISTS trace_findings_trace_finding_key ON trace_findings(trace_id, finding_id);`,
 		`CREATE INDEX IF NOT EXISTS idx_trace_findings_trace_severity ON trace_findings(trace_id, severity, category);`,
+		`CREATE TABLE IF NOT EXISTS finding_suppressions (
+			id TEXT PRIMARY KEY,
+			fingerprint TEXT NOT NULL DEFAULT '',
+			category TEXT NOT NULL DEFAULT '',
+			evidence_pattern TEXT NOT NULL DEFAULT '',
+			reason TEXT NOT NULL DEFAULT '',
+			created_by TEXT NOT NULL DEFAULT '',
+			created_at datetime NOT NULL
+		);`,
+		`CREATE TABLE IF NOT EXISTS finding_audit (
+			id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+			trace_id TEXT NOT NULL,
+			finding_id TEXT NOT NULL DEFAULT '',
+			suppression_id TEXT NOT NULL DEFAULT '',
+			action TEXT NOT NULL,
+			from_status TEXT NOT NULL DEFAULT '',
+			to_status TEXT NOT NULL DEFAULT '',
+			actor TEXT NOT NULL DEFAULT '',
+			note TEXT NOT NULL DEFAULT '',
+			created_at datetime NOT NULL
+		);`,
+		`CREATE INDEX IF NOT EXISTS idx_finding_audit_trace_finding ON finding_audit(trace_id, finding_id, id);`,
 		`CREATE TABLE IF NOT EXISTS analysis_runs (
 			id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
 			trace_id TEXT NOT NULL DEFAULT '',
@@ -2983,12 +3166,36 @@ func (s *Store) initSchema() error {
 	if err := s.ensureColumn("logs", "routing_failure_reason", "TEXT NOT NULL DEFAULT ''"); err != nil {
 		return err
 	}
+	if err := s.ensureColumn("logs", "token_name", "TEXT NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+	if err := s.ensureColumn("logs", "client_canceled", "bool NOT NULL DEFAULT false"); err != nil {
+		return err
+	}
+	if err := s.ensureColumn("trace_findings", "session_id", "TEXT NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+	if err := s.ensureColumn("trace_findings", "status", "TEXT NOT NULL DEFAULT 'open'"); err != nil {
+		return err
+	}
+	if err := s.ensureColumn("trace_findings", "fingerprint", "TEXT NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+	if err := s.ensureColumn("trace_findings", "suppression_id", "TEXT NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
 	if err := s.ensureColumn("analysis_jobs", "request_json", "TEXT NOT NULL DEFAULT '{}'"); err != nil {
 		return err
 	}
 	if err := s.ensureColumn("channel_configs", "source", "TEXT NOT NULL DEFAULT 'manual'"); err != nil {
 		return err
 	}
+	if err := s.ensureColumn("experiment_runs", "status", "TEXT NOT NULL DEFAULT 'completed'"); err != nil {
+		return err
+	}
+	if err := s.ensureColumn("experiment_runs", "error", "TEXT NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
 	if err := s.backfillTraceIDs(); err != nil {
 		return err
 	}
@@ -3001,6 +3208,10 @@ func (s *Store) initSchema() error {
 		`CREATE INDEX IF NOT EXISTS tracelog_model_recorded_at ON logs(model, recorded_at);`,
 		`CREATE INDEX IF NOT EXISTS tracelog_session_id_recorded_at ON logs(session_id, recorded_at);`,
 		`CREATE INDEX IF NOT EXISTS tracelog_request_id ON logs(request_id);`,
+		`CREATE INDEX IF NOT EXISTS tracelog_token_name_recorded_at ON logs(token_name, recorded_at);`,
+		`CREATE INDEX IF NOT EXISTS idx_trace_findings_session ON trace_findings(session_id, category);`,
+		`CREATE INDEX IF NOT EXISTS idx_trace_findings_status ON trace_findings(status, severity);`,
+		`CREATE INDEX IF NOT EXISTS idx_trace_findings_fingerprint ON trace_findings(fingerprint);`,
 	}
 	for _, stmt := range postColumnStmts {
 		if _, err := s.db.Exec(stmt); err != nil {
@@ -3010,6 +3221,15 @@ func (s *Store) initSchema() error {
 	if err := s.ensureEntCompatibleTables(); err != nil {
 		return err
 	}
+	if err := s.ensureEvalJudgementsModelKey(); err != nil {
+		return err
+	}
+	if err := s.backfillFindingFingerprints(); err != nil {
+		return err
+	}
+	if err := s.backfillSearchIndex(); err != nil {
+		return err
+	}
 	if err := s.backfillSemantics(); err != nil {
 		return err
 	}
@@ -3194,7 +3414,9 @@ func (s *Store) ensureLogsDatetimeTable() error {
 		routing_policy TEXT NOT NULL DEFAULT '',
 		routing_score REAL NOT NULL DEFAULT 0,
 		routing_candidate_count INTEGER NOT NULL DEFAULT 0,
-		routing_failure_reason TEXT NOT NULL DEFAULT ''
+		routing_failure_reason TEXT NOT NULL DEFAULT '',
+		token_name TEXT NOT NULL DEFAULT '',
+		client_canceled bool NOT NULL DEFAULT false
 	)`); err != nil {
 		return err
 	}
@@ -3205,7 +3427,7 @@ func (s *Store) ensureLogsDatetimeTable() error {
 		req_header_len, req_body_len, res_header_len, res_body_len, is_stream,
 		session_id, session_source, window_id, client_request_id,
 		selected_upstream_id, selected_upstream_base_url, selected_upstream_provider_preset,
-		routing_policy, routing_score, routing_candidate_count, routing_failure_reason
+		routing_policy, routing_score, routing_candidate_count, routing_failure_reason, token_name, client_canceled
 	)
 	SELECT
 		path, trace_id, mod_time_ns, file_size, version, request_id,
@@ -3217,7 +3439,7 @@ func (s *Store) ensureLogsDatetimeTable() error {
 		CASE WHEN is_stream IN (1, '1', 'true', 'TRUE') THEN true ELSE false END,
 		session_id, session_source, window_id, client_request_id,
 		selected_upstream_id, selected_upstream_base_url, selected_upstream_provider_preset,
-		routing_policy, routing_score, routing_candidate_count, routing_failure_reason
+		routing_policy, routing_score, routing_candidate_count, routing_failure_reason, token_name, client_canceled
 	FROM logs_old`); err != nil {
 		return err
 	}
@@ -3227,6 +3449,45 @@ func (s *Store) ensureLogsDatetimeTable() error {
 	return tx.Commit()
 }
 
+// ensureEvalJudgementsModelKey 把旧库中以 (trace_id, rubric_version) 为主键的裁判缓存重建为包含 judge_model 的主键，
+// 换用其他裁判模型时不再命中旧模型的打分。
+func (s *Store) ensureEvalJudgementsModelKey() error {
+	var pk int
+	if err := s.db.QueryRow(`SELECT pk FROM pragma_table_info('eval_judgements') WHERE name = 'judge_model'`).Scan(&pk); err != nil {
+		return err
+	}
+	if pk > 0 {
+		return nil
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	stmts := []string{
+		`ALTER TABLE eval_judgements RENAME TO eval_judgements_old`,
+		`CREATE TABLE eval_judgements (
+			trace_id TEXT NOT NULL,
+			rubric_version TEXT NOT NULL,
+			score REAL NOT NULL,
+			explanation TEXT NOT NULL DEFAULT '',
+			judge_model TEXT NOT NULL DEFAULT '',
+			judge_trace_id TEXT NOT NULL DEFAULT '',
+			created_at datetime NOT NULL,
+			PRIMARY KEY (trace_id, rubric_version, judge_model)
+		)`,
+		`INSERT INTO eval_judgements (trace_id, rubric_version, score, explanation, judge_model, judge_trace_id, created_at)
+		 SELECT trace_id, rubric_version, score, explanation, judge_model, judge_trace_id, created_at FROM eval_judgements_old`,
+		`DROP TABLE eval_judgements_old`,
+	}
+	for _, stmt := range stmts {
+		if _, err := tx.Exec(stmt); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
 func (s *Store) ensureAutoIDTable(table string, createSQL string, copySQL string, indexes []string) error {
 	hasID, err := s.hasColumn(table, "id")
 	if err != nil {
@@ -3453,7 +3714,11 @@ func (s *Store) ListDatasets() ([]DatasetRecord, error) {
 		if err != nil {
 			return nil, err
 		}
-		out = append(out, datasetRecordFromEnt(row, count))
+		record := datasetRecordFromEnt(row, count)
+		if record.Version, err = s.datasetVersion(ctx, row.ID); err != nil {
+			return nil, err
+		}
+		out = append(out, record)
 	}
 	return out, nil
 }
@@ -3473,7 +3738,11 @@ func (s *Store) GetDataset(datasetID string) (DatasetRecord, error) {
 	if err != nil {
 		return DatasetRecord{}, err
 	}
-	return datasetRecordFromEnt(row, count), nil
+	record := datasetRecordFromEnt(row, count)
+	if record.Version, err = s.datasetVersion(ctx, row.ID); err != nil {
+		return DatasetRecord{}, err
+	}
+	return record, nil
 }
 
 func (s *Store) AppendDatasetExamples(datasetID string, traceIDs []string, sourceType string, sourceID string, note string) (int, int, error) {
@@ -3579,6 +3848,10 @@ func (s *Store) GetDatasetExamples(datasetID string) ([]DatasetExampleRecord, er
 	if err != nil {
 		return nil, err
 	}
+	overrides, err := s.datasetExampleOverrides(datasetID)
+	if err != nil {
+		return nil, err
+	}
 
 	out := make([]DatasetExampleRecord, 0, len(rows))
 	for _, row := range rows {
@@ -3589,7 +3862,7 @@ func (s *Store) GetDatasetExamples(datasetID string) ([]DatasetExampleRecord, er
 		if err != nil {
 			return nil, err
 		}
-		out = append(out, DatasetExampleRecord{
+		record := DatasetExampleRecord{
 			DatasetID:  row.DatasetID,
 			TraceID:    row.TraceID,
 			Position:   row.Position,
@@ -3598,7 +3871,9 @@ func (s *Store) GetDatasetExamples(datasetID string) ([]DatasetExampleRecord, er
 			SourceID:   row.SourceID,
 			Note:       row.Note,
 			Trace:      trace,
-		})
+		}
+		applyDatasetExampleOverride(&record, overrides[row.TraceID])
+		out = append(out, record)
 	}
 	return out, nil
 }
@@ -3689,6 +3964,25 @@ func (s *Store) GetEvalRun(evalRunID string) (EvalRunRecord, error) {
 	return evalRunRecordFromEnt(row), nil
 }
 
+// LatestEvalRun 返回指定数据集与评估集下最近一次 eval run，excludeID 对应的运行不计入；不存在时返回 sql.ErrNoRows。
+func (s *Store) LatestEvalRun(datasetID string, evaluatorSet string, excludeID string) (EvalRunRecord, error) {
+	row, err := s.client.EvalRun.Query().
+		Where(
+			evalrun.DatasetIDEQ(strings.TrimSpace(datasetID)),
+			evalrun.EvaluatorSetEQ(strings.TrimSpace(evaluatorSet)),
+			evalrun.IDNEQ(strings.TrimSpace(excludeID)),
+		).
+		Order(evalrun.ByCreatedAt(entsql.OrderDesc()), evalrun.ByID(entsql.OrderDesc())).
+		First(context.Background())
+	if err != nil {
+		if dao.IsNotFound(err) {
+			return EvalRunRecord{}, sql.ErrNoRows
+		}
+		return EvalRunRecord{}, err
+	}
+	return evalRunRecordFromEnt(row), nil
+}
+
 func (s *Store) ListEvalRuns(limit int) ([]EvalRunRecord, error) {
 	if limit <= 0 {
 		limit = 20
@@ -3724,6 +4018,9 @@ func (s *Store) ListScores(filter ScoreFilter, limit int) ([]ScoreRecord, error)
 	if evalRunID := strings.TrimSpace(filter.EvalRunID); evalRunID != "" {
 		predicates = append(predicates, score.EvalRunIDEQ(evalRunID))
 	}
+	if evaluatorKey := strings.TrimSpace(filter.EvaluatorKey); evaluatorKey != "" {
+		predicates = append(predicates, score.EvaluatorKeyEQ(evaluatorKey))
+	}
 	rows, err := s.client.Score.Query().
 		Where(predicates...).
 		Order(score.ByCreatedAt(entsql.OrderDesc()), score.ByID(entsql.OrderDesc())).
@@ -3739,12 +4036,19 @@ func (s *Store) ListScores(filter ScoreFilter, limit int) ([]ScoreRecord, error)
 	return out, nil
 }
 
+// CreateExperimentRun 写入实验记录；Status 为空视为 completed，此时必须带上两侧 eval run。
+// 异步实验先以 running 状态创建，完成后由 FinishExperimentRun 回填结果。
 func (s *Store) CreateExperimentRun(record ExperimentRunRecord) (ExperimentRunRecord, error) {
-	if strings.TrimSpace(record.BaselineEvalRunID) == "" {
-		return ExperimentRunRecord{}, fmt.Errorf("baseline eval run id is required")
+	if record.Status == "" {
+		record.Status = ExperimentCompleted
 	}
-	if strings.TrimSpace(record.CandidateEvalRunID) == "" {
-		return ExperimentRunRecord{}, fmt.Errorf("candidate eval run id is required")
+	if record.Status == ExperimentCompleted {
+		if strings.TrimSpace(record.BaselineEvalRunID) == "" {
+			return ExperimentRunRecord{}, fmt.Errorf("baseline eval run id is required")
+		}
+		if strings.TrimSpace(record.CandidateEvalRunID) == "" {
+			return ExperimentRunRecord{}, fmt.Errorf("candidate eval run id is required")
+		}
 	}
 	record.ID = uuid.NewString()
 	record.CreatedAt = time.Now().UTC()
@@ -3767,12 +4071,39 @@ func (s *Store) CreateExperimentRun(record ExperimentRunRecord) (ExperimentRunRe
 		SetMatchedScoreCount(record.MatchedScoreCount).
 		SetImprovementCount(record.ImprovementCount).
 		SetRegressionCount(record.RegressionCount).
+		SetStatus(record.Status).
+		SetError(record.Error).
 		Exec(context.Background()); err != nil {
 		return ExperimentRunRecord{}, err
 	}
 	return record, nil
 }
 
+// FinishExperimentRun 回填异步实验的结果与最终状态，名称、描述与创建时间保持不变。
+func (s *Store) FinishExperimentRun(record ExperimentRunRecord) error {
+	if record.Status == "" {
+		record.Status = ExperimentCompleted
+	}
+	err := s.client.ExperimentRun.UpdateOneID(record.ID).
+		SetBaselineEvalRunID(strings.TrimSpace(record.BaselineEvalRunID)).
+		SetCandidateEvalRunID(strings.TrimSpace(record.CandidateEvalRunID)).
+		SetBaselineScoreCount(record.BaselineScoreCount).
+		SetCandidateScoreCount(record.CandidateScoreCount).
+		SetBaselinePassRate(record.BaselinePassRate).
+		SetCandidatePassRate(record.CandidatePassRate).
+		SetPassRateDelta(record.PassRateDelta).
+		SetMatchedScoreCount(record.MatchedScoreCount).
+		SetImprovementCount(record.ImprovementCount).
+		SetRegressionCount(record.RegressionCount).
+		SetStatus(record.Status).
+		SetError(record.Error).
+		Exec(context.Background())
+	if dao.IsNotFound(err) {
+		return sql.ErrNoRows
+	}
+	return err
+}
+
 func (s *Store) GetExperimentRun(experimentRunID string) (ExperimentRunRecord, error) {
 	row, err := s.client.ExperimentRun.Get(context.Background(), experimentRunID)
 	if err != nil {
@@ -3873,8 +4204,8 @@ func (s *Store) UpsertLogWithGrouping(path string, header recordfile.RecordHeade
 			req_header_len, req_body_len, res_header_len, res_body_len, is_stream,
 			session_id, session_source, window_id, client_request_id,
 			selected_upstream_id, selected_upstream_base_url, selected_upstream_provider_preset,
-			routing_policy, routing_score, routing_candidate_count, routing_failure_reason
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			routing_policy, routing_score, routing_candidate_count, routing_failure_reason, token_name, client_canceled
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 		ON CONFLICT(path) DO UPDATE SET
 			trace_id=CASE WHEN logs.trace_id = '' THEN excluded.trace_id ELSE logs.trace_id END,
 			mod_time_ns=excluded.mod_time_ns,
@@ -3913,7 +4244,9 @@ func (s *Store) UpsertLogWithGrouping(path string, header recordfile.RecordHeade
 			routing_policy=excluded.routing_policy,
 			routing_score=excluded.routing_score,
 			routing_candidate_count=excluded.routing_candidate_count,
-			routing_failure_reason=excluded.routing_failure_reason
+			routing_failure_reason=excluded.routing_failure_reason,
+			token_name=excluded.token_name,
+			client_canceled=excluded.client_canceled
 	`,
 		path,
 		traceID,
@@ -3954,11 +4287,18 @@ func (s *Store) UpsertLogWithGrouping(path string, header recordfile.RecordHeade
 		header.Meta.RoutingScore,
 		header.Meta.RoutingCandidateCount,
 		header.Meta.RoutingFailureReason,
+		header.Meta.TokenName,
+		header.Meta.ClientCanceled,
 	)
 
 	if err != nil {
 		return err
 	}
+	if header.Meta.RerunOf != "" {
+		if err := s.upsertTraceRerun(traceID, header.Meta.RerunOf, header.Meta.Time); err != nil {
+			return err
+		}
+	}
 	return s.upsertSystemEventsForLog(traceID, header, grouping)
 }
 
@@ -3979,6 +4319,183 @@ func (s *Store) UpdateLogUsage(traceID string, usage recordfile.UsageInfo) error
 	return err
 }
 
+// ListUncompressedLogPaths 返回录制时间早于 before 且仍未压缩的 cassette 路径，按录制时间升序。
+func (s *Store) ListUncompressedLogPaths(before time.Time, limit int) ([]string, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	rows, err := s.db.Query(`
+		SELECT path FROM logs
+		WHERE recorded_at < ? AND path LIKE '%.http'
+		ORDER BY recorded_at ASC
+		LIMIT ?
+	`, before.UTC().Format(timeLayout), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var paths []string
+	for rows.Next() {
+		var path string
+		if err := rows.Scan(&path); err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, rows.Err()
+}
+
+// RenameLogPath 把索引中的 cassette 路径切换到 newPath，trace_id 与派生数据保持不变。
+// 若后台 Sync 已经为 newPath 建了新行，该行会被移除以免同一录制出现两个 trace。
+func (s *Store) RenameLogPath(oldPath string, newPath string) error {
+	info, err := os.Stat(newPath)
+	if err != nil {
+		return err
+	}
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.Exec(`DELETE FROM logs WHERE path = ?`, newPath); err != nil {
+		return err
+	}
+	result, err := tx.Exec(
+		`UPDATE logs SET path = ?, mod_time_ns = ?, file_size = ? WHERE path = ?`,
+		newPath, info.ModTime().UnixNano(), info.Size(), oldPath,
+	)
+	if err != nil {
+		return err
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return fmt.Errorf("rename log path %s: %w", oldPath, sql.ErrNoRows)
+	}
+	return tx.Commit()
+}
+
+// RetentionRecord 是保留策略评估所需的最小 trace 信息。
+type RetentionRecord struct {
+	TraceID     string
+	Path        string
+	Model       string
+	UpstreamID  string
+	RecordedAt  time.Time
+	FileSize    int64
+	HasFindings bool
+	InDataset   bool
+}
+
+// ListRetentionRecords 按录制时间升序返回全部 trace，用于保留策略计算。
+func (s *Store) ListRetentionRecords() ([]RetentionRecord, error) {
+	rows, err := s.db.Query(`
+		WITH snapshot_traces AS (
+			SELECT DISTINCT json_extract(e.value, '$.trace_id') AS trace_id
+			FROM dataset_snapshots s, json_each(s.examples_json) e
+		)
+		SELECT l.trace_id, l.path, l.model, l.selected_upstream_id, l.recorded_at, l.file_size,
+			EXISTS(SELECT 1 FROM trace_findings f WHERE f.trace_id = l.trace_id),
+			EXISTS(SELECT 1 FROM dataset_examples d WHERE d.trace_id = l.trace_id)
+				OR l.trace_id IN (SELECT trace_id FROM snapshot_traces)
+		FROM logs l
+		WHERE l.trace_id <> ''
+		ORDER BY l.recorded_at ASC, l.trace_id ASC
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []RetentionRecord
+	for rows.Next() {
+		var (
+			record     RetentionRecord
+			recordedAt string
+		)
+		if err := rows.Scan(&record.TraceID, &record.Path, &record.Model, &record.UpstreamID, &recordedAt, &record.FileSize, &record.HasFindings, &record.InDataset); err != nil {
+			return nil, err
+		}
+		record.RecordedAt, err = timeParse(recordedAt)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+// traceScopedTables 列出以 trace_id 关联到录制的派生数据表，删除 trace 时一并清理。
+var traceScopedTables = []string{
+	"trace_observations",
+	"semantic_nodes",
+	"trace_search",
+	"trace_findings",
+	"finding_audit",
+	"parse_jobs",
+	"analysis_runs",
+	"scores",
+	"dataset_examples",
+	"dataset_example_overrides",
+	"system_events",
+	"trace_reruns",
+	"eval_judgements",
+	"logs",
+}
+
+// DeleteTraces 在一个事务内删除 trace 的索引行及其全部派生数据，不触碰 cassette 文件。
+func (s *Store) DeleteTraces(traceIDs []string) (int, error) {
+	if len(traceIDs) == 0 {
+		return 0, nil
+	}
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	deleted := 0
+	for start := 0; start < len(traceIDs); start += 500 {
+		batch := traceIDs[start:min(start+500, len(traceIDs))]
+		placeholders := strings.TrimSuffix(strings.Repeat("?,", len(batch)), ",")
+		args := make([]any, 0, len(batch))
+		for _, id := range batch {
+			args = append(args, id)
+		}
+		for _, table := range traceScopedTables {
+			result, err := tx.Exec(`DELETE FROM `+table+` WHERE trace_id IN (`+placeholders+`)`, args...)
+			if err != nil {
+				return 0, fmt.Errorf("delete %s: %w", table, err)
+			}
+			if table == "logs" {
+				affected, _ := result.RowsAffected()
+				deleted += int(affected)
+			}
+		}
+		// 原始 trace 被删除后，重放记录不再保留指向它的关联。
+		if _, err := tx.Exec(`DELETE FROM trace_reruns WHERE original_trace_id IN (`+placeholders+`)`, args...); err != nil {
+			return 0, fmt.Errorf("delete trace_reruns: %w", err)
+		}
+		if _, err := tx.Exec(`DELETE FROM analysis_jobs WHERE target_type = 'trace' AND target_id IN (`+placeholders+`)`, args...); err != nil {
+			return 0, fmt.Errorf("delete analysis_jobs: %w", err)
+		}
+		for _, table := range []string{"trace_tags", "annotations"} {
+			if _, err := tx.Exec(`DELETE FROM `+table+` WHERE target_type = 'trace' AND target_id IN (`+placeholders+`)`, args...); err != nil {
+				return 0, fmt.Errorf("delete %s: %w", table, err)
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
+
 const timeLayout = "2006-01-02T15:04:05.999999999Z07:00"
 
 func (s *Store) Sync() error {
@@ -4000,7 +4517,7 @@ func (s *Store) Sync() error {
 		if path == s.dbPath || strings.HasSuffix(path, "-wal") || strings.HasSuffix(path, "-shm") {
 			return nil
 		}
-		if !strings.HasSuffix(info.Name(), ".http") {
+		if !recordfile.IsRecordPath(info.Name()) {
 			return nil
 		}
 
@@ -4008,7 +4525,7 @@ func (s *Store) Sync() error {
 			return nil
 		}
 
-		content, err := os.ReadFile(path)
+		content, err := recordfile.ReadFile(path)
 		if err != nil {
 			return err
 		}
@@ -4314,6 +4831,9 @@ func (s *Store) SaveObservation(obs observe.TraceObservation) error {
 			return err
 		}
 	}
+	if err := replaceSearchDocuments(tx, obs.TraceID, searchDocuments(obs)); err != nil {
+		return err
+	}
 	if _, err := tx.Exec(`
 		INSERT INTO parse_jobs (trace_id, status, attempts, created_at, updated_at)
 		VALUES (?, ?, 1, ?, ?)
@@ -4765,18 +5285,118 @@ func (s *Store) SubscribeSystemEvents(buffer int) (<-chan SystemEventNotificatio
 	}
 }
 
+// ProxyFindingDetectorPrefix 标记代理请求路径上产生的 finding。这类 finding 无法从录制文件重新推导，
+// 因此 SaveFindings 重新分析时只替换分析器产生的 finding，保留带此前缀的记录。
+const ProxyFindingDetectorPrefix = "proxy."
+
+// SessionFindingDetectorPrefix 标记 session 级检测器产生的 finding。它们挂在证据所在的 trace 上，
+// 但只由 SaveSessionFindings 按 session 整体替换，单条 trace 重新分析时保留。
+const SessionFindingDetectorPrefix = "session."
+
 func (s *Store) SaveFindings(traceID string, findings []observe.Finding) error {
 	if strings.TrimSpace(traceID) == "" {
 		return fmt.Errorf("save findings: trace id is required")
 	}
-	now := time.Now().UTC()
 	tx, err := s.db.Begin()
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
-	if _, err := tx.Exec(`DELETE FROM trace_findings WHERE trace_id = ?`, traceID); err != nil {
+	states, err := loadFindingStates(tx, `trace_id = ?`, traceID)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM trace_findings WHERE trace_id = ? AND detector NOT LIKE ? AND detector NOT LIKE ?`,
+		traceID, ProxyFindingDetectorPrefix+"%", SessionFindingDetectorPrefix+"%"); err != nil {
+		return err
+	}
+	if err := insertFindings(tx, traceID, findings, states); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// SaveSessionFindings 替换某个 session 的全部 session 级 finding，每条 finding 必须带 TraceID 指向证据所在的 trace。
+func (s *Store) SaveSessionFindings(sessionID string, findings []observe.Finding) error {
+	if strings.TrimSpace(sessionID) == "" {
+		return fmt.Errorf("save session findings: session id is required")
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	states, err := loadFindingStates(tx, `session_id = ?`, sessionID)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM trace_findings WHERE session_id = ? AND detector LIKE ?`, sessionID, SessionFindingDetectorPrefix+"%"); err != nil {
+		return err
+	}
+	for _, finding := range findings {
+		if strings.TrimSpace(finding.TraceID) == "" {
+			return fmt.Errorf("save session findings: finding %s has no trace id", finding.ID)
+		}
+		finding.SessionID = sessionID
+		if _, err := tx.Exec(`DELETE FROM trace_findings WHERE trace_id = ? AND finding_id = ?`, finding.TraceID, finding.ID); err != nil {
+			return err
+		}
+		if err := insertFindings(tx, finding.TraceID, []observe.Finding{finding}, states); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// ListSessionFindings 返回 session 级检测器在该 session 上产生的 finding。
+func (s *Store) ListSessionFindings(sessionID string, filter FindingFilter) ([]observe.Finding, error) {
+	query := `SELECT ` + findingColumns + ` FROM trace_findings WHERE session_id = ? AND detector LIKE ?`
+	args := []any{sessionID, SessionFindingDetectorPrefix + "%"}
+	clause, filterArgs := findingFilterClause(filter)
+	query += clause + ` ORDER BY id ASC`
+	args = append(args, filterArgs...)
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanFindings(rows)
+}
+
+// AppendFindings 写入 finding 但不清除该 trace 已有的记录；相同 finding_id 会被覆盖。
+func (s *Store) AppendFindings(traceID string, findings []observe.Finding) error {
+	if strings.TrimSpace(traceID) == "" {
+		return fmt.Errorf("append findings: trace id is required")
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	states, err := loadFindingStates(tx, `trace_id = ?`, traceID)
+	if err != nil {
+		return err
+	}
+	for _, finding := range findings {
+		if _, err := tx.Exec(`DELETE FROM trace_findings WHERE trace_id = ? AND finding_id = ?`, traceID, finding.ID); err != nil {
+			return err
+		}
+	}
+	if err := insertFindings(tx, traceID, findings, states); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// insertFindings 写入 finding：沿用重新分析前的处置状态，否则套用匹配的抑制规则。
+func insertFindings(tx *sql.Tx, traceID string, findings []observe.Finding, states findingStates) error {
+	now := time.Now().UTC()
+	suppressions, err := loadFindingSuppressions(tx)
+	if err != nil {
 		return err
 	}
 	for _, finding := range findings {
@@ -4789,87 +5409,63 @@ func (s *Store) SaveFindings(traceID string, findings []observe.Finding) error {
 		if finding.TraceID == "" {
 			finding.TraceID = traceID
 		}
+		finding.EvidenceExcerpt = textPreview(finding.EvidenceExcerpt, 500)
+		if finding.Fingerprint == "" {
+			finding.Fingerprint = FindingFingerprint(finding)
+		}
+		if finding.Status == "" {
+			finding.Status = FindingStatusOpen
+		}
+		if state, ok := states.lookup(finding); ok {
+			finding.Status, finding.SuppressionID = state.status, state.suppressionID
+		}
+		if finding.Status == FindingStatusOpen {
+			if rule, ok := matchFindingSuppression(suppressions, finding); ok {
+				finding.Status, finding.SuppressionID = FindingStatusFalsePositive, rule.ID
+			}
+		}
 		if _, err := tx.Exec(`
 			INSERT INTO trace_findings (
 				trace_id, finding_id, category, severity, confidence, title, description,
-				evidence_path, evidence_excerpt, node_id, detector, detector_version, created_at
+				evidence_path, evidence_excerpt, node_id, detector, detector_version, created_at, session_id,
+				status, fingerprint, suppression_id
 			)
-			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 		`, finding.TraceID, finding.ID, finding.Category, string(finding.Severity), finding.Confidence, finding.Title,
-			finding.Description, finding.EvidencePath, textPreview(finding.EvidenceExcerpt, 500), finding.NodeID,
-			finding.Detector, finding.DetectorVersion, finding.CreatedAt); err != nil {
+			finding.Description, finding.EvidencePath, finding.EvidenceExcerpt, finding.NodeID,
+			finding.Detector, finding.DetectorVersion, finding.CreatedAt, finding.SessionID,
+			finding.Status, finding.Fingerprint, finding.SuppressionID); err != nil {
 			return err
 		}
 	}
-	return tx.Commit()
+	return nil
 }
 
 func (s *Store) ListFindings(traceID string, filter FindingFilter) ([]observe.Finding, error) {
 	if strings.TrimSpace(traceID) == "" {
 		return nil, fmt.Errorf("list findings: trace id is required")
 	}
-	query := `
-		SELECT finding_id, trace_id, category, severity, confidence, title, description,
-			evidence_path, evidence_excerpt, node_id, detector, detector_version, created_at
-		FROM trace_findings
-		WHERE trace_id = ?
-	`
+	query := `SELECT ` + findingColumns + ` FROM trace_findings WHERE trace_id = ?`
 	args := []any{traceID}
-	if category := strings.TrimSpace(filter.Category); category != "" {
-		query += ` AND category = ?`
-		args = append(args, category)
-	}
-	if severity := strings.TrimSpace(filter.Severity); severity != "" {
-		query += ` AND severity = ?`
-		args = append(args, severity)
-	}
-	query += ` ORDER BY id ASC`
+	clause, filterArgs := findingFilterClause(filter)
+	query += clause + ` ORDER BY id ASC`
+	args = append(args, filterArgs...)
 
 	rows, err := s.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-
-	var out []observe.Finding
-	for rows.Next() {
-		var finding observe.Finding
-		var severity string
-		var createdAt any
-		if err := rows.Scan(&finding.ID, &finding.TraceID, &finding.Category, &severity, &finding.Confidence, &finding.Title,
-			&finding.Description, &finding.EvidencePath, &finding.EvidenceExcerpt, &finding.NodeID,
-			&finding.Detector, &finding.DetectorVersion, &createdAt); err != nil {
-			return nil, err
-		}
-		finding.Severity = observe.Severity(severity)
-		if finding.CreatedAt, err = timeParseValue(createdAt); err != nil {
-			return nil, err
-		}
-		out = append(out, finding)
-	}
-	return out, rows.Err()
+	return scanFindings(rows)
 }
 
 func (s *Store) ListAllFindings(filter FindingFilter, limit int) ([]observe.Finding, error) {
 	if limit <= 0 {
 		limit = 50
 	}
-	query := `
-		SELECT finding_id, trace_id, category, severity, confidence, title, description,
-			evidence_path, evidence_excerpt, node_id, detector, detector_version, created_at
-		FROM trace_findings
-		WHERE 1 = 1
-	`
-	var args []any
-	if category := strings.TrimSpace(filter.Category); category != "" {
-		query += ` AND category = ?`
-		args = append(args, category)
-	}
-	if severity := strings.TrimSpace(filter.Severity); severity != "" {
-		query += ` AND severity = ?`
-		args = append(args, severity)
-	}
-	query += ` ORDER BY created_at DESC, id DESC LIMIT ?`
+	query := `SELECT ` + findingColumns + ` FROM trace_findings WHERE 1 = 1`
+	clause, args := findingFilterClause(filter)
+	query += clause + ` ORDER BY created_at DESC, id DESC LIMIT ?`
 	args = append(args, limit)
 
 	rows, err := s.db.Query(query, args...)
@@ -5241,7 +5837,7 @@ func (s *Store) ListTracesBySession(sessionID string) ([]LogEntry, error) {
 			req_header_len, req_body_len, res_header_len, res_body_len, is_stream,
 			session_id, session_source, window_id, client_request_id,
 			selected_upstream_id, selected_upstream_base_url, selected_upstream_provider_preset,
-			routing_policy, routing_score, routing_candidate_count, routing_failure_reason
+			routing_policy, routing_score, routing_candidate_count, routing_failure_reason, token_name, client_canceled
 		FROM logs
 		WHERE session_id = ?
 		ORDER BY recorded_at DESC, trace_id DESC
@@ -5351,6 +5947,10 @@ func (s *Store) Overview(opts OverviewOptions) (OverviewDashboard, error) {
 	if err != nil {
 		return OverviewDashboard{}, err
 	}
+	pii, err := s.overviewPIIExposure(whereSQL, whereArgs, opts.Limit)
+	if err != nil {
+		return OverviewDashboard{}, err
+	}
 	return OverviewDashboard{
 		Summary:     summary,
 		Timeline:    timeline,
@@ -5358,6 +5958,7 @@ func (s *Store) Overview(opts OverviewOptions) (OverviewDashboard, error) {
 		Attention:   attention,
 		Analysis:    analysis,
 		Observation: observation,
+		PII:         pii,
 	}, nil
 }
 
@@ -5581,7 +6182,7 @@ func (s *Store) overviewFindingCategories(limit int) ([]CountItem, error) {
 	rows, err := s.db.Query(`
 		SELECT category, COUNT(*) AS count
 		FROM trace_findings
-		WHERE category != ''
+		WHERE category != '' AND status IN ('open', 'acknowledged')
 		GROUP BY category
 		ORDER BY count DESC, category ASC
 		LIMIT ?
@@ -5622,7 +6223,7 @@ func (s *Store) overviewTraceList(whereSQL string, whereArgs []any, orderBy stri
 			req_header_len, req_body_len, res_header_len, res_body_len, is_stream,
 			session_id, session_source, window_id, client_request_id,
 			selected_upstream_id, selected_upstream_base_url, selected_upstream_provider_preset,
-			routing_policy, routing_score, routing_candidate_count, routing_failure_reason
+			routing_policy, routing_score, routing_candidate_count, routing_failure_reason, token_name, client_canceled
 		FROM logs
 		WHERE `+whereSQL+`
 		ORDER BY `+orderBy+`
@@ -5646,10 +6247,9 @@ func (s *Store) overviewTraceList(whereSQL string, whereArgs []any, orderBy stri
 
 func (s *Store) overviewHighRiskFindings(limit int) ([]observe.Finding, error) {
 	rows, err := s.db.Query(`
-		SELECT finding_id, trace_id, category, severity, confidence, title, description,
-			evidence_path, evidence_excerpt, node_id, detector, detector_version, created_at
+		SELECT `+findingColumns+`
 		FROM trace_findings
-		WHERE severity IN ('critical', 'high')
+		WHERE severity IN ('critical', 'high') AND status IN ('open', 'acknowledged')
 		ORDER BY
 			CASE severity WHEN 'critical' THEN 0 WHEN 'high' THEN 1 ELSE 2 END,
 			created_at DESC,
@@ -5695,6 +6295,48 @@ func (s *Store) overviewRoutingFailures(whereSQL string, whereArgs []any, limit
 	return out, rows.Err()
 }
 
+func (s *Store) overviewPIIExposure(whereSQL string, whereArgs []any, limit int) (OverviewPIIExposure, error) {
+	from := `
+		FROM trace_findings f
+		JOIN logs l ON l.trace_id = f.trace_id
+		WHERE f.category LIKE 'pii\_%' ESCAPE '\' AND f.status IN ('open', 'acknowledged') AND ` + whereSQL
+	// 会话中后续轮次会带上历史消息，同一处 PII 在每个 trace 上都会产生指纹相同的 finding；
+	// 按 (会话, 指纹) 计数，只算一次暴露，没有会话的 trace 按自身计。
+	const exposure = `COALESCE(NULLIF(l.session_id, ''), f.trace_id) || char(0) || f.fingerprint`
+	var out OverviewPIIExposure
+	if err := s.db.QueryRow(`SELECT COUNT(DISTINCT `+exposure+`), COUNT(DISTINCT f.trace_id) `+from, whereArgs...).Scan(&out.Findings, &out.Traces); err != nil {
+		return OverviewPIIExposure{}, err
+	}
+	groups := []struct {
+		column string
+		target *[]CountItem
+	}{
+		{`SUBSTR(f.category, 5)`, &out.Entities},
+		{`l.session_id`, &out.Sessions},
+		{`l.model`, &out.Models},
+	}
+	for _, group := range groups {
+		queryArgs := append(append([]any{}, whereArgs...), limit)
+		rows, err := s.db.Query(`
+			SELECT `+group.column+` AS label, COUNT(DISTINCT `+exposure+`) AS count
+			`+from+` AND `+group.column+` != ''
+			GROUP BY label
+			ORDER BY count DESC, label ASC
+			LIMIT ?
+		`, queryArgs...)
+		if err != nil {
+			return OverviewPIIExposure{}, err
+		}
+		items, err := scanCountItems(rows)
+		rows.Close()
+		if err != nil {
+			return OverviewPIIExposure{}, err
+		}
+		*group.target = items
+	}
+	return out, nil
+}
+
 func (s *Store) overviewAnalysis(limit int) (OverviewAnalysisSummary, error) {
 	var summary OverviewAnalysisSummary
 	if err := s.db.QueryRow(`
@@ -5787,6 +6429,8 @@ func logEntryFromTraceLog(row *dao.TraceLog) LogEntry {
 	entry.Header.Meta.RoutingScore = row.RoutingScore
 	entry.Header.Meta.RoutingCandidateCount = row.RoutingCandidateCount
 	entry.Header.Meta.RoutingFailureReason = row.RoutingFailureReason
+	entry.Header.Meta.TokenName = row.TokenName
+	entry.Header.Meta.ClientCanceled = row.ClientCanceled
 	entry.Header.Usage.PromptTokens = row.PromptTokens
 	entry.Header.Usage.CompletionTokens = row.CompletionTokens
 	entry.Header.Usage.TotalTokens = row.TotalTokens
@@ -6009,6 +6653,8 @@ func experimentRunRecordFromEnt(row *dao.ExperimentRun) ExperimentRunRecord {
 		MatchedScoreCount:   row.MatchedScoreCount,
 		ImprovementCount:    row.ImprovementCount,
 		RegressionCount:     row.RegressionCount,
+		Status:              row.Status,
+		Error:               row.Error,
 	}
 }
 
@@ -6062,6 +6708,8 @@ func scanEntry(scanner interface {
 		&routingScore,
 		&entry.Header.Meta.RoutingCandidateCount,
 		&entry.Header.Meta.RoutingFailureReason,
+		&entry.Header.Meta.TokenName,
+		&entry.Header.Meta.ClientCanceled,
 	)
 	if err != nil {
 		return LogEntry{}, err
@@ -6347,6 +6995,26 @@ func buildTraceLogPredicates(filter ListFilter) []predicate.TraceLog {
 			tracelog.URLContainsFold(query),
 		))
 	}
+	if search := strings.TrimSpace(filter.Search); search != "" {
+		predicates = append(predicates, predicate.TraceLog(func(sel *entsql.Selector) {
+			if clause, args := searchFilterClause(sel.C(tracelog.FieldTraceID), search); clause != "" {
+				sel.Where(entsql.ExprP(clause, args...))
+			}
+		}))
+	}
+	if conditions := filter.queryConditions(); len(conditions) > 0 {
+		predicates = append(predicates, predicate.TraceLog(func(sel *entsql.Selector) {
+			// 条件已由 ParseTraceQuery 校验，这里的编译错误只会来自手工构造的 QueryCondition。
+			clause, args, err := compileQueryConditions(conditions, sel.C, time.Now())
+			if err != nil {
+				sel.Where(entsql.False())
+				return
+			}
+			if clause != "" {
+				sel.Where(entsql.ExprP(clause, args...))
+			}
+		}))
+	}
 	return predicates
 }
 
@@ -6377,7 +7045,8 @@ func scanFindings(rows *sql.Rows) ([]observe.Finding, error) {
 		var createdAt any
 		if err := rows.Scan(&finding.ID, &finding.TraceID, &finding.Category, &severity, &finding.Confidence, &finding.Title,
 			&finding.Description, &finding.EvidencePath, &finding.EvidenceExcerpt, &finding.NodeID,
-			&finding.Detector, &finding.DetectorVersion, &createdAt); err != nil {
+			&finding.Detector, &finding.DetectorVersion, &createdAt, &finding.SessionID,
+			&finding.Status, &finding.Fingerprint, &finding.SuppressionID); err != nil {
 			return nil, err
 		}
 		finding.Severity = observe.Severity(severity)
@@ -6641,6 +7310,27 @@ func buildLogFilterClause(filter ListFilter, alias string) (string, []any) {
 			args = append(args, pattern)
 		}
 	}
+	if clause, searchArgs := searchFilterClause(column("trace_id"), filter.Search); clause != "" {
+		clauses = append(clauses, clause)
+		args = append(args, searchArgs...)
+	}
+	if conditions := filter.queryConditions(); len(conditions) > 0 {
+		// 查询条件包含 finding / observation 的关联子查询，外层列必须带表名限定。
+		qualified := func(name string) string {
+			if alias == "" {
+				return "logs." + name
+			}
+			return alias + "." + name
+		}
+		clause, queryArgs, err := compileQueryConditions(conditions, qualified, time.Now())
+		if err != nil {
+			clause, queryArgs = "0 = 1", nil
+		}
+		if clause != "" {
+			clauses = append(clauses, clause)
+			args = append(args, queryArgs...)
+		}
+	}
 
 	return strings.Join(clauses, " AND "), args
 }
@@ -6665,8 +7355,9 @@ func buildSystemEventFilterClause(filter SystemEventFilter) (string, []any) {
 		args = append(args, category)
 	}
 	if !filter.Since.IsZero() {
+		// last_seen_at 由驱动按 time.Time 默认格式写入，参数需使用同样的格式才能按字符串比较。
 		clauses = append(clauses, `last_seen_at >= ?`)
-		args = append(args, filter.Since.UTC().Format(timeLayout))
+		args = append(args, filter.Since.UTC())
 	}
 	if query := strings.TrimSpace(filter.Query); query != "" {
 		pattern := "%" + escapeLike(query) + "%"
@@ -7036,7 +7727,7 @@ func (s *Store) backfillGrouping() error {
 	}
 
 	for _, path := range paths {
-		content, err := os.ReadFile(path)
+		content, err := recordfile.ReadFile(path)
 		if err != nil {
 			if os.IsNotExist(err) {
 				continue
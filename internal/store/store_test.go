@@ -2228,6 +2228,100 @@ func TestSaveFindingsRebuildsTraceFindings(t *testing.T) {
 	}
 }
 
+func TestSaveFindingsPreservesProxyFindings(t *testing.T) {
+	st, err := New(t.TempDir())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer st.Close()
+
+	proxyFinding := observe.Finding{
+		ID:              "finding-guard",
+		Category:        "outbound_credential",
+		Severity:        observe.SeverityHigh,
+		Title:           "OpenAI API key masked before upstream",
+		EvidencePath:    "$.messages[0].content",
+		Detector:        ProxyFindingDetectorPrefix + "outbound_guard",
+		DetectorVersion: "0.1.0",
+	}
+	if err := st.AppendFindings("trace-guard", []observe.Finding{proxyFinding}); err != nil {
+		t.Fatalf("AppendFindings() error = %v", err)
+	}
+	if err := st.AppendFindings("trace-guard", []observe.Finding{proxyFinding}); err != nil {
+		t.Fatalf("AppendFindings(repeat) error = %v", err)
+	}
+	analyzed := observe.Finding{
+		ID:              "finding-analyzer",
+		Category:        "credential_leak",
+		Severity:        observe.SeverityHigh,
+		Detector:        "credential",
+		DetectorVersion: "0.1.0",
+	}
+	if err := st.SaveFindings("trace-guard", []observe.Finding{analyzed}); err != nil {
+		t.Fatalf("SaveFindings() error = %v", err)
+	}
+	if err := st.SaveFindings("trace-guard", []observe.Finding{analyzed}); err != nil {
+		t.Fatalf("SaveFindings(rebuild) error = %v", err)
+	}
+	findings, err := st.ListFindings("trace-guard", FindingFilter{})
+	if err != nil {
+		t.Fatalf("ListFindings() error = %v", err)
+	}
+	if len(findings) != 2 || findings[0].ID != "finding-guard" || findings[1].ID != "finding-analyzer" {
+		t.Fatalf("findings = %+v, want proxy finding preserved alongside analyzer finding", findings)
+	}
+}
+
+func TestSaveSessionFindingsReplacesPerSessionAndSurvivesTraceRescan(t *testing.T) {
+	st, err := New(t.TempDir())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer st.Close()
+
+	loop := observe.Finding{
+		ID:              "finding-loop",
+		TraceID:         "trace-2",
+		Category:        "agent_repeated_tool_call",
+		Severity:        observe.SeverityMedium,
+		Detector:        SessionFindingDetectorPrefix + "agent_patterns",
+		DetectorVersion: "0.1.0",
+	}
+	budget := loop
+	budget.ID, budget.TraceID, budget.Category = "finding-budget", "trace-3", "agent_turn_budget_exceeded"
+	if err := st.SaveSessionFindings("sess-1", []observe.Finding{loop, budget}); err != nil {
+		t.Fatalf("SaveSessionFindings() error = %v", err)
+	}
+	if err := st.SaveSessionFindings("sess-1", []observe.Finding{{ID: "finding-orphan", Detector: loop.Detector}}); err == nil {
+		t.Fatalf("SaveSessionFindings() without trace id should fail")
+	}
+	// 单条 trace 重新扫描不应清掉 session 级 finding。
+	if err := st.SaveFindings("trace-2", []observe.Finding{{ID: "finding-tool", Category: "tool_result_error", Severity: observe.SeverityMedium, Detector: "tool_error", DetectorVersion: "0.1.0"}}); err != nil {
+		t.Fatalf("SaveFindings() error = %v", err)
+	}
+	traceFindings, err := st.ListFindings("trace-2", FindingFilter{})
+	if err != nil {
+		t.Fatalf("ListFindings() error = %v", err)
+	}
+	if len(traceFindings) != 2 || traceFindings[0].ID != "finding-loop" || traceFindings[0].SessionID != "sess-1" {
+		t.Fatalf("trace findings = %+v", traceFindings)
+	}
+
+	if err := st.SaveSessionFindings("sess-1", []observe.Finding{budget}); err != nil {
+		t.Fatalf("SaveSessionFindings(replace) error = %v", err)
+	}
+	sessionFindings, err := st.ListSessionFindings("sess-1", FindingFilter{})
+	if err != nil {
+		t.Fatalf("ListSessionFindings() error = %v", err)
+	}
+	if len(sessionFindings) != 1 || sessionFindings[0].ID != "finding-budget" || sessionFindings[0].TraceID != "trace-3" {
+		t.Fatalf("session findings = %+v", sessionFindings)
+	}
+	if others, _ := st.ListSessionFindings("sess-2", FindingFilter{}); len(others) != 0 {
+		t.Fatalf("other session findings = %+v", others)
+	}
+}
+
 func TestSaveAndListAnalysisRuns(t *testing.T) {
 	st, err := New(t.TempDir())
 	if err != nil {
@@ -2402,3 +2496,116 @@ func mustTraceID(t *testing.T, st *Store, path string) string {
 	}
 	return traceID
 }
+
+func TestOverviewPIIExposureGroupsActiveFindingsBySessionAndModel(t *testing.T) {
+	dir := t.TempDir()
+	st, err := New(dir)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer st.Close()
+
+	now := time.Now().UTC()
+	traceIDs := map[string]string{}
+	for _, item := range []struct{ name, model, session string }{
+		{"pii-a.http", "gpt-5", "sess-pii"},
+		{"pii-b.http", "gpt-5-mini", "sess-pii"},
+		{"pii-c.http", "gpt-5", "sess-other"},
+	} {
+		path := filepath.Join(dir, item.name)
+		if err := os.WriteFile(path, []byte("test"), 0o644); err != nil {
+			t.Fatalf("WriteFile(%q) error = %v", path, err)
+		}
+		header := recordfile.RecordHeader{
+			Version: "LLM_PROXY_V3",
+			Meta:    recordfile.MetaData{RequestID: item.name, Time: now, Model: item.model, URL: "/v1/responses", Method: "POST", StatusCode: 200},
+		}
+		if err := st.UpsertLogWithGrouping(path, header, GroupingInfo{SessionID: item.session, SessionSource: "header.session_id"}); err != nil {
+			t.Fatalf("UpsertLogWithGrouping(%q) error = %v", path, err)
+		}
+		entry, err := st.GetByRequestID(item.name)
+		if err != nil {
+			t.Fatalf("GetByRequestID(%q) error = %v", item.name, err)
+		}
+		traceIDs[item.name] = entry.ID
+	}
+	piiFinding := func(id string, category string, excerpt string) observe.Finding {
+		return observe.Finding{ID: id, Category: category, Severity: observe.SeverityMedium, EvidenceExcerpt: excerpt, Detector: "pii", DetectorVersion: "0.1.0"}
+	}
+	if err := st.SaveFindings(traceIDs["pii-a.http"], []observe.Finding{
+		piiFinding("a-email", "pii_email", "email: ja***om"),
+		piiFinding("a-card", "pii_credit_card", "credit_card: 41***11"),
+		{ID: "a-other", Category: "credential_leak", Severity: observe.SeverityHigh, Detector: "credential", DetectorVersion: "0.1.0"},
+	}); err != nil {
+		t.Fatalf("SaveFindings(a) error = %v", err)
+	}
+	// pii-b 是同一会话的下一轮，历史消息中的 ja***om 再次命中，不应重复计入会话暴露。
+	if err := st.SaveFindings(traceIDs["pii-b.http"], []observe.Finding{
+		piiFinding("b-email", "pii_email", "email: jo***om"),
+		piiFinding("b-history", "pii_email", "email: ja***om"),
+	}); err != nil {
+		t.Fatalf("SaveFindings(b) error = %v", err)
+	}
+	if err := st.SaveFindings(traceIDs["pii-c.http"], []observe.Finding{piiFinding("c-email", "pii_email", "email: te***om")}); err != nil {
+		t.Fatalf("SaveFindings(c) error = %v", err)
+	}
+	// 标记为误报的 PII finding 不计入暴露统计。
+	if _, err := st.UpdateFindingStatus(traceIDs["pii-c.http"], "c-email", FindingStatusFalsePositive, "", ""); err != nil {
+		t.Fatalf("UpdateFindingStatus() error = %v", err)
+	}
+
+	dashboard, err := st.Overview(OverviewOptions{Since: now.Add(-time.Hour)})
+	if err != nil {
+		t.Fatalf("Overview() error = %v", err)
+	}
+	pii := dashboard.PII
+	if pii.Findings != 3 || pii.Traces != 2 {
+		t.Fatalf("pii totals = %+v", pii)
+	}
+	if len(pii.Entities) != 2 || pii.Entities[0] != (CountItem{Label: "email", Count: 2}) || pii.Entities[1] != (CountItem{Label: "credit_card", Count: 1}) {
+		t.Fatalf("pii entities = %+v", pii.Entities)
+	}
+	if len(pii.Sessions) != 1 || pii.Sessions[0] != (CountItem{Label: "sess-pii", Count: 3}) {
+		t.Fatalf("pii sessions = %+v", pii.Sessions)
+	}
+	if len(pii.Models) != 2 || pii.Models[0] != (CountItem{Label: "gpt-5", Count: 2}) || pii.Models[1] != (CountItem{Label: "gpt-5-mini", Count: 2}) {
+		t.Fatalf("pii models = %+v", pii.Models)
+	}
+}
+
+func TestLogEntryKeepsTokenName(t *testing.T) {
+	dir := t.TempDir()
+	st, err := New(dir)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer st.Close()
+
+	path := filepath.Join(dir, "token.http")
+	if err := os.WriteFile(path, []byte("test"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	header := recordfile.RecordHeader{
+		Version: "LLM_PROXY_V3",
+		Meta: recordfile.MetaData{
+			RequestID: "req-token",
+			Time:      time.Now().UTC(),
+			Model:     "gpt-5.1",
+			TokenName: "ci",
+		},
+	}
+	if err := st.UpsertLogWithGrouping(path, header, GroupingInfo{SessionID: "session-token"}); err != nil {
+		t.Fatalf("UpsertLog() error = %v", err)
+	}
+	entry, err := st.GetByRequestID("req-token")
+	if err != nil {
+		t.Fatalf("GetByRequestID() error = %v", err)
+	}
+	if entry.Header.Meta.TokenName != "ci" {
+		t.Fatalf("GetByRequestID().TokenName = %q, want ci", entry.Header.Meta.TokenName)
+	}
+	entries, err := st.ListTracesBySession("session-token")
+	if err != nil || len(entries) != 1 || entries[0].Header.Meta.TokenName != "ci" {
+		t.Fatalf("ListTracesBySession() = %+v, err = %v", entries, err)
+	}
+}
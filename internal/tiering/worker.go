@@ -0,0 +1,151 @@
+package tiering
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/config"
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/pkg/recordfile"
+)
+
+// Worker 定期把超过保留期的 cassette 压缩为冷存储格式，并同步索引中的路径。
+type Worker struct {
+	store     *store.Store
+	algorithm string
+	olderThan time.Duration
+	interval  time.Duration
+	batchSize int
+	now       func() time.Time
+}
+
+type Options struct {
+	Algorithm string
+	OlderThan time.Duration
+	Interval  time.Duration
+	BatchSize int
+}
+
+type Result struct {
+	Compressed int   `json:"compressed"`
+	Failed     int   `json:"failed"`
+	BytesSaved int64 `json:"bytes_saved"`
+}
+
+func New(st *store.Store, opts Options) (*Worker, error) {
+	algorithm := strings.ToLower(strings.TrimSpace(opts.Algorithm))
+	if algorithm == "" {
+		algorithm = recordfile.CompressionZstd
+	}
+	if _, err := recordfile.CompressedPath("", algorithm); err != nil {
+		return nil, err
+	}
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	return &Worker{
+		store:     st,
+		algorithm: algorithm,
+		olderThan: max(opts.OlderThan, 0),
+		interval:  interval,
+		batchSize: batchSize,
+		now:       time.Now,
+	}, nil
+}
+
+// OptionsFromConfig 把 trace.compression 配置转换为 Worker 参数。
+func OptionsFromConfig(cfg config.TraceCompressionConfig) Options {
+	return Options{
+		Algorithm: cfg.Algorithm,
+		OlderThan: time.Duration(cfg.OlderThanDays) * 24 * time.Hour,
+		Interval:  cfg.Interval,
+	}
+}
+
+func (w *Worker) Run(ctx context.Context) {
+	if w == nil || w.store == nil {
+		return
+	}
+	w.runOnce(ctx)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.runOnce(ctx)
+		}
+	}
+}
+
+func (w *Worker) runOnce(ctx context.Context) {
+	result, err := w.RunOnce(ctx)
+	if err != nil {
+		slog.Warn("Cassette compression failed", "error", err)
+		return
+	}
+	if result.Compressed > 0 || result.Failed > 0 {
+		slog.Info("Cassette compression finished", "compressed", result.Compressed, "failed", result.Failed, "bytes_saved", result.BytesSaved)
+	}
+}
+
+// RunOnce 压缩一批符合条件的 cassette；单个文件失败只计数，不中断整批。
+func (w *Worker) RunOnce(ctx context.Context) (Result, error) {
+	var result Result
+	paths, err := w.store.ListUncompressedLogPaths(w.now().Add(-w.olderThan), w.batchSize)
+	if err != nil {
+		return result, err
+	}
+	for _, path := range paths {
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		default:
+		}
+		saved, err := w.compress(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			result.Failed++
+			slog.Warn("Compress cassette failed", "path", path, "error", err)
+			continue
+		}
+		result.Compressed++
+		result.BytesSaved += saved
+	}
+	return result, nil
+}
+
+func (w *Worker) compress(path string) (int64, error) {
+	before, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	target, err := recordfile.CompressFile(path, w.algorithm)
+	if err != nil {
+		return 0, err
+	}
+	if err := w.store.RenameLogPath(path, target); err != nil {
+		_ = os.Remove(target)
+		return 0, fmt.Errorf("update index: %w", err)
+	}
+	if err := os.Remove(path); err != nil {
+		return 0, err
+	}
+	after, err := os.Stat(target)
+	if err != nil {
+		return 0, err
+	}
+	return before.Size() - after.Size(), nil
+}
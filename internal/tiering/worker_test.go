@@ -0,0 +1,129 @@
+package tiering
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/internal/observeworker"
+	"github.com/kingfs/llm-tracelab/internal/store"
+	"github.com/kingfs/llm-tracelab/pkg/observe"
+	"github.com/kingfs/llm-tracelab/pkg/recordfile"
+)
+
+func TestRunOnceCompressesOldCassettesAndKeepsTraceID(t *testing.T) {
+	dir := t.TempDir()
+	st, err := store.New(dir)
+	if err != nil {
+		t.Fatalf("store.New() error = %v", err)
+	}
+	defer st.Close()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	oldID, oldPath := writeIndexedTrace(t, st, dir, "req-old", now.Add(-10*24*time.Hour))
+	newID, newPath := writeIndexedTrace(t, st, dir, "req-new", now.Add(-time.Hour))
+
+	worker, err := New(st, Options{Algorithm: "zstd", OlderThan: 7 * 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	worker.now = func() time.Time { return now }
+	result, err := worker.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("RunOnce() error = %v", err)
+	}
+	if result.Compressed != 1 || result.Failed != 0 {
+		t.Fatalf("RunOnce() = %+v, want one compressed cassette", result)
+	}
+
+	entry, err := st.GetByID(oldID)
+	if err != nil {
+		t.Fatalf("GetByID(old) error = %v", err)
+	}
+	if entry.LogPath != oldPath+".zst" {
+		t.Fatalf("old LogPath = %q, want %q", entry.LogPath, oldPath+".zst")
+	}
+	if _, err := os.Stat(oldPath); !os.IsNotExist(err) {
+		t.Fatalf("original cassette still exists: %v", err)
+	}
+	if entry, err := st.GetByID(newID); err != nil || entry.LogPath != newPath {
+		t.Fatalf("recent trace = %+v, err = %v", entry, err)
+	}
+
+	if err := st.Sync(); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	entries, err := st.ListRecent(10)
+	if err != nil {
+		t.Fatalf("ListRecent() error = %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("ListRecent() = %d entries after sync, want 2", len(entries))
+	}
+
+	obs, err := observeworker.ReparseTrace(context.Background(), st, observe.NewDefaultRegistry(), oldID)
+	if err != nil {
+		t.Fatalf("ReparseTrace(compressed) error = %v", err)
+	}
+	if obs.TraceID != oldID {
+		t.Fatalf("ReparseTrace() trace id = %q", obs.TraceID)
+	}
+
+	result, err = worker.RunOnce(context.Background())
+	if err != nil || result.Compressed != 0 {
+		t.Fatalf("second RunOnce() = %+v, err = %v", result, err)
+	}
+}
+
+func TestNewRejectsUnknownAlgorithm(t *testing.T) {
+	if _, err := New(nil, Options{Algorithm: "lz4"}); err == nil {
+		t.Fatalf("New(lz4) error = nil, want error")
+	}
+}
+
+func writeIndexedTrace(t *testing.T, st *store.Store, dir string, requestID string, recordedAt time.Time) (string, string) {
+	t.Helper()
+	reqHead := "POST /v1/responses HTTP/1.1\r\nHost: example.com\r\n\r\n"
+	reqBody := `{"model":"gpt-5.1","input":"` + strings.Repeat("hello ", 50) + `"}`
+	resHead := "HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n"
+	resBody := `{"id":"resp_1","object":"response","status":"completed","model":"gpt-5.1","output":[{"type":"message","role":"assistant","content":[{"type":"output_text","text":"hi"}]}],"usage":{"input_tokens":1,"output_tokens":1,"total_tokens":2}}`
+	header := recordfile.RecordHeader{
+		Version: "LLM_PROXY_V3",
+		Meta: recordfile.MetaData{
+			RequestID:  requestID,
+			Time:       recordedAt,
+			Model:      "gpt-5.1",
+			Provider:   "openai_compatible",
+			Operation:  "responses",
+			Endpoint:   "/v1/responses",
+			URL:        "/v1/responses",
+			Method:     "POST",
+			StatusCode: 200,
+		},
+		Layout: recordfile.LayoutInfo{
+			ReqHeaderLen: int64(len(reqHead)),
+			ReqBodyLen:   int64(len(reqBody)),
+			ResHeaderLen: int64(len(resHead)),
+			ResBodyLen:   int64(len(resBody)),
+		},
+	}
+	prelude, err := recordfile.MarshalPrelude(header, recordfile.BuildEvents(header))
+	if err != nil {
+		t.Fatalf("MarshalPrelude() error = %v", err)
+	}
+	logPath := filepath.Join(dir, requestID+".http")
+	if err := os.WriteFile(logPath, []byte(string(prelude)+reqHead+reqBody+"\n"+resHead+resBody), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := st.UpsertLog(logPath, header); err != nil {
+		t.Fatalf("UpsertLog() error = %v", err)
+	}
+	entry, err := st.GetByRequestID(requestID)
+	if err != nil {
+		t.Fatalf("GetByRequestID() error = %v", err)
+	}
+	return entry.ID, logPath
+}
@@ -0,0 +1,252 @@
+// Package jsonpath 实现请求改写、脱敏与检测规则共用的简化 JSON 路径，
+// 例如 $.metadata.user_id、messages[*].content、$.*.api_key。
+package jsonpath
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Path 是解析后的路径，作用于 encoding/json 解码出的 map[string]any / []any。
+type Path []Segment
+
+// Segment 是路径中的一段：对象字段、数组下标或通配符 *。
+type Segment struct {
+	Key      string
+	Index    int // 数组下标；对象字段为 -1
+	Wildcard bool
+}
+
+// Fields 用对象字段名直接构造路径。
+func Fields(keys ...string) Path {
+	out := make(Path, 0, len(keys))
+	for _, key := range keys {
+		out = append(out, Segment{Key: key, Index: -1})
+	}
+	return out
+}
+
+// Parse 解析路径，开头的 $ 与 . 可省略。
+func Parse(raw string) (Path, error) {
+	text := strings.TrimSpace(raw)
+	text = strings.TrimPrefix(text, "$")
+	text = strings.TrimPrefix(text, ".")
+	if text == "" {
+		return nil, fmt.Errorf("empty path")
+	}
+
+	var out Path
+	for len(text) > 0 {
+		switch text[0] {
+		case '.':
+			text = text[1:]
+			if text == "" || text[0] == '.' || text[0] == '[' {
+				return nil, fmt.Errorf("empty field name")
+			}
+		case '[':
+			end := strings.IndexByte(text, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("missing ']'")
+			}
+			inner := strings.TrimSpace(text[1:end])
+			if inner == "*" {
+				out = append(out, Segment{Index: -1, Wildcard: true})
+			} else {
+				index, err := strconv.Atoi(inner)
+				if err != nil || index < 0 {
+					return nil, fmt.Errorf("invalid index %q", inner)
+				}
+				out = append(out, Segment{Index: index})
+			}
+			text = text[end+1:]
+		default:
+			end := strings.IndexAny(text, ".[")
+			if end < 0 {
+				end = len(text)
+			}
+			key := text[:end]
+			out = append(out, Segment{Key: key, Index: -1, Wildcard: key == "*"})
+			text = text[end:]
+		}
+	}
+	return out, nil
+}
+
+// IsIndex 返回该段是否为数组下标。
+func (s Segment) IsIndex() bool {
+	return !s.Wildcard && s.Index >= 0
+}
+
+// HasWildcard 返回路径中是否含通配符。
+func (p Path) HasWildcard() bool {
+	for _, segment := range p {
+		if segment.Wildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// Lookup 返回路径命中的全部值；对象通配符按 key 排序展开。
+func (p Path) Lookup(root any) []any {
+	current := []any{root}
+	for _, segment := range p {
+		var next []any
+		for _, value := range current {
+			switch typed := value.(type) {
+			case map[string]any:
+				if segment.Wildcard {
+					keys := make([]string, 0, len(typed))
+					for key := range typed {
+						keys = append(keys, key)
+					}
+					sort.Strings(keys)
+					for _, key := range keys {
+						next = append(next, typed[key])
+					}
+				} else if !segment.IsIndex() {
+					if child, ok := typed[segment.Key]; ok {
+						next = append(next, child)
+					}
+				}
+			case []any:
+				if segment.Wildcard {
+					next = append(next, typed...)
+				} else if segment.IsIndex() && segment.Index < len(typed) {
+					next = append(next, typed[segment.Index])
+				}
+			}
+		}
+		current = next
+	}
+	return current
+}
+
+// Get 返回不含通配符的路径指向的值。
+func (p Path) Get(root any) (any, bool) {
+	current := root
+	for _, segment := range p {
+		switch {
+		case segment.Wildcard:
+			return nil, false
+		case segment.IsIndex():
+			arr, ok := current.([]any)
+			if !ok || segment.Index >= len(arr) {
+				return nil, false
+			}
+			current = arr[segment.Index]
+		default:
+			obj, ok := current.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			if current, ok = obj[segment.Key]; !ok {
+				return nil, false
+			}
+		}
+	}
+	return current, true
+}
+
+// Set 写入值并按需创建中间对象；数组只允许覆盖已存在的下标，不支持通配符。
+func (p Path) Set(root map[string]any, value any) bool {
+	var parent any = root
+	for i, segment := range p {
+		last := i == len(p)-1
+		switch {
+		case segment.Wildcard:
+			return false
+		case segment.IsIndex():
+			arr, ok := parent.([]any)
+			if !ok || segment.Index >= len(arr) {
+				return false
+			}
+			if last {
+				arr[segment.Index] = value
+				return true
+			}
+			parent = arr[segment.Index]
+		default:
+			obj, ok := parent.(map[string]any)
+			if !ok {
+				return false
+			}
+			if last {
+				obj[segment.Key] = value
+				return true
+			}
+			next, exists := obj[segment.Key]
+			if !exists || next == nil {
+				if p[i+1].IsIndex() {
+					return false
+				}
+				next = map[string]any{}
+				obj[segment.Key] = next
+			}
+			parent = next
+		}
+	}
+	return false
+}
+
+// Remove 删除以对象字段结尾的路径，返回是否删除了值。
+func (p Path) Remove(root map[string]any) bool {
+	if len(p) == 0 {
+		return false
+	}
+	last := p[len(p)-1]
+	if last.Wildcard || last.IsIndex() {
+		return false
+	}
+	parent, ok := p[:len(p)-1].Get(root)
+	if !ok {
+		return false
+	}
+	obj, ok := parent.(map[string]any)
+	if !ok {
+		return false
+	}
+	if _, exists := obj[last.Key]; !exists {
+		return false
+	}
+	delete(obj, last.Key)
+	return true
+}
+
+// Apply 用 fn 的返回值替换路径命中的每个值，name 为最近一层对象字段名。
+func (p Path) Apply(root any, fn func(name string, value any) any) any {
+	return p.apply(root, "", fn)
+}
+
+func (p Path) apply(value any, name string, fn func(string, any) any) any {
+	if len(p) == 0 {
+		return fn(name, value)
+	}
+	segment, rest := p[0], p[1:]
+	switch v := value.(type) {
+	case map[string]any:
+		if segment.IsIndex() {
+			return v
+		}
+		for key, child := range v {
+			if segment.Wildcard || key == segment.Key {
+				v[key] = rest.apply(child, key, fn)
+			}
+		}
+		return v
+	case []any:
+		if !segment.Wildcard && !segment.IsIndex() {
+			return v
+		}
+		for i := range v {
+			if segment.Wildcard || i == segment.Index {
+				v[i] = rest.apply(v[i], name, fn)
+			}
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,104 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func decode(t *testing.T, raw string) map[string]any {
+	t.Helper()
+	var out map[string]any
+	if err := json.Unmarshal([]byte(raw), &out); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", raw, err)
+	}
+	return out
+}
+
+func TestParse(t *testing.T) {
+	path, err := Parse("$.messages[*].content[0].text")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	want := Path{
+		{Key: "messages", Index: -1},
+		{Index: -1, Wildcard: true},
+		{Key: "content", Index: -1},
+		{Index: 0},
+		{Key: "text", Index: -1},
+	}
+	if !reflect.DeepEqual(path, want) || !path.HasWildcard() {
+		t.Fatalf("Parse() = %+v", path)
+	}
+	if got, err := Parse("stream_options.include_usage"); err != nil || !reflect.DeepEqual(got, Fields("stream_options", "include_usage")) {
+		t.Fatalf("Parse(no $) = %+v, err = %v", got, err)
+	}
+	for _, raw := range []string{"", "$", "a..b", "a.[0]", "a[x]", "a[-1]", "a[0"} {
+		if _, err := Parse(raw); err == nil {
+			t.Fatalf("Parse(%q) error = nil", raw)
+		}
+	}
+}
+
+func TestLookupExpandsWildcards(t *testing.T) {
+	doc := decode(t, `{"files":[{"path":"a"},{"path":"b"},{"name":"c"}],"env":{"z":{"url":"z"},"a":{"url":"a"}}}`)
+	for raw, want := range map[string][]any{
+		"files[*].path": {"a", "b"},
+		"files[1].path": {"b"},
+		"$.env.*.url":   {"a", "z"},
+		"files[9].path": nil,
+		"missing":       nil,
+	} {
+		path, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", raw, err)
+		}
+		if got := path.Lookup(doc); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Lookup(%q) = %v, want %v", raw, got, want)
+		}
+	}
+}
+
+func TestSetAndRemove(t *testing.T) {
+	doc := decode(t, `{"messages":[{"content":"hi"}],"metadata":{"user":"u"}}`)
+	if !Fields("generationConfig", "maxOutputTokens").Set(doc, 64) {
+		t.Fatalf("Set(new object) = false")
+	}
+	index, _ := Parse("messages[0].content")
+	if !index.Set(doc, "edited") {
+		t.Fatalf("Set(messages[0].content) = false")
+	}
+	outOfRange, _ := Parse("messages[3].content")
+	missingArray, _ := Parse("tools[0].name")
+	wildcard, _ := Parse("messages[*].content")
+	if outOfRange.Set(doc, "x") || missingArray.Set(doc, "x") || wildcard.Set(doc, "x") {
+		t.Fatalf("Set() created arrays or used a wildcard: %v", doc)
+	}
+	if value, ok := index.Get(doc); !ok || value != "edited" {
+		t.Fatalf("Get() = %v, %v", value, ok)
+	}
+	if !Fields("metadata", "user").Remove(doc) || Fields("metadata", "user").Remove(doc) {
+		t.Fatalf("Remove() did not delete exactly once: %v", doc)
+	}
+	want := decode(t, `{"messages":[{"content":"edited"}],"metadata":{},"generationConfig":{"maxOutputTokens":64}}`)
+	want["generationConfig"].(map[string]any)["maxOutputTokens"] = 64
+	if !reflect.DeepEqual(doc, want) {
+		t.Fatalf("doc = %v, want %v", doc, want)
+	}
+}
+
+func TestApplyReplacesMatchesWithFieldName(t *testing.T) {
+	doc := decode(t, `{"messages":[{"api_key":"a"},{"api_key":"b"}],"api_key":"c"}`)
+	path, _ := Parse("messages[*].api_key")
+	var names []string
+	path.Apply(doc, func(name string, value any) any {
+		names = append(names, name)
+		return "[" + value.(string) + "]"
+	})
+	if !reflect.DeepEqual(names, []string{"api_key", "api_key"}) {
+		t.Fatalf("names = %v", names)
+	}
+	if got := path.Lookup(doc); !reflect.DeepEqual(got, []any{"[a]", "[b]"}) || doc["api_key"] != "c" {
+		t.Fatalf("doc = %v", doc)
+	}
+}
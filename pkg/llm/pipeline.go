@@ -363,18 +363,21 @@ func (p *ResponsePipeline) appendEvent(eventType string, message string, attrs m
 }
 
 type compatibleUsage struct {
-	PromptTokens             int                            `json:"prompt_tokens"`
-	CompletionTokens         int                            `json:"completion_tokens"`
-	TotalTokens              int                            `json:"total_tokens"`
-	InputTokens              int                            `json:"input_tokens"`
-	OutputTokens             int                            `json:"output_tokens"`
-	CacheCreationInputTokens int                            `json:"cache_creation_input_tokens"`
-	CacheReadInputTokens     int                            `json:"cache_read_input_tokens"`
-	InputTokenDetails        *recordfile.PromptTokenDetails `json:"input_tokens_details,omitempty"`
-	PromptTokenDetails       *recordfile.PromptTokenDetails `json:"prompt_tokens_details,omitempty"`
-	PromptTokenCount         int                            `json:"promptTokenCount"`
-	CandidatesTokenCount     int                            `json:"candidatesTokenCount"`
-	TotalTokenCount          int                            `json:"totalTokenCount"`
+	PromptTokens             int                                `json:"prompt_tokens"`
+	CompletionTokens         int                                `json:"completion_tokens"`
+	TotalTokens              int                                `json:"total_tokens"`
+	InputTokens              int                                `json:"input_tokens"`
+	OutputTokens             int                                `json:"output_tokens"`
+	CacheCreationInputTokens int                                `json:"cache_creation_input_tokens"`
+	CacheReadInputTokens     int                                `json:"cache_read_input_tokens"`
+	InputTokenDetails        *recordfile.PromptTokenDetails     `json:"input_tokens_details,omitempty"`
+	PromptTokenDetails       *recordfile.PromptTokenDetails     `json:"prompt_tokens_details,omitempty"`
+	CompletionTokenDetails   *recordfile.CompletionTokenDetails `json:"completion_tokens_details,omitempty"`
+	OutputTokenDetails       *recordfile.CompletionTokenDetails `json:"output_tokens_details,omitempty"`
+	PromptTokenCount         int                                `json:"promptTokenCount"`
+	CandidatesTokenCount     int                                `json:"candidatesTokenCount"`
+	TotalTokenCount          int                                `json:"totalTokenCount"`
+	ThoughtsTokenCount       int                                `json:"thoughtsTokenCount"`
 }
 
 func (u compatibleUsage) toUsageSummary() (UsageSummary, bool) {
@@ -410,11 +413,23 @@ func (u compatibleUsage) toUsageSummary() (UsageSummary, bool) {
 		return UsageSummary{}, false
 	}
 
+	completionDetails := u.CompletionTokenDetails
+	if completionDetails == nil {
+		completionDetails = u.OutputTokenDetails
+	}
+	if completionDetails == nil && u.ThoughtsTokenCount > 0 {
+		completionDetails = &recordfile.CompletionTokenDetails{ReasoningTokens: u.ThoughtsTokenCount}
+	}
+	if completionDetails != nil && completionDetails.ReasoningTokens == 0 {
+		completionDetails = nil
+	}
+
 	return UsageSummary{
-		PromptTokens:       promptTokens,
-		CompletionTokens:   completionTokens,
-		TotalTokens:        totalTokens,
-		PromptTokenDetails: promptDetails,
+		PromptTokens:           promptTokens,
+		CompletionTokens:       completionTokens,
+		TotalTokens:            totalTokens,
+		PromptTokenDetails:     promptDetails,
+		CompletionTokenDetails: completionDetails,
 	}, true
 }
 
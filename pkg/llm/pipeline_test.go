@@ -35,6 +35,27 @@ func TestExtractUsageFromJSONGoogleUsageMetadata(t *testing.T) {
 	assert.Equal(t, 10, usage.TotalTokens)
 }
 
+func TestExtractUsageFromJSONReasoningTokens(t *testing.T) {
+	usage, ok := ExtractUsageFromJSON([]byte(`{"usage":{"prompt_tokens":10,"completion_tokens":40,"total_tokens":50,"completion_tokens_details":{"reasoning_tokens":32}}}`))
+	require.True(t, ok)
+	require.NotNil(t, usage.CompletionTokenDetails)
+	assert.Equal(t, 32, usage.CompletionTokenDetails.ReasoningTokens)
+
+	usage, ok = ExtractUsageFromJSON([]byte(`{"usage":{"input_tokens":10,"output_tokens":40,"output_tokens_details":{"reasoning_tokens":12}}}`))
+	require.True(t, ok)
+	require.NotNil(t, usage.CompletionTokenDetails)
+	assert.Equal(t, 12, usage.CompletionTokenDetails.ReasoningTokens)
+
+	usage, ok = ExtractUsageFromJSON([]byte(`{"usageMetadata":{"promptTokenCount":3,"candidatesTokenCount":7,"thoughtsTokenCount":5,"totalTokenCount":15}}`))
+	require.True(t, ok)
+	require.NotNil(t, usage.CompletionTokenDetails)
+	assert.Equal(t, 5, usage.CompletionTokenDetails.ReasoningTokens)
+
+	usage, ok = ExtractUsageFromJSON([]byte(`{"usage":{"prompt_tokens":1,"completion_tokens":1,"completion_tokens_details":{"reasoning_tokens":0}}}`))
+	require.True(t, ok)
+	assert.Nil(t, usage.CompletionTokenDetails)
+}
+
 func TestResponsePipelineStream(t *testing.T) {
 	pipeline := NewResponsePipeline(ProviderOpenAICompatible, "/v1/responses", true)
 	pipeline.Feed([]byte("event: response.completed\n"))
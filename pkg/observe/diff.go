@@ -0,0 +1,495 @@
+package observe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	DiffSame    DiffStatus = "same"
+	DiffAdded   DiffStatus = "added"
+	DiffRemoved DiffStatus = "removed"
+	DiffChanged DiffStatus = "changed"
+
+	DiffSectionInstructions     = "instructions"
+	DiffSectionMessages         = "messages"
+	DiffSectionToolDeclarations = "tool_declarations"
+	DiffSectionToolCalls        = "tool_calls"
+	DiffSectionOutputs          = "outputs"
+	DiffSectionFinishReasons    = "finish_reasons"
+	DiffSectionUsage            = "usage"
+	DiffSectionTimings          = "timings"
+)
+
+type DiffStatus string
+
+// TraceDiff 是两条 trace 在语义层面的对比结果，按 section 对齐而不是逐字节比较。
+// Identical 只看内容类 section；usage 与耗时几乎每次都会变化，单独由 PerformanceChanged 表示。
+type TraceDiff struct {
+	A                  TraceDiffSide `json:"a"`
+	B                  TraceDiffSide `json:"b"`
+	Identical          bool          `json:"identical"`
+	PerformanceChanged bool          `json:"performance_changed"`
+	Sections           []DiffSection `json:"sections"`
+}
+
+type TraceDiffSide struct {
+	TraceID   string `json:"trace_id,omitempty"`
+	Provider  string `json:"provider,omitempty"`
+	Operation string `json:"operation,omitempty"`
+	Endpoint  string `json:"endpoint,omitempty"`
+	Model     string `json:"model,omitempty"`
+}
+
+type DiffSection struct {
+	Name    string     `json:"name"`
+	Changed bool       `json:"changed"`
+	Items   []DiffItem `json:"items"`
+}
+
+type DiffItem struct {
+	Key     string        `json:"key"`
+	Status  DiffStatus    `json:"status"`
+	A       string        `json:"a,omitempty"`
+	B       string        `json:"b,omitempty"`
+	Delta   *float64      `json:"delta,omitempty"`
+	Changes []FieldChange `json:"changes,omitempty"`
+}
+
+// FieldChange 描述结构化 JSON（工具参数、schema）中单个路径上的差异。
+type FieldChange struct {
+	Path   string     `json:"path"`
+	Status DiffStatus `json:"status"`
+	A      any        `json:"a,omitempty"`
+	B      any        `json:"b,omitempty"`
+}
+
+// DiffObservations 对齐两条 observation 的指令、消息、工具声明、工具调用、输出、
+// finish reason、usage 与耗时，生成语义 diff。
+func DiffObservations(a, b TraceObservation) TraceDiff {
+	diff := TraceDiff{
+		A: diffSide(a),
+		B: diffSide(b),
+		Sections: []DiffSection{
+			newDiffSection(DiffSectionInstructions, diffTextSequence("instruction", nodeEntries(a.Request.Instructions), nodeEntries(b.Request.Instructions))),
+			newDiffSection(DiffSectionMessages, diffTextSequence("message", requestMessageEntries(a), requestMessageEntries(b))),
+			newDiffSection(DiffSectionToolDeclarations, diffToolDeclarations(a.Tools.Declarations, b.Tools.Declarations)),
+			newDiffSection(DiffSectionToolCalls, diffToolCalls(a.Tools.Calls, b.Tools.Calls)),
+			newDiffSection(DiffSectionOutputs, diffTextSequence("output", outputEntries(a), outputEntries(b))),
+			newDiffSection(DiffSectionFinishReasons, diffValueList("choice", FinishReasons(a), FinishReasons(b))),
+			newDiffSection(DiffSectionUsage, diffNumbers([]diffNumber{
+				{"input_tokens", float64(a.Usage.InputTokens), float64(b.Usage.InputTokens)},
+				{"output_tokens", float64(a.Usage.OutputTokens), float64(b.Usage.OutputTokens)},
+				{"total_tokens", float64(a.Usage.TotalTokens), float64(b.Usage.TotalTokens)},
+				{"reasoning_tokens", float64(a.Usage.ReasoningTokens), float64(b.Usage.ReasoningTokens)},
+				{"cache_creation_tokens", float64(a.Usage.CacheCreationTokens), float64(b.Usage.CacheCreationTokens)},
+				{"cache_read_tokens", float64(a.Usage.CacheReadTokens), float64(b.Usage.CacheReadTokens)},
+			})),
+			newDiffSection(DiffSectionTimings, diffNumbers([]diffNumber{
+				{"duration_ms", float64(a.Timings.DurationMs), float64(b.Timings.DurationMs)},
+				{"ttft_ms", float64(a.Timings.TTFTMs), float64(b.Timings.TTFTMs)},
+				{"tokens_per_sec", a.Timings.TokensPerSec, b.Timings.TokensPerSec},
+			})),
+		},
+	}
+	diff.Identical = true
+	for _, section := range diff.Sections {
+		if !section.Changed {
+			continue
+		}
+		switch section.Name {
+		case DiffSectionUsage, DiffSectionTimings:
+			diff.PerformanceChanged = true
+		default:
+			diff.Identical = false
+		}
+	}
+	return diff
+}
+
+func diffSide(obs TraceObservation) TraceDiffSide {
+	return TraceDiffSide{
+		TraceID:   obs.TraceID,
+		Provider:  obs.Provider,
+		Operation: obs.Operation,
+		Endpoint:  obs.Endpoint,
+		Model:     obs.Model,
+	}
+}
+
+func newDiffSection(name string, items []DiffItem) DiffSection {
+	section := DiffSection{Name: name, Items: items}
+	if section.Items == nil {
+		section.Items = []DiffItem{}
+	}
+	for _, item := range items {
+		if item.Status != DiffSame {
+			section.Changed = true
+			break
+		}
+	}
+	return section
+}
+
+type diffEntry struct {
+	label string
+	text  string
+}
+
+func (e diffEntry) render() string {
+	if e.label == "" {
+		return e.text
+	}
+	return e.label + ": " + e.text
+}
+
+func nodeEntries(nodes []SemanticNode) []diffEntry {
+	entries := make([]diffEntry, 0, len(nodes))
+	for _, node := range nodes {
+		entries = append(entries, diffEntry{label: node.Role, text: diffNodeText(node)})
+	}
+	return entries
+}
+
+func requestMessageEntries(obs TraceObservation) []diffEntry {
+	entries := nodeEntries(obs.Request.Messages)
+	return append(entries, nodeEntries(obs.Request.Inputs)...)
+}
+
+func outputEntries(obs TraceObservation) []diffEntry {
+	nodes := obs.Response.Candidates
+	if len(nodes) == 0 {
+		nodes = obs.Response.Outputs
+	}
+	var entries []diffEntry
+	for _, node := range nodes {
+		if isDiffToolCallNode(node) {
+			continue
+		}
+		entries = append(entries, diffEntry{label: node.Role, text: diffNodeText(node)})
+	}
+	if len(entries) == 0 && obs.Stream.AccumulatedText != "" {
+		entries = append(entries, diffEntry{label: "assistant", text: obs.Stream.AccumulatedText})
+	}
+	return entries
+}
+
+func isDiffToolCallNode(node SemanticNode) bool {
+	return node.NormalizedType == NodeToolCall || node.NormalizedType == NodeServerToolCall || node.NormalizedType == NodeToolCallDelta
+}
+
+// diffNodeText 拼接节点子树里的文本；工具调用节点由 tool_calls section 单独对比。
+func diffNodeText(node SemanticNode) string {
+	if len(node.Children) == 0 {
+		return node.Text
+	}
+	var parts []string
+	for _, child := range node.Children {
+		if isDiffToolCallNode(child) {
+			continue
+		}
+		if text := diffNodeText(child); text != "" {
+			parts = append(parts, text)
+		}
+	}
+	if len(parts) == 0 {
+		return node.Text
+	}
+	return strings.Join(parts, "\n")
+}
+
+// diffTextSequence 用最长公共子序列对齐两个文本序列，插入一条消息不会让后续消息全部显示为变更。
+// 相邻的删除和新增会配对成 changed。
+func diffTextSequence(prefix string, a, b []diffEntry) []DiffItem {
+	n, m := len(a), len(b)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var items []DiffItem
+	var removed, added []int
+	flush := func() {
+		paired := min(len(removed), len(added))
+		for k := 0; k < paired; k++ {
+			items = append(items, DiffItem{
+				Key:    sequenceKey(prefix, removed[k], added[k]),
+				Status: DiffChanged,
+				A:      a[removed[k]].render(),
+				B:      b[added[k]].render(),
+			})
+		}
+		for _, i := range removed[paired:] {
+			items = append(items, DiffItem{Key: sequenceKey(prefix, i, -1), Status: DiffRemoved, A: a[i].render()})
+		}
+		for _, j := range added[paired:] {
+			items = append(items, DiffItem{Key: sequenceKey(prefix, -1, j), Status: DiffAdded, B: b[j].render()})
+		}
+		removed, added = removed[:0], added[:0]
+	}
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && a[i] == b[j]:
+			flush()
+			items = append(items, DiffItem{Key: sequenceKey(prefix, i, j), Status: DiffSame, A: a[i].render(), B: b[j].render()})
+			i++
+			j++
+		case j < m && (i == n || lcs[i][j+1] >= lcs[i+1][j]):
+			added = append(added, j)
+			j++
+		default:
+			removed = append(removed, i)
+			i++
+		}
+	}
+	flush()
+	return items
+}
+
+func sequenceKey(prefix string, i, j int) string {
+	switch {
+	case i == j:
+		return fmt.Sprintf("%s[%d]", prefix, i)
+	case j < 0:
+		return fmt.Sprintf("%s[%d]", prefix, i)
+	case i < 0:
+		return fmt.Sprintf("%s[%d]", prefix, j)
+	default:
+		return fmt.Sprintf("%s[%d→%d]", prefix, i, j)
+	}
+}
+
+func diffToolDeclarations(a, b []ToolDeclaration) []DiffItem {
+	left := make(map[string]ToolDeclaration, len(a))
+	right := make(map[string]ToolDeclaration, len(b))
+	for _, decl := range a {
+		left[decl.Name] = decl
+	}
+	for _, decl := range b {
+		right[decl.Name] = decl
+	}
+	var items []DiffItem
+	for _, name := range unionKeys(left, right) {
+		da, inA := left[name]
+		db, inB := right[name]
+		switch {
+		case !inB:
+			items = append(items, DiffItem{Key: name, Status: DiffRemoved, A: da.Description})
+		case !inA:
+			items = append(items, DiffItem{Key: name, Status: DiffAdded, B: db.Description})
+		default:
+			item := DiffItem{Key: name, Status: DiffSame, A: da.Description, B: db.Description}
+			if da.Description != db.Description {
+				item.Changes = append(item.Changes, FieldChange{Path: "description", Status: DiffChanged, A: da.Description, B: db.Description})
+			}
+			item.Changes = append(item.Changes, diffJSONValues("schema", decodeDiffJSON(da.Schema), decodeDiffJSON(db.Schema))...)
+			if len(item.Changes) > 0 {
+				item.Status = DiffChanged
+			}
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// diffToolCalls 按“工具名 + 第 n 次调用”对齐调用，参数按 JSON 结构逐路径比较。
+func diffToolCalls(a, b []ToolCallObservation) []DiffItem {
+	left := indexToolCalls(a)
+	right := indexToolCalls(b)
+	var items []DiffItem
+	for _, key := range unionKeys(left, right) {
+		ca, inA := left[key]
+		cb, inB := right[key]
+		switch {
+		case !inB:
+			items = append(items, DiffItem{Key: key, Status: DiffRemoved, A: toolCallArgs(ca)})
+		case !inA:
+			items = append(items, DiffItem{Key: key, Status: DiffAdded, B: toolCallArgs(cb)})
+		default:
+			item := DiffItem{Key: key, Status: DiffSame, A: toolCallArgs(ca), B: toolCallArgs(cb)}
+			item.Changes = diffJSONValues("args", toolCallValue(ca), toolCallValue(cb))
+			if len(item.Changes) > 0 {
+				item.Status = DiffChanged
+			}
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func indexToolCalls(calls []ToolCallObservation) map[string]ToolCallObservation {
+	seen := map[string]int{}
+	out := make(map[string]ToolCallObservation, len(calls))
+	for _, call := range calls {
+		key := fmt.Sprintf("%s#%d", call.Name, seen[call.Name])
+		seen[call.Name]++
+		out[key] = call
+	}
+	return out
+}
+
+func toolCallArgs(call ToolCallObservation) string {
+	if len(call.ArgsJSON) > 0 {
+		return string(call.ArgsJSON)
+	}
+	return call.ArgsText
+}
+
+func toolCallValue(call ToolCallObservation) any {
+	if value := decodeDiffJSON(call.ArgsJSON); value != nil {
+		return value
+	}
+	if value := decodeDiffJSON(json.RawMessage(call.ArgsText)); value != nil {
+		return value
+	}
+	if call.ArgsText == "" {
+		return nil
+	}
+	return call.ArgsText
+}
+
+func decodeDiffJSON(raw json.RawMessage) any {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil
+	}
+	var value any
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil
+	}
+	return value
+}
+
+func diffJSONValues(path string, a, b any) []FieldChange {
+	switch {
+	case a == nil && b == nil:
+		return nil
+	case a == nil:
+		return []FieldChange{{Path: path, Status: DiffAdded, B: b}}
+	case b == nil:
+		return []FieldChange{{Path: path, Status: DiffRemoved, A: a}}
+	}
+	if ma, ok := a.(map[string]any); ok {
+		if mb, ok := b.(map[string]any); ok {
+			var changes []FieldChange
+			for _, key := range unionKeys(ma, mb) {
+				changes = append(changes, diffJSONValues(path+"."+key, ma[key], mb[key])...)
+			}
+			return changes
+		}
+	}
+	if la, ok := a.([]any); ok {
+		if lb, ok := b.([]any); ok {
+			var changes []FieldChange
+			for i := 0; i < max(len(la), len(lb)); i++ {
+				var va, vb any
+				if i < len(la) {
+					va = la[i]
+				}
+				if i < len(lb) {
+					vb = lb[i]
+				}
+				changes = append(changes, diffJSONValues(path+"["+strconv.Itoa(i)+"]", va, vb)...)
+			}
+			return changes
+		}
+	}
+	if reflect.DeepEqual(a, b) {
+		return nil
+	}
+	return []FieldChange{{Path: path, Status: DiffChanged, A: a, B: b}}
+}
+
+func unionKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// FinishReasons 从各 provider 的响应节点 metadata 中收集结束原因。
+func FinishReasons(obs TraceObservation) []string {
+	var reasons []string
+	var walk func([]SemanticNode)
+	walk = func(nodes []SemanticNode) {
+		for _, node := range nodes {
+			for _, key := range []string{"finish_reason", "stop_reason", "finishReason"} {
+				if value, ok := node.Metadata[key].(string); ok && value != "" {
+					reasons = append(reasons, value)
+				}
+			}
+			walk(node.Children)
+		}
+	}
+	walk(obs.Response.Nodes)
+	return reasons
+}
+
+func diffValueList(prefix string, a, b []string) []DiffItem {
+	var items []DiffItem
+	for i := 0; i < max(len(a), len(b)); i++ {
+		key := fmt.Sprintf("%s[%d]", prefix, i)
+		switch {
+		case i >= len(b):
+			items = append(items, DiffItem{Key: key, Status: DiffRemoved, A: a[i]})
+		case i >= len(a):
+			items = append(items, DiffItem{Key: key, Status: DiffAdded, B: b[i]})
+		case a[i] == b[i]:
+			items = append(items, DiffItem{Key: key, Status: DiffSame, A: a[i], B: b[i]})
+		default:
+			items = append(items, DiffItem{Key: key, Status: DiffChanged, A: a[i], B: b[i]})
+		}
+	}
+	return items
+}
+
+type diffNumber struct {
+	key  string
+	a, b float64
+}
+
+func diffNumbers(values []diffNumber) []DiffItem {
+	items := make([]DiffItem, 0, len(values))
+	for _, value := range values {
+		if value.a == 0 && value.b == 0 {
+			continue
+		}
+		delta := value.b - value.a
+		item := DiffItem{
+			Key:    value.key,
+			Status: DiffSame,
+			A:      strconv.FormatFloat(value.a, 'f', -1, 64),
+			B:      strconv.FormatFloat(value.b, 'f', -1, 64),
+			Delta:  &delta,
+		}
+		if delta != 0 {
+			item.Status = DiffChanged
+		}
+		items = append(items, item)
+	}
+	return items
+}
@@ -0,0 +1,103 @@
+package observe
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDiffObservationsAlignsSections(t *testing.T) {
+	message := func(role, text string) SemanticNode {
+		return SemanticNode{NormalizedType: NodeMessage, Role: role, Text: text}
+	}
+	choiceResponse := func(finishReason string) ObservationResponse {
+		choice := SemanticNode{
+			NormalizedType: NodeMessage,
+			Role:           "assistant",
+			Metadata:       map[string]any{"finish_reason": finishReason},
+			Children:       []SemanticNode{{NormalizedType: NodeText, Text: "done"}},
+		}
+		return ObservationResponse{Candidates: []SemanticNode{choice}, Nodes: []SemanticNode{choice}}
+	}
+	a := TraceObservation{
+		TraceID: "a",
+		Model:   "gpt-4o",
+		Request: ObservationRequest{
+			Instructions: []SemanticNode{message("system", "You are terse.")},
+			Messages:     []SemanticNode{message("user", "hello"), message("user", "list files")},
+		},
+		Response: choiceResponse("stop"),
+		Tools: ObservationTools{
+			Declarations: []ToolDeclaration{{Name: "shell", Schema: json.RawMessage(`{"type":"object","required":["cmd"]}`)}},
+			Calls:        []ToolCallObservation{{Name: "shell", ArgsText: `{"cmd":"ls","flags":["-l"]}`}},
+		},
+		Usage:   ObservationUsage{InputTokens: 10, OutputTokens: 5, TotalTokens: 15},
+		Timings: ObservationTimings{DurationMs: 300},
+	}
+	b := a
+	b.TraceID = "b"
+	b.Request = ObservationRequest{
+		Instructions: []SemanticNode{message("system", "You are terse.")},
+		Messages:     []SemanticNode{message("user", "hello"), message("assistant", "hi"), message("user", "list files")},
+	}
+	b.Response = choiceResponse("tool_calls")
+	b.Tools = ObservationTools{
+		Declarations: []ToolDeclaration{
+			{Name: "shell", Schema: json.RawMessage(`{"required":["cmd"],"type":"object"}`)},
+			{Name: "read_file"},
+		},
+		Calls: []ToolCallObservation{{Name: "shell", ArgsJSON: json.RawMessage(`{"flags":["-la"],"cmd":"ls"}`)}},
+	}
+	b.Usage = ObservationUsage{InputTokens: 12, OutputTokens: 5, TotalTokens: 17}
+
+	diff := DiffObservations(a, b)
+	if diff.Identical || diff.A.TraceID != "a" || diff.B.TraceID != "b" {
+		t.Fatalf("DiffObservations() = %+v", diff)
+	}
+	sections := map[string]DiffSection{}
+	for _, section := range diff.Sections {
+		sections[section.Name] = section
+	}
+	if sections[DiffSectionInstructions].Changed || sections[DiffSectionOutputs].Changed || sections[DiffSectionTimings].Changed {
+		t.Fatalf("unchanged sections reported as changed: %+v", diff.Sections)
+	}
+
+	messages := sections[DiffSectionMessages].Items
+	if len(messages) != 3 || messages[0].Status != DiffSame || messages[1].Status != DiffAdded || messages[1].B != "assistant: hi" || messages[2].Status != DiffSame || messages[2].Key != "message[1→2]" {
+		t.Fatalf("messages = %+v", messages)
+	}
+
+	decls := sections[DiffSectionToolDeclarations].Items
+	if len(decls) != 2 || decls[0].Key != "read_file" || decls[0].Status != DiffAdded || decls[1].Key != "shell" || decls[1].Status != DiffSame {
+		t.Fatalf("tool declarations = %+v", decls)
+	}
+
+	calls := sections[DiffSectionToolCalls].Items
+	if len(calls) != 1 || calls[0].Key != "shell#0" || calls[0].Status != DiffChanged {
+		t.Fatalf("tool calls = %+v", calls)
+	}
+	if len(calls[0].Changes) != 1 || calls[0].Changes[0].Path != "args.flags[0]" || calls[0].Changes[0].A != "-l" || calls[0].Changes[0].B != "-la" {
+		t.Fatalf("tool call changes = %+v", calls[0].Changes)
+	}
+
+	finish := sections[DiffSectionFinishReasons].Items
+	if len(finish) != 1 || finish[0].Status != DiffChanged || finish[0].A != "stop" || finish[0].B != "tool_calls" {
+		t.Fatalf("finish reasons = %+v", finish)
+	}
+
+	usage := sections[DiffSectionUsage].Items
+	if len(usage) != 3 || usage[0].Key != "input_tokens" || usage[0].Status != DiffChanged || *usage[0].Delta != 2 || usage[1].Status != DiffSame {
+		t.Fatalf("usage = %+v", usage)
+	}
+
+	if same := DiffObservations(a, a); !same.Identical || same.PerformanceChanged {
+		t.Fatalf("DiffObservations(a, a) = %+v", same)
+	}
+
+	// 同样的内容仅耗时与 usage 不同，仍视为语义一致。
+	slower := a
+	slower.Usage.OutputTokens = 7
+	slower.Timings = ObservationTimings{DurationMs: 900, TTFTMs: 120}
+	if perf := DiffObservations(a, slower); !perf.Identical || !perf.PerformanceChanged {
+		t.Fatalf("DiffObservations(a, slower) identical=%v performance_changed=%v", perf.Identical, perf.PerformanceChanged)
+	}
+}
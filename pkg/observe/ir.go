@@ -228,6 +228,7 @@ type SafetySignal struct {
 type Finding struct {
 	ID              string    `json:"id"`
 	TraceID         string    `json:"trace_id,omitempty"`
+	SessionID       string    `json:"session_id,omitempty"`
 	Category        string    `json:"category"`
 	Severity        Severity  `json:"severity"`
 	Confidence      float64   `json:"confidence"`
@@ -239,6 +240,10 @@ type Finding struct {
 	Detector        string    `json:"detector"`
 	DetectorVersion string    `json:"detector_version"`
 	CreatedAt       time.Time `json:"created_at,omitempty"`
+	// Status、Fingerprint 与 SuppressionID 由存储层维护，记录 finding 的处置状态。
+	Status        string `json:"status,omitempty"`
+	Fingerprint   string `json:"fingerprint,omitempty"`
+	SuppressionID string `json:"suppression_id,omitempty"`
 }
 
 type RawReferences struct {
@@ -0,0 +1,188 @@
+package recordfile
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	FileExt = ".http"
+
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// IsRecordPath 判断文件名是否为 cassette：未压缩的 .http 或冷存储的 .http.gz / .http.zst。
+func IsRecordPath(name string) bool {
+	return strings.HasSuffix(name, FileExt) ||
+		strings.HasSuffix(name, FileExt+".gz") ||
+		strings.HasSuffix(name, FileExt+".zst")
+}
+
+// CompressionFromPath 按后缀返回压缩算法，未压缩时为空。
+func CompressionFromPath(path string) string {
+	switch {
+	case strings.HasSuffix(path, ".zst"):
+		return CompressionZstd
+	case strings.HasSuffix(path, ".gz"):
+		return CompressionGzip
+	default:
+		return ""
+	}
+}
+
+// CompressedPath 返回 path 以 algorithm 压缩后的文件名。
+func CompressedPath(path string, algorithm string) (string, error) {
+	switch algorithm {
+	case CompressionZstd:
+		return path + ".zst", nil
+	case CompressionGzip:
+		return path + ".gz", nil
+	default:
+		return "", fmt.Errorf("unsupported cassette compression %q", algorithm)
+	}
+}
+
+// PlainName 去掉压缩后缀，用于下载等需要原始 .http 文件名的场景。
+func PlainName(path string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(filepath.Base(path), ".zst"), ".gz")
+}
+
+// Open 打开 cassette 并按文件头透明解压，返回的 reader 以流式方式读取，不会整体解压到磁盘或内存。
+func Open(path string) (io.ReadCloser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	br := bufio.NewReader(f)
+	head, _ := br.Peek(len(zstdMagic))
+	switch {
+	case bytes.HasPrefix(head, zstdMagic):
+		dec, err := zstd.NewReader(br, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		return &decompressReader{Reader: dec, close: func() error { dec.Close(); return f.Close() }}, nil
+	case bytes.HasPrefix(head, gzipMagic):
+		dec, err := gzip.NewReader(br)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		return &decompressReader{Reader: dec, close: func() error { dec.Close(); return f.Close() }}, nil
+	default:
+		return &decompressReader{Reader: br, close: f.Close}, nil
+	}
+}
+
+// ReadFile 读取完整的 cassette 内容，压缩文件会在内存中解压。
+func ReadFile(path string) ([]byte, error) {
+	if CompressionFromPath(path) == "" {
+		return os.ReadFile(path)
+	}
+	r, err := Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// WriteFile 按 path 的后缀压缩写入，先写临时文件再 rename，避免读者看到半截文件。
+func WriteFile(path string, content []byte, perm os.FileMode) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+	if err := writeCompressed(f, content, CompressionFromPath(path)); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+// CompressFile 把未压缩的 cassette 压缩为 path+后缀并保留修改时间，返回新路径。
+// 原文件不会被删除：调用方应先把索引切换到新路径，再移除原文件。
+func CompressFile(path string, algorithm string) (string, error) {
+	if CompressionFromPath(path) != "" {
+		return "", fmt.Errorf("cassette %s is already compressed", path)
+	}
+	target, err := CompressedPath(path, algorithm)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	if err := WriteFile(target, content, info.Mode().Perm()); err != nil {
+		return "", err
+	}
+	if err := os.Chtimes(target, info.ModTime(), info.ModTime()); err != nil {
+		return "", err
+	}
+	return target, nil
+}
+
+func writeCompressed(w io.Writer, content []byte, algorithm string) error {
+	switch algorithm {
+	case "":
+		_, err := w.Write(content)
+		return err
+	case CompressionGzip:
+		enc := gzip.NewWriter(w)
+		if _, err := enc.Write(content); err != nil {
+			return err
+		}
+		return enc.Close()
+	case CompressionZstd:
+		enc, err := zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return err
+		}
+		if _, err := enc.Write(content); err != nil {
+			enc.Close()
+			return err
+		}
+		return enc.Close()
+	default:
+		return fmt.Errorf("unsupported cassette compression %q", algorithm)
+	}
+}
+
+type decompressReader struct {
+	io.Reader
+	close func() error
+}
+
+func (r *decompressReader) Close() error {
+	return r.close()
+}
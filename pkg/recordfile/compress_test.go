@@ -0,0 +1,49 @@
+package recordfile
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressFileRoundTrip(t *testing.T) {
+	content := []byte(FileMagic + "\n# meta: {}\n\nPOST / HTTP/1.1\r\n\r\n")
+	for _, algorithm := range []string{CompressionGzip, CompressionZstd} {
+		t.Run(algorithm, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "trace.http")
+			require.NoError(t, os.WriteFile(path, content, 0o640))
+			modTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+			require.NoError(t, os.Chtimes(path, modTime, modTime))
+
+			target, err := CompressFile(path, algorithm)
+			require.NoError(t, err)
+			assert.True(t, IsRecordPath(target))
+			assert.Equal(t, algorithm, CompressionFromPath(target))
+			assert.Equal(t, "trace.http", PlainName(target))
+
+			info, err := os.Stat(target)
+			require.NoError(t, err)
+			assert.True(t, info.ModTime().Equal(modTime))
+			assert.Less(t, info.Size(), int64(len(content))+64)
+
+			got, err := ReadFile(target)
+			require.NoError(t, err)
+			assert.Equal(t, content, got)
+
+			r, err := Open(target)
+			require.NoError(t, err)
+			streamed, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+			assert.Equal(t, content, streamed)
+
+			_, err = CompressFile(target, algorithm)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -18,11 +18,16 @@ type PromptTokenDetails struct {
 	CachedTokens int `json:"cached_tokens"`
 }
 
+type CompletionTokenDetails struct {
+	ReasoningTokens int `json:"reasoning_tokens"`
+}
+
 type UsageInfo struct {
-	PromptTokens       int                 `json:"prompt_tokens"`
-	CompletionTokens   int                 `json:"completion_tokens"`
-	TotalTokens        int                 `json:"total_tokens"`
-	PromptTokenDetails *PromptTokenDetails `json:"prompt_tokens_details,omitempty"`
+	PromptTokens           int                     `json:"prompt_tokens"`
+	CompletionTokens       int                     `json:"completion_tokens"`
+	TotalTokens            int                     `json:"total_tokens"`
+	PromptTokenDetails     *PromptTokenDetails     `json:"prompt_tokens_details,omitempty"`
+	CompletionTokenDetails *CompletionTokenDetails `json:"completion_tokens_details,omitempty"`
 }
 
 type LayoutInfo struct {
@@ -55,6 +60,13 @@ type MetaData struct {
 	RoutingScore                   float64   `json:"routing_score,omitempty"`
 	RoutingCandidateCount          int       `json:"routing_candidate_count,omitempty"`
 	RoutingFailureReason           string    `json:"routing_failure_reason,omitempty"`
+	RerunOf                        string    `json:"rerun_of,omitempty"`
+	TokenName                      string    `json:"token_name,omitempty"`
+	W3CTraceID                     string    `json:"w3c_trace_id,omitempty"`
+	W3CSpanID                      string    `json:"w3c_span_id,omitempty"`
+	W3CParentSpanID                string    `json:"w3c_parent_span_id,omitempty"`
+	// ClientCanceled 表示客户端在响应完成前断开；这不是调用失败，因此不写入 Error。
+	ClientCanceled bool `json:"client_canceled,omitempty"`
 }
 
 type RecordHeader struct {
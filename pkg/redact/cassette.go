@@ -0,0 +1,145 @@
+package redact
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kingfs/llm-tracelab/pkg/recordfile"
+)
+
+// Payload 脱敏 cassette 的 payload 部分（请求头、请求体、分隔换行、响应头、响应体），
+// 返回新的 payload 与同步后的 LayoutInfo。头中的 Content-Length 会按脱敏后的 body 长度更新，
+// 以便 pkg/replay 仍能按原协议读回响应。
+func (p *Policy) Payload(payload []byte, layout recordfile.LayoutInfo) ([]byte, recordfile.LayoutInfo, bool) {
+	if !p.Enabled() {
+		return payload, layout, false
+	}
+	reqEnd := layout.ReqHeaderLen + layout.ReqBodyLen
+	if layout.ReqHeaderLen < 0 || layout.ReqBodyLen < 0 || reqEnd > int64(len(payload)) {
+		return payload, layout, false
+	}
+
+	reqHeader, reqBody, reqChanged := p.section(payload[:layout.ReqHeaderLen], payload[layout.ReqHeaderLen:reqEnd])
+	out := make([]byte, 0, len(payload))
+	out = append(out, reqHeader...)
+	out = append(out, reqBody...)
+	layout.ReqHeaderLen = int64(len(reqHeader))
+	layout.ReqBodyLen = int64(len(reqBody))
+	changed := reqChanged
+
+	if reqEnd < int64(len(payload)) {
+		// 请求与响应之间的分隔换行。
+		out = append(out, payload[reqEnd])
+		response := payload[reqEnd+1:]
+		resHeaderLen := min(max(layout.ResHeaderLen, 0), int64(len(response)))
+		resHeader, resBody, resChanged := p.section(response[:resHeaderLen], response[resHeaderLen:])
+		out = append(out, resHeader...)
+		out = append(out, resBody...)
+		layout.ResHeaderLen = int64(len(resHeader))
+		layout.ResBodyLen += int64(len(resBody)) - int64(len(response)-int(resHeaderLen))
+		changed = changed || resChanged
+	}
+
+	if !changed {
+		return payload, layout, false
+	}
+	return out, layout, true
+}
+
+// section 脱敏一段 HTTP 消息。压缩编码的 body 无法可靠地按文本处理，保持原样。
+func (p *Policy) section(header, body []byte) ([]byte, []byte, bool) {
+	newHeader, headerChanged := p.HeaderBlock(header)
+	if encoding := headerValue(header, "Content-Encoding"); encoding != "" && !strings.EqualFold(encoding, "identity") {
+		return newHeader, body, headerChanged
+	}
+	newBody, bodyChanged := p.Body(body)
+	if bodyChanged {
+		newHeader = setContentLength(newHeader, len(newBody))
+	}
+	return newHeader, newBody, headerChanged || bodyChanged
+}
+
+// Events 脱敏事件属性中保存的原始请求体（例如 request.rewrite 的 original_body）。
+func (p *Policy) Events(events []recordfile.RecordEvent) bool {
+	if !p.Enabled() {
+		return false
+	}
+	changed := false
+	for i := range events {
+		raw, ok := events[i].Attributes["original_body"].(string)
+		if !ok || raw == "" {
+			continue
+		}
+		out, bodyChanged := p.Body([]byte(raw))
+		if !bodyChanged {
+			continue
+		}
+		events[i].Attributes["original_body"] = string(out)
+		if _, ok := events[i].Attributes["original_body_bytes"]; ok {
+			events[i].Attributes["original_body_bytes"] = len(out)
+		}
+		changed = true
+	}
+	return changed
+}
+
+// Cassette 脱敏一个完整的 V3 cassette 文件，并重写 prelude 中的 layout 与 request/response 事件长度。
+func (p *Policy) Cassette(content []byte) ([]byte, bool, error) {
+	if !bytes.HasPrefix(content, []byte(recordfile.FileMagic)) {
+		return nil, false, fmt.Errorf("not a v3 cassette; run migrate first")
+	}
+	parsed, err := recordfile.ParsePrelude(content)
+	if err != nil {
+		return nil, false, err
+	}
+	payload, layout, payloadChanged := p.Payload(content[parsed.PayloadOffset:], parsed.Header.Layout)
+	eventsChanged := p.Events(parsed.Events)
+	if !payloadChanged && !eventsChanged {
+		return content, false, nil
+	}
+
+	parsed.Header.Layout = layout
+	for i := range parsed.Events {
+		switch parsed.Events[i].Type {
+		case "request":
+			parsed.Events[i].HeaderBytes = layout.ReqHeaderLen
+			parsed.Events[i].BodyBytes = layout.ReqBodyLen
+		case "response":
+			parsed.Events[i].HeaderBytes = layout.ResHeaderLen
+			parsed.Events[i].BodyBytes = layout.ResBodyLen
+		}
+	}
+	prelude, err := recordfile.MarshalPrelude(parsed.Header, parsed.Events)
+	if err != nil {
+		return nil, false, err
+	}
+	return append(prelude, payload...), true, nil
+}
+
+func headerValue(block []byte, name string) string {
+	for _, line := range bytes.Split(block, []byte("\n")) {
+		key, value, ok := bytes.Cut(bytes.TrimRight(line, "\r"), []byte(":"))
+		if ok && strings.EqualFold(string(bytes.TrimSpace(key)), name) {
+			return string(bytes.TrimSpace(value))
+		}
+	}
+	return ""
+}
+
+// setContentLength 只更新已存在的 Content-Length 头，不为分块或流式响应补写长度。
+func setContentLength(block []byte, n int) []byte {
+	lines := bytes.SplitAfter(block, []byte("\n"))
+	for i := 1; i < len(lines); i++ {
+		content := bytes.TrimRight(lines[i], "\r\n")
+		key, _, ok := bytes.Cut(content, []byte(":"))
+		if !ok || http.CanonicalHeaderKey(string(bytes.TrimSpace(key))) != "Content-Length" {
+			continue
+		}
+		lines[i] = append([]byte("Content-Length: "+strconv.Itoa(n)), lines[i][len(content):]...)
+		return bytes.Join(lines, nil)
+	}
+	return block
+}
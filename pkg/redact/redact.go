@@ -0,0 +1,307 @@
+// Package redact 对录制的 cassette 做可配置脱敏：按头名称、JSON 路径与正则替换敏感值。
+//
+// 默认替换方式为带密钥的哈希占位符，相同的原始值总是得到相同的占位符，
+// 因此脱敏后的 cassette 仍可用于会话分组、diff 等依赖等值比较的场景。
+package redact
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kingfs/llm-tracelab/pkg/jsonpath"
+)
+
+const (
+	ReplacementHash = "hash"
+	ReplacementMask = "mask"
+)
+
+var placeholderRe = regexp.MustCompile(`\[REDACTED:[^\]\n]*\]`)
+
+// Pattern 是一条正则规则；Validate 非空时只替换校验通过的命中。
+type Pattern struct {
+	Name     string
+	Regexp   *regexp.Regexp
+	Validate func(string) bool
+}
+
+type Options struct {
+	Headers     []string
+	JSONPaths   []string
+	Patterns    []Pattern
+	Replacement string // "hash"（默认）或 "mask"
+	HashKey     string // 哈希占位符使用的 HMAC 密钥，为空时退化为普通 sha256
+}
+
+// Policy 是编译后的脱敏策略，可安全地并发使用。
+type Policy struct {
+	headers     map[string]bool
+	paths       []jsonpath.Path
+	patterns    []Pattern
+	replacement string
+	key         []byte
+}
+
+func New(opts Options) (*Policy, error) {
+	p := &Policy{
+		headers: map[string]bool{},
+		key:     []byte(opts.HashKey),
+	}
+	switch strings.ToLower(strings.TrimSpace(opts.Replacement)) {
+	case "", ReplacementHash:
+		p.replacement = ReplacementHash
+	case ReplacementMask:
+		p.replacement = ReplacementMask
+	default:
+		return nil, fmt.Errorf("unsupported redaction replacement %q", opts.Replacement)
+	}
+	for _, name := range opts.Headers {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			p.headers[name] = true
+		}
+	}
+	for _, raw := range opts.JSONPaths {
+		parsed, err := jsonpath.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("redaction json path %q: %w", raw, err)
+		}
+		p.paths = append(p.paths, parsed)
+	}
+	for _, pattern := range opts.Patterns {
+		if pattern.Regexp == nil {
+			return nil, fmt.Errorf("redaction pattern %q: missing regexp", pattern.Name)
+		}
+		if pattern.Name == "" {
+			pattern.Name = "pattern"
+		}
+		p.patterns = append(p.patterns, pattern)
+	}
+	return p, nil
+}
+
+func (p *Policy) Enabled() bool {
+	return p != nil && (len(p.headers) > 0 || len(p.paths) > 0 || len(p.patterns) > 0)
+}
+
+// Value 返回 value 的占位符。hash 模式下占位符只取决于 value 本身，name 仅用于提示来源。
+func (p *Policy) Value(name, value string) string {
+	if p.replacement == ReplacementMask {
+		return "[REDACTED:" + name + "]"
+	}
+	var sum []byte
+	if len(p.key) > 0 {
+		mac := hmac.New(sha256.New, p.key)
+		mac.Write([]byte(value))
+		sum = mac.Sum(nil)
+	} else {
+		digest := sha256.Sum256([]byte(value))
+		sum = digest[:]
+	}
+	return "[REDACTED:" + name + ":" + hex.EncodeToString(sum)[:12] + "]"
+}
+
+// HeaderBlock 脱敏一段原始 HTTP 头（首行为请求行或状态行，保持原有换行风格）。
+// 首行不做处理，以免破坏回放时的请求行解析。
+func (p *Policy) HeaderBlock(block []byte) ([]byte, bool) {
+	if !p.Enabled() || len(block) == 0 {
+		return block, false
+	}
+	lines := bytes.SplitAfter(block, []byte("\n"))
+	changed := false
+	for i := 1; i < len(lines); i++ {
+		line := lines[i]
+		content := bytes.TrimRight(line, "\r\n")
+		colon := bytes.IndexByte(content, ':')
+		if colon <= 0 {
+			continue
+		}
+		name := string(bytes.TrimSpace(content[:colon]))
+		value := string(bytes.TrimSpace(content[colon+1:]))
+		if value == "" || placeholderRe.FindString(value) == value {
+			continue
+		}
+		var redacted string
+		if p.headers[strings.ToLower(name)] {
+			redacted = p.Value(strings.ToLower(name), value)
+		} else {
+			redacted = p.text(value)
+		}
+		if redacted == value {
+			continue
+		}
+		lines[i] = append([]byte(name+": "+redacted), line[len(content):]...)
+		changed = true
+	}
+	if !changed {
+		return block, false
+	}
+	return bytes.Join(lines, nil), true
+}
+
+// Body 脱敏请求体或响应体：JSON 文档按路径与正则处理，SSE 按 data 行逐条处理，其余按纯文本做正则替换。
+func (p *Policy) Body(body []byte) ([]byte, bool) {
+	if !p.Enabled() || len(bytes.TrimSpace(body)) == 0 {
+		return body, false
+	}
+	if out, changed, ok := p.jsonDocument(body); ok {
+		return out, changed
+	}
+	if isSSE(body) {
+		return p.sse(body)
+	}
+	out := p.text(string(body))
+	return []byte(out), out != string(body)
+}
+
+func (p *Policy) jsonDocument(body []byte) ([]byte, bool, bool) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || (trimmed[0] != '{' && trimmed[0] != '[') || !json.Valid(trimmed) {
+		return nil, false, false
+	}
+	decoder := json.NewDecoder(bytes.NewReader(trimmed))
+	decoder.UseNumber()
+	var doc any
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, false, false
+	}
+
+	changed := false
+	for _, path := range p.paths {
+		doc = path.Apply(doc, func(name string, value any) any {
+			if name == "" {
+				name = "json"
+			}
+			return p.leaf(name, value, &changed)
+		})
+	}
+	doc = p.walkStrings(doc, &changed)
+	if !changed {
+		return body, false, true
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(doc); err != nil {
+		return body, false, true
+	}
+	start := bytes.Index(body, trimmed[:1])
+	out := append(append([]byte{}, body[:start]...), bytes.TrimSuffix(buf.Bytes(), []byte("\n"))...)
+	out = append(out, body[start+len(trimmed):]...)
+	return out, true, true
+}
+
+// leaf 用占位符替换整个值；非字符串值按其 JSON 编码计算占位符。
+func (p *Policy) leaf(name string, value any, changed *bool) any {
+	var raw string
+	switch v := value.(type) {
+	case nil:
+		return v
+	case string:
+		if placeholderRe.FindString(v) == v {
+			return v
+		}
+		raw = v
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return v
+		}
+		raw = string(encoded)
+	}
+	*changed = true
+	return p.Value(name, raw)
+}
+
+func (p *Policy) walkStrings(value any, changed *bool) any {
+	if len(p.patterns) == 0 {
+		return value
+	}
+	switch v := value.(type) {
+	case string:
+		out := p.text(v)
+		if out != v {
+			*changed = true
+		}
+		return out
+	case map[string]any:
+		for key, child := range v {
+			v[key] = p.walkStrings(child, changed)
+		}
+		return v
+	case []any:
+		for i := range v {
+			v[i] = p.walkStrings(v[i], changed)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// text 对纯文本做正则替换；已有占位符原样保留，保证重复脱敏的结果稳定。
+func (p *Policy) text(text string) string {
+	if len(p.patterns) == 0 {
+		return text
+	}
+	var out strings.Builder
+	last := 0
+	for _, loc := range placeholderRe.FindAllStringIndex(text, -1) {
+		out.WriteString(p.replacePatterns(text[last:loc[0]]))
+		out.WriteString(text[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	out.WriteString(p.replacePatterns(text[last:]))
+	return out.String()
+}
+
+func (p *Policy) replacePatterns(text string) string {
+	for _, pattern := range p.patterns {
+		text = pattern.Regexp.ReplaceAllStringFunc(text, func(hit string) string {
+			if pattern.Validate != nil && !pattern.Validate(hit) {
+				return hit
+			}
+			return p.Value(pattern.Name, hit)
+		})
+	}
+	return text
+}
+
+func isSSE(body []byte) bool {
+	return bytes.HasPrefix(body, []byte("data:")) || bytes.HasPrefix(body, []byte("event:")) || bytes.Contains(body, []byte("\ndata:"))
+}
+
+// sse 逐行处理 data 字段，保持事件分隔与其它字段不变。
+func (p *Policy) sse(body []byte) ([]byte, bool) {
+	lines := bytes.SplitAfter(body, []byte("\n"))
+	changed := false
+	for i, line := range lines {
+		content := bytes.TrimRight(line, "\r\n")
+		if !bytes.HasPrefix(content, []byte("data:")) {
+			continue
+		}
+		data := bytes.TrimPrefix(content, []byte("data:"))
+		prefix := content[:len(content)-len(bytes.TrimLeft(data, " "))]
+		data = bytes.TrimLeft(data, " ")
+		out, lineChanged, ok := p.jsonDocument(data)
+		if !ok {
+			redacted := p.text(string(data))
+			out, lineChanged = []byte(redacted), redacted != string(data)
+		}
+		if !lineChanged {
+			continue
+		}
+		lines[i] = append(append(append([]byte{}, prefix...), out...), line[len(content):]...)
+		changed = true
+	}
+	if !changed {
+		return body, false
+	}
+	return bytes.Join(lines, nil), true
+}
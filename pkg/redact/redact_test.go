@@ -0,0 +1,189 @@
+package redact
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kingfs/llm-tracelab/pkg/recordfile"
+	"github.com/kingfs/llm-tracelab/pkg/replay"
+)
+
+func newTestPolicy(t *testing.T, opts Options) *Policy {
+	t.Helper()
+	p, err := New(opts)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return p
+}
+
+func TestBodyRedactsPathsAndPatternsWithStableHashes(t *testing.T) {
+	p := newTestPolicy(t, Options{
+		JSONPaths: []string{"$.metadata.user_id", "messages[*].name"},
+		Patterns:  []Pattern{{Name: "email", Regexp: regexp.MustCompile(`[a-z]+@example\.com`)}},
+		HashKey:   "salt",
+	})
+
+	body := []byte(`{"metadata":{"user_id":"u-42"},"messages":[{"role":"user","name":"alice","content":"mail alice@example.com <now>"},{"role":"user","name":"alice","content":"cc alice@example.com"}]}` + "\n")
+	out, changed := p.Body(body)
+	if !changed {
+		t.Fatalf("Body() changed = false")
+	}
+	text := string(out)
+	for _, leaked := range []string{"u-42", `"alice"`, "alice@example.com"} {
+		if strings.Contains(text, leaked) {
+			t.Fatalf("Body() leaked %q: %s", leaked, text)
+		}
+	}
+	if !strings.HasSuffix(text, "\n") || !strings.Contains(text, "<now>") {
+		t.Fatalf("Body() should keep trailing newline and avoid HTML escaping: %q", text)
+	}
+
+	var doc struct {
+		Messages []struct {
+			Name    string `json:"name"`
+			Content string `json:"content"`
+		} `json:"messages"`
+	}
+	if err := json.Unmarshal(out, &doc); err != nil {
+		t.Fatalf("Body() output is not JSON: %v", err)
+	}
+	if doc.Messages[0].Name != doc.Messages[1].Name || doc.Messages[0].Name != p.Value("name", "alice") {
+		t.Fatalf("names = %q/%q, want equal hashed placeholders", doc.Messages[0].Name, doc.Messages[1].Name)
+	}
+	if strings.TrimPrefix(doc.Messages[1].Content, "cc ") != p.Value("email", "alice@example.com") {
+		t.Fatalf("content = %q", doc.Messages[1].Content)
+	}
+
+	again, changed := p.Body(out)
+	if changed || string(again) != string(out) {
+		t.Fatalf("Body() is not idempotent: %s", again)
+	}
+}
+
+func TestBodyRedactsSSEDataLines(t *testing.T) {
+	p := newTestPolicy(t, Options{Patterns: []Pattern{{Name: "key", Regexp: regexp.MustCompile(`sk-[a-z0-9]{8}`)}}, Replacement: ReplacementMask})
+	body := []byte("event: delta\ndata: {\"delta\":\"use sk-abcd1234\"}\n\ndata: [DONE]\n\n")
+	out, changed := p.Body(body)
+	if !changed {
+		t.Fatalf("Body() changed = false")
+	}
+	want := "event: delta\ndata: {\"delta\":\"use [REDACTED:key]\"}\n\ndata: [DONE]\n\n"
+	if string(out) != want {
+		t.Fatalf("Body() = %q, want %q", out, want)
+	}
+}
+
+func TestNewRejectsInvalidOptions(t *testing.T) {
+	for name, opts := range map[string]Options{
+		"replacement": {Replacement: "drop"},
+		"path":        {JSONPaths: []string{"messages[x]"}},
+		"empty_path":  {JSONPaths: []string{"$"}},
+		"pattern":     {Patterns: []Pattern{{Name: "nil"}}},
+	} {
+		if _, err := New(opts); err == nil {
+			t.Fatalf("New(%s) error = nil, want error", name)
+		}
+	}
+}
+
+func TestCassetteKeepsLayoutConsistentForReplay(t *testing.T) {
+	reqBody := `{"model":"gpt-4.1","user":"alice@example.com","messages":[{"role":"user","content":"hi"}]}`
+	resBody := `{"id":"resp-1","output":"hello alice@example.com"}`
+	reqHeader := "POST /v1/chat/completions HTTP/1.1\r\nHost: api.example.com\r\nAuthorization: Bearer sk-live-secret\r\nContent-Length: " + strconv.Itoa(len(reqBody)) + "\r\n\r\n"
+	resHeader := "HTTP/1.1 200 OK\r\nContent-Length: " + strconv.Itoa(len(resBody)) + "\r\nContent-Type: application/json\r\n\r\n"
+	header := recordfile.RecordHeader{
+		Version: "LLM_PROXY_V3",
+		Meta: recordfile.MetaData{
+			RequestID:  "req-1",
+			Time:       time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC),
+			Model:      "gpt-4.1",
+			URL:        "/v1/chat/completions",
+			Method:     "POST",
+			StatusCode: 200,
+		},
+		Layout: recordfile.LayoutInfo{
+			ReqHeaderLen: int64(len(reqHeader)),
+			ReqBodyLen:   int64(len(reqBody)),
+			ResHeaderLen: int64(len(resHeader)),
+			ResBodyLen:   int64(len(resBody)),
+		},
+	}
+	events := append(recordfile.BuildEvents(header), recordfile.RecordEvent{
+		Type:       "request.rewrite",
+		Attributes: map[string]interface{}{"original_body": `{"user":"alice@example.com"}`, "original_body_bytes": 28},
+	})
+	prelude, err := recordfile.MarshalPrelude(header, events)
+	if err != nil {
+		t.Fatalf("MarshalPrelude() error = %v", err)
+	}
+	content := append(prelude, []byte(reqHeader+reqBody+"\n"+resHeader+resBody)...)
+
+	p := newTestPolicy(t, Options{
+		Headers:  []string{"Authorization"},
+		Patterns: []Pattern{{Name: "email", Regexp: regexp.MustCompile(`[a-z]+@example\.com`)}},
+	})
+	out, changed, err := p.Cassette(content)
+	if err != nil || !changed {
+		t.Fatalf("Cassette() changed=%v err=%v", changed, err)
+	}
+	if strings.Contains(string(out), "alice@example.com") || strings.Contains(string(out), "sk-live-secret") {
+		t.Fatalf("Cassette() leaked secrets:\n%s", out)
+	}
+
+	parsed, err := recordfile.ParsePrelude(out)
+	if err != nil {
+		t.Fatalf("ParsePrelude() error = %v", err)
+	}
+	reqFull, gotReqBody, _, gotResBody := recordfile.ExtractSections(out, parsed)
+	layout := parsed.Header.Layout
+	if int64(len(gotReqBody)) != layout.ReqBodyLen || int64(len(gotResBody)) != layout.ResBodyLen {
+		t.Fatalf("layout = %+v, sections req=%d res=%d", layout, len(gotReqBody), len(gotResBody))
+	}
+	if !strings.Contains(string(reqFull), "Content-Length: "+strconv.Itoa(len(gotReqBody))+"\r\n") {
+		t.Fatalf("request Content-Length not updated:\n%s", reqFull)
+	}
+	for _, event := range parsed.Events {
+		switch event.Type {
+		case "request":
+			if event.BodyBytes != layout.ReqBodyLen || event.HeaderBytes != layout.ReqHeaderLen {
+				t.Fatalf("request event = %+v, layout = %+v", event, layout)
+			}
+		case "request.rewrite":
+			if strings.Contains(event.Attributes["original_body"].(string), "alice@") {
+				t.Fatalf("rewrite event leaked original body: %+v", event)
+			}
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "trace.http")
+	if err := os.WriteFile(path, out, 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	req, _ := http.NewRequest(http.MethodPost, "http://localhost/v1/chat/completions", strings.NewReader(`{}`))
+	resp, err := replay.NewTransport(path).RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	defer resp.Body.Close()
+	replayed, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if string(replayed) != string(gotResBody) || !strings.Contains(string(replayed), p.Value("email", "alice@example.com")) {
+		t.Fatalf("replayed body = %s", replayed)
+	}
+
+	again, changed, err := p.Cassette(out)
+	if err != nil || changed || string(again) != string(out) {
+		t.Fatalf("Cassette() second pass changed=%v err=%v", changed, err)
+	}
+}
@@ -51,7 +51,7 @@ func NewTransport(filename string) *Transport {
 }
 
 func ReplayFile(filename string, opts SummaryOptions) (*Summary, error) {
-	content, err := os.ReadFile(filename)
+	content, err := recordfile.ReadFile(filename)
 	if err != nil {
 		return nil, fmt.Errorf("replay: failed to read file %s: %w", filename, err)
 	}
@@ -114,14 +114,9 @@ func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 		return nil, err
 	}
 
-	f, err := os.Open(t.Filename)
+	f, err := openAt(t.Filename, respOffset)
 	if err != nil {
-		return nil, fmt.Errorf("replay: failed to open file %s: %w", t.Filename, err)
-	}
-
-	if _, err := f.Seek(respOffset, 0); err != nil {
-		f.Close()
-		return nil, fmt.Errorf("replay: seek failed: %w", err)
+		return nil, err
 	}
 
 	bufReader := bufio.NewReader(f)
@@ -156,7 +151,7 @@ func (t *Transport) cachedResponseOffset() (int64, error) {
 	}
 	t.mu.Unlock()
 
-	content, err := os.ReadFile(t.Filename)
+	content, err := recordfile.ReadFile(t.Filename)
 	if err != nil {
 		return 0, fmt.Errorf("replay: failed to read file %s: %w", t.Filename, err)
 	}
@@ -178,10 +173,34 @@ func (t *Transport) cachedResponseOffset() (int64, error) {
 	return respOffset, nil
 }
 
+// openAt 返回定位到 offset 的读取器。压缩 cassette 无法 seek，按流解压并跳过前面的字节。
+func openAt(filename string, offset int64) (io.ReadCloser, error) {
+	if recordfile.CompressionFromPath(filename) == "" {
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, fmt.Errorf("replay: failed to open file %s: %w", filename, err)
+		}
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("replay: seek failed: %w", err)
+		}
+		return f, nil
+	}
+	r, err := recordfile.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("replay: failed to open file %s: %w", filename, err)
+	}
+	if _, err := io.CopyN(io.Discard, r, offset); err != nil {
+		r.Close()
+		return nil, fmt.Errorf("replay: seek failed: %w", err)
+	}
+	return r, nil
+}
+
 // fileCloser 包装器，确保 Body 关闭时文件句柄也被释放
 type fileCloser struct {
 	io.ReadCloser
-	File *os.File
+	File io.Closer
 }
 
 func (fc *fileCloser) Close() error {
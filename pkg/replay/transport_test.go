@@ -198,3 +198,38 @@ func buildLegacyReplayFixture(t *testing.T, status string, resBody string) []byt
 	buf[len(headerJSON)] = '\n'
 	return append(buf, []byte(reqHeader+reqBody+"\n"+resHeader+resBody)...)
 }
+
+func TestTransportReadsCompressedCassettes(t *testing.T) {
+	t.Parallel()
+
+	for _, algorithm := range []string{recordfile.CompressionGzip, recordfile.CompressionZstd} {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "trace.http")
+		if err := os.WriteFile(path, buildReplayFixture(t, "200 OK", `{"output":"compressed"}`), 0o644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+		compressed, err := recordfile.CompressFile(path, algorithm)
+		if err != nil {
+			t.Fatalf("CompressFile(%s) error = %v", algorithm, err)
+		}
+
+		req, err := http.NewRequest(http.MethodPost, "http://localhost/v1/responses", strings.NewReader(`{"input":"hello"}`))
+		if err != nil {
+			t.Fatalf("NewRequest() error = %v", err)
+		}
+		resp, err := NewTransport(compressed).RoundTrip(req)
+		if err != nil {
+			t.Fatalf("RoundTrip(%s) error = %v", algorithm, err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil || string(body) != `{"output":"compressed"}` {
+			t.Fatalf("RoundTrip(%s) body = %q, err = %v", algorithm, body, err)
+		}
+
+		summary, err := ReplayFile(compressed, SummaryOptions{})
+		if err != nil || summary.StatusCode != http.StatusOK {
+			t.Fatalf("ReplayFile(%s) = %+v, err = %v", algorithm, summary, err)
+		}
+	}
+}
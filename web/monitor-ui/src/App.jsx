@@ -6,6 +6,8 @@ import { AnalysisPage } from "./routes/AnalysisPage";
 import { AuditPage } from "./routes/AuditPage";
 import { ChannelDetailPage } from "./routes/ChannelDetailPage";
 import { ChannelsPage } from "./routes/ChannelsPage";
+import { DatasetDetailPage } from "./routes/DatasetDetailPage";
+import { DatasetsPage } from "./routes/DatasetsPage";
 import { EventsPage } from "./routes/EventsPage";
 import { ModelDetailPage } from "./routes/ModelDetailPage";
 import { ModelsPage } from "./routes/ModelsPage";
@@ -112,6 +114,8 @@ function App() {
         <Route path="/traces" element={<RequestsPage />} />
         <Route path="/sessions" element={<SessionsPage />} />
         <Route path="/audit" element={<AuditPage />} />
+        <Route path="/datasets" element={<DatasetsPage />} />
+        <Route path="/datasets/:datasetID" element={<DatasetDetailPage />} />
         <Route path="/models" element={<ModelsPage />} />
         <Route path="/models/:model" element={<ModelDetailPage />} />
         <Route path="/channels" element={<ChannelsPage />} />
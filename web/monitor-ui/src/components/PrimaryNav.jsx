@@ -10,6 +10,7 @@ const navItems = [
   { to: "/sessions", label: "Sessions", icon: "layers" },
   { to: "/traces", label: "Traces", icon: "activity" },
   { to: "/audit", label: "Audit", icon: "shield" },
+  { to: "/datasets", label: "Datasets", icon: "database" },
   { to: "/models", label: "Models", icon: "box" },
   { to: "/channels", label: "Channels", icon: "plug" },
   { to: "/routing", label: "Routing", icon: "route" },
@@ -164,6 +165,8 @@ function NavIcon({ name }) {
       return <svg {...common}><path d="M4 12h4l2-6 4 12 2-6h4" /></svg>;
     case "shield":
       return <svg {...common}><path d="M12 3 5 6v5c0 4.2 2.8 8 7 10 4.2-2 7-5.8 7-10V6l-7-3Z" /><path d="m9.5 12 1.7 1.7 3.8-4" /></svg>;
+    case "database":
+      return <svg {...common}><ellipse cx="12" cy="6" rx="7" ry="3" /><path d="M5 6v6c0 1.7 3.1 3 7 3s7-1.3 7-3V6" /><path d="M5 12v6c0 1.7 3.1 3 7 3s7-1.3 7-3v-6" /></svg>;
     case "route":
       return <svg {...common}><circle cx="6" cy="6" r="2" /><circle cx="18" cy="18" r="2" /><path d="M8 6h5a3 3 0 0 1 0 6h-2a3 3 0 0 0 0 6h5" /></svg>;
     case "box":
@@ -0,0 +1,87 @@
+import React, { useState } from "react";
+import { createPortal } from "react-dom";
+import { Link } from "react-router-dom";
+import { useJSON } from "../../hooks/useJSON";
+import { apiPaths, postJSON } from "../../lib/api";
+
+const NEW_DATASET = "__new__";
+
+export function AddToDatasetButton({ source, label = "Add to dataset", subject = "" }) {
+  const [open, setOpen] = useState(false);
+  return (
+    <>
+      <button className="ghost-button" type="button" onClick={() => setOpen(true)}>{label}</button>
+      {open ? <AddToDatasetDialog source={source} subject={subject} onClose={() => setOpen(false)} /> : null}
+    </>
+  );
+}
+
+function AddToDatasetDialog({ source, subject, onClose }) {
+  const datasets = useJSON(apiPaths.datasets);
+  const [datasetID, setDatasetID] = useState("");
+  const [name, setName] = useState("");
+  const [note, setNote] = useState("");
+  const [busy, setBusy] = useState(false);
+  const [error, setError] = useState("");
+  const [result, setResult] = useState(null);
+  const items = datasets.data?.items || [];
+  const selected = datasetID || items[0]?.id || NEW_DATASET;
+
+  const submit = async (event) => {
+    event.preventDefault();
+    setBusy(true);
+    setError("");
+    setResult(null);
+    try {
+      let targetID = selected;
+      if (targetID === NEW_DATASET) {
+        const created = await postJSON(apiPaths.datasets, { name });
+        targetID = created.id;
+        setDatasetID(created.id);
+      }
+      setResult(await postJSON(apiPaths.datasetExamples(targetID), { ...source, note }));
+    } catch (err) {
+      setError(err.message || "Unable to add to dataset.");
+    } finally {
+      setBusy(false);
+    }
+  };
+
+  return createPortal(
+    <div className="nav-modal-backdrop" role="presentation">
+      <form className="nav-modal channel-create-modal" onSubmit={submit}>
+        <div className="nav-modal-head">
+          <div>
+            <p className="eyebrow">Datasets</p>
+            <h2>Add to dataset</h2>
+          </div>
+          <button className="icon-button" type="button" onClick={onClose} aria-label="Close">x</button>
+        </div>
+        {subject ? <p className="trace-subline">{subject}</p> : null}
+        {datasets.error ? <p className="auth-error">{datasets.error}</p> : null}
+        <div className="channel-form channel-form-modal">
+          <label className="channel-form-wide">Dataset<select value={selected} onChange={(event) => setDatasetID(event.target.value)} disabled={datasets.loading && !datasets.data}>
+            {items.map((item) => <option key={item.id} value={item.id}>{`${item.name} (${item.example_count})`}</option>)}
+            <option value={NEW_DATASET}>New dataset</option>
+          </select></label>
+          {selected === NEW_DATASET ? (
+            <label className="channel-form-wide">Dataset name<input required value={name} onChange={(event) => setName(event.target.value)} placeholder="checkout-regressions" /></label>
+          ) : null}
+          <label className="channel-form-wide">Note<input value={note} onChange={(event) => setNote(event.target.value)} placeholder="why these examples matter" /></label>
+        </div>
+        {error ? <p className="auth-error">{error}</p> : null}
+        {result ? (
+          <p className="auth-success">
+            {`Added ${result.added}, skipped ${result.skipped} already in ${result.dataset?.name || "dataset"}. `}
+            <Link to={`/datasets/${encodeURIComponent(result.dataset?.id || selected)}`} onClick={onClose}>Open dataset</Link>
+          </p>
+        ) : null}
+        <div className="nav-modal-actions">
+          <button className="ghost-button" type="button" onClick={onClose}>{result ? "Close" : "Cancel"}</button>
+          <button className="ghost-button active" type="submit" disabled={busy}>{busy ? "Adding" : "Add"}</button>
+        </div>
+      </form>
+    </div>,
+    document.body,
+  );
+}
@@ -15,6 +15,8 @@ export const apiPaths = {
   eventRead: (eventID) => `/api/events/${encodeURIComponent(eventID)}/read`,
   eventResolve: (eventID) => `/api/events/${encodeURIComponent(eventID)}/resolve`,
   eventIgnore: (eventID) => `/api/events/${encodeURIComponent(eventID)}/ignore`,
+  notifications: "/api/notifications",
+  notificationRetry: (deliveryID) => `/api/notifications/${encodeURIComponent(deliveryID)}/retry`,
   traces: "/api/traces",
   findings: "/api/findings",
   analysis: "/api/analysis",
@@ -32,6 +34,13 @@ export const apiPaths = {
   traceScan: (traceID) => `/api/traces/${encodeURIComponent(traceID)}/scan`,
   traceRepairUsage: (traceID) => `/api/traces/${encodeURIComponent(traceID)}/repair-usage`,
   traceReanalyze: (traceID) => `/api/traces/${encodeURIComponent(traceID)}/reanalyze`,
+  traceRerun: (traceID) => `/api/traces/${encodeURIComponent(traceID)}/rerun`,
+  traceDiff: (traceID, otherID) => `/api/traces/${encodeURIComponent(traceID)}/diff/${encodeURIComponent(otherID)}`,
+  datasets: "/api/datasets",
+  dataset: (datasetID) => `/api/datasets/${encodeURIComponent(datasetID)}`,
+  datasetExamples: (datasetID) => `/api/datasets/${encodeURIComponent(datasetID)}/examples`,
+  datasetExample: (datasetID, traceID) => `/api/datasets/${encodeURIComponent(datasetID)}/examples/${encodeURIComponent(traceID)}`,
+  datasetSnapshots: (datasetID) => `/api/datasets/${encodeURIComponent(datasetID)}/snapshots`,
   sessions: "/api/sessions",
   session: (sessionID) => `/api/sessions/${encodeURIComponent(sessionID)}`,
   sessionAnalysis: (sessionID) => `/api/sessions/${encodeURIComponent(sessionID)}/analysis`,
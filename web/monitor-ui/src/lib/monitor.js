@@ -224,6 +224,7 @@ export function normalizeTraceTab(value = "") {
     case "protocol":
     case "audit":
     case "performance":
+    case "rerun":
     case "raw":
       return value;
     case "timeline":
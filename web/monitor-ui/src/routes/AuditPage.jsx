@@ -2,6 +2,7 @@ import React from "react";
 import { Link, useSearchParams } from "react-router-dom";
 import { EmptyState } from "../components/common/EmptyState";
 import { DetailMetaPill, InlineTag } from "../components/common/Badges";
+import { AddToDatasetButton } from "../components/monitor/AddToDatasetButton";
 import { useJSON } from "../hooks/useJSON";
 import { apiPaths, apiURL } from "../lib/api";
 import { setOrDeleteParam } from "../lib/monitor";
@@ -82,6 +83,12 @@ export function AuditPage() {
                 <div className="action-group action-group-start">
                   <Link className="ghost-button" to={`/traces/${encodeURIComponent(finding.trace_id)}?tab=audit`}>Open Finding</Link>
                   <Link className="ghost-button" to={`/traces/${encodeURIComponent(finding.trace_id)}?tab=protocol`}>Protocol</Link>
+                  <AddToDatasetButton source={{ trace_ids: [finding.trace_id] }} subject={`Trace ${finding.trace_id} with ${finding.category} finding`} />
+                  <AddToDatasetButton
+                    label="Add all like this"
+                    source={{ finding: { category: finding.category, severity: finding.severity } }}
+                    subject={`Every trace with a ${finding.severity} ${finding.category} finding`}
+                  />
                 </div>
               </article>
             ))}
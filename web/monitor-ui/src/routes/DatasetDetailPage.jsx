@@ -0,0 +1,249 @@
+import React, { useEffect, useState } from "react";
+import { Link, useNavigate, useParams } from "react-router-dom";
+import { DetailMetaPill, HomeIcon, InlineTag } from "../components/common/Badges";
+import { EmptyState } from "../components/common/EmptyState";
+import { useJSON } from "../hooks/useJSON";
+import { apiPaths, patchJSON, postJSON, requestJSON } from "../lib/api";
+import { buildTraceLink, formatDateTime, formatDuration, formatEndpointTag } from "../lib/monitor";
+
+export function DatasetDetailPage() {
+  const { datasetID = "" } = useParams();
+  const navigate = useNavigate();
+  const [refreshTick, setRefreshTick] = useState(0);
+  const [form, setForm] = useState({ name: "", description: "" });
+  const [snapshotNote, setSnapshotNote] = useState("");
+  const [busy, setBusy] = useState("");
+  const [notice, setNotice] = useState(null);
+  const [confirmDelete, setConfirmDelete] = useState(false);
+  const detail = useJSON(apiPaths.dataset(datasetID), [datasetID, refreshTick]);
+  const dataset = detail.data?.dataset;
+  const examples = detail.data?.examples || [];
+  const snapshots = detail.data?.snapshots || [];
+  const refresh = () => setRefreshTick((tick) => tick + 1);
+
+  useEffect(() => {
+    if (dataset) {
+      setForm({ name: dataset.name || "", description: dataset.description || "" });
+    }
+  }, [dataset?.id, dataset?.updated_at]);
+
+  const runAction = async (action, request, successText) => {
+    setBusy(action);
+    setNotice(null);
+    try {
+      const response = await request();
+      setNotice({ tone: "green", text: successText(response) });
+      refresh();
+    } catch (err) {
+      setNotice({ tone: "danger", text: err.message || "request failed" });
+    } finally {
+      setBusy("");
+    }
+  };
+
+  const saveDataset = (event) => {
+    event.preventDefault();
+    runAction("save", () => patchJSON(apiPaths.dataset(datasetID), form), () => "Dataset updated.");
+  };
+
+  const freezeSnapshot = (event) => {
+    event.preventDefault();
+    runAction("snapshot", () => postJSON(apiPaths.datasetSnapshots(datasetID), { note: snapshotNote }), (snapshot) => {
+      setSnapshotNote("");
+      return `Snapshot v${snapshot.version} frozen with ${snapshot.example_count} examples.`;
+    });
+  };
+
+  const deleteDataset = async () => {
+    setBusy("delete");
+    try {
+      await requestJSON(apiPaths.dataset(datasetID), { method: "DELETE" });
+      navigate("/datasets");
+    } catch (err) {
+      setNotice({ tone: "danger", text: err.message || "Unable to delete dataset." });
+      setBusy("");
+    }
+  };
+
+  return (
+    <div className="shell shell-detail">
+      <header className="topbar detail-topbar">
+        <div className="detail-title-block">
+          <div className="detail-heading-row">
+            <h1>{dataset?.name || "dataset detail"}</h1>
+            <div className="trace-tag-group detail-tag-group">
+              <InlineTag tone="accent">{dataset?.example_count ?? 0} examples</InlineTag>
+              <InlineTag tone={dataset?.version ? "green" : "default"}>{dataset?.version ? `v${dataset.version}` : "no snapshot"}</InlineTag>
+            </div>
+          </div>
+          <div className="detail-meta-strip">
+            <DetailMetaPill label="dataset" value={dataset?.id || datasetID} mono />
+            <DetailMetaPill label="created" value={formatDateTime(dataset?.created_at)} />
+            <DetailMetaPill label="updated" value={formatDateTime(dataset?.updated_at)} />
+          </div>
+        </div>
+        <div className="topbar-meta detail-toolbar">
+          <div className="detail-toolbar-actions">
+            <Link className="icon-button" to="/datasets" title="Back to datasets" aria-label="Back to datasets">
+              <HomeIcon />
+            </Link>
+            {confirmDelete ? (
+              <>
+                <button className="ghost-button" type="button" onClick={() => setConfirmDelete(false)}>Keep</button>
+                <button className="ghost-button active" type="button" disabled={busy === "delete"} onClick={deleteDataset}>{busy === "delete" ? "Deleting" : "Confirm delete"}</button>
+              </>
+            ) : (
+              <button className="ghost-button" type="button" onClick={() => setConfirmDelete(true)}>Delete dataset</button>
+            )}
+          </div>
+        </div>
+      </header>
+
+      {notice ? <EmptyState title="Dataset" detail={notice.text} tone={notice.tone} compact /> : null}
+      {detail.error ? <EmptyState title="Unable to load dataset" detail={detail.error} tone="danger" /> : null}
+      {detail.loading && !detail.data ? <EmptyState title="Loading dataset" detail="Reading examples, edits and frozen snapshots." /> : null}
+
+      {detail.data ? (
+        <div className="detail-grid">
+          <section className="panel">
+            <div className="panel-head">
+              <div>
+                <p className="eyebrow">Settings</p>
+                <h2>Dataset</h2>
+              </div>
+            </div>
+            <form className="channel-form" onSubmit={saveDataset}>
+              <label>Name<input required value={form.name} onChange={(event) => setForm((current) => ({ ...current, name: event.target.value }))} /></label>
+              <label className="dataset-form-span">Description<input value={form.description} onChange={(event) => setForm((current) => ({ ...current, description: event.target.value }))} /></label>
+              <div className="channel-form-actions">
+                <button className="ghost-button active" type="submit" disabled={busy === "save"}>{busy === "save" ? "Saving" : "Save"}</button>
+              </div>
+            </form>
+          </section>
+
+          <section className="panel">
+            <div className="panel-head">
+              <div>
+                <p className="eyebrow">Examples</p>
+                <h2>{examples.length} trace{examples.length === 1 ? "" : "s"}</h2>
+              </div>
+            </div>
+            {examples.length ? (
+              <div className="finding-list">
+                {examples.map((example) => (
+                  <DatasetExampleCard key={example.trace_id} datasetID={datasetID} example={example} onChanged={refresh} onNotice={setNotice} />
+                ))}
+              </div>
+            ) : (
+              <EmptyState title="No examples" detail="Use Add to dataset from a trace, session or finding to collect examples." compact />
+            )}
+          </section>
+
+          <section className="panel">
+            <div className="panel-head">
+              <div>
+                <p className="eyebrow">Versions</p>
+                <h2>Snapshots</h2>
+              </div>
+            </div>
+            <form className="filter-bar" onSubmit={freezeSnapshot}>
+              <input className="filter-input filter-input-wide" type="text" placeholder="Snapshot note" value={snapshotNote} onChange={(event) => setSnapshotNote(event.target.value)} />
+              <button className="ghost-button active" type="submit" disabled={busy === "snapshot" || !examples.length}>{busy === "snapshot" ? "Freezing" : "Freeze snapshot"}</button>
+            </form>
+            {snapshots.length ? (
+              <div className="finding-list">
+                {snapshots.map((snapshot) => (
+                  <article key={snapshot.id} className="finding-card">
+                    <div className="finding-card-head">
+                      <div>
+                        <strong>{`v${snapshot.version}`}</strong>
+                        <span>{snapshot.note || "No note"}</span>
+                      </div>
+                      <InlineTag>{snapshot.example_count} examples</InlineTag>
+                    </div>
+                    <div className="detail-meta-strip">
+                      <DetailMetaPill label="created" value={formatDateTime(snapshot.created_at)} />
+                      <DetailMetaPill label="by" value={snapshot.created_by || "-"} />
+                    </div>
+                  </article>
+                ))}
+              </div>
+            ) : (
+              <EmptyState title="No snapshots" detail="Freeze a snapshot before running evaluations against a fixed version." compact />
+            )}
+          </section>
+        </div>
+      ) : null}
+    </div>
+  );
+}
+
+function DatasetExampleCard({ datasetID, example, onChanged, onNotice }) {
+  const [expectedOutput, setExpectedOutput] = useState(example.expected_output || "");
+  const [tags, setTags] = useState((example.tags || []).join(", "));
+  const [note, setNote] = useState(example.note || "");
+  const [busy, setBusy] = useState("");
+  const trace = example.trace || {};
+
+  const save = async (event) => {
+    event.preventDefault();
+    setBusy("save");
+    try {
+      await patchJSON(apiPaths.datasetExample(datasetID, example.trace_id), {
+        expected_output: expectedOutput,
+        tags: tags.split(",").map((tag) => tag.trim()).filter(Boolean),
+        note,
+      });
+      onNotice({ tone: "green", text: `Example ${example.trace_id} saved.` });
+      onChanged();
+    } catch (err) {
+      onNotice({ tone: "danger", text: err.message || "Unable to save example." });
+    } finally {
+      setBusy("");
+    }
+  };
+
+  const remove = async () => {
+    setBusy("remove");
+    try {
+      await requestJSON(apiPaths.datasetExample(datasetID, example.trace_id), { method: "DELETE" });
+      onNotice({ tone: "green", text: `Example ${example.trace_id} removed.` });
+      onChanged();
+    } catch (err) {
+      onNotice({ tone: "danger", text: err.message || "Unable to remove example." });
+      setBusy("");
+    }
+  };
+
+  return (
+    <article className="finding-card">
+      <div className="finding-card-head">
+        <div>
+          <Link to={buildTraceLink(example.trace_id)}><strong>{trace.model || example.trace_id}</strong></Link>
+          <span className="mono">{example.trace_id}</span>
+        </div>
+        <div className="trace-tag-group">
+          <InlineTag tone="accent">{formatEndpointTag(trace.endpoint || trace.operation)}</InlineTag>
+          <InlineTag tone={trace.status_code >= 200 && trace.status_code < 300 ? "green" : "danger"}>{trace.status_code || 0}</InlineTag>
+          {example.source_type ? <InlineTag>{example.source_id ? `${example.source_type}: ${example.source_id}` : example.source_type}</InlineTag> : null}
+        </div>
+      </div>
+      <div className="detail-meta-strip">
+        <DetailMetaPill label="position" value={example.position} />
+        <DetailMetaPill label="added" value={formatDateTime(example.added_at)} />
+        <DetailMetaPill label="duration" value={formatDuration(trace.duration_ms || 0)} />
+        <DetailMetaPill label="tokens" value={trace.total_tokens || 0} />
+        {example.input_override ? <DetailMetaPill label="input override" value="set" /> : null}
+      </div>
+      <form className="channel-form" onSubmit={save}>
+        <label className="channel-form-wide">Expected output<textarea value={expectedOutput} onChange={(event) => setExpectedOutput(event.target.value)} /></label>
+        <label>Tags<input value={tags} onChange={(event) => setTags(event.target.value)} placeholder="smoke, refund" /></label>
+        <label className="dataset-form-span">Note<input value={note} onChange={(event) => setNote(event.target.value)} /></label>
+        <div className="channel-form-actions">
+          <button className="ghost-button" type="button" disabled={busy === "remove"} onClick={remove}>{busy === "remove" ? "Removing" : "Remove"}</button>
+          <button className="ghost-button active" type="submit" disabled={busy === "save"}>{busy === "save" ? "Saving" : "Save example"}</button>
+        </div>
+      </form>
+    </article>
+  );
+}
@@ -0,0 +1,100 @@
+import React, { useState } from "react";
+import { Link, useNavigate } from "react-router-dom";
+import { InlineTag, PlusIcon } from "../components/common/Badges";
+import { EmptyState } from "../components/common/EmptyState";
+import { useJSON } from "../hooks/useJSON";
+import { apiPaths, postJSON } from "../lib/api";
+import { formatDateTime } from "../lib/monitor";
+
+export function DatasetsPage() {
+  const navigate = useNavigate();
+  const [name, setName] = useState("");
+  const [description, setDescription] = useState("");
+  const [error, setError] = useState("");
+  const [saving, setSaving] = useState(false);
+  const datasets = useJSON(apiPaths.datasets);
+  const items = datasets.data?.items || [];
+
+  const createDataset = async (event) => {
+    event.preventDefault();
+    setSaving(true);
+    setError("");
+    try {
+      const created = await postJSON(apiPaths.datasets, { name, description });
+      navigate(`/datasets/${encodeURIComponent(created.id)}`);
+    } catch (err) {
+      setError(err.message || "Unable to create dataset.");
+    } finally {
+      setSaving(false);
+    }
+  };
+
+  return (
+    <div className="shell shell-list">
+      <header className="topbar">
+        <div>
+          <p className="eyebrow">Regression sets</p>
+          <h1>Datasets</h1>
+        </div>
+        <div className="topbar-meta">
+          <span className="badge">{items.length} datasets</span>
+        </div>
+      </header>
+
+      <section className="panel token-panel">
+        <div className="panel-head">
+          <div>
+            <p className="eyebrow">Curate from traffic</p>
+            <h2>Create dataset</h2>
+          </div>
+        </div>
+        <form className="token-form" onSubmit={createDataset}>
+          <label className="token-field" htmlFor="dataset-name">
+            <span>Name</span>
+            <input id="dataset-name" type="text" required value={name} onChange={(event) => setName(event.target.value)} />
+          </label>
+          <label className="token-field" htmlFor="dataset-description">
+            <span>Description</span>
+            <input id="dataset-description" type="text" value={description} onChange={(event) => setDescription(event.target.value)} />
+          </label>
+          <button className="icon-button token-create-button" type="submit" disabled={saving} title={saving ? "Creating dataset" : "Create dataset"} aria-label={saving ? "Creating dataset" : "Create dataset"}>
+            <PlusIcon />
+          </button>
+        </form>
+        {error ? <p className="auth-error">{error}</p> : null}
+      </section>
+
+      <section className="panel">
+        <div className="panel-head">
+          <div>
+            <p className="eyebrow">Dataset inventory</p>
+            <h2>All datasets</h2>
+          </div>
+        </div>
+        {datasets.error ? <EmptyState title="Unable to load datasets" detail={datasets.error} tone="danger" /> : null}
+        {datasets.loading && !datasets.data ? <EmptyState title="Loading datasets" detail="Reading datasets and their example counts." /> : null}
+        {items.length ? (
+          <div className="finding-list">
+            {items.map((item) => (
+              <article key={item.id} className="finding-card">
+                <div className="finding-card-head">
+                  <div>
+                    <Link to={`/datasets/${encodeURIComponent(item.id)}`}><strong>{item.name}</strong></Link>
+                    <span>{item.description || "No description"}</span>
+                  </div>
+                  <div className="trace-tag-group">
+                    <InlineTag tone="accent">{item.example_count} examples</InlineTag>
+                    <InlineTag tone={item.version ? "green" : "default"}>{item.version ? `v${item.version}` : "no snapshot"}</InlineTag>
+                  </div>
+                </div>
+                <span className="trace-subline">updated {formatDateTime(item.updated_at)}</span>
+              </article>
+            ))}
+          </div>
+        ) : datasets.data ? (
+          <EmptyState title="No datasets" detail="Create one here, or use Add to dataset from a trace, session or finding." />
+        ) : null}
+      </section>
+    </div>
+  );
+}
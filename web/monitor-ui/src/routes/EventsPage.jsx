@@ -11,6 +11,7 @@ const WINDOW_OPTIONS = ["1h", "24h", "7d", "all"];
 const STATUS_OPTIONS = ["unread", "read", "resolved", "ignored", "all"];
 const SEVERITY_OPTIONS = ["all", "critical", "error", "warning", "info"];
 const SOURCE_OPTIONS = ["all", "parser", "analyzer", "router", "upstream", "proxy", "recorder", "monitor", "store", "auth", "mcp"];
+const DELIVERY_STATUS_OPTIONS = ["all", "pending", "delivered", "failed"];
 
 export function EventsPage() {
   const [searchParams, setSearchParams] = useSearchParams();
@@ -142,10 +143,72 @@ export function EventsPage() {
           )}
         </section>
       </div>
+
+      <DeliveryLog />
     </div>
   );
 }
 
+function DeliveryLog() {
+  const [status, setStatus] = useState("all");
+  const [refreshTick, setRefreshTick] = useState(0);
+  const [busyID, setBusyID] = useState(0);
+  const [retryError, setRetryError] = useState("");
+  const { loading, data, error } = useJSON(apiURL(apiPaths.notifications, status === "all" ? { limit: "50" } : { status, limit: "50" }), [status, refreshTick]);
+  const items = data?.items || [];
+
+  const retry = async (deliveryID) => {
+    setBusyID(deliveryID);
+    setRetryError("");
+    try {
+      await postJSON(apiPaths.notificationRetry(deliveryID), {});
+      setRefreshTick((tick) => tick + 1);
+    } catch (err) {
+      setRetryError(err.message || "Retry failed.");
+    } finally {
+      setBusyID(0);
+    }
+  };
+
+  return (
+    <section className="panel delivery-log-panel">
+      <div className="panel-head">
+        <div>
+          <p className="eyebrow">Notifications</p>
+          <h2>Delivery log</h2>
+        </div>
+        <div className="topbar-meta">
+          <select className="filter-input" value={status} onChange={(event) => setStatus(event.target.value)} aria-label="Delivery status">
+            {DELIVERY_STATUS_OPTIONS.map((option) => <option key={option} value={option}>{option}</option>)}
+          </select>
+          <button className="ghost-button" type="button" onClick={() => setRefreshTick((tick) => tick + 1)}>Refresh</button>
+        </div>
+      </div>
+      {error ? <EmptyState title="Unable to load deliveries" detail={error} tone="danger" compact /> : null}
+      {retryError ? <EmptyState title="Retry failed" detail={retryError} tone="danger" compact /> : null}
+      {loading && !data ? <EmptyState title="Loading deliveries" detail="Fetching the notification outbox." compact /> : null}
+      <div className="event-list">
+        {items.length ? items.map((item) => (
+          <div key={item.id} className="delivery-row">
+            <span className="event-row-main">
+              <strong>{item.event?.title || item.fingerprint}</strong>
+              <span>{item.last_error || item.event?.message || item.fingerprint}</span>
+            </span>
+            <span className="event-row-meta delivery-row-meta">
+              <InlineTag tone="accent">{item.sink}</InlineTag>
+              <InlineTag tone={deliveryTone(item.status)}>{item.status}</InlineTag>
+              <small>{deliveryTiming(item)}</small>
+              {item.status === "failed" ? (
+                <button className="ghost-button" type="button" onClick={() => retry(item.id)} disabled={busyID === item.id}>Retry</button>
+              ) : null}
+            </span>
+          </div>
+        )) : (!loading && !error ? <EmptyState title="No deliveries" detail="Notifications appear here once a configured sink matches a system event." compact /> : null)}
+      </div>
+    </section>
+  );
+}
+
 function EventDetail({ event, busyID, onAction }) {
   return (
     <div className="event-detail">
@@ -215,6 +278,29 @@ function severityTone(severity = "") {
   }
 }
 
+function deliveryTone(status = "") {
+  switch (status) {
+    case "delivered":
+      return "green";
+    case "failed":
+      return "danger";
+    default:
+      return "gold";
+  }
+}
+
+function deliveryTiming(item) {
+  const attempts = `${item.attempts ?? 0} attempt${item.attempts === 1 ? "" : "s"}`;
+  const status = item.response_status ? ` · HTTP ${item.response_status}` : "";
+  if (item.status === "delivered") {
+    return `delivered ${formatDateTime(item.delivered_at)} · ${attempts}${status}`;
+  }
+  if (item.status === "pending") {
+    return `next ${formatDateTime(item.next_attempt_at)} · ${attempts}${status}`;
+  }
+  return `queued ${formatDateTime(item.created_at)} · ${attempts}${status}`;
+}
+
 function formatJSON(value) {
   if (!value) {
     return "{}";
@@ -3,6 +3,7 @@ import { Link, useParams } from "react-router-dom";
 import { StatCard } from "../components/common/Display";
 import { DetailMetaPill, HomeIcon, InlineTag, TokenBadge, ViewIcon } from "../components/common/Badges";
 import { EmptyState } from "../components/common/EmptyState";
+import { AddToDatasetButton } from "../components/monitor/AddToDatasetButton";
 import { BreakdownList } from "../components/monitor/BreakdownList";
 import { RequestList } from "../components/monitor/RequestList";
 import { useJSON } from "../hooks/useJSON";
@@ -78,6 +79,7 @@ export function SessionDetailPage() {
             <Link className="icon-button" to="/sessions" title="Back to sessions" aria-label="Back to sessions">
               <HomeIcon />
             </Link>
+            <AddToDatasetButton source={{ session_id: summary?.session_id || sessionID }} subject={`All traces in session ${summary?.session_id || sessionID}`} />
             <button className="ghost-button active" type="button" disabled={jobBusy} onClick={reanalyzeSession}>
               {jobBusy ? "Queueing" : "Reanalyze"}
             </button>
@@ -3,6 +3,7 @@ import { Link, useParams, useSearchParams } from "react-router-dom";
 import { CollapsibleCard, CodeBlock, MessageContent } from "../components/common/Display";
 import { DetailMetaPill, DownloadIcon, HomeIcon, InlineTag, StackIcon, TokenBadge } from "../components/common/Badges";
 import { EmptyState } from "../components/common/EmptyState";
+import { AddToDatasetButton } from "../components/monitor/AddToDatasetButton";
 import { useJSON } from "../hooks/useJSON";
 import { apiPaths, downloadBlob, postJSON } from "../lib/api";
 import {
@@ -18,6 +19,7 @@ import {
   formatProviderTag,
   formatRatio,
   formatRoutingScore,
+  formatSignedMetric,
   formatTokenRate,
   healthTone,
   metricThresholdTone,
@@ -173,6 +175,7 @@ export function TraceDetailPage() {
             </button>
           </div>
           <div className="detail-toolbar-actions trace-reanalysis-actions">
+            <AddToDatasetButton source={{ trace_ids: [traceID] }} subject={`Trace ${traceID}`} />
             <button className="ghost-button" type="button" disabled={jobBusy === "repair"} onClick={() => runTraceAction("repair", apiPaths.traceRepairUsage(traceID), { mode: "sync" })}>
               {jobBusy === "repair" ? "Repairing" : "Repair usage"}
             </button>
@@ -263,6 +266,11 @@ export function TraceDetailPage() {
               <span>Latency and token speed</span>
               <p>Use this for latency, TTFT, token throughput, cache ratio, status, and routing context.</p>
             </button>
+            <button className={tab === "rerun" ? "trace-reading-card trace-reading-card-active" : "trace-reading-card"} onClick={() => setTab("rerun")}>
+              <strong>Rerun</strong>
+              <span>Playground replay</span>
+              <p>Use this to edit the request, replay it through the proxy, and compare the rerun side by side with the original.</p>
+            </button>
             <button className={tab === "raw" ? "trace-reading-card trace-reading-card-active" : "trace-reading-card"} onClick={() => setTab("raw")}>
               <strong>Raw</strong>
               <span>Original HTTP exchange</span>
@@ -425,6 +433,7 @@ export function TraceDetailPage() {
       {tab === "protocol" ? <ProtocolPanel observation={observation} CodeBlock={CodeBlock} InlineTag={InlineTag} /> : null}
       {tab === "audit" ? <AuditPanel findings={findings} InlineTag={InlineTag} CodeBlock={CodeBlock} /> : null}
       {tab === "performance" ? <PerformancePanel performance={performance} /> : null}
+      {tab === "rerun" ? <RerunPanel traceID={traceID} /> : null}
       {tab === "raw" ? <RawProtocolPanel raw={raw} focusTarget={focusTarget} /> : null}
     </div>
   );
@@ -726,6 +735,209 @@ function PerformancePanel({ performance }) {
   );
 }
 
+function RerunPanel({ traceID }) {
+  const [reloadKey, setReloadKey] = useState(0);
+  const [form, setForm] = useState(null);
+  const [busy, setBusy] = useState(false);
+  const [notice, setNotice] = useState(null);
+  const [selectedRerun, setSelectedRerun] = useState("");
+  const rerun = useJSON(apiPaths.traceRerun(traceID), [traceID, reloadKey]);
+  const draft = rerun.data?.draft;
+  const reruns = rerun.data?.reruns || [];
+  const rerunOf = rerun.data?.rerun_of;
+  const compareRerunID = selectedRerun || reruns[0]?.trace_id || "";
+  const comparison = rerunOf
+    ? { original: rerunOf.original_trace_id, rerun: traceID }
+    : compareRerunID
+      ? { original: traceID, rerun: compareRerunID }
+      : null;
+
+  useEffect(() => {
+    if (draft) {
+      setForm(buildRerunForm(draft));
+    }
+  }, [draft?.trace_id]);
+
+  const updateForm = (key, value) => setForm((current) => ({ ...current, [key]: value }));
+  const updateMessage = (index, text) => setForm((current) => ({
+    ...current,
+    messages: current.messages.map((message) => (message.index === index ? { ...message, text } : message)),
+  }));
+
+  const submit = async (event) => {
+    event.preventDefault();
+    setBusy(true);
+    setNotice(null);
+    try {
+      const response = await postJSON(apiPaths.traceRerun(traceID), buildRerunEdits(draft, form));
+      setNotice({ tone: "green", text: `Rerun ${response.trace_id} returned ${response.status_code} via ${response.channel || "router"}` });
+      setSelectedRerun(response.trace_id);
+    } catch (error) {
+      if (error.payload?.trace_id) {
+        setSelectedRerun(error.payload.trace_id);
+      }
+      setNotice({ tone: "danger", text: error.message || "rerun failed" });
+    } finally {
+      setBusy(false);
+      setReloadKey((key) => key + 1);
+    }
+  };
+
+  if (rerun.error) {
+    return <EmptyState title="Unable to load playground" detail={rerun.error} tone="danger" />;
+  }
+  if (rerun.loading && !rerun.data) {
+    return <EmptyState title="Loading playground" detail="Reading the recorded request and earlier reruns for this trace." />;
+  }
+
+  return (
+    <div className="detail-grid">
+      {rerunOf ? (
+        <EmptyState
+          title="This trace is a rerun"
+          detail={`Replayed from ${rerunOf.original_trace_id} at ${formatDateTime(rerunOf.created_at)}. The comparison below shows the original on the left.`}
+          compact
+        />
+      ) : (
+        <section className="panel">
+          <div className="panel-head">
+            <div>
+              <p className="eyebrow">Playground</p>
+              <h2>Edit and rerun</h2>
+            </div>
+            <InlineTag tone={rerun.data?.enabled ? "green" : "gold"}>{rerun.data?.enabled ? "proxy attached" : "read only"}</InlineTag>
+          </div>
+          {form ? (
+            <form className="channel-form" onSubmit={submit}>
+              <label>Model<input value={form.model} onChange={(event) => updateForm("model", event.target.value)} disabled={!draft.editable} /></label>
+              <label>Channel<select value={form.channel} onChange={(event) => updateForm("channel", event.target.value)}>
+                <option value="">Router default</option>
+                {(rerun.data?.channels || []).map((channel) => <option key={channel} value={channel}>{channel === draft.channel ? `${channel} (original)` : channel}</option>)}
+              </select></label>
+              <label>Temperature<input type="number" step="0.1" min="0" value={form.temperature} onChange={(event) => updateForm("temperature", event.target.value)} disabled={!draft.editable} /></label>
+              <label>Endpoint<input value={`${draft.method} ${draft.path}`} readOnly /></label>
+              {draft.editable ? (
+                <>
+                  <label className="channel-form-wide">System<textarea value={form.system} onChange={(event) => updateForm("system", event.target.value)} /></label>
+                  {form.messages.map((message) => (
+                    <label key={message.index} className="channel-form-wide">
+                      {`#${message.index} ${message.role || "message"}`}
+                      <textarea value={message.text} onChange={(event) => updateMessage(message.index, event.target.value)} />
+                    </label>
+                  ))}
+                </>
+              ) : (
+                <p className="trace-subline channel-form-wide">This endpoint does not support structured edits; the original body is replayed unchanged.</p>
+              )}
+              <div className="channel-form-actions">
+                <button className="ghost-button" type="button" onClick={() => setForm(buildRerunForm(draft))}>Reset</button>
+                <button className="ghost-button active" type="submit" disabled={busy || !rerun.data?.enabled}>{busy ? "Running" : "Rerun"}</button>
+              </div>
+            </form>
+          ) : null}
+          {notice ? <EmptyState title="Rerun" detail={notice.text} tone={notice.tone} compact /> : null}
+          {reruns.length ? (
+            <div className="action-group action-group-start rerun-history">
+              {reruns.map((item) => (
+                <button
+                  key={item.trace_id}
+                  className={item.trace_id === compareRerunID ? "ghost-button active" : "ghost-button"}
+                  type="button"
+                  onClick={() => setSelectedRerun(item.trace_id)}
+                  title={item.trace_id}
+                >
+                  {formatDateTime(item.created_at)}
+                </button>
+              ))}
+            </div>
+          ) : null}
+        </section>
+      )}
+      {comparison ? <RerunComparison originalID={comparison.original} rerunID={comparison.rerun} /> : null}
+    </div>
+  );
+}
+
+function RerunComparison({ originalID, rerunID }) {
+  const [showUnchanged, setShowUnchanged] = useState(false);
+  const diff = useJSON(apiPaths.traceDiff(originalID, rerunID), [originalID, rerunID]);
+
+  if (diff.error) {
+    return <EmptyState title="Unable to compare traces" detail={diff.error} tone="danger" />;
+  }
+  if (diff.loading && !diff.data) {
+    return <EmptyState title="Comparing traces" detail="Parsing both cassettes and aligning their semantic sections." />;
+  }
+  const sections = (diff.data?.sections || []).filter((section) => showUnchanged || section.changed);
+
+  return (
+    <section className="panel">
+      <div className="panel-head">
+        <div>
+          <p className="eyebrow">Original vs rerun</p>
+          <h2>Side-by-side comparison</h2>
+        </div>
+        <div className="panel-head-actions">
+          <InlineTag tone={diff.data?.identical ? "green" : "danger"}>{diff.data?.identical ? "content identical" : "content changed"}</InlineTag>
+          {diff.data?.performance_changed ? <InlineTag tone="gold">usage or timings differ</InlineTag> : null}
+          <label className="wrap-toggle">
+            <input type="checkbox" checked={showUnchanged} onChange={(event) => setShowUnchanged(event.target.checked)} />
+            Show unchanged
+          </label>
+        </div>
+      </div>
+      <div className="raw-grid rerun-compare-head">
+        <RerunCompareSide label="Original" side={diff.data?.a} />
+        <RerunCompareSide label="Rerun" side={diff.data?.b} />
+      </div>
+      {sections.length ? (
+        sections.map((section) => (
+          <CollapsibleCard key={section.name} title={formatDiffSection(section.name)} subtitle={`${(section.items || []).filter((item) => item.status !== "same").length} change(s)`} defaultOpen={section.changed}>
+            {(section.items || []).filter((item) => showUnchanged || item.status !== "same").map((item) => (
+              <div key={item.key} className={`rerun-diff-row rerun-diff-${item.status}`}>
+                <div className="trace-tag-group">
+                  <InlineTag tone={diffStatusTone(item.status)}>{item.status}</InlineTag>
+                  <span className="mono">{item.key}</span>
+                  {item.delta !== undefined && item.delta !== null ? <InlineTag>{formatSignedMetric(Math.round(item.delta * 100) / 100)}</InlineTag> : null}
+                </div>
+                <div className="raw-grid">
+                  <CodeBlock value={item.a || "-"} />
+                  <CodeBlock value={item.b || "-"} />
+                </div>
+                {item.changes?.length ? (
+                  <div className="detail-meta-strip">
+                    {item.changes.map((change) => (
+                      <DetailMetaPill key={`${change.path}-${change.status}`} label={`${change.status} ${change.path}`} value={`${JSON.stringify(change.a ?? null)} → ${JSON.stringify(change.b ?? null)}`} mono />
+                    ))}
+                  </div>
+                ) : null}
+              </div>
+            ))}
+          </CollapsibleCard>
+        ))
+      ) : (
+        <EmptyState title="No differences" detail="Every semantic section matches between the original and the rerun." compact />
+      )}
+    </section>
+  );
+}
+
+function RerunCompareSide({ label, side }) {
+  return (
+    <div className="breakdown-card">
+      <div className="breakdown-title">{label}</div>
+      <div className="routing-summary-stack">
+        {side?.trace_id ? <Link className="trace-model-name mono" to={`/traces/${encodeURIComponent(side.trace_id)}`}>{side.trace_id}</Link> : <strong>-</strong>}
+        <div className="trace-tag-group">
+          {side?.model ? <InlineTag tone="accent">{side.model}</InlineTag> : null}
+          {side?.provider ? <InlineTag>{formatProviderTag(side.provider)}</InlineTag> : null}
+          {side?.endpoint ? <InlineTag>{formatEndpointTag(side.endpoint)}</InlineTag> : null}
+        </div>
+      </div>
+    </div>
+  );
+}
+
 function RawProtocolPanel({ raw, focusTarget = "" }) {
   const [wrap, setWrap] = useState(false);
   const requestRef = useRef(null);
@@ -1070,3 +1282,53 @@ function labelTraceAction(action) {
       return "Analysis";
   }
 }
+
+function buildRerunForm(draft) {
+  return {
+    model: draft.model || "",
+    channel: "",
+    temperature: draft.temperature === undefined || draft.temperature === null ? "" : String(draft.temperature),
+    system: draft.system || "",
+    messages: (draft.messages || []).map((message) => ({ ...message })),
+  };
+}
+
+function buildRerunEdits(draft, form) {
+  const edits = { channel: form.channel };
+  if (!draft.editable) {
+    return edits;
+  }
+  const model = form.model.trim();
+  if (model && model !== draft.model) {
+    edits.model = model;
+  }
+  if (form.system !== (draft.system || "")) {
+    edits.system = form.system;
+  }
+  if (form.temperature !== "" && Number(form.temperature) !== draft.temperature) {
+    edits.temperature = Number(form.temperature);
+  }
+  const original = new Map((draft.messages || []).map((message) => [message.index, message.text]));
+  const messages = form.messages.filter((message) => message.text !== original.get(message.index)).map(({ index, text }) => ({ index, text }));
+  if (messages.length) {
+    edits.messages = messages;
+  }
+  return edits;
+}
+
+function formatDiffSection(name = "") {
+  return name.replace(/_/g, " ").replace(/^\w/, (letter) => letter.toUpperCase());
+}
+
+function diffStatusTone(status = "") {
+  switch (status) {
+    case "added":
+      return "green";
+    case "removed":
+      return "danger";
+    case "changed":
+      return "gold";
+    default:
+      return "default";
+  }
+}
@@ -70,9 +70,52 @@ test.beforeEach(async ({ page }) => {
     if (path === "/api/traces/trace-routed/reanalyze" && method === "POST") {
       return route.fulfill({ json: { job: analysisJobPayload({ id: 301, job_type: "trace_reanalyze", target_type: "trace", target_id: "trace-routed", status: "completed" }) } });
     }
+    if (path === "/api/traces/trace-routed/rerun" && method === "GET") {
+      return route.fulfill({ json: rerunDetailPayload() });
+    }
+    if (path === "/api/traces/trace-routed/rerun" && method === "POST") {
+      const body = route.request().postDataJSON();
+      expect(body).toEqual({ channel: "openai-backup", messages: [{ index: 0, text: "hello again" }] });
+      return route.fulfill({ json: { trace_id: "trace-rerun", rerun_of: "trace-routed", channel: "openai-backup", status_code: 200, compare: "/api/traces/trace-routed/diff/trace-rerun" } });
+    }
+    if (path === "/api/traces/trace-routed/diff/trace-rerun") {
+      return route.fulfill({ json: traceDiffPayload() });
+    }
     if (path === "/api/traces/trace-routed/observation" || path === "/api/traces/trace-routed/findings" || path === "/api/traces/trace-routed/performance") {
       return route.fulfill({ json: {} });
     }
+    if (path === "/api/datasets" && method === "GET") {
+      return route.fulfill({ json: { items: [datasetPayload()] } });
+    }
+    if (path === "/api/datasets/ds-1" && method === "GET") {
+      return route.fulfill({ json: datasetDetailPayload() });
+    }
+    if (path === "/api/datasets/ds-1/examples" && method === "POST") {
+      const body = route.request().postDataJSON();
+      expect(body.trace_ids).toEqual(["trace-routed"]);
+      return route.fulfill({ json: { added: 1, skipped: 0, source_type: "trace", dataset: datasetPayload() } });
+    }
+    if (path === "/api/datasets/ds-1/examples/trace-routed" && method === "PATCH") {
+      const body = route.request().postDataJSON();
+      expect(body.expected_output).toBe("a friendly greeting");
+      expect(body.tags).toEqual(["smoke", "greeting"]);
+      return route.fulfill({ json: datasetDetailPayload().examples[0] });
+    }
+    if (path === "/api/datasets/ds-1/snapshots" && method === "POST") {
+      return route.fulfill({ status: 201, json: { id: "snap-1", version: 1, example_count: 1, created_at: new Date().toISOString() } });
+    }
+    if (path === "/api/events") {
+      return route.fulfill({ json: { items: [], total: 0, page: 1, page_size: 50, total_pages: 0 } });
+    }
+    if (path === "/api/events/summary") {
+      return route.fulfill({ json: { total: 0, unread: 0, critical: 0, error: 0, warning: 0 } });
+    }
+    if (path === "/api/notifications" && method === "GET") {
+      return route.fulfill({ json: { items: notificationDeliveriesPayload(), total: 2 } });
+    }
+    if (path === "/api/notifications/7/retry" && method === "POST") {
+      return route.fulfill({ json: { ...notificationDeliveriesPayload()[1], status: "pending", attempts: 0 } });
+    }
     if (path === "/api/analysis") {
       return route.fulfill({ json: analysisPayload() });
     }
@@ -176,6 +219,53 @@ test("trace routing links to channel and upstream views", async ({ page }) => {
   await expect(page.getByText(/Reanalysis job #301 completed/)).toBeVisible();
 });
 
+test("trace rerun compares the original and the rerun side by side", async ({ page }) => {
+  await page.goto("/traces/trace-routed?tab=rerun");
+  await expect(page.getByRole("heading", { name: "Edit and rerun" })).toBeVisible();
+  await page.getByLabel("Channel").selectOption("openai-backup");
+  await page.getByLabel("#0 user").fill("hello again");
+  await page.getByRole("button", { name: "Rerun", exact: true }).click();
+  await expect(page.getByText(/Rerun trace-rerun returned 200 via openai-backup/)).toBeVisible();
+  await expect(page.getByRole("heading", { name: "Side-by-side comparison" })).toBeVisible();
+  await expect(page.getByText("content changed")).toBeVisible();
+  await expect(page.getByText("usage or timings differ")).toBeVisible();
+  await expect(page.getByRole("link", { name: "trace-rerun" })).toHaveAttribute("href", "/traces/trace-rerun");
+  await expect(page.getByText("hi, how can I help?")).toBeVisible();
+});
+
+test("datasets collect traces and freeze snapshots", async ({ page }) => {
+  await page.goto("/traces/trace-routed");
+  await page.getByRole("button", { name: "Add to dataset" }).click();
+  await expect(page.locator(".nav-modal select")).toHaveValue("ds-1");
+  await page.getByRole("button", { name: "Add", exact: true }).click();
+  await expect(page.getByText(/Added 1, skipped 0 already in Regression smoke/)).toBeVisible();
+  await page.getByRole("link", { name: "Open dataset" }).click();
+
+  await expect(page.getByRole("heading", { name: "Regression smoke" })).toBeVisible();
+  await expect(page.getByText("trace-routed").first()).toBeVisible();
+  await page.getByLabel("Expected output").fill("a friendly greeting");
+  await page.getByLabel("Tags").fill("smoke, greeting");
+  await page.getByRole("button", { name: "Save example" }).click();
+  await expect(page.getByText("Example trace-routed saved.")).toBeVisible();
+  await page.getByRole("button", { name: "Freeze snapshot" }).click();
+  await expect(page.getByText("Snapshot v1 frozen with 1 examples.")).toBeVisible();
+
+  await page.goto("/datasets");
+  await expect(page.getByRole("heading", { name: "Datasets", exact: true })).toBeVisible();
+  await expect(page.getByRole("link", { name: "Regression smoke" })).toHaveAttribute("href", "/datasets/ds-1");
+});
+
+test("events page lists notification deliveries and retries failures", async ({ page }) => {
+  await page.goto("/events");
+  await expect(page.getByRole("heading", { name: "Delivery log" })).toBeVisible();
+  const failed = page.locator(".delivery-row").filter({ hasText: "collector returned status 502" });
+  await expect(failed).toContainText("ops-webhook");
+  await expect(page.locator(".delivery-row").filter({ hasText: "Upstream transport error" }).first()).toContainText("delivered");
+  const retry = page.waitForRequest((request) => request.url().endsWith("/api/notifications/7/retry") && request.method() === "POST");
+  await failed.getByRole("button", { name: "Retry" }).click();
+  await retry;
+});
+
 test("analysis page renders runs and reanalysis jobs", async ({ page }) => {
   await page.goto("/analysis");
   await expect(page.getByRole("heading", { name: "Analysis", exact: true })).toBeVisible();
@@ -352,6 +442,62 @@ function tracePayload() {
   };
 }
 
+function datasetPayload() {
+  return {
+    id: "ds-1",
+    name: "Regression smoke",
+    description: "Greeting flows",
+    created_at: new Date().toISOString(),
+    updated_at: new Date().toISOString(),
+    example_count: 1,
+    version: 0,
+  };
+}
+
+function datasetDetailPayload() {
+  const trace = traceListPayload().items[0];
+  return {
+    dataset: datasetPayload(),
+    examples: [{ trace_id: "trace-routed", position: 1, added_at: new Date().toISOString(), source_type: "trace", tags: [], trace }],
+    snapshots: [],
+  };
+}
+
+function rerunDetailPayload() {
+  return {
+    draft: {
+      trace_id: "trace-routed",
+      method: "POST",
+      path: "/v1/responses",
+      provider: "openai",
+      endpoint: "/v1/responses",
+      model: "gpt-5",
+      channel: "openai-primary",
+      editable: true,
+      messages: [{ index: 0, role: "user", text: "hello" }],
+      body: {},
+    },
+    reruns: [],
+    channels: ["openai-backup", "openai-primary"],
+    enabled: true,
+  };
+}
+
+function traceDiffPayload() {
+  return {
+    a: { trace_id: "trace-routed", provider: "openai", endpoint: "/v1/responses", model: "gpt-5" },
+    b: { trace_id: "trace-rerun", provider: "openai", endpoint: "/v1/responses", model: "gpt-5" },
+    identical: false,
+    performance_changed: true,
+    sections: [
+      { name: "messages", changed: true, items: [{ key: "message[0]", status: "changed", a: "hello", b: "hello again" }] },
+      { name: "outputs", changed: true, items: [{ key: "output[0]", status: "changed", a: "hello!", b: "hi, how can I help?" }] },
+      { name: "usage", changed: true, items: [{ key: "total_tokens", status: "changed", a: "120", b: "128", delta: 8 }] },
+      { name: "finish_reasons", changed: false, items: [{ key: "choice[0]", status: "same", a: "stop", b: "stop" }] },
+    ],
+  };
+}
+
 function traceListPayload() {
   return {
     page: 1,
@@ -477,3 +623,11 @@ function usageSummary(overrides = {}) {
     ...overrides,
   };
 }
+
+function notificationDeliveriesPayload() {
+  const event = { id: "evt-1", fingerprint: "upstream:openai-primary:transport", title: "Upstream transport error", message: "dial tcp: connection refused", severity: "error" };
+  return [
+    { id: 8, sink: "team-slack", event_id: "evt-1", fingerprint: event.fingerprint, event, status: "delivered", attempts: 1, response_status: 200, created_at: "2026-10-18T08:00:00Z", delivered_at: "2026-10-18T08:00:01Z" },
+    { id: 7, sink: "ops-webhook", event_id: "evt-1", fingerprint: event.fingerprint, event, status: "failed", attempts: 5, last_error: "collector returned status 502", response_status: 502, created_at: "2026-10-18T07:59:00Z" },
+  ];
+}